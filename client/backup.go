@@ -0,0 +1,131 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"strings"
+
+	"github.com/opensds/opensds/pkg/model"
+	"github.com/opensds/opensds/pkg/utils/urls"
+)
+
+type BackupBuilder *model.BackupSpec
+type RestoreBackupBuilder *model.RestoreBackupSpec
+
+// NewBackupMgr
+func NewBackupMgr(r Receiver, edp string, tenantId string) *BackupMgr {
+	return &BackupMgr{
+		Receiver: r,
+		Endpoint: edp,
+		TenantId: tenantId,
+	}
+}
+
+// BackupMgr
+type BackupMgr struct {
+	Receiver
+	Endpoint string
+	TenantId string
+}
+
+// CreateBackup
+func (b *BackupMgr) CreateBackup(body BackupBuilder) (*model.BackupSpec, error) {
+	var res model.BackupSpec
+	url := strings.Join([]string{
+		b.Endpoint,
+		urls.GenerateBackupURL(urls.Client, b.TenantId)}, "/")
+
+	if err := b.Recv(url, "POST", body, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// GetBackup
+func (b *BackupMgr) GetBackup(backupId string) (*model.BackupSpec, error) {
+	var res model.BackupSpec
+	url := strings.Join([]string{
+		b.Endpoint,
+		urls.GenerateBackupURL(urls.Client, b.TenantId, backupId)}, "/")
+
+	if err := b.Recv(url, "GET", nil, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// ListBackups
+func (b *BackupMgr) ListBackups(args ...interface{}) ([]*model.BackupSpec, error) {
+	var res []*model.BackupSpec
+
+	url := strings.Join([]string{
+		b.Endpoint,
+		urls.GenerateBackupURL(urls.Client, b.TenantId)}, "/")
+
+	param, err := processListParam(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if param != "" {
+		url += "?" + param
+	}
+
+	if err := b.Recv(url, "GET", nil, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// UpdateBackup
+func (b *BackupMgr) UpdateBackup(backupId string, body BackupBuilder) (*model.BackupSpec, error) {
+	var res model.BackupSpec
+	url := strings.Join([]string{
+		b.Endpoint,
+		urls.GenerateBackupURL(urls.Client, b.TenantId, backupId)}, "/")
+
+	if err := b.Recv(url, "PUT", body, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// DeleteBackup
+func (b *BackupMgr) DeleteBackup(backupId string) error {
+	url := strings.Join([]string{
+		b.Endpoint,
+		urls.GenerateBackupURL(urls.Client, b.TenantId, backupId)}, "/")
+
+	return b.Recv(url, "DELETE", nil, nil)
+}
+
+// RestoreBackup restores the backup into the volume specified in body, or
+// into a new volume if no volume is specified, and returns that volume.
+func (b *BackupMgr) RestoreBackup(backupId string, body RestoreBackupBuilder) (*model.VolumeSpec, error) {
+	var res model.VolumeSpec
+	url := strings.Join([]string{
+		b.Endpoint,
+		urls.GenerateBackupURL(urls.Client, b.TenantId, backupId, "restore")}, "/")
+
+	if err := b.Recv(url, "POST", body, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/opensds/opensds/pkg/model"
+	. "github.com/opensds/opensds/testutils/collection"
+)
+
+var fb = &BackupMgr{
+	Receiver: NewFakeBackupReceiver(),
+}
+
+func TestCreateBackup(t *testing.T) {
+	expected := &SampleBackups[0]
+
+	bkp, err := fb.CreateBackup(&model.BackupSpec{
+		Name:     "sample-backup-01",
+		VolumeId: "bd5b12a8-a101-11e7-941e-d77981b584d8",
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if !reflect.DeepEqual(bkp, expected) {
+		t.Errorf("expected %v, got %v", expected, bkp)
+		return
+	}
+}
+
+func TestGetBackup(t *testing.T) {
+	var bkpID = "5d8c2a5e-5e0f-11e9-8cf1-6f3c2b7e2c11"
+	expected := &SampleBackups[0]
+
+	bkp, err := fb.GetBackup(bkpID)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if !reflect.DeepEqual(bkp, expected) {
+		t.Errorf("expected %v, got %v", expected, bkp)
+		return
+	}
+}
+
+func TestListBackups(t *testing.T) {
+	var expected []*model.BackupSpec
+	expected = append(expected, &SampleBackups[0])
+	expected = append(expected, &SampleBackups[1])
+
+	bkps, err := fb.ListBackups(map[string]string{"volumeId": "bd5b12a8-a101-11e7-941e-d77981b584d8"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if !reflect.DeepEqual(bkps, expected) {
+		t.Errorf("expected %v, got %v", expected, bkps)
+		return
+	}
+}
+
+func TestUpdateBackup(t *testing.T) {
+	var bkpID = "5d8c2a5e-5e0f-11e9-8cf1-6f3c2b7e2c11"
+	expected := &SampleBackups[0]
+
+	bkp, err := fb.UpdateBackup(bkpID, &model.BackupSpec{
+		Name: "sample-backup-01",
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if !reflect.DeepEqual(bkp, expected) {
+		t.Errorf("expected %v, got %v", expected, bkp)
+		return
+	}
+}
+
+func TestDeleteBackup(t *testing.T) {
+	var bkpID = "5d8c2a5e-5e0f-11e9-8cf1-6f3c2b7e2c11"
+
+	if err := fb.DeleteBackup(bkpID); err != nil {
+		t.Error(err)
+		return
+	}
+}
+
+func TestRestoreBackup(t *testing.T) {
+	var bkpID = "5d8c2a5e-5e0f-11e9-8cf1-6f3c2b7e2c11"
+	expected := &SampleVolumes[0]
+
+	vol, err := fb.RestoreBackup(bkpID, &model.RestoreBackupSpec{
+		VolumeId: "bd5b12a8-a101-11e7-941e-d77981b584d8",
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if !reflect.DeepEqual(vol, expected) {
+		t.Errorf("expected %v, got %v", expected, vol)
+		return
+	}
+}
@@ -41,6 +41,12 @@ type Client struct {
 	*VolumeMgr
 	*VersionMgr
 	*ReplicationMgr
+	*OperationMgr
+	*BackupMgr
+	*QuotaMgr
+	*FileShareMgr
+	*WebhookMgr
+	*WatchMgr
 
 	cfg *Config
 }
@@ -96,6 +102,12 @@ func NewClient(c *Config) (*Client, error) {
 		VolumeMgr:      NewVolumeMgr(r, c.Endpoint, t),
 		VersionMgr:     NewVersionMgr(r, c.Endpoint, t),
 		ReplicationMgr: NewReplicationMgr(r, c.Endpoint, t),
+		OperationMgr:   NewOperationMgr(r, c.Endpoint, t),
+		BackupMgr:      NewBackupMgr(r, c.Endpoint, t),
+		QuotaMgr:       NewQuotaMgr(r, c.Endpoint, t),
+		FileShareMgr:   NewFileShareMgr(r, c.Endpoint, t),
+		WebhookMgr:     NewWebhookMgr(r, c.Endpoint, t),
+		WatchMgr:       NewWatchMgr(r, c.Endpoint, t),
 	}, nil
 }
 
@@ -17,6 +17,8 @@ package client
 import (
 	"encoding/json"
 	"errors"
+	"io"
+	"io/ioutil"
 	"os"
 	"strings"
 	"sync"
@@ -59,6 +61,30 @@ func NewFakeClient(config *Config) *Client {
 				Receiver: NewFakeVersionReceiver(),
 				Endpoint: config.Endpoint,
 			},
+			OperationMgr: &OperationMgr{
+				Receiver: NewFakeOperationReceiver(),
+				Endpoint: config.Endpoint,
+			},
+			BackupMgr: &BackupMgr{
+				Receiver: NewFakeBackupReceiver(),
+				Endpoint: config.Endpoint,
+			},
+			QuotaMgr: &QuotaMgr{
+				Receiver: NewFakeQuotaReceiver(),
+				Endpoint: config.Endpoint,
+			},
+			FileShareMgr: &FileShareMgr{
+				Receiver: NewFakeFileShareReceiver(),
+				Endpoint: config.Endpoint,
+			},
+			WebhookMgr: &WebhookMgr{
+				Receiver: NewFakeWebhookReceiver(),
+				Endpoint: config.Endpoint,
+			},
+			WatchMgr: &WatchMgr{
+				Receiver: NewFakeWatchReceiver(),
+				Endpoint: config.Endpoint,
+			},
 		}
 	})
 	return fakeClient
@@ -237,6 +263,13 @@ func (*fakeVolumeReceiver) Recv(
 				return err
 			}
 			break
+		case *model.VolumeGroupSnapshotSpec:
+			if err := json.Unmarshal([]byte(ByteGroupSnapshot), out); err != nil {
+				return err
+			}
+			break
+		case nil:
+			break
 		default:
 			return errors.New("output format not supported")
 		}
@@ -283,6 +316,16 @@ func (*fakeVolumeReceiver) Recv(
 				return err
 			}
 			break
+		case *model.VolumeGroupSnapshotSpec:
+			if err := json.Unmarshal([]byte(ByteGroupSnapshot), out); err != nil {
+				return err
+			}
+			break
+		case *[]*model.VolumeGroupSnapshotSpec:
+			if err := json.Unmarshal([]byte(ByteGroupSnapshots), out); err != nil {
+				return err
+			}
+			break
 		default:
 			return errors.New("output format not supported")
 		}
@@ -331,6 +374,184 @@ func (*fakeReplicationReceiver) Recv(
 	return errors.New("input method format not supported")
 }
 
+func NewFakeOperationReceiver() Receiver {
+	return &fakeOperationReceiver{}
+}
+
+type fakeOperationReceiver struct{}
+
+func (*fakeOperationReceiver) Recv(
+	string,
+	method string,
+	in interface{},
+	out interface{},
+) error {
+	if strings.ToUpper(method) != "GET" {
+		return errors.New("method not supported")
+	}
+
+	switch out.(type) {
+	case *model.OperationSpec:
+		return json.Unmarshal([]byte(ByteOperation), out)
+	case *[]*model.OperationSpec:
+		return json.Unmarshal([]byte(ByteOperations), out)
+	default:
+		return errors.New("output format not supported")
+	}
+}
+
+func NewFakeBackupReceiver() Receiver {
+	return &fakeBackupReceiver{}
+}
+
+type fakeBackupReceiver struct{}
+
+func (*fakeBackupReceiver) Recv(
+	url string,
+	method string,
+	in interface{},
+	out interface{},
+) error {
+	switch strings.ToUpper(method) {
+	case "POST", "PUT", "GET":
+		switch out.(type) {
+		case *model.BackupSpec:
+			return json.Unmarshal([]byte(ByteBackup), out)
+		case *[]*model.BackupSpec:
+			return json.Unmarshal([]byte(ByteBackups), out)
+		case *model.VolumeSpec:
+			return json.Unmarshal([]byte(ByteVolume), out)
+		default:
+			return errors.New("output format not supported")
+		}
+	case "DELETE":
+		return nil
+	}
+	return errors.New("input method format not supported")
+}
+
+func NewFakeQuotaReceiver() Receiver {
+	return &fakeQuotaReceiver{}
+}
+
+type fakeQuotaReceiver struct{}
+
+func (*fakeQuotaReceiver) Recv(
+	string,
+	method string,
+	in interface{},
+	out interface{},
+) error {
+	switch strings.ToUpper(method) {
+	case "PUT", "GET":
+		switch out.(type) {
+		case *model.QuotaSpec:
+			return json.Unmarshal([]byte(ByteQuota), out)
+		default:
+			return errors.New("output format not supported")
+		}
+	}
+	return errors.New("input method format not supported")
+}
+
+func NewFakeWebhookReceiver() Receiver {
+	return &fakeWebhookReceiver{}
+}
+
+type fakeWebhookReceiver struct{}
+
+func (*fakeWebhookReceiver) Recv(
+	string,
+	method string,
+	in interface{},
+	out interface{},
+) error {
+	switch strings.ToUpper(method) {
+	case "POST", "GET":
+		switch out.(type) {
+		case *model.WebhookSpec:
+			return json.Unmarshal([]byte(ByteWebhook), out)
+		case *[]*model.WebhookSpec:
+			return json.Unmarshal([]byte(ByteWebhooks), out)
+		case *[]*model.WebhookDeliverySpec:
+			return json.Unmarshal([]byte(ByteWebhookDeliveries), out)
+		default:
+			return errors.New("output format not supported")
+		}
+	case "DELETE":
+		return nil
+	}
+	return errors.New("input method format not supported")
+}
+
+func NewFakeWatchReceiver() Receiver {
+	return &fakeWatchReceiver{}
+}
+
+type fakeWatchReceiver struct{}
+
+func (*fakeWatchReceiver) Recv(
+	string,
+	method string,
+	in interface{},
+	out interface{},
+) error {
+	return errors.New("method not supported")
+}
+
+// Stream returns the events of a volume created and deleted, then ends the
+// watch with an ERROR event.
+func (*fakeWatchReceiver) Stream(string) (io.ReadCloser, error) {
+	return ioutil.NopCloser(strings.NewReader(ByteWatchEvents)), nil
+}
+
+func NewFakeFileShareReceiver() Receiver {
+	return &fakeFileShareReceiver{}
+}
+
+type fakeFileShareReceiver struct{}
+
+func (*fakeFileShareReceiver) Recv(
+	string,
+	method string,
+	in interface{},
+	out interface{},
+) error {
+	switch strings.ToUpper(method) {
+	case "POST", "PUT":
+		switch out.(type) {
+		case *model.FileShareSpec:
+			return json.Unmarshal([]byte(ByteFileShare), out)
+		case *model.FileShareSnapshotSpec:
+			return json.Unmarshal([]byte(ByteFileShareSnapshot), out)
+		case *model.FileShareAclSpec:
+			return json.Unmarshal([]byte(ByteFileShareAcl), out)
+		default:
+			return errors.New("output format not supported")
+		}
+	case "GET":
+		switch out.(type) {
+		case *model.FileShareSpec:
+			return json.Unmarshal([]byte(ByteFileShare), out)
+		case *[]*model.FileShareSpec:
+			return json.Unmarshal([]byte(ByteFileShares), out)
+		case *model.FileShareSnapshotSpec:
+			return json.Unmarshal([]byte(ByteFileShareSnapshot), out)
+		case *[]*model.FileShareSnapshotSpec:
+			return json.Unmarshal([]byte(ByteFileShareSnapshots), out)
+		case *model.FileShareAclSpec:
+			return json.Unmarshal([]byte(ByteFileShareAcl), out)
+		case *[]*model.FileShareAclSpec:
+			return json.Unmarshal([]byte(ByteFileShareAcls), out)
+		default:
+			return errors.New("output format not supported")
+		}
+	case "DELETE":
+		return nil
+	}
+	return errors.New("inputed method format not supported")
+}
+
 func NewFakeVersionReceiver() Receiver {
 	return &fakeVersionReceiver{}
 }
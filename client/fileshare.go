@@ -0,0 +1,259 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"strings"
+
+	"github.com/opensds/opensds/pkg/model"
+	"github.com/opensds/opensds/pkg/utils/urls"
+)
+
+// FileShareBuilder contains request body of handling a fileshare request.
+// Currently it's assigned as the pointer of FileShareSpec struct, but it
+// could be discussed if it's better to define an interface.
+type FileShareBuilder *model.FileShareSpec
+
+// FileShareSnapshotBuilder contains request body of handling a fileshare
+// snapshot request. Currently it's assigned as the pointer of
+// FileShareSnapshotSpec struct, but it could be discussed if it's better
+// to define an interface.
+type FileShareSnapshotBuilder *model.FileShareSnapshotSpec
+
+// FileShareAclBuilder contains request body of handling a fileshare acl
+// request. Currently it's assigned as the pointer of FileShareAclSpec
+// struct, but it could be discussed if it's better to define an interface.
+type FileShareAclBuilder *model.FileShareAclSpec
+
+// NewFileShareMgr
+func NewFileShareMgr(r Receiver, edp string, tenantId string) *FileShareMgr {
+	return &FileShareMgr{
+		Receiver: r,
+		Endpoint: edp,
+		TenantId: tenantId,
+	}
+}
+
+// FileShareMgr
+type FileShareMgr struct {
+	Receiver
+	Endpoint string
+	TenantId string
+}
+
+// CreateFileShare
+func (f *FileShareMgr) CreateFileShare(body FileShareBuilder) (*model.FileShareSpec, error) {
+	var res model.FileShareSpec
+	url := strings.Join([]string{
+		f.Endpoint,
+		urls.GenerateFileShareURL(urls.Client, f.TenantId)}, "/")
+
+	if err := f.Recv(url, "POST", body, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// GetFileShare
+func (f *FileShareMgr) GetFileShare(fshareID string) (*model.FileShareSpec, error) {
+	var res model.FileShareSpec
+	url := strings.Join([]string{
+		f.Endpoint,
+		urls.GenerateFileShareURL(urls.Client, f.TenantId, fshareID)}, "/")
+
+	if err := f.Recv(url, "GET", nil, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// ListFileShares
+func (f *FileShareMgr) ListFileShares(args ...interface{}) ([]*model.FileShareSpec, error) {
+	url := strings.Join([]string{
+		f.Endpoint,
+		urls.GenerateFileShareURL(urls.Client, f.TenantId)}, "/")
+
+	param, err := processListParam(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if param != "" {
+		url += "?" + param
+	}
+
+	var res []*model.FileShareSpec
+	if err := f.Recv(url, "GET", nil, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// DeleteFileShare
+func (f *FileShareMgr) DeleteFileShare(fshareID string) error {
+	url := strings.Join([]string{
+		f.Endpoint,
+		urls.GenerateFileShareURL(urls.Client, f.TenantId, fshareID)}, "/")
+
+	return f.Recv(url, "DELETE", nil, nil)
+}
+
+// UpdateFileShare
+func (f *FileShareMgr) UpdateFileShare(fshareID string, body FileShareBuilder) (*model.FileShareSpec, error) {
+	var res model.FileShareSpec
+	url := strings.Join([]string{
+		f.Endpoint,
+		urls.GenerateFileShareURL(urls.Client, f.TenantId, fshareID)}, "/")
+
+	if err := f.Recv(url, "PUT", body, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// CreateFileShareSnapshot
+func (f *FileShareMgr) CreateFileShareSnapshot(body FileShareSnapshotBuilder) (*model.FileShareSnapshotSpec, error) {
+	var res model.FileShareSnapshotSpec
+	url := strings.Join([]string{
+		f.Endpoint,
+		urls.GenerateFileShareSnapshotURL(urls.Client, f.TenantId)}, "/")
+
+	if err := f.Recv(url, "POST", body, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// GetFileShareSnapshot
+func (f *FileShareMgr) GetFileShareSnapshot(snpID string) (*model.FileShareSnapshotSpec, error) {
+	var res model.FileShareSnapshotSpec
+	url := strings.Join([]string{
+		f.Endpoint,
+		urls.GenerateFileShareSnapshotURL(urls.Client, f.TenantId, snpID)}, "/")
+
+	if err := f.Recv(url, "GET", nil, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// ListFileShareSnapshots
+func (f *FileShareMgr) ListFileShareSnapshots(args ...interface{}) ([]*model.FileShareSnapshotSpec, error) {
+	url := strings.Join([]string{
+		f.Endpoint,
+		urls.GenerateFileShareSnapshotURL(urls.Client, f.TenantId)}, "/")
+
+	param, err := processListParam(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if param != "" {
+		url += "?" + param
+	}
+
+	var res []*model.FileShareSnapshotSpec
+	if err := f.Recv(url, "GET", nil, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// DeleteFileShareSnapshot
+func (f *FileShareMgr) DeleteFileShareSnapshot(snpID string) error {
+	url := strings.Join([]string{
+		f.Endpoint,
+		urls.GenerateFileShareSnapshotURL(urls.Client, f.TenantId, snpID)}, "/")
+
+	return f.Recv(url, "DELETE", nil, nil)
+}
+
+// UpdateFileShareSnapshot
+func (f *FileShareMgr) UpdateFileShareSnapshot(snpID string, body FileShareSnapshotBuilder) (*model.FileShareSnapshotSpec, error) {
+	var res model.FileShareSnapshotSpec
+	url := strings.Join([]string{
+		f.Endpoint,
+		urls.GenerateFileShareSnapshotURL(urls.Client, f.TenantId, snpID)}, "/")
+
+	if err := f.Recv(url, "PUT", body, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// CreateFileShareAcl
+func (f *FileShareMgr) CreateFileShareAcl(body FileShareAclBuilder) (*model.FileShareAclSpec, error) {
+	var res model.FileShareAclSpec
+	url := strings.Join([]string{
+		f.Endpoint,
+		urls.GenerateFileShareAclURL(urls.Client, f.TenantId)}, "/")
+
+	if err := f.Recv(url, "POST", body, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// GetFileShareAcl
+func (f *FileShareMgr) GetFileShareAcl(aclID string) (*model.FileShareAclSpec, error) {
+	var res model.FileShareAclSpec
+	url := strings.Join([]string{
+		f.Endpoint,
+		urls.GenerateFileShareAclURL(urls.Client, f.TenantId, aclID)}, "/")
+
+	if err := f.Recv(url, "GET", nil, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// ListFileSharesAcl
+func (f *FileShareMgr) ListFileSharesAcl(args ...interface{}) ([]*model.FileShareAclSpec, error) {
+	url := strings.Join([]string{
+		f.Endpoint,
+		urls.GenerateFileShareAclURL(urls.Client, f.TenantId)}, "/")
+
+	param, err := processListParam(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if param != "" {
+		url += "?" + param
+	}
+
+	var res []*model.FileShareAclSpec
+	if err := f.Recv(url, "GET", nil, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// DeleteFileShareAcl
+func (f *FileShareMgr) DeleteFileShareAcl(aclID string) error {
+	url := strings.Join([]string{
+		f.Endpoint,
+		urls.GenerateFileShareAclURL(urls.Client, f.TenantId, aclID)}, "/")
+
+	return f.Recv(url, "DELETE", nil, nil)
+}
@@ -0,0 +1,186 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/opensds/opensds/pkg/model"
+	. "github.com/opensds/opensds/testutils/collection"
+)
+
+var ff = &FileShareMgr{
+	Receiver: NewFakeFileShareReceiver(),
+}
+
+func TestCreateFileShare(t *testing.T) {
+	var expected model.FileShareSpec
+	json.Unmarshal([]byte(ByteFileShare), &expected)
+
+	fshare, err := ff.CreateFileShare(&model.FileShareSpec{Name: "sample-fileshare", Size: 1})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if !reflect.DeepEqual(fshare, &expected) {
+		t.Errorf("Expected %v, got %v", &expected, fshare)
+		return
+	}
+}
+
+func TestGetFileShare(t *testing.T) {
+	var expected model.FileShareSpec
+	json.Unmarshal([]byte(ByteFileShare), &expected)
+
+	fshare, err := ff.GetFileShare("d2975ebe-d82c-430f-b28e-f373746a71ca")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if !reflect.DeepEqual(fshare, &expected) {
+		t.Errorf("Expected %v, got %v", &expected, fshare)
+		return
+	}
+}
+
+func TestListFileShares(t *testing.T) {
+	var expected []*model.FileShareSpec
+	json.Unmarshal([]byte(ByteFileShares), &expected)
+
+	fshares, err := ff.ListFileShares(map[string]string{"limit": "3", "offset": "4"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if !reflect.DeepEqual(fshares, expected) {
+		t.Errorf("Expected %v, got %v", expected, fshares)
+		return
+	}
+}
+
+func TestUpdateFileShare(t *testing.T) {
+	var expected model.FileShareSpec
+	json.Unmarshal([]byte(ByteFileShare), &expected)
+
+	fshare, err := ff.UpdateFileShare("d2975ebe-d82c-430f-b28e-f373746a71ca", &model.FileShareSpec{
+		Description: "This is a sample fileshare for testing",
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if !reflect.DeepEqual(fshare, &expected) {
+		t.Errorf("Expected %v, got %v", &expected, fshare)
+		return
+	}
+}
+
+func TestDeleteFileShare(t *testing.T) {
+	if err := ff.DeleteFileShare("d2975ebe-d82c-430f-b28e-f373746a71ca"); err != nil {
+		t.Error(err)
+		return
+	}
+}
+
+func TestCreateFileShareSnapshot(t *testing.T) {
+	var expected model.FileShareSnapshotSpec
+	json.Unmarshal([]byte(ByteFileShareSnapshot), &expected)
+
+	snp, err := ff.CreateFileShareSnapshot(&model.FileShareSnapshotSpec{
+		FileShareId: "d2975ebe-d82c-430f-b28e-f373746a71ca",
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if !reflect.DeepEqual(snp, &expected) {
+		t.Errorf("Expected %v, got %v", &expected, snp)
+		return
+	}
+}
+
+func TestListFileShareSnapshots(t *testing.T) {
+	var expected []*model.FileShareSnapshotSpec
+	json.Unmarshal([]byte(ByteFileShareSnapshots), &expected)
+
+	snps, err := ff.ListFileShareSnapshots()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if !reflect.DeepEqual(snps, expected) {
+		t.Errorf("Expected %v, got %v", expected, snps)
+		return
+	}
+}
+
+func TestDeleteFileShareSnapshot(t *testing.T) {
+	if err := ff.DeleteFileShareSnapshot("3769855c-a102-11e7-b772-17b880d2f537"); err != nil {
+		t.Error(err)
+		return
+	}
+}
+
+func TestCreateFileShareAcl(t *testing.T) {
+	var expected model.FileShareAclSpec
+	json.Unmarshal([]byte(ByteFileShareAcl), &expected)
+
+	acl, err := ff.CreateFileShareAcl(&model.FileShareAclSpec{
+		FileShareId:      "d2975ebe-d82c-430f-b28e-f373746a71ca",
+		Type:             "ip",
+		AccessTo:         []string{"10.0.0.0/24"},
+		AccessCapability: []string{"Read", "Write"},
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if !reflect.DeepEqual(acl, &expected) {
+		t.Errorf("Expected %v, got %v", &expected, acl)
+		return
+	}
+}
+
+func TestListFileSharesAcl(t *testing.T) {
+	var expected []*model.FileShareAclSpec
+	json.Unmarshal([]byte(ByteFileShareAcls), &expected)
+
+	acls, err := ff.ListFileSharesAcl()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if !reflect.DeepEqual(acls, expected) {
+		t.Errorf("Expected %v, got %v", expected, acls)
+		return
+	}
+}
+
+func TestDeleteFileShareAcl(t *testing.T) {
+	if err := ff.DeleteFileShareAcl("d2975ebe-d82c-430f-b28e-f373746a71ca"); err != nil {
+		t.Error(err)
+		return
+	}
+}
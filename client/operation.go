@@ -0,0 +1,101 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/opensds/opensds/pkg/model"
+	"github.com/opensds/opensds/pkg/utils/urls"
+)
+
+// NewOperationMgr
+func NewOperationMgr(r Receiver, edp string, tenantId string) *OperationMgr {
+	return &OperationMgr{
+		Receiver: r,
+		Endpoint: edp,
+		TenantId: tenantId,
+	}
+}
+
+// OperationMgr
+type OperationMgr struct {
+	Receiver
+	Endpoint string
+	TenantId string
+}
+
+// GetOperation
+func (o *OperationMgr) GetOperation(opId string) (*model.OperationSpec, error) {
+	var res model.OperationSpec
+	url := strings.Join([]string{
+		o.Endpoint,
+		urls.GenerateOperationURL(urls.Client, o.TenantId, opId)}, "/")
+
+	if err := o.Recv(url, "GET", nil, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// ListOperations
+func (o *OperationMgr) ListOperations(args ...interface{}) ([]*model.OperationSpec, error) {
+	var res []*model.OperationSpec
+
+	url := strings.Join([]string{
+		o.Endpoint,
+		urls.GenerateOperationURL(urls.Client, o.TenantId)}, "/")
+
+	param, err := processListParam(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if param != "" {
+		url += "?" + param
+	}
+
+	if err := o.Recv(url, "GET", nil, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// WaitOperation polls the operation specified by opId every interval until
+// it is finished or the timeout expires. An error is returned if the
+// operation failed or didn't finish in time.
+func (o *OperationMgr) WaitOperation(opId string, interval, timeout time.Duration) (*model.OperationSpec, error) {
+	deadline := time.Now().Add(timeout)
+	for {
+		op, err := o.GetOperation(opId)
+		if err != nil {
+			return nil, err
+		}
+		if op.IsFinished() {
+			if op.Status == model.OperationFailed {
+				return op, fmt.Errorf("operation %s failed: %s", opId, op.ErrorMessage)
+			}
+			return op, nil
+		}
+		if time.Now().After(deadline) {
+			return op, fmt.Errorf("timed out waiting for operation %s, current status is %s", opId, op.Status)
+		}
+		time.Sleep(interval)
+	}
+}
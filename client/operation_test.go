@@ -0,0 +1,75 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/opensds/opensds/pkg/model"
+	. "github.com/opensds/opensds/testutils/collection"
+)
+
+var fo = &OperationMgr{
+	Receiver: NewFakeOperationReceiver(),
+}
+
+func TestGetOperation(t *testing.T) {
+	var opID = "8b3ac17a-4c4f-11e9-9d5b-7b2ad6b2b1ea"
+	expected := &SampleOperations[0]
+
+	op, err := fo.GetOperation(opID)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if !reflect.DeepEqual(op, expected) {
+		t.Errorf("expected %v, got %v", expected, op)
+		return
+	}
+}
+
+func TestListOperations(t *testing.T) {
+	var expected []*model.OperationSpec
+	expected = append(expected, &SampleOperations[0])
+	expected = append(expected, &SampleOperations[1])
+
+	ops, err := fo.ListOperations(map[string]string{"resourceId": "bd5b12a8-a101-11e7-941e-d77981b584d8"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if !reflect.DeepEqual(ops, expected) {
+		t.Errorf("expected %v, got %v", expected, ops)
+		return
+	}
+}
+
+func TestWaitOperation(t *testing.T) {
+	var opID = "8b3ac17a-4c4f-11e9-9d5b-7b2ad6b2b1ea"
+
+	op, err := fo.WaitOperation(opID, time.Millisecond, time.Second)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if op.Status != model.OperationSucceeded {
+		t.Errorf("expected %s, got %s", model.OperationSucceeded, op.Status)
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/opensds/opensds/pkg/model"
+	. "github.com/opensds/opensds/testutils/collection"
+)
+
+var fq = &QuotaMgr{
+	Receiver: NewFakeQuotaReceiver(),
+}
+
+func TestGetQuota(t *testing.T) {
+	var expected model.QuotaSpec
+	json.Unmarshal([]byte(ByteQuota), &expected)
+
+	quota, err := fq.GetQuota("e93b4c0934da416eb9c8d120c5d04d96")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if !reflect.DeepEqual(quota, &expected) {
+		t.Errorf("expected %v, got %v", &expected, quota)
+		return
+	}
+}
+
+func TestUpdateQuota(t *testing.T) {
+	body := &model.QuotaSpec{
+		QuotaSet: model.QuotaSet{Volumes: 10, Snapshots: 20, Capacity: 1000, FileShares: -1},
+	}
+
+	quota, err := fq.UpdateQuota("e93b4c0934da416eb9c8d120c5d04d96", body)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if quota.QuotaSet != body.QuotaSet {
+		t.Errorf("expected %v, got %v", body.QuotaSet, quota.QuotaSet)
+		return
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"crypto/x509"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
 	"net/http"
@@ -149,8 +150,49 @@ func request(urlStr string, method string, headers HeaderOption, input interface
 	return nil
 }
 
+// Streamer is implemented by the receivers which can open a long-lived
+// response, such as the stream of the watch events.
+type Streamer interface {
+	Stream(url string) (io.ReadCloser, error)
+}
+
+// stream sends a GET request without timeout, the caller should close the
+// body returned when it's done with the stream.
+func stream(urlStr string, headers HeaderOption) (io.ReadCloser, error) {
+	req, err := http.NewRequest("GET", urlStr, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	cli := &http.Client{}
+	u, _ := url.Parse(urlStr)
+	if u.Scheme == "https" && cacert != "" {
+		cli.Transport = &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true, VerifyPeerCertificate: customVerify},
+		}
+	}
+	resp, err := cli.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if 400 <= resp.StatusCode && resp.StatusCode <= 599 {
+		defer resp.Body.Close()
+		rbody, _ := ioutil.ReadAll(resp.Body)
+		return nil, NewHttpError(resp.StatusCode, string(rbody))
+	}
+	return resp.Body, nil
+}
+
 type receiver struct{}
 
+func (*receiver) Stream(url string) (io.ReadCloser, error) {
+	return stream(url, nil)
+}
+
 func (*receiver) Recv(url string, method string, input interface{}, output interface{}) error {
 	return request(url, method, nil, input, output)
 }
@@ -223,6 +265,28 @@ func (k *KeystoneReceiver) Recv(url string, method string, body interface{}, out
 	})
 }
 
+func (k *KeystoneReceiver) Stream(url string) (io.ReadCloser, error) {
+	var body io.ReadCloser
+	desc := fmt.Sprintf("GET %s", url)
+	err := utils.Retry(2, desc, true, func(retryIdx int, lastErr error) error {
+		if retryIdx > 0 {
+			err, ok := lastErr.(*HttpError)
+			if ok && err.Code == http.StatusUnauthorized {
+				k.GetToken()
+			} else {
+				return lastErr
+			}
+		}
+
+		headers := HeaderOption{}
+		headers[constants.AuthTokenHeader] = k.Auth.TokenID
+		var err error
+		body, err = stream(url, headers)
+		return err
+	})
+	return body, err
+}
+
 func checkHTTPResponseStatusCode(resp *http.Response) error {
 	if 400 <= resp.StatusCode && resp.StatusCode <= 599 {
 		return fmt.Errorf("response == %d, %s", resp.StatusCode, http.StatusText(resp.StatusCode))
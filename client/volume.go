@@ -31,6 +31,26 @@ type VolumeBuilder *model.VolumeSpec
 // could be discussed if it's better to define an interface.
 type ExtendVolumeBuilder *model.ExtendVolumeSpec
 
+// RetypeVolumeBuilder contains request body of handling a retype volume request.
+// Currently it's assigned as the pointer of RetypeVolumeSpec struct, but it
+// could be discussed if it's better to define an interface.
+type RetypeVolumeBuilder *model.RetypeVolumeSpec
+
+// MigrateVolumeBuilder contains request body of handling a migrate volume
+// request. Currently it's assigned as the pointer of MigrateVolumeSpec struct,
+// but it could be discussed if it's better to define an interface.
+type MigrateVolumeBuilder *model.MigrateVolumeSpec
+
+// RevertVolumeBuilder contains request body of handling a revert volume
+// request. Currently it's assigned as the pointer of RevertVolumeSpec struct,
+// but it could be discussed if it's better to define an interface.
+type RevertVolumeBuilder *model.RevertVolumeSpec
+
+// ManageVolumeBuilder contains request body of handling a manage volume
+// request. Currently it's assigned as the pointer of ManageVolumeSpec struct,
+// but it could be discussed if it's better to define an interface.
+type ManageVolumeBuilder *model.ManageVolumeSpec
+
 // VolumeAttachmentBuilder contains request body of handling a volume request.
 // Currently it's assigned as the pointer of VolumeSpec struct, but it
 // could be discussed if it's better to define an interface.
@@ -41,11 +61,23 @@ type VolumeAttachmentBuilder *model.VolumeAttachmentSpec
 // struct, but it could be discussed if it's better to define an interface.
 type VolumeSnapshotBuilder *model.VolumeSnapshotSpec
 
+// ManageVolumeSnapshotBuilder contains request body of handling a manage
+// volume snapshot request. Currently it's assigned as the pointer of
+// ManageVolumeSnapshotSpec struct, but it could be discussed if it's better
+// to define an interface.
+type ManageVolumeSnapshotBuilder *model.ManageVolumeSnapshotSpec
+
 // VolumeGroupBuilder contains request body of handling a volume group
 // request. Currently it's assigned as the pointer of VolumeGroupSpec
 // struct, but it could be discussed if it's better to define an interface.
 type VolumeGroupBuilder *model.VolumeGroupSpec
 
+// VolumeGroupSnapshotBuilder contains request body of handling a volume group
+// snapshot request. Currently it's assigned as the pointer of
+// VolumeGroupSnapshotSpec struct, but it could be discussed if it's better to
+// define an interface.
+type VolumeGroupSnapshotBuilder *model.VolumeGroupSnapshotSpec
+
 // NewVolumeMgr
 func NewVolumeMgr(r Receiver, edp string, tenantId string) *VolumeMgr {
 	return &VolumeMgr{
@@ -149,6 +181,71 @@ func (v *VolumeMgr) ExtendVolume(volID string, body ExtendVolumeBuilder) (*model
 	return &res, nil
 }
 
+// RetypeVolume ...
+func (v *VolumeMgr) RetypeVolume(volID string, body RetypeVolumeBuilder) (*model.VolumeSpec, error) {
+	var res model.VolumeSpec
+	url := strings.Join([]string{
+		v.Endpoint,
+		urls.GenerateVolumeURL(urls.Client, v.TenantId, volID, "retype")}, "/")
+
+	if err := v.Recv(url, "POST", body, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// MigrateVolume ...
+func (v *VolumeMgr) MigrateVolume(volID string, body MigrateVolumeBuilder) (*model.VolumeSpec, error) {
+	var res model.VolumeSpec
+	url := strings.Join([]string{
+		v.Endpoint,
+		urls.GenerateVolumeURL(urls.Client, v.TenantId, volID, "migrate")}, "/")
+
+	if err := v.Recv(url, "POST", body, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// RevertVolume ...
+func (v *VolumeMgr) RevertVolume(volID string, body RevertVolumeBuilder) (*model.VolumeSpec, error) {
+	var res model.VolumeSpec
+	url := strings.Join([]string{
+		v.Endpoint,
+		urls.GenerateVolumeURL(urls.Client, v.TenantId, volID, "revert")}, "/")
+
+	if err := v.Recv(url, "POST", body, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// ManageVolume ...
+func (v *VolumeMgr) ManageVolume(body ManageVolumeBuilder) (*model.VolumeSpec, error) {
+	var res model.VolumeSpec
+	url := strings.Join([]string{
+		v.Endpoint,
+		urls.GenerateVolumeURL(urls.Client, v.TenantId, "manage")}, "/")
+
+	if err := v.Recv(url, "POST", body, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// UnmanageVolume ...
+func (v *VolumeMgr) UnmanageVolume(volID string) error {
+	url := strings.Join([]string{
+		v.Endpoint,
+		urls.GenerateVolumeURL(urls.Client, v.TenantId, volID, "unmanage")}, "/")
+
+	return v.Recv(url, "POST", nil, nil)
+}
+
 // CreateVolumeAttachment
 func (v *VolumeMgr) CreateVolumeAttachment(body VolumeAttachmentBuilder) (*model.VolumeAttachmentSpec, error) {
 	var res model.VolumeAttachmentSpec
@@ -296,6 +393,29 @@ func (v *VolumeMgr) UpdateVolumeSnapshot(snpID string, body VolumeSnapshotBuilde
 	return &res, nil
 }
 
+// ManageVolumeSnapshot
+func (v *VolumeMgr) ManageVolumeSnapshot(body ManageVolumeSnapshotBuilder) (*model.VolumeSnapshotSpec, error) {
+	var res model.VolumeSnapshotSpec
+	url := strings.Join([]string{
+		v.Endpoint,
+		urls.GenerateSnapshotURL(urls.Client, v.TenantId, "manage")}, "/")
+
+	if err := v.Recv(url, "POST", body, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// UnmanageVolumeSnapshot
+func (v *VolumeMgr) UnmanageVolumeSnapshot(snpID string) error {
+	url := strings.Join([]string{
+		v.Endpoint,
+		urls.GenerateSnapshotURL(urls.Client, v.TenantId, snpID, "unmanage")}, "/")
+
+	return v.Recv(url, "POST", nil, nil)
+}
+
 // CreateVolumeGroup
 func (v *VolumeMgr) CreateVolumeGroup(body VolumeGroupBuilder) (*model.VolumeGroupSpec, error) {
 	var res model.VolumeGroupSpec
@@ -369,3 +489,54 @@ func (v *VolumeMgr) UpdateVolumeGroup(vgId string, body VolumeGroupBuilder) (*mo
 
 	return &res, nil
 }
+
+// CreateGroupSnapshot
+func (v *VolumeMgr) CreateGroupSnapshot(vgId string, body VolumeGroupSnapshotBuilder) (*model.VolumeGroupSnapshotSpec, error) {
+	var res model.VolumeGroupSnapshotSpec
+	url := strings.Join([]string{
+		v.Endpoint,
+		urls.GenerateVolumeGroupURL(urls.Client, v.TenantId, vgId, "snapshots")}, "/")
+
+	if err := v.Recv(url, "POST", body, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// GetGroupSnapshot
+func (v *VolumeMgr) GetGroupSnapshot(vgId, gsId string) (*model.VolumeGroupSnapshotSpec, error) {
+	var res model.VolumeGroupSnapshotSpec
+	url := strings.Join([]string{
+		v.Endpoint,
+		urls.GenerateVolumeGroupURL(urls.Client, v.TenantId, vgId, "snapshots", gsId)}, "/")
+
+	if err := v.Recv(url, "GET", nil, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// ListGroupSnapshots
+func (v *VolumeMgr) ListGroupSnapshots(vgId string) ([]*model.VolumeGroupSnapshotSpec, error) {
+	var res []*model.VolumeGroupSnapshotSpec
+	url := strings.Join([]string{
+		v.Endpoint,
+		urls.GenerateVolumeGroupURL(urls.Client, v.TenantId, vgId, "snapshots")}, "/")
+
+	if err := v.Recv(url, "GET", nil, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// DeleteGroupSnapshot
+func (v *VolumeMgr) DeleteGroupSnapshot(vgId, gsId string) error {
+	url := strings.Join([]string{
+		v.Endpoint,
+		urls.GenerateVolumeGroupURL(urls.Client, v.TenantId, vgId, "snapshots", gsId)}, "/")
+
+	return v.Recv(url, "DELETE", nil, nil)
+}
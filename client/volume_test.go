@@ -173,6 +173,135 @@ func TestExtendVolume(t *testing.T) {
 	}
 }
 
+func TestRetypeVolume(t *testing.T) {
+	var volID = "bd5b12a8-a101-11e7-941e-d77981b584d8"
+	body := model.RetypeVolumeSpec{
+		ProfileId: "2f9c0a04-66ef-11e7-ade2-43158893e017",
+	}
+
+	result, err := fv.RetypeVolume(volID, &body)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	expected := &model.VolumeSpec{
+		BaseModel: &model.BaseModel{
+			Id: "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		},
+		Name:        "sample-volume",
+		Description: "This is a sample volume for testing",
+		Size:        int64(1),
+		Status:      "available",
+		PoolId:      "084bf71e-a102-11e7-88a8-e31fe6d52248",
+		ProfileId:   "1106b972-66ef-11e7-b172-db03f3689c9c",
+	}
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+		return
+	}
+}
+
+func TestMigrateVolume(t *testing.T) {
+	var volID = "bd5b12a8-a101-11e7-941e-d77981b584d8"
+	body := model.MigrateVolumeSpec{
+		PoolId: "a594b8ac-a103-11e7-985f-d723bcf01b5f",
+	}
+
+	result, err := fv.MigrateVolume(volID, &body)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	expected := &model.VolumeSpec{
+		BaseModel: &model.BaseModel{
+			Id: "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		},
+		Name:        "sample-volume",
+		Description: "This is a sample volume for testing",
+		Size:        int64(1),
+		Status:      "available",
+		PoolId:      "084bf71e-a102-11e7-88a8-e31fe6d52248",
+		ProfileId:   "1106b972-66ef-11e7-b172-db03f3689c9c",
+	}
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+		return
+	}
+}
+
+func TestRevertVolume(t *testing.T) {
+	var volID = "bd5b12a8-a101-11e7-941e-d77981b584d8"
+	body := model.RevertVolumeSpec{
+		SnapshotId: "3769855c-a102-11e7-b772-17b880d2f537",
+	}
+
+	result, err := fv.RevertVolume(volID, &body)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	expected := &model.VolumeSpec{
+		BaseModel: &model.BaseModel{
+			Id: "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		},
+		Name:        "sample-volume",
+		Description: "This is a sample volume for testing",
+		Size:        int64(1),
+		Status:      "available",
+		PoolId:      "084bf71e-a102-11e7-88a8-e31fe6d52248",
+		ProfileId:   "1106b972-66ef-11e7-b172-db03f3689c9c",
+	}
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+		return
+	}
+}
+
+func TestManageVolume(t *testing.T) {
+	body := model.ManageVolumeSpec{
+		Identifier: "vol01",
+		PoolId:     "084bf71e-a102-11e7-88a8-e31fe6d52248",
+	}
+
+	result, err := fv.ManageVolume(&body)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	expected := &model.VolumeSpec{
+		BaseModel: &model.BaseModel{
+			Id: "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		},
+		Name:        "sample-volume",
+		Description: "This is a sample volume for testing",
+		Size:        int64(1),
+		Status:      "available",
+		PoolId:      "084bf71e-a102-11e7-88a8-e31fe6d52248",
+		ProfileId:   "1106b972-66ef-11e7-b172-db03f3689c9c",
+	}
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+		return
+	}
+}
+
+func TestUnmanageVolume(t *testing.T) {
+	var volID = "bd5b12a8-a101-11e7-941e-d77981b584d8"
+
+	if err := fv.UnmanageVolume(volID); err != nil {
+		t.Error(err)
+		return
+	}
+}
+
 func TestCreateVolumeAttachment(t *testing.T) {
 	var volID = "bd5b12a8-a101-11e7-941e-d77981b584d8"
 	expected := &model.VolumeAttachmentSpec{
@@ -450,6 +579,43 @@ func TestUpdateVolumeSnapshot(t *testing.T) {
 	}
 }
 
+func TestManageVolumeSnapshot(t *testing.T) {
+	expected := &model.VolumeSnapshotSpec{
+		BaseModel: &model.BaseModel{
+			Id: "3769855c-a102-11e7-b772-17b880d2f537",
+		},
+		Name:        "sample-snapshot-01",
+		Description: "This is the first sample snapshot for testing",
+		Size:        int64(1),
+		Status:      "available",
+		VolumeId:    "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		ProfileId:   "1106b972-66ef-11e7-b172-db03f3689c9c",
+	}
+
+	snp, err := fv.ManageVolumeSnapshot(&model.ManageVolumeSnapshotSpec{
+		Identifier: "snap01",
+		VolumeId:   "bd5b12a8-a101-11e7-941e-d77981b584d8",
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if !reflect.DeepEqual(snp, expected) {
+		t.Errorf("Expected %v, got %v", expected, snp)
+		return
+	}
+}
+
+func TestUnmanageVolumeSnapshot(t *testing.T) {
+	var snpID = "3769855c-a102-11e7-b772-17b880d2f537"
+
+	if err := fv.UnmanageVolumeSnapshot(snpID); err != nil {
+		t.Error(err)
+		return
+	}
+}
+
 func TestCreateVolumeGroup(t *testing.T) {
 	expected := &model.VolumeGroupSpec{
 		BaseModel: &model.BaseModel{
@@ -557,3 +723,67 @@ func TestUpdateVolumeGroup(t *testing.T) {
 		return
 	}
 }
+
+func sampleGroupSnapshot() *model.VolumeGroupSnapshotSpec {
+	return &model.VolumeGroupSnapshotSpec{
+		BaseModel: &model.BaseModel{
+			Id: "9c8a3d2e-7f41-11e9-a6b2-3f5e8c1d4b90",
+		},
+		Name:        "sample-group-snapshot-01",
+		Description: "This is the first sample group snapshot for testing",
+		Status:      "available",
+		GroupId:     "3769855c-a102-11e7-b772-17b880d2f555",
+		Snapshots:   []string{"3769855c-a102-11e7-b772-17b880d2f537"},
+	}
+}
+
+func TestCreateGroupSnapshot(t *testing.T) {
+	var vgId = "3769855c-a102-11e7-b772-17b880d2f555"
+	gs, err := fv.CreateGroupSnapshot(vgId, &model.VolumeGroupSnapshotSpec{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if !reflect.DeepEqual(gs, sampleGroupSnapshot()) {
+		t.Errorf("Expected %v, got %v", sampleGroupSnapshot(), gs)
+		return
+	}
+}
+
+func TestGetGroupSnapshot(t *testing.T) {
+	var vgId, gsId = "3769855c-a102-11e7-b772-17b880d2f555", "9c8a3d2e-7f41-11e9-a6b2-3f5e8c1d4b90"
+	gs, err := fv.GetGroupSnapshot(vgId, gsId)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if !reflect.DeepEqual(gs, sampleGroupSnapshot()) {
+		t.Errorf("Expected %v, got %v", sampleGroupSnapshot(), gs)
+		return
+	}
+}
+
+func TestListGroupSnapshots(t *testing.T) {
+	var vgId = "3769855c-a102-11e7-b772-17b880d2f555"
+	gss, err := fv.ListGroupSnapshots(vgId)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	expected := []*model.VolumeGroupSnapshotSpec{sampleGroupSnapshot()}
+	if !reflect.DeepEqual(gss, expected) {
+		t.Errorf("Expected %v, got %v", expected, gss)
+		return
+	}
+}
+
+func TestDeleteGroupSnapshot(t *testing.T) {
+	var vgId, gsId = "3769855c-a102-11e7-b772-17b880d2f555", "9c8a3d2e-7f41-11e9-a6b2-3f5e8c1d4b90"
+	if err := fv.DeleteGroupSnapshot(vgId, gsId); err != nil {
+		t.Error(err)
+		return
+	}
+}
@@ -0,0 +1,224 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/opensds/opensds/pkg/model"
+	"github.com/opensds/opensds/pkg/utils/urls"
+)
+
+// watchRetryInterval is how long the watcher waits before it resumes a
+// watch whose stream is broken unexpectedly.
+var watchRetryInterval = time.Second
+
+// NewWatchMgr
+func NewWatchMgr(r Receiver, edp string, tenantId string) *WatchMgr {
+	return &WatchMgr{
+		Receiver: r,
+		Endpoint: edp,
+		TenantId: tenantId,
+	}
+}
+
+// WatchMgr
+type WatchMgr struct {
+	Receiver
+	Endpoint string
+	TenantId string
+}
+
+// Watch streams the changes of the resources, which is one of volumes,
+// snapshots, attachments, replications and pools, after the resourceVersion.
+// The resourceVersion is usually the largest revision of the resources
+// listed, zero means watching the changes from now on. The stream ended by
+// the server is resumed from the last event got, until the watcher is
+// stopped or an ERROR event is got.
+func (w *WatchMgr) Watch(resource string, resourceVersion int64) (*Watcher, error) {
+	s, ok := w.Receiver.(Streamer)
+	if !ok {
+		return nil, fmt.Errorf("the receiver doesn't support watch")
+	}
+
+	watcher := &Watcher{
+		streamer: s,
+		url: strings.Join([]string{
+			w.Endpoint,
+			urls.GenerateEventURL(urls.Client, w.TenantId)}, "/"),
+		resource: resource,
+		rev:      resourceVersion,
+		result:   make(chan *model.WatchEvent),
+		stop:     make(chan struct{}),
+	}
+	// The first stream is opened here so that a request rejected, such as
+	// the resource can't be watched, is returned to the caller.
+	body, err := watcher.open()
+	if err != nil {
+		return nil, err
+	}
+	go watcher.run(body)
+	return watcher, nil
+}
+
+// Watcher receives the watch events of the resources.
+type Watcher struct {
+	streamer Streamer
+	url      string
+	resource string
+	rev      int64
+	result   chan *model.WatchEvent
+
+	lock    sync.Mutex
+	stop    chan struct{}
+	stopped bool
+	body    io.ReadCloser
+}
+
+// ResultChan returns the channel of the watch events, which is closed when
+// the watch stops.
+func (w *Watcher) ResultChan() <-chan *model.WatchEvent {
+	return w.result
+}
+
+// Stop stops the watch, it's safe to be called more than once.
+func (w *Watcher) Stop() {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if w.stopped {
+		return
+	}
+	w.stopped = true
+	close(w.stop)
+	if w.body != nil {
+		w.body.Close()
+	}
+}
+
+func (w *Watcher) open() (io.ReadCloser, error) {
+	q := url.Values{}
+	q.Set("resource", w.resource)
+	if w.rev > 0 {
+		q.Set("resourceVersion", strconv.FormatInt(w.rev, 10))
+	}
+	body, err := w.streamer.Stream(w.url + "?" + q.Encode())
+	if err != nil {
+		return nil, err
+	}
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if w.stopped {
+		body.Close()
+		return nil, fmt.Errorf("watcher is stopped")
+	}
+	w.body = body
+	return body, nil
+}
+
+func (w *Watcher) run(body io.ReadCloser) {
+	defer close(w.result)
+	for {
+		done := w.consume(body)
+		body.Close()
+		if done {
+			return
+		}
+
+		var err error
+		for {
+			select {
+			case <-w.stop:
+				return
+			case <-time.After(watchRetryInterval):
+			}
+			if body, err = w.open(); err == nil {
+				break
+			}
+			log.Printf("resume watching %s from %d failed: %v", w.resource, w.rev, err)
+			// The request rejected can't be resumed any more.
+			if _, ok := err.(*HttpError); ok {
+				w.send(&model.WatchEvent{Type: model.WatchError, ErrorMessage: err.Error()})
+				return
+			}
+		}
+	}
+}
+
+// consume sends the events in the stream to the result channel, it returns
+// true if the watch is done.
+func (w *Watcher) consume(body io.Reader) bool {
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	var data bytes.Buffer
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case line == "":
+			if data.Len() == 0 {
+				continue
+			}
+			e := &model.WatchEvent{}
+			if err := json.Unmarshal(data.Bytes(), e); err != nil {
+				log.Printf("failed to unmarshal watch event: %v", err)
+				data.Reset()
+				continue
+			}
+			data.Reset()
+			if e.ResourceVersion > w.rev {
+				w.rev = e.ResourceVersion
+			}
+			if !w.send(e) || e.Type == model.WatchError {
+				return true
+			}
+		case strings.HasPrefix(line, "data:"):
+			if data.Len() != 0 {
+				data.WriteByte('\n')
+			}
+			data.WriteString(strings.TrimPrefix(strings.TrimPrefix(line, "data:"), " "))
+		}
+		// The comments for keeping alive, the ids and the event types which
+		// are in the data too are skipped.
+	}
+
+	select {
+	case <-w.stop:
+		return true
+	default:
+	}
+	if err := scanner.Err(); err != nil {
+		log.Printf("watch stream of %s is broken: %v", w.resource, err)
+	}
+	return false
+}
+
+func (w *Watcher) send(e *model.WatchEvent) bool {
+	select {
+	case w.result <- e:
+		return true
+	case <-w.stop:
+		return false
+	}
+}
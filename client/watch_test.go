@@ -0,0 +1,121 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/opensds/opensds/pkg/model"
+)
+
+var fwa = &WatchMgr{
+	Receiver: NewFakeWatchReceiver(),
+}
+
+func TestWatch(t *testing.T) {
+	w, err := fwa.Watch("volumes", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Stop()
+
+	var types []string
+	for e := range w.ResultChan() {
+		types = append(types, e.Type)
+	}
+	expected := fmt.Sprint([]string{model.WatchAdded, model.WatchDeleted, model.WatchError})
+	if fmt.Sprint(types) != expected {
+		t.Errorf("expected events %s, got %v", expected, types)
+	}
+}
+
+func TestWatchResume(t *testing.T) {
+	oldInterval := watchRetryInterval
+	watchRetryInterval = time.Millisecond
+	defer func() { watchRetryInterval = oldInterval }()
+
+	var versions []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		versions = append(versions, r.URL.Query().Get("resourceVersion"))
+		w.Header().Set("Content-Type", "text/event-stream")
+		switch len(versions) {
+		case 1:
+			// The stream ended by the server is resumed from the last event.
+			fmt.Fprint(w, "id: 5\nevent: MODIFIED\ndata: {\"type\":\"MODIFIED\",\"resourceVersion\":5}\n\n")
+		default:
+			http.Error(w, `{"code":400,"message":"invalid resource version"}`, http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	w, err := NewWatchMgr(NewReceiver(), server.URL, "tenant").Watch("volumes", 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Stop()
+
+	var events []*model.WatchEvent
+	for e := range w.ResultChan() {
+		events = append(events, e)
+	}
+	if len(events) != 2 || events[0].ResourceVersion != 5 || events[1].Type != model.WatchError {
+		t.Errorf("expected a MODIFIED event then an ERROR event, got %+v", events)
+	}
+	if fmt.Sprint(versions) != "[3 5]" {
+		t.Errorf("expected watching from version 3 then 5, got %v", versions)
+	}
+}
+
+func TestWatchStop(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(http.StatusOK)
+		w.(http.Flusher).Flush()
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	w, err := NewWatchMgr(NewReceiver(), server.URL, "tenant").Watch("pools", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.Stop()
+	w.Stop()
+
+	select {
+	case _, ok := <-w.ResultChan():
+		if ok {
+			t.Error("expected no event after the watcher is stopped")
+		}
+	case <-time.After(5 * time.Second):
+		t.Error("expected the result channel to be closed after the watcher is stopped")
+	}
+}
+
+func TestWatchRejected(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"code":501,"message":"watch is not supported"}`, http.StatusNotImplemented)
+	}))
+	defer server.Close()
+
+	_, err := NewWatchMgr(NewReceiver(), server.URL, "tenant").Watch("volumes", 0)
+	if e, ok := err.(*HttpError); !ok || e.Code != http.StatusNotImplemented {
+		t.Errorf("expected the request to be rejected with 501, got %v", err)
+	}
+}
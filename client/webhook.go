@@ -0,0 +1,109 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"strings"
+
+	"github.com/opensds/opensds/pkg/model"
+	"github.com/opensds/opensds/pkg/utils/urls"
+)
+
+// WebhookBuilder contains request body of handling a webhook request.
+// Currently it's assigned as the pointer of WebhookSpec struct, but it
+// could be discussed if it's better to define an interface.
+type WebhookBuilder *model.WebhookSpec
+
+// NewWebhookMgr
+func NewWebhookMgr(r Receiver, edp string, tenantId string) *WebhookMgr {
+	return &WebhookMgr{
+		Receiver: r,
+		Endpoint: edp,
+		TenantId: tenantId,
+	}
+}
+
+// WebhookMgr
+type WebhookMgr struct {
+	Receiver
+	Endpoint string
+	TenantId string
+}
+
+// CreateWebhook subscribes the events in body, which are posted to the url
+// of the webhook.
+func (w *WebhookMgr) CreateWebhook(body WebhookBuilder) (*model.WebhookSpec, error) {
+	var res model.WebhookSpec
+	url := strings.Join([]string{
+		w.Endpoint,
+		urls.GenerateWebhookURL(urls.Client, w.TenantId)}, "/")
+
+	if err := w.Recv(url, "POST", body, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// GetWebhook
+func (w *WebhookMgr) GetWebhook(hookId string) (*model.WebhookSpec, error) {
+	var res model.WebhookSpec
+	url := strings.Join([]string{
+		w.Endpoint,
+		urls.GenerateWebhookURL(urls.Client, w.TenantId, hookId)}, "/")
+
+	if err := w.Recv(url, "GET", nil, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// ListWebhooks
+func (w *WebhookMgr) ListWebhooks() ([]*model.WebhookSpec, error) {
+	var res []*model.WebhookSpec
+	url := strings.Join([]string{
+		w.Endpoint,
+		urls.GenerateWebhookURL(urls.Client, w.TenantId)}, "/")
+
+	if err := w.Recv(url, "GET", nil, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// DeleteWebhook
+func (w *WebhookMgr) DeleteWebhook(hookId string) error {
+	url := strings.Join([]string{
+		w.Endpoint,
+		urls.GenerateWebhookURL(urls.Client, w.TenantId, hookId)}, "/")
+
+	return w.Recv(url, "DELETE", nil, nil)
+}
+
+// ListWebhookDeliveries returns the events delivered to the webhook.
+func (w *WebhookMgr) ListWebhookDeliveries(hookId string) ([]*model.WebhookDeliverySpec, error) {
+	var res []*model.WebhookDeliverySpec
+	url := strings.Join([]string{
+		w.Endpoint,
+		urls.GenerateWebhookURL(urls.Client, w.TenantId, hookId, "deliveries")}, "/")
+
+	if err := w.Recv(url, "GET", nil, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
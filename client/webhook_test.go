@@ -0,0 +1,87 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/opensds/opensds/pkg/model"
+	. "github.com/opensds/opensds/testutils/collection"
+)
+
+var fw = &WebhookMgr{
+	Receiver: NewFakeWebhookReceiver(),
+}
+
+func TestCreateWebhook(t *testing.T) {
+	var expected model.WebhookSpec
+	json.Unmarshal([]byte(ByteWebhook), &expected)
+
+	hook, err := fw.CreateWebhook(&model.WebhookSpec{
+		Name:   "volume-watcher",
+		Url:    "https://hooks.example.com/opensds",
+		Events: []string{"volume.*"},
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if !reflect.DeepEqual(hook, &expected) {
+		t.Errorf("expected %v, got %v", &expected, hook)
+		return
+	}
+}
+
+func TestListWebhooks(t *testing.T) {
+	var expected []*model.WebhookSpec
+	json.Unmarshal([]byte(ByteWebhooks), &expected)
+
+	hooks, err := fw.ListWebhooks()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if !reflect.DeepEqual(hooks, expected) {
+		t.Errorf("expected %v, got %v", expected, hooks)
+		return
+	}
+}
+
+func TestDeleteWebhook(t *testing.T) {
+	if err := fw.DeleteWebhook("4a3f2d3c-6b1e-11e9-9a4a-6f7c1f4f2b1a"); err != nil {
+		t.Error(err)
+		return
+	}
+}
+
+func TestListWebhookDeliveries(t *testing.T) {
+	var expected []*model.WebhookDeliverySpec
+	json.Unmarshal([]byte(ByteWebhookDeliveries), &expected)
+
+	dlvs, err := fw.ListWebhookDeliveries("4a3f2d3c-6b1e-11e9-9a4a-6f7c1f4f2b1a")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if !reflect.DeepEqual(dlvs, expected) {
+		t.Errorf("expected %v, got %v", expected, dlvs)
+		return
+	}
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/opensds/opensds/pkg/utils/constants"
 	"github.com/opensds/opensds/pkg/utils/daemon"
 	"github.com/opensds/opensds/pkg/utils/logs"
+	"github.com/opensds/opensds/pkg/webhook"
 )
 
 func init() {
@@ -51,6 +52,11 @@ func main() {
 	// Set up database session.
 	db.Init(&CONF.Database)
 
+	// Notify the webhooks of the status transitions written by controller.
+	notifier := webhook.NewNotifier(db.C, CONF.OsdsLet.WebhookMaxAttempts,
+		CONF.OsdsLet.WebhookRetryInterval, CONF.OsdsLet.WebhookTimeout)
+	db.C = db.WithStatusHook(db.C, notifier.Notify)
+
 	// Construct controller module grpc server struct and run controller server process.
 	if err := c.NewController(constants.OpensdsCtrBindEndpoint).Run(); err != nil {
 		panic(err)
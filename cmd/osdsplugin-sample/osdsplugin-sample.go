@@ -0,0 +1,57 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+This module implements a reference storage driver plugin which serves the
+sample driver out of the dock process, see contrib/drivers/plugin.
+
+*/
+
+package main
+
+import (
+	"flag"
+	"time"
+
+	"github.com/opensds/opensds/contrib/drivers"
+	"github.com/opensds/opensds/contrib/drivers/plugin"
+	"github.com/opensds/opensds/pkg/utils/logs"
+	sample "github.com/opensds/opensds/testutils/driver"
+)
+
+var (
+	socket            string
+	logFlushFrequency time.Duration
+)
+
+func init() {
+	flag.StringVar(&socket, "socket", "/var/run/opensds/sample-plugin.sock", "Unix socket which the plugin listens on")
+	flag.DurationVar(&logFlushFrequency, "log-flush-frequency", 5*time.Second, "Maximum number of seconds between log flushes")
+	flag.Parse()
+}
+
+func main() {
+	logs.InitLogs(logFlushFrequency)
+	defer logs.FlushLogs()
+
+	p := &plugin.Plugin{
+		Name:              "sample",
+		Capability:        drivers.Capability{Replication: true},
+		VolumeDriver:      &sample.Driver{},
+		ReplicationDriver: &sample.ReplicationDriver{},
+	}
+	if err := plugin.Serve(socket, p); err != nil {
+		panic(err)
+	}
+}
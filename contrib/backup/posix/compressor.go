@@ -0,0 +1,136 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package posix
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/opensds/opensds/pkg/utils/exec"
+)
+
+const (
+	CompressionNone = "none"
+	CompressionGzip = "gzip"
+	CompressionZstd = "zstd"
+)
+
+// compressor stores a chunk into an object file and reads it back.
+type compressor interface {
+	// Ext returns the file extension of the object.
+	Ext() string
+	Compress(data []byte, object string) error
+	Decompress(object string) ([]byte, error)
+}
+
+func newCompressor(name string) (compressor, error) {
+	switch name {
+	case "", CompressionNone:
+		return &noneCompressor{}, nil
+	case CompressionGzip:
+		return &gzipCompressor{}, nil
+	case CompressionZstd:
+		return &zstdCompressor{executer: exec.NewBaseExecuter()}, nil
+	default:
+		return nil, fmt.Errorf("unsupported compression %s", name)
+	}
+}
+
+type noneCompressor struct{}
+
+func (*noneCompressor) Ext() string { return "" }
+
+func (*noneCompressor) Compress(data []byte, object string) error {
+	return writeFileSync(object, data)
+}
+
+func (*noneCompressor) Decompress(object string) ([]byte, error) {
+	return ioutil.ReadFile(object)
+}
+
+type gzipCompressor struct{}
+
+func (*gzipCompressor) Ext() string { return ".gz" }
+
+func (*gzipCompressor) Compress(data []byte, object string) error {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(data); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return writeFileSync(object, buf.Bytes())
+}
+
+func (*gzipCompressor) Decompress(object string) ([]byte, error) {
+	f, err := os.Open(object)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return ioutil.ReadAll(r)
+}
+
+// zstdCompressor relies on the zstd command line tool, which needs to be
+// installed on the host where the dock runs.
+type zstdCompressor struct {
+	executer exec.Executer
+}
+
+func (*zstdCompressor) Ext() string { return ".zst" }
+
+func (z *zstdCompressor) Compress(data []byte, object string) error {
+	raw := object + ".raw"
+	if err := ioutil.WriteFile(raw, data, 0644); err != nil {
+		return err
+	}
+	defer os.Remove(raw)
+
+	if _, err := z.executer.Run("zstd", "-q", "-f", raw, "-o", object); err != nil {
+		return err
+	}
+	// Make sure the object is flushed to the disk.
+	f, err := os.Open(object)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return f.Sync()
+}
+
+func (z *zstdCompressor) Decompress(object string) ([]byte, error) {
+	tmp, err := ioutil.TempFile("", "opensds-backup-")
+	if err != nil {
+		return nil, err
+	}
+	tmp.Close()
+	defer os.Remove(tmp.Name())
+
+	if _, err = z.executer.Run("zstd", "-d", "-q", "-f", object, "-o", tmp.Name()); err != nil {
+		return nil, err
+	}
+	return ioutil.ReadFile(tmp.Name())
+}
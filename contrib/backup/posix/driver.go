@@ -0,0 +1,333 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+This module implements a backup driver which stores the backup data into a
+local directory, which could be a local disk or a mounted NFS share. The data
+of the volume is split into chunks, each of them is optionally compressed and
+stored as a single object file, and a manifest with the SHA-256 checksum of
+every chunk is written at last so that the backup can be verified on restoring.
+
+The layout of the backup directory looks like:
+
+	<BackupPath>/<backup id>/manifest.json
+	<BackupPath>/<backup id>/chunk-00000001.gz
+	<BackupPath>/<backup id>/chunk-00000002.gz
+	...
+
+*/
+
+package posix
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/opensds/opensds/contrib/backup"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	ConfFile          = "/etc/opensds/driver/posix.yaml"
+	DefaultBackupPath = "/var/lib/opensds/backup"
+	DefaultChunkSize  = 1024 * 1024 * 50
+	ManifestFile      = "manifest.json"
+	ManifestVersion   = "1.0"
+)
+
+func init() {
+	backup.RegisterBackupCtor("posix", NewPosix)
+}
+
+func NewPosix() (backup.BackupDriver, error) {
+	return &Posix{}, nil
+}
+
+type PosixConf struct {
+	// The directory where the backups are stored, it could be a local
+	// directory or the mount point of a NFS share.
+	BackupPath string `yaml:"BackupPath,omitempty"`
+	// The size in bytes of every chunk the volume data is split into.
+	ChunkSize int64 `yaml:"ChunkSize,omitempty"`
+	// The compression algorithm of chunks, supports none, gzip and zstd.
+	Compression string `yaml:"Compression,omitempty"`
+}
+
+// Chunk describes an object file which stores a piece of the volume data.
+type Chunk struct {
+	Index  int64  `json:"index"`
+	Object string `json:"object"`
+	Offset int64  `json:"offset"`
+	Length int64  `json:"length"`
+	// The SHA-256 checksum of the uncompressed data.
+	Sha256 string `json:"sha256"`
+}
+
+// Manifest describes how the volume data is stored in the backup directory.
+type Manifest struct {
+	Version     string   `json:"version"`
+	BackupId    string   `json:"backupId"`
+	Name        string   `json:"name,omitempty"`
+	CreatedAt   string   `json:"createdAt"`
+	ChunkSize   int64    `json:"chunkSize"`
+	Compression string   `json:"compression"`
+	Size        int64    `json:"size"`
+	Chunks      []*Chunk `json:"chunks"`
+}
+
+type Posix struct {
+	conf *PosixConf
+}
+
+func (p *Posix) loadConf(path string) (*PosixConf, error) {
+	conf := &PosixConf{
+		BackupPath:  DefaultBackupPath,
+		ChunkSize:   DefaultChunkSize,
+		Compression: CompressionGzip,
+	}
+	confYaml, err := ioutil.ReadFile(path)
+	if err != nil {
+		// The driver works with the default options if no config file is provided.
+		if os.IsNotExist(err) {
+			glog.Warningf("Config yaml file (%s) doesn't exist, use default options", path)
+			return conf, nil
+		}
+		glog.Errorf("Read config yaml file (%s) failed, reason:(%v)", path, err)
+		return nil, err
+	}
+	if err = yaml.Unmarshal(confYaml, conf); err != nil {
+		glog.Errorf("Parse error: %v", err)
+		return nil, err
+	}
+	if conf.ChunkSize <= 0 {
+		return nil, fmt.Errorf("invalid chunk size %d", conf.ChunkSize)
+	}
+	if _, err = newCompressor(conf.Compression); err != nil {
+		return nil, err
+	}
+	return conf, nil
+}
+
+func (p *Posix) SetUp() error {
+	var err error
+	if p.conf, err = p.loadConf(ConfFile); err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(p.conf.BackupPath, 0755); err != nil {
+		glog.Errorf("Create backup directory (%s) failed: %v", p.conf.BackupPath, err)
+		return err
+	}
+
+	return nil
+}
+
+func (p *Posix) CleanUp() error {
+	// Do nothing
+	return nil
+}
+
+// Backup reads the volume data chunk by chunk and stores them into a temporary
+// directory, which will be renamed to the backup directory after the manifest
+// is written, so that an incomplete backup will never be seen.
+func (p *Posix) Backup(backup *backup.BackupSpec, volFile *os.File) error {
+	dir, err := p.backupDir(backup.Id)
+	if err != nil {
+		return err
+	}
+	if _, err = os.Stat(dir); err == nil {
+		return fmt.Errorf("backup %s already exists", backup.Id)
+	}
+
+	// The compression algorithm can be specified in the metadata of backup.
+	compression := p.conf.Compression
+	if c, ok := backup.Metadata["compression"]; ok && c != "" {
+		compression = c
+	}
+	cp, err := newCompressor(compression)
+	if err != nil {
+		return err
+	}
+
+	tmpDir := dir + ".tmp"
+	os.RemoveAll(tmpDir)
+	if err = os.Mkdir(tmpDir, 0755); err != nil {
+		glog.Errorf("Create backup directory (%s) failed: %v", tmpDir, err)
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	manifest := &Manifest{
+		Version:     ManifestVersion,
+		BackupId:    backup.Id,
+		Name:        backup.Name,
+		CreatedAt:   time.Now().Format(time.RFC3339),
+		ChunkSize:   p.conf.ChunkSize,
+		Compression: compression,
+	}
+	buf := make([]byte, p.conf.ChunkSize)
+	for index := int64(1); ; index++ {
+		size, err := io.ReadFull(volFile, buf)
+		if err == io.EOF {
+			break
+		}
+		if err != nil && err != io.ErrUnexpectedEOF {
+			glog.Errorf("Read volume data failed: %v", err)
+			return err
+		}
+
+		data := buf[:size]
+		sum := sha256.Sum256(data)
+		object := fmt.Sprintf("chunk-%08d%s", index, cp.Ext())
+		if err = cp.Compress(data, filepath.Join(tmpDir, object)); err != nil {
+			glog.Errorf("Write chunk %s failed: %v", object, err)
+			return err
+		}
+		manifest.Chunks = append(manifest.Chunks, &Chunk{
+			Index:  index,
+			Object: object,
+			Offset: manifest.Size,
+			Length: int64(size),
+			Sha256: hex.EncodeToString(sum[:]),
+		})
+		manifest.Size += int64(size)
+		glog.V(5).Infof("backup chunk %s, size: %d", object, size)
+
+		if err == io.ErrUnexpectedEOF {
+			break
+		}
+	}
+
+	if err = writeManifest(filepath.Join(tmpDir, ManifestFile), manifest); err != nil {
+		glog.Errorf("Write manifest of backup %s failed: %v", backup.Id, err)
+		return err
+	}
+	if err = os.Rename(tmpDir, dir); err != nil {
+		glog.Errorf("Rename backup directory failed: %v", err)
+		return err
+	}
+	glog.Infof("backup %s success, size: %d, chunks: %d", backup.Id, manifest.Size, len(manifest.Chunks))
+	return nil
+}
+
+// Restore writes every chunk of the backup to the volume after its checksum
+// is verified.
+func (p *Posix) Restore(backup *backup.BackupSpec, backupId string, volFile *os.File) error {
+	dir, err := p.backupDir(backupId)
+	if err != nil {
+		return err
+	}
+	manifest, err := readManifest(filepath.Join(dir, ManifestFile))
+	if err != nil {
+		glog.Errorf("Read manifest of backup %s failed: %v", backupId, err)
+		return err
+	}
+	cp, err := newCompressor(manifest.Compression)
+	if err != nil {
+		return err
+	}
+
+	for _, chunk := range manifest.Chunks {
+		data, err := cp.Decompress(filepath.Join(dir, chunk.Object))
+		if err != nil {
+			glog.Errorf("Read chunk %s failed: %v", chunk.Object, err)
+			return err
+		}
+		if int64(len(data)) != chunk.Length {
+			return fmt.Errorf("length of chunk %s is %d, expected %d", chunk.Object, len(data), chunk.Length)
+		}
+		sum := sha256.Sum256(data)
+		if hex.EncodeToString(sum[:]) != chunk.Sha256 {
+			return fmt.Errorf("checksum of chunk %s mismatched", chunk.Object)
+		}
+		if _, err = volFile.WriteAt(data, chunk.Offset); err != nil {
+			glog.Errorf("Write chunk %s to volume failed: %v", chunk.Object, err)
+			return err
+		}
+		glog.V(5).Infof("restore chunk %s, size: %d", chunk.Object, len(data))
+	}
+	if err = volFile.Sync(); err != nil {
+		return err
+	}
+	glog.Infof("restore %s success ...", backupId)
+	return nil
+}
+
+func (p *Posix) Delete(backup *backup.BackupSpec) error {
+	dir, err := p.backupDir(backup.Id)
+	if err != nil {
+		return err
+	}
+	return os.RemoveAll(dir)
+}
+
+// backupDir returns the directory of the backup, the backup id is checked to
+// make sure that nothing outside the backup path could be touched.
+func (p *Posix) backupDir(backupId string) (string, error) {
+	if backupId == "" || backupId == "." || backupId == ".." ||
+		filepath.Base(backupId) != backupId {
+		return "", fmt.Errorf("invalid backup id %q", backupId)
+	}
+	return filepath.Join(p.conf.BackupPath, backupId), nil
+}
+
+func writeManifest(path string, manifest *Manifest) error {
+	body, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeFileSync(path, body)
+}
+
+func readManifest(path string) (*Manifest, error) {
+	body, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	manifest := &Manifest{}
+	if err = json.Unmarshal(body, manifest); err != nil {
+		return nil, err
+	}
+	if manifest.Version != ManifestVersion {
+		return nil, errors.New("unsupported manifest version " + manifest.Version)
+	}
+	return manifest, nil
+}
+
+// writeFileSync writes data to the file and flushes it to the disk, which is
+// necessary before the backup is reported as completed.
+func writeFileSync(path string, data []byte) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err = f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
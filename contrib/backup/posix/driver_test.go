@@ -0,0 +1,176 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package posix
+
+import (
+	"bytes"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/opensds/opensds/contrib/backup"
+)
+
+const (
+	testConfFile = "./testdata/posix.yaml"
+)
+
+func TestLoadConf(t *testing.T) {
+	p := &Posix{}
+	conf, err := p.loadConf(testConfFile)
+	if err != nil {
+		t.Errorf("load conf file failed: %v", err)
+	}
+	expect := &PosixConf{
+		BackupPath:  "/tmp/opensds/backup",
+		ChunkSize:   4096,
+		Compression: CompressionZstd,
+	}
+	if !reflect.DeepEqual(expect, conf) {
+		t.Errorf("expected %+v, got %+v", expect, conf)
+	}
+
+	conf, err = p.loadConf("./testdata/not-exist.yaml")
+	if err != nil {
+		t.Errorf("load default conf failed: %v", err)
+	}
+	expect = &PosixConf{
+		BackupPath:  DefaultBackupPath,
+		ChunkSize:   DefaultChunkSize,
+		Compression: CompressionGzip,
+	}
+	if !reflect.DeepEqual(expect, conf) {
+		t.Errorf("expected %+v, got %+v", expect, conf)
+	}
+}
+
+func newTestPosix(t *testing.T, compression string) (*Posix, func()) {
+	dir, err := ioutil.TempDir("", "posix-backup-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := &Posix{
+		conf: &PosixConf{
+			BackupPath:  dir,
+			ChunkSize:   4096,
+			Compression: compression,
+		},
+	}
+	return p, func() { os.RemoveAll(dir) }
+}
+
+func newTestVolume(t *testing.T, data []byte) *os.File {
+	f, err := ioutil.TempFile("", "posix-volume-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = f.Write(data); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = f.Seek(0, 0); err != nil {
+		t.Fatal(err)
+	}
+	return f
+}
+
+func testBackupAndRestore(t *testing.T, compression string) {
+	p, clean := newTestPosix(t, compression)
+	defer clean()
+
+	// The size of data is not aligned with the chunk size on purpose.
+	data := make([]byte, 4096*3+100)
+	rand.Read(data)
+	vol := newTestVolume(t, data)
+	defer os.Remove(vol.Name())
+	defer vol.Close()
+
+	spec := &backup.BackupSpec{Id: "f6fc4e6c-5e12-11e9-8c0d-3f2b3a1e9e01", Name: "test"}
+	if err := p.Backup(spec, vol); err != nil {
+		t.Fatalf("backup failed: %v", err)
+	}
+	manifest, err := readManifest(filepath.Join(p.conf.BackupPath, spec.Id, ManifestFile))
+	if err != nil {
+		t.Fatalf("read manifest failed: %v", err)
+	}
+	if manifest.Size != int64(len(data)) || len(manifest.Chunks) != 4 || manifest.Compression != compression {
+		t.Errorf("unexpected manifest %+v", manifest)
+	}
+
+	target := newTestVolume(t, make([]byte, len(data)))
+	defer os.Remove(target.Name())
+	defer target.Close()
+	if err = p.Restore(spec, spec.Id, target); err != nil {
+		t.Fatalf("restore failed: %v", err)
+	}
+	restored, _ := ioutil.ReadFile(target.Name())
+	if !bytes.Equal(restored, data) {
+		t.Error("restored data mismatched")
+	}
+
+	if err = p.Delete(spec); err != nil {
+		t.Errorf("delete failed: %v", err)
+	}
+	if _, err = os.Stat(filepath.Join(p.conf.BackupPath, spec.Id)); !os.IsNotExist(err) {
+		t.Error("backup directory is not deleted")
+	}
+}
+
+func TestBackupAndRestore(t *testing.T) {
+	t.Run("none", func(t *testing.T) { testBackupAndRestore(t, CompressionNone) })
+	t.Run("gzip", func(t *testing.T) { testBackupAndRestore(t, CompressionGzip) })
+	t.Run("zstd", func(t *testing.T) {
+		if _, err := exec.LookPath("zstd"); err != nil {
+			t.Skip("zstd executable not found")
+		}
+		testBackupAndRestore(t, CompressionZstd)
+	})
+}
+
+func TestRestoreChecksumMismatch(t *testing.T) {
+	p, clean := newTestPosix(t, CompressionNone)
+	defer clean()
+
+	vol := newTestVolume(t, bytes.Repeat([]byte("opensds"), 1000))
+	defer os.Remove(vol.Name())
+	defer vol.Close()
+
+	spec := &backup.BackupSpec{Id: "0c7b4c1e-5e13-11e9-a1b4-1f6a2d8e5c02"}
+	if err := p.Backup(spec, vol); err != nil {
+		t.Fatalf("backup failed: %v", err)
+	}
+	object := filepath.Join(p.conf.BackupPath, spec.Id, "chunk-00000001")
+	corrupted, _ := ioutil.ReadFile(object)
+	corrupted[0] ^= 0xff
+	ioutil.WriteFile(object, corrupted, 0644)
+
+	if err := p.Restore(spec, spec.Id, vol); err == nil {
+		t.Error("expected checksum mismatch error, got nil")
+	}
+}
+
+func TestInvalidBackupId(t *testing.T) {
+	p, clean := newTestPosix(t, CompressionNone)
+	defer clean()
+
+	for _, id := range []string{"", ".", "..", "../etc", "a/b"} {
+		if err := p.Delete(&backup.BackupSpec{Id: id}); err == nil {
+			t.Errorf("expected error for backup id %q, got nil", id)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drivers_test
+
+import (
+	"testing"
+
+	"github.com/opensds/opensds/contrib/drivers"
+	_ "github.com/opensds/opensds/contrib/drivers/ceph"
+	_ "github.com/opensds/opensds/contrib/drivers/lvm"
+	_ "github.com/opensds/opensds/contrib/drivers/openstack/cinder"
+	"github.com/opensds/opensds/contrib/drivers/utils/config"
+)
+
+func TestVolumeGroupCapability(t *testing.T) {
+	for _, dType := range []string{config.LVMDriverType, config.CephDriverType, config.CinderDriverType} {
+		c, err := drivers.GetCapability(dType)
+		if err != nil {
+			t.Errorf("Failed to get capability of driver %s: %v\n", dType, err)
+			continue
+		}
+		if !c.VolumeGroup {
+			t.Errorf("Expected driver %s to support volume group\n", dType)
+		}
+	}
+}
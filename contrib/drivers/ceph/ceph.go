@@ -23,17 +23,25 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/ceph/go-ceph/rados"
 	"github.com/ceph/go-ceph/rbd"
 	log "github.com/golang/glog"
+	"github.com/opensds/opensds/contrib/drivers"
 	. "github.com/opensds/opensds/contrib/drivers/utils/config"
 	"github.com/opensds/opensds/pkg/model"
 	pb "github.com/opensds/opensds/pkg/model/proto"
 	"github.com/opensds/opensds/pkg/utils/config"
+	"github.com/opensds/opensds/pkg/utils/exec"
 	uuid "github.com/satori/go.uuid"
 )
 
+func init() {
+	drivers.RegisterVolumeDriver(CephDriverType, func() drivers.VolumeDriver { return &Driver{} },
+		drivers.Capability{VolumeGroup: true, SnapshotAttach: true})
+}
+
 const (
 	opensdsPrefix   = "opensds-"
 	sizeShiftBit    = 30
@@ -55,6 +63,25 @@ func EncodeName(id string) string {
 	return opensdsPrefix + id
 }
 
+// snapshotName returns the name of the rbd snapshot, the snapshots taken over
+// from the cluster keep their original names because rbd snapshots can't be
+// renamed.
+func snapshotName(id string, metadata map[string]string) string {
+	if name, ok := metadata[KManagedSnapshotName]; ok {
+		return name
+	}
+	return EncodeName(id)
+}
+
+// imageName returns the name of the rbd image of the volume, the images taken
+// over from the cluster keep their original names.
+func imageName(id string, metadata map[string]string) string {
+	if name, ok := metadata[KManagedVolumeName]; ok {
+		return name
+	}
+	return EncodeName(id)
+}
+
 func NewSrcMgr(conf *CephConfig) *SrcMgr {
 	return &SrcMgr{conf: conf}
 }
@@ -140,9 +167,12 @@ func (s *SrcMgr) destroy() {
 
 type Driver struct {
 	conf *CephConfig
+	// Command executer of the rbd operations which go-ceph doesn't provide
+	executer exec.Executer
 }
 
 func (d *Driver) Setup() error {
+	d.executer = exec.NewRootExecuter()
 	d.conf = &CephConfig{ConfigFile: "/etc/ceph/ceph.conf"}
 	p := config.CONF.OsdsDock.Backends.Ceph.ConfigPath
 	if "" == p {
@@ -155,8 +185,11 @@ func (d *Driver) Setup() error {
 func (d *Driver) Unset() error { return nil }
 
 func (d *Driver) createVolumeFromSnapshot(opt *pb.CreateVolumeOpts) (*model.VolumeSpec, error) {
+	if _, ok := opt.GetMetadata()[KGroupSnapName]; ok {
+		return d.cloneGroupSnapshot(opt)
+	}
 	poolName := opt.GetPoolName()
-	srcSnapName := EncodeName(opt.GetSnapshotId())
+	srcSnapName := snapshotName(opt.GetSnapshotId(), opt.GetMetadata())
 	srcImgName := opt.GetMetadata()[KImageName]
 	destImgName := EncodeName(opt.GetId())
 
@@ -242,6 +275,86 @@ func (d *Driver) CreateVolume(opt *pb.CreateVolumeOpts) (*model.VolumeSpec, erro
 	return d.createVolume(opt)
 }
 
+// CloneVolume clones the source rbd image through a temporary snapshot, and
+// flattens the cloned image so that it doesn't depend on the source any more.
+func (d *Driver) CloneVolume(opt *pb.CreateVolumeOpts) (*model.VolumeSpec, error) {
+	poolName := opt.GetPoolName()
+	srcImgName := EncodeName(opt.GetSourceVolumeId())
+	tmpSnapName := EncodeName("clone-" + opt.GetId())
+	destImgName := EncodeName(opt.GetId())
+
+	mgr := NewSrcMgr(d.conf)
+	defer mgr.destroy()
+
+	ioctx, err := mgr.GetIoctx(poolName)
+	if err != nil {
+		return nil, err
+	}
+
+	img, err := mgr.GetImage(poolName, srcImgName)
+	if err != nil {
+		return nil, err
+	}
+	snap, err := img.CreateSnapshot(tmpSnapName)
+	if err != nil {
+		log.Errorf("create temporary snapshot of image (%s) failed, %v", srcImgName, err)
+		return nil, err
+	}
+	defer func() {
+		if err := snap.Remove(); err != nil {
+			log.Errorf("remove temporary snapshot (%s) failed, %v", tmpSnapName, err)
+		}
+	}()
+	if err := snap.Protect(); err != nil {
+		log.Errorf("protect failed, %v", err)
+		return nil, err
+	}
+	defer snap.Unprotect()
+
+	destImg, err := img.Clone(tmpSnapName, ioctx, destImgName, rbd.RbdFeatureLayering, 20)
+	if err != nil {
+		log.Errorf("clone volume (%s) from volume (%s) failed, %v",
+			opt.GetId(), opt.GetSourceVolumeId(), err)
+		return nil, err
+	}
+	if err := flattenImage(destImg, opt.GetSize()); err != nil {
+		log.Errorf("flatten cloned volume (%s) failed, %v", opt.GetId(), err)
+		if err := rbd.GetImage(ioctx, destImgName).Remove(); err != nil {
+			log.Errorf("remove cloned volume (%s) failed, %v", opt.GetId(), err)
+		}
+		return nil, err
+	}
+
+	log.Infof("clone volume (%s) from volume (%s) success",
+		opt.GetId(), opt.GetSourceVolumeId())
+	return &model.VolumeSpec{
+		BaseModel: &model.BaseModel{
+			Id: opt.GetId(),
+		},
+		Name:             opt.GetName(),
+		Size:             opt.GetSize(),
+		Description:      opt.GetDescription(),
+		AvailabilityZone: opt.GetAvailabilityZone(),
+		Metadata: map[string]string{
+			KPoolName: opt.GetPoolName(),
+		},
+	}, nil
+}
+
+// flattenImage detaches the image from its parent snapshot and resizes it
+// to the requested size.
+func flattenImage(img *rbd.Image, size int64) error {
+	if err := img.Open(); err != nil {
+		return err
+	}
+	defer img.Close()
+
+	if err := img.Flatten(); err != nil {
+		return err
+	}
+	return img.Resize(uint64(size) << sizeShiftBit)
+}
+
 // ExtendVolume ...
 func (d *Driver) ExtendVolume(opt *pb.ExtendVolumeOpts) (*model.VolumeSpec, error) {
 	mgr := NewSrcMgr(d.conf)
@@ -269,9 +382,185 @@ func (d *Driver) ExtendVolume(opt *pb.ExtendVolumeOpts) (*model.VolumeSpec, erro
 	}, nil
 }
 
-func (d *Driver) PullVolume(volID string) (*model.VolumeSpec, error) {
-	// Not used, do nothing.
-	return nil, nil
+// MigrateVolume copies the image to the target pool of the same cluster and
+// removes the source image.
+func (d *Driver) MigrateVolume(opt *pb.MigrateVolumeOpts) (*model.VolumeSpec, error) {
+	srcPoolName, ok := opt.GetMetadata()[KPoolName]
+	if !ok {
+		err := errors.New("Failed to find poolName in volume metadata!")
+		log.Error(err)
+		return nil, err
+	}
+	imgName := EncodeName(opt.GetId())
+
+	mgr := NewSrcMgr(d.conf)
+	defer mgr.destroy()
+
+	img, err := mgr.GetImage(srcPoolName, imgName)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := mgr.GetConn()
+	if err != nil {
+		return nil, err
+	}
+	destIoctx, err := conn.OpenIOContext(opt.GetPoolName())
+	if err != nil {
+		log.Error("Open IO context failed, poolName:", opt.GetPoolName(), err)
+		return nil, err
+	}
+	defer destIoctx.Destroy()
+
+	if err := img.Copy(*destIoctx, imgName); err != nil {
+		log.Errorf("copy volume (%s) to pool (%s) failed, %v", opt.GetId(), opt.GetPoolName(), err)
+		return nil, err
+	}
+
+	// The source image can't be removed until it's closed.
+	img.Close()
+	mgr.img = nil
+	if err := rbd.GetImage(mgr.ioctx, imgName).Remove(); err != nil {
+		log.Errorf("remove source volume (%s) failed, %v", opt.GetId(), err)
+		if err := rbd.GetImage(destIoctx, imgName).Remove(); err != nil {
+			log.Errorf("remove copied volume (%s) failed, %v", opt.GetId(), err)
+		}
+		return nil, err
+	}
+
+	log.Infof("migrate volume (%s) to pool (%s) success", opt.GetId(), opt.GetPoolName())
+	return &model.VolumeSpec{
+		BaseModel: &model.BaseModel{
+			Id: opt.GetId(),
+		},
+		Name: opt.GetName(),
+		Size: opt.GetSize(),
+		Metadata: map[string]string{
+			KPoolName: opt.GetPoolName(),
+		},
+	}, nil
+}
+
+// RevertVolumeToSnapshot rolls the image back to the snapshot, it's the same
+// as rbd snap rollback. The snapshots in the group namespace can't be rolled
+// back individually, so they are reverted by copying.
+func (d *Driver) RevertVolumeToSnapshot(opt *pb.RevertVolumeToSnapshotOpts) error {
+	if _, ok := opt.GetSnapshotMetadata()[KGroupSnapName]; ok {
+		return &model.NotImplementError{S: "reverting volume to group snapshot is not supported by rbd"}
+	}
+
+	mgr := NewSrcMgr(d.conf)
+	defer mgr.destroy()
+
+	img, err := mgr.GetImage(opt.GetPoolName(), imageName(opt.GetId(), opt.GetMetadata()))
+	if err != nil {
+		// Nothing is changed if the image can't be opened.
+		return model.NewPreconditionError(err.Error())
+	}
+	snapName := snapshotName(opt.GetSnapshotId(), opt.GetSnapshotMetadata())
+	if err := img.GetSnapshot(snapName).Rollback(); err != nil {
+		log.Errorf("revert volume (%s) to snapshot (%s) failed, %v", opt.GetId(), opt.GetSnapshotId(), err)
+		return err
+	}
+
+	log.Infof("revert volume (%s) to snapshot (%s) success", opt.GetId(), opt.GetSnapshotId())
+	return nil
+}
+
+// ManageVolume takes over the rbd image named by the identifier in the pool,
+// it's renamed like the ones created by opensds.
+func (d *Driver) ManageVolume(opt *pb.ManageVolumeOpts) (*model.VolumeSpec, error) {
+	poolName := opt.GetPoolName()
+	imgName := opt.GetIdentifier()
+
+	mgr := NewSrcMgr(d.conf)
+	defer mgr.destroy()
+
+	img, err := mgr.GetImage(poolName, imgName)
+	if err != nil {
+		return nil, err
+	}
+	size, err := img.GetSize()
+	if err != nil {
+		log.Errorf("get size of image (%s) failed, %v", imgName, err)
+		return nil, err
+	}
+
+	img.Close()
+	mgr.img = nil
+	if err := rbd.GetImage(mgr.ioctx, imgName).Rename(EncodeName(opt.GetId())); err != nil {
+		log.Errorf("rename image (%s) failed, %v", imgName, err)
+		return nil, err
+	}
+
+	log.Infof("manage image (%s) as volume (%s) success", imgName, opt.GetId())
+	return &model.VolumeSpec{
+		BaseModel: &model.BaseModel{
+			Id: opt.GetId(),
+		},
+		Name:        opt.GetName(),
+		Size:        int64((size + 1<<sizeShiftBit - 1) >> sizeShiftBit),
+		Description: opt.GetDescription(),
+		Metadata: map[string]string{
+			KPoolName:          poolName,
+			KManagedVolumeName: imgName,
+		},
+	}, nil
+}
+
+// UnmanageVolume gives the rbd image its original name back if it was taken
+// over from the pool.
+func (d *Driver) UnmanageVolume(opt *pb.UnmanageVolumeOpts) error {
+	imgName, ok := opt.GetMetadata()[KManagedVolumeName]
+	if !ok {
+		return nil
+	}
+
+	mgr := NewSrcMgr(d.conf)
+	defer mgr.destroy()
+
+	ioctx, err := mgr.GetIoctx(opt.GetMetadata()[KPoolName])
+	if err != nil {
+		return err
+	}
+	if err := rbd.GetImage(ioctx, EncodeName(opt.GetId())).Rename(imgName); err != nil {
+		log.Errorf("rename volume (%s) failed, %v", opt.GetId(), err)
+		return err
+	}
+
+	log.Infof("unmanage volume (%s) success", opt.GetId())
+	return nil
+}
+
+// PullVolume opens the image of the volume in the pool to get its size.
+func (d *Driver) PullVolume(opt *pb.PullVolumeOpts) (*model.VolumeSpec, error) {
+	mgr := NewSrcMgr(d.conf)
+	defer mgr.destroy()
+
+	poolName, imgName := opt.GetPoolName(), EncodeName(opt.GetId())
+	img, err := mgr.GetImage(poolName, imgName)
+	if err == rbd.RbdErrorNotFound {
+		return nil, model.NewNotFoundError(fmt.Sprintf("image (%s) doesn't exist in pool (%s)", imgName, poolName))
+	}
+	if err != nil {
+		return nil, err
+	}
+	size, err := img.GetSize()
+	if err != nil {
+		log.Errorf("get size of image (%s) failed, %v", imgName, err)
+		return nil, err
+	}
+
+	return &model.VolumeSpec{
+		BaseModel: &model.BaseModel{
+			Id: opt.GetId(),
+		},
+		Name:   imgName,
+		Size:   sizeInGb(size),
+		PoolId: opt.GetPoolId(),
+		Metadata: map[string]string{
+			KPoolName: poolName,
+		},
+	}, nil
 }
 
 func (d *Driver) DeleteVolume(opt *pb.DeleteVolumeOpts) error {
@@ -351,16 +640,102 @@ func (d *Driver) CreateSnapshot(opt *pb.CreateVolumeSnapshotOpts) (*model.Volume
 
 }
 
-func (d *Driver) PullSnapshot(snapID string) (*model.VolumeSnapshotSpec, error) {
-	return nil, fmt.Errorf("Ceph PullSnapshot has not implemented yet.")
+// PullSnapshot finds the snapshot among the ones of the image of the volume.
+func (d *Driver) PullSnapshot(opt *pb.PullVolumeSnapshotOpts) (*model.VolumeSnapshotSpec, error) {
+	mgr := NewSrcMgr(d.conf)
+	defer mgr.destroy()
+
+	poolName, ok := opt.GetMetadata()[KPoolName]
+	if !ok {
+		poolName = opt.GetPoolName()
+	}
+	imgName := EncodeName(opt.GetVolumeId())
+	snapName := snapshotName(opt.GetId(), opt.GetMetadata())
+	img, err := mgr.GetImage(poolName, imgName)
+	if err == rbd.RbdErrorNotFound {
+		return nil, model.NewNotFoundError(fmt.Sprintf("image (%s) doesn't exist in pool (%s)", imgName, poolName))
+	}
+	if err != nil {
+		return nil, err
+	}
+	snaps, err := img.GetSnapshotNames()
+	if err != nil {
+		log.Errorf("list snapshots of image (%s) failed, %v", imgName, err)
+		return nil, err
+	}
+
+	for _, snap := range snaps {
+		if snap.Name != snapName {
+			continue
+		}
+		return &model.VolumeSnapshotSpec{
+			BaseModel: &model.BaseModel{
+				Id: opt.GetId(),
+			},
+			Name:     snap.Name,
+			Size:     sizeInGb(snap.Size),
+			VolumeId: opt.GetVolumeId(),
+			Metadata: map[string]string{
+				KPoolName:  poolName,
+				KImageName: imgName,
+			},
+		}, nil
+	}
+	return nil, model.NewNotFoundError(fmt.Sprintf("snapshot (%s) of image (%s) doesn't exist", snapName, imgName))
 }
 
+// ManageSnapshot takes over the snapshot of the image of the volume, the
+// snapshot keeps its name which is recorded in the metadata.
+func (d *Driver) ManageSnapshot(opt *pb.ManageVolumeSnapshotOpts) (*model.VolumeSnapshotSpec, error) {
+	mgr := NewSrcMgr(d.conf)
+	defer mgr.destroy()
+
+	poolName := opt.GetMetadata()[KPoolName]
+	imgName := EncodeName(opt.GetVolumeId())
+	img, err := mgr.GetImage(poolName, imgName)
+	if err != nil {
+		return nil, err
+	}
+	snaps, err := img.GetSnapshotNames()
+	if err != nil {
+		log.Errorf("list snapshots of image (%s) failed, %v", imgName, err)
+		return nil, err
+	}
+
+	for _, snap := range snaps {
+		if snap.Name != opt.GetIdentifier() {
+			continue
+		}
+		log.Infof("manage snapshot (%s) of volume (%s) success", snap.Name, opt.GetVolumeId())
+		return &model.VolumeSnapshotSpec{
+			BaseModel: &model.BaseModel{
+				Id: opt.GetId(),
+			},
+			Name:        opt.GetName(),
+			Description: opt.GetDescription(),
+			VolumeId:    opt.GetVolumeId(),
+			Size:        opt.GetSize(),
+			Metadata: map[string]string{
+				KPoolName:            poolName,
+				KImageName:           imgName,
+				KManagedSnapshotName: snap.Name,
+			},
+		}, nil
+	}
+	return nil, fmt.Errorf("snapshot (%s) of volume (%s) not found", opt.GetIdentifier(), opt.GetVolumeId())
+}
+
+// UnmanageSnapshot does nothing because the snapshot wasn't renamed when it
+// was taken over.
+func (d *Driver) UnmanageSnapshot(opt *pb.UnmanageVolumeSnapshotOpts) error { return nil }
+
 func (d *Driver) DeleteSnapshot(opt *pb.DeleteVolumeSnapshotOpts) error {
 	mgr := NewSrcMgr(d.conf)
 	defer mgr.destroy()
 
 	poolName := opt.GetMetadata()[KPoolName]
-	img, err := mgr.GetImage(poolName, EncodeName(opt.GetVolumeId()), EncodeName(opt.GetId()))
+	snapName := snapshotName(opt.GetId(), opt.GetMetadata())
+	img, err := mgr.GetImage(poolName, EncodeName(opt.GetVolumeId()), snapName)
 	if err == rbd.RbdErrorNotFound {
 		log.Warningf("Specified snapshot (%s) does not exist, ignore it", opt.GetId())
 		return nil
@@ -369,7 +744,7 @@ func (d *Driver) DeleteSnapshot(opt *pb.DeleteVolumeSnapshotOpts) error {
 		return err
 	}
 
-	snap := img.GetSnapshot(EncodeName(opt.GetId()))
+	snap := img.GetSnapshot(snapName)
 	if ok, _ := snap.IsProtected(); ok {
 		if err := snap.Unprotect(); err != nil {
 			log.Errorf("unprotect failed, %v", err)
@@ -452,6 +827,106 @@ func (d *Driver) ListPools() ([]*model.StoragePoolSpec, error) {
 	return pols, nil
 }
 
+// ListVolumes lists the images named by opensds in the pool.
+func (d *Driver) ListVolumes(opt *pb.ListVolumesOpts) ([]*model.VolumeSpec, error) {
+	var vols []*model.VolumeSpec
+	err := d.walkImages(opt.GetPoolName(), func(img *rbd.Image, imgName string) error {
+		size, err := img.GetSize()
+		if err != nil {
+			log.Errorf("get size of image (%s) failed, %v", imgName, err)
+			return err
+		}
+		vols = append(vols, &model.VolumeSpec{
+			BaseModel: &model.BaseModel{
+				Id: strings.TrimPrefix(imgName, opensdsPrefix),
+			},
+			Name:   imgName,
+			Size:   sizeInGb(size),
+			PoolId: opt.GetPoolId(),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return vols, nil
+}
+
+// ListSnapshots lists the snapshots named by opensds of the images in the
+// pool, the snapshots taken over from the cluster keep their original names
+// so they aren't listed.
+func (d *Driver) ListSnapshots(opt *pb.ListVolumesOpts) ([]*model.VolumeSnapshotSpec, error) {
+	var snaps []*model.VolumeSnapshotSpec
+	err := d.walkImages(opt.GetPoolName(), func(img *rbd.Image, imgName string) error {
+		infos, err := img.GetSnapshotNames()
+		if err != nil {
+			log.Errorf("list snapshots of image (%s) failed, %v", imgName, err)
+			return err
+		}
+		for _, info := range infos {
+			if !strings.HasPrefix(info.Name, opensdsPrefix) {
+				continue
+			}
+			snaps = append(snaps, &model.VolumeSnapshotSpec{
+				BaseModel: &model.BaseModel{
+					Id: strings.TrimPrefix(info.Name, opensdsPrefix),
+				},
+				Name:     info.Name,
+				Size:     sizeInGb(info.Size),
+				VolumeId: strings.TrimPrefix(imgName, opensdsPrefix),
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return snaps, nil
+}
+
+// walkImages opens the images named by opensds in the pool one by one and
+// calls fn with each of them.
+func (d *Driver) walkImages(poolName string, fn func(img *rbd.Image, imgName string) error) error {
+	mgr := NewSrcMgr(d.conf)
+	defer mgr.destroy()
+
+	ioctx, err := mgr.GetIoctx(poolName)
+	if err != nil {
+		return err
+	}
+	names, err := rbd.GetImageNames(ioctx)
+	if err != nil {
+		log.Errorf("list images in pool (%s) failed, %v", poolName, err)
+		return err
+	}
+
+	for _, name := range names {
+		if !strings.HasPrefix(name, opensdsPrefix) {
+			continue
+		}
+		img := rbd.GetImage(ioctx, name)
+		if err := img.Open(); err != nil {
+			// The image may be deleted after it's listed.
+			if err == rbd.RbdErrorNotFound {
+				continue
+			}
+			log.Errorf("open image (%s) failed, %v", name, err)
+			return err
+		}
+		err := fn(img, name)
+		img.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sizeInGb rounds the size in bytes up to GiB.
+func sizeInGb(size uint64) int64 {
+	return int64((size + 1<<sizeShiftBit - 1) >> sizeShiftBit)
+}
+
 func (d *Driver) InitializeSnapshotConnection(opt *pb.CreateSnapshotAttachmentOpts) (*model.ConnectionInfo, error) {
 	poolName, ok := opt.GetMetadata()[KPoolName]
 	if !ok {
@@ -476,15 +951,3 @@ func (d *Driver) InitializeSnapshotConnection(opt *pb.CreateSnapshotAttachmentOp
 func (d *Driver) TerminateSnapshotConnection(opt *pb.DeleteSnapshotAttachmentOpts) error {
 	return nil
 }
-
-func (d *Driver) CreateVolumeGroup(opt *pb.CreateVolumeGroupOpts) (*model.VolumeGroupSpec, error) {
-	return nil, &model.NotImplementError{"method CreateVolumeGroup has not been implemented yet"}
-}
-
-func (d *Driver) UpdateVolumeGroup(opt *pb.UpdateVolumeGroupOpts) (*model.VolumeGroupSpec, error) {
-	return nil, &model.NotImplementError{"method UpdateVolumeGroup has not been implemented yet"}
-}
-
-func (d *Driver) DeleteVolumeGroup(opt *pb.DeleteVolumeGroupOpts) error {
-	return &model.NotImplementError{"method DeleteVolumeGroup has not been implemented yet"}
-}
@@ -0,0 +1,198 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ceph
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/opensds/opensds/pkg/model"
+	pb "github.com/opensds/opensds/pkg/model/proto"
+)
+
+// fakeExecuter records the rbd commands without the config option, and
+// returns the output of the first response whose key the command starts
+// with.
+type fakeExecuter struct {
+	cmds  []string
+	resps map[string]string
+	fail  string
+}
+
+func (f *fakeExecuter) Run(name string, args ...string) (string, error) {
+	cmd := strings.Join(args[2:], " ")
+	f.cmds = append(f.cmds, cmd)
+	if f.fail != "" && strings.HasPrefix(cmd, f.fail) {
+		return "", fmt.Errorf("%s failed", cmd)
+	}
+	for k, out := range f.resps {
+		if strings.HasPrefix(cmd, k) {
+			return out, nil
+		}
+	}
+	return "", nil
+}
+
+func TestUpdateVolumeGroup(t *testing.T) {
+	var groupId = "3769855c-a102-11e7-b772-17b880d2f555"
+	var spec = "rbd/opensds-" + groupId
+	opt := &pb.UpdateVolumeGroupOpts{
+		Id:       groupId,
+		Metadata: map[string]string{KPoolName: "rbd"},
+		AddVolumesRef: []*pb.VolumeRef{
+			// It's in the group already.
+			{Id: "bd5b12a8-a101-11e7-941e-d77981b584d8", Status: "available", Metadata: map[string]string{KPoolName: "rbd"}},
+			{Id: "c2a5f7b0-a101-11e7-941e-d77981b584d8", Status: "available", Metadata: map[string]string{KPoolName: "rbd"}},
+			{Id: "d1916c49-3088-4a40-b6fb-0fda18d074c3", Status: "available", Metadata: map[string]string{KPoolName: "ssd"}},
+		},
+		RemoveVolumesRef: []*pb.VolumeRef{
+			{Id: "e0c4b4d2-7f41-11e9-a6b2-3f5e8c1d4b90", Status: "inUse", Metadata: map[string]string{KPoolName: "rbd"}},
+		},
+	}
+	fe := &fakeExecuter{
+		resps: map[string]string{
+			"group list":       `["opensds-` + groupId + `"]`,
+			"group image list": `[{"image":"opensds-bd5b12a8-a101-11e7-941e-d77981b584d8","pool":"rbd"},{"image":"opensds-e0c4b4d2-7f41-11e9-a6b2-3f5e8c1d4b90","pool":"rbd"}]`,
+		},
+		fail: "group image add " + spec + " ssd/",
+	}
+	d := &Driver{conf: &CephConfig{ConfigFile: "/etc/ceph/ceph.conf"}, executer: fe}
+
+	vg, err := d.UpdateVolumeGroup(opt)
+	if err != nil {
+		t.Fatal("Failed to update volume group:", err)
+	}
+	var expectedCmds = []string{
+		"group list --pool rbd --format json",
+		"group image list " + spec + " --format json",
+		"group image add " + spec + " rbd/opensds-c2a5f7b0-a101-11e7-941e-d77981b584d8",
+		"group image add " + spec + " ssd/opensds-d1916c49-3088-4a40-b6fb-0fda18d074c3",
+		"group image remove " + spec + " rbd/opensds-e0c4b4d2-7f41-11e9-a6b2-3f5e8c1d4b90",
+	}
+	if !reflect.DeepEqual(fe.cmds, expectedCmds) {
+		t.Errorf("Expected %v, got %v\n", expectedCmds, fe.cmds)
+	}
+	var expected = []*model.VolumeSpec{
+		{BaseModel: &model.BaseModel{Id: "bd5b12a8-a101-11e7-941e-d77981b584d8"}, Status: "available", GroupId: groupId},
+		{BaseModel: &model.BaseModel{Id: "c2a5f7b0-a101-11e7-941e-d77981b584d8"}, Status: "available", GroupId: groupId},
+		{BaseModel: &model.BaseModel{Id: "d1916c49-3088-4a40-b6fb-0fda18d074c3"}, Status: model.VolumeError},
+		{BaseModel: &model.BaseModel{Id: "e0c4b4d2-7f41-11e9-a6b2-3f5e8c1d4b90"}, Status: "inUse"},
+	}
+	if !reflect.DeepEqual(vg.Volumes, expected) {
+		t.Errorf("Expected %+v, got %+v\n", expected, vg.Volumes)
+	}
+	if vg.Metadata[KPoolName] != "rbd" {
+		t.Errorf("Expected pool rbd in metadata, got %v\n", vg.Metadata)
+	}
+
+	// The volume which can't be taken out of the rbd group stays in the group.
+	fe.cmds, fe.fail = nil, "group image remove"
+	vg, err = d.UpdateVolumeGroup(&pb.UpdateVolumeGroupOpts{
+		Id:               groupId,
+		Metadata:         opt.Metadata,
+		RemoveVolumesRef: opt.RemoveVolumesRef,
+	})
+	if err != nil {
+		t.Fatal("Failed to update volume group:", err)
+	}
+	if len(vg.Volumes) != 1 || vg.Volumes[0].Status != model.VolumeError ||
+		vg.Volumes[0].GroupId != groupId {
+		t.Errorf("Unexpected members: %+v\n", vg.Volumes)
+	}
+}
+
+func TestCreateVolumeGroup(t *testing.T) {
+	var groupId = "3769855c-a102-11e7-b772-17b880d2f555"
+	fe := &fakeExecuter{
+		resps: map[string]string{
+			"group list":       `[]`,
+			"group image list": `[]`,
+		},
+	}
+	d := &Driver{conf: &CephConfig{ConfigFile: "/etc/ceph/ceph.conf"}, executer: fe}
+
+	// The rbd group isn't created until a volume is put into the group.
+	vg, err := d.CreateVolumeGroup(&pb.CreateVolumeGroupOpts{Id: groupId})
+	if err != nil {
+		t.Fatal("Failed to create volume group:", err)
+	}
+	if len(fe.cmds) != 0 || vg.Metadata != nil {
+		t.Errorf("Expected no rbd group, got commands %v, metadata %v\n", fe.cmds, vg.Metadata)
+	}
+
+	vg, err = d.CreateVolumeGroup(&pb.CreateVolumeGroupOpts{
+		Id: groupId,
+		AddVolumesRef: []*pb.VolumeRef{
+			{Id: "bd5b12a8-a101-11e7-941e-d77981b584d8", Metadata: map[string]string{KPoolName: "rbd"}},
+		},
+	})
+	if err != nil {
+		t.Fatal("Failed to create volume group:", err)
+	}
+	var expectedCmds = []string{
+		"group list --pool rbd --format json",
+		"group create rbd/opensds-" + groupId,
+		"group image list rbd/opensds-" + groupId + " --format json",
+		"group image add rbd/opensds-" + groupId + " rbd/opensds-bd5b12a8-a101-11e7-941e-d77981b584d8",
+	}
+	if !reflect.DeepEqual(fe.cmds, expectedCmds) {
+		t.Errorf("Expected %v, got %v\n", expectedCmds, fe.cmds)
+	}
+	if vg.Metadata[KPoolName] != "rbd" || len(vg.Volumes) != 1 || vg.Volumes[0].GroupId != groupId {
+		t.Errorf("Unexpected volume group: %+v\n", vg)
+	}
+}
+
+func TestDeleteVolumeGroup(t *testing.T) {
+	var groupId = "3769855c-a102-11e7-b772-17b880d2f555"
+	fe := &fakeExecuter{}
+	d := &Driver{conf: &CephConfig{ConfigFile: "/etc/ceph/ceph.conf"}, executer: fe}
+
+	if err := d.DeleteVolumeGroup(&pb.DeleteVolumeGroupOpts{
+		Id:       groupId,
+		Metadata: map[string]string{KPoolName: "rbd"},
+	}); err != nil {
+		t.Fatal("Failed to delete volume group:", err)
+	}
+	var expectedCmds = []string{"group remove rbd/opensds-" + groupId}
+	if !reflect.DeepEqual(fe.cmds, expectedCmds) {
+		t.Errorf("Expected %v, got %v\n", expectedCmds, fe.cmds)
+	}
+
+	fe.cmds, fe.fail = nil, "group remove"
+	if err := d.DeleteVolumeGroup(&pb.DeleteVolumeGroupOpts{
+		Id:       groupId,
+		Metadata: map[string]string{KPoolName: "rbd"},
+	}); err == nil {
+		t.Error("Expected an error when the rbd group can't be removed")
+	}
+}
+
+func TestRevertVolumeToGroupSnapshot(t *testing.T) {
+	d := &Driver{conf: &CephConfig{ConfigFile: "/etc/ceph/ceph.conf"}}
+
+	// The member of a group snapshot is reverted by copying.
+	err := d.RevertVolumeToSnapshot(&pb.RevertVolumeToSnapshotOpts{
+		Id:               "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		SnapshotId:       "d1916c49-3088-4a40-b6fb-0fda18d074c3",
+		PoolName:         "rbd",
+		SnapshotMetadata: map[string]string{KGroupSnapName: "opensds-9c8a3d2e"},
+	})
+	if _, ok := err.(*model.NotImplementError); !ok {
+		t.Errorf("Expected NotImplementError, got %v\n", err)
+	}
+}
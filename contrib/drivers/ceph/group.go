@@ -0,0 +1,374 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ceph
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	log "github.com/golang/glog"
+	"github.com/opensds/opensds/pkg/model"
+	pb "github.com/opensds/opensds/pkg/model/proto"
+)
+
+const (
+	// KGroupSnapName is the name of the rbd group snapshot which the member
+	// snapshot belongs to, the member is a snapshot of the image in the
+	// group namespace and can only be found by its id.
+	KGroupSnapName = "CephGroupSnapName"
+	KSnapId        = "CephSnapId"
+)
+
+// rbd runs the rbd command against the cluster, the group operations of rbd
+// are not provided by go-ceph.
+func (d *Driver) rbd(args ...string) (string, error) {
+	return d.executer.Run("rbd", append([]string{"--conf", d.conf.ConfigFile}, args...)...)
+}
+
+// groupSpec returns the spec of the rbd group which holds the images of the
+// volume group.
+func groupSpec(poolName, groupId string) string {
+	return poolName + "/" + EncodeName(groupId)
+}
+
+// createGroup creates the rbd group of the volume group if it doesn't exist.
+func (d *Driver) createGroup(poolName, groupId string) error {
+	out, err := d.rbd("group", "list", "--pool", poolName, "--format", "json")
+	if err != nil {
+		return err
+	}
+	var groups []string
+	if err := json.Unmarshal([]byte(out), &groups); err != nil {
+		return err
+	}
+	for _, g := range groups {
+		if g == EncodeName(groupId) {
+			return nil
+		}
+	}
+	_, err = d.rbd("group", "create", groupSpec(poolName, groupId))
+	return err
+}
+
+// groupImages returns the specs of the images in the rbd group.
+func (d *Driver) groupImages(poolName, groupId string) (map[string]bool, error) {
+	out, err := d.rbd("group", "image", "list", groupSpec(poolName, groupId), "--format", "json")
+	if err != nil {
+		return nil, err
+	}
+	var members []struct {
+		Image string `json:"image"`
+		Pool  string `json:"pool"`
+	}
+	if err := json.Unmarshal([]byte(out), &members); err != nil {
+		return nil, err
+	}
+	var images = make(map[string]bool)
+	for _, m := range members {
+		images[m.Pool+"/"+m.Image] = true
+	}
+	return images, nil
+}
+
+// ensureGroup creates the rbd group of the volume group if it doesn't exist,
+// and adds the images which aren't in the group yet.
+func (d *Driver) ensureGroup(poolName, groupId string, imgNames []string) error {
+	if err := d.createGroup(poolName, groupId); err != nil {
+		return err
+	}
+	images, err := d.groupImages(poolName, groupId)
+	if err != nil {
+		return err
+	}
+	for _, img := range imgNames {
+		if images[poolName+"/"+img] {
+			continue
+		}
+		if _, err := d.rbd("group", "image", "add", groupSpec(poolName, groupId), poolName+"/"+img); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// groupPoolName returns the pool of the rbd group, which is the pool of the
+// first volume put into the group. It's empty if no volume is ever put into
+// the group.
+func groupPoolName(metadata map[string]string, volumesRef []*pb.VolumeRef) string {
+	if poolName, ok := metadata[KPoolName]; ok {
+		return poolName
+	}
+	for _, ref := range volumesRef {
+		if poolName, ok := ref.GetMetadata()[KPoolName]; ok {
+			return poolName
+		}
+	}
+	return ""
+}
+
+// CreateVolumeGroup creates the rbd group of the volume group and puts the
+// images of the members into it. The rbd group is created along with the
+// first member when the volume group is empty, since the pool of the volume
+// group isn't known until then.
+func (d *Driver) CreateVolumeGroup(opt *pb.CreateVolumeGroupOpts) (*model.VolumeGroupSpec, error) {
+	vg := &model.VolumeGroupSpec{
+		BaseModel: &model.BaseModel{
+			Id: opt.GetId(),
+		},
+		Name:             opt.GetName(),
+		Description:      opt.GetDescription(),
+		AvailabilityZone: opt.GetAvailabilityZone(),
+		PoolId:           opt.GetPoolId(),
+	}
+	poolName := groupPoolName(nil, opt.GetAddVolumesRef())
+	if poolName == "" {
+		log.Infof("Create volume group (%s) success", opt.GetId())
+		return vg, nil
+	}
+
+	vols, err := d.updateGroupImages(poolName, opt.GetId(), opt.GetAddVolumesRef(), nil)
+	if err != nil {
+		log.Errorf("create rbd group of volume group (%s) failed, %v", opt.GetId(), err)
+		return nil, err
+	}
+	vg.Metadata = map[string]string{KPoolName: poolName}
+	vg.Volumes = vols
+	log.Infof("Create volume group (%s) success", opt.GetId())
+	return vg, nil
+}
+
+func (d *Driver) UpdateVolumeGroup(opt *pb.UpdateVolumeGroupOpts) (*model.VolumeGroupSpec, error) {
+	vg := &model.VolumeGroupSpec{
+		BaseModel: &model.BaseModel{
+			Id: opt.GetId(),
+		},
+		PoolId: opt.GetPoolId(),
+	}
+	poolName := groupPoolName(opt.GetMetadata(), opt.GetAddVolumesRef())
+	if poolName == "" {
+		// No volume is ever put into the group, so there is no rbd group.
+		log.Infof("Update volume group (%s) success", opt.GetId())
+		return vg, nil
+	}
+
+	vols, err := d.updateGroupImages(poolName, opt.GetId(), opt.GetAddVolumesRef(), opt.GetRemoveVolumesRef())
+	if err != nil {
+		log.Errorf("update rbd group of volume group (%s) failed, %v", opt.GetId(), err)
+		return nil, err
+	}
+	vg.Metadata = map[string]string{KPoolName: poolName}
+	vg.Volumes = vols
+	log.Infof("Update volume group (%s) success", opt.GetId())
+	return vg, nil
+}
+
+// DeleteVolumeGroup removes the rbd group, the images in it are taken out of
+// the group and kept.
+func (d *Driver) DeleteVolumeGroup(opt *pb.DeleteVolumeGroupOpts) error {
+	poolName := groupPoolName(opt.GetMetadata(), opt.GetVolumesRef())
+	if poolName == "" {
+		log.Infof("Delete volume group (%s) success", opt.GetId())
+		return nil
+	}
+	if out, err := d.rbd("group", "remove", groupSpec(poolName, opt.GetId())); err != nil {
+		if !strings.Contains(out, "No such file or directory") {
+			log.Errorf("remove rbd group of volume group (%s) failed, %v", opt.GetId(), err)
+			return err
+		}
+		log.Warningf("Specified rbd group of volume group (%s) does not exist, ignore it", opt.GetId())
+	}
+	log.Infof("Delete volume group (%s) success", opt.GetId())
+	return nil
+}
+
+// updateGroupImages puts the images of the volumes added into the rbd group
+// and takes the ones of the volumes removed out of it, the status of each of
+// the volumes is reported.
+func (d *Driver) updateGroupImages(poolName, groupId string, addVolumesRef, removeVolumesRef []*pb.VolumeRef) ([]*model.VolumeSpec, error) {
+	if err := d.createGroup(poolName, groupId); err != nil {
+		return nil, err
+	}
+	images, err := d.groupImages(poolName, groupId)
+	if err != nil {
+		return nil, err
+	}
+
+	spec := groupSpec(poolName, groupId)
+	var vols []*model.VolumeSpec
+	for _, ref := range addVolumesRef {
+		vol := &model.VolumeSpec{
+			BaseModel: &model.BaseModel{
+				Id: ref.GetId(),
+			},
+			Status:  ref.GetStatus(),
+			GroupId: groupId,
+		}
+		img := ref.GetMetadata()[KPoolName] + "/" + imageName(ref.GetId(), ref.GetMetadata())
+		if !images[img] {
+			if _, err := d.rbd("group", "image", "add", spec, img); err != nil {
+				log.Errorf("add volume (%s) to volume group (%s) failed, %v", ref.GetId(), groupId, err)
+				vol.Status, vol.GroupId = model.VolumeError, ""
+			}
+		}
+		vols = append(vols, vol)
+	}
+	for _, ref := range removeVolumesRef {
+		vol := &model.VolumeSpec{
+			BaseModel: &model.BaseModel{
+				Id: ref.GetId(),
+			},
+			Status: ref.GetStatus(),
+		}
+		img := ref.GetMetadata()[KPoolName] + "/" + imageName(ref.GetId(), ref.GetMetadata())
+		if images[img] {
+			if _, err := d.rbd("group", "image", "remove", spec, img); err != nil {
+				log.Errorf("remove volume (%s) from volume group (%s) failed, %v", ref.GetId(), groupId, err)
+				vol.Status, vol.GroupId = model.VolumeError, groupId
+			}
+		}
+		vols = append(vols, vol)
+	}
+	return vols, nil
+}
+
+// groupSnapId returns the id of the snapshot which the group snapshot took of
+// the image.
+func (d *Driver) groupSnapId(poolName, imgName, groupSnapName string) (string, error) {
+	out, err := d.rbd("snap", "list", "--all", "--format", "json", poolName+"/"+imgName)
+	if err != nil {
+		return "", err
+	}
+	var snaps []struct {
+		Id        uint64 `json:"id"`
+		Namespace struct {
+			Type      string `json:"type"`
+			GroupSnap string `json:"group snap"`
+		} `json:"namespace"`
+	}
+	if err := json.Unmarshal([]byte(out), &snaps); err != nil {
+		return "", err
+	}
+	for _, s := range snaps {
+		if s.Namespace.Type == "group" && s.Namespace.GroupSnap == groupSnapName {
+			return fmt.Sprint(s.Id), nil
+		}
+	}
+	return "", fmt.Errorf("can't find snapshot of group snapshot %s in image %s", groupSnapName, imgName)
+}
+
+// CreateGroupSnapshot puts the images of the members into the rbd group of the
+// volume group and takes a group snapshot of it, rbd blocks the writes to all
+// the images while the snapshot is taken.
+func (d *Driver) CreateGroupSnapshot(opt *pb.CreateGroupSnapshotOpts) ([]*model.VolumeSnapshotSpec, error) {
+	if len(opt.GetSnapshots()) == 0 {
+		return nil, errors.New("no volume to take group snapshot of")
+	}
+	poolName := opt.GetSnapshots()[0].GetMetadata()[KPoolName]
+	var imgNames []string
+	for _, snp := range opt.GetSnapshots() {
+		imgNames = append(imgNames, EncodeName(snp.GetVolumeId()))
+	}
+	if err := d.ensureGroup(poolName, opt.GetGroupId(), imgNames); err != nil {
+		log.Errorf("prepare rbd group of volume group %s failed, %v", opt.GetGroupId(), err)
+		return nil, err
+	}
+
+	snapName := EncodeName(opt.GetId())
+	snapSpec := groupSpec(poolName, opt.GetGroupId()) + "@" + snapName
+	if _, err := d.rbd("group", "snap", "create", snapSpec); err != nil {
+		log.Errorf("create group snapshot (%s) failed, %v", opt.GetId(), err)
+		return nil, err
+	}
+
+	var snps []*model.VolumeSnapshotSpec
+	for i, snp := range opt.GetSnapshots() {
+		snapId, err := d.groupSnapId(poolName, imgNames[i], snapName)
+		if err != nil {
+			if _, err := d.rbd("group", "snap", "remove", snapSpec); err != nil {
+				log.Errorf("remove group snapshot (%s) failed, %v", opt.GetId(), err)
+			}
+			return nil, err
+		}
+		snps = append(snps, &model.VolumeSnapshotSpec{
+			BaseModel: &model.BaseModel{
+				Id: snp.GetId(),
+			},
+			Name:        snp.GetName(),
+			Description: snp.GetDescription(),
+			VolumeId:    snp.GetVolumeId(),
+			Size:        snp.GetSize(),
+			Metadata: map[string]string{
+				KPoolName:      poolName,
+				KImageName:     imgNames[i],
+				KGroupSnapName: snapName,
+				KSnapId:        snapId,
+			},
+		})
+	}
+	log.Infof("Create group snapshot (%s) of %d volumes success", opt.GetId(), len(snps))
+	return snps, nil
+}
+
+// DeleteGroupSnapshot removes the rbd group snapshot together with the
+// snapshots it took of the images.
+func (d *Driver) DeleteGroupSnapshot(opt *pb.DeleteGroupSnapshotOpts) error {
+	if len(opt.GetSnapshots()) == 0 {
+		return nil
+	}
+	md := opt.GetSnapshots()[0].GetMetadata()
+	snapSpec := groupSpec(md[KPoolName], opt.GetGroupId()) + "@" + md[KGroupSnapName]
+	if out, err := d.rbd("group", "snap", "remove", snapSpec); err != nil {
+		if strings.Contains(out, "No such file or directory") {
+			log.Warningf("Specified group snapshot (%s) does not exist, ignore it", opt.GetId())
+			return nil
+		}
+		return err
+	}
+	log.Infof("Delete group snapshot (%s) success", opt.GetId())
+	return nil
+}
+
+// cloneGroupSnapshot creates the image of the volume from a member of the
+// group snapshot, the snapshot in the group namespace can only be cloned by
+// its id with the clone format 2, which requires a Ceph release whose rbd
+// clone accepts --snap-id.
+func (d *Driver) cloneGroupSnapshot(opt *pb.CreateVolumeOpts) (*model.VolumeSpec, error) {
+	md := opt.GetMetadata()
+	if _, err := d.rbd("clone", "--rbd-default-clone-format", "2",
+		"--snap-id", md[KSnapId],
+		opt.GetPoolName()+"/"+md[KImageName],
+		opt.GetPoolName()+"/"+EncodeName(opt.GetId()),
+	); err != nil {
+		log.Errorf("create volume (%s) from snapshot (%s) failed, %v",
+			opt.GetId(), opt.GetSnapshotId(), err)
+		return nil, err
+	}
+	log.Infof("create volume (%s) from snapshot (%s) success",
+		opt.GetId(), opt.GetSnapshotId())
+	return &model.VolumeSpec{
+		BaseModel: &model.BaseModel{
+			Id: opt.GetId(),
+		},
+		Name:             opt.GetName(),
+		Size:             opt.GetSize(),
+		Description:      opt.GetDescription(),
+		AvailabilityZone: opt.GetAvailabilityZone(),
+		Metadata: map[string]string{
+			KPoolName: opt.GetPoolName(),
+		},
+	}, nil
+}
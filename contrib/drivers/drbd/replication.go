@@ -20,11 +20,16 @@ import (
 
 	"github.com/LINBIT/godrbdutils"
 	log "github.com/golang/glog"
+	"github.com/opensds/opensds/contrib/drivers"
 	"github.com/opensds/opensds/contrib/drivers/utils/config"
 	"github.com/opensds/opensds/pkg/model"
 	pb "github.com/opensds/opensds/pkg/model/proto"
 )
 
+func init() {
+	drivers.RegisterReplicationDriver(config.DRBDDriverType, func() drivers.ReplicationDriver { return &ReplicationDriver{} })
+}
+
 // ReplicationDriver
 type ReplicationDriver struct{}
 
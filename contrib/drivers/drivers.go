@@ -13,27 +13,30 @@
 // limitations under the License.
 
 /*
-This module defines an standard table of storage driver. The default storage
-driver is sample driver used for testing. If you want to use other storage
-plugin, just modify Init() and Clean() method.
+This module defines an standard table of storage driver. The sample driver is
+used for testing, other storage drivers register themselves into the registry
+when their packages are imported.
 
 */
 
 package drivers
 
 import (
+	"fmt"
+
 	_ "github.com/opensds/opensds/contrib/backup/multicloud"
-	"github.com/opensds/opensds/contrib/drivers/ceph"
-	"github.com/opensds/opensds/contrib/drivers/huawei/dorado"
-	"github.com/opensds/opensds/contrib/drivers/huawei/fusionstorage"
-	"github.com/opensds/opensds/contrib/drivers/lvm"
-	"github.com/opensds/opensds/contrib/drivers/openstack/cinder"
+	_ "github.com/opensds/opensds/contrib/backup/posix"
 	"github.com/opensds/opensds/contrib/drivers/utils/config"
 	"github.com/opensds/opensds/pkg/model"
 	pb "github.com/opensds/opensds/pkg/model/proto"
 	sample "github.com/opensds/opensds/testutils/driver"
 )
 
+func init() {
+	RegisterVolumeDriver(config.SampleDriverType, func() VolumeDriver { return &sample.Driver{} }, Capability{})
+	RegisterReplicationDriver(config.SampleDriverType, func() ReplicationDriver { return &sample.ReplicationDriver{} })
+}
+
 // VolumeDriver is an interface for exposing some operations of different volume
 // drivers, currently support sample, lvm, ceph, cinder and so forth.
 type VolumeDriver interface {
@@ -44,19 +47,57 @@ type VolumeDriver interface {
 
 	CreateVolume(opt *pb.CreateVolumeOpts) (*model.VolumeSpec, error)
 
-	PullVolume(volIdentifier string) (*model.VolumeSpec, error)
+	// NOTE Parameter opt contains the uuid and metadata of the source volume.
+	// Driver which can't clone volume natively should return NotImplementError,
+	// then the volume will be cloned through a temporary snapshot.
+	CloneVolume(opt *pb.CreateVolumeOpts) (*model.VolumeSpec, error)
+
+	// NOTE The volume is pulled to check it against the database, driver
+	// should return NotFoundError if the volume doesn't exist in the backend,
+	// and NotImplementError if it can't pull the volume.
+	PullVolume(opt *pb.PullVolumeOpts) (*model.VolumeSpec, error)
 
 	DeleteVolume(opt *pb.DeleteVolumeOpts) error
 
 	ExtendVolume(opt *pb.ExtendVolumeOpts) (*model.VolumeSpec, error)
 
+	// NOTE Parameter opt contains the metadata of the volume and the target
+	// pool of the same backend. Driver which can't migrate volume natively
+	// should return NotImplementError, then the volume will be copied to the
+	// target pool through an attacher dock.
+	MigrateVolume(opt *pb.MigrateVolumeOpts) (*model.VolumeSpec, error)
+
+	// NOTE Parameter opt contains the metadata of both the volume and the
+	// snapshot. Driver which can't revert volume natively should return
+	// NotImplementError, then the data of the snapshot will be copied back
+	// to the volume through a temporary volume.
+	RevertVolumeToSnapshot(opt *pb.RevertVolumeToSnapshotOpts) error
+
+	// NOTE Parameter opt contains the identifier of the volume in the pool,
+	// driver should rename or tag it so that it can be found by the uuid of
+	// the volume, and report its size. The data of the volume must be kept.
+	ManageVolume(opt *pb.ManageVolumeOpts) (*model.VolumeSpec, error)
+
+	// NOTE Driver should revert what it did when the volume was managed,
+	// the volume itself must be kept in the backend.
+	UnmanageVolume(opt *pb.UnmanageVolumeOpts) error
+
 	InitializeConnection(opt *pb.CreateVolumeAttachmentOpts) (*model.ConnectionInfo, error)
 
 	TerminateConnection(opt *pb.DeleteVolumeAttachmentOpts) error
 
 	CreateSnapshot(opt *pb.CreateVolumeSnapshotOpts) (*model.VolumeSnapshotSpec, error)
 
-	PullSnapshot(snapIdentifier string) (*model.VolumeSnapshotSpec, error)
+	// NOTE The same as PullVolume, NotFoundError is returned if the snapshot
+	// doesn't exist in the backend.
+	PullSnapshot(opt *pb.PullVolumeSnapshotOpts) (*model.VolumeSnapshotSpec, error)
+
+	// NOTE Parameter opt contains the identifier of the snapshot and the
+	// metadata of the volume it belongs to, the snapshot should be checked
+	// against the volume.
+	ManageSnapshot(opt *pb.ManageVolumeSnapshotOpts) (*model.VolumeSnapshotSpec, error)
+
+	UnmanageSnapshot(opt *pb.UnmanageVolumeSnapshotOpts) error
 
 	DeleteSnapshot(opt *pb.DeleteVolumeSnapshotOpts) error
 
@@ -70,60 +111,63 @@ type VolumeDriver interface {
 
 	// NOTE Parameter addVolumesRef or removeVolumesRef means complete volume
 	// information that will be added or removed from group. Driver may use
-	// them to do some related operations and return their status.
+	// them to do some related operations and return their status in the
+	// Volumes of the group, a member which fails to be added or removed is
+	// reported with the error status and its group id unchanged.
 	UpdateVolumeGroup(opt *pb.UpdateVolumeGroupOpts) (*model.VolumeGroupSpec, error)
 
-	// NOTE Parameter volumes means volumes deleted from group, driver may use
-	// their compelete information to do some related operations and return
-	// their status.
+	// NOTE Parameter volumesRef means volumes in the group, driver only removes
+	// the group from the backend and leaves the volumes, which are deleted one
+	// by one afterwards.
 	DeleteVolumeGroup(opt *pb.DeleteVolumeGroupOpts) error
 
+	// NOTE The snapshots of all the volumes in opt.Snapshots must be taken at
+	// the same point in time, and they are returned in the same order. Driver
+	// which can't take them consistently should return NotImplementError, then
+	// the controller quiesces the volumes and snapshots them one by one.
+	CreateGroupSnapshot(opt *pb.CreateGroupSnapshotOpts) ([]*model.VolumeSnapshotSpec, error)
+
+	// NOTE The same as CreateGroupSnapshot, NotImplementError tells the
+	// controller to delete the member snapshots one by one.
+	DeleteGroupSnapshot(opt *pb.DeleteGroupSnapshotOpts) error
+
 	ListPools() ([]*model.StoragePoolSpec, error)
+
+	// NOTE Only the volumes named by opensds are listed, and the uuid of each
+	// volume is parsed from its name, so that the volumes whose records are
+	// lost can be found. Driver which can't list the volumes in the pool
+	// should return NotImplementError.
+	ListVolumes(opt *pb.ListVolumesOpts) ([]*model.VolumeSpec, error)
+
+	// NOTE The same as ListVolumes, but the snapshots are listed.
+	ListSnapshots(opt *pb.ListVolumesOpts) ([]*model.VolumeSnapshotSpec, error)
 }
 
-// Init
-func Init(resourceType string) VolumeDriver {
+// Init creates the volume driver registered under resourceType and sets it up,
+// the proxy of the driver plugin is created if resourceType is plugin:<socket>.
+func Init(resourceType string) (VolumeDriver, error) {
 	var d VolumeDriver
-	switch resourceType {
-	case config.CinderDriverType:
-		d = &cinder.Driver{}
-		break
-	case config.CephDriverType:
-		d = &ceph.Driver{}
-		break
-	case config.LVMDriverType:
-		d = &lvm.Driver{}
-		break
-	case config.HuaweiDoradoDriverType:
-		d = &dorado.Driver{}
-		break
-	case config.HuaweiFusionStorageDriverType:
-		d = &fusionstorage.Driver{}
-	default:
-		d = &sample.Driver{}
-		break
+	if IsPluginDriver(resourceType) {
+		p, err := newPluginDriver(resourceType)
+		if err != nil {
+			return nil, err
+		}
+		d = p
+	} else {
+		entry, exist := volumeDrivers[resourceType]
+		if !exist {
+			return nil, unknownDriverError("volume", resourceType, volumeDriverTypes())
+		}
+		d = entry.factory()
 	}
-	d.Setup()
-	return d
+	if err := d.Setup(); err != nil {
+		return nil, fmt.Errorf("set up volume driver %s failed: %v", resourceType, err)
+	}
+	return d, nil
 }
 
 // Clean
 func Clean(d VolumeDriver) VolumeDriver {
-	// Execute different clean operations according to the VolumeDriver type.
-	switch d.(type) {
-	case *cinder.Driver:
-		break
-	case *ceph.Driver:
-		break
-	case *lvm.Driver:
-		break
-	case *dorado.Driver:
-		break
-	case *fusionstorage.Driver:
-		break
-	default:
-		break
-	}
 	d.Unset()
 	d = nil
 
@@ -140,17 +184,29 @@ type MetricDriver interface {
 	ValidateMetricsSupportList(metricList []string, resourceType string) ([]string, error)
 }
 
-// Init
-func InitMetricDriver(resourceType string) MetricDriver {
+// InitMetricDriver creates the metric driver registered under resourceType and
+// sets it up.
+func InitMetricDriver(resourceType string) (MetricDriver, error) {
 	var d MetricDriver
-	switch resourceType {
-	case config.LVMDriverType:
-		d = &lvm.MetricDriver{}
-		break
-	default:
-		//d = &sample.Driver{}
-		break
+	if IsPluginDriver(resourceType) {
+		p, err := newPluginMetricDriver(resourceType)
+		if err != nil {
+			return nil, err
+		}
+		d = p
+	} else {
+		factory, exist := metricDrivers[resourceType]
+		if !exist {
+			var types []string
+			for k := range metricDrivers {
+				types = append(types, k)
+			}
+			return nil, unknownDriverError("metric", resourceType, types)
+		}
+		d = factory()
 	}
-	d.Setup()
-	return d
+	if err := d.Setup(); err != nil {
+		return nil, fmt.Errorf("set up metric driver %s failed: %v", resourceType, err)
+	}
+	return d, nil
 }
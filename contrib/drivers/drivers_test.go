@@ -18,28 +18,31 @@ import (
 	"reflect"
 	"testing"
 
-	"github.com/opensds/opensds/contrib/drivers/ceph"
-	"github.com/opensds/opensds/contrib/drivers/lvm"
-	"github.com/opensds/opensds/contrib/drivers/openstack/cinder"
 	sample "github.com/opensds/opensds/testutils/driver"
 )
 
 func TestInit(t *testing.T) {
-	var rsList = []string{"others"}
+	var rsList = []string{"sample"}
 	var expectedVd = []VolumeDriver{&sample.Driver{}}
 
 	for i, rs := range rsList {
-		if vp := Init(rs); !reflect.DeepEqual(vp, expectedVd[i]) {
+		vp, err := Init(rs)
+		if err != nil {
+			t.Errorf("Failed to init driver %s: %v\n", rs, err)
+		}
+		if !reflect.DeepEqual(vp, expectedVd[i]) {
 			t.Errorf("Expected %v, got %v\n", expectedVd, vp)
 		}
 	}
+
+	// Unknown driver doesn't fall back to the sample driver any more.
+	if vp, err := Init("others"); err == nil {
+		t.Errorf("Expected error for unknown driver, got %v\n", vp)
+	}
 }
 
 func TestClean(t *testing.T) {
 	var driverList = []VolumeDriver{
-		&ceph.Driver{},
-		&lvm.Driver{},
-		&cinder.Driver{},
 		&sample.Driver{},
 	}
 
@@ -49,3 +52,24 @@ func TestClean(t *testing.T) {
 		}
 	}
 }
+
+func TestInitReplicationDriver(t *testing.T) {
+	d, err := InitReplicationDriver("sample")
+	if err != nil {
+		t.Errorf("Failed to init replication driver: %v\n", err)
+	}
+	if !reflect.DeepEqual(d, &sample.ReplicationDriver{}) {
+		t.Errorf("Expected %v, got %v\n", &sample.ReplicationDriver{}, d)
+	}
+
+	if d, err := InitReplicationDriver("others"); err == nil {
+		t.Errorf("Expected error for unknown replication driver, got %v\n", d)
+	}
+}
+
+func TestInitMetricDriver(t *testing.T) {
+	// No metric driver is registered until the driver packages are imported.
+	if d, err := InitMetricDriver("others"); err == nil {
+		t.Errorf("Expected error for unknown metric driver, got %v\n", d)
+	}
+}
@@ -13,22 +13,27 @@
 // limitations under the License.
 
 /*
-This module defines an standard table of storage driver. The default storage
-driver is sample driver used for testing. If you want to use other storage
-plugin, just modify Init() and Clean() method.
+This module defines an standard table of fileshare driver. The sample driver is
+used for testing, other fileshare drivers register themselves into the registry
+when their packages are imported.
 */
 
 package filesharedrivers
 
 import (
 	"fmt"
-	nfs "github.com/opensds/opensds/contrib/drivers/filesharedrivers/nfs"
+	"sort"
+
 	"github.com/opensds/opensds/contrib/drivers/utils/config"
 	"github.com/opensds/opensds/pkg/model"
 	pb "github.com/opensds/opensds/pkg/model/proto"
 	sample "github.com/opensds/opensds/testutils/driver"
 )
 
+func init() {
+	RegisterFileShareDriver(config.SampleDriverType, func() FileShareDriver { return &sample.Driver{} })
+}
+
 type FileShareDriver interface {
 	//Any initialization the fileshare driver does while starting.
 	Setup() error
@@ -40,36 +45,57 @@ type FileShareDriver interface {
 	ListPools() ([]*model.StoragePoolSpec, error)
 
 	DeleteFileShare(opts *pb.DeleteFileShareOpts) (*model.FileShareSpec, error)
+
+	CreateFileShareSnapshot(opts *pb.CreateFileShareSnapshotOpts) (*model.FileShareSnapshotSpec, error)
+
+	DeleteFileShareSnapshot(opts *pb.DeleteFileShareSnapshotOpts) error
+
+	CreateFileShareAcl(opts *pb.CreateFileShareAclOpts) (*model.FileShareAclSpec, error)
+
+	DeleteFileShareAcl(opts *pb.DeleteFileShareAclOpts) error
 }
 
-// Init
-func Init(resourceType string) FileShareDriver {
-	fmt.Println("resourceType ...",resourceType)
-	var f FileShareDriver
-	switch resourceType {
-	case config.NFSDriverType:
-		fmt.Println("IN nfs driver...")
-		f = &nfs.Driver{}
-		break
-	default:
-		fmt.Println("IN nfs-sample driver...")
-		f = &nfs.Driver{}
-		break
+// FileShareDriverFactory creates a new instance of the fileshare driver.
+type FileShareDriverFactory func() FileShareDriver
+
+var fileShareDrivers = map[string]FileShareDriverFactory{}
+
+// RegisterFileShareDriver registers the factory of the fileshare driver under
+// the driver type.
+func RegisterFileShareDriver(fType string, factory FileShareDriverFactory) error {
+	if _, exist := fileShareDrivers[fType]; exist {
+		return fmt.Errorf("fileshare driver %s already exist", fType)
 	}
-	return f
+	fileShareDrivers[fType] = factory
+	return nil
+}
+
+// UnregisterFileShareDriver removes the fileshare driver from the registry.
+func UnregisterFileShareDriver(fType string) {
+	delete(fileShareDrivers, fType)
+}
+
+// Init creates the fileshare driver registered under resourceType and sets
+// it up.
+func Init(resourceType string) (FileShareDriver, error) {
+	factory, exist := fileShareDrivers[resourceType]
+	if !exist {
+		var types []string
+		for k := range fileShareDrivers {
+			types = append(types, k)
+		}
+		sort.Strings(types)
+		return nil, fmt.Errorf("fileshare driver %q is not registered, registered drivers: %v", resourceType, types)
+	}
+	f := factory()
+	if err := f.Setup(); err != nil {
+		return nil, fmt.Errorf("set up fileshare driver %s failed: %v", resourceType, err)
+	}
+	return f, nil
 }
 
 // Clean
 func Clean(f FileShareDriver) FileShareDriver {
-	// Execute different clean operations according to the FileShareDriver type.
-	switch f.(type) {
-	case *nfs.Driver:
-		break
-	case *sample.Driver:
-		break
-	default:
-		break
-	}
 	_ = f.Unset()
 	f = nil
 
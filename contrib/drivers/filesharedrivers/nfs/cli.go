@@ -185,6 +185,35 @@ func (c *Cli) Delete(name, vg string) error {
 	return nil
 }
 
+// CreateLvSnapshot creates a read-only snapshot of the logical volume backing
+// the file share.
+func (c *Cli) CreateLvSnapshot(name, sourceLvName, vg string, size int64) error {
+	cmd := []string{
+		"env", "LC_ALL=C",
+		"lvcreate",
+		"-n", name,
+		"-L", sizeStr(size),
+		"-p", "r",
+		"-s", path.Join(vg, sourceLvName),
+	}
+	if _, err := c.execute(cmd...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ExportFs re-exports all the directories in the exports files, so that the
+// exports of the file shares take effect.
+func (c *Cli) ExportFs() error {
+	cmd := []string{
+		"env", "LC_ALL=C",
+		"exportfs",
+		"-ra",
+	}
+	_, err := c.execute(cmd...)
+	return err
+}
+
 type VolumeGroup struct {
 	Name          string
 	TotalCapacity int64
@@ -0,0 +1,158 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nfs
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/opensds/opensds/pkg/utils/constants"
+)
+
+const (
+	defaultExportsDir = "/etc/exports.d"
+	exportsFilePrefix = "opensds-"
+	exportsFileSuffix = ".exports"
+	// Every export is preceded by a comment naming the acl it comes from, so
+	// that the acl can be revoked without touching the others.
+	aclMarker     = "# opensds acl "
+	exportOptions = "sync,no_subtree_check"
+)
+
+// exportsLock serializes the updates of the exports files, the acls of a
+// file share may be created or deleted concurrently.
+var exportsLock sync.Mutex
+
+// exportEntry is the export of the file share rendered from an acl.
+type exportEntry struct {
+	AclId string
+	Line  string
+}
+
+// exportsPath returns the path of the exports file of the file share.
+func exportsPath(dir, fileshareId string) string {
+	return path.Join(dir, exportsFilePrefix+fileshareId+exportsFileSuffix)
+}
+
+// renderExport renders the acl into an export line of the directory, the
+// clients are granted the read-write access only if the acl has the Write
+// capability.
+func renderExport(dir, aclType string, accessTo, capabilities []string) (string, error) {
+	if aclType != constants.IpAccess {
+		return "", fmt.Errorf("unsupported acl type: %s", aclType)
+	}
+	if len(accessTo) == 0 {
+		return "", fmt.Errorf("no client is specified in acl")
+	}
+
+	var access = "ro"
+	for _, c := range capabilities {
+		if c == constants.Write {
+			access = "rw"
+		}
+	}
+
+	var fields = []string{dir}
+	for _, client := range accessTo {
+		if net.ParseIP(client) == nil {
+			if _, _, err := net.ParseCIDR(client); err != nil {
+				return "", fmt.Errorf("invalid client in acl: %s", client)
+			}
+		}
+		fields = append(fields, fmt.Sprintf("%s(%s,%s)", client, access, exportOptions))
+	}
+	return strings.Join(fields, " "), nil
+}
+
+// readExports reads the exports of the file share, nothing is exported if
+// the file doesn't exist.
+func readExports(file string) ([]exportEntry, error) {
+	f, err := os.Open(file)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var entries []exportEntry
+	var aclId string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case strings.HasPrefix(line, aclMarker):
+			aclId = strings.TrimSpace(strings.TrimPrefix(line, aclMarker))
+		case line == "" || strings.HasPrefix(line, "#"):
+		case aclId != "":
+			entries = append(entries, exportEntry{AclId: aclId, Line: line})
+			aclId = ""
+		}
+	}
+	return entries, scanner.Err()
+}
+
+// writeExports replaces the exports of the file share, the file is removed
+// if nothing is exported any more.
+func writeExports(file string, entries []exportEntry) error {
+	if len(entries) == 0 {
+		if err := os.Remove(file); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+
+	var buf strings.Builder
+	for _, e := range entries {
+		fmt.Fprintf(&buf, "%s%s\n%s\n", aclMarker, e.AclId, e.Line)
+	}
+	if err := os.MkdirAll(path.Dir(file), 0755); err != nil {
+		return err
+	}
+	// Write to a temporary file first so that exportfs never reads a
+	// partially written file.
+	tmp := file + ".tmp"
+	if err := ioutil.WriteFile(tmp, []byte(buf.String()), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, file)
+}
+
+// setExport adds or replaces the export of the acl, an empty line removes it.
+func setExport(entries []exportEntry, aclId, line string) []exportEntry {
+	var result []exportEntry
+	var found bool
+	for _, e := range entries {
+		if e.AclId != aclId {
+			result = append(result, e)
+			continue
+		}
+		found = true
+		if line != "" {
+			result = append(result, exportEntry{AclId: aclId, Line: line})
+		}
+	}
+	if !found && line != "" {
+		result = append(result, exportEntry{AclId: aclId, Line: line})
+	}
+	return result
+}
@@ -15,10 +15,14 @@
 package nfs
 
 import (
-	"path"
+	"errors"
 	"fmt"
+	"path"
+	"reflect"
+	"strings"
 
 	log "github.com/golang/glog"
+	"github.com/opensds/opensds/contrib/drivers/filesharedrivers"
 	. "github.com/opensds/opensds/contrib/drivers/utils/config"
 	"github.com/opensds/opensds/pkg/model"
 	pb "github.com/opensds/opensds/pkg/model/proto"
@@ -26,6 +30,10 @@ import (
 	"github.com/satori/go.uuid"
 )
 
+func init() {
+	filesharedrivers.RegisterFileShareDriver(NFSDriverType, func() filesharedrivers.FileShareDriver { return &Driver{} })
+}
+
 const (
 	defaultTgtConfDir = "/etc/tgt/conf.d"
 	defaultTgtBindIp  = "127.0.0.1"
@@ -49,6 +57,7 @@ const (
 type NFSConfig struct {
 	TgtBindIp      string                    `yaml:"tgtBindIp"`
 	TgtConfDir     string                    `yaml:"tgtConfDir"`
+	ExportsDir     string                    `yaml:"exportsDir"`
 	EnableChapAuth bool                      `yaml:"enableChapAuth"`
 	Pool           map[string]PoolProperties `yaml:"pool,flow"`
 }
@@ -60,7 +69,11 @@ type Driver struct {
 
 func (d *Driver) Setup() error {
 	// Read lvm config file
-	d.conf = &NFSConfig{TgtBindIp: defaultTgtBindIp, TgtConfDir: defaultTgtConfDir}
+	d.conf = &NFSConfig{
+		TgtBindIp:  defaultTgtBindIp,
+		TgtConfDir: defaultTgtConfDir,
+		ExportsDir: defaultExportsDir,
+	}
 	//p := config.CONF.OsdsDock.Nfs_Back.NFSNative.ConfigPath
   p := config.CONF.OsdsDock.Backends.NFS.ConfigPath
 	if "" == p {
@@ -93,7 +106,7 @@ func (d *Driver) CreateFileShare(opt *pb.CreateFileShareOpts) (fshare *model.Fil
 	fmt.Println("namee == ",name)
 	fmt.Println("vg == ",vg)
 	// Crete a directory to mount
-	var dirName = path.Join("/var/", name)
+	var dirName = shareDir(name)
 	// create a fileshare path
 	var lvPath = path.Join("/dev", vg, name)
 	if err = d.cli.CreateVolume(name, vg, opt.GetSize()); err != nil {
@@ -142,10 +155,11 @@ func (d *Driver) CreateFileShare(opt *pb.CreateFileShareOpts) (fshare *model.Fil
 		Description:      opt.GetDescription(),
 		AvailabilityZone: opt.GetAvailabilityZone(),
 		PoolId:           vg,
-		ExportLocations:  location,
+		ExportLocations:  []string{location},
 		Metadata: map[string]string{
 			KFileshareName: name,
 			KFileshareID:   "123",
+			KLvPath:        lvPath,
 		},
 	}
 	return ffshare, nil
@@ -208,3 +222,150 @@ func (d *Driver) DeleteFileShare(opt *pb.DeleteFileShareOpts) (fshare *model.Fil
   */
 	return nil, nil
 }
+
+// CreateFileShareSnapshot takes a read-only LVM snapshot of the logical volume
+// backing the file share, which is found by the lvPath in the metadata of the
+// file share.
+func (d *Driver) CreateFileShareSnapshot(opt *pb.CreateFileShareSnapshotOpts) (*model.FileShareSnapshotSpec, error) {
+	var snapName = snapshotPrefix + opt.GetId()
+
+	lvPath, ok := opt.GetMetadata()[KLvPath]
+	if !ok {
+		err := errors.New("can't find 'lvPath' in file share metadata")
+		log.Error(err)
+		return nil, err
+	}
+
+	fields := strings.Split(lvPath, "/")
+	if len(fields) != 4 {
+		err := fmt.Errorf("invalid 'lvPath' in file share metadata: %s", lvPath)
+		log.Error(err)
+		return nil, err
+	}
+	vg, sourceLvName := fields[2], fields[3]
+	if err := d.cli.CreateLvSnapshot(snapName, sourceLvName, vg, opt.GetSize()); err != nil {
+		log.Error("Failed to create file share snapshot:", err)
+		return nil, err
+	}
+
+	return &model.FileShareSnapshotSpec{
+		BaseModel: &model.BaseModel{
+			Id: opt.GetId(),
+		},
+		Name:         opt.GetName(),
+		Description:  opt.GetDescription(),
+		FileShareId:  opt.GetFileshareId(),
+		ShareSize:    opt.GetSize(),
+		SnapshotSize: opt.GetSize(),
+		Metadata: map[string]string{
+			KLvsPath: path.Join("/dev", vg, snapName),
+		},
+	}, nil
+}
+
+// DeleteFileShareSnapshot removes the LVM snapshot found by the lvsPath in the
+// metadata of the file share snapshot.
+func (d *Driver) DeleteFileShareSnapshot(opt *pb.DeleteFileShareSnapshotOpts) error {
+	lvsPath, ok := opt.GetMetadata()[KLvsPath]
+	if !ok {
+		err := errors.New("can't find 'lvsPath' in file share snapshot metadata")
+		log.Error(err)
+		return err
+	}
+
+	fields := strings.Split(lvsPath, "/")
+	if len(fields) != 4 {
+		err := fmt.Errorf("invalid 'lvsPath' in file share snapshot metadata: %s", lvsPath)
+		log.Error(err)
+		return err
+	}
+	vg, snapName := fields[2], fields[3]
+	if !d.cli.Exists(snapName) {
+		log.Warningf("Snapshot(%s) does not exist, nothing to remove", snapName)
+		return nil
+	}
+
+	if err := d.cli.Delete(snapName, vg); err != nil {
+		log.Error("Failed to remove file share snapshot:", err)
+		return err
+	}
+	return nil
+}
+
+// shareDir returns the directory where the file share is mounted and
+// exported from.
+func shareDir(name string) string {
+	return path.Join("/var/", name)
+}
+
+// CreateFileShareAcl exports the file share to the clients of the acl, which
+// is rendered into the exports file of the file share under the exports dir.
+func (d *Driver) CreateFileShareAcl(opt *pb.CreateFileShareAclOpts) (*model.FileShareAclSpec, error) {
+	name, ok := opt.GetMetadata()[KFileshareName]
+	if !ok {
+		err := errors.New("can't find 'lvmFileshareName' in file share metadata")
+		log.Error(err)
+		return nil, err
+	}
+
+	line, err := renderExport(shareDir(name), opt.GetType(), opt.GetAccessTo(), opt.GetAccessCapability())
+	if err != nil {
+		log.Error("Failed to render file share acl:", err)
+		return nil, err
+	}
+	if err := d.updateExports(opt.GetFileshareId(), opt.GetId(), line); err != nil {
+		log.Error("Failed to export file share:", err)
+		return nil, err
+	}
+
+	return &model.FileShareAclSpec{
+		BaseModel: &model.BaseModel{
+			Id: opt.GetId(),
+		},
+		FileShareId:      opt.GetFileshareId(),
+		Type:             opt.GetType(),
+		AccessTo:         opt.GetAccessTo(),
+		AccessCapability: opt.GetAccessCapability(),
+		Description:      opt.GetDescription(),
+	}, nil
+}
+
+// DeleteFileShareAcl revokes the access of the clients of the acl by removing
+// its export from the exports file of the file share.
+func (d *Driver) DeleteFileShareAcl(opt *pb.DeleteFileShareAclOpts) error {
+	if err := d.updateExports(opt.GetFileshareId(), opt.GetId(), ""); err != nil {
+		log.Error("Failed to unexport file share:", err)
+		return err
+	}
+	return nil
+}
+
+// updateExports sets the export of the acl in the exports file of the file
+// share and reloads the exports. The file is restored if the exports can't
+// be reloaded, an empty line removes the export.
+func (d *Driver) updateExports(fileshareId, aclId, line string) error {
+	exportsLock.Lock()
+	defer exportsLock.Unlock()
+
+	file := exportsPath(d.conf.ExportsDir, fileshareId)
+	entries, err := readExports(file)
+	if err != nil {
+		return err
+	}
+	updated := setExport(entries, aclId, line)
+	if reflect.DeepEqual(updated, entries) {
+		log.Infof("Exports of file share(%s) are not changed", fileshareId)
+		return nil
+	}
+
+	if err := writeExports(file, updated); err != nil {
+		return err
+	}
+	if err := d.cli.ExportFs(); err != nil {
+		if err := writeExports(file, entries); err != nil {
+			log.Errorf("Failed to restore exports file %s: %v", file, err)
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,312 @@
+// Copyright (c) 2017 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+//    Licensed under the Apache License, Version 2.0 (the "License"); you may
+//    not use this file except in compliance with the License. You may obtain
+//    a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//    WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//    License for the specific language governing permissions and limitations
+//    under the License.
+
+package nfs
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/opensds/opensds/pkg/model"
+	pb "github.com/opensds/opensds/pkg/model/proto"
+	"github.com/opensds/opensds/pkg/utils/exec"
+)
+
+type FakeResp struct {
+	out string
+	err error
+}
+
+func NewFakeExecuter(respMap map[string]*FakeResp) *FakeExecuter {
+	return &FakeExecuter{RespMap: respMap}
+}
+
+// FakeExecuter returns the response of the command and records the commands
+// it has run.
+type FakeExecuter struct {
+	RespMap map[string]*FakeResp
+	Cmds    []string
+}
+
+func (f *FakeExecuter) Run(name string, args ...string) (string, error) {
+	var cmd = name
+	if name == "env" {
+		cmd = args[1]
+	}
+	f.Cmds = append(f.Cmds, strings.Join(append([]string{name}, args...), " "))
+	v, ok := f.RespMap[cmd]
+	if !ok {
+		return "", fmt.Errorf("can find specified op: %s", args[1])
+	}
+	return v.out, v.err
+}
+
+func newFakeDriver(e exec.Executer) *Driver {
+	return &Driver{
+		conf: &NFSConfig{},
+		cli:  &Cli{RootExecuter: e, BaseExecuter: e},
+	}
+}
+
+func TestCreateFileShareSnapshot(t *testing.T) {
+	e := NewFakeExecuter(map[string]*FakeResp{
+		"lvcreate": {"", nil},
+	})
+	fd := newFakeDriver(e)
+
+	opt := &pb.CreateFileShareSnapshotOpts{
+		Id:          "3769855c-a102-11e7-b772-17b880d2f537",
+		Name:        "snap001",
+		Description: "file share snapshot for testing",
+		FileshareId: "d2975ebe-d82c-430f-b28e-f373746a71ca",
+		Size:        int64(1),
+		Metadata: map[string]string{
+			"lvPath": "/dev/opensds-files-default/share001",
+		},
+	}
+	var expected = &model.FileShareSnapshotSpec{
+		BaseModel:    &model.BaseModel{Id: "3769855c-a102-11e7-b772-17b880d2f537"},
+		Name:         "snap001",
+		Description:  "file share snapshot for testing",
+		FileShareId:  "d2975ebe-d82c-430f-b28e-f373746a71ca",
+		ShareSize:    int64(1),
+		SnapshotSize: int64(1),
+		Metadata: map[string]string{
+			"lvsPath": "/dev/opensds-files-default/_snapshot-3769855c-a102-11e7-b772-17b880d2f537",
+		},
+	}
+	snp, err := fd.CreateFileShareSnapshot(opt)
+	if err != nil {
+		t.Fatal("Failed to create file share snapshot:", err)
+	}
+	if !reflect.DeepEqual(snp, expected) {
+		t.Errorf("Expected %+v, got %+v\n", expected, snp)
+	}
+	if len(e.Cmds) != 1 || !strings.HasSuffix(e.Cmds[0], "-s opensds-files-default/share001") {
+		t.Errorf("Expected a snapshot of the logic volume, got %v", e.Cmds)
+	}
+
+	delete(opt.Metadata, "lvPath")
+	if _, err = fd.CreateFileShareSnapshot(opt); err == nil {
+		t.Error("Expected an error when there is no lvPath")
+	}
+}
+
+func TestDeleteFileShareSnapshot(t *testing.T) {
+	lvsResp := `  _snapshot-3769855c-a102-11e7-b772-17b880d2f537
+  share001
+`
+	e := NewFakeExecuter(map[string]*FakeResp{
+		"lvs":      {lvsResp, nil},
+		"lvremove": {"", nil},
+	})
+	fd := newFakeDriver(e)
+
+	opt := &pb.DeleteFileShareSnapshotOpts{
+		Id: "3769855c-a102-11e7-b772-17b880d2f537",
+		Metadata: map[string]string{
+			"lvsPath": "/dev/opensds-files-default/_snapshot-3769855c-a102-11e7-b772-17b880d2f537",
+		},
+	}
+	if err := fd.DeleteFileShareSnapshot(opt); err != nil {
+		t.Fatal("Failed to delete file share snapshot:", err)
+	}
+	if len(e.Cmds) != 2 || !strings.Contains(e.Cmds[1], "lvremove") {
+		t.Errorf("Expected the snapshot to be removed, got %v", e.Cmds)
+	}
+
+	// Nothing is removed if the snapshot is gone.
+	opt.Metadata["lvsPath"] = "/dev/opensds-files-default/_snapshot-unknown"
+	e.Cmds = nil
+	if err := fd.DeleteFileShareSnapshot(opt); err != nil {
+		t.Fatal("Failed to delete file share snapshot:", err)
+	}
+	if len(e.Cmds) != 1 {
+		t.Errorf("Expected no logic volume to be removed, got %v", e.Cmds)
+	}
+}
+
+func TestCreateFileShareAcl(t *testing.T) {
+	dir, err := ioutil.TempDir("", "exports")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	e := NewFakeExecuter(map[string]*FakeResp{
+		"exportfs": {"", nil},
+	})
+	fd := newFakeDriver(e)
+	fd.conf.ExportsDir = dir
+
+	opt := &pb.CreateFileShareAclOpts{
+		Id:               "d2975ebe-d82c-430f-b28e-f373746a71ca",
+		FileshareId:      "1e643aca-4922-4b1a-bb98-4245054aeff4",
+		Type:             "ip",
+		AccessTo:         []string{"10.0.0.0/24", "192.168.1.10"},
+		AccessCapability: []string{"Read", "Write"},
+		Metadata: map[string]string{
+			"lvmFileshareName": "share001",
+		},
+	}
+	var expected = &model.FileShareAclSpec{
+		BaseModel:        &model.BaseModel{Id: "d2975ebe-d82c-430f-b28e-f373746a71ca"},
+		FileShareId:      "1e643aca-4922-4b1a-bb98-4245054aeff4",
+		Type:             "ip",
+		AccessTo:         []string{"10.0.0.0/24", "192.168.1.10"},
+		AccessCapability: []string{"Read", "Write"},
+	}
+	acl, err := fd.CreateFileShareAcl(opt)
+	if err != nil {
+		t.Fatal("Failed to create file share acl:", err)
+	}
+	if !reflect.DeepEqual(acl, expected) {
+		t.Errorf("Expected %+v, got %+v\n", expected, acl)
+	}
+
+	// A read-only acl is appended to the exports of the same file share.
+	opt2 := &pb.CreateFileShareAclOpts{
+		Id:               "3769855c-a102-11e7-b772-17b880d2f537",
+		FileshareId:      opt.FileshareId,
+		Type:             "ip",
+		AccessTo:         []string{"10.0.1.0/24"},
+		AccessCapability: []string{"Read"},
+		Metadata:         opt.Metadata,
+	}
+	if _, err = fd.CreateFileShareAcl(opt2); err != nil {
+		t.Fatal("Failed to create file share acl:", err)
+	}
+
+	file := path.Join(dir, "opensds-1e643aca-4922-4b1a-bb98-4245054aeff4.exports")
+	content, err := ioutil.ReadFile(file)
+	if err != nil {
+		t.Fatal("Failed to read exports file:", err)
+	}
+	expectedContent := `# opensds acl d2975ebe-d82c-430f-b28e-f373746a71ca
+/var/share001 10.0.0.0/24(rw,sync,no_subtree_check) 192.168.1.10(rw,sync,no_subtree_check)
+# opensds acl 3769855c-a102-11e7-b772-17b880d2f537
+/var/share001 10.0.1.0/24(ro,sync,no_subtree_check)
+`
+	if string(content) != expectedContent {
+		t.Errorf("Expected exports file:\n%s\ngot:\n%s", expectedContent, content)
+	}
+	if len(e.Cmds) != 2 || !strings.HasSuffix(e.Cmds[1], "exportfs -ra") {
+		t.Errorf("Expected the exports to be reloaded, got %v", e.Cmds)
+	}
+
+	// Invalid clients are never written into the exports file.
+	opt2.AccessTo = []string{"10.0.1.0/24(rw) *"}
+	if _, err = fd.CreateFileShareAcl(opt2); err == nil {
+		t.Error("Expected an error when the client is invalid")
+	}
+}
+
+func TestCreateFileShareAclExportFsFailed(t *testing.T) {
+	dir, err := ioutil.TempDir("", "exports")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	e := NewFakeExecuter(map[string]*FakeResp{
+		"exportfs": {"", errors.New("exportfs failed")},
+	})
+	fd := newFakeDriver(e)
+	fd.conf.ExportsDir = dir
+
+	opt := &pb.CreateFileShareAclOpts{
+		Id:               "d2975ebe-d82c-430f-b28e-f373746a71ca",
+		FileshareId:      "1e643aca-4922-4b1a-bb98-4245054aeff4",
+		Type:             "ip",
+		AccessTo:         []string{"10.0.0.0/24"},
+		AccessCapability: []string{"Read"},
+		Metadata: map[string]string{
+			"lvmFileshareName": "share001",
+		},
+	}
+	if _, err = fd.CreateFileShareAcl(opt); err == nil {
+		t.Fatal("Expected an error when exportfs fails")
+	}
+	// The exports file is restored, which is removed as nothing is exported.
+	file := path.Join(dir, "opensds-1e643aca-4922-4b1a-bb98-4245054aeff4.exports")
+	if _, err = os.Stat(file); !os.IsNotExist(err) {
+		t.Errorf("Expected no exports file, got %v", err)
+	}
+}
+
+func TestDeleteFileShareAcl(t *testing.T) {
+	dir, err := ioutil.TempDir("", "exports")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	file := path.Join(dir, "opensds-1e643aca-4922-4b1a-bb98-4245054aeff4.exports")
+	content := `# opensds acl d2975ebe-d82c-430f-b28e-f373746a71ca
+/var/share001 10.0.0.0/24(rw,sync,no_subtree_check)
+# opensds acl 3769855c-a102-11e7-b772-17b880d2f537
+/var/share001 10.0.1.0/24(ro,sync,no_subtree_check)
+`
+	if err = ioutil.WriteFile(file, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	e := NewFakeExecuter(map[string]*FakeResp{
+		"exportfs": {"", nil},
+	})
+	fd := newFakeDriver(e)
+	fd.conf.ExportsDir = dir
+
+	opt := &pb.DeleteFileShareAclOpts{
+		Id:          "d2975ebe-d82c-430f-b28e-f373746a71ca",
+		FileshareId: "1e643aca-4922-4b1a-bb98-4245054aeff4",
+	}
+	if err = fd.DeleteFileShareAcl(opt); err != nil {
+		t.Fatal("Failed to delete file share acl:", err)
+	}
+	got, err := ioutil.ReadFile(file)
+	if err != nil {
+		t.Fatal("Failed to read exports file:", err)
+	}
+	expectedContent := `# opensds acl 3769855c-a102-11e7-b772-17b880d2f537
+/var/share001 10.0.1.0/24(ro,sync,no_subtree_check)
+`
+	if string(got) != expectedContent {
+		t.Errorf("Expected exports file:\n%s\ngot:\n%s", expectedContent, got)
+	}
+
+	// Nothing is reloaded if the acl is gone.
+	e.Cmds = nil
+	if err = fd.DeleteFileShareAcl(opt); err != nil {
+		t.Fatal("Failed to delete file share acl:", err)
+	}
+	if len(e.Cmds) != 0 {
+		t.Errorf("Expected no command to be run, got %v", e.Cmds)
+	}
+
+	// The exports file is removed with the last acl.
+	opt.Id = "3769855c-a102-11e7-b772-17b880d2f537"
+	if err = fd.DeleteFileShareAcl(opt); err != nil {
+		t.Fatal("Failed to delete file share acl:", err)
+	}
+	if _, err = os.Stat(file); !os.IsNotExist(err) {
+		t.Errorf("Expected no exports file, got %v", err)
+	}
+}
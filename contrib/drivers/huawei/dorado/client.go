@@ -312,6 +312,13 @@ func (c *DoradoClient) ExtendVolume(size int64, id string) error {
 	return err
 }
 
+func (c *DoradoClient) RenameVolume(id, name string) error {
+	data := map[string]interface{}{
+		"NAME": name,
+	}
+	return c.request("PUT", "/lun/"+id, data, nil)
+}
+
 func (c *DoradoClient) CheckLunExist(id, wwn string) bool {
 	lun := &LunResp{}
 	err := c.request("GET", "/lun/"+id, nil, lun)
@@ -345,8 +352,30 @@ func (c *DoradoClient) GetSnapshot(id string) (*Snapshot, error) {
 
 func (c *DoradoClient) GetSnapshotByName(name string) (*Snapshot, error) {
 	snap := &SnapshotsResp{}
-	err := c.request("GET", "/snapshot?filter=NAME::"+name, nil, snap)
-	return &snap.Data[0], err
+	if err := c.request("GET", "/snapshot?filter=NAME::"+name, nil, snap); err != nil {
+		return nil, err
+	}
+	if len(snap.Data) == 0 {
+		return nil, &NotFoundError{name: name}
+	}
+	return &snap.Data[0], nil
+}
+
+func (c *DoradoClient) RenameSnapshot(id, name string) error {
+	data := map[string]interface{}{
+		"NAME": name,
+	}
+	return c.request("PUT", "/snapshot/"+id, data, nil)
+}
+
+// RollbackSnapshot starts rolling the source lun back to the snapshot, the
+// progress can be checked by the running status of the snapshot.
+func (c *DoradoClient) RollbackSnapshot(id, speed string) error {
+	data := map[string]interface{}{
+		"ID":            id,
+		"ROLLBACKSPEED": speed,
+	}
+	return c.request("PUT", "/snapshot/rollback", data, nil)
 }
 
 func (c *DoradoClient) DeleteSnapshot(id string) error {
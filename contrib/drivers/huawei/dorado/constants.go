@@ -24,11 +24,21 @@ const (
 	LunCopySpeedHigest = "4"
 )
 
+const (
+	SnapshotRollbackSpeedLow     = "1"
+	SnapshotRollbackSpeedMedium  = "2"
+	SnapshotRollbackSpeedHigh    = "3"
+	SnapshotRollbackSpeedHighest = "4"
+)
+
 const (
 	LunReadyWaitInterval = 2 * time.Second
 	LunReadyWaitTimeout  = 20 * time.Second
 	LunCopyWaitInterval  = 2 * time.Second
 	LunCopyWaitTimeout   = 200 * time.Second
+
+	SnapshotRollbackWaitInterval = 2 * time.Second
+	SnapshotRollbackWaitTimeout  = 600 * time.Second
 )
 
 const (
@@ -59,6 +69,8 @@ const (
 	StatusLunCopyNotStart = "36"
 	StatusQosActive       = "2"
 	StatusQosInactive     = "45"
+
+	StatusSnapshotRollingBack = "44"
 )
 
 // Array type
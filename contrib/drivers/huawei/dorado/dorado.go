@@ -21,6 +21,7 @@ import (
 	"strings"
 
 	log "github.com/golang/glog"
+	"github.com/opensds/opensds/contrib/drivers"
 	. "github.com/opensds/opensds/contrib/drivers/utils/config"
 	"github.com/opensds/opensds/pkg/model"
 	pb "github.com/opensds/opensds/pkg/model/proto"
@@ -29,6 +30,11 @@ import (
 	uuid "github.com/satori/go.uuid"
 )
 
+func init() {
+	drivers.RegisterVolumeDriver(HuaweiDoradoDriverType, func() drivers.VolumeDriver { return &Driver{} },
+		drivers.Capability{Replication: true})
+}
+
 type Driver struct {
 	conf   *DoradoConfig
 	client *DoradoClient
@@ -69,6 +75,31 @@ func (d *Driver) createVolumeFromSnapshot(opt *pb.CreateVolumeOpts) (*model.Volu
 		log.Infof("Get Snapshot failed : %v", e1)
 		return nil, e1
 	}
+	log.Infof("Create Volume from snapshot, source_snapshot_id : %s", snapshot.Id)
+	return d.createVolumeByLunCopy(opt, snapshot.Id)
+}
+
+// CloneVolume creates a new lun and copies the data of the source lun into
+// it through lun copy.
+func (d *Driver) CloneVolume(opt *pb.CreateVolumeOpts) (*model.VolumeSpec, error) {
+	metadata := opt.GetMetadata()
+	if metadata["hypermetro"] == "true" && metadata["replication_enabled"] == "true" {
+		msg := "Hypermetro and Replication can not be used in the same volume_type"
+		log.Error(msg)
+		return nil, errors.New(msg)
+	}
+	srcLun, err := d.client.GetVolumeByName(EncodeName(opt.GetSourceVolumeId()))
+	if err != nil {
+		log.Infof("Get source volume failed : %v", err)
+		return nil, err
+	}
+	log.Infof("Clone Volume, source_lun_id : %s", srcLun.Id)
+	return d.createVolumeByLunCopy(opt, srcLun.Id)
+}
+
+// createVolumeByLunCopy creates a new lun and copies the data of the source
+// lun or snapshot specified by srcId into it.
+func (d *Driver) createVolumeByLunCopy(opt *pb.CreateVolumeOpts, srcId string) (*model.VolumeSpec, error) {
 	volumeDesc := TruncateDescription(opt.GetDescription())
 	poolId, err1 := d.client.GetPoolIdByName(opt.GetPoolName())
 	if err1 != nil {
@@ -82,7 +113,7 @@ func (d *Driver) createVolumeFromSnapshot(opt *pb.CreateVolumeOpts) (*model.Volu
 		return nil, err
 	}
 
-	log.Infof("Create Volume from snapshot, source_lun_id : %s , target_lun_id : %s", snapshot.Id, lun.Id)
+	log.Infof("Create Volume by lun copy, source_id : %s , target_lun_id : %s", srcId, lun.Id)
 	err = utils.WaitForCondition(func() (bool, error) {
 		getVolumeResult, getVolumeErr := d.client.GetVolume(lun.Id)
 		if nil == getVolumeErr {
@@ -102,7 +133,7 @@ func (d *Driver) createVolumeFromSnapshot(opt *pb.CreateVolumeOpts) (*model.Volu
 		d.client.DeleteVolume(lun.Id)
 		return nil, err
 	}
-	err = d.copyVolume(opt, snapshot.Id, lun.Id)
+	err = d.copyVolume(opt, srcId, lun.Id)
 	if err != nil {
 		d.client.DeleteVolume(lun.Id)
 		return nil, err
@@ -187,8 +218,8 @@ func (d *Driver) CreateVolume(opt *pb.CreateVolumeOpts) (*model.VolumeSpec, erro
 	}, nil
 }
 
-func (d *Driver) PullVolume(volID string) (*model.VolumeSpec, error) {
-	name := EncodeName(volID)
+func (d *Driver) PullVolume(opt *pb.PullVolumeOpts) (*model.VolumeSpec, error) {
+	name := EncodeName(opt.GetId())
 	lun, err := d.client.GetVolumeByName(name)
 	if err != nil {
 		return nil, err
@@ -196,7 +227,7 @@ func (d *Driver) PullVolume(volID string) (*model.VolumeSpec, error) {
 
 	return &model.VolumeSpec{
 		BaseModel: &model.BaseModel{
-			Id: volID,
+			Id: opt.GetId(),
 		},
 		Size:             Sector2Gb(lun.Capacity),
 		Description:      lun.Description,
@@ -204,6 +235,55 @@ func (d *Driver) PullVolume(volID string) (*model.VolumeSpec, error) {
 	}, nil
 }
 
+// ManageVolume takes over the LUN whose id is the identifier, it's renamed
+// like the ones created by opensds.
+func (d *Driver) ManageVolume(opt *pb.ManageVolumeOpts) (*model.VolumeSpec, error) {
+	lun, err := d.client.GetVolume(opt.GetIdentifier())
+	if err != nil {
+		log.Errorf("Get LUN %s failed: %v", opt.GetIdentifier(), err)
+		return nil, err
+	}
+	if lun.ParentName != opt.GetPoolName() {
+		err := fmt.Errorf("LUN %s is not in pool %s", opt.GetIdentifier(), opt.GetPoolName())
+		log.Error(err)
+		return nil, err
+	}
+	if err := d.client.RenameVolume(lun.Id, EncodeName(opt.GetId())); err != nil {
+		log.Errorf("Rename LUN %s failed: %v", lun.Id, err)
+		return nil, err
+	}
+
+	log.Infof("Manage LUN %s as volume %s success.", lun.Id, opt.GetId())
+	return &model.VolumeSpec{
+		BaseModel: &model.BaseModel{
+			Id: opt.GetId(),
+		},
+		Name:        opt.GetName(),
+		Size:        Sector2Gb(lun.Capacity),
+		Description: opt.GetDescription(),
+		Metadata: map[string]string{
+			KLunId:             lun.Id,
+			KManagedVolumeName: lun.Name,
+		},
+	}, nil
+}
+
+// UnmanageVolume gives the LUN its original name back if it was taken over
+// from the array.
+func (d *Driver) UnmanageVolume(opt *pb.UnmanageVolumeOpts) error {
+	name, ok := opt.GetMetadata()[KManagedVolumeName]
+	if !ok {
+		return nil
+	}
+	lunId := opt.GetMetadata()[KLunId]
+	if err := d.client.RenameVolume(lunId, name); err != nil {
+		log.Errorf("Rename LUN %s failed: %v", lunId, err)
+		return err
+	}
+	log.Info("Unmanage volume success, volume id =", opt.GetId())
+	return nil
+}
+
 func (d *Driver) DeleteVolume(opt *pb.DeleteVolumeOpts) error {
 	lunId := opt.GetMetadata()[KLunId]
 	err := d.client.DeleteVolume(lunId)
@@ -236,6 +316,51 @@ func (d *Driver) ExtendVolume(opt *pb.ExtendVolumeOpts) (*model.VolumeSpec, erro
 	}, nil
 }
 
+func (d *Driver) MigrateVolume(opt *pb.MigrateVolumeOpts) (*model.VolumeSpec, error) {
+	return nil, &model.NotImplementError{S: "method MigrateVolume has not been implemented yet"}
+}
+
+// RevertVolumeToSnapshot rolls the lun back to the snapshot and waits until
+// the rollback completes.
+func (d *Driver) RevertVolumeToSnapshot(opt *pb.RevertVolumeToSnapshotOpts) error {
+	snapId, ok := opt.GetSnapshotMetadata()[KSnapId]
+	if !ok {
+		snap, err := d.client.GetSnapshotByName(EncodeName(opt.GetSnapshotId()))
+		if err != nil {
+			log.Errorf("Get snapshot %s failed: %v", opt.GetSnapshotId(), err)
+			return model.NewPreconditionError(err.Error())
+		}
+		snapId = snap.Id
+	}
+
+	// The lun isn't changed if the array refuses to start the rollback.
+	if err := d.client.RollbackSnapshot(snapId, SnapshotRollbackSpeedMedium); err != nil {
+		log.Errorf("Rollback snapshot %s failed: %v", snapId, err)
+		return model.NewPreconditionError(err.Error())
+	}
+
+	err := utils.WaitForCondition(func() (bool, error) {
+		snap, err := d.client.GetSnapshot(snapId)
+		if err != nil {
+			return false, err
+		}
+		if snap.HealthStatus != StatusHealth {
+			return false, fmt.Errorf("snapshot %s is unhealthy while rolling back, health status: %s",
+				snapId, snap.HealthStatus)
+		}
+		log.V(5).Infof("Current snapshot RunningStatus : %s, RollbackRate : %s",
+			snap.RunningStatus, snap.RollbackRate)
+		return snap.RunningStatus != StatusSnapshotRollingBack, nil
+	}, SnapshotRollbackWaitInterval, SnapshotRollbackWaitTimeout)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+
+	log.Infof("Revert volume %s to snapshot %s success", opt.GetId(), opt.GetSnapshotId())
+	return nil
+}
+
 func (d *Driver) getTargetInfo() (string, string, error) {
 	tgtIp := d.conf.TargetIp
 	resp, err := d.client.ListTgtPort()
@@ -459,15 +584,18 @@ func (d *Driver) CreateSnapshot(opt *pb.CreateVolumeSnapshotOpts) (*model.Volume
 	}, nil
 }
 
-func (d *Driver) PullSnapshot(id string) (*model.VolumeSnapshotSpec, error) {
-	name := EncodeName(id)
+func (d *Driver) PullSnapshot(opt *pb.PullVolumeSnapshotOpts) (*model.VolumeSnapshotSpec, error) {
+	name := EncodeName(opt.GetId())
 	snap, err := d.client.GetSnapshotByName(name)
+	if IsNotFoundError(err) {
+		return nil, model.NewNotFoundError(err.Error())
+	}
 	if err != nil {
 		return nil, err
 	}
 	return &model.VolumeSnapshotSpec{
 		BaseModel: &model.BaseModel{
-			Id: snap.Id,
+			Id: opt.GetId(),
 		},
 		Name:        snap.Name,
 		Description: snap.Description,
@@ -476,6 +604,57 @@ func (d *Driver) PullSnapshot(id string) (*model.VolumeSnapshotSpec, error) {
 	}, nil
 }
 
+// ManageSnapshot takes over the snapshot whose id is the identifier, which
+// must be taken from the LUN of the volume.
+func (d *Driver) ManageSnapshot(opt *pb.ManageVolumeSnapshotOpts) (*model.VolumeSnapshotSpec, error) {
+	snap, err := d.client.GetSnapshot(opt.GetIdentifier())
+	if err != nil {
+		log.Errorf("Get snapshot %s failed: %v", opt.GetIdentifier(), err)
+		return nil, err
+	}
+	lunId := opt.GetMetadata()[KLunId]
+	if snap.ParentId != lunId {
+		err := fmt.Errorf("snapshot %s is not taken from LUN %s", opt.GetIdentifier(), lunId)
+		log.Error(err)
+		return nil, err
+	}
+	if err := d.client.RenameSnapshot(snap.Id, EncodeName(opt.GetId())); err != nil {
+		log.Errorf("Rename snapshot %s failed: %v", snap.Id, err)
+		return nil, err
+	}
+
+	log.Infof("Manage snapshot %s as volume snapshot %s success.", snap.Id, opt.GetId())
+	return &model.VolumeSnapshotSpec{
+		BaseModel: &model.BaseModel{
+			Id: opt.GetId(),
+		},
+		Name:        opt.GetName(),
+		Description: opt.GetDescription(),
+		VolumeId:    opt.GetVolumeId(),
+		Size:        0,
+		Metadata: map[string]string{
+			KSnapId:              snap.Id,
+			KManagedSnapshotName: snap.Name,
+		},
+	}, nil
+}
+
+// UnmanageSnapshot gives the snapshot its original name back if it was taken
+// over from the array.
+func (d *Driver) UnmanageSnapshot(opt *pb.UnmanageVolumeSnapshotOpts) error {
+	name, ok := opt.GetMetadata()[KManagedSnapshotName]
+	if !ok {
+		return nil
+	}
+	id := opt.GetMetadata()[KSnapId]
+	if err := d.client.RenameSnapshot(id, name); err != nil {
+		log.Errorf("Rename snapshot %s failed: %v", id, err)
+		return err
+	}
+	log.Info("Unmanage volume snapshot success, volume snapshot id =", opt.GetId())
+	return nil
+}
+
 func (d *Driver) DeleteSnapshot(opt *pb.DeleteVolumeSnapshotOpts) error {
 	id := opt.GetMetadata()[KSnapId]
 	err := d.client.DeleteSnapshot(id)
@@ -809,6 +988,14 @@ func (d *Driver) clearHostRelatedResource(lunGrpId, viewId, hostId, hostGrpId st
 	return nil
 }
 
+func (d *Driver) ListVolumes(opt *pb.ListVolumesOpts) ([]*model.VolumeSpec, error) {
+	return nil, &model.NotImplementError{S: "method ListVolumes has not been implemented yet."}
+}
+
+func (d *Driver) ListSnapshots(opt *pb.ListVolumesOpts) ([]*model.VolumeSnapshotSpec, error) {
+	return nil, &model.NotImplementError{S: "method ListSnapshots has not been implemented yet."}
+}
+
 func (d *Driver) InitializeSnapshotConnection(opt *pb.CreateSnapshotAttachmentOpts) (*model.ConnectionInfo, error) {
 	return nil, &model.NotImplementError{S: "method InitializeSnapshotConnection has not been implemented yet."}
 }
@@ -828,3 +1015,11 @@ func (d *Driver) UpdateVolumeGroup(opt *pb.UpdateVolumeGroupOpts) (*model.Volume
 func (d *Driver) DeleteVolumeGroup(opt *pb.DeleteVolumeGroupOpts) error {
 	return &model.NotImplementError{"method DeleteVolumeGroup has not been implemented yet"}
 }
+
+func (d *Driver) CreateGroupSnapshot(opt *pb.CreateGroupSnapshotOpts) ([]*model.VolumeSnapshotSpec, error) {
+	return nil, &model.NotImplementError{S: "method CreateGroupSnapshot has not been implemented yet"}
+}
+
+func (d *Driver) DeleteGroupSnapshot(opt *pb.DeleteGroupSnapshotOpts) error {
+	return &model.NotImplementError{S: "method DeleteGroupSnapshot has not been implemented yet"}
+}
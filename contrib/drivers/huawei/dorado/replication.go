@@ -21,6 +21,7 @@ import (
 	"time"
 
 	log "github.com/golang/glog"
+	"github.com/opensds/opensds/contrib/drivers"
 	. "github.com/opensds/opensds/contrib/drivers/utils/config"
 	"github.com/opensds/opensds/pkg/model"
 	pb "github.com/opensds/opensds/pkg/model/proto"
@@ -28,6 +29,10 @@ import (
 	"github.com/opensds/opensds/pkg/utils/config"
 )
 
+func init() {
+	drivers.RegisterReplicationDriver(HuaweiDoradoDriverType, func() drivers.ReplicationDriver { return &ReplicationDriver{} })
+}
+
 // ReplicationDriver
 type ReplicationDriver struct {
 	conf *DoradoConfig
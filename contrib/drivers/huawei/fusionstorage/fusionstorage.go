@@ -21,6 +21,7 @@ import (
 	"strings"
 
 	log "github.com/golang/glog"
+	"github.com/opensds/opensds/contrib/drivers"
 	. "github.com/opensds/opensds/contrib/drivers/utils/config"
 	. "github.com/opensds/opensds/pkg/model"
 	pb "github.com/opensds/opensds/pkg/model/proto"
@@ -28,6 +29,11 @@ import (
 	uuid "github.com/satori/go.uuid"
 )
 
+func init() {
+	drivers.RegisterVolumeDriver(HuaweiFusionStorageDriverType, func() drivers.VolumeDriver { return &Driver{} },
+		drivers.Capability{})
+}
+
 type Driver struct {
 	cli  *FsCli
 	conf *Config
@@ -109,6 +115,10 @@ func (d *Driver) CreateVolume(opt *pb.CreateVolumeOpts) (*VolumeSpec, error) {
 	}, nil
 }
 
+func (d *Driver) CloneVolume(opt *pb.CreateVolumeOpts) (*VolumeSpec, error) {
+	return nil, &NotImplementError{S: "method CloneVolume has not been implemented yet"}
+}
+
 func (d *Driver) DeleteVolume(opt *pb.DeleteVolumeOpts) error {
 	name := EncodeName(opt.GetId())
 	err := d.cli.deleteVolume(name)
@@ -365,9 +375,8 @@ func (d *Driver) TerminateConnection(opt *pb.DeleteVolumeAttachmentOpts) error {
 	return nil
 }
 
-func (d *Driver) PullVolume(volIdentifier string) (*VolumeSpec, error) {
-	// Not used , do nothing
-	return nil, nil
+func (d *Driver) PullVolume(opt *pb.PullVolumeOpts) (*VolumeSpec, error) {
+	return nil, &NotImplementError{S: "method PullVolume has not been implemented yet."}
 }
 
 func (d *Driver) ExtendVolume(opt *pb.ExtendVolumeOpts) (*VolumeSpec, error) {
@@ -388,6 +397,22 @@ func (d *Driver) ExtendVolume(opt *pb.ExtendVolumeOpts) (*VolumeSpec, error) {
 	}, nil
 }
 
+func (d *Driver) MigrateVolume(opt *pb.MigrateVolumeOpts) (*VolumeSpec, error) {
+	return nil, &NotImplementError{S: "method MigrateVolume has not been implemented yet"}
+}
+
+func (d *Driver) RevertVolumeToSnapshot(opt *pb.RevertVolumeToSnapshotOpts) error {
+	return &NotImplementError{S: "method RevertVolumeToSnapshot has not been implemented yet"}
+}
+
+func (d *Driver) ManageVolume(opt *pb.ManageVolumeOpts) (*VolumeSpec, error) {
+	return nil, &NotImplementError{S: "method ManageVolume has not been implemented yet"}
+}
+
+func (d *Driver) UnmanageVolume(opt *pb.UnmanageVolumeOpts) error {
+	return &NotImplementError{S: "method UnmanageVolume has not been implemented yet"}
+}
+
 func (d *Driver) CreateSnapshot(opt *pb.CreateVolumeSnapshotOpts) (*VolumeSnapshotSpec, error) {
 	snapName := EncodeName(opt.GetId())
 	volName := EncodeName(opt.GetVolumeId())
@@ -409,8 +434,16 @@ func (d *Driver) CreateSnapshot(opt *pb.CreateVolumeSnapshotOpts) (*VolumeSnapsh
 	}, nil
 }
 
-func (d *Driver) PullSnapshot(snapIdentifier string) (*VolumeSnapshotSpec, error) {
-	return nil, nil
+func (d *Driver) PullSnapshot(opt *pb.PullVolumeSnapshotOpts) (*VolumeSnapshotSpec, error) {
+	return nil, &NotImplementError{S: "method PullSnapshot has not been implemented yet."}
+}
+
+func (d *Driver) ManageSnapshot(opt *pb.ManageVolumeSnapshotOpts) (*VolumeSnapshotSpec, error) {
+	return nil, &NotImplementError{S: "method ManageSnapshot has not been implemented yet"}
+}
+
+func (d *Driver) UnmanageSnapshot(opt *pb.UnmanageVolumeSnapshotOpts) error {
+	return &NotImplementError{S: "method UnmanageSnapshot has not been implemented yet"}
 }
 
 func (d *Driver) DeleteSnapshot(opt *pb.DeleteVolumeSnapshotOpts) error {
@@ -423,6 +456,14 @@ func (d *Driver) DeleteSnapshot(opt *pb.DeleteVolumeSnapshotOpts) error {
 	return nil
 }
 
+func (d *Driver) ListVolumes(opt *pb.ListVolumesOpts) ([]*VolumeSpec, error) {
+	return nil, &NotImplementError{S: "method ListVolumes has not been implemented yet."}
+}
+
+func (d *Driver) ListSnapshots(opt *pb.ListVolumesOpts) ([]*VolumeSnapshotSpec, error) {
+	return nil, &NotImplementError{S: "method ListSnapshots has not been implemented yet."}
+}
+
 func (d *Driver) InitializeSnapshotConnection(opt *pb.CreateSnapshotAttachmentOpts) (*ConnectionInfo, error) {
 	return nil, &NotImplementError{S: "method InitializeSnapshotConnection has not been implemented yet."}
 }
@@ -442,3 +483,11 @@ func (d *Driver) UpdateVolumeGroup(opt *pb.UpdateVolumeGroupOpts) (*VolumeGroupS
 func (d *Driver) DeleteVolumeGroup(opt *pb.DeleteVolumeGroupOpts) error {
 	return &NotImplementError{"method DeleteVolumeGroup has not been implemented yet"}
 }
+
+func (d *Driver) CreateGroupSnapshot(opt *pb.CreateGroupSnapshotOpts) ([]*VolumeSnapshotSpec, error) {
+	return nil, &NotImplementError{S: "method CreateGroupSnapshot has not been implemented yet"}
+}
+
+func (d *Driver) DeleteGroupSnapshot(opt *pb.DeleteGroupSnapshotOpts) error {
+	return &NotImplementError{S: "method DeleteGroupSnapshot has not been implemented yet"}
+}
@@ -16,6 +16,7 @@ package lvm
 
 import (
 	"fmt"
+	"math"
 	"path"
 	"strconv"
 	"strings"
@@ -152,6 +153,25 @@ func (c *Cli) LvIsActivate(name, vg string) bool {
 	return out[4] == 'a'
 }
 
+// LvIsOpen checks whether the device of the logical volume is opened, e.g.
+// it's mounted or exported as an iscsi target.
+func (c *Cli) LvIsOpen(name, vg string) bool {
+	cmd := []string{
+		"env", "LC_ALL=C",
+		"lvdisplay",
+		"--noheading",
+		"-C", "-o",
+		"Attr", path.Join(vg, name),
+	}
+	out, err := c.execute(cmd...)
+	if err != nil {
+		glog.Error("Failed to display logic volume:", err)
+		return false
+	}
+	out = strings.TrimSpace(out)
+	return len(out) > 5 && out[5] == 'o'
+}
+
 func (c *Cli) DeactivateLv(name, vg string) error {
 	cmd := []string{
 		"env", "LC_ALL=C",
@@ -219,6 +239,80 @@ func (c *Cli) CreateLvSnapshot(name, sourceLvName, vg string, size int64) error
 	return nil
 }
 
+// MergeSnapshot merges the snapshot into its origin volume and waits until
+// the merging completes, the snapshot is removed after that.
+func (c *Cli) MergeSnapshot(name, vg string) error {
+	cmd := []string{
+		"env", "LC_ALL=C",
+		"lvconvert",
+		"--merge",
+		path.Join(vg, name),
+	}
+	if _, err := c.execute(cmd...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// dmName returns the name of the device mapper device of the logical volume,
+// lvm doubles the hyphens in the names of the volume group and the volume.
+func dmName(name, vg string) string {
+	return strings.Replace(vg, "-", "--", -1) + "-" + strings.Replace(name, "-", "--", -1)
+}
+
+// SuspendLv flushes the device of the logical volume and holds the writes to
+// it until it's resumed.
+func (c *Cli) SuspendLv(name, vg string) error {
+	cmd := []string{
+		"env", "LC_ALL=C",
+		"dmsetup", "suspend", dmName(name, vg),
+	}
+	if _, err := c.execute(cmd...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ResumeLv resumes the device of the logical volume, it does nothing if the
+// device isn't suspended.
+func (c *Cli) ResumeLv(name, vg string) error {
+	cmd := []string{
+		"env", "LC_ALL=C",
+		"dmsetup", "resume", dmName(name, vg),
+	}
+	if _, err := c.execute(cmd...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// AddTag adds the tag to the logical volume.
+func (c *Cli) AddTag(name, vg, tag string) error {
+	cmd := []string{
+		"env", "LC_ALL=C",
+		"lvchange", "--addtag", tag,
+		path.Join(vg, name),
+	}
+	if _, err := c.execute(cmd...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DeleteTag deletes the tag from the logical volume, it does nothing if the
+// logical volume doesn't have the tag.
+func (c *Cli) DeleteTag(name, vg, tag string) error {
+	cmd := []string{
+		"env", "LC_ALL=C",
+		"lvchange", "--deltag", tag,
+		path.Join(vg, name),
+	}
+	if _, err := c.execute(cmd...); err != nil {
+		return err
+	}
+	return nil
+}
+
 type VolumeGroup struct {
 	Name          string
 	TotalCapacity int64
@@ -259,6 +353,91 @@ func (c *Cli) ListVgs() (*[]VolumeGroup, error) {
 	return &vgs, nil
 }
 
+type LogicalVolume struct {
+	Name string
+	// The size is rounded up to GiB.
+	Size int64
+	// The name of the origin volume if it's a snapshot.
+	Origin string
+}
+
+func (c *Cli) GetLv(name, vg string) (*LogicalVolume, error) {
+	cmd := []string{
+		"env", "LC_ALL=C",
+		"lvs",
+		"--noheadings",
+		"--nosuffix",
+		"--unit=g",
+		"--separator", ":",
+		"-o", "name,size,origin",
+		path.Join(vg, name),
+	}
+	out, err := c.execute(cmd...)
+	if err != nil {
+		return nil, err
+	}
+	return parseLv(strings.TrimSpace(out))
+}
+
+// ListLvs lists all the logical volumes in the volume group.
+func (c *Cli) ListLvs(vg string) ([]*LogicalVolume, error) {
+	cmd := []string{
+		"env", "LC_ALL=C",
+		"lvs",
+		"--noheadings",
+		"--nosuffix",
+		"--unit=g",
+		"--separator", ":",
+		"-o", "name,size,origin",
+		vg,
+	}
+	out, err := c.execute(cmd...)
+	if err != nil {
+		return nil, err
+	}
+	var lvs []*LogicalVolume
+	for _, line := range strings.Split(out, "\n") {
+		line = strings.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+		lv, err := parseLv(line)
+		if err != nil {
+			return nil, err
+		}
+		lvs = append(lvs, lv)
+	}
+	return lvs, nil
+}
+
+// parseLv parses a line of lvs output in the format of name:size:origin.
+func parseLv(line string) (*LogicalVolume, error) {
+	fields := strings.Split(line, ":")
+	if len(fields) != 3 {
+		return nil, fmt.Errorf("unexpected output of lvs: %s", line)
+	}
+	size, err := strconv.ParseFloat(fields[1], 64)
+	if err != nil {
+		return nil, err
+	}
+	return &LogicalVolume{
+		Name:   fields[0],
+		Size:   int64(math.Ceil(size)),
+		Origin: fields[2],
+	}, nil
+}
+
+// rename volume or snapshot
+func (c *Cli) Rename(name, newName, vg string) error {
+	cmd := []string{
+		"env", "LC_ALL=C",
+		"lvrename",
+		vg, name, newName,
+	}
+	_, err := c.execute(cmd...)
+	return err
+}
+
 func (c *Cli) CopyVolume(src, dest string, size int64) error {
 	var count = (size << sizeShiftBit) / blocksize
 	_, err := c.execute("dd",
@@ -25,6 +25,7 @@ import (
 	log "github.com/golang/glog"
 	"github.com/opensds/opensds/contrib/backup"
 	"github.com/opensds/opensds/contrib/connector"
+	"github.com/opensds/opensds/contrib/drivers"
 	"github.com/opensds/opensds/contrib/drivers/lvm/targets"
 	. "github.com/opensds/opensds/contrib/drivers/utils/config"
 	"github.com/opensds/opensds/pkg/model"
@@ -34,12 +35,19 @@ import (
 	uuid "github.com/satori/go.uuid"
 )
 
+func init() {
+	drivers.RegisterVolumeDriver(LVMDriverType, func() drivers.VolumeDriver { return &Driver{} },
+		drivers.Capability{VolumeGroup: true, SnapshotAttach: true, Metrics: true})
+	drivers.RegisterMetricDriver(LVMDriverType, func() drivers.MetricDriver { return &MetricDriver{} })
+}
+
 const (
 	defaultTgtConfDir = "/etc/tgt/conf.d"
 	defaultTgtBindIp  = "127.0.0.1"
 	defaultConfPath   = "/etc/opensds/driver/lvm.yaml"
 	volumePrefix      = "volume-"
 	snapshotPrefix    = "_snapshot-"
+	groupTagPrefix    = "opensds-group-"
 	blocksize         = 4096
 	sizeShiftBit      = 30
 	opensdsnvmepool   = "opensds-nvmegroup"
@@ -177,9 +185,128 @@ func (d *Driver) CreateVolume(opt *pb.CreateVolumeOpts) (vol *model.VolumeSpec,
 	}, nil
 }
 
-func (d *Driver) PullVolume(volIdentifier string) (*model.VolumeSpec, error) {
-	// Not used , do nothing
-	return nil, nil
+// CloneVolume creates a new logic volume and copies the data of the source
+// volume into it.
+func (d *Driver) CloneVolume(opt *pb.CreateVolumeOpts) (vol *model.VolumeSpec, err error) {
+	srcLvPath, ok := opt.GetMetadata()[KLvPath]
+	if !ok {
+		err := errors.New("can't find 'lvPath' in source volume metadata")
+		log.Error(err)
+		return nil, err
+	}
+
+	var name = volumePrefix + opt.GetId()
+	var vg = opt.GetPoolName()
+	if err = d.cli.CreateVolume(name, vg, opt.GetSize()); err != nil {
+		return
+	}
+
+	// remove created volume if got error
+	defer func() {
+		// using return value as the error flag
+		if vol == nil {
+			if err := d.cli.Delete(name, vg); err != nil {
+				log.Error("Failed to remove logic volume:", err)
+			}
+		}
+	}()
+
+	var lvPath = path.Join("/dev", vg, name)
+	if err := d.cli.CopyVolume(srcLvPath, lvPath, opt.GetSourceVolumeSize()); err != nil {
+		log.Error("Failed to clone logic volume:", err)
+		return nil, err
+	}
+
+	return &model.VolumeSpec{
+		BaseModel: &model.BaseModel{
+			Id: opt.GetId(),
+		},
+		Name:        opt.GetName(),
+		Size:        opt.GetSize(),
+		Description: opt.GetDescription(),
+		Metadata: map[string]string{
+			KLvPath: lvPath,
+		},
+	}, nil
+}
+
+// ManageVolume takes over the logical volume named by the identifier in the
+// volume group of the pool, it's renamed like the ones created by opensds.
+func (d *Driver) ManageVolume(opt *pb.ManageVolumeOpts) (*model.VolumeSpec, error) {
+	var vg, lvName = opt.GetPoolName(), opt.GetIdentifier()
+	lv, err := d.cli.GetLv(lvName, vg)
+	if err != nil {
+		log.Errorf("Failed to get logic volume %s in volume group %s: %v", lvName, vg, err)
+		return nil, err
+	}
+	if lv.Origin != "" {
+		err := fmt.Errorf("logic volume %s is a snapshot of %s", lvName, lv.Origin)
+		log.Error(err)
+		return nil, err
+	}
+
+	var name = volumePrefix + opt.GetId()
+	if err := d.cli.Rename(lvName, name, vg); err != nil {
+		log.Error("Failed to rename logic volume:", err)
+		return nil, err
+	}
+
+	return &model.VolumeSpec{
+		BaseModel: &model.BaseModel{
+			Id: opt.GetId(),
+		},
+		Name:        opt.GetName(),
+		Size:        lv.Size,
+		Description: opt.GetDescription(),
+		Metadata: map[string]string{
+			KLvPath:            path.Join("/dev", vg, name),
+			KManagedVolumeName: lvName,
+		},
+	}, nil
+}
+
+// UnmanageVolume gives the logical volume its original name back if it was
+// taken over from the volume group.
+func (d *Driver) UnmanageVolume(opt *pb.UnmanageVolumeOpts) error {
+	lvName, ok := opt.GetMetadata()[KManagedVolumeName]
+	if !ok {
+		return nil
+	}
+	lvPath, ok := opt.GetMetadata()[KLvPath]
+	if !ok {
+		err := errors.New("can't find 'lvPath' in volume metadata")
+		log.Error(err)
+		return err
+	}
+
+	fields := strings.Split(lvPath, "/")
+	vg, name := fields[2], fields[3]
+	if err := d.cli.Rename(name, lvName, vg); err != nil {
+		log.Error("Failed to rename logic volume:", err)
+		return err
+	}
+	return nil
+}
+
+// PullVolume finds the logical volume of the volume in the volume group of
+// the pool.
+func (d *Driver) PullVolume(opt *pb.PullVolumeOpts) (*model.VolumeSpec, error) {
+	var name, vg = volumePrefix + opt.GetId(), opt.GetPoolName()
+	lv, err := d.findLv(name, vg)
+	if err != nil {
+		return nil, err
+	}
+	return &model.VolumeSpec{
+		BaseModel: &model.BaseModel{
+			Id: opt.GetId(),
+		},
+		Name:   lv.Name,
+		Size:   lv.Size,
+		PoolId: opt.GetPoolId(),
+		Metadata: map[string]string{
+			KLvPath: path.Join("/dev", vg, name),
+		},
+	}, nil
 }
 
 func (d *Driver) DeleteVolume(opt *pb.DeleteVolumeOpts) error {
@@ -231,6 +358,110 @@ func (d *Driver) ExtendVolume(opt *pb.ExtendVolumeOpts) (*model.VolumeSpec, erro
 	}, nil
 }
 
+// MigrateVolume copies the logical volume to the volume group of the target
+// pool and removes the source one.
+func (d *Driver) MigrateVolume(opt *pb.MigrateVolumeOpts) (vol *model.VolumeSpec, err error) {
+	srcLvPath, ok := opt.GetMetadata()[KLvPath]
+	if !ok {
+		err := errors.New("can't find 'lvPath' in volume metadata")
+		log.Error(err)
+		return nil, err
+	}
+
+	var name = volumePrefix + opt.GetId()
+	var vg = opt.GetPoolName()
+	srcVg := strings.Split(srcLvPath, "/")[2]
+	if srcVg == vg {
+		return nil, fmt.Errorf("volume %s is already in volume group %s", name, vg)
+	}
+	if d.cli.LvHasSnapshot(name, srcVg) {
+		err := fmt.Errorf("unable to migrate due to existing snapshot for volume: %s", name)
+		log.Error(err)
+		return nil, err
+	}
+
+	if err = d.cli.CreateVolume(name, vg, opt.GetSize()); err != nil {
+		return
+	}
+
+	// remove created volume if got error
+	defer func() {
+		// using return value as the error flag
+		if vol == nil {
+			if err := d.cli.Delete(name, vg); err != nil {
+				log.Error("Failed to remove logic volume:", err)
+			}
+		}
+	}()
+
+	var lvPath = path.Join("/dev", vg, name)
+	if err := d.cli.CopyVolume(srcLvPath, lvPath, opt.GetSize()); err != nil {
+		log.Error("Failed to migrate logic volume:", err)
+		return nil, err
+	}
+	if err := d.cli.Delete(name, srcVg); err != nil {
+		log.Error("Failed to remove source logic volume:", err)
+		return nil, err
+	}
+
+	return &model.VolumeSpec{
+		BaseModel: &model.BaseModel{
+			Id: opt.GetId(),
+		},
+		Name: opt.GetName(),
+		Size: opt.GetSize(),
+		Metadata: map[string]string{
+			KLvPath: lvPath,
+		},
+	}, nil
+}
+
+// RevertVolumeToSnapshot merges the snapshot into the logical volume, since
+// lvm removes the snapshot after merging, it's re-created with the same name
+// so that it's still available for reverting. The errors occurred before the
+// merging are returned as PreconditionError because nothing is changed.
+func (d *Driver) RevertVolumeToSnapshot(opt *pb.RevertVolumeToSnapshotOpts) error {
+	lvPath, ok := opt.GetMetadata()[KLvPath]
+	if !ok {
+		err := model.NewPreconditionError("can't find 'lvPath' in volume metadata")
+		log.Error(err)
+		return err
+	}
+	lvsPath, ok := opt.GetSnapshotMetadata()[KLvsPath]
+	if !ok {
+		err := model.NewPreconditionError("can't find 'lvsPath' in snapshot metadata")
+		log.Error(err)
+		return err
+	}
+
+	fields := strings.Split(lvPath, "/")
+	vg, lvName := fields[2], fields[3]
+	snapName := strings.Split(lvsPath, "/")[3]
+	// The merging is postponed until the volume is activated next time if
+	// it's opened, so it's refused to avoid reverting the volume behind.
+	if d.cli.LvIsOpen(lvName, vg) {
+		err := model.NewPreconditionError(fmt.Sprintf(
+			"logic volume %s is opened, it can't be reverted until it's closed", lvName))
+		log.Error(err)
+		return err
+	}
+	snap, err := d.cli.GetLv(snapName, vg)
+	if err != nil {
+		log.Error("Failed to get logic volume snapshot:", err)
+		return model.NewPreconditionError(err.Error())
+	}
+
+	if err := d.cli.MergeSnapshot(snapName, vg); err != nil {
+		log.Error("Failed to merge logic volume snapshot:", err)
+		return err
+	}
+	if err := d.cli.CreateLvSnapshot(snapName, lvName, vg, snap.Size); err != nil {
+		log.Errorf("Volume %s is reverted, but failed to re-create logic volume snapshot %s: %v", lvName, snapName, err)
+		return err
+	}
+	return nil
+}
+
 func (d *Driver) InitializeConnection(opt *pb.CreateVolumeAttachmentOpts) (*model.ConnectionInfo, error) {
 	log.V(8).Infof("lvm initialize connection information: %v", opt)
 	initiator := opt.HostInfo.GetInitiator()
@@ -452,9 +683,93 @@ func (d *Driver) CreateSnapshot(opt *pb.CreateVolumeSnapshotOpts) (snap *model.V
 	}, nil
 }
 
-func (d *Driver) PullSnapshot(snapIdentifier string) (*model.VolumeSnapshotSpec, error) {
-	// not used, do nothing
-	return nil, nil
+// PullSnapshot finds the logical volume of the snapshot in the volume group
+// of the pool which the volume is placed in.
+func (d *Driver) PullSnapshot(opt *pb.PullVolumeSnapshotOpts) (*model.VolumeSnapshotSpec, error) {
+	var name, vg = snapshotPrefix + opt.GetId(), opt.GetPoolName()
+	lv, err := d.findLv(name, vg)
+	if err != nil {
+		return nil, err
+	}
+	return &model.VolumeSnapshotSpec{
+		BaseModel: &model.BaseModel{
+			Id: opt.GetId(),
+		},
+		Name:     lv.Name,
+		Size:     lv.Size,
+		VolumeId: strings.TrimPrefix(lv.Origin, volumePrefix),
+		Metadata: map[string]string{
+			KLvsPath: path.Join("/dev", vg, name),
+		},
+	}, nil
+}
+
+// ManageSnapshot takes over the snapshot named by the identifier, which must
+// be taken from the logical volume of the volume.
+func (d *Driver) ManageSnapshot(opt *pb.ManageVolumeSnapshotOpts) (*model.VolumeSnapshotSpec, error) {
+	lvPath, ok := opt.GetMetadata()[KLvPath]
+	if !ok {
+		err := errors.New("can't find 'lvPath' in volume metadata")
+		log.Error(err)
+		return nil, err
+	}
+
+	fields := strings.Split(lvPath, "/")
+	vg, volName := fields[2], fields[3]
+	snapName := opt.GetIdentifier()
+	lv, err := d.cli.GetLv(snapName, vg)
+	if err != nil {
+		log.Errorf("Failed to get logic volume %s in volume group %s: %v", snapName, vg, err)
+		return nil, err
+	}
+	if lv.Origin != volName {
+		err := fmt.Errorf("logic volume %s is not a snapshot of %s", snapName, volName)
+		log.Error(err)
+		return nil, err
+	}
+
+	var name = snapshotPrefix + opt.GetId()
+	if err := d.cli.Rename(snapName, name, vg); err != nil {
+		log.Error("Failed to rename snapshot:", err)
+		return nil, err
+	}
+
+	return &model.VolumeSnapshotSpec{
+		BaseModel: &model.BaseModel{
+			Id: opt.GetId(),
+		},
+		Name:        opt.GetName(),
+		Size:        opt.GetSize(),
+		Description: opt.GetDescription(),
+		VolumeId:    opt.GetVolumeId(),
+		Metadata: map[string]string{
+			KLvsPath:             path.Join("/dev", vg, name),
+			KManagedSnapshotName: snapName,
+		},
+	}, nil
+}
+
+// UnmanageSnapshot gives the snapshot its original name back if it was taken
+// over from the volume group.
+func (d *Driver) UnmanageSnapshot(opt *pb.UnmanageVolumeSnapshotOpts) error {
+	snapName, ok := opt.GetMetadata()[KManagedSnapshotName]
+	if !ok {
+		return nil
+	}
+	lvsPath, ok := opt.GetMetadata()[KLvsPath]
+	if !ok {
+		err := errors.New("can't find 'lvsPath' in snapshot metadata")
+		log.Error(err)
+		return err
+	}
+
+	fields := strings.Split(lvsPath, "/")
+	vg, name := fields[2], fields[3]
+	if err := d.cli.Rename(name, snapName, vg); err != nil {
+		log.Error("Failed to rename snapshot:", err)
+		return err
+	}
+	return nil
 }
 
 func (d *Driver) DeleteSnapshot(opt *pb.DeleteVolumeSnapshotOpts) error {
@@ -520,6 +835,74 @@ func (d *Driver) ListPools() ([]*model.StoragePoolSpec, error) {
 	return pols, nil
 }
 
+// ListVolumes lists the logical volumes named by opensds in the volume group
+// of the pool, the snapshots are excluded.
+func (d *Driver) ListVolumes(opt *pb.ListVolumesOpts) ([]*model.VolumeSpec, error) {
+	lvs, err := d.cli.ListLvs(opt.GetPoolName())
+	if err != nil {
+		log.Errorf("Failed to list logic volumes in volume group %s: %v", opt.GetPoolName(), err)
+		return nil, err
+	}
+
+	var vols []*model.VolumeSpec
+	for _, lv := range lvs {
+		if !strings.HasPrefix(lv.Name, volumePrefix) {
+			continue
+		}
+		vols = append(vols, &model.VolumeSpec{
+			BaseModel: &model.BaseModel{
+				Id: strings.TrimPrefix(lv.Name, volumePrefix),
+			},
+			Name:   lv.Name,
+			Size:   lv.Size,
+			PoolId: opt.GetPoolId(),
+		})
+	}
+	return vols, nil
+}
+
+// ListSnapshots lists the snapshots named by opensds in the volume group of
+// the pool.
+func (d *Driver) ListSnapshots(opt *pb.ListVolumesOpts) ([]*model.VolumeSnapshotSpec, error) {
+	lvs, err := d.cli.ListLvs(opt.GetPoolName())
+	if err != nil {
+		log.Errorf("Failed to list logic volumes in volume group %s: %v", opt.GetPoolName(), err)
+		return nil, err
+	}
+
+	var snaps []*model.VolumeSnapshotSpec
+	for _, lv := range lvs {
+		if !strings.HasPrefix(lv.Name, snapshotPrefix) {
+			continue
+		}
+		snaps = append(snaps, &model.VolumeSnapshotSpec{
+			BaseModel: &model.BaseModel{
+				Id: strings.TrimPrefix(lv.Name, snapshotPrefix),
+			},
+			Name:     lv.Name,
+			Size:     lv.Size,
+			VolumeId: strings.TrimPrefix(lv.Origin, volumePrefix),
+		})
+	}
+	return snaps, nil
+}
+
+// findLv finds the logical volume in the volume group, NotFoundError is
+// returned if it doesn't exist.
+func (d *Driver) findLv(name, vg string) (*LogicalVolume, error) {
+	lvs, err := d.cli.ListLvs(vg)
+	if err != nil {
+		log.Errorf("Failed to list logic volumes in volume group %s: %v", vg, err)
+		return nil, err
+	}
+	for _, lv := range lvs {
+		if lv.Name == name {
+			return lv, nil
+		}
+	}
+	return nil, model.NewNotFoundError(fmt.Sprintf("logic volume %s doesn't exist in volume group %s", name, vg))
+}
+
 func (d *Driver) InitializeSnapshotConnection(opt *pb.CreateSnapshotAttachmentOpts) (*model.ConnectionInfo, error) {
 	initiator := opt.HostInfo.GetInitiator()
 	if initiator == "" {
@@ -578,14 +961,174 @@ func (d *Driver) TerminateSnapshotConnection(opt *pb.DeleteSnapshotAttachmentOpt
 
 }
 
+// CreateVolumeGroup marks the logical volumes of the members with the tag of
+// the group, the group snapshots of them are taken by CreateGroupSnapshot.
 func (d *Driver) CreateVolumeGroup(opt *pb.CreateVolumeGroupOpts) (*model.VolumeGroupSpec, error) {
-	return nil, &model.NotImplementError{"method CreateVolumeGroup has not been implemented yet"}
+	vols := d.updateGroupTags(opt.GetId(), opt.GetAddVolumesRef(), nil)
+	log.Infof("Create volume group (%s) success", opt.GetId())
+	return &model.VolumeGroupSpec{
+		BaseModel: &model.BaseModel{
+			Id: opt.GetId(),
+		},
+		Name:             opt.GetName(),
+		Description:      opt.GetDescription(),
+		AvailabilityZone: opt.GetAvailabilityZone(),
+		PoolId:           opt.GetPoolId(),
+		Volumes:          vols,
+	}, nil
 }
 
 func (d *Driver) UpdateVolumeGroup(opt *pb.UpdateVolumeGroupOpts) (*model.VolumeGroupSpec, error) {
-	return nil, &model.NotImplementError{"method UpdateVolumeGroup has not been implemented yet"}
+	vols := d.updateGroupTags(opt.GetId(), opt.GetAddVolumesRef(), opt.GetRemoveVolumesRef())
+	log.Infof("Update volume group (%s) success", opt.GetId())
+	return &model.VolumeGroupSpec{
+		BaseModel: &model.BaseModel{
+			Id: opt.GetId(),
+		},
+		PoolId:  opt.GetPoolId(),
+		Volumes: vols,
+	}, nil
 }
 
+// DeleteVolumeGroup deletes the tag of the group from the members, it goes on
+// when the tag of a member can't be deleted since the members are deleted
+// afterwards.
 func (d *Driver) DeleteVolumeGroup(opt *pb.DeleteVolumeGroupOpts) error {
-	return &model.NotImplementError{"method DeleteVolumeGroup has not been implemented yet"}
+	var tag = groupTagPrefix + opt.GetId()
+	for _, ref := range opt.GetVolumesRef() {
+		if err := d.tagLv(ref, tag, d.cli.DeleteTag); err != nil {
+			log.Warningf("delete tag of volume group (%s) from volume (%s) failed: %v", opt.GetId(), ref.GetId(), err)
+		}
+	}
+	log.Infof("Delete volume group (%s) success", opt.GetId())
+	return nil
+}
+
+// updateGroupTags adds the tag of the group to the logical volumes added and
+// deletes it from the ones removed, the status of each of them is reported.
+func (d *Driver) updateGroupTags(groupId string, addVolumesRef, removeVolumesRef []*pb.VolumeRef) []*model.VolumeSpec {
+	var tag = groupTagPrefix + groupId
+	var vols []*model.VolumeSpec
+	for _, ref := range addVolumesRef {
+		vol := &model.VolumeSpec{
+			BaseModel: &model.BaseModel{
+				Id: ref.GetId(),
+			},
+			Status:  ref.GetStatus(),
+			GroupId: groupId,
+		}
+		if err := d.tagLv(ref, tag, d.cli.AddTag); err != nil {
+			log.Errorf("add volume (%s) to volume group (%s) failed: %v", ref.GetId(), groupId, err)
+			vol.Status, vol.GroupId = model.VolumeError, ""
+		}
+		vols = append(vols, vol)
+	}
+	for _, ref := range removeVolumesRef {
+		vol := &model.VolumeSpec{
+			BaseModel: &model.BaseModel{
+				Id: ref.GetId(),
+			},
+			Status: ref.GetStatus(),
+		}
+		if err := d.tagLv(ref, tag, d.cli.DeleteTag); err != nil {
+			log.Errorf("remove volume (%s) from volume group (%s) failed: %v", ref.GetId(), groupId, err)
+			vol.Status, vol.GroupId = model.VolumeError, groupId
+		}
+		vols = append(vols, vol)
+	}
+	return vols
+}
+
+// tagLv adds or deletes the tag of the logical volume of the volume.
+func (d *Driver) tagLv(ref *pb.VolumeRef, tag string, fn func(name, vg, tag string) error) error {
+	lvPath, ok := ref.GetMetadata()[KLvPath]
+	if !ok {
+		return fmt.Errorf("can't find 'lvPath' in metadata of volume %s", ref.GetId())
+	}
+	fields := strings.Split(lvPath, "/")
+	if len(fields) != 4 {
+		return fmt.Errorf("invalid lvPath %s of volume %s", lvPath, ref.GetId())
+	}
+	return fn(fields[3], fields[2], tag)
+}
+
+// CreateGroupSnapshot suspends the logical volumes of all the members before
+// the first snapshot is taken, so that no write reaches any of them until
+// all the snapshots are taken. lvcreate leaves an origin alone if it's
+// suspended already and resumes it once the snapshot is loaded, the members
+// still suspended when something fails are resumed at the end.
+func (d *Driver) CreateGroupSnapshot(opt *pb.CreateGroupSnapshotOpts) (snps []*model.VolumeSnapshotSpec, err error) {
+	var vgs, lvs []string
+	for _, snp := range opt.GetSnapshots() {
+		lvPath, ok := snp.GetMetadata()[KLvPath]
+		if !ok {
+			err := fmt.Errorf("can't find 'lvPath' in metadata of snapshot %s", snp.GetId())
+			log.Error(err)
+			return nil, err
+		}
+		fields := strings.Split(lvPath, "/")
+		vgs, lvs = append(vgs, fields[2]), append(lvs, fields[3])
+	}
+
+	// Remove the snapshots taken if any of them fails, it runs after the
+	// members are resumed.
+	defer func() {
+		if err == nil {
+			return
+		}
+		for i, snp := range snps {
+			if err := d.cli.Delete(snapshotPrefix+snp.Id, vgs[i]); err != nil {
+				log.Errorf("when remove snapshot %s of group snapshot %s: %v", snp.Id, opt.GetId(), err)
+			}
+		}
+		snps = nil
+	}()
+
+	var suspended int
+	defer func() {
+		for i := 0; i < suspended; i++ {
+			if err := d.cli.ResumeLv(lvs[i], vgs[i]); err != nil {
+				log.Errorf("when resume logic volume %s: %v", lvs[i], err)
+			}
+		}
+	}()
+	for ; suspended < len(lvs); suspended++ {
+		if err = d.cli.SuspendLv(lvs[suspended], vgs[suspended]); err != nil {
+			log.Error("Failed to suspend logic volume:", err)
+			return nil, err
+		}
+	}
+
+	for i, snp := range opt.GetSnapshots() {
+		var snapName = snapshotPrefix + snp.GetId()
+		if err = d.cli.CreateLvSnapshot(snapName, lvs[i], vgs[i], snp.GetSize()); err != nil {
+			log.Error("Failed to create logic volume snapshot:", err)
+			return snps, err
+		}
+		snps = append(snps, &model.VolumeSnapshotSpec{
+			BaseModel: &model.BaseModel{
+				Id: snp.GetId(),
+			},
+			Name:        snp.GetName(),
+			Size:        snp.GetSize(),
+			Description: snp.GetDescription(),
+			VolumeId:    snp.GetVolumeId(),
+			Metadata: map[string]string{
+				KLvsPath: path.Join("/dev", vgs[i], snapName),
+			},
+		})
+	}
+	log.Infof("Create group snapshot (%s) of %d volumes success", opt.GetId(), len(snps))
+	return snps, nil
+}
+
+// DeleteGroupSnapshot removes the snapshots of the members one by one, the
+// snapshots of lvm are independent of each other once they are taken.
+func (d *Driver) DeleteGroupSnapshot(opt *pb.DeleteGroupSnapshotOpts) error {
+	for _, snp := range opt.GetSnapshots() {
+		if err := d.DeleteSnapshot(snp); err != nil {
+			return err
+		}
+	}
+	return nil
 }
@@ -17,6 +17,7 @@ package lvm
 import (
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 
 	. "github.com/opensds/opensds/contrib/drivers/utils/config"
@@ -171,6 +172,217 @@ func TestCreateVolumeFromSnapshot(t *testing.T) {
 	}
 }
 
+func TestCloneVolume(t *testing.T) {
+	var fd = &Driver{}
+	config.CONF.OsdsDock.Backends.LVM.ConfigPath = "testdata/lvm.yaml"
+	fd.Setup()
+
+	respMap := map[string]*FakeResp{
+		"lvcreate": {"", nil},
+		"dd":       {"", nil},
+	}
+	fd.cli.RootExecuter = NewFakeExecuter(respMap)
+	fd.cli.BaseExecuter = NewFakeExecuter(respMap)
+
+	opt := &pb.CreateVolumeOpts{
+		Id:               "e1bb066c-5ce7-46eb-9336-25508cee9f71",
+		Name:             "test001",
+		Description:      "volume for testing",
+		Size:             int64(2),
+		PoolName:         "vg001",
+		SourceVolumeId:   "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		SourceVolumeSize: int64(1),
+		Metadata: map[string]string{
+			"lvPath": "/dev/vg001/volume-bd5b12a8-a101-11e7-941e-d77981b584d8",
+		},
+	}
+	var expected = &model.VolumeSpec{
+		BaseModel:   &model.BaseModel{},
+		Name:        "test001",
+		Description: "volume for testing",
+		Size:        int64(2),
+		Metadata: map[string]string{
+			"lvPath": "/dev/vg001/volume-e1bb066c-5ce7-46eb-9336-25508cee9f71",
+		},
+	}
+	vol, err := fd.CloneVolume(opt)
+	if err != nil {
+		t.Fatal("Failed to clone volume:", err)
+	}
+	vol.Id = ""
+	if !reflect.DeepEqual(vol, expected) {
+		t.Errorf("Expected %+v, got %+v\n", expected, vol)
+	}
+}
+
+func TestMigrateVolume(t *testing.T) {
+	var fd = &Driver{}
+	config.CONF.OsdsDock.Backends.LVM.ConfigPath = "testdata/lvm.yaml"
+	fd.Setup()
+
+	respMap := map[string]*FakeResp{
+		"lvdisplay": {"  -wi-a-----", nil},
+		"lvcreate":  {"", nil},
+		"dd":        {"", nil},
+		"lvremove":  {"", nil},
+	}
+	fd.cli.RootExecuter = NewFakeExecuter(respMap)
+	fd.cli.BaseExecuter = NewFakeExecuter(respMap)
+
+	opt := &pb.MigrateVolumeOpts{
+		Id:             "e1bb066c-5ce7-46eb-9336-25508cee9f71",
+		Name:           "test001",
+		Size:           int64(1),
+		PoolName:       "vg002",
+		SourcePoolName: "vg001",
+		Metadata: map[string]string{
+			"lvPath": "/dev/vg001/volume-e1bb066c-5ce7-46eb-9336-25508cee9f71",
+		},
+	}
+	var expected = &model.VolumeSpec{
+		BaseModel: &model.BaseModel{
+			Id: "e1bb066c-5ce7-46eb-9336-25508cee9f71",
+		},
+		Name: "test001",
+		Size: int64(1),
+		Metadata: map[string]string{
+			"lvPath": "/dev/vg002/volume-e1bb066c-5ce7-46eb-9336-25508cee9f71",
+		},
+	}
+	vol, err := fd.MigrateVolume(opt)
+	if err != nil {
+		t.Fatal("Failed to migrate volume:", err)
+	}
+	if !reflect.DeepEqual(vol, expected) {
+		t.Errorf("Expected %+v, got %+v\n", expected, vol)
+	}
+
+	// The volume can't be migrated to the volume group it's placed in.
+	opt.PoolName = "vg001"
+	if _, err := fd.MigrateVolume(opt); err == nil {
+		t.Error("Expected error when migrate volume to its own volume group, got nil")
+	}
+}
+
+func TestRevertVolumeToSnapshot(t *testing.T) {
+	var fd = &Driver{}
+	config.CONF.OsdsDock.Backends.LVM.ConfigPath = "testdata/lvm.yaml"
+	fd.Setup()
+
+	respMap := map[string]*FakeResp{
+		"lvdisplay": {"  owi-a-s---", nil},
+		"lvs":       {"  _snapshot-d1916c49-3088-4a40-b6fb-0fda18d074c3:1.00:volume-bd5b12a8-a101-11e7-941e-d77981b584d8", nil},
+		"lvconvert": {"", nil},
+		"lvcreate":  {"", nil},
+	}
+	fd.cli.RootExecuter = NewFakeExecuter(respMap)
+	fd.cli.BaseExecuter = NewFakeExecuter(respMap)
+
+	opt := &pb.RevertVolumeToSnapshotOpts{
+		Id:         "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		SnapshotId: "d1916c49-3088-4a40-b6fb-0fda18d074c3",
+		Size:       int64(1),
+		Metadata: map[string]string{
+			"lvPath": "/dev/vg001/volume-bd5b12a8-a101-11e7-941e-d77981b584d8",
+		},
+		SnapshotMetadata: map[string]string{
+			"lvsPath": "/dev/vg001/_snapshot-d1916c49-3088-4a40-b6fb-0fda18d074c3",
+		},
+	}
+	if err := fd.RevertVolumeToSnapshot(opt); err != nil {
+		t.Error("Failed to revert volume to snapshot:", err)
+	}
+
+	// The volume which is opened can't be reverted.
+	respMap["lvdisplay"] = &FakeResp{"  owi-aos---", nil}
+	if err := fd.RevertVolumeToSnapshot(opt); err == nil {
+		t.Error("Expected error when revert an opened volume, got nil")
+	} else if _, ok := err.(*model.PreconditionError); !ok {
+		t.Errorf("Expected PreconditionError when revert an opened volume, got %v", err)
+	}
+
+	respMap["lvdisplay"] = &FakeResp{"  owi-a-s---", nil}
+	respMap["lvconvert"] = &FakeResp{"", fmt.Errorf("merge failed")}
+	if err := fd.RevertVolumeToSnapshot(opt); err == nil {
+		t.Error("Expected error when merge snapshot failed, got nil")
+	} else if _, ok := err.(*model.PreconditionError); ok {
+		t.Error("Expected the merge failure not to be PreconditionError")
+	}
+}
+
+func TestManageVolume(t *testing.T) {
+	var fd = &Driver{}
+	config.CONF.OsdsDock.Backends.LVM.ConfigPath = "testdata/lvm.yaml"
+	fd.Setup()
+
+	respMap := map[string]*FakeResp{
+		"lvs":      {"  lv001:1.50:", nil},
+		"lvrename": {"", nil},
+	}
+	fd.cli.RootExecuter = NewFakeExecuter(respMap)
+	fd.cli.BaseExecuter = NewFakeExecuter(respMap)
+
+	opt := &pb.ManageVolumeOpts{
+		Id:         "e1bb066c-5ce7-46eb-9336-25508cee9f71",
+		Name:       "test001",
+		Identifier: "lv001",
+		PoolName:   "vg001",
+	}
+	var expected = &model.VolumeSpec{
+		BaseModel: &model.BaseModel{
+			Id: "e1bb066c-5ce7-46eb-9336-25508cee9f71",
+		},
+		Name: "test001",
+		Size: int64(2),
+		Metadata: map[string]string{
+			"lvPath":            "/dev/vg001/volume-e1bb066c-5ce7-46eb-9336-25508cee9f71",
+			"managedVolumeName": "lv001",
+		},
+	}
+	vol, err := fd.ManageVolume(opt)
+	if err != nil {
+		t.Fatal("Failed to manage volume:", err)
+	}
+	if !reflect.DeepEqual(vol, expected) {
+		t.Errorf("Expected %+v, got %+v\n", expected, vol)
+	}
+
+	// The snapshot can't be managed as a volume.
+	respMap["lvs"] = &FakeResp{"  lv001:1.50:lv000", nil}
+	if _, err := fd.ManageVolume(opt); err == nil {
+		t.Error("Expected error when manage snapshot as volume, got nil")
+	}
+}
+
+func TestUnmanageVolume(t *testing.T) {
+	var fd = &Driver{}
+	config.CONF.OsdsDock.Backends.LVM.ConfigPath = "testdata/lvm.yaml"
+	fd.Setup()
+
+	// The volume created by OpenSDS is left as it is.
+	fd.cli.RootExecuter = NewFakeExecuter(map[string]*FakeResp{})
+	fd.cli.BaseExecuter = NewFakeExecuter(map[string]*FakeResp{})
+	opt := &pb.UnmanageVolumeOpts{
+		Id: "e1bb066c-5ce7-46eb-9336-25508cee9f71",
+		Metadata: map[string]string{
+			"lvPath": "/dev/vg001/volume-e1bb066c-5ce7-46eb-9336-25508cee9f71",
+		},
+	}
+	if err := fd.UnmanageVolume(opt); err != nil {
+		t.Error("Failed to unmanage volume:", err)
+	}
+
+	respMap := map[string]*FakeResp{
+		"lvrename": {"", nil},
+	}
+	fd.cli.RootExecuter = NewFakeExecuter(respMap)
+	fd.cli.BaseExecuter = NewFakeExecuter(respMap)
+	opt.Metadata["managedVolumeName"] = "lv001"
+	if err := fd.UnmanageVolume(opt); err != nil {
+		t.Error("Failed to unmanage volume:", err)
+	}
+}
+
 func TestDeleteVolume(t *testing.T) {
 	var fd = &Driver{}
 	config.CONF.OsdsDock.Backends.LVM.ConfigPath = "testdata/lvm.yaml"
@@ -296,6 +508,55 @@ func TestDeleteSnapshot(t *testing.T) {
 	}
 }
 
+func TestManageSnapshot(t *testing.T) {
+	var fd = &Driver{}
+	config.CONF.OsdsDock.Backends.LVM.ConfigPath = "testdata/lvm.yaml"
+	fd.Setup()
+
+	respMap := map[string]*FakeResp{
+		"lvs":      {"  snap001:1.00:volume-bd5b12a8-a101-11e7-941e-d77981b584d8", nil},
+		"lvrename": {"", nil},
+	}
+	fd.cli.RootExecuter = NewFakeExecuter(respMap)
+	fd.cli.BaseExecuter = NewFakeExecuter(respMap)
+
+	opt := &pb.ManageVolumeSnapshotOpts{
+		Id:         "d1916c49-3088-4a40-b6fb-0fda18d074c3",
+		Name:       "snap001",
+		Identifier: "snap001",
+		Size:       int64(1),
+		VolumeId:   "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		Metadata: map[string]string{
+			"lvPath": "/dev/vg001/volume-bd5b12a8-a101-11e7-941e-d77981b584d8",
+		},
+	}
+	var expected = &model.VolumeSnapshotSpec{
+		BaseModel: &model.BaseModel{
+			Id: "d1916c49-3088-4a40-b6fb-0fda18d074c3",
+		},
+		Name:     "snap001",
+		Size:     int64(1),
+		VolumeId: "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		Metadata: map[string]string{
+			"lvsPath":             "/dev/vg001/_snapshot-d1916c49-3088-4a40-b6fb-0fda18d074c3",
+			"managedSnapshotName": "snap001",
+		},
+	}
+	snp, err := fd.ManageSnapshot(opt)
+	if err != nil {
+		t.Fatal("Failed to manage volume snapshot:", err)
+	}
+	if !reflect.DeepEqual(snp, expected) {
+		t.Errorf("Expected %+v, got %+v\n", expected, snp)
+	}
+
+	// The snapshot has to be taken from the volume specified.
+	respMap["lvs"] = &FakeResp{"  snap001:1.00:lv001", nil}
+	if _, err := fd.ManageSnapshot(opt); err == nil {
+		t.Error("Expected error when manage snapshot of another volume, got nil")
+	}
+}
+
 func TestListPools(t *testing.T) {
 	var fd = &Driver{}
 	config.CONF.OsdsDock.Backends.LVM.ConfigPath = "testdata/lvm.yaml"
@@ -348,3 +609,175 @@ func TestListPools(t *testing.T) {
 		t.Errorf("Expected %+v, got %+v\n", expected[0], pols[0])
 	}
 }
+
+type recordExecuter struct {
+	cmds []string
+	fail string
+}
+
+func (r *recordExecuter) Run(name string, args ...string) (string, error) {
+	// Skip "env LC_ALL=C" and record the command with its first argument.
+	r.cmds = append(r.cmds, strings.Join(args[1:3], " "))
+	if r.fail != "" && strings.Contains(strings.Join(args, " "), r.fail) {
+		return "", fmt.Errorf("%s failed", args[1])
+	}
+	return "", nil
+}
+
+func TestCreateGroupSnapshot(t *testing.T) {
+	var fd = &Driver{}
+	config.CONF.OsdsDock.Backends.LVM.ConfigPath = "testdata/lvm.yaml"
+	fd.Setup()
+
+	opt := &pb.CreateGroupSnapshotOpts{
+		Id: "9c8a3d2e-7f41-11e9-a6b2-3f5e8c1d4b90",
+		Snapshots: []*pb.CreateVolumeSnapshotOpts{
+			{
+				Id:       "d1916c49-3088-4a40-b6fb-0fda18d074c3",
+				Size:     int64(1),
+				VolumeId: "bd5b12a8-a101-11e7-941e-d77981b584d8",
+				Metadata: map[string]string{"lvPath": "/dev/vg001/test001"},
+			},
+			{
+				Id:       "e4a9e4c1-80b4-4c57-9a0e-2f1b3c6d8e7a",
+				Size:     int64(2),
+				VolumeId: "c2a5f7b0-a101-11e7-941e-d77981b584d8",
+				Metadata: map[string]string{"lvPath": "/dev/vg001/test002"},
+			},
+		},
+	}
+
+	rec := &recordExecuter{}
+	fd.cli.RootExecuter, fd.cli.BaseExecuter = rec, rec
+	snps, err := fd.CreateGroupSnapshot(opt)
+	if err != nil {
+		t.Fatal("Failed to create group snapshot:", err)
+	}
+	// All the members are suspended before the first snapshot is taken.
+	var expectedCmds = []string{
+		"dmsetup suspend", "dmsetup suspend", "lvcreate -n", "lvcreate -n",
+		"dmsetup resume", "dmsetup resume",
+	}
+	if !reflect.DeepEqual(rec.cmds, expectedCmds) {
+		t.Errorf("Expected %v, got %v\n", expectedCmds, rec.cmds)
+	}
+	if len(snps) != 2 || snps[1].VolumeId != opt.Snapshots[1].VolumeId ||
+		snps[1].Metadata["lvsPath"] != "/dev/vg001/_snapshot-e4a9e4c1-80b4-4c57-9a0e-2f1b3c6d8e7a" {
+		t.Errorf("Unexpected member snapshots: %+v\n", snps)
+	}
+
+	// The snapshots taken are removed after the members are resumed.
+	rec = &recordExecuter{fail: "lvcreate -n _snapshot-e4a9e4c1"}
+	fd.cli.RootExecuter, fd.cli.BaseExecuter = rec, rec
+	if _, err = fd.CreateGroupSnapshot(opt); err == nil {
+		t.Fatal("Expected an error when a member snapshot fails")
+	}
+	expectedCmds = []string{
+		"dmsetup suspend", "dmsetup suspend", "lvcreate -n", "lvcreate -n",
+		"dmsetup resume", "dmsetup resume", "lvremove --config",
+	}
+	if !reflect.DeepEqual(rec.cmds, expectedCmds) {
+		t.Errorf("Expected %v, got %v\n", expectedCmds, rec.cmds)
+	}
+}
+
+func TestUpdateVolumeGroup(t *testing.T) {
+	var fd = &Driver{}
+	config.CONF.OsdsDock.Backends.LVM.ConfigPath = "testdata/lvm.yaml"
+	fd.Setup()
+
+	opt := &pb.UpdateVolumeGroupOpts{
+		Id: "3769855c-a102-11e7-b772-17b880d2f555",
+		AddVolumesRef: []*pb.VolumeRef{
+			{
+				Id:       "bd5b12a8-a101-11e7-941e-d77981b584d8",
+				Status:   "available",
+				Metadata: map[string]string{"lvPath": "/dev/vg001/test001"},
+			},
+			{
+				Id:       "c2a5f7b0-a101-11e7-941e-d77981b584d8",
+				Status:   "available",
+				Metadata: map[string]string{"lvPath": "/dev/vg001/test002"},
+			},
+		},
+		RemoveVolumesRef: []*pb.VolumeRef{
+			{
+				Id:       "e0c4b4d2-7f41-11e9-a6b2-3f5e8c1d4b90",
+				Status:   "inUse",
+				Metadata: map[string]string{"lvPath": "/dev/vg001/test003"},
+			},
+		},
+	}
+
+	// The tag of the second volume can't be added, it's reported in error and
+	// out of the group.
+	rec := &recordExecuter{fail: "vg001/test002"}
+	fd.cli.RootExecuter, fd.cli.BaseExecuter = rec, rec
+	vg, err := fd.UpdateVolumeGroup(opt)
+	if err != nil {
+		t.Fatal("Failed to update volume group:", err)
+	}
+	var expectedCmds = []string{
+		"lvchange --addtag", "lvchange --addtag", "lvchange --deltag",
+	}
+	if !reflect.DeepEqual(rec.cmds, expectedCmds) {
+		t.Errorf("Expected %v, got %v\n", expectedCmds, rec.cmds)
+	}
+	var expected = []*model.VolumeSpec{
+		{
+			BaseModel: &model.BaseModel{Id: "bd5b12a8-a101-11e7-941e-d77981b584d8"},
+			Status:    "available",
+			GroupId:   opt.Id,
+		},
+		{
+			BaseModel: &model.BaseModel{Id: "c2a5f7b0-a101-11e7-941e-d77981b584d8"},
+			Status:    model.VolumeError,
+		},
+		{
+			BaseModel: &model.BaseModel{Id: "e0c4b4d2-7f41-11e9-a6b2-3f5e8c1d4b90"},
+			Status:    "inUse",
+		},
+	}
+	if !reflect.DeepEqual(vg.Volumes, expected) {
+		t.Errorf("Expected %+v, got %+v\n", expected, vg.Volumes)
+	}
+
+	// The volume whose tag can't be deleted stays in the group.
+	rec = &recordExecuter{fail: "--deltag"}
+	fd.cli.RootExecuter, fd.cli.BaseExecuter = rec, rec
+	vg, err = fd.UpdateVolumeGroup(&pb.UpdateVolumeGroupOpts{
+		Id:               opt.Id,
+		RemoveVolumesRef: opt.RemoveVolumesRef,
+	})
+	if err != nil {
+		t.Fatal("Failed to update volume group:", err)
+	}
+	if len(vg.Volumes) != 1 || vg.Volumes[0].Status != model.VolumeError ||
+		vg.Volumes[0].GroupId != opt.Id {
+		t.Errorf("Unexpected members: %+v\n", vg.Volumes)
+	}
+}
+
+func TestDeleteVolumeGroup(t *testing.T) {
+	var fd = &Driver{}
+	config.CONF.OsdsDock.Backends.LVM.ConfigPath = "testdata/lvm.yaml"
+	fd.Setup()
+
+	rec := &recordExecuter{fail: "--deltag"}
+	fd.cli.RootExecuter, fd.cli.BaseExecuter = rec, rec
+	opt := &pb.DeleteVolumeGroupOpts{
+		Id: "3769855c-a102-11e7-b772-17b880d2f555",
+		VolumesRef: []*pb.VolumeRef{
+			{Id: "bd5b12a8-a101-11e7-941e-d77981b584d8", Metadata: map[string]string{"lvPath": "/dev/vg001/test001"}},
+			{Id: "c2a5f7b0-a101-11e7-941e-d77981b584d8", Metadata: map[string]string{"lvPath": "/dev/vg001/test002"}},
+		},
+	}
+	// The members are deleted afterwards, so the failures are ignored.
+	if err := fd.DeleteVolumeGroup(opt); err != nil {
+		t.Error("Failed to delete volume group:", err)
+	}
+	var expectedCmds = []string{"lvchange --deltag", "lvchange --deltag"}
+	if !reflect.DeepEqual(rec.cmds, expectedCmds) {
+		t.Errorf("Expected %v, got %v\n", expectedCmds, rec.cmds)
+	}
+}
@@ -22,6 +22,8 @@ Go SDK.
 package cinder
 
 import (
+	"errors"
+	"fmt"
 	"time"
 
 	log "github.com/golang/glog"
@@ -33,6 +35,7 @@ import (
 	snapshotsv2 "github.com/gophercloud/gophercloud/openstack/blockstorage/v2/snapshots"
 	volumesv2 "github.com/gophercloud/gophercloud/openstack/blockstorage/v2/volumes"
 	"github.com/gophercloud/gophercloud/pagination"
+	"github.com/opensds/opensds/contrib/drivers"
 	. "github.com/opensds/opensds/contrib/drivers/utils/config"
 	"github.com/opensds/opensds/pkg/model"
 	pb "github.com/opensds/opensds/pkg/model/proto"
@@ -41,6 +44,11 @@ import (
 	uuid "github.com/satori/go.uuid"
 )
 
+func init() {
+	drivers.RegisterVolumeDriver(CinderDriverType, func() drivers.VolumeDriver { return &Driver{} },
+		drivers.Capability{VolumeGroup: true})
+}
+
 const (
 	defaultConfPath = "/etc/opensds/driver/cinder.yaml"
 	KCinderVolumeId = "cinderVolumeId"
@@ -76,6 +84,11 @@ type AuthOptions struct {
 type CinderConfig struct {
 	AuthOptions `yaml:"authOptions"`
 	Pool        map[string]PoolProperties `yaml:"pool,flow"`
+	// The group type and the volume types of the cinder groups which hold
+	// the volume groups, the volume groups are only kept in opensds if they
+	// are not set.
+	GroupType   string   `yaml:"groupType,omitempty"`
+	VolumeTypes []string `yaml:"volumeTypes,omitempty"`
 }
 
 //ListPoolOpts
@@ -167,6 +180,7 @@ func (d *Driver) Setup() error {
 			return err
 		}
 	}
+	d.blockStoragev3 = newBlockStorageV3(d.blockStoragev2)
 
 	return nil
 }
@@ -183,6 +197,29 @@ func (d *Driver) CreateVolume(req *pb.CreateVolumeOpts) (*model.VolumeSpec, erro
 		Size:        int(req.GetSize()),
 	}
 
+	return d.createVolume(req, opts)
+}
+
+// CloneVolume
+func (d *Driver) CloneVolume(req *pb.CreateVolumeOpts) (*model.VolumeSpec, error) {
+	srcVolID, ok := req.GetMetadata()[KCinderVolumeId]
+	if !ok {
+		err := errors.New("can't find cinder volume id in source volume metadata")
+		log.Error(err)
+		return nil, err
+	}
+	//Configure create request body.
+	opts := &volumesv2.CreateOpts{
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		Size:        int(req.GetSize()),
+		SourceVolID: srcVolID,
+	}
+
+	return d.createVolume(req, opts)
+}
+
+func (d *Driver) createVolume(req *pb.CreateVolumeOpts, opts *volumesv2.CreateOpts) (*model.VolumeSpec, error) {
 	vol, err := volumesv2.Create(d.blockStoragev2, opts).Extract()
 	if err != nil {
 		log.Error("Cannot create volume:", err)
@@ -199,7 +236,7 @@ func (d *Driver) CreateVolume(req *pb.CreateVolumeOpts) (*model.VolumeSpec, erro
 		for {
 			select {
 			case <-ticker.C:
-				tmpVol, err := d.PullVolume(vol.ID)
+				tmpVol, err := d.getVolume(vol.ID)
 				if err != nil {
 					continue
 				}
@@ -230,9 +267,30 @@ func (d *Driver) CreateVolume(req *pb.CreateVolumeOpts) (*model.VolumeSpec, erro
 	}, nil
 }
 
-// PullVolume
-func (d *Driver) PullVolume(volID string) (*model.VolumeSpec, error) {
+// PullVolume gets the cinder volume of the volume.
+func (d *Driver) PullVolume(req *pb.PullVolumeOpts) (*model.VolumeSpec, error) {
+	cinderVolId, ok := req.GetMetadata()[KCinderVolumeId]
+	if !ok {
+		err := errors.New("can't find cinder volume id in volume metadata")
+		log.Error(err)
+		return nil, err
+	}
+	vol, err := d.getVolume(cinderVolId)
+	if err != nil {
+		return nil, err
+	}
+	vol.Id = req.GetId()
+	vol.PoolId = req.GetPoolId()
+	vol.Metadata = map[string]string{KCinderVolumeId: cinderVolId}
+	return vol, nil
+}
+
+// getVolume gets the cinder volume by its id in cinder.
+func (d *Driver) getVolume(volID string) (*model.VolumeSpec, error) {
 	vol, err := volumesv2.Get(d.blockStoragev2, volID).Extract()
+	if _, ok := err.(gophercloud.ErrDefault404); ok {
+		return nil, model.NewNotFoundError(fmt.Sprintf("cinder volume %s doesn't exist", volID))
+	}
 	if err != nil {
 		log.Error("Cannot get volume:", err)
 		return nil, err
@@ -284,6 +342,43 @@ func (d *Driver) ExtendVolume(req *pb.ExtendVolumeOpts) (*model.VolumeSpec, erro
 	}, nil
 }
 
+// MigrateVolume
+func (d *Driver) MigrateVolume(req *pb.MigrateVolumeOpts) (*model.VolumeSpec, error) {
+	return nil, &model.NotImplementError{S: "method MigrateVolume has not been implemented yet"}
+}
+
+// RevertVolumeToSnapshot
+func (d *Driver) RevertVolumeToSnapshot(req *pb.RevertVolumeToSnapshotOpts) error {
+	return &model.NotImplementError{S: "method RevertVolumeToSnapshot has not been implemented yet"}
+}
+
+// ManageVolume takes over the cinder volume whose id is the identifier, the
+// cinder volume is kept as it is.
+func (d *Driver) ManageVolume(req *pb.ManageVolumeOpts) (*model.VolumeSpec, error) {
+	vol, err := d.getVolume(req.GetIdentifier())
+	if err != nil {
+		return nil, err
+	}
+	if vol.Status != "available" {
+		err := fmt.Errorf("cinder volume %s is %s, only the available one can be managed", req.GetIdentifier(), vol.Status)
+		log.Error(err)
+		return nil, err
+	}
+
+	return &model.VolumeSpec{
+		BaseModel: &model.BaseModel{
+			Id: req.GetId(),
+		},
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		Size:        vol.Size,
+		Metadata:    map[string]string{KCinderVolumeId: req.GetIdentifier()},
+	}, nil
+}
+
+// UnmanageVolume
+func (d *Driver) UnmanageVolume(req *pb.UnmanageVolumeOpts) error { return nil }
+
 // InitializeConnection
 func (d *Driver) InitializeConnection(req *pb.CreateVolumeAttachmentOpts) (*model.ConnectionInfo, error) {
 	opts := &volumeactions.InitializeConnectionOpts{
@@ -363,7 +458,7 @@ func (d *Driver) CreateSnapshot(req *pb.CreateVolumeSnapshotOpts) (*model.Volume
 		for {
 			select {
 			case <-ticker.C:
-				tmpSnp, err := d.PullSnapshot(snp.ID)
+				tmpSnp, err := d.getSnapshot(snp.ID)
 				if err != nil {
 					continue
 				}
@@ -394,9 +489,30 @@ func (d *Driver) CreateSnapshot(req *pb.CreateVolumeSnapshotOpts) (*model.Volume
 	}, nil
 }
 
-// PullSnapshot
-func (d *Driver) PullSnapshot(snapID string) (*model.VolumeSnapshotSpec, error) {
+// PullSnapshot gets the cinder snapshot of the snapshot.
+func (d *Driver) PullSnapshot(req *pb.PullVolumeSnapshotOpts) (*model.VolumeSnapshotSpec, error) {
+	cinderSnapId, ok := req.GetMetadata()[KCinderSnapId]
+	if !ok {
+		err := errors.New("can't find cinder snapshot id in snapshot metadata")
+		log.Error(err)
+		return nil, err
+	}
+	snp, err := d.getSnapshot(cinderSnapId)
+	if err != nil {
+		return nil, err
+	}
+	snp.Id = req.GetId()
+	snp.VolumeId = req.GetVolumeId()
+	snp.Metadata = map[string]string{KCinderSnapId: cinderSnapId}
+	return snp, nil
+}
+
+// getSnapshot gets the cinder snapshot by its id in cinder.
+func (d *Driver) getSnapshot(snapID string) (*model.VolumeSnapshotSpec, error) {
 	snp, err := snapshotsv2.Get(d.blockStoragev2, snapID).Extract()
+	if _, ok := err.(gophercloud.ErrDefault404); ok {
+		return nil, model.NewNotFoundError(fmt.Sprintf("cinder snapshot %s doesn't exist", snapID))
+	}
 	if err != nil {
 		log.Error("Cannot get snapshot:", err)
 		return nil, err
@@ -413,6 +529,36 @@ func (d *Driver) PullSnapshot(snapID string) (*model.VolumeSnapshotSpec, error)
 	}, nil
 }
 
+// ManageSnapshot takes over the cinder snapshot whose id is the identifier,
+// which must be taken from the cinder volume of the volume.
+func (d *Driver) ManageSnapshot(req *pb.ManageVolumeSnapshotOpts) (*model.VolumeSnapshotSpec, error) {
+	snp, err := snapshotsv2.Get(d.blockStoragev2, req.GetIdentifier()).Extract()
+	if err != nil {
+		log.Error("Cannot get snapshot:", err)
+		return nil, err
+	}
+	cinderVolId := req.GetMetadata()[KCinderVolumeId]
+	if snp.VolumeID != cinderVolId {
+		err := fmt.Errorf("cinder snapshot %s is not taken from cinder volume %s", req.GetIdentifier(), cinderVolId)
+		log.Error(err)
+		return nil, err
+	}
+
+	return &model.VolumeSnapshotSpec{
+		BaseModel: &model.BaseModel{
+			Id: req.GetId(),
+		},
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		Size:        int64(snp.Size),
+		VolumeId:    req.GetVolumeId(),
+		Metadata:    map[string]string{KCinderSnapId: snp.ID},
+	}, nil
+}
+
+// UnmanageSnapshot
+func (d *Driver) UnmanageSnapshot(req *pb.UnmanageVolumeSnapshotOpts) error { return nil }
+
 // DeleteSnapshot
 func (d *Driver) DeleteSnapshot(req *pb.DeleteVolumeSnapshotOpts) error {
 	cinderSnapId := req.Metadata[KCinderSnapId]
@@ -470,6 +616,17 @@ func (d *Driver) ListPools() ([]*model.StoragePoolSpec, error) {
 	return pols, nil
 }
 
+// ListVolumes isn't supported because the cinder volumes are named after the
+// volumes, so the ones created by opensds can't be told apart.
+func (d *Driver) ListVolumes(opt *pb.ListVolumesOpts) ([]*model.VolumeSpec, error) {
+	return nil, &model.NotImplementError{S: "method ListVolumes has not been implemented yet"}
+}
+
+// ListSnapshots isn't supported for the same reason as ListVolumes.
+func (d *Driver) ListSnapshots(opt *pb.ListVolumesOpts) ([]*model.VolumeSnapshotSpec, error) {
+	return nil, &model.NotImplementError{S: "method ListSnapshots has not been implemented yet"}
+}
+
 func (d *Driver) InitializeSnapshotConnection(opt *pb.CreateSnapshotAttachmentOpts) (*model.ConnectionInfo, error) {
 	return nil, &model.NotImplementError{S: "method InitializeSnapshotConnection has not been implemented yet"}
 }
@@ -478,14 +635,10 @@ func (d *Driver) TerminateSnapshotConnection(opt *pb.DeleteSnapshotAttachmentOpt
 	return &model.NotImplementError{S: "method TerminateSnapshotConnection has not been implemented yet"}
 }
 
-func (d *Driver) CreateVolumeGroup(opt *pb.CreateVolumeGroupOpts) (*model.VolumeGroupSpec, error) {
-	return nil, &model.NotImplementError{"method CreateVolumeGroup has not been implemented yet"}
-}
-
-func (d *Driver) UpdateVolumeGroup(opt *pb.UpdateVolumeGroupOpts) (*model.VolumeGroupSpec, error) {
-	return nil, &model.NotImplementError{"method UpdateVolumeGroup has not been implemented yet"}
+func (d *Driver) CreateGroupSnapshot(opt *pb.CreateGroupSnapshotOpts) ([]*model.VolumeSnapshotSpec, error) {
+	return nil, &model.NotImplementError{S: "method CreateGroupSnapshot has not been implemented yet"}
 }
 
-func (d *Driver) DeleteVolumeGroup(opt *pb.DeleteVolumeGroupOpts) error {
-	return &model.NotImplementError{"method DeleteVolumeGroup has not been implemented yet"}
+func (d *Driver) DeleteGroupSnapshot(opt *pb.DeleteGroupSnapshotOpts) error {
+	return &model.NotImplementError{S: "method DeleteGroupSnapshot has not been implemented yet"}
 }
@@ -0,0 +1,258 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cinder
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	log "github.com/golang/glog"
+	"github.com/gophercloud/gophercloud"
+	"github.com/opensds/opensds/pkg/model"
+	pb "github.com/opensds/opensds/pkg/model/proto"
+)
+
+const (
+	KCinderGroupId = "cinderGroupId"
+	// The microversion of the block storage api which introduces the generic
+	// volume groups.
+	groupMicroversion = "3.13"
+)
+
+// newBlockStorageV3 derives the client of the block storage v3 api from the
+// one of v2, the vendored gophercloud doesn't provide the v3 api which the
+// generic volume groups belong to.
+func newBlockStorageV3(v2 *gophercloud.ServiceClient) *gophercloud.ServiceClient {
+	v3 := *v2
+	v3.Endpoint = strings.Replace(v2.Endpoint, "/v2/", "/v3/", 1)
+	v3.ResourceBase = ""
+	v3.Type = "volume"
+	v3.Microversion = groupMicroversion
+	return &v3
+}
+
+type cinderGroup struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+}
+
+func (d *Driver) getGroup(groupID string) (*cinderGroup, error) {
+	var res struct {
+		Group cinderGroup `json:"group"`
+	}
+	_, err := d.blockStoragev3.Get(d.blockStoragev3.ServiceURL("groups", groupID), &res, nil)
+	if _, ok := err.(gophercloud.ErrDefault404); ok {
+		return nil, model.NewNotFoundError(fmt.Sprintf("cinder group %s doesn't exist", groupID))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &res.Group, nil
+}
+
+// waitGroup waits until the cinder group is neither being created nor being
+// updated, it times out after 10s as the volumes do.
+func (d *Driver) waitGroup(groupID string) (*cinderGroup, error) {
+	timeout := time.After(10 * time.Second)
+	for {
+		grp, err := d.getGroup(groupID)
+		if err != nil {
+			return nil, err
+		}
+		if grp.Status != "creating" && grp.Status != "updating" {
+			return grp, nil
+		}
+		select {
+		case <-timeout:
+			return nil, fmt.Errorf("cinder group %s is still %s after 10s", groupID, grp.Status)
+		case <-time.After(300 * time.Millisecond):
+		}
+	}
+}
+
+// volumeGroupID returns the id of the cinder group which the cinder volume is
+// in, it's empty if the cinder volume isn't in any group.
+func (d *Driver) volumeGroupID(volID string) (string, error) {
+	var res struct {
+		Volume struct {
+			GroupID string `json:"group_id"`
+		} `json:"volume"`
+	}
+	if _, err := d.blockStoragev3.Get(d.blockStoragev3.ServiceURL("volumes", volID), &res, nil); err != nil {
+		return "", err
+	}
+	return res.Volume.GroupID, nil
+}
+
+// CreateVolumeGroup creates the cinder group of the volume group and puts the
+// cinder volumes of the members into it.
+func (d *Driver) CreateVolumeGroup(opt *pb.CreateVolumeGroupOpts) (*model.VolumeGroupSpec, error) {
+	if d.conf.GroupType == "" || len(d.conf.VolumeTypes) == 0 {
+		return nil, &model.NotImplementError{S: "groupType and volumeTypes of cinder groups are not configured"}
+	}
+
+	body := map[string]interface{}{
+		"group": map[string]interface{}{
+			"name":         opt.GetName(),
+			"description":  opt.GetDescription(),
+			"group_type":   d.conf.GroupType,
+			"volume_types": d.conf.VolumeTypes,
+		},
+	}
+	var res struct {
+		Group cinderGroup `json:"group"`
+	}
+	if _, err := d.blockStoragev3.Post(d.blockStoragev3.ServiceURL("groups"), body, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	}); err != nil {
+		log.Error("Cannot create group:", err)
+		return nil, err
+	}
+	grp, err := d.waitGroup(res.Group.ID)
+	if err != nil {
+		log.Error("Cannot create group:", err)
+		return nil, err
+	}
+	if grp.Status != "available" {
+		err := fmt.Errorf("cinder group %s is %s after it's created", grp.ID, grp.Status)
+		log.Error(err)
+		return nil, err
+	}
+
+	return &model.VolumeGroupSpec{
+		BaseModel: &model.BaseModel{
+			Id: opt.GetId(),
+		},
+		Name:             opt.GetName(),
+		Description:      opt.GetDescription(),
+		AvailabilityZone: opt.GetAvailabilityZone(),
+		PoolId:           opt.GetPoolId(),
+		Metadata:         map[string]string{KCinderGroupId: grp.ID},
+		Volumes:          d.updateGroupVolumes(grp.ID, opt.GetId(), opt.GetAddVolumesRef(), nil),
+	}, nil
+}
+
+func (d *Driver) UpdateVolumeGroup(opt *pb.UpdateVolumeGroupOpts) (*model.VolumeGroupSpec, error) {
+	cinderGroupID, ok := opt.GetMetadata()[KCinderGroupId]
+	if !ok {
+		return nil, &model.NotImplementError{S: "volume group isn't held by any cinder group"}
+	}
+
+	return &model.VolumeGroupSpec{
+		BaseModel: &model.BaseModel{
+			Id: opt.GetId(),
+		},
+		PoolId:  opt.GetPoolId(),
+		Volumes: d.updateGroupVolumes(cinderGroupID, opt.GetId(), opt.GetAddVolumesRef(), opt.GetRemoveVolumesRef()),
+	}, nil
+}
+
+// DeleteVolumeGroup takes the cinder volumes out of the cinder group before
+// deleting it, so that the cinder volumes are kept.
+func (d *Driver) DeleteVolumeGroup(opt *pb.DeleteVolumeGroupOpts) error {
+	cinderGroupID, ok := opt.GetMetadata()[KCinderGroupId]
+	if !ok {
+		return &model.NotImplementError{S: "volume group isn't held by any cinder group"}
+	}
+	if _, err := d.getGroup(cinderGroupID); err != nil {
+		if _, ok := err.(*model.NotFoundError); ok {
+			log.Warningf("Specified cinder group (%s) does not exist, ignore it", cinderGroupID)
+			return nil
+		}
+		return err
+	}
+
+	if len(opt.GetVolumesRef()) > 0 {
+		for _, vol := range d.updateGroupVolumes(cinderGroupID, opt.GetId(), nil, opt.GetVolumesRef()) {
+			if vol.Status == model.VolumeError {
+				return fmt.Errorf("cannot remove volume %s from cinder group %s", vol.Id, cinderGroupID)
+			}
+		}
+	}
+
+	body := map[string]interface{}{
+		"delete": map[string]interface{}{
+			"delete-volumes": false,
+		},
+	}
+	if _, err := d.blockStoragev3.Post(d.blockStoragev3.ServiceURL("groups", cinderGroupID, "action"), body, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	}); err != nil {
+		log.Error("Cannot delete group:", err)
+		return err
+	}
+	return nil
+}
+
+// updateGroupVolumes adds the cinder volumes to the cinder group and removes
+// them from it. Cinder updates the group all or nothing, the status of each of
+// the volumes is reported by checking the group of its cinder volume.
+func (d *Driver) updateGroupVolumes(cinderGroupID, groupId string, addVolumesRef, removeVolumesRef []*pb.VolumeRef) []*model.VolumeSpec {
+	var addVolumes, removeVolumes []string
+	for _, ref := range addVolumesRef {
+		addVolumes = append(addVolumes, ref.GetMetadata()[KCinderVolumeId])
+	}
+	for _, ref := range removeVolumesRef {
+		removeVolumes = append(removeVolumes, ref.GetMetadata()[KCinderVolumeId])
+	}
+	if len(addVolumes)+len(removeVolumes) == 0 {
+		return nil
+	}
+
+	body := map[string]interface{}{
+		"group": map[string]interface{}{
+			"add_volumes":    strings.Join(addVolumes, ","),
+			"remove_volumes": strings.Join(removeVolumes, ","),
+		},
+	}
+	if _, err := d.blockStoragev3.Put(d.blockStoragev3.ServiceURL("groups", cinderGroupID), body, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	}); err != nil {
+		log.Error("Cannot update group:", err)
+	} else if _, err := d.waitGroup(cinderGroupID); err != nil {
+		log.Error("Cannot update group:", err)
+	}
+
+	var vols []*model.VolumeSpec
+	for i, ref := range addVolumesRef {
+		vol := &model.VolumeSpec{
+			BaseModel: &model.BaseModel{
+				Id: ref.GetId(),
+			},
+			Status:  ref.GetStatus(),
+			GroupId: groupId,
+		}
+		if id, err := d.volumeGroupID(addVolumes[i]); err != nil || id != cinderGroupID {
+			log.Errorf("add volume (%s) to volume group (%s) failed", ref.GetId(), groupId)
+			vol.Status, vol.GroupId = model.VolumeError, ""
+		}
+		vols = append(vols, vol)
+	}
+	for i, ref := range removeVolumesRef {
+		vol := &model.VolumeSpec{
+			BaseModel: &model.BaseModel{
+				Id: ref.GetId(),
+			},
+			Status: ref.GetStatus(),
+		}
+		if id, err := d.volumeGroupID(removeVolumes[i]); err != nil || id == cinderGroupID {
+			log.Errorf("remove volume (%s) from volume group (%s) failed", ref.GetId(), groupId)
+			vol.Status, vol.GroupId = model.VolumeError, groupId
+		}
+		vols = append(vols, vol)
+	}
+	return vols
+}
@@ -0,0 +1,481 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+This module implements the proxy drivers of the storage driver plugins, which
+run out of the dock process and are served over gRPC on a unix socket. The
+dock loads the proxy when the driver name of a backend is plugin:<socket>.
+
+*/
+
+package drivers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	log "github.com/golang/glog"
+	"github.com/opensds/opensds/pkg/model"
+	pb "github.com/opensds/opensds/pkg/model/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// PluginDriverPrefix is the prefix of the driver name which tells the
+	// dock to load the driver from the plugin listening on the socket.
+	PluginDriverPrefix = "plugin:"
+	// PluginProtocolVersion is the version of the plugin protocol, the dock
+	// and the plugin must speak the same version, it is bumped whenever a
+	// method is added to the plugin service.
+	PluginProtocolVersion = 4
+
+	pluginDialTimeout = 10 * time.Second
+)
+
+// IsPluginDriver tells whether the driver is served by a plugin.
+func IsPluginDriver(dType string) bool {
+	return strings.HasPrefix(dType, PluginDriverPrefix)
+}
+
+// pluginConn is the connection to a plugin which has passed the handshake.
+type pluginConn struct {
+	*grpc.ClientConn
+	name       string
+	capability Capability
+}
+
+// dialPlugin connects to the plugin and checks that it speaks the same
+// protocol version as the dock.
+func dialPlugin(dType string) (*pluginConn, error) {
+	socket := strings.TrimPrefix(dType, PluginDriverPrefix)
+	if socket == "" {
+		return nil, fmt.Errorf("socket of driver plugin %s is empty", dType)
+	}
+	conn, err := grpc.Dial(socket, grpc.WithInsecure(), grpc.WithBlock(),
+		grpc.WithTimeout(pluginDialTimeout),
+		grpc.WithDialer(func(addr string, timeout time.Duration) (net.Conn, error) {
+			return net.DialTimeout("unix", addr, timeout)
+		}))
+	if err != nil {
+		log.Errorf("connect to driver plugin %s failed: %v", socket, err)
+		return nil, err
+	}
+
+	reply, err := pb.NewDriverPluginClient(conn).Handshake(context.Background(),
+		&pb.HandshakeOpts{Version: PluginProtocolVersion})
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("handshake with driver plugin %s failed: %v", socket, err)
+	}
+	if reply.GetVersion() != PluginProtocolVersion {
+		conn.Close()
+		return nil, fmt.Errorf("driver plugin %s speaks protocol version %d, but version %d is required",
+			socket, reply.GetVersion(), PluginProtocolVersion)
+	}
+
+	c := reply.GetCapability()
+	return &pluginConn{
+		ClientConn: conn,
+		name:       reply.GetName(),
+		capability: Capability{
+			VolumeGroup:    c.GetVolumeGroup(),
+			Replication:    c.GetReplication(),
+			SnapshotAttach: c.GetSnapshotAttach(),
+			Metrics:        c.GetMetrics(),
+		},
+	}, nil
+}
+
+// pluginCapability asks the plugin what it supports.
+func pluginCapability(dType string) (Capability, error) {
+	conn, err := dialPlugin(dType)
+	if err != nil {
+		return Capability{}, err
+	}
+	defer conn.Close()
+	return conn.capability, nil
+}
+
+// parsePluginReply turns the reply of the plugin into result. The plugin
+// returns codes.Unimplemented for NotImplementError, which is restored so that
+// the dock is still able to fall back to the generic way, and so are
+// codes.NotFound for NotFoundError and codes.FailedPrecondition for
+// PreconditionError.
+func parsePluginReply(res *pb.GenericResponse, err error, result interface{}) error {
+	if err != nil {
+		switch status.Code(err) {
+		case codes.Unimplemented:
+			return &model.NotImplementError{S: status.Convert(err).Message()}
+		case codes.NotFound:
+			return model.NewNotFoundError(status.Convert(err).Message())
+		case codes.FailedPrecondition:
+			return model.NewPreconditionError(status.Convert(err).Message())
+		}
+		return err
+	}
+	if errorMsg := res.GetError(); errorMsg != nil {
+		return fmt.Errorf("driver plugin failed, code: %v, message: %v",
+			errorMsg.GetCode(), errorMsg.GetDescription())
+	}
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal([]byte(res.GetResult().GetMessage()), result)
+}
+
+// pluginDriver is the proxy of the VolumeDriver served by the plugin.
+type pluginDriver struct {
+	conn   *pluginConn
+	client pb.DriverPluginClient
+}
+
+func newPluginDriver(dType string) (*pluginDriver, error) {
+	conn, err := dialPlugin(dType)
+	if err != nil {
+		return nil, err
+	}
+	return &pluginDriver{conn: conn, client: pb.NewDriverPluginClient(conn.ClientConn)}, nil
+}
+
+func (d *pluginDriver) Setup() error {
+	res, err := d.client.Setup(context.Background(), &pb.PluginOpts{})
+	if err = parsePluginReply(res, err, nil); err != nil {
+		d.conn.Close()
+	}
+	return err
+}
+
+func (d *pluginDriver) Unset() error {
+	defer d.conn.Close()
+	res, err := d.client.Unset(context.Background(), &pb.PluginOpts{})
+	return parsePluginReply(res, err, nil)
+}
+
+func (d *pluginDriver) CreateVolume(opt *pb.CreateVolumeOpts) (*model.VolumeSpec, error) {
+	var vol = &model.VolumeSpec{}
+	res, err := d.client.CreateVolume(context.Background(), opt)
+	if err = parsePluginReply(res, err, vol); err != nil {
+		return nil, err
+	}
+	return vol, nil
+}
+
+func (d *pluginDriver) CloneVolume(opt *pb.CreateVolumeOpts) (*model.VolumeSpec, error) {
+	var vol = &model.VolumeSpec{}
+	res, err := d.client.CloneVolume(context.Background(), opt)
+	if err = parsePluginReply(res, err, vol); err != nil {
+		return nil, err
+	}
+	return vol, nil
+}
+
+func (d *pluginDriver) PullVolume(opt *pb.PullVolumeOpts) (*model.VolumeSpec, error) {
+	var vol = &model.VolumeSpec{}
+	res, err := d.client.PullVolume(context.Background(), opt)
+	if err = parsePluginReply(res, err, vol); err != nil {
+		return nil, err
+	}
+	return vol, nil
+}
+
+func (d *pluginDriver) DeleteVolume(opt *pb.DeleteVolumeOpts) error {
+	res, err := d.client.DeleteVolume(context.Background(), opt)
+	return parsePluginReply(res, err, nil)
+}
+
+func (d *pluginDriver) ExtendVolume(opt *pb.ExtendVolumeOpts) (*model.VolumeSpec, error) {
+	var vol = &model.VolumeSpec{}
+	res, err := d.client.ExtendVolume(context.Background(), opt)
+	if err = parsePluginReply(res, err, vol); err != nil {
+		return nil, err
+	}
+	return vol, nil
+}
+
+func (d *pluginDriver) MigrateVolume(opt *pb.MigrateVolumeOpts) (*model.VolumeSpec, error) {
+	var vol = &model.VolumeSpec{}
+	res, err := d.client.MigrateVolume(context.Background(), opt)
+	if err = parsePluginReply(res, err, vol); err != nil {
+		return nil, err
+	}
+	return vol, nil
+}
+
+func (d *pluginDriver) RevertVolumeToSnapshot(opt *pb.RevertVolumeToSnapshotOpts) error {
+	res, err := d.client.RevertVolumeToSnapshot(context.Background(), opt)
+	return parsePluginReply(res, err, nil)
+}
+
+func (d *pluginDriver) ManageVolume(opt *pb.ManageVolumeOpts) (*model.VolumeSpec, error) {
+	var vol = &model.VolumeSpec{}
+	res, err := d.client.ManageVolume(context.Background(), opt)
+	if err = parsePluginReply(res, err, vol); err != nil {
+		return nil, err
+	}
+	return vol, nil
+}
+
+func (d *pluginDriver) UnmanageVolume(opt *pb.UnmanageVolumeOpts) error {
+	res, err := d.client.UnmanageVolume(context.Background(), opt)
+	return parsePluginReply(res, err, nil)
+}
+
+func (d *pluginDriver) InitializeConnection(opt *pb.CreateVolumeAttachmentOpts) (*model.ConnectionInfo, error) {
+	var info = &model.ConnectionInfo{}
+	res, err := d.client.InitializeConnection(context.Background(), opt)
+	if err = parsePluginReply(res, err, info); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+func (d *pluginDriver) TerminateConnection(opt *pb.DeleteVolumeAttachmentOpts) error {
+	res, err := d.client.TerminateConnection(context.Background(), opt)
+	return parsePluginReply(res, err, nil)
+}
+
+func (d *pluginDriver) CreateSnapshot(opt *pb.CreateVolumeSnapshotOpts) (*model.VolumeSnapshotSpec, error) {
+	var snap = &model.VolumeSnapshotSpec{}
+	res, err := d.client.CreateSnapshot(context.Background(), opt)
+	if err = parsePluginReply(res, err, snap); err != nil {
+		return nil, err
+	}
+	return snap, nil
+}
+
+func (d *pluginDriver) PullSnapshot(opt *pb.PullVolumeSnapshotOpts) (*model.VolumeSnapshotSpec, error) {
+	var snap = &model.VolumeSnapshotSpec{}
+	res, err := d.client.PullSnapshot(context.Background(), opt)
+	if err = parsePluginReply(res, err, snap); err != nil {
+		return nil, err
+	}
+	return snap, nil
+}
+
+func (d *pluginDriver) ManageSnapshot(opt *pb.ManageVolumeSnapshotOpts) (*model.VolumeSnapshotSpec, error) {
+	var snap = &model.VolumeSnapshotSpec{}
+	res, err := d.client.ManageSnapshot(context.Background(), opt)
+	if err = parsePluginReply(res, err, snap); err != nil {
+		return nil, err
+	}
+	return snap, nil
+}
+
+func (d *pluginDriver) UnmanageSnapshot(opt *pb.UnmanageVolumeSnapshotOpts) error {
+	res, err := d.client.UnmanageSnapshot(context.Background(), opt)
+	return parsePluginReply(res, err, nil)
+}
+
+func (d *pluginDriver) DeleteSnapshot(opt *pb.DeleteVolumeSnapshotOpts) error {
+	res, err := d.client.DeleteSnapshot(context.Background(), opt)
+	return parsePluginReply(res, err, nil)
+}
+
+func (d *pluginDriver) InitializeSnapshotConnection(opt *pb.CreateSnapshotAttachmentOpts) (*model.ConnectionInfo, error) {
+	var info = &model.ConnectionInfo{}
+	res, err := d.client.InitializeSnapshotConnection(context.Background(), opt)
+	if err = parsePluginReply(res, err, info); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+func (d *pluginDriver) TerminateSnapshotConnection(opt *pb.DeleteSnapshotAttachmentOpts) error {
+	res, err := d.client.TerminateSnapshotConnection(context.Background(), opt)
+	return parsePluginReply(res, err, nil)
+}
+
+func (d *pluginDriver) CreateVolumeGroup(opt *pb.CreateVolumeGroupOpts) (*model.VolumeGroupSpec, error) {
+	var vg = &model.VolumeGroupSpec{}
+	res, err := d.client.CreateVolumeGroup(context.Background(), opt)
+	if err = parsePluginReply(res, err, vg); err != nil {
+		return nil, err
+	}
+	return vg, nil
+}
+
+func (d *pluginDriver) UpdateVolumeGroup(opt *pb.UpdateVolumeGroupOpts) (*model.VolumeGroupSpec, error) {
+	var vg = &model.VolumeGroupSpec{}
+	res, err := d.client.UpdateVolumeGroup(context.Background(), opt)
+	if err = parsePluginReply(res, err, vg); err != nil {
+		return nil, err
+	}
+	return vg, nil
+}
+
+func (d *pluginDriver) DeleteVolumeGroup(opt *pb.DeleteVolumeGroupOpts) error {
+	res, err := d.client.DeleteVolumeGroup(context.Background(), opt)
+	return parsePluginReply(res, err, nil)
+}
+
+func (d *pluginDriver) CreateGroupSnapshot(opt *pb.CreateGroupSnapshotOpts) ([]*model.VolumeSnapshotSpec, error) {
+	var snps []*model.VolumeSnapshotSpec
+	res, err := d.client.CreateGroupSnapshot(context.Background(), opt)
+	if err = parsePluginReply(res, err, &snps); err != nil {
+		return nil, err
+	}
+	return snps, nil
+}
+
+func (d *pluginDriver) DeleteGroupSnapshot(opt *pb.DeleteGroupSnapshotOpts) error {
+	res, err := d.client.DeleteGroupSnapshot(context.Background(), opt)
+	return parsePluginReply(res, err, nil)
+}
+
+func (d *pluginDriver) ListPools() ([]*model.StoragePoolSpec, error) {
+	var pols []*model.StoragePoolSpec
+	res, err := d.client.ListPools(context.Background(), &pb.PluginOpts{})
+	if err = parsePluginReply(res, err, &pols); err != nil {
+		return nil, err
+	}
+	return pols, nil
+}
+
+func (d *pluginDriver) ListVolumes(opt *pb.ListVolumesOpts) ([]*model.VolumeSpec, error) {
+	var vols []*model.VolumeSpec
+	res, err := d.client.ListVolumes(context.Background(), opt)
+	if err = parsePluginReply(res, err, &vols); err != nil {
+		return nil, err
+	}
+	return vols, nil
+}
+
+func (d *pluginDriver) ListSnapshots(opt *pb.ListVolumesOpts) ([]*model.VolumeSnapshotSpec, error) {
+	var snaps []*model.VolumeSnapshotSpec
+	res, err := d.client.ListSnapshots(context.Background(), opt)
+	if err = parsePluginReply(res, err, &snaps); err != nil {
+		return nil, err
+	}
+	return snaps, nil
+}
+
+// pluginReplicationDriver is the proxy of the ReplicationDriver served by the
+// plugin.
+type pluginReplicationDriver struct {
+	conn   *pluginConn
+	client pb.ReplicationDriverPluginClient
+}
+
+func newPluginReplicationDriver(dType string) (*pluginReplicationDriver, error) {
+	conn, err := dialPlugin(dType)
+	if err != nil {
+		return nil, err
+	}
+	if !conn.capability.Replication {
+		conn.Close()
+		return nil, fmt.Errorf("driver plugin %s doesn't support replication", conn.name)
+	}
+	return &pluginReplicationDriver{conn: conn, client: pb.NewReplicationDriverPluginClient(conn.ClientConn)}, nil
+}
+
+func (r *pluginReplicationDriver) Setup() error {
+	res, err := r.client.Setup(context.Background(), &pb.PluginOpts{})
+	if err = parsePluginReply(res, err, nil); err != nil {
+		r.conn.Close()
+	}
+	return err
+}
+
+func (r *pluginReplicationDriver) Unset() error {
+	defer r.conn.Close()
+	res, err := r.client.Unset(context.Background(), &pb.PluginOpts{})
+	return parsePluginReply(res, err, nil)
+}
+
+func (r *pluginReplicationDriver) CreateReplication(opt *pb.CreateReplicationOpts) (*model.ReplicationSpec, error) {
+	var replica = &model.ReplicationSpec{}
+	res, err := r.client.CreateReplication(context.Background(), opt)
+	if err = parsePluginReply(res, err, replica); err != nil {
+		return nil, err
+	}
+	return replica, nil
+}
+
+func (r *pluginReplicationDriver) DeleteReplication(opt *pb.DeleteReplicationOpts) error {
+	res, err := r.client.DeleteReplication(context.Background(), opt)
+	return parsePluginReply(res, err, nil)
+}
+
+func (r *pluginReplicationDriver) EnableReplication(opt *pb.EnableReplicationOpts) error {
+	res, err := r.client.EnableReplication(context.Background(), opt)
+	return parsePluginReply(res, err, nil)
+}
+
+func (r *pluginReplicationDriver) DisableReplication(opt *pb.DisableReplicationOpts) error {
+	res, err := r.client.DisableReplication(context.Background(), opt)
+	return parsePluginReply(res, err, nil)
+}
+
+func (r *pluginReplicationDriver) FailoverReplication(opt *pb.FailoverReplicationOpts) error {
+	res, err := r.client.FailoverReplication(context.Background(), opt)
+	return parsePluginReply(res, err, nil)
+}
+
+// pluginMetricDriver is the proxy of the MetricDriver served by the plugin.
+type pluginMetricDriver struct {
+	conn   *pluginConn
+	client pb.MetricDriverPluginClient
+}
+
+func newPluginMetricDriver(dType string) (*pluginMetricDriver, error) {
+	conn, err := dialPlugin(dType)
+	if err != nil {
+		return nil, err
+	}
+	if !conn.capability.Metrics {
+		conn.Close()
+		return nil, fmt.Errorf("driver plugin %s doesn't support metrics", conn.name)
+	}
+	return &pluginMetricDriver{conn: conn, client: pb.NewMetricDriverPluginClient(conn.ClientConn)}, nil
+}
+
+func (m *pluginMetricDriver) Setup() error {
+	res, err := m.client.Setup(context.Background(), &pb.PluginOpts{})
+	if err = parsePluginReply(res, err, nil); err != nil {
+		m.conn.Close()
+	}
+	return err
+}
+
+func (m *pluginMetricDriver) Teardown() error {
+	defer m.conn.Close()
+	res, err := m.client.Teardown(context.Background(), &pb.PluginOpts{})
+	return parsePluginReply(res, err, nil)
+}
+
+func (m *pluginMetricDriver) CollectMetrics(metricList []string, instanceID string) ([]*model.MetricSpec, error) {
+	var metrics []*model.MetricSpec
+	opt := &pb.CollectMetricsOpts{Metrics: metricList, InstanceId: instanceID}
+	res, err := m.client.CollectMetrics(context.Background(), opt)
+	if err = parsePluginReply(res, err, &metrics); err != nil {
+		return nil, err
+	}
+	return metrics, nil
+}
+
+func (m *pluginMetricDriver) ValidateMetricsSupportList(metricList []string, resourceType string) ([]string, error) {
+	var supported []string
+	opt := &pb.ValidateMetricsOpts{Metrics: metricList, ResourceType: resourceType}
+	res, err := m.client.ValidateMetricsSupportList(context.Background(), opt)
+	if err = parsePluginReply(res, err, &supported); err != nil {
+		return nil, err
+	}
+	return supported, nil
+}
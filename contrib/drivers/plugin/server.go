@@ -0,0 +1,293 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+This module implements the server side of the storage driver plugin, which
+helps vendors to serve their drivers out of the dock process. The dock talks
+to the plugin through the proxy drivers in contrib/drivers.
+
+*/
+
+package plugin
+
+import (
+	"context"
+	"net"
+	"os"
+
+	log "github.com/golang/glog"
+	"github.com/opensds/opensds/contrib/drivers"
+	"github.com/opensds/opensds/pkg/model"
+	pb "github.com/opensds/opensds/pkg/model/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Plugin describes the drivers served by a plugin process. The drivers are
+// shared by all the requests, so they must be safe for concurrent use.
+type Plugin struct {
+	// Name is reported to the dock in handshake.
+	Name string
+	// Capability is reported to the dock in handshake, replication and
+	// metrics are only reported if the corresponding drivers are provided.
+	Capability drivers.Capability
+
+	VolumeDriver drivers.VolumeDriver
+	// ReplicationDriver is optional.
+	ReplicationDriver drivers.ReplicationDriver
+	// MetricDriver is optional.
+	MetricDriver drivers.MetricDriver
+}
+
+// NewServer returns a gRPC server which serves the drivers of the plugin.
+func NewServer(p *Plugin) *grpc.Server {
+	s := grpc.NewServer()
+	pb.RegisterDriverPluginServer(s, &volumeServer{p})
+	if p.ReplicationDriver != nil {
+		pb.RegisterReplicationDriverPluginServer(s, &replicationServer{p.ReplicationDriver})
+	}
+	if p.MetricDriver != nil {
+		pb.RegisterMetricDriverPluginServer(s, &metricServer{p.MetricDriver})
+	}
+	return s
+}
+
+// Serve listens on the unix socket and serves the plugin until it fails.
+func Serve(socket string, p *Plugin) error {
+	// Remove the socket left by the last run.
+	if err := os.Remove(socket); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	lis, err := net.Listen("unix", socket)
+	if err != nil {
+		log.Errorf("failed to listen on %s: %v", socket, err)
+		return err
+	}
+
+	log.Infof("Driver plugin %s initialized! Start listening on %s", p.Name, socket)
+	s := NewServer(p)
+	defer s.Stop()
+	return s.Serve(lis)
+}
+
+// reply turns the result of the driver into the reply of the plugin, the
+// NotImplementError is sent as codes.Unimplemented so that the dock is able
+// to fall back to the generic way, the NotFoundError as codes.NotFound and
+// the PreconditionError as codes.FailedPrecondition.
+func reply(result interface{}, err error) (*pb.GenericResponse, error) {
+	if err != nil {
+		switch err.(type) {
+		case *model.NotImplementError:
+			return pb.GenericResponseError(err), status.Error(codes.Unimplemented, err.Error())
+		case *model.NotFoundError:
+			return pb.GenericResponseError(err), status.Error(codes.NotFound, err.Error())
+		case *model.PreconditionError:
+			return pb.GenericResponseError(err), status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return pb.GenericResponseError(err), err
+	}
+	return pb.GenericResponseResult(result), nil
+}
+
+// volumeServer implements pb.DriverPluginServer
+type volumeServer struct {
+	p *Plugin
+}
+
+// Handshake implements pb.DriverPluginServer.Handshake
+func (v *volumeServer) Handshake(ctx context.Context, opt *pb.HandshakeOpts) (*pb.HandshakeReply, error) {
+	if opt.GetVersion() != drivers.PluginProtocolVersion {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"plugin %s speaks protocol version %d, but the dock speaks version %d",
+			v.p.Name, drivers.PluginProtocolVersion, opt.GetVersion())
+	}
+	c := v.p.Capability
+	return &pb.HandshakeReply{
+		Version: drivers.PluginProtocolVersion,
+		Name:    v.p.Name,
+		Capability: &pb.DriverCapability{
+			VolumeGroup:    c.VolumeGroup,
+			Replication:    c.Replication && v.p.ReplicationDriver != nil,
+			SnapshotAttach: c.SnapshotAttach,
+			Metrics:        c.Metrics && v.p.MetricDriver != nil,
+		},
+	}, nil
+}
+
+func (v *volumeServer) Setup(ctx context.Context, opt *pb.PluginOpts) (*pb.GenericResponse, error) {
+	return reply(nil, v.p.VolumeDriver.Setup())
+}
+
+func (v *volumeServer) Unset(ctx context.Context, opt *pb.PluginOpts) (*pb.GenericResponse, error) {
+	return reply(nil, v.p.VolumeDriver.Unset())
+}
+
+func (v *volumeServer) CreateVolume(ctx context.Context, opt *pb.CreateVolumeOpts) (*pb.GenericResponse, error) {
+	return reply(v.p.VolumeDriver.CreateVolume(opt))
+}
+
+func (v *volumeServer) CloneVolume(ctx context.Context, opt *pb.CreateVolumeOpts) (*pb.GenericResponse, error) {
+	return reply(v.p.VolumeDriver.CloneVolume(opt))
+}
+
+func (v *volumeServer) PullVolume(ctx context.Context, opt *pb.PullVolumeOpts) (*pb.GenericResponse, error) {
+	return reply(v.p.VolumeDriver.PullVolume(opt))
+}
+
+func (v *volumeServer) DeleteVolume(ctx context.Context, opt *pb.DeleteVolumeOpts) (*pb.GenericResponse, error) {
+	return reply(nil, v.p.VolumeDriver.DeleteVolume(opt))
+}
+
+func (v *volumeServer) ExtendVolume(ctx context.Context, opt *pb.ExtendVolumeOpts) (*pb.GenericResponse, error) {
+	return reply(v.p.VolumeDriver.ExtendVolume(opt))
+}
+
+func (v *volumeServer) MigrateVolume(ctx context.Context, opt *pb.MigrateVolumeOpts) (*pb.GenericResponse, error) {
+	return reply(v.p.VolumeDriver.MigrateVolume(opt))
+}
+
+func (v *volumeServer) RevertVolumeToSnapshot(ctx context.Context, opt *pb.RevertVolumeToSnapshotOpts) (*pb.GenericResponse, error) {
+	return reply(nil, v.p.VolumeDriver.RevertVolumeToSnapshot(opt))
+}
+
+func (v *volumeServer) ManageVolume(ctx context.Context, opt *pb.ManageVolumeOpts) (*pb.GenericResponse, error) {
+	return reply(v.p.VolumeDriver.ManageVolume(opt))
+}
+
+func (v *volumeServer) UnmanageVolume(ctx context.Context, opt *pb.UnmanageVolumeOpts) (*pb.GenericResponse, error) {
+	return reply(nil, v.p.VolumeDriver.UnmanageVolume(opt))
+}
+
+func (v *volumeServer) InitializeConnection(ctx context.Context, opt *pb.CreateVolumeAttachmentOpts) (*pb.GenericResponse, error) {
+	return reply(v.p.VolumeDriver.InitializeConnection(opt))
+}
+
+func (v *volumeServer) TerminateConnection(ctx context.Context, opt *pb.DeleteVolumeAttachmentOpts) (*pb.GenericResponse, error) {
+	return reply(nil, v.p.VolumeDriver.TerminateConnection(opt))
+}
+
+func (v *volumeServer) CreateSnapshot(ctx context.Context, opt *pb.CreateVolumeSnapshotOpts) (*pb.GenericResponse, error) {
+	return reply(v.p.VolumeDriver.CreateSnapshot(opt))
+}
+
+func (v *volumeServer) PullSnapshot(ctx context.Context, opt *pb.PullVolumeSnapshotOpts) (*pb.GenericResponse, error) {
+	return reply(v.p.VolumeDriver.PullSnapshot(opt))
+}
+
+func (v *volumeServer) ManageSnapshot(ctx context.Context, opt *pb.ManageVolumeSnapshotOpts) (*pb.GenericResponse, error) {
+	return reply(v.p.VolumeDriver.ManageSnapshot(opt))
+}
+
+func (v *volumeServer) UnmanageSnapshot(ctx context.Context, opt *pb.UnmanageVolumeSnapshotOpts) (*pb.GenericResponse, error) {
+	return reply(nil, v.p.VolumeDriver.UnmanageSnapshot(opt))
+}
+
+func (v *volumeServer) DeleteSnapshot(ctx context.Context, opt *pb.DeleteVolumeSnapshotOpts) (*pb.GenericResponse, error) {
+	return reply(nil, v.p.VolumeDriver.DeleteSnapshot(opt))
+}
+
+func (v *volumeServer) InitializeSnapshotConnection(ctx context.Context, opt *pb.CreateSnapshotAttachmentOpts) (*pb.GenericResponse, error) {
+	return reply(v.p.VolumeDriver.InitializeSnapshotConnection(opt))
+}
+
+func (v *volumeServer) TerminateSnapshotConnection(ctx context.Context, opt *pb.DeleteSnapshotAttachmentOpts) (*pb.GenericResponse, error) {
+	return reply(nil, v.p.VolumeDriver.TerminateSnapshotConnection(opt))
+}
+
+func (v *volumeServer) CreateVolumeGroup(ctx context.Context, opt *pb.CreateVolumeGroupOpts) (*pb.GenericResponse, error) {
+	return reply(v.p.VolumeDriver.CreateVolumeGroup(opt))
+}
+
+func (v *volumeServer) UpdateVolumeGroup(ctx context.Context, opt *pb.UpdateVolumeGroupOpts) (*pb.GenericResponse, error) {
+	return reply(v.p.VolumeDriver.UpdateVolumeGroup(opt))
+}
+
+func (v *volumeServer) DeleteVolumeGroup(ctx context.Context, opt *pb.DeleteVolumeGroupOpts) (*pb.GenericResponse, error) {
+	return reply(nil, v.p.VolumeDriver.DeleteVolumeGroup(opt))
+}
+
+func (v *volumeServer) CreateGroupSnapshot(ctx context.Context, opt *pb.CreateGroupSnapshotOpts) (*pb.GenericResponse, error) {
+	return reply(v.p.VolumeDriver.CreateGroupSnapshot(opt))
+}
+
+func (v *volumeServer) DeleteGroupSnapshot(ctx context.Context, opt *pb.DeleteGroupSnapshotOpts) (*pb.GenericResponse, error) {
+	return reply(nil, v.p.VolumeDriver.DeleteGroupSnapshot(opt))
+}
+
+func (v *volumeServer) ListPools(ctx context.Context, opt *pb.PluginOpts) (*pb.GenericResponse, error) {
+	return reply(v.p.VolumeDriver.ListPools())
+}
+
+func (v *volumeServer) ListVolumes(ctx context.Context, opt *pb.ListVolumesOpts) (*pb.GenericResponse, error) {
+	return reply(v.p.VolumeDriver.ListVolumes(opt))
+}
+
+func (v *volumeServer) ListSnapshots(ctx context.Context, opt *pb.ListVolumesOpts) (*pb.GenericResponse, error) {
+	return reply(v.p.VolumeDriver.ListSnapshots(opt))
+}
+
+// replicationServer implements pb.ReplicationDriverPluginServer
+type replicationServer struct {
+	d drivers.ReplicationDriver
+}
+
+func (r *replicationServer) Setup(ctx context.Context, opt *pb.PluginOpts) (*pb.GenericResponse, error) {
+	return reply(nil, r.d.Setup())
+}
+
+func (r *replicationServer) Unset(ctx context.Context, opt *pb.PluginOpts) (*pb.GenericResponse, error) {
+	return reply(nil, r.d.Unset())
+}
+
+func (r *replicationServer) CreateReplication(ctx context.Context, opt *pb.CreateReplicationOpts) (*pb.GenericResponse, error) {
+	return reply(r.d.CreateReplication(opt))
+}
+
+func (r *replicationServer) DeleteReplication(ctx context.Context, opt *pb.DeleteReplicationOpts) (*pb.GenericResponse, error) {
+	return reply(nil, r.d.DeleteReplication(opt))
+}
+
+func (r *replicationServer) EnableReplication(ctx context.Context, opt *pb.EnableReplicationOpts) (*pb.GenericResponse, error) {
+	return reply(nil, r.d.EnableReplication(opt))
+}
+
+func (r *replicationServer) DisableReplication(ctx context.Context, opt *pb.DisableReplicationOpts) (*pb.GenericResponse, error) {
+	return reply(nil, r.d.DisableReplication(opt))
+}
+
+func (r *replicationServer) FailoverReplication(ctx context.Context, opt *pb.FailoverReplicationOpts) (*pb.GenericResponse, error) {
+	return reply(nil, r.d.FailoverReplication(opt))
+}
+
+// metricServer implements pb.MetricDriverPluginServer
+type metricServer struct {
+	d drivers.MetricDriver
+}
+
+func (m *metricServer) Setup(ctx context.Context, opt *pb.PluginOpts) (*pb.GenericResponse, error) {
+	return reply(nil, m.d.Setup())
+}
+
+func (m *metricServer) Teardown(ctx context.Context, opt *pb.PluginOpts) (*pb.GenericResponse, error) {
+	return reply(nil, m.d.Teardown())
+}
+
+func (m *metricServer) CollectMetrics(ctx context.Context, opt *pb.CollectMetricsOpts) (*pb.GenericResponse, error) {
+	return reply(m.d.CollectMetrics(opt.GetMetrics(), opt.GetInstanceId()))
+}
+
+func (m *metricServer) ValidateMetricsSupportList(ctx context.Context, opt *pb.ValidateMetricsOpts) (*pb.GenericResponse, error) {
+	return reply(m.d.ValidateMetricsSupportList(opt.GetMetrics(), opt.GetResourceType()))
+}
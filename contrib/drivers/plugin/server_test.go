@@ -0,0 +1,162 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/opensds/opensds/contrib/drivers"
+	"github.com/opensds/opensds/pkg/model"
+	pb "github.com/opensds/opensds/pkg/model/proto"
+	. "github.com/opensds/opensds/testutils/collection"
+	sample "github.com/opensds/opensds/testutils/driver"
+	"google.golang.org/grpc"
+)
+
+// startPlugin serves the plugin on a temporary socket, the returned function
+// stops the plugin and cleans the socket up.
+func startPlugin(t *testing.T, p *Plugin) (string, func()) {
+	dir, err := ioutil.TempDir("", "osdsplugin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	socket := filepath.Join(dir, "plugin.sock")
+	lis, err := net.Listen("unix", socket)
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+	s := NewServer(p)
+	go s.Serve(lis)
+
+	return drivers.PluginDriverPrefix + socket, func() {
+		s.Stop()
+		os.RemoveAll(dir)
+	}
+}
+
+func newSamplePlugin() *Plugin {
+	return &Plugin{
+		Name:              "sample",
+		Capability:        drivers.Capability{Replication: true, Metrics: true},
+		VolumeDriver:      &sample.Driver{},
+		ReplicationDriver: &sample.ReplicationDriver{},
+	}
+}
+
+func TestVolumeDriver(t *testing.T) {
+	dType, stop := startPlugin(t, newSamplePlugin())
+	defer stop()
+
+	d, err := drivers.Init(dType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer drivers.Clean(d)
+
+	vol, err := d.CreateVolume(&pb.CreateVolumeOpts{Id: "bd5b12a8-a101-11e7-941e-d77981b584d8"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := &SampleVolumes[0]; !reflect.DeepEqual(vol, expected) {
+		t.Errorf("expected %+v, got %+v", expected, vol)
+	}
+
+	pols, err := d.ListPools()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pols) != len(SamplePools) {
+		t.Errorf("expected %d pools, got %d", len(SamplePools), len(pols))
+	}
+
+	if err := d.DeleteVolume(&pb.DeleteVolumeOpts{}); err != nil {
+		t.Error(err)
+	}
+
+	// The NotImplementError of the driver is restored by the proxy.
+	_, err = d.CreateVolumeGroup(&pb.CreateVolumeGroupOpts{})
+	if _, ok := err.(*model.NotImplementError); !ok {
+		t.Errorf("expected NotImplementError, got %v", err)
+	}
+}
+
+func TestCapability(t *testing.T) {
+	dType, stop := startPlugin(t, newSamplePlugin())
+	defer stop()
+
+	c, err := drivers.GetCapability(dType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Metrics isn't reported since the plugin has no metric driver.
+	expected := drivers.Capability{Replication: true}
+	if !reflect.DeepEqual(c, expected) {
+		t.Errorf("expected %+v, got %+v", expected, c)
+	}
+
+	if _, err := drivers.InitMetricDriver(dType); err == nil {
+		t.Error("expected error when the plugin has no metric driver")
+	}
+
+	r, err := drivers.InitReplicationDriver(dType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer drivers.CleanReplicationDriver(r)
+	if err := r.EnableReplication(&pb.EnableReplicationOpts{}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHandshake(t *testing.T) {
+	dType, stop := startPlugin(t, newSamplePlugin())
+	defer stop()
+
+	conn, err := grpc.Dial(dType[len(drivers.PluginDriverPrefix):], grpc.WithInsecure(),
+		grpc.WithDialer(func(addr string, timeout time.Duration) (net.Conn, error) {
+			return net.DialTimeout("unix", addr, timeout)
+		}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	client := pb.NewDriverPluginClient(conn)
+	reply, err := client.Handshake(context.Background(), &pb.HandshakeOpts{Version: drivers.PluginProtocolVersion})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reply.GetName() != "sample" || reply.GetVersion() != drivers.PluginProtocolVersion {
+		t.Errorf("unexpected handshake reply %+v", reply)
+	}
+
+	if _, err := client.Handshake(context.Background(), &pb.HandshakeOpts{Version: drivers.PluginProtocolVersion + 1}); err == nil {
+		t.Error("expected error when the protocol version mismatches")
+	}
+}
+
+func TestInitEmptySocket(t *testing.T) {
+	if _, err := drivers.Init(drivers.PluginDriverPrefix); err == nil {
+		t.Error("expected error when the socket is empty")
+	}
+}
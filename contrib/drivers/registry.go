@@ -0,0 +1,133 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+This module implements the registry of storage drivers. Every driver registers
+its factory in init() under the driver type which is configured as driver_name
+of the backend, so that in-house drivers can be plugged in by just importing
+their packages into the dock.
+
+*/
+
+package drivers
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Capability describes the optional features of a volume driver, so that the
+// callers are able to find out whether a feature is supported natively before
+// falling back to the generic way.
+type Capability struct {
+	// The driver is able to manage volume groups in the backend.
+	VolumeGroup bool `json:"volumeGroup"`
+	// The driver is able to replicate volumes through the backend.
+	Replication bool `json:"replication"`
+	// The driver is able to attach snapshots to hosts.
+	SnapshotAttach bool `json:"snapshotAttach"`
+	// The driver collects metrics through its metric driver.
+	Metrics bool `json:"metrics"`
+}
+
+// VolumeDriverFactory creates a new instance of the volume driver.
+type VolumeDriverFactory func() VolumeDriver
+
+// ReplicationDriverFactory creates a new instance of the replication driver.
+type ReplicationDriverFactory func() ReplicationDriver
+
+// MetricDriverFactory creates a new instance of the metric driver.
+type MetricDriverFactory func() MetricDriver
+
+type volumeDriverEntry struct {
+	factory    VolumeDriverFactory
+	capability Capability
+}
+
+var (
+	volumeDrivers      = map[string]*volumeDriverEntry{}
+	replicationDrivers = map[string]ReplicationDriverFactory{}
+	metricDrivers      = map[string]MetricDriverFactory{}
+)
+
+// RegisterVolumeDriver registers the factory of the volume driver and the
+// features it supports under the driver type.
+func RegisterVolumeDriver(dType string, factory VolumeDriverFactory, capability Capability) error {
+	if _, exist := volumeDrivers[dType]; exist {
+		return fmt.Errorf("volume driver %s already exist", dType)
+	}
+	volumeDrivers[dType] = &volumeDriverEntry{factory: factory, capability: capability}
+	return nil
+}
+
+// UnregisterVolumeDriver removes the volume driver from the registry.
+func UnregisterVolumeDriver(dType string) {
+	delete(volumeDrivers, dType)
+}
+
+// GetCapability returns the features supported by the volume driver, the
+// driver plugin is asked through handshake.
+func GetCapability(dType string) (Capability, error) {
+	if IsPluginDriver(dType) {
+		return pluginCapability(dType)
+	}
+	entry, exist := volumeDrivers[dType]
+	if !exist {
+		return Capability{}, unknownDriverError("volume", dType, volumeDriverTypes())
+	}
+	return entry.capability, nil
+}
+
+// RegisterReplicationDriver registers the factory of the replication driver
+// under the driver type.
+func RegisterReplicationDriver(dType string, factory ReplicationDriverFactory) error {
+	if _, exist := replicationDrivers[dType]; exist {
+		return fmt.Errorf("replication driver %s already exist", dType)
+	}
+	replicationDrivers[dType] = factory
+	return nil
+}
+
+// UnregisterReplicationDriver removes the replication driver from the registry.
+func UnregisterReplicationDriver(dType string) {
+	delete(replicationDrivers, dType)
+}
+
+// RegisterMetricDriver registers the factory of the metric driver under the
+// driver type.
+func RegisterMetricDriver(dType string, factory MetricDriverFactory) error {
+	if _, exist := metricDrivers[dType]; exist {
+		return fmt.Errorf("metric driver %s already exist", dType)
+	}
+	metricDrivers[dType] = factory
+	return nil
+}
+
+// UnregisterMetricDriver removes the metric driver from the registry.
+func UnregisterMetricDriver(dType string) {
+	delete(metricDrivers, dType)
+}
+
+func volumeDriverTypes() []string {
+	var types []string
+	for k := range volumeDrivers {
+		types = append(types, k)
+	}
+	return types
+}
+
+func unknownDriverError(kind, dType string, registered []string) error {
+	sort.Strings(registered)
+	return fmt.Errorf("%s driver %q is not registered, registered drivers: %v", kind, dType, registered)
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drivers
+
+import (
+	"reflect"
+	"testing"
+
+	sample "github.com/opensds/opensds/testutils/driver"
+)
+
+type fakeDriver struct {
+	sample.Driver
+}
+
+func TestRegisterVolumeDriver(t *testing.T) {
+	const fakeType = "fake"
+	capability := Capability{VolumeGroup: true, SnapshotAttach: true}
+	factory := func() VolumeDriver { return &fakeDriver{} }
+
+	if err := RegisterVolumeDriver(fakeType, factory, capability); err != nil {
+		t.Fatalf("Failed to register volume driver: %v\n", err)
+	}
+	defer UnregisterVolumeDriver(fakeType)
+
+	if err := RegisterVolumeDriver(fakeType, factory, capability); err == nil {
+		t.Errorf("Expected error when registering driver %s twice\n", fakeType)
+	}
+
+	d, err := Init(fakeType)
+	if err != nil {
+		t.Errorf("Failed to init registered driver: %v\n", err)
+	}
+	if _, ok := d.(*fakeDriver); !ok {
+		t.Errorf("Expected %T, got %T\n", &fakeDriver{}, d)
+	}
+
+	result, err := GetCapability(fakeType)
+	if err != nil {
+		t.Errorf("Failed to get capability: %v\n", err)
+	}
+	if !reflect.DeepEqual(result, capability) {
+		t.Errorf("Expected %+v, got %+v\n", capability, result)
+	}
+
+	UnregisterVolumeDriver(fakeType)
+	if _, err := Init(fakeType); err == nil {
+		t.Errorf("Expected error after driver %s is unregistered\n", fakeType)
+	}
+	if _, err := GetCapability(fakeType); err == nil {
+		t.Errorf("Expected error after driver %s is unregistered\n", fakeType)
+	}
+}
@@ -13,9 +13,8 @@
 // limitations under the License.
 
 /*
-This module defines an standard table of storage driver. The default storage
-driver is sample driver used for testing. If you want to use other storage
-plugin, just modify Init() and Clean() method.
+This module defines an standard table of replication driver. The replication
+drivers register themselves into the registry when their packages are imported.
 
 */
 
@@ -24,13 +23,9 @@ package drivers
 import (
 	"reflect"
 
-	"github.com/opensds/opensds/contrib/drivers/drbd"
-	"github.com/opensds/opensds/contrib/drivers/huawei/dorado"
-	driversConfig "github.com/opensds/opensds/contrib/drivers/utils/config"
 	"github.com/opensds/opensds/pkg/model"
 	pb "github.com/opensds/opensds/pkg/model/proto"
 	"github.com/opensds/opensds/pkg/utils/config"
-	replication_sample "github.com/opensds/opensds/testutils/driver"
 )
 
 // ReplicationDriver is an interface for exposing some operations of different
@@ -49,6 +44,11 @@ type ReplicationDriver interface {
 }
 
 func IsSupportHostBasedReplication(resourceType string) bool {
+	// The driver plugin tells whether it supports replication in handshake.
+	if IsPluginDriver(resourceType) {
+		capability, err := GetCapability(resourceType)
+		return err == nil && capability.Replication
+	}
 	v := reflect.ValueOf(config.CONF.Backends)
 	t := reflect.TypeOf(config.CONF.Backends)
 	for i := 0; i < t.NumField(); i++ {
@@ -61,19 +61,26 @@ func IsSupportHostBasedReplication(resourceType string) bool {
 	return false
 }
 
-// Init
+// InitReplicationDriver creates the replication driver registered under
+// resourceType and sets it up.
 func InitReplicationDriver(resourceType string) (ReplicationDriver, error) {
 	var d ReplicationDriver
-	switch resourceType {
-	case driversConfig.DRBDDriverType:
-		d = &drbd.ReplicationDriver{}
-		break
-	case driversConfig.HuaweiDoradoDriverType:
-		d = &dorado.ReplicationDriver{}
-		break
-	default:
-		d = &replication_sample.ReplicationDriver{}
-		break
+	if IsPluginDriver(resourceType) {
+		p, err := newPluginReplicationDriver(resourceType)
+		if err != nil {
+			return nil, err
+		}
+		d = p
+	} else {
+		factory, exist := replicationDrivers[resourceType]
+		if !exist {
+			var types []string
+			for k := range replicationDrivers {
+				types = append(types, k)
+			}
+			return nil, unknownDriverError("replication", resourceType, types)
+		}
+		d = factory()
 	}
 	err := d.Setup()
 	return d, err
@@ -81,15 +88,6 @@ func InitReplicationDriver(resourceType string) (ReplicationDriver, error) {
 
 // Clean
 func CleanReplicationDriver(d ReplicationDriver) ReplicationDriver {
-	// Execute different clean operations according to the ReplicationDriver type.
-	switch d.(type) {
-	case *drbd.ReplicationDriver:
-		break
-	case *dorado.ReplicationDriver:
-		d = &dorado.ReplicationDriver{}
-	default:
-		break
-	}
 	d.Unset()
 	d = nil
 
@@ -22,6 +22,7 @@ package config
 // These constants below represent the vendor name of all storage drivers which
 // can be supported by now.
 const (
+	SampleDriverType              = "sample"
 	CinderDriverType              = "cinder"
 	CephDriverType                = "ceph"
 	LVMDriverType                 = "lvm"
@@ -43,3 +44,11 @@ const (
 	RBDProtocol   = "rbd"
 	FCProtocol    = "fibre_channel"
 )
+
+// These constants below represent the metadata keys which record the original
+// names of the volumes and snapshots taken over from the backend, so that the
+// drivers can restore the names when they're unmanaged.
+const (
+	KManagedVolumeName   = "managedVolumeName"
+	KManagedSnapshotName = "managedSnapshotName"
+)
@@ -49,6 +49,9 @@ func init() {
 	rootCommand.AddCommand(poolCommand)
 	rootCommand.AddCommand(profileCommand)
 	rootCommand.AddCommand(replicationCommand)
+	rootCommand.AddCommand(operationCommand)
+	rootCommand.AddCommand(quotaCommand)
+	rootCommand.AddCommand(fileShareCommand)
 	flags := rootCommand.PersistentFlags()
 	flags.BoolVar(&Debug, "debug", false, "shows debugging output.")
 }
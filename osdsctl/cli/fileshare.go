@@ -0,0 +1,201 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+This module implements a entry into the OpenSDS service.
+
+*/
+
+package cli
+
+import (
+	"log"
+	"os"
+	"strconv"
+
+	"github.com/opensds/opensds/pkg/model"
+	"github.com/spf13/cobra"
+)
+
+var fileShareCommand = &cobra.Command{
+	Use:   "fileshare",
+	Short: "manage fileshares in the cluster",
+	Run:   fileShareAction,
+}
+
+var fileShareCreateCommand = &cobra.Command{
+	Use:   "create <size>",
+	Short: "create a fileshare in the cluster",
+	Run:   fileShareCreateAction,
+}
+
+var fileShareShowCommand = &cobra.Command{
+	Use:   "show <id>",
+	Short: "show a fileshare in the cluster",
+	Run:   fileShareShowAction,
+}
+
+var fileShareListCommand = &cobra.Command{
+	Use:   "list",
+	Short: "list all fileshares in the cluster",
+	Run:   fileShareListAction,
+}
+
+var fileShareDeleteCommand = &cobra.Command{
+	Use:   "delete <id>",
+	Short: "delete a fileshare in the cluster",
+	Run:   fileShareDeleteAction,
+}
+
+var fileShareUpdateCommand = &cobra.Command{
+	Use:   "update <id>",
+	Short: "update a fileshare in the cluster",
+	Run:   fileShareUpdateAction,
+}
+
+var (
+	fileShareName      string
+	fileShareDesp      string
+	fileShareAz        string
+	fileShareProfileId string
+	fileShareSnap      string
+)
+
+var (
+	fileShareLimit    string
+	fileShareOffset   string
+	fileShareSortDir  string
+	fileShareSortKey  string
+	fileShareId       string
+	fileShareTenantId string
+	fileShareUserId   string
+	fileShareStatus   string
+	fileSharePoolId   string
+)
+
+func init() {
+	fileShareListCommand.Flags().StringVarP(&fileShareLimit, "limit", "", "50", "the number of ertries displayed per page")
+	fileShareListCommand.Flags().StringVarP(&fileShareOffset, "offset", "", "0", "all requested data offsets")
+	fileShareListCommand.Flags().StringVarP(&fileShareSortDir, "sortDir", "", "desc", "the sort direction of all requested data. supports asc or desc(default)")
+	fileShareListCommand.Flags().StringVarP(&fileShareSortKey, "sortKey", "", "id",
+		"the sort key of all requested data. supports id(default), name, status, availabilityzone, profileid, tenantid, size, poolid, description")
+	fileShareListCommand.Flags().StringVarP(&fileShareId, "id", "", "", "list fileshare by id")
+	fileShareListCommand.Flags().StringVarP(&fileShareName, "name", "", "", "list fileshare by name")
+	fileShareListCommand.Flags().StringVarP(&fileShareDesp, "description", "", "", "list fileshare by description")
+	fileShareListCommand.Flags().StringVarP(&fileShareTenantId, "tenantId", "", "", "list fileshare by tenantId")
+	fileShareListCommand.Flags().StringVarP(&fileShareUserId, "userId", "", "", "list fileshare by storage userId")
+	fileShareListCommand.Flags().StringVarP(&fileShareStatus, "status", "", "", "list fileshare by status")
+	fileShareListCommand.Flags().StringVarP(&fileSharePoolId, "poolId", "", "", "list fileshare by poolId")
+	fileShareListCommand.Flags().StringVarP(&fileShareAz, "availabilityZone", "", "", "list fileshare by availability zone")
+	fileShareListCommand.Flags().StringVarP(&fileShareProfileId, "profileId", "", "", "list fileshare by profile id")
+
+	fileShareCommand.AddCommand(fileShareCreateCommand)
+	fileShareCreateCommand.Flags().StringVarP(&fileShareName, "name", "n", "", "the name of created fileshare")
+	fileShareCreateCommand.Flags().StringVarP(&fileShareDesp, "description", "d", "", "the description of created fileshare")
+	fileShareCreateCommand.Flags().StringVarP(&fileShareAz, "az", "a", "", "the availability zone of created fileshare")
+	fileShareCreateCommand.Flags().StringVarP(&fileShareProfileId, "profile", "p", "", "the id of profile configured by admin")
+	fileShareCreateCommand.Flags().StringVarP(&fileShareSnap, "snapshot", "s", "", "the snapshot to create fileshare")
+	fileShareCommand.AddCommand(fileShareShowCommand)
+	fileShareCommand.AddCommand(fileShareListCommand)
+	fileShareCommand.AddCommand(fileShareDeleteCommand)
+	fileShareCommand.AddCommand(fileShareUpdateCommand)
+	fileShareUpdateCommand.Flags().StringVarP(&fileShareName, "name", "n", "", "the name of updated fileshare")
+	fileShareUpdateCommand.Flags().StringVarP(&fileShareDesp, "description", "d", "", "the description of updated fileshare")
+
+	fileShareCommand.AddCommand(fileShareSnapshotCommand)
+	fileShareCommand.AddCommand(fileShareAclCommand)
+}
+
+func fileShareAction(cmd *cobra.Command, args []string) {
+	cmd.Usage()
+	os.Exit(1)
+}
+
+var fileShareFormatters = FormatterList{"Metadata": JsonFormatter}
+
+func fileShareCreateAction(cmd *cobra.Command, args []string) {
+	ArgsNumCheck(cmd, args, 1)
+	size, err := strconv.Atoi(args[0])
+	if err != nil {
+		log.Fatalf("error parsing size %s: %+v", args[0], err)
+	}
+
+	fshare := &model.FileShareSpec{
+		Name:             fileShareName,
+		Description:      fileShareDesp,
+		AvailabilityZone: fileShareAz,
+		Size:             int64(size),
+		ProfileId:        fileShareProfileId,
+		SnapshotId:       fileShareSnap,
+	}
+
+	resp, err := client.CreateFileShare(fshare)
+	if err != nil {
+		Fatalln(HttpErrStrip(err))
+	}
+
+	keys := KeyList{"Id", "CreatedAt", "Name", "Description", "Size", "AvailabilityZone",
+		"Status", "PoolId", "ProfileId", "SnapshotId", "Protocols", "ExportLocations", "Metadata"}
+	PrintDict(resp, keys, fileShareFormatters)
+}
+
+func fileShareShowAction(cmd *cobra.Command, args []string) {
+	ArgsNumCheck(cmd, args, 1)
+	resp, err := client.GetFileShare(args[0])
+	if err != nil {
+		Fatalln(HttpErrStrip(err))
+	}
+	keys := KeyList{"Id", "CreatedAt", "UpdatedAt", "Name", "Description", "Size", "AvailabilityZone",
+		"Status", "PoolId", "ProfileId", "SnapshotId", "Protocols", "ExportLocations", "Metadata"}
+	PrintDict(resp, keys, fileShareFormatters)
+}
+
+func fileShareListAction(cmd *cobra.Command, args []string) {
+	ArgsNumCheck(cmd, args, 0)
+
+	var opts = map[string]string{"limit": fileShareLimit, "offset": fileShareOffset, "sortDir": fileShareSortDir,
+		"sortKey": fileShareSortKey, "Id": fileShareId, "Name": fileShareName, "Description": fileShareDesp,
+		"TenantId": fileShareTenantId, "UserId": fileShareUserId, "AvailabilityZone": fileShareAz,
+		"Status": fileShareStatus, "PoolId": fileSharePoolId, "ProfileId": fileShareProfileId}
+
+	resp, err := client.ListFileShares(opts)
+	if err != nil {
+		Fatalln(HttpErrStrip(err))
+	}
+	keys := KeyList{"Id", "Name", "Description", "Size", "Status", "ProfileId", "ExportLocations"}
+	PrintList(resp, keys, fileShareFormatters)
+}
+
+func fileShareDeleteAction(cmd *cobra.Command, args []string) {
+	ArgsNumCheck(cmd, args, 1)
+	if err := client.DeleteFileShare(args[0]); err != nil {
+		Fatalln(HttpErrStrip(err))
+	}
+}
+
+func fileShareUpdateAction(cmd *cobra.Command, args []string) {
+	ArgsNumCheck(cmd, args, 1)
+	fshare := &model.FileShareSpec{
+		Name:        fileShareName,
+		Description: fileShareDesp,
+	}
+
+	resp, err := client.UpdateFileShare(args[0], fshare)
+	if err != nil {
+		Fatalln(HttpErrStrip(err))
+	}
+	keys := KeyList{"Id", "UpdatedAt", "Name", "Description", "Size", "AvailabilityZone",
+		"Status", "PoolId", "ProfileId", "SnapshotId", "Protocols", "ExportLocations", "Metadata"}
+	PrintDict(resp, keys, fileShareFormatters)
+}
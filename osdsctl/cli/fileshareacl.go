@@ -0,0 +1,131 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+This module implements a entry into the OpenSDS service.
+
+*/
+
+package cli
+
+import (
+	"os"
+
+	"github.com/opensds/opensds/pkg/model"
+	"github.com/spf13/cobra"
+)
+
+var fileShareAclCommand = &cobra.Command{
+	Use:   "acl",
+	Short: "manage fileshare access rules in the cluster",
+	Run:   fileShareAclAction,
+}
+
+var fileShareAclCreateCommand = &cobra.Command{
+	Use:   "create <fileshare id>",
+	Short: "grant the access to specified fileshare in the cluster",
+	Run:   fileShareAclCreateAction,
+}
+
+var fileShareAclShowCommand = &cobra.Command{
+	Use:   "show <acl id>",
+	Short: "show a fileshare acl in the cluster",
+	Run:   fileShareAclShowAction,
+}
+
+var fileShareAclListCommand = &cobra.Command{
+	Use:   "list",
+	Short: "list all fileshare acls in the cluster",
+	Run:   fileShareAclListAction,
+}
+
+var fileShareAclDeleteCommand = &cobra.Command{
+	Use:   "delete <acl id>",
+	Short: "revoke the access of a fileshare acl in the cluster",
+	Run:   fileShareAclDeleteAction,
+}
+
+var (
+	fileShareAclType             string
+	fileShareAclAccessTo         []string
+	fileShareAclAccessCapability []string
+	fileShareAclDesp             string
+)
+
+func init() {
+	fileShareAclCommand.AddCommand(fileShareAclCreateCommand)
+	fileShareAclCreateCommand.Flags().StringVarP(&fileShareAclType, "type", "t", "ip", "the type of access, only ip is supported")
+	fileShareAclCreateCommand.Flags().StringSliceVarP(&fileShareAclAccessTo, "accessTo", "a", nil, "the ips or cidrs of the clients granted the access")
+	fileShareAclCreateCommand.Flags().StringSliceVarP(&fileShareAclAccessCapability, "accessCapability", "c", []string{"Read"},
+		"the capabilities of the access. supports Read(default), Write, Execute")
+	fileShareAclCreateCommand.Flags().StringVarP(&fileShareAclDesp, "description", "d", "", "the description of created fileshare acl")
+	fileShareAclCommand.AddCommand(fileShareAclShowCommand)
+	fileShareAclCommand.AddCommand(fileShareAclListCommand)
+	fileShareAclCommand.AddCommand(fileShareAclDeleteCommand)
+}
+
+func fileShareAclAction(cmd *cobra.Command, args []string) {
+	cmd.Usage()
+	os.Exit(1)
+}
+
+var fileShareAclFormatters = FormatterList{}
+
+func fileShareAclCreateAction(cmd *cobra.Command, args []string) {
+	ArgsNumCheck(cmd, args, 1)
+	acl := &model.FileShareAclSpec{
+		FileShareId:      args[0],
+		Type:             fileShareAclType,
+		AccessTo:         fileShareAclAccessTo,
+		AccessCapability: fileShareAclAccessCapability,
+		Description:      fileShareAclDesp,
+	}
+
+	resp, err := client.CreateFileShareAcl(acl)
+	if err != nil {
+		Fatalln(HttpErrStrip(err))
+	}
+	keys := KeyList{"Id", "CreatedAt", "FileShareId", "Type", "AccessTo", "AccessCapability",
+		"Description", "Status"}
+	PrintDict(resp, keys, fileShareAclFormatters)
+}
+
+func fileShareAclShowAction(cmd *cobra.Command, args []string) {
+	ArgsNumCheck(cmd, args, 1)
+	resp, err := client.GetFileShareAcl(args[0])
+	if err != nil {
+		Fatalln(HttpErrStrip(err))
+	}
+	keys := KeyList{"Id", "CreatedAt", "UpdatedAt", "FileShareId", "Type", "AccessTo",
+		"AccessCapability", "Description", "Status"}
+	PrintDict(resp, keys, fileShareAclFormatters)
+}
+
+func fileShareAclListAction(cmd *cobra.Command, args []string) {
+	ArgsNumCheck(cmd, args, 0)
+
+	resp, err := client.ListFileSharesAcl()
+	if err != nil {
+		Fatalln(HttpErrStrip(err))
+	}
+	keys := KeyList{"Id", "FileShareId", "Type", "AccessTo", "AccessCapability", "Status"}
+	PrintList(resp, keys, fileShareAclFormatters)
+}
+
+func fileShareAclDeleteAction(cmd *cobra.Command, args []string) {
+	ArgsNumCheck(cmd, args, 1)
+	if err := client.DeleteFileShareAcl(args[0]); err != nil {
+		Fatalln(HttpErrStrip(err))
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+This module implements a entry into the OpenSDS service.
+
+*/
+
+package cli
+
+import (
+	"os"
+
+	"github.com/opensds/opensds/pkg/model"
+	"github.com/spf13/cobra"
+)
+
+var fileShareSnapshotCommand = &cobra.Command{
+	Use:   "snapshot",
+	Short: "manage fileshare snapshots in the cluster",
+	Run:   fileShareSnapshotAction,
+}
+
+var fileShareSnapshotCreateCommand = &cobra.Command{
+	Use:   "create <fileshare id>",
+	Short: "create a snapshot of specified fileshare in the cluster",
+	Run:   fileShareSnapshotCreateAction,
+}
+
+var fileShareSnapshotShowCommand = &cobra.Command{
+	Use:   "show <snapshot id>",
+	Short: "show a fileshare snapshot in the cluster",
+	Run:   fileShareSnapshotShowAction,
+}
+
+var fileShareSnapshotListCommand = &cobra.Command{
+	Use:   "list",
+	Short: "list all fileshare snapshots in the cluster",
+	Run:   fileShareSnapshotListAction,
+}
+
+var fileShareSnapshotDeleteCommand = &cobra.Command{
+	Use:   "delete <snapshot id>",
+	Short: "delete a fileshare snapshot of specified fileshare in the cluster",
+	Run:   fileShareSnapshotDeleteAction,
+}
+
+var fileShareSnapshotUpdateCommand = &cobra.Command{
+	Use:   "update <snapshot id>",
+	Short: "update a fileshare snapshot in the cluster",
+	Run:   fileShareSnapshotUpdateAction,
+}
+
+var (
+	fileShareSnapshotName string
+	fileShareSnapshotDesp string
+)
+
+var (
+	fileShareSnapLimit       string
+	fileShareSnapOffset      string
+	fileShareSnapSortDir     string
+	fileShareSnapSortKey     string
+	fileShareSnapId          string
+	fileShareSnapUserId      string
+	fileShareSnapName        string
+	fileShareSnapDescription string
+	fileShareSnapStatus      string
+	fileShareSnapFileShareId string
+)
+
+func init() {
+	fileShareSnapshotListCommand.Flags().StringVarP(&fileShareSnapLimit, "limit", "", "50", "the number of ertries displayed per page")
+	fileShareSnapshotListCommand.Flags().StringVarP(&fileShareSnapOffset, "offset", "", "0", "all requested data offsets")
+	fileShareSnapshotListCommand.Flags().StringVarP(&fileShareSnapSortDir, "sortDir", "", "desc", "the sort direction of all requested data. supports asc or desc(default)")
+	fileShareSnapshotListCommand.Flags().StringVarP(&fileShareSnapSortKey, "sortKey", "", "id",
+		"the sort key of all requested data. supports id(default), status, userid")
+	fileShareSnapshotListCommand.Flags().StringVarP(&fileShareSnapId, "id", "", "", "list fileshare snapshot by id")
+	fileShareSnapshotListCommand.Flags().StringVarP(&fileShareSnapUserId, "userId", "", "", "list fileshare snapshot by storage userId")
+	fileShareSnapshotListCommand.Flags().StringVarP(&fileShareSnapFileShareId, "fileshareId", "", "", "list fileshare snapshot by fileshare id")
+	fileShareSnapshotListCommand.Flags().StringVarP(&fileShareSnapStatus, "status", "", "", "list fileshare snapshot by status")
+	fileShareSnapshotListCommand.Flags().StringVarP(&fileShareSnapName, "name", "", "", "list fileshare snapshot by Name")
+	fileShareSnapshotListCommand.Flags().StringVarP(&fileShareSnapDescription, "description", "", "", "list fileshare snapshot by description")
+
+	fileShareSnapshotCommand.AddCommand(fileShareSnapshotCreateCommand)
+	fileShareSnapshotCreateCommand.Flags().StringVarP(&fileShareSnapshotName, "name", "n", "", "the name of created fileshare snapshot")
+	fileShareSnapshotCreateCommand.Flags().StringVarP(&fileShareSnapshotDesp, "description", "d", "", "the description of created fileshare snapshot")
+	fileShareSnapshotCommand.AddCommand(fileShareSnapshotShowCommand)
+	fileShareSnapshotCommand.AddCommand(fileShareSnapshotListCommand)
+	fileShareSnapshotCommand.AddCommand(fileShareSnapshotDeleteCommand)
+	fileShareSnapshotCommand.AddCommand(fileShareSnapshotUpdateCommand)
+	fileShareSnapshotUpdateCommand.Flags().StringVarP(&fileShareSnapshotName, "name", "n", "", "the name of updated fileshare snapshot")
+	fileShareSnapshotUpdateCommand.Flags().StringVarP(&fileShareSnapshotDesp, "description", "d", "", "the description of updated fileshare snapshot")
+}
+
+func fileShareSnapshotAction(cmd *cobra.Command, args []string) {
+	cmd.Usage()
+	os.Exit(1)
+}
+
+var fileShareSnapshotFormatters = FormatterList{"Metadata": JsonFormatter}
+
+func fileShareSnapshotCreateAction(cmd *cobra.Command, args []string) {
+	ArgsNumCheck(cmd, args, 1)
+	snp := &model.FileShareSnapshotSpec{
+		Name:        fileShareSnapshotName,
+		Description: fileShareSnapshotDesp,
+		FileShareId: args[0],
+	}
+
+	resp, err := client.CreateFileShareSnapshot(snp)
+	if err != nil {
+		Fatalln(HttpErrStrip(err))
+	}
+	keys := KeyList{"Id", "CreatedAt", "Name", "Description", "ShareSize", "SnapshotSize",
+		"Status", "FileShareId", "Metadata"}
+	PrintDict(resp, keys, fileShareSnapshotFormatters)
+}
+
+func fileShareSnapshotShowAction(cmd *cobra.Command, args []string) {
+	ArgsNumCheck(cmd, args, 1)
+	resp, err := client.GetFileShareSnapshot(args[0])
+	if err != nil {
+		Fatalln(HttpErrStrip(err))
+	}
+	keys := KeyList{"Id", "CreatedAt", "UpdatedAt", "Name", "Description", "ShareSize",
+		"SnapshotSize", "Status", "FileShareId", "Metadata"}
+	PrintDict(resp, keys, fileShareSnapshotFormatters)
+}
+
+func fileShareSnapshotListAction(cmd *cobra.Command, args []string) {
+	ArgsNumCheck(cmd, args, 0)
+
+	var opts = map[string]string{"limit": fileShareSnapLimit, "offset": fileShareSnapOffset,
+		"sortDir": fileShareSnapSortDir, "sortKey": fileShareSnapSortKey, "Id": fileShareSnapId,
+		"Name": fileShareSnapName, "Description": fileShareSnapDescription, "UserId": fileShareSnapUserId,
+		"Status": fileShareSnapStatus, "FileShareId": fileShareSnapFileShareId}
+
+	resp, err := client.ListFileShareSnapshots(opts)
+	if err != nil {
+		Fatalln(HttpErrStrip(err))
+	}
+	keys := KeyList{"Id", "Name", "Description", "SnapshotSize", "Status", "FileShareId"}
+	PrintList(resp, keys, fileShareSnapshotFormatters)
+}
+
+func fileShareSnapshotDeleteAction(cmd *cobra.Command, args []string) {
+	ArgsNumCheck(cmd, args, 1)
+	if err := client.DeleteFileShareSnapshot(args[0]); err != nil {
+		Fatalln(HttpErrStrip(err))
+	}
+}
+
+func fileShareSnapshotUpdateAction(cmd *cobra.Command, args []string) {
+	ArgsNumCheck(cmd, args, 1)
+	snp := &model.FileShareSnapshotSpec{
+		Name:        fileShareSnapshotName,
+		Description: fileShareSnapshotDesp,
+	}
+
+	resp, err := client.UpdateFileShareSnapshot(args[0], snp)
+	if err != nil {
+		Fatalln(HttpErrStrip(err))
+	}
+	keys := KeyList{"Id", "UpdatedAt", "Name", "Description", "ShareSize", "SnapshotSize",
+		"Status", "FileShareId", "Metadata"}
+	PrintDict(resp, keys, fileShareSnapshotFormatters)
+}
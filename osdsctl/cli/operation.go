@@ -0,0 +1,138 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+This module implements a entry into the OpenSDS service.
+
+*/
+
+package cli
+
+import (
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var operationCommand = &cobra.Command{
+	Use:   "operation",
+	Short: "manage asynchronous operations in the cluster",
+	Run:   operationAction,
+}
+
+var operationShowCommand = &cobra.Command{
+	Use:   "show <operation id>",
+	Short: "show an operation in the cluster",
+	Run:   operationShowAction,
+}
+
+var operationListCommand = &cobra.Command{
+	Use:   "list",
+	Short: "list all operations in the cluster",
+	Run:   operationListAction,
+}
+
+var operationWaitCommand = &cobra.Command{
+	Use:   "wait <operation id>",
+	Short: "wait for an operation in the cluster to finish",
+	Run:   operationWaitAction,
+}
+
+var (
+	opLimit        string
+	opOffset       string
+	opSortDir      string
+	opSortKey      string
+	opId           string
+	opAction       string
+	opResourceType string
+	opResourceId   string
+	opDockId       string
+	opStatus       string
+)
+
+var (
+	opWaitInterval int64
+	opWaitTimeout  int64
+)
+
+func init() {
+	operationListCommand.Flags().StringVarP(&opLimit, "limit", "", "50", "the number of ertries displayed per page")
+	operationListCommand.Flags().StringVarP(&opOffset, "offset", "", "0", "all requested data offsets")
+	operationListCommand.Flags().StringVarP(&opSortDir, "sortDir", "", "desc", "the sort direction of all requested data. supports asc or desc(default)")
+	operationListCommand.Flags().StringVarP(&opSortKey, "sortKey", "", "createdAt",
+		"the sort key of all requested data. supports id, createdAt(default), action, resourceType, resourceId, status")
+	operationListCommand.Flags().StringVarP(&opId, "id", "", "", "list operation by id")
+	operationListCommand.Flags().StringVarP(&opAction, "action", "", "", "list operation by action")
+	operationListCommand.Flags().StringVarP(&opResourceType, "resourceType", "", "", "list operation by resource type")
+	operationListCommand.Flags().StringVarP(&opResourceId, "resourceId", "", "", "list operation by resource id")
+	operationListCommand.Flags().StringVarP(&opDockId, "dockId", "", "", "list operation by dock id")
+	operationListCommand.Flags().StringVarP(&opStatus, "status", "", "", "list operation by status")
+
+	operationWaitCommand.Flags().Int64VarP(&opWaitInterval, "interval", "i", 2, "the interval(second) between two polls of the operation")
+	operationWaitCommand.Flags().Int64VarP(&opWaitTimeout, "timeout", "t", 300, "the maximum time(second) to wait for the operation")
+
+	operationCommand.AddCommand(operationShowCommand)
+	operationCommand.AddCommand(operationListCommand)
+	operationCommand.AddCommand(operationWaitCommand)
+}
+
+func operationAction(cmd *cobra.Command, args []string) {
+	cmd.Usage()
+	os.Exit(1)
+}
+
+var operationKeys = KeyList{"Id", "CreatedAt", "UpdatedAt", "Action", "ResourceType",
+	"ResourceId", "DockId", "Status", "ErrorMessage"}
+
+func operationShowAction(cmd *cobra.Command, args []string) {
+	ArgsNumCheck(cmd, args, 1)
+	resp, err := client.GetOperation(args[0])
+	if err != nil {
+		Fatalln(HttpErrStrip(err))
+	}
+	PrintDict(resp, operationKeys, FormatterList{})
+}
+
+func operationListAction(cmd *cobra.Command, args []string) {
+	ArgsNumCheck(cmd, args, 0)
+
+	var opts = map[string]string{"limit": opLimit, "offset": opOffset, "sortDir": opSortDir,
+		"sortKey": opSortKey, "Id": opId, "Action": opAction, "ResourceType": opResourceType,
+		"ResourceId": opResourceId, "DockId": opDockId, "Status": opStatus}
+
+	resp, err := client.ListOperations(opts)
+	if err != nil {
+		Fatalln(HttpErrStrip(err))
+	}
+	keys := KeyList{"Id", "CreatedAt", "Action", "ResourceType", "ResourceId", "Status"}
+	PrintList(resp, keys, FormatterList{})
+}
+
+func operationWaitAction(cmd *cobra.Command, args []string) {
+	ArgsNumCheck(cmd, args, 1)
+	if opWaitInterval <= 0 || opWaitTimeout <= 0 {
+		Fatalf("invalid interval '%d' or timeout '%d'\n", opWaitInterval, opWaitTimeout)
+	}
+
+	resp, err := client.WaitOperation(args[0], time.Duration(opWaitInterval)*time.Second,
+		time.Duration(opWaitTimeout)*time.Second)
+	if resp != nil {
+		PrintDict(resp, operationKeys, FormatterList{})
+	}
+	if err != nil {
+		Fatalln(HttpErrStrip(err))
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+This module implements a entry into the OpenSDS service.
+
+*/
+
+package cli
+
+import (
+	"os"
+	"strconv"
+
+	"github.com/opensds/opensds/pkg/model"
+	"github.com/spf13/cobra"
+)
+
+var quotaCommand = &cobra.Command{
+	Use:   "quota",
+	Short: "manage tenant quotas in the cluster",
+	Run:   quotaAction,
+}
+
+var quotaShowCommand = &cobra.Command{
+	Use:   "show <tenant id>",
+	Short: "show the quota and usage of a tenant in the cluster",
+	Run:   quotaShowAction,
+}
+
+var quotaSetCommand = &cobra.Command{
+	Use:   "set <tenant id>",
+	Short: "set the quota of a tenant in the cluster, -1 means unlimited",
+	Run:   quotaSetAction,
+}
+
+var (
+	quotaVolumes    int64
+	quotaSnapshots  int64
+	quotaCapacity   int64
+	quotaFileShares int64
+)
+
+func init() {
+	quotaSetCommand.Flags().Int64VarP(&quotaVolumes, "volumes", "", -1, "the maximum number of volumes")
+	quotaSetCommand.Flags().Int64VarP(&quotaSnapshots, "snapshots", "", -1, "the maximum number of volume snapshots")
+	quotaSetCommand.Flags().Int64VarP(&quotaCapacity, "capacity", "", -1, "the maximum capacity(GB) of volumes, snapshots and fileshares")
+	quotaSetCommand.Flags().Int64VarP(&quotaFileShares, "fileshares", "", -1, "the maximum number of fileshares")
+
+	quotaCommand.AddCommand(quotaShowCommand)
+	quotaCommand.AddCommand(quotaSetCommand)
+}
+
+func quotaAction(cmd *cobra.Command, args []string) {
+	cmd.Usage()
+	os.Exit(1)
+}
+
+// quotaRow is one resource of the quota when it's printed.
+type quotaRow struct {
+	Resource string
+	Limit    string
+	InUse    int64
+	Reserved int64
+}
+
+func printQuota(q *model.QuotaSpec) {
+	var inUse, reserved model.QuotaSet
+	if q.InUse != nil {
+		inUse = *q.InUse
+	}
+	if q.Reserved != nil {
+		reserved = *q.Reserved
+	}
+	limit := func(n int64) string {
+		if n < 0 {
+			return "unlimited"
+		}
+		return strconv.FormatInt(n, 10)
+	}
+
+	rows := []*quotaRow{
+		{"volumes", limit(q.Volumes), inUse.Volumes, reserved.Volumes},
+		{"snapshots", limit(q.Snapshots), inUse.Snapshots, reserved.Snapshots},
+		{"capacity(GB)", limit(q.Capacity), inUse.Capacity, reserved.Capacity},
+		{"fileshares", limit(q.FileShares), inUse.FileShares, reserved.FileShares},
+	}
+	keys := KeyList{"Resource", "Limit", "InUse", "Reserved"}
+	PrintList(rows, keys, FormatterList{})
+}
+
+func quotaShowAction(cmd *cobra.Command, args []string) {
+	ArgsNumCheck(cmd, args, 1)
+	resp, err := client.GetQuota(args[0])
+	if err != nil {
+		Fatalln(HttpErrStrip(err))
+	}
+	printQuota(resp)
+}
+
+func quotaSetAction(cmd *cobra.Command, args []string) {
+	ArgsNumCheck(cmd, args, 1)
+
+	// Only the limits specified are changed, so start from the current ones.
+	quota, err := client.GetQuota(args[0])
+	if err != nil {
+		Fatalln(HttpErrStrip(err))
+	}
+	body := &model.QuotaSpec{QuotaSet: quota.QuotaSet}
+	flags := cmd.Flags()
+	if flags.Changed("volumes") {
+		body.Volumes = quotaVolumes
+	}
+	if flags.Changed("snapshots") {
+		body.Snapshots = quotaSnapshots
+	}
+	if flags.Changed("capacity") {
+		body.Capacity = quotaCapacity
+	}
+	if flags.Changed("fileshares") {
+		body.FileShares = quotaFileShares
+	}
+
+	resp, err := client.UpdateQuota(args[0], body)
+	if err != nil {
+		Fatalln(HttpErrStrip(err))
+	}
+	printQuota(resp)
+}
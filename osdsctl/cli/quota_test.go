@@ -0,0 +1,58 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"os"
+	"os/exec"
+	"testing"
+
+	c "github.com/opensds/opensds/client"
+)
+
+func init() {
+	client = c.NewFakeClient(&c.Config{Endpoint: c.TestEp})
+}
+
+func TestQuotaAction(t *testing.T) {
+	beCrasher := os.Getenv("BE_CRASHER")
+
+	if beCrasher == "1" {
+		quotaAction(quotaCommand, []string{})
+		return
+	}
+
+	cmd := exec.Command(os.Args[0], "-test.run=TestQuotaAction")
+	cmd.Env = append(os.Environ(), "BE_CRASHER=1")
+	err := cmd.Run()
+	e, ok := err.(*exec.ExitError)
+
+	if ok && ("exit status 1" == e.Error()) {
+		return
+	}
+
+	t.Fatalf("process ran with %s, want exit status 1", e.Error())
+}
+
+func TestQuotaShowAction(t *testing.T) {
+	var args = []string{"e93b4c0934da416eb9c8d120c5d04d96"}
+	quotaShowAction(quotaShowCommand, args)
+}
+
+func TestQuotaSetAction(t *testing.T) {
+	var args = []string{"e93b4c0934da416eb9c8d120c5d04d96"}
+	quotaSetCommand.Flags().Set("volumes", "20")
+	quotaSetAction(quotaSetCommand, args)
+}
@@ -69,12 +69,43 @@ var volumeExtendCommand = &cobra.Command{
 	Run:   volumeExtendAction,
 }
 
+var volumeRetypeCommand = &cobra.Command{
+	Use:   "retype <id> <profile id>",
+	Short: "change the profile of a volume, it may be migrated to another pool",
+	Run:   volumeRetypeAction,
+}
+
+var volumeMigrateCommand = &cobra.Command{
+	Use:   "migrate <id>",
+	Short: "migrate a volume to another pool",
+	Run:   volumeMigrateAction,
+}
+
+var volumeRevertCommand = &cobra.Command{
+	Use:   "revert <id> <snapshot id>",
+	Short: "revert a volume to one of its snapshots",
+	Run:   volumeRevertAction,
+}
+
+var volumeManageCommand = &cobra.Command{
+	Use:   "manage <pool id> <identifier>",
+	Short: "take over a volume existing in the backend without copying its data",
+	Run:   volumeManageAction,
+}
+
+var volumeUnmanageCommand = &cobra.Command{
+	Use:   "unmanage <id>",
+	Short: "remove a volume from the cluster but leave its data in the backend",
+	Run:   volumeUnmanageAction,
+}
+
 var (
 	profileId string
 	volName   string
 	volDesp   string
 	volAz     string
 	volSnap   string
+	volSource string
 )
 
 var (
@@ -92,6 +123,12 @@ var (
 	snapshotFromCloud bool
 )
 
+var (
+	volTargetPool      string
+	volMigrationPolicy string
+	volRevertForce     bool
+)
+
 func init() {
 	volumeListCommand.Flags().StringVarP(&volLimit, "limit", "", "50", "the number of ertries displayed per page")
 	volumeListCommand.Flags().StringVarP(&volOffset, "offset", "", "0", "all requested data offsets")
@@ -117,6 +154,7 @@ func init() {
 	volumeCreateCommand.Flags().StringVarP(&volAz, "az", "a", "", "the availability zone of created volume")
 	volumeCreateCommand.Flags().StringVarP(&volSnap, "snapshot", "s", "", "the snapshot to create volume")
 	volumeCreateCommand.Flags().BoolVarP(&snapshotFromCloud, "snapshotFromCloud", "c", false, "download snapshot from cloud")
+	volumeCreateCommand.Flags().StringVarP(&volSource, "source", "", "", "the source volume to clone volume from")
 	volumeCommand.AddCommand(volumeShowCommand)
 	volumeCommand.AddCommand(volumeListCommand)
 	volumeCommand.AddCommand(volumeDeleteCommand)
@@ -124,8 +162,22 @@ func init() {
 	volumeUpdateCommand.Flags().StringVarP(&volName, "name", "n", "", "the name of updated volume")
 	volumeUpdateCommand.Flags().StringVarP(&volDesp, "description", "d", "", "the description of updated volume")
 	volumeCommand.AddCommand(volumeExtendCommand)
+	volumeCommand.AddCommand(volumeRetypeCommand)
+	volumeRetypeCommand.Flags().StringVarP(&volTargetPool, "pool", "", "", "the pool to migrate volume to if it has to be migrated")
+	volumeRetypeCommand.Flags().StringVarP(&volMigrationPolicy, "migrationPolicy", "", "",
+		"whether volume can be migrated to satisfy the new profile. supports onDemand(default) or never")
+	volumeCommand.AddCommand(volumeMigrateCommand)
+	volumeMigrateCommand.Flags().StringVarP(&volTargetPool, "pool", "", "", "the pool to migrate volume to")
+	volumeCommand.AddCommand(volumeRevertCommand)
+	volumeRevertCommand.Flags().BoolVarP(&volRevertForce, "force", "f", false,
+		"revert volume even if it's attached or the snapshot isn't the latest one")
+	volumeCommand.AddCommand(volumeManageCommand)
+	volumeManageCommand.Flags().StringVarP(&volName, "name", "n", "", "the name of managed volume")
+	volumeManageCommand.Flags().StringVarP(&volDesp, "description", "d", "", "the description of managed volume")
+	volumeCommand.AddCommand(volumeUnmanageCommand)
 
 	volumeCommand.AddCommand(volumeSnapshotCommand)
+	volumeCommand.AddCommand(volumeBackupCommand)
 	volumeCommand.AddCommand(volumeAttachmentCommand)
 	volumeCommand.AddCommand(volumeGroupCommand)
 }
@@ -152,6 +204,7 @@ func volumeCreateAction(cmd *cobra.Command, args []string) {
 		ProfileId:         profileId,
 		SnapshotId:        volSnap,
 		SnapshotFromCloud: snapshotFromCloud,
+		SourceVolumeId:    volSource,
 	}
 
 	resp, err := client.CreateVolume(vol)
@@ -172,7 +225,7 @@ func volumeShowAction(cmd *cobra.Command, args []string) {
 	}
 	keys := KeyList{"Id", "CreatedAt", "UpdatedAt", "Name", "Description", "Size",
 		"AvailabilityZone", "Status", "PoolId", "ProfileId", "Metadata", "GroupId", "SnapshotId",
-		"MultiAttach"}
+		"SourceVolumeId", "MultiAttach"}
 	PrintDict(resp, keys, volFormatters)
 }
 
@@ -238,3 +291,77 @@ func volumeExtendAction(cmd *cobra.Command, args []string) {
 		"AvailabilityZone", "Status", "PoolId", "ProfileId", "Metadata", "GroupId", "MultiAttach"}
 	PrintDict(resp, keys, volFormatters)
 }
+
+func volumeRetypeAction(cmd *cobra.Command, args []string) {
+	ArgsNumCheck(cmd, args, 2)
+	body := &model.RetypeVolumeSpec{
+		ProfileId:       args[1],
+		PoolId:          volTargetPool,
+		MigrationPolicy: volMigrationPolicy,
+	}
+
+	resp, err := client.RetypeVolume(args[0], body)
+	if err != nil {
+		Fatalln(HttpErrStrip(err))
+	}
+	keys := KeyList{"Id", "CreatedAt", "UpdatedAt", "Name", "Description", "Size",
+		"AvailabilityZone", "Status", "PoolId", "ProfileId", "Metadata", "GroupId", "MultiAttach"}
+	PrintDict(resp, keys, volFormatters)
+}
+
+func volumeMigrateAction(cmd *cobra.Command, args []string) {
+	ArgsNumCheck(cmd, args, 1)
+	body := &model.MigrateVolumeSpec{
+		PoolId: volTargetPool,
+	}
+
+	resp, err := client.MigrateVolume(args[0], body)
+	if err != nil {
+		Fatalln(HttpErrStrip(err))
+	}
+	keys := KeyList{"Id", "CreatedAt", "UpdatedAt", "Name", "Description", "Size",
+		"AvailabilityZone", "Status", "PoolId", "ProfileId", "Metadata", "GroupId", "MultiAttach"}
+	PrintDict(resp, keys, volFormatters)
+}
+
+func volumeRevertAction(cmd *cobra.Command, args []string) {
+	ArgsNumCheck(cmd, args, 2)
+	body := &model.RevertVolumeSpec{
+		SnapshotId: args[1],
+		Force:      volRevertForce,
+	}
+
+	resp, err := client.RevertVolume(args[0], body)
+	if err != nil {
+		Fatalln(HttpErrStrip(err))
+	}
+	keys := KeyList{"Id", "CreatedAt", "UpdatedAt", "Name", "Description", "Size",
+		"AvailabilityZone", "Status", "PoolId", "ProfileId", "Metadata", "GroupId", "MultiAttach"}
+	PrintDict(resp, keys, volFormatters)
+}
+
+func volumeManageAction(cmd *cobra.Command, args []string) {
+	ArgsNumCheck(cmd, args, 2)
+	body := &model.ManageVolumeSpec{
+		Name:        volName,
+		Description: volDesp,
+		PoolId:      args[0],
+		Identifier:  args[1],
+		ProfileId:   profileId,
+	}
+
+	resp, err := client.ManageVolume(body)
+	if err != nil {
+		Fatalln(HttpErrStrip(err))
+	}
+	keys := KeyList{"Id", "CreatedAt", "Name", "Description", "Size",
+		"AvailabilityZone", "Status", "PoolId", "ProfileId", "Metadata"}
+	PrintDict(resp, keys, volFormatters)
+}
+
+func volumeUnmanageAction(cmd *cobra.Command, args []string) {
+	ArgsNumCheck(cmd, args, 1)
+	if err := client.UnmanageVolume(args[0]); err != nil {
+		Fatalln(HttpErrStrip(err))
+	}
+}
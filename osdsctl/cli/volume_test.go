@@ -82,3 +82,35 @@ func TestVolumeExtendAction(t *testing.T) {
 	args = append(args, "5")
 	volumeExtendAction(volumeExtendCommand, args)
 }
+
+func TestVolumeRetypeAction(t *testing.T) {
+	var args []string
+	args = append(args, "bd5b12a8-a101-11e7-941e-d77981b584d8")
+	args = append(args, "2f9c0a04-66ef-11e7-ade2-43158893e017")
+	volumeRetypeAction(volumeRetypeCommand, args)
+}
+
+func TestVolumeMigrateAction(t *testing.T) {
+	var args []string
+	args = append(args, "bd5b12a8-a101-11e7-941e-d77981b584d8")
+	volumeMigrateAction(volumeMigrateCommand, args)
+}
+
+func TestVolumeRevertAction(t *testing.T) {
+	var args []string
+	args = append(args, "bd5b12a8-a101-11e7-941e-d77981b584d8")
+	args = append(args, "3769855c-a102-11e7-b772-17b880d2f537")
+	volumeRevertAction(volumeRevertCommand, args)
+}
+
+func TestVolumeManageAction(t *testing.T) {
+	var args []string
+	args = append(args, "084bf71e-a102-11e7-88a8-e31fe6d52248", "vol01")
+	volumeManageAction(volumeManageCommand, args)
+}
+
+func TestVolumeUnmanageAction(t *testing.T) {
+	var args []string
+	args = append(args, "bd5b12a8-a101-11e7-941e-d77981b584d8")
+	volumeUnmanageAction(volumeUnmanageCommand, args)
+}
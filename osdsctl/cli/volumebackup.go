@@ -0,0 +1,227 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+This module implements a entry into the OpenSDS service.
+
+*/
+
+package cli
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/opensds/opensds/pkg/model"
+	"github.com/spf13/cobra"
+)
+
+var volumeBackupCommand = &cobra.Command{
+	Use:   "backup",
+	Short: "manage volume backups in the cluster",
+	Run:   volumeBackupAction,
+}
+
+var volumeBackupCreateCommand = &cobra.Command{
+	Use:   "create <volume id>",
+	Short: "create a backup of specified volume or snapshot in the cluster",
+	Run:   volumeBackupCreateAction,
+}
+
+var volumeBackupShowCommand = &cobra.Command{
+	Use:   "show <backup id>",
+	Short: "show a volume backup in the cluster",
+	Run:   volumeBackupShowAction,
+}
+
+var volumeBackupListCommand = &cobra.Command{
+	Use:   "list",
+	Short: "list all volume backups in the cluster",
+	Run:   volumeBackupListAction,
+}
+
+var volumeBackupDeleteCommand = &cobra.Command{
+	Use:   "delete <backup id>",
+	Short: "delete a volume backup in the cluster",
+	Run:   volumeBackupDeleteAction,
+}
+
+var volumeBackupUpdateCommand = &cobra.Command{
+	Use:   "update <backup id>",
+	Short: "update a volume backup in the cluster",
+	Run:   volumeBackupUpdateAction,
+}
+
+var volumeBackupRestoreCommand = &cobra.Command{
+	Use:   "restore <backup id>",
+	Short: "restore a volume backup into a new or existing volume in the cluster",
+	Run:   volumeBackupRestoreAction,
+}
+
+var (
+	volBackupName       string
+	volBackupDesp       string
+	volBackupSnapshotId string
+	volBackupMetadata   string
+)
+
+var (
+	volBackupRestoreVolumeId string
+	volBackupRestoreName     string
+	volBackupRestoreProfile  string
+	volBackupRestoreAZ       string
+)
+
+var (
+	volBkpLimit      string
+	volBkpOffset     string
+	volBkpSortDir    string
+	volBkpSortKey    string
+	volBkpId         string
+	volBkpName       string
+	volBkpStatus     string
+	volBkpVolumeId   string
+	volBkpSnapshotId string
+)
+
+func init() {
+	volumeBackupListCommand.Flags().StringVarP(&volBkpLimit, "limit", "", "50", "the number of ertries displayed per page")
+	volumeBackupListCommand.Flags().StringVarP(&volBkpOffset, "offset", "", "0", "all requested data offsets")
+	volumeBackupListCommand.Flags().StringVarP(&volBkpSortDir, "sortDir", "", "desc", "the sort direction of all requested data. supports asc or desc(default)")
+	volumeBackupListCommand.Flags().StringVarP(&volBkpSortKey, "sortKey", "", "id",
+		"the sort key of all requested data. supports id(default), name, volumeid, snapshotid, status, size")
+	volumeBackupListCommand.Flags().StringVarP(&volBkpId, "id", "", "", "list volume backup by id")
+	volumeBackupListCommand.Flags().StringVarP(&volBkpName, "name", "", "", "list volume backup by name")
+	volumeBackupListCommand.Flags().StringVarP(&volBkpStatus, "status", "", "", "list volume backup by status")
+	volumeBackupListCommand.Flags().StringVarP(&volBkpVolumeId, "volumeId", "", "", "list volume backup by volume id")
+	volumeBackupListCommand.Flags().StringVarP(&volBkpSnapshotId, "snapshotId", "", "", "list volume backup by snapshot id")
+
+	volumeBackupCommand.AddCommand(volumeBackupCreateCommand)
+	volumeBackupCreateCommand.Flags().StringVarP(&volBackupName, "name", "n", "", "the name of created volume backup")
+	volumeBackupCreateCommand.Flags().StringVarP(&volBackupDesp, "description", "d", "", "the description of created volume backup")
+	volumeBackupCreateCommand.Flags().StringVarP(&volBackupSnapshotId, "snapshot", "s", "", "the id of the snapshot to back up instead of the volume")
+	volumeBackupCreateCommand.Flags().StringVarP(&volBackupMetadata, "metadata", "m", "", "the metadata passed to the backup driver in json format, e.g. '{\"bucket\":\"backup-bucket\"}'")
+	volumeBackupCommand.AddCommand(volumeBackupShowCommand)
+	volumeBackupCommand.AddCommand(volumeBackupListCommand)
+	volumeBackupCommand.AddCommand(volumeBackupDeleteCommand)
+	volumeBackupCommand.AddCommand(volumeBackupUpdateCommand)
+	volumeBackupUpdateCommand.Flags().StringVarP(&volBackupName, "name", "n", "", "the name of updated volume backup")
+	volumeBackupUpdateCommand.Flags().StringVarP(&volBackupDesp, "description", "d", "", "the description of updated volume backup")
+	volumeBackupCommand.AddCommand(volumeBackupRestoreCommand)
+	volumeBackupRestoreCommand.Flags().StringVarP(&volBackupRestoreVolumeId, "volume", "v", "", "the id of an existing volume to restore into, a new volume is created if not specified")
+	volumeBackupRestoreCommand.Flags().StringVarP(&volBackupRestoreName, "name", "n", "", "the name of the new volume")
+	volumeBackupRestoreCommand.Flags().StringVarP(&volBackupRestoreProfile, "profile", "p", "", "the profile id of the new volume")
+	volumeBackupRestoreCommand.Flags().StringVarP(&volBackupRestoreAZ, "az", "a", "", "the availability zone of the new volume")
+}
+
+func volumeBackupAction(cmd *cobra.Command, args []string) {
+	cmd.Usage()
+	os.Exit(1)
+}
+
+var volBackupFormatters = FormatterList{"Metadata": JsonFormatter}
+
+func volumeBackupCreateAction(cmd *cobra.Command, args []string) {
+	ArgsNumCheck(cmd, args, 1)
+	metadata := map[string]string{}
+	if len(volBackupMetadata) != 0 {
+		if err := json.Unmarshal([]byte(volBackupMetadata), &metadata); err != nil {
+			Debugln(err)
+			Fatalln("invalid volume backup metadata")
+		}
+	}
+	bkp := &model.BackupSpec{
+		Name:        volBackupName,
+		Description: volBackupDesp,
+		VolumeId:    args[0],
+		SnapshotId:  volBackupSnapshotId,
+		Metadata:    metadata,
+	}
+
+	resp, err := client.CreateBackup(bkp)
+	if err != nil {
+		Fatalln(HttpErrStrip(err))
+	}
+	keys := KeyList{"Id", "CreatedAt", "Name", "Description", "Size", "Status",
+		"VolumeId", "SnapshotId", "Metadata"}
+	PrintDict(resp, keys, volBackupFormatters)
+}
+
+func volumeBackupShowAction(cmd *cobra.Command, args []string) {
+	ArgsNumCheck(cmd, args, 1)
+	resp, err := client.GetBackup(args[0])
+	if err != nil {
+		Fatalln(HttpErrStrip(err))
+	}
+	keys := KeyList{"Id", "CreatedAt", "UpdatedAt", "Name", "Description", "Size", "Status",
+		"VolumeId", "SnapshotId", "PoolId", "BackupDriver", "Metadata"}
+	PrintDict(resp, keys, volBackupFormatters)
+}
+
+func volumeBackupListAction(cmd *cobra.Command, args []string) {
+	ArgsNumCheck(cmd, args, 0)
+
+	var opts = map[string]string{"limit": volBkpLimit, "offset": volBkpOffset, "sortDir": volBkpSortDir,
+		"sortKey": volBkpSortKey, "Id": volBkpId, "Name": volBkpName, "Status": volBkpStatus,
+		"VolumeId": volBkpVolumeId, "SnapshotId": volBkpSnapshotId}
+
+	resp, err := client.ListBackups(opts)
+	if err != nil {
+		Fatalln(HttpErrStrip(err))
+	}
+	keys := KeyList{"Id", "Name", "Description", "Size", "Status", "VolumeId", "SnapshotId"}
+	PrintList(resp, keys, volBackupFormatters)
+}
+
+func volumeBackupDeleteAction(cmd *cobra.Command, args []string) {
+	ArgsNumCheck(cmd, args, 1)
+	err := client.DeleteBackup(args[0])
+	if err != nil {
+		Fatalln(HttpErrStrip(err))
+	}
+}
+
+func volumeBackupUpdateAction(cmd *cobra.Command, args []string) {
+	ArgsNumCheck(cmd, args, 1)
+	bkp := &model.BackupSpec{
+		Name:        volBackupName,
+		Description: volBackupDesp,
+	}
+
+	resp, err := client.UpdateBackup(args[0], bkp)
+	if err != nil {
+		Fatalln(HttpErrStrip(err))
+	}
+	keys := KeyList{"Id", "UpdatedAt", "Name", "Description", "Size", "Status",
+		"VolumeId", "SnapshotId", "Metadata"}
+	PrintDict(resp, keys, volBackupFormatters)
+}
+
+func volumeBackupRestoreAction(cmd *cobra.Command, args []string) {
+	ArgsNumCheck(cmd, args, 1)
+	restore := &model.RestoreBackupSpec{
+		VolumeId:         volBackupRestoreVolumeId,
+		Name:             volBackupRestoreName,
+		ProfileId:        volBackupRestoreProfile,
+		AvailabilityZone: volBackupRestoreAZ,
+	}
+
+	resp, err := client.RestoreBackup(args[0], restore)
+	if err != nil {
+		Fatalln(HttpErrStrip(err))
+	}
+	keys := KeyList{"Id", "Name", "Description", "Size", "AvailabilityZone", "Status",
+		"PoolId", "ProfileId"}
+	PrintDict(resp, keys, FormatterList{})
+}
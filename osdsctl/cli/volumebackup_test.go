@@ -0,0 +1,85 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"os"
+	"os/exec"
+	"testing"
+
+	c "github.com/opensds/opensds/client"
+)
+
+func init() {
+	client = c.NewFakeClient(&c.Config{Endpoint: c.TestEp})
+}
+
+func TestVolumeBackupAction(t *testing.T) {
+	beCrasher := os.Getenv("BE_CRASHER")
+
+	if beCrasher == "1" {
+		var args []string
+		volumeBackupAction(volumeBackupCommand, args)
+
+		return
+	}
+
+	cmd := exec.Command(os.Args[0], "-test.run=TestVolumeBackupAction")
+	cmd.Env = append(os.Environ(), "BE_CRASHER=1")
+	err := cmd.Run()
+	e, ok := err.(*exec.ExitError)
+
+	if ok && ("exit status 1" == e.Error()) {
+		return
+	}
+
+	t.Fatalf("process ran with %s, want exit status 1", e.Error())
+}
+
+func TestVolumeBackupCreateAction(t *testing.T) {
+	var args []string
+	args = append(args, "bd5b12a8-a101-11e7-941e-d77981b584d8")
+	volBackupMetadata = `{"bucket":"sample-bucket"}`
+	volumeBackupCreateAction(volumeBackupCreateCommand, args)
+}
+
+func TestVolumeBackupShowAction(t *testing.T) {
+	var args []string
+	args = append(args, "5d8c2a5e-5e0f-11e9-8cf1-6f3c2b7e2c11")
+	volumeBackupShowAction(volumeBackupShowCommand, args)
+}
+
+func TestVolumeBackupListAction(t *testing.T) {
+	var args []string
+	volumeBackupListAction(volumeBackupListCommand, args)
+}
+
+func TestVolumeBackupDeleteAction(t *testing.T) {
+	var args []string
+	args = append(args, "5d8c2a5e-5e0f-11e9-8cf1-6f3c2b7e2c11")
+	volumeBackupDeleteAction(volumeBackupDeleteCommand, args)
+}
+
+func TestVolumeBackupUpdateAction(t *testing.T) {
+	var args []string
+	args = append(args, "5d8c2a5e-5e0f-11e9-8cf1-6f3c2b7e2c11")
+	volumeBackupUpdateAction(volumeBackupUpdateCommand, args)
+}
+
+func TestVolumeBackupRestoreAction(t *testing.T) {
+	var args []string
+	args = append(args, "5d8c2a5e-5e0f-11e9-8cf1-6f3c2b7e2c11")
+	volumeBackupRestoreAction(volumeBackupRestoreCommand, args)
+}
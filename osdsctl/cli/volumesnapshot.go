@@ -62,6 +62,18 @@ var volumeSnapshotUpdateCommand = &cobra.Command{
 	Run:   volumeSnapshotUpdateAction,
 }
 
+var volumeSnapshotManageCommand = &cobra.Command{
+	Use:   "manage <volume id> <identifier>",
+	Short: "take over a snapshot of specified volume existing in the backend",
+	Run:   volumeSnapshotManageAction,
+}
+
+var volumeSnapshotUnmanageCommand = &cobra.Command{
+	Use:   "unmanage <snapshot id>",
+	Short: "remove a volume snapshot from the cluster but leave its data in the backend",
+	Run:   volumeSnapshotUnmanageAction,
+}
+
 var (
 	volSnapshotName string
 	volSnapshotDesp string
@@ -102,6 +114,10 @@ func init() {
 	volumeSnapshotCommand.AddCommand(volumeSnapshotUpdateCommand)
 	volumeSnapshotUpdateCommand.Flags().StringVarP(&volSnapshotName, "name", "n", "", "the name of updated volume snapshot")
 	volumeSnapshotUpdateCommand.Flags().StringVarP(&volSnapshotDesp, "description", "d", "", "the description of updated volume snapshot")
+	volumeSnapshotCommand.AddCommand(volumeSnapshotManageCommand)
+	volumeSnapshotManageCommand.Flags().StringVarP(&volSnapshotName, "name", "n", "", "the name of managed volume snapshot")
+	volumeSnapshotManageCommand.Flags().StringVarP(&volSnapshotDesp, "description", "d", "", "the description of managed volume snapshot")
+	volumeSnapshotCommand.AddCommand(volumeSnapshotUnmanageCommand)
 }
 
 func volumeSnapshotAction(cmd *cobra.Command, args []string) {
@@ -180,3 +196,28 @@ func volumeSnapshotUpdateAction(cmd *cobra.Command, args []string) {
 		"ProfileId", "VolumeId", "Metadata"}
 	PrintDict(resp, keys, volSnapshotFormatters)
 }
+
+func volumeSnapshotManageAction(cmd *cobra.Command, args []string) {
+	ArgsNumCheck(cmd, args, 2)
+	snp := &model.ManageVolumeSnapshotSpec{
+		Name:        volSnapshotName,
+		Description: volSnapshotDesp,
+		VolumeId:    args[0],
+		Identifier:  args[1],
+	}
+
+	resp, err := client.ManageVolumeSnapshot(snp)
+	if err != nil {
+		Fatalln(HttpErrStrip(err))
+	}
+	keys := KeyList{"Id", "CreatedAt", "Name", "Description", "Size", "Status",
+		"ProfileId", "VolumeId", "Metadata"}
+	PrintDict(resp, keys, volSnapshotFormatters)
+}
+
+func volumeSnapshotUnmanageAction(cmd *cobra.Command, args []string) {
+	ArgsNumCheck(cmd, args, 1)
+	if err := client.UnmanageVolumeSnapshot(args[0]); err != nil {
+		Fatalln(HttpErrStrip(err))
+	}
+}
@@ -76,3 +76,15 @@ func TestVolumeSnapshotUpdateAction(t *testing.T) {
 	args = append(args, "3769855c-a102-11e7-b772-17b880d2f537")
 	volumeSnapshotUpdateAction(volumeSnapshotDeleteCommand, args)
 }
+
+func TestVolumeSnapshotManageAction(t *testing.T) {
+	var args []string
+	args = append(args, "bd5b12a8-a101-11e7-941e-d77981b584d8", "snap01")
+	volumeSnapshotManageAction(volumeSnapshotManageCommand, args)
+}
+
+func TestVolumeSnapshotUnmanageAction(t *testing.T) {
+	var args []string
+	args = append(args, "3769855c-a102-11e7-b772-17b880d2f537")
+	volumeSnapshotUnmanageAction(volumeSnapshotUnmanageCommand, args)
+}
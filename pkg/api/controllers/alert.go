@@ -28,6 +28,7 @@ import (
 	log "github.com/golang/glog"
 	"github.com/opensds/opensds/pkg/controller/client"
 	"github.com/opensds/opensds/pkg/model"
+	"github.com/opensds/opensds/pkg/utils/constants"
 )
 
 func NewAlertPortal() *AlertPortal {
@@ -67,7 +68,7 @@ func (v *AlertPortal) CreateAlert() {
 
 	// Alert manager will be co-located on the server, default port is 9093 for the POST API endpoint
 	// Raised issue https://github.com/opensds/opensds/issues/691 to make this configurable
-	req, err := http.NewRequest("POST", "http://localhost:9093/api/v1/alerts", body)
+	req, err := http.NewRequest("POST", constants.AlertmanagerEndpoint, body)
 	if err != nil {
 		// handle err
 		v.ErrorHandle(model.ErrorInternalServer, e.Error())
@@ -0,0 +1,96 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+This module implements a entry into the OpenSDS northbound service.
+
+*/
+
+package controllers
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/opensds/opensds/pkg/api/policy"
+	c "github.com/opensds/opensds/pkg/context"
+	"github.com/opensds/opensds/pkg/db"
+	"github.com/opensds/opensds/pkg/model"
+	"github.com/opensds/opensds/pkg/utils/constants"
+)
+
+// AuditPortal exposes the audit trail of the mutating requests, it's used
+// by admin only.
+type AuditPortal struct {
+	BasePortal
+}
+
+// ListAuditRecords returns the audit records newest first. The time range is
+// specified by since and until in RFC 3339 or the time format of OpenSDS,
+// the records can also be filtered by tenantId, userId, action, resourceId,
+// requestId and outcome.
+func (a *AuditPortal) ListAuditRecords() {
+	if !policy.Authorize(a.Ctx, "audit:list") {
+		return
+	}
+	m, err := a.GetParameters()
+	if err != nil {
+		errMsg := fmt.Sprintf("list audit records failed: %s", err.Error())
+		a.ErrorHandle(model.ErrorBadRequest, errMsg)
+		return
+	}
+	for _, key := range []string{"since", "until"} {
+		if v, ok := m[key]; ok && len(v) != 0 {
+			t, err := parseAuditTime(v[0])
+			if err != nil {
+				errMsg := fmt.Sprintf("invalid %s %q: %s", key, v[0], err.Error())
+				a.ErrorHandle(model.ErrorBadRequest, errMsg)
+				return
+			}
+			m[key] = []string{t}
+		}
+	}
+
+	result, err := db.C.ListAuditRecords(c.GetContext(a.Ctx), m)
+	if err != nil {
+		errMsg := fmt.Sprintf("list audit records failed: %s", err.Error())
+		a.ErrorHandle(model.ErrorInternalServer, errMsg)
+		return
+	}
+
+	// Marshal the result.
+	body, err := json.Marshal(result)
+	if err != nil {
+		errMsg := fmt.Sprintf("marshal audit records failed: %s", err.Error())
+		a.ErrorHandle(model.ErrorInternalServer, errMsg)
+		return
+	}
+
+	a.SuccessHandle(StatusOK, body)
+	return
+}
+
+// parseAuditTime converts the time in the query to the format the records
+// are stored in, which is compared as string.
+func parseAuditTime(s string) (string, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t.Local().Format(constants.TimeFormat), nil
+	}
+	t, err := time.ParseInLocation(constants.TimeFormat, s, time.Local)
+	if err != nil {
+		return "", err
+	}
+	return t.Format(constants.TimeFormat), nil
+}
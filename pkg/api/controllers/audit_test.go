@@ -0,0 +1,72 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/astaxie/beego"
+	"github.com/astaxie/beego/context"
+	c "github.com/opensds/opensds/pkg/context"
+	"github.com/opensds/opensds/pkg/db"
+	"github.com/opensds/opensds/pkg/model"
+	"github.com/opensds/opensds/pkg/utils/constants"
+	dbtest "github.com/opensds/opensds/testutils/db/testing"
+	"github.com/stretchr/testify/mock"
+)
+
+func init() {
+	var auditPortal AuditPortal
+	beego.Router("/v1beta/audit", &auditPortal, "get:ListAuditRecords")
+}
+
+func TestListAuditRecords(t *testing.T) {
+	t.Run("Should convert the time range to the stored format", func(t *testing.T) {
+		mockClient := new(dbtest.Client)
+		mockClient.On("ListAuditRecords", c.NewAdminContext(), mock.Anything).Return([]*model.AuditRecordSpec{}, nil)
+		db.C = mockClient
+
+		since := time.Date(2019, 5, 1, 8, 0, 0, 0, time.UTC)
+		r, _ := http.NewRequest("GET", "/v1beta/audit?since="+since.Format(time.RFC3339)+
+			"&until=2019-05-02T08:00:00&resourceId=vol", nil)
+		w := httptest.NewRecorder()
+		beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+			httpCtx.Input.SetData("context", c.NewAdminContext())
+		})
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		assertTestResult(t, w.Code, 200)
+		m := mockClient.Calls[0].Arguments.Get(1).(map[string][]string)
+		assertTestResult(t, m["since"], []string{since.Local().Format(constants.TimeFormat)})
+		assertTestResult(t, m["until"], []string{"2019-05-02T08:00:00"})
+		assertTestResult(t, m["resourceId"], []string{"vol"})
+	})
+
+	t.Run("Should return 400 if the time is invalid", func(t *testing.T) {
+		mockClient := new(dbtest.Client)
+		db.C = mockClient
+
+		r, _ := http.NewRequest("GET", "/v1beta/audit?since=yesterday", nil)
+		w := httptest.NewRecorder()
+		beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+			httpCtx.Input.SetData("context", c.NewAdminContext())
+		})
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		assertTestResult(t, w.Code, 400)
+		mockClient.AssertNotCalled(t, "ListAuditRecords", mock.Anything, mock.Anything)
+	})
+}
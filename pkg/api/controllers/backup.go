@@ -0,0 +1,414 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+This module implements a entry into the OpenSDS northbound service.
+
+*/
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	log "github.com/golang/glog"
+	"github.com/opensds/opensds/pkg/api/policy"
+	"github.com/opensds/opensds/pkg/api/util"
+	c "github.com/opensds/opensds/pkg/context"
+	"github.com/opensds/opensds/pkg/controller/client"
+	"github.com/opensds/opensds/pkg/db"
+	"github.com/opensds/opensds/pkg/model"
+	pb "github.com/opensds/opensds/pkg/model/proto"
+	. "github.com/opensds/opensds/pkg/utils/config"
+)
+
+func NewBackupPortal() *BackupPortal {
+	return &BackupPortal{
+		CtrClient: client.NewClient(),
+	}
+}
+
+type BackupPortal struct {
+	BasePortal
+
+	CtrClient client.Client
+}
+
+func (b *BackupPortal) CreateBackup() {
+	if !policy.Authorize(b.Ctx, "backup:create") {
+		return
+	}
+	ctx := c.GetContext(b.Ctx)
+	var backup = model.BackupSpec{
+		BaseModel: &model.BaseModel{},
+	}
+
+	if err := json.NewDecoder(b.Ctx.Request.Body).Decode(&backup); err != nil {
+		errMsg := fmt.Sprintf("parse volume backup request body failed: %s", err.Error())
+		b.ErrorHandle(model.ErrorBadRequest, errMsg)
+		return
+	}
+
+	// NOTE:It will create a volume backup entry into the database and initialize its status
+	// as "creating". It will not wait for the real volume backup creation to complete
+	// and will return result immediately.
+	result, err := util.CreateBackupDBEntry(ctx, &backup)
+	if err != nil {
+		errMsg := fmt.Sprintf("create volume backup failed: %s", err.Error())
+		b.ErrorHandle(model.ErrorBadRequest, errMsg)
+		return
+	}
+
+	// Marshal the result.
+	body, _ := json.Marshal(result)
+	opId := b.TrackOperation(ctx, &model.OperationSpec{
+		Action:       "CreateVolumeBackup",
+		ResourceType: model.OperationResourceBackup,
+		ResourceId:   result.Id,
+		Request:      string(body),
+	})
+	b.SuccessHandle(StatusAccepted, body)
+
+	// NOTE:The real volume backup creation process.
+	// Volume backup creation request is sent to the Dock. Dock will update volume backup status to "available"
+	// after the data of volume or snapshot has been stored by the backup driver.
+	if err := b.CtrClient.Connect(CONF.OsdsLet.ApiEndpoint); err != nil {
+		log.Error("when connecting controller client:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
+		return
+	}
+	defer b.CtrClient.Close()
+
+	opt := &pb.CreateVolumeBackupOpts{
+		Id:          result.Id,
+		Name:        result.Name,
+		Description: result.Description,
+		VolumeId:    result.VolumeId,
+		SnapshotId:  result.SnapshotId,
+		Size:        result.Size,
+		Metadata:    result.Metadata,
+		Context:     ctx.ToJson(),
+		OperationId: opId,
+	}
+	if _, err = b.CtrClient.CreateVolumeBackup(context.Background(), opt); err != nil {
+		log.Error("create volume backup failed in controller service:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
+		return
+	}
+
+	return
+}
+
+func (b *BackupPortal) ListBackups() {
+	if !policy.Authorize(b.Ctx, "backup:list") {
+		return
+	}
+	m, err := b.GetParameters()
+	if err != nil {
+		errMsg := fmt.Sprintf("list volume backups failed: %s", err.Error())
+		b.ErrorHandle(model.ErrorBadRequest, errMsg)
+		return
+	}
+
+	result, err := db.C.ListBackupsWithFilter(c.GetContext(b.Ctx), m)
+	if err != nil {
+		errMsg := fmt.Sprintf("list volume backups failed: %s", err.Error())
+		b.ErrorHandle(model.ErrorInternalServer, errMsg)
+		return
+	}
+
+	// Marshal the result.
+	body, _ := json.Marshal(result)
+	b.SuccessHandle(StatusOK, body)
+
+	return
+}
+
+func (b *BackupPortal) GetBackup() {
+	if !policy.Authorize(b.Ctx, "backup:get") {
+		return
+	}
+	id := b.Ctx.Input.Param(":backupId")
+
+	result, err := db.C.GetBackup(c.GetContext(b.Ctx), id)
+	if err != nil {
+		errMsg := fmt.Sprintf("volume backup %s not found: %s", id, err.Error())
+		b.ErrorHandle(model.ErrorNotFound, errMsg)
+		return
+	}
+
+	b.SetETag(result.BaseModel)
+
+	// Marshal the result.
+	body, _ := json.Marshal(result)
+	b.SuccessHandle(StatusOK, body)
+
+	return
+}
+
+func (b *BackupPortal) UpdateBackup() {
+	if !policy.Authorize(b.Ctx, "backup:update") {
+		return
+	}
+	var backup = model.BackupSpec{
+		BaseModel: &model.BaseModel{},
+	}
+	id := b.Ctx.Input.Param(":backupId")
+
+	if err := json.NewDecoder(b.Ctx.Request.Body).Decode(&backup); err != nil {
+		errMsg := fmt.Sprintf("parse volume backup request body failed: %s", err.Error())
+		b.ErrorHandle(model.ErrorBadRequest, errMsg)
+		return
+	}
+
+	rev, err := b.GetIfMatch()
+	if err != nil {
+		b.ErrorHandle(model.ErrorBadRequest, err.Error())
+		return
+	}
+
+	// Only the name and description of volume backup can be updated by users.
+	var input = &model.BackupSpec{
+		BaseModel:   &model.BaseModel{Id: id, Revision: rev},
+		Name:        backup.Name,
+		Description: backup.Description,
+	}
+	result, err := db.C.UpdateBackup(c.GetContext(b.Ctx), id, input)
+	if err != nil {
+		errMsg := fmt.Sprintf("update volume backup failed: %s", err.Error())
+		b.ErrorHandle(updateErrorType(err, rev), errMsg)
+		return
+	}
+
+	b.SetETag(result.BaseModel)
+
+	// Marshal the result.
+	body, _ := json.Marshal(result)
+	b.SuccessHandle(StatusOK, body)
+
+	return
+}
+
+func (b *BackupPortal) DeleteBackup() {
+	if !policy.Authorize(b.Ctx, "backup:delete") {
+		return
+	}
+	ctx := c.GetContext(b.Ctx)
+	id := b.Ctx.Input.Param(":backupId")
+
+	backup, err := db.C.GetBackup(ctx, id)
+	if err != nil {
+		errMsg := fmt.Sprintf("volume backup %s not found: %s", id, err.Error())
+		b.ErrorHandle(model.ErrorNotFound, errMsg)
+		return
+	}
+
+	// NOTE:It will update the the status of the volume backup waiting for deletion in
+	// the database to "deleting" and return the result immediately.
+	if err = util.DeleteBackupDBEntry(ctx, backup); err != nil {
+		errMsg := fmt.Sprintf("delete volume backup failed: %v", err.Error())
+		b.ErrorHandle(model.ErrorBadRequest, errMsg)
+		return
+	}
+
+	// The backup without any data stored has been removed from database.
+	if backup.BackupDriver == "" {
+		b.SuccessHandle(StatusAccepted, nil)
+		return
+	}
+
+	opId := b.TrackOperation(ctx, &model.OperationSpec{
+		Action:       "DeleteVolumeBackup",
+		ResourceType: model.OperationResourceBackup,
+		ResourceId:   backup.Id,
+	})
+	b.SuccessHandle(StatusAccepted, nil)
+
+	// NOTE:The real volume backup deletion process.
+	// Volume backup deletion request is sent to the Dock. Dock will delete the backup data from backup driver
+	// and the controller will remove it from database or update its status to "errorDeleting" if failed.
+	if err := b.CtrClient.Connect(CONF.OsdsLet.ApiEndpoint); err != nil {
+		log.Error("when connecting controller client:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
+		return
+	}
+	defer b.CtrClient.Close()
+
+	opt := &pb.DeleteVolumeBackupOpts{
+		Id:           backup.Id,
+		Metadata:     backup.Metadata,
+		BackupDriver: backup.BackupDriver,
+		Context:      ctx.ToJson(),
+		OperationId:  opId,
+	}
+	if _, err = b.CtrClient.DeleteVolumeBackup(context.Background(), opt); err != nil {
+		log.Error("delete volume backup failed in controller service:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
+		return
+	}
+
+	return
+}
+
+// ResetBackupStatus is used by admin to repair the volume backup stuck in a
+// transient status.
+func (b *BackupPortal) ResetBackupStatus() {
+	b.ResetStatus("backup:reset_status", ":backupId", model.OperationResourceBackup,
+		func(ctx *c.Context, id string) (interface{}, error) { return db.C.GetBackup(ctx, id) })
+}
+
+// ForceDeleteBackup deletes the volume backup whatever its status is. The
+// deletion from the backup driver is best-effort, the DB entry is always
+// removed.
+func (b *BackupPortal) ForceDeleteBackup() {
+	if !policy.Authorize(b.Ctx, "backup:force_delete") {
+		return
+	}
+	ctx := c.GetContext(b.Ctx)
+	id := b.Ctx.Input.Param(":backupId")
+
+	backup, err := db.C.GetBackup(ctx, id)
+	if err != nil {
+		errMsg := fmt.Sprintf("volume backup %s not found: %s", id, err.Error())
+		b.ErrorHandle(model.ErrorNotFound, errMsg)
+		return
+	}
+	if err := db.C.UpdateStatus(ctx, backup, model.BackupDeleting); err != nil {
+		errMsg := fmt.Sprintf("update status of volume backup %s failed: %s", id, err.Error())
+		b.ErrorHandle(updateErrorType(err, 0), errMsg)
+		return
+	}
+
+	var deleteBackend func() error
+	// The backup without backup driver has no data stored.
+	if backup.BackupDriver != "" {
+		deleteBackend = func() error {
+			opt := &pb.DeleteVolumeBackupOpts{
+				Id:           backup.Id,
+				Metadata:     backup.Metadata,
+				BackupDriver: backup.BackupDriver,
+				Context:      ctx.ToJson(),
+			}
+			return callController(b.CtrClient, func(cctx context.Context) error {
+				_, err := b.CtrClient.DeleteVolumeBackup(cctx, opt)
+				return err
+			})
+		}
+	}
+	b.ForceDelete(ctx, &model.OperationSpec{
+		Action:       "ForceDeleteVolumeBackup",
+		ResourceType: model.OperationResourceBackup,
+		ResourceId:   backup.Id,
+	}, deleteBackend, func() error { return util.ForceDeleteBackupDBEntry(ctx, backup) })
+}
+
+func (b *BackupPortal) RestoreBackup() {
+	if !policy.Authorize(b.Ctx, "backup:restore") {
+		return
+	}
+	ctx := c.GetContext(b.Ctx)
+	id := b.Ctx.Input.Param(":backupId")
+
+	var restore = model.RestoreBackupSpec{}
+	if err := json.NewDecoder(b.Ctx.Request.Body).Decode(&restore); err != nil {
+		errMsg := fmt.Sprintf("parse restore volume backup request body failed: %s", err.Error())
+		b.ErrorHandle(model.ErrorBadRequest, errMsg)
+		return
+	}
+
+	backup, err := db.C.GetBackup(ctx, id)
+	if err != nil {
+		errMsg := fmt.Sprintf("volume backup %s not found: %s", id, err.Error())
+		b.ErrorHandle(model.ErrorNotFound, errMsg)
+		return
+	}
+
+	// The profile is only required when the backup is restored into a new volume.
+	var prf *model.ProfileSpec
+	if restore.VolumeId == "" {
+		if restore.ProfileId == "" {
+			log.Warning("Use default profile when user doesn't specify profile.")
+			prf, err = db.C.GetDefaultProfile(ctx)
+		} else {
+			prf, err = db.C.GetProfile(ctx, restore.ProfileId)
+		}
+		if err != nil {
+			errMsg := fmt.Sprintf("get profile failed: %s", err.Error())
+			b.ErrorHandle(model.ErrorBadRequest, errMsg)
+			return
+		}
+		restore.ProfileId = prf.Id
+	}
+
+	// NOTE:It will update the status of the volume backup to "restoring", and
+	// create a new volume entry into the database if no volume is specified.
+	vol, err := util.RestoreBackupDBEntry(ctx, backup, &restore)
+	if err != nil {
+		errMsg := fmt.Sprintf("restore volume backup failed: %s", err.Error())
+		b.ErrorHandle(createErrorType(err), errMsg)
+		return
+	}
+
+	// Marshal the volume which the backup is restored into.
+	body, _ := json.Marshal(vol)
+	opId := b.TrackOperation(ctx, &model.OperationSpec{
+		Action:       "RestoreVolumeBackup",
+		ResourceType: model.OperationResourceBackup,
+		ResourceId:   backup.Id,
+		Request:      string(body),
+	})
+	b.SuccessHandle(StatusAccepted, body)
+
+	// NOTE:The real volume backup restoring process.
+	// Volume backup restoring request is sent to the Dock. The volume status will be updated to
+	// "available" after the data has been restored, or "errorRestoring" if failed.
+	if err := b.CtrClient.Connect(CONF.OsdsLet.ApiEndpoint); err != nil {
+		log.Error("when connecting controller client:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
+		return
+	}
+	defer b.CtrClient.Close()
+
+	opt := &pb.RestoreVolumeBackupOpts{
+		Id:           backup.Id,
+		VolumeId:     vol.Id,
+		Size:         backup.Size,
+		Metadata:     backup.Metadata,
+		BackupDriver: backup.BackupDriver,
+		Context:      ctx.ToJson(),
+		OperationId:  opId,
+	}
+	if prf != nil {
+		opt.NewVolume = &pb.CreateVolumeOpts{
+			Id:               vol.Id,
+			Name:             vol.Name,
+			Description:      vol.Description,
+			Size:             vol.Size,
+			AvailabilityZone: vol.AvailabilityZone,
+			ProfileId:        vol.ProfileId,
+			Profile:          prf.ToJson(),
+			Metadata:         vol.Metadata,
+			Context:          ctx.ToJson(),
+		}
+	}
+	if _, err = b.CtrClient.RestoreVolumeBackup(context.Background(), opt); err != nil {
+		log.Error("restore volume backup failed in controller service:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
+		return
+	}
+
+	return
+}
@@ -0,0 +1,224 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllers
+
+import (
+	"bytes"
+	ctx "context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/astaxie/beego"
+	"github.com/astaxie/beego/context"
+	c "github.com/opensds/opensds/pkg/context"
+	"github.com/opensds/opensds/pkg/db"
+	"github.com/opensds/opensds/pkg/model"
+	pb "github.com/opensds/opensds/pkg/model/proto"
+	. "github.com/opensds/opensds/testutils/collection"
+	ctrtest "github.com/opensds/opensds/testutils/controller/testing"
+	dbtest "github.com/opensds/opensds/testutils/db/testing"
+	"github.com/stretchr/testify/mock"
+)
+
+func init() {
+	beego.Router("/v1beta/block/backups", NewFakeBackupPortal(),
+		"post:CreateBackup;get:ListBackups")
+	beego.Router("/v1beta/block/backups/:backupId", NewFakeBackupPortal(),
+		"get:GetBackup;put:UpdateBackup;delete:DeleteBackup")
+	beego.Router("/v1beta/block/backups/:backupId/restore", NewFakeBackupPortal(),
+		"post:RestoreBackup")
+}
+
+func NewFakeBackupPortal() *BackupPortal {
+	mockClient := new(ctrtest.Client)
+
+	mockClient.On("Connect", "localhost:50049").Return(nil)
+	mockClient.On("Close").Return(nil)
+	mockClient.On("DeleteVolumeBackup", ctx.Background(), mock.Anything).Return(&pb.GenericResponse{}, nil)
+
+	return &BackupPortal{
+		CtrClient: mockClient,
+	}
+}
+
+func TestListBackups(t *testing.T) {
+
+	t.Run("Should return 200 if everything works well", func(t *testing.T) {
+		var sampleBackups = []*model.BackupSpec{&SampleBackups[0], &SampleBackups[1]}
+		mockClient := new(dbtest.Client)
+		m := map[string][]string{
+			"volumeId": {"bd5b12a8-a101-11e7-941e-d77981b584d8"},
+		}
+		mockClient.On("ListBackupsWithFilter", c.NewAdminContext(), m).Return(sampleBackups, nil)
+		db.C = mockClient
+
+		r, _ := http.NewRequest("GET", "/v1beta/block/backups?volumeId=bd5b12a8-a101-11e7-941e-d77981b584d8", nil)
+		w := httptest.NewRecorder()
+		beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+			httpCtx.Input.SetData("context", c.NewAdminContext())
+		})
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		var output []*model.BackupSpec
+		json.Unmarshal(w.Body.Bytes(), &output)
+		assertTestResult(t, w.Code, 200)
+		assertTestResult(t, output, sampleBackups)
+	})
+
+	t.Run("Should return 500 if list volume backups with bad request", func(t *testing.T) {
+		mockClient := new(dbtest.Client)
+		m := map[string][]string{
+			"volumeId": {"bd5b12a8-a101-11e7-941e-d77981b584d8"},
+		}
+		mockClient.On("ListBackupsWithFilter", c.NewAdminContext(), m).Return(nil, errors.New("db error"))
+		db.C = mockClient
+
+		r, _ := http.NewRequest("GET", "/v1beta/block/backups?volumeId=bd5b12a8-a101-11e7-941e-d77981b584d8", nil)
+		w := httptest.NewRecorder()
+		beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+			httpCtx.Input.SetData("context", c.NewAdminContext())
+		})
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		assertTestResult(t, w.Code, 500)
+	})
+}
+
+func TestGetBackup(t *testing.T) {
+
+	t.Run("Should return 200 if everything works well", func(t *testing.T) {
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetBackup", c.NewAdminContext(), "5d8c2a5e-5e0f-11e9-8cf1-6f3c2b7e2c11").Return(&SampleBackups[0], nil)
+		db.C = mockClient
+
+		r, _ := http.NewRequest("GET", "/v1beta/block/backups/5d8c2a5e-5e0f-11e9-8cf1-6f3c2b7e2c11", nil)
+		w := httptest.NewRecorder()
+		beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+			httpCtx.Input.SetData("context", c.NewAdminContext())
+		})
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		var output model.BackupSpec
+		json.Unmarshal(w.Body.Bytes(), &output)
+		assertTestResult(t, w.Code, 200)
+		assertTestResult(t, &output, &SampleBackups[0])
+	})
+
+	t.Run("Should return 404 if get volume backup with bad request", func(t *testing.T) {
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetBackup", c.NewAdminContext(), "5d8c2a5e-5e0f-11e9-8cf1-6f3c2b7e2c11").Return(nil, errors.New("db error"))
+		db.C = mockClient
+
+		r, _ := http.NewRequest("GET", "/v1beta/block/backups/5d8c2a5e-5e0f-11e9-8cf1-6f3c2b7e2c11", nil)
+		w := httptest.NewRecorder()
+		beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+			httpCtx.Input.SetData("context", c.NewAdminContext())
+		})
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		assertTestResult(t, w.Code, 404)
+	})
+}
+
+func TestUpdateBackup(t *testing.T) {
+	var jsonStr = []byte(`{
+		"name":"fake backup",
+		"description":"fake backup"
+	}`)
+	var input = &model.BackupSpec{
+		BaseModel:   &model.BaseModel{Id: "5d8c2a5e-5e0f-11e9-8cf1-6f3c2b7e2c11"},
+		Name:        "fake backup",
+		Description: "fake backup",
+	}
+	var expected = SampleBackups[0]
+	expected.Name = "fake backup"
+	expected.Description = "fake backup"
+
+	t.Run("Should return 200 if everything works well", func(t *testing.T) {
+		mockClient := new(dbtest.Client)
+		mockClient.On("UpdateBackup", c.NewAdminContext(), "5d8c2a5e-5e0f-11e9-8cf1-6f3c2b7e2c11", input).Return(&expected, nil)
+		db.C = mockClient
+
+		r, _ := http.NewRequest("PUT", "/v1beta/block/backups/5d8c2a5e-5e0f-11e9-8cf1-6f3c2b7e2c11", bytes.NewBuffer(jsonStr))
+		w := httptest.NewRecorder()
+		r.Header.Set("Content-Type", "application/JSON")
+		beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+			httpCtx.Input.SetData("context", c.NewAdminContext())
+		})
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		var output model.BackupSpec
+		json.Unmarshal(w.Body.Bytes(), &output)
+		assertTestResult(t, w.Code, 200)
+		assertTestResult(t, &output, &expected)
+	})
+
+	t.Run("Should return 500 if update volume backup with bad request", func(t *testing.T) {
+		mockClient := new(dbtest.Client)
+		mockClient.On("UpdateBackup", c.NewAdminContext(), "5d8c2a5e-5e0f-11e9-8cf1-6f3c2b7e2c11", input).Return(nil, errors.New("db error"))
+		db.C = mockClient
+
+		r, _ := http.NewRequest("PUT", "/v1beta/block/backups/5d8c2a5e-5e0f-11e9-8cf1-6f3c2b7e2c11", bytes.NewBuffer(jsonStr))
+		w := httptest.NewRecorder()
+		r.Header.Set("Content-Type", "application/JSON")
+		beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+			httpCtx.Input.SetData("context", c.NewAdminContext())
+		})
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		assertTestResult(t, w.Code, 500)
+	})
+}
+
+func TestDeleteBackup(t *testing.T) {
+
+	t.Run("Should return 202 if everything works well", func(t *testing.T) {
+		var backup = SampleBackups[0]
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetBackup", c.NewAdminContext(), "5d8c2a5e-5e0f-11e9-8cf1-6f3c2b7e2c11").Return(&backup, nil)
+		mockClient.On("UpdateBackup", c.NewAdminContext(), "5d8c2a5e-5e0f-11e9-8cf1-6f3c2b7e2c11", mock.Anything).Return(&backup, nil)
+		mockClient.On("CreateOperation", c.NewAdminContext(), &model.OperationSpec{
+			BaseModel:    &model.BaseModel{},
+			Action:       "DeleteVolumeBackup",
+			ResourceType: "backup",
+			ResourceId:   "5d8c2a5e-5e0f-11e9-8cf1-6f3c2b7e2c11",
+			Status:       "accepted",
+		}).Return(&SampleOperations[0], nil)
+		db.C = mockClient
+
+		r, _ := http.NewRequest("DELETE", "/v1beta/block/backups/5d8c2a5e-5e0f-11e9-8cf1-6f3c2b7e2c11", nil)
+		w := httptest.NewRecorder()
+		beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+			httpCtx.Input.SetData("context", c.NewAdminContext())
+		})
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		assertTestResult(t, w.Code, 202)
+		assertTestResult(t, backup.Status, "deleting")
+		assertTestResult(t, w.Header().Get("Location"), "/v1beta/operations/8b3ac17a-4c4f-11e9-9d5b-7b2ad6b2b1ea")
+	})
+
+	t.Run("Should return 400 if delete volume backup with bad status", func(t *testing.T) {
+		var backup = SampleBackups[0]
+		backup.Status = "creating"
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetBackup", c.NewAdminContext(), "5d8c2a5e-5e0f-11e9-8cf1-6f3c2b7e2c11").Return(&backup, nil)
+		db.C = mockClient
+
+		r, _ := http.NewRequest("DELETE", "/v1beta/block/backups/5d8c2a5e-5e0f-11e9-8cf1-6f3c2b7e2c11", nil)
+		w := httptest.NewRecorder()
+		beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+			httpCtx.Input.SetData("context", c.NewAdminContext())
+		})
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		assertTestResult(t, w.Code, 400)
+	})
+}
@@ -15,13 +15,26 @@
 package controllers
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/url"
 	"reflect"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/astaxie/beego"
 	log "github.com/golang/glog"
+	"github.com/opensds/opensds/pkg/api/filter/audit"
+	"github.com/opensds/opensds/pkg/api/policy"
+	"github.com/opensds/opensds/pkg/api/util"
+	c "github.com/opensds/opensds/pkg/context"
+	"github.com/opensds/opensds/pkg/controller/client"
 	"github.com/opensds/opensds/pkg/model"
+	. "github.com/opensds/opensds/pkg/utils/config"
+	"github.com/opensds/opensds/pkg/utils/urls"
 )
 
 const (
@@ -84,6 +97,12 @@ func (b *BasePortal) ErrorHandle(errType int, errMsg string) {
 		errBody = model.ErrorForbiddenStatus(errMsg)
 	case model.ErrorNotFound:
 		errBody = model.ErrorNotFoundStatus(errMsg)
+	case model.ErrorConflict:
+		errBody = model.ErrorConflictStatus(errMsg)
+	case model.ErrorPrecondition:
+		errBody = model.ErrorPreconditionStatus(errMsg)
+	case model.ErrorOverQuota:
+		errBody = model.ErrorOverQuotaStatus(errMsg)
 	case model.ErrorInternalServer:
 		errBody = model.ErrorInternalServerStatus(errMsg)
 	default:
@@ -98,6 +117,154 @@ func (b *BasePortal) ErrorHandle(errType int, errMsg string) {
 func (b *BasePortal) SuccessHandle(status int, body []byte) {
 	b.Ctx.Output.SetStatus(status)
 	if body != nil {
+		b.auditResource(body)
 		b.Ctx.Output.Body(body)
 	}
 }
+
+// auditResource tells the audit filter the resource in the response of a
+// mutating request, which is the only place to find a resource created.
+func (b *BasePortal) auditResource(body []byte) {
+	if b.Ctx.Input.Method() == http.MethodGet {
+		return
+	}
+	var resource struct {
+		Id string `json:"id"`
+	}
+	if err := json.Unmarshal(body, &resource); err == nil && resource.Id != "" {
+		audit.SetResource(b.Ctx, resource.Id)
+	}
+}
+
+// SetETag tells the client the revision of the object in the response, which
+// can be sent back in the If-Match header to update the object only if it
+// hasn't been modified since.
+func (b *BasePortal) SetETag(m *model.BaseModel) {
+	if m != nil && m.Revision != 0 {
+		b.Ctx.Output.Header("ETag", strconv.Quote(strconv.FormatInt(m.Revision, 10)))
+	}
+}
+
+// GetIfMatch returns the revision in the If-Match header of the request, zero
+// means the request isn't conditional.
+func (b *BasePortal) GetIfMatch() (int64, error) {
+	tag := strings.TrimSpace(b.Ctx.Input.Header("If-Match"))
+	if tag == "" || tag == "*" {
+		return 0, nil
+	}
+	// Revisions are compared strongly, a weak tag is treated the same way.
+	if unquoted, err := strconv.Unquote(strings.TrimPrefix(tag, "W/")); err == nil {
+		if rev, err := strconv.ParseInt(unquoted, 10, 64); err == nil && rev > 0 {
+			return rev, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid If-Match header: %s", tag)
+}
+
+// updateErrorType returns the status code for a failed update, a conflict
+// means the precondition failed if the update is based on a revision.
+func updateErrorType(err error, rev int64) int {
+	if !model.IsConflictError(err) {
+		return model.ErrorInternalServer
+	}
+	if rev != 0 {
+		return model.ErrorPrecondition
+	}
+	return model.ErrorConflict
+}
+
+// createErrorType returns the status code for a request rejected when its
+// db entry is created, most of them are caused by invalid input.
+func createErrorType(err error) int {
+	if model.IsOverQuotaError(err) {
+		return model.ErrorOverQuota
+	}
+	return model.ErrorBadRequest
+}
+
+// TrackOperation stores the operation which tracks the asynchronous request
+// into database and tells the caller where to find it through the Location
+// header, so it should be called before the response is sent. An empty id
+// will be returned if the operation can't be stored, which doesn't block the
+// request itself.
+func (b *BasePortal) TrackOperation(ctx *c.Context, op *model.OperationSpec) string {
+	result, err := util.CreateOperationDBEntry(ctx, op)
+	if err != nil {
+		log.Error("create operation failed: ", err)
+		return ""
+	}
+
+	location := "/" + urls.GenerateOperationURL(urls.Client, ctx.TenantId, result.Id)
+	b.Ctx.Output.Header("Location", location)
+	return result.Id
+}
+
+// ResetStatus sets the status of the resource to the one in the request
+// body without touching the backend, get reads the resource by the id in
+// the path parameter param. It's used by admin to repair the resource stuck
+// in a transient status.
+func (b *BasePortal) ResetStatus(rule, param, resourceType string, get func(ctx *c.Context, id string) (interface{}, error)) {
+	if !policy.Authorize(b.Ctx, rule) {
+		return
+	}
+	ctx := c.GetContext(b.Ctx)
+
+	var in = model.ResetStatusSpec{}
+	if err := json.NewDecoder(b.Ctx.Request.Body).Decode(&in); err != nil {
+		errMsg := fmt.Sprintf("parse reset status request body failed: %s", err.Error())
+		b.ErrorHandle(model.ErrorBadRequest, errMsg)
+		return
+	}
+
+	id := b.Ctx.Input.Param(param)
+	result, err := get(ctx, id)
+	if err != nil {
+		errMsg := fmt.Sprintf("%s %s not found: %s", resourceType, id, err.Error())
+		b.ErrorHandle(model.ErrorNotFound, errMsg)
+		return
+	}
+	if err := util.ResetStatusDBEntry(ctx, resourceType, result, in.Status); err != nil {
+		errMsg := fmt.Sprintf("reset status of %s %s failed: %s", resourceType, id, err.Error())
+		b.ErrorHandle(model.ErrorBadRequest, errMsg)
+		return
+	}
+	log.Infof("status of %s %s is reset to %s by admin", resourceType, id, in.Status)
+
+	body, _ := json.Marshal(result)
+	b.SuccessHandle(StatusOK, body)
+}
+
+// forceDeleteTimeout bounds how long a force deletion waits for the backend,
+// which may be the one that got the resource stuck.
+var forceDeleteTimeout = 5 * time.Minute
+
+// ForceDelete responds to the force deletion of a resource, then deletes it
+// from the backend through deleteBackend on a best-effort basis, and removes
+// it and its dependents from the DB through cleanDB at last whether the
+// backend deletion succeeded or not. deleteBackend is nil if the resource
+// has never reached the backend.
+func (b *BasePortal) ForceDelete(ctx *c.Context, op *model.OperationSpec, deleteBackend, cleanDB func() error) {
+	opId := b.TrackOperation(ctx, op)
+	b.SuccessHandle(StatusAccepted, nil)
+
+	if deleteBackend != nil {
+		if err := deleteBackend(); err != nil {
+			log.Warningf("force delete %s %s from backend failed, its db entries are removed anyway: %v",
+				op.ResourceType, op.ResourceId, err)
+		}
+	}
+	util.FinishOperationDBEntry(ctx, opId, cleanDB())
+}
+
+// callController calls the controller service with a deadline of the force
+// deletion.
+func callController(cli client.Client, call func(ctx context.Context) error) error {
+	if err := cli.Connect(CONF.OsdsLet.ApiEndpoint); err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), forceDeleteTimeout)
+	defer cancel()
+	return call(ctx)
+}
@@ -20,12 +20,14 @@ import (
 	"fmt"
 
 	log "github.com/golang/glog"
+	"github.com/opensds/opensds/pkg/api/policy"
 	"github.com/opensds/opensds/pkg/api/util"
 	c "github.com/opensds/opensds/pkg/context"
 	"github.com/opensds/opensds/pkg/controller/client"
 	"github.com/opensds/opensds/pkg/db"
 	"github.com/opensds/opensds/pkg/model"
 	pb "github.com/opensds/opensds/pkg/model/proto"
+	"github.com/opensds/opensds/pkg/quota"
 	. "github.com/opensds/opensds/pkg/utils/config"
 )
 
@@ -42,6 +44,7 @@ type FileSharePortal struct {
 }
 // Function to store Acl's related entry into databse
 func (f *FileSharePortal) CreateFileShareAcl() {
+	ctx := c.GetContext(f.Ctx)
 	var fileshareacl = model.FileShareAclSpec{
 		BaseModel: &model.BaseModel{},
 	}
@@ -52,7 +55,11 @@ func (f *FileSharePortal) CreateFileShareAcl() {
 		log.Error(reason)
 		return
 	}
-	result, err := util.CreateFileShareAclDBEntry(c.GetContext(f.Ctx), &fileshareacl)
+
+	// NOTE:It will create a fileshare acl entry into the database and initialize its status
+	// as "creating". It will not wait for the access rules to be exported and will return
+	// result immediately.
+	result, err := util.CreateFileShareAclDBEntry(ctx, &fileshareacl)
 	if err != nil {
 		reason := fmt.Sprintf("create access rules for fileshare failed: %s", err.Error())
 		f.ErrorHandle(model.ErrorBadRequest, reason)
@@ -67,8 +74,41 @@ func (f *FileSharePortal) CreateFileShareAcl() {
 		log.Error(reason)
 		return
 	}
-
+	opId := f.TrackOperation(ctx, &model.OperationSpec{
+		Action:       "CreateFileShareAcl",
+		ResourceType: model.OperationResourceFileShareAcl,
+		ResourceId:   result.Id,
+		Request:      string(body),
+	})
 	f.SuccessHandle(StatusAccepted, body)
+
+	// NOTE: The real access rules creation process.
+	// File share acl creation request is sent to the Dock. Dock will update
+	// file share acl status to "available" after the file share is exported
+	// to the clients.
+	if err := f.CtrClient.Connect(CONF.OsdsLet.ApiEndpoint); err != nil {
+		log.Error("when connecting controller client:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
+		return
+	}
+	defer f.CtrClient.Close()
+
+	opt := &pb.CreateFileShareAclOpts{
+		Id:               result.Id,
+		FileshareId:      result.FileShareId,
+		Type:             result.Type,
+		AccessTo:         result.AccessTo,
+		AccessCapability: result.AccessCapability,
+		Description:      result.Description,
+		Context:          ctx.ToJson(),
+		OperationId:      opId,
+	}
+	if _, err = f.CtrClient.CreateFileShareAcl(context.Background(), opt); err != nil {
+		log.Error("create file share acl failed in controller service:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
+		return
+	}
+
 	return
 }
 
@@ -129,7 +169,7 @@ func (f *FileSharePortal) CreateFileShare() {
 	result, err := util.CreateFileShareDBEntry(c.GetContext(f.Ctx), &fileshare)
 	if err != nil {
 		reason := fmt.Sprintf("create fileshare failed: %s", err.Error())
-		f.ErrorHandle(model.ErrorBadRequest, reason)
+		f.ErrorHandle(createErrorType(err), reason)
 		log.Error(reason)
 		return
 	}
@@ -141,6 +181,12 @@ func (f *FileSharePortal) CreateFileShare() {
 		log.Error(reason)
 		return
 	}
+	opId := f.TrackOperation(ctx, &model.OperationSpec{
+		Action:       "CreateFileShare",
+		ResourceType: model.OperationResourceFileShare,
+		ResourceId:   result.Id,
+		Request:      string(body),
+	})
 	f.SuccessHandle(StatusAccepted, body)
 
 	// NOTE: The real file share creation process.
@@ -148,6 +194,7 @@ func (f *FileSharePortal) CreateFileShare() {
 	// after file share creation is completed.
 	if err := f.CtrClient.Connect(CONF.OsdsLet.ApiEndpoint); err != nil {
 		log.Error("when connecting controller client:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
 		return
 	}
 
@@ -163,9 +210,11 @@ func (f *FileSharePortal) CreateFileShare() {
 		PoolId:    result.PoolId,
 		Metadata:  result.Metadata,
 		Context:   ctx.ToJson(),
+		OperationId: opId,
 	}
 	if _, err = f.CtrClient.CreateFileShare(context.Background(), opt); err != nil {
 		log.Error("create file share failed in controller service:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
 		return
 	}
 
@@ -227,6 +276,8 @@ func (f *FileSharePortal) GetFileShare() {
 		return
 	}
 
+	f.SetETag(result.BaseModel)
+
 	// Marshal the result.
 	body, _ := json.Marshal(result)
 	if err != nil {
@@ -252,14 +303,23 @@ func (f *FileSharePortal) UpdateFileShare() {
 		return
 	}
 
+	rev, err := f.GetIfMatch()
+	if err != nil {
+		f.ErrorHandle(model.ErrorBadRequest, err.Error())
+		return
+	}
 	fshare.Id = id
+	fshare.Revision = rev
+
 	result, err := db.C.UpdateFileShare(c.GetContext(f.Ctx), &fshare)
 	if err != nil {
 		errMsg := fmt.Sprintf("update fileshare failed: %s", err.Error())
-		f.ErrorHandle(model.ErrorInternalServer, errMsg)
+		f.ErrorHandle(updateErrorType(err, rev), errMsg)
 		return
 	}
 
+	f.SetETag(result.BaseModel)
+
 	// Marshal the result.
 	body, _ := json.Marshal(result)
 	f.SuccessHandle(StatusOK, body)
@@ -279,15 +339,103 @@ func (f *FileSharePortal) DeleteFileShareAcl() {
 		return
 	}
 
-	if err := db.C.DeleteFileShareAcl(ctx, acl.Id); err != nil {
+	// NOTE: It will update the the status of the file share acl waiting for deletion in
+	// the database to "deleting" and return the result immediately.
+	if err = util.DeleteFileShareAclDBEntry(ctx, acl); err != nil {
 		errMsg := fmt.Sprintf("delete fileshare acl failed: %v", err.Error())
-		f.ErrorHandle(model.ErrorInternalServer, errMsg)
+		f.ErrorHandle(model.ErrorBadRequest, errMsg)
+		return
+	}
+	// The acl entry has been deleted directly if its file share is gone.
+	if acl.Status != model.FileShareAclDeleting {
+		f.SuccessHandle(StatusAccepted, nil)
 		return
 	}
+
+	opId := f.TrackOperation(ctx, &model.OperationSpec{
+		Action:       "DeleteFileShareAcl",
+		ResourceType: model.OperationResourceFileShareAcl,
+		ResourceId:   acl.Id,
+	})
 	f.SuccessHandle(StatusAccepted, nil)
+
+	// NOTE: The real access rules deletion process.
+	// File share acl deletion request is sent to the Dock. Dock will revoke the
+	// access of the clients and delete the acl from database or update its
+	// status to "errorDeleting" if the revocation failed.
+	if err := f.CtrClient.Connect(CONF.OsdsLet.ApiEndpoint); err != nil {
+		log.Error("when connecting controller client:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
+		return
+	}
+	defer f.CtrClient.Close()
+
+	opt := &pb.DeleteFileShareAclOpts{
+		Id:          acl.Id,
+		FileshareId: acl.FileShareId,
+		Context:     ctx.ToJson(),
+		OperationId: opId,
+	}
+	if _, err = f.CtrClient.DeleteFileShareAcl(context.Background(), opt); err != nil {
+		log.Error("delete file share acl failed in controller service:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
+		return
+	}
+
 	return
 }
 
+// ResetFileShareAclStatus is used by admin to repair the fileshare acl stuck
+// in a transient status.
+func (f *FileSharePortal) ResetFileShareAclStatus() {
+	f.ResetStatus("fileshare_acl:reset_status", ":aclId", model.OperationResourceFileShareAcl,
+		func(ctx *c.Context, id string) (interface{}, error) { return db.C.GetFileShareAcl(ctx, id) })
+}
+
+// ForceDeleteFileShareAcl deletes the fileshare acl whatever its status is.
+// The revocation of the access is best-effort, the DB entry is always
+// removed.
+func (f *FileSharePortal) ForceDeleteFileShareAcl() {
+	if !policy.Authorize(f.Ctx, "fileshare_acl:force_delete") {
+		return
+	}
+	ctx := c.GetContext(f.Ctx)
+
+	id := f.Ctx.Input.Param(":aclId")
+	acl, err := db.C.GetFileShareAcl(ctx, id)
+	if err != nil {
+		errMsg := fmt.Sprintf("fileshare acl %s not found: %s", id, err.Error())
+		f.ErrorHandle(model.ErrorNotFound, errMsg)
+		return
+	}
+	if err := db.C.UpdateStatus(ctx, acl, model.FileShareAclDeleting); err != nil {
+		errMsg := fmt.Sprintf("update status of fileshare acl %s failed: %s", id, err.Error())
+		f.ErrorHandle(updateErrorType(err, 0), errMsg)
+		return
+	}
+
+	var deleteBackend func() error
+	// The access can't be revoked any more if its fileshare is gone.
+	if _, err := db.C.GetFileShare(ctx, acl.FileShareId); err == nil {
+		deleteBackend = func() error {
+			opt := &pb.DeleteFileShareAclOpts{
+				Id:          acl.Id,
+				FileshareId: acl.FileShareId,
+				Context:     ctx.ToJson(),
+			}
+			return callController(f.CtrClient, func(cctx context.Context) error {
+				_, err := f.CtrClient.DeleteFileShareAcl(cctx, opt)
+				return err
+			})
+		}
+	}
+	f.ForceDelete(ctx, &model.OperationSpec{
+		Action:       "ForceDeleteFileShareAcl",
+		ResourceType: model.OperationResourceFileShareAcl,
+		ResourceId:   acl.Id,
+	}, deleteBackend, func() error { return util.ForceDeleteFileShareAclDBEntry(ctx, acl) })
+}
+
 func (f *FileSharePortal) DeleteFileShare() {
 	ctx := c.GetContext(f.Ctx)
 
@@ -310,6 +458,7 @@ func (f *FileSharePortal) DeleteFileShare() {
 			f.ErrorHandle(model.ErrorInternalServer, errMsg)
 			return
 		}
+		quota.Release(ctx, fileshare.TenantId, fileshare.Id)
 		f.SuccessHandle(StatusAccepted, nil)
 		return
 	}
@@ -328,6 +477,11 @@ func (f *FileSharePortal) DeleteFileShare() {
 		return
 	}
 
+	opId := f.TrackOperation(ctx, &model.OperationSpec{
+		Action:       "DeleteFileShare",
+		ResourceType: model.OperationResourceFileShare,
+		ResourceId:   fileshare.Id,
+	})
 	f.SuccessHandle(StatusAccepted, nil)
 
 	// NOTE: The real file share deletion process.
@@ -335,6 +489,7 @@ func (f *FileSharePortal) DeleteFileShare() {
 	// and database or update file share status to "errorDeleting" if deletion from driver failed.
 	if err := f.CtrClient.Connect(CONF.OsdsLet.ApiEndpoint); err != nil {
 		log.Error("when connecting controller client:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
 		return
 	}
 	defer f.CtrClient.Close()
@@ -344,15 +499,74 @@ func (f *FileSharePortal) DeleteFileShare() {
 		Metadata:  fileshare.Metadata,
 		Context:   ctx.ToJson(),
 		Profile:   prf.ToJson(),
+		OperationId: opId,
 	}
 	if _, err = f.CtrClient.DeleteFileShare(context.Background(), opt); err != nil {
 		log.Error("delete fileshare failed in controller service:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
 		return
 	}
 
 	return
 }
 
+// ResetFileShareStatus is used by admin to repair the fileshare stuck in a
+// transient status.
+func (f *FileSharePortal) ResetFileShareStatus() {
+	f.ResetStatus("fileshare:reset_status", ":fileshareId", model.OperationResourceFileShare,
+		func(ctx *c.Context, id string) (interface{}, error) { return db.C.GetFileShare(ctx, id) })
+}
+
+// ForceDeleteFileShare deletes the fileshare whatever its status is, along
+// with its snapshots and acls in the DB. The deletion from the backend is
+// best-effort, the DB entries are always removed.
+func (f *FileSharePortal) ForceDeleteFileShare() {
+	if !policy.Authorize(f.Ctx, "fileshare:force_delete") {
+		return
+	}
+	ctx := c.GetContext(f.Ctx)
+
+	id := f.Ctx.Input.Param(":fileshareId")
+	fileshare, err := db.C.GetFileShare(ctx, id)
+	if err != nil {
+		errMsg := fmt.Sprintf("fileshare %s not found: %s", id, err.Error())
+		f.ErrorHandle(model.ErrorNotFound, errMsg)
+		return
+	}
+	if err := db.C.UpdateStatus(ctx, fileshare, model.FileShareDeleting); err != nil {
+		errMsg := fmt.Sprintf("update status of fileshare %s failed: %s", id, err.Error())
+		f.ErrorHandle(updateErrorType(err, 0), errMsg)
+		return
+	}
+
+	var deleteBackend func() error
+	// The fileshare without profileId or poolId has never reached the backend.
+	if fileshare.ProfileId != "" && fileshare.PoolId != "" {
+		deleteBackend = func() error {
+			prf, err := db.C.GetProfile(ctx, fileshare.ProfileId)
+			if err != nil {
+				return err
+			}
+			opt := &pb.DeleteFileShareOpts{
+				Id:       fileshare.Id,
+				PoolId:   fileshare.PoolId,
+				Metadata: fileshare.Metadata,
+				Context:  ctx.ToJson(),
+				Profile:  prf.ToJson(),
+			}
+			return callController(f.CtrClient, func(cctx context.Context) error {
+				_, err := f.CtrClient.DeleteFileShare(cctx, opt)
+				return err
+			})
+		}
+	}
+	f.ForceDelete(ctx, &model.OperationSpec{
+		Action:       "ForceDeleteFileShare",
+		ResourceType: model.OperationResourceFileShare,
+		ResourceId:   fileshare.Id,
+	}, deleteBackend, func() error { return util.ForceDeleteFileShareDBEntry(ctx, fileshare) })
+}
+
 func NewFileShareSnapshotPortal() *FileShareSnapshotPortal {
 	return &FileShareSnapshotPortal{
 		CtrClient: client.NewClient(),
@@ -389,8 +603,38 @@ func (f *FileShareSnapshotPortal) CreateFileShareSnapshot() {
 
 	// Marshal the result.
 	body, _ := json.Marshal(result)
+	opId := f.TrackOperation(ctx, &model.OperationSpec{
+		Action:       "CreateFileShareSnapshot",
+		ResourceType: model.OperationResourceFileShareSnapshot,
+		ResourceId:   result.Id,
+		Request:      string(body),
+	})
 	f.SuccessHandle(StatusAccepted, body)
 
+	// NOTE: The real file share snapshot creation process.
+	// File share snapshot creation request is sent to the Dock. Dock will update
+	// file share snapshot status to "available" after it is created.
+	if err := f.CtrClient.Connect(CONF.OsdsLet.ApiEndpoint); err != nil {
+		log.Error("when connecting controller client:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
+		return
+	}
+	defer f.CtrClient.Close()
+
+	opt := &pb.CreateFileShareSnapshotOpts{
+		Id:          result.Id,
+		Name:        result.Name,
+		Description: result.Description,
+		FileshareId: result.FileShareId,
+		Context:     ctx.ToJson(),
+		OperationId: opId,
+	}
+	if _, err = f.CtrClient.CreateFileShareSnapshot(context.Background(), opt); err != nil {
+		log.Error("create file share snapshot failed in controller service:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
+		return
+	}
+
 	return
 }
 
@@ -425,6 +669,8 @@ func (f *FileShareSnapshotPortal) GetFileShareSnapshot() {
 		return
 	}
 
+	f.SetETag(result.BaseModel)
+
 	// Marshal the result.
 	body, _ := json.Marshal(result)
 	f.SuccessHandle(StatusOK, body)
@@ -444,15 +690,24 @@ func (f *FileShareSnapshotPortal) UpdateFileShareSnapshot() {
 		f.ErrorHandle(model.ErrorBadRequest, errMsg)
 		return
 	}
+
+	rev, err := f.GetIfMatch()
+	if err != nil {
+		f.ErrorHandle(model.ErrorBadRequest, err.Error())
+		return
+	}
 	snapshot.Id = id
+	snapshot.Revision = rev
 
 	result, err := db.C.UpdateFileShareSnapshot(c.GetContext(f.Ctx), id, &snapshot)
 	if err != nil {
 		errMsg := fmt.Sprintf("update fileshare snapshot failed: %s", err.Error())
-		f.ErrorHandle(model.ErrorInternalServer, errMsg)
+		f.ErrorHandle(updateErrorType(err, rev), errMsg)
 		return
 	}
 
+	f.SetETag(result.BaseModel)
+
 	// Marshal the result.
 	body, _ := json.Marshal(result)
 	f.SuccessHandle(StatusOK, body)
@@ -479,7 +734,94 @@ func (f *FileShareSnapshotPortal) DeleteFileShareSnapshot() {
 		f.ErrorHandle(model.ErrorBadRequest, errMsg)
 		return
 	}
-	f.Ctx.Output.SetStatus(StatusAccepted)
+	// The snapshot entry has been deleted directly if its file share is gone.
+	if snapshot.Status != model.FileShareSnapDeleting {
+		f.SuccessHandle(StatusAccepted, nil)
+		return
+	}
+
+	opId := f.TrackOperation(ctx, &model.OperationSpec{
+		Action:       "DeleteFileShareSnapshot",
+		ResourceType: model.OperationResourceFileShareSnapshot,
+		ResourceId:   snapshot.Id,
+	})
+	f.SuccessHandle(StatusAccepted, nil)
+
+	// NOTE: The real file share snapshot deletion process.
+	// File share snapshot deletion request is sent to the Dock. Dock will delete
+	// it from driver and database or update its status to "errorDeleting" if
+	// the deletion from driver failed.
+	if err := f.CtrClient.Connect(CONF.OsdsLet.ApiEndpoint); err != nil {
+		log.Error("when connecting controller client:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
+		return
+	}
+	defer f.CtrClient.Close()
+
+	opt := &pb.DeleteFileShareSnapshotOpts{
+		Id:          snapshot.Id,
+		FileshareId: snapshot.FileShareId,
+		Metadata:    snapshot.Metadata,
+		Context:     ctx.ToJson(),
+		OperationId: opId,
+	}
+	if _, err = f.CtrClient.DeleteFileShareSnapshot(context.Background(), opt); err != nil {
+		log.Error("delete file share snapshot failed in controller service:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
+		return
+	}
+
 	return
+}
+
+// ResetFileShareSnapshotStatus is used by admin to repair the fileshare
+// snapshot stuck in a transient status.
+func (f *FileShareSnapshotPortal) ResetFileShareSnapshotStatus() {
+	f.ResetStatus("fileshare_snapshot:reset_status", ":snapshotId", model.OperationResourceFileShareSnapshot,
+		func(ctx *c.Context, id string) (interface{}, error) { return db.C.GetFileShareSnapshot(ctx, id) })
+}
 
+// ForceDeleteFileShareSnapshot deletes the fileshare snapshot whatever its
+// status is. The deletion from the backend is best-effort, the DB entry is
+// always removed.
+func (f *FileShareSnapshotPortal) ForceDeleteFileShareSnapshot() {
+	if !policy.Authorize(f.Ctx, "fileshare_snapshot:force_delete") {
+		return
+	}
+	ctx := c.GetContext(f.Ctx)
+
+	id := f.Ctx.Input.Param(":snapshotId")
+	snapshot, err := db.C.GetFileShareSnapshot(ctx, id)
+	if err != nil {
+		errMsg := fmt.Sprintf("fileshare snapshot %s not found: %s", id, err.Error())
+		f.ErrorHandle(model.ErrorNotFound, errMsg)
+		return
+	}
+	if err := db.C.UpdateStatus(ctx, snapshot, model.FileShareSnapDeleting); err != nil {
+		errMsg := fmt.Sprintf("update status of fileshare snapshot %s failed: %s", id, err.Error())
+		f.ErrorHandle(updateErrorType(err, 0), errMsg)
+		return
+	}
+
+	var deleteBackend func() error
+	// The snapshot can't be deleted from the backend if its fileshare is gone.
+	if _, err := db.C.GetFileShare(ctx, snapshot.FileShareId); err == nil {
+		deleteBackend = func() error {
+			opt := &pb.DeleteFileShareSnapshotOpts{
+				Id:          snapshot.Id,
+				FileshareId: snapshot.FileShareId,
+				Metadata:    snapshot.Metadata,
+				Context:     ctx.ToJson(),
+			}
+			return callController(f.CtrClient, func(cctx context.Context) error {
+				_, err := f.CtrClient.DeleteFileShareSnapshot(cctx, opt)
+				return err
+			})
+		}
+	}
+	f.ForceDelete(ctx, &model.OperationSpec{
+		Action:       "ForceDeleteFileShareSnapshot",
+		ResourceType: model.OperationResourceFileShareSnapshot,
+		ResourceId:   snapshot.Id,
+	}, deleteBackend, func() error { return util.ForceDeleteFileShareSnapshotDBEntry(ctx, snapshot) })
 }
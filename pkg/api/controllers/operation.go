@@ -0,0 +1,91 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+This module implements a entry into the OpenSDS northbound service.
+
+*/
+
+package controllers
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/opensds/opensds/pkg/api/policy"
+	c "github.com/opensds/opensds/pkg/context"
+	"github.com/opensds/opensds/pkg/db"
+	"github.com/opensds/opensds/pkg/model"
+)
+
+// OperationPortal exposes the operations which track the asynchronous
+// requests, the caller can follow the request through the Location header
+// returned by the api server.
+type OperationPortal struct {
+	BasePortal
+}
+
+func (o *OperationPortal) ListOperations() {
+	if !policy.Authorize(o.Ctx, "operation:list") {
+		return
+	}
+	m, err := o.GetParameters()
+	if err != nil {
+		errMsg := fmt.Sprintf("list operations failed: %s", err.Error())
+		o.ErrorHandle(model.ErrorBadRequest, errMsg)
+		return
+	}
+
+	result, err := db.C.ListOperationsWithFilter(c.GetContext(o.Ctx), m)
+	if err != nil {
+		errMsg := fmt.Sprintf("list operations failed: %s", err.Error())
+		o.ErrorHandle(model.ErrorInternalServer, errMsg)
+		return
+	}
+
+	// Marshal the result.
+	body, err := json.Marshal(result)
+	if err != nil {
+		errMsg := fmt.Sprintf("marshal operations failed: %s", err.Error())
+		o.ErrorHandle(model.ErrorInternalServer, errMsg)
+		return
+	}
+
+	o.SuccessHandle(StatusOK, body)
+	return
+}
+
+func (o *OperationPortal) GetOperation() {
+	if !policy.Authorize(o.Ctx, "operation:get") {
+		return
+	}
+	id := o.Ctx.Input.Param(":operationId")
+	result, err := db.C.GetOperation(c.GetContext(o.Ctx), id)
+	if err != nil {
+		errMsg := fmt.Sprintf("operation %s not found: %s", id, err.Error())
+		o.ErrorHandle(model.ErrorNotFound, errMsg)
+		return
+	}
+
+	// Marshal the result.
+	body, err := json.Marshal(result)
+	if err != nil {
+		errMsg := fmt.Sprintf("marshal operation failed: %s", err.Error())
+		o.ErrorHandle(model.ErrorInternalServer, errMsg)
+		return
+	}
+
+	o.SuccessHandle(StatusOK, body)
+	return
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/astaxie/beego"
+	c "github.com/opensds/opensds/pkg/context"
+	"github.com/opensds/opensds/pkg/db"
+	"github.com/opensds/opensds/pkg/model"
+	. "github.com/opensds/opensds/testutils/collection"
+	dbtest "github.com/opensds/opensds/testutils/db/testing"
+)
+
+func init() {
+	var operationPortal OperationPortal
+	beego.Router("/v1beta/operations", &operationPortal, "get:ListOperations")
+	beego.Router("/v1beta/operations/:operationId", &operationPortal, "get:GetOperation")
+}
+
+func TestListOperations(t *testing.T) {
+
+	t.Run("Should return 200 if everything works well", func(t *testing.T) {
+		var sampleOperations = []*model.OperationSpec{&SampleOperations[0], &SampleOperations[1]}
+		mockClient := new(dbtest.Client)
+		m := map[string][]string{
+			"status": {"failed"},
+		}
+		mockClient.On("ListOperationsWithFilter", c.NewAdminContext(), m).Return(sampleOperations, nil)
+		db.C = mockClient
+
+		r, _ := http.NewRequest("GET", "/v1beta/operations?status=failed", nil)
+		w := httptest.NewRecorder()
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		var output []*model.OperationSpec
+		json.Unmarshal(w.Body.Bytes(), &output)
+		assertTestResult(t, w.Code, 200)
+		assertTestResult(t, output, sampleOperations)
+	})
+
+	t.Run("Should return 500 if list operations with bad request", func(t *testing.T) {
+		mockClient := new(dbtest.Client)
+		m := map[string][]string{
+			"status": {"failed"},
+		}
+		mockClient.On("ListOperationsWithFilter", c.NewAdminContext(), m).Return(nil, errors.New("db error"))
+		db.C = mockClient
+
+		r, _ := http.NewRequest("GET", "/v1beta/operations?status=failed", nil)
+		w := httptest.NewRecorder()
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		assertTestResult(t, w.Code, 500)
+	})
+}
+
+func TestGetOperation(t *testing.T) {
+
+	t.Run("Should return 200 if everything works well", func(t *testing.T) {
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetOperation", c.NewAdminContext(), "8b3ac17a-4c4f-11e9-9d5b-7b2ad6b2b1ea").Return(&SampleOperations[0], nil)
+		db.C = mockClient
+
+		r, _ := http.NewRequest("GET", "/v1beta/operations/8b3ac17a-4c4f-11e9-9d5b-7b2ad6b2b1ea", nil)
+		w := httptest.NewRecorder()
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		var output model.OperationSpec
+		json.Unmarshal(w.Body.Bytes(), &output)
+		assertTestResult(t, w.Code, 200)
+		assertTestResult(t, &output, &SampleOperations[0])
+	})
+
+	t.Run("Should return 404 if get operation with bad request", func(t *testing.T) {
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetOperation", c.NewAdminContext(), "8b3ac17a-4c4f-11e9-9d5b-7b2ad6b2b1ea").Return(nil, errors.New("db error"))
+		db.C = mockClient
+
+		r, _ := http.NewRequest("GET", "/v1beta/operations/8b3ac17a-4c4f-11e9-9d5b-7b2ad6b2b1ea", nil)
+		w := httptest.NewRecorder()
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		assertTestResult(t, w.Code, 404)
+	})
+}
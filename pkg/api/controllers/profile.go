@@ -26,6 +26,7 @@ import (
 
 	"github.com/opensds/opensds/pkg/api/policy"
 	c "github.com/opensds/opensds/pkg/context"
+	"github.com/opensds/opensds/pkg/controller/policy/schedule"
 	"github.com/opensds/opensds/pkg/db"
 	"github.com/opensds/opensds/pkg/model"
 	"github.com/opensds/opensds/pkg/utils"
@@ -90,6 +91,11 @@ func (p *ProfilePortal) CreateProfile() {
 		p.ErrorHandle(model.ErrorBadRequest, errMsg)
 		return
 	}
+	if _, err := schedule.NewPolicy(&profile); err != nil {
+		errMsg := fmt.Sprintf("parse profile request body failed: %v", err)
+		p.ErrorHandle(model.ErrorBadRequest, errMsg)
+		return
+	}
 
 	// Call db api module to handle create profile request.
 	result, err := db.C.CreateProfile(c.GetContext(p.Ctx), &profile)
@@ -155,6 +161,8 @@ func (p *ProfilePortal) GetProfile() {
 		return
 	}
 
+	p.SetETag(result.BaseModel)
+
 	// Marshal the result.
 	body, err := json.Marshal(result)
 	if err != nil {
@@ -183,13 +191,28 @@ func (p *ProfilePortal) UpdateProfile() {
 		return
 	}
 
+	rev, err := p.GetIfMatch()
+	if err != nil {
+		p.ErrorHandle(model.ErrorBadRequest, err.Error())
+		return
+	}
+	profile.Revision = rev
+
+	if _, err := schedule.NewPolicy(&profile); err != nil {
+		errMsg := fmt.Sprintf("parse profile request body failed: %v", err)
+		p.ErrorHandle(model.ErrorBadRequest, errMsg)
+		return
+	}
+
 	result, err := db.C.UpdateProfile(c.GetContext(p.Ctx), id, &profile)
 	if err != nil {
 		errMsg := fmt.Sprintf("update profiles failed: %v", err)
-		p.ErrorHandle(model.ErrorInternalServer, errMsg)
+		p.ErrorHandle(updateErrorType(err, rev), errMsg)
 		return
 	}
 
+	p.SetETag(result.BaseModel)
+
 	// Marshal the result.
 	body, err := json.Marshal(result)
 	if err != nil {
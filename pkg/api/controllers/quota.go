@@ -0,0 +1,119 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+This module implements a entry into the OpenSDS northbound service.
+
+*/
+
+package controllers
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/opensds/opensds/pkg/api/policy"
+	c "github.com/opensds/opensds/pkg/context"
+	"github.com/opensds/opensds/pkg/db"
+	"github.com/opensds/opensds/pkg/model"
+	"github.com/opensds/opensds/pkg/quota"
+)
+
+// QuotaPortal exposes the quota of the tenant specified in the url, the
+// tenant can see its own quota while only admin can change it.
+type QuotaPortal struct {
+	BasePortal
+}
+
+func (q *QuotaPortal) GetQuota() {
+	if !policy.Authorize(q.Ctx, "quota:get") {
+		return
+	}
+	tenantId := q.Ctx.Input.Param(":tenantId")
+
+	result, err := quota.Get(c.GetContext(q.Ctx), tenantId)
+	if err != nil {
+		errMsg := fmt.Sprintf("get quota of tenant %s failed: %s", tenantId, err.Error())
+		q.ErrorHandle(model.ErrorInternalServer, errMsg)
+		return
+	}
+
+	q.SetETag(result.BaseModel)
+
+	// Marshal the result.
+	body, err := json.Marshal(result)
+	if err != nil {
+		errMsg := fmt.Sprintf("marshal quota failed: %s", err.Error())
+		q.ErrorHandle(model.ErrorInternalServer, errMsg)
+		return
+	}
+
+	q.SuccessHandle(StatusOK, body)
+	return
+}
+
+func (q *QuotaPortal) UpdateQuota() {
+	if !policy.Authorize(q.Ctx, "quota:update") {
+		return
+	}
+	ctx := c.GetContext(q.Ctx)
+	tenantId := q.Ctx.Input.Param(":tenantId")
+
+	rev, err := q.GetIfMatch()
+	if err != nil {
+		q.ErrorHandle(model.ErrorBadRequest, err.Error())
+		return
+	}
+
+	// The limits which are not specified in the request are kept unchanged.
+	current, err := db.C.GetQuota(ctx, tenantId)
+	if _, ok := err.(*model.NotFoundError); ok {
+		current = quota.Default(tenantId)
+	} else if err != nil {
+		errMsg := fmt.Sprintf("get quota of tenant %s failed: %s", tenantId, err.Error())
+		q.ErrorHandle(model.ErrorInternalServer, errMsg)
+		return
+	}
+	if err := json.NewDecoder(q.Ctx.Request.Body).Decode(current); err != nil {
+		errMsg := fmt.Sprintf("parse quota request body failed: %s", err.Error())
+		q.ErrorHandle(model.ErrorBadRequest, errMsg)
+		return
+	}
+	if l := current.QuotaSet; l.Volumes < -1 || l.Snapshots < -1 || l.Capacity < -1 || l.FileShares < -1 {
+		errMsg := fmt.Sprintf("invalid quota %+v, every limit should be -1 (unlimited) or a non-negative number", l)
+		q.ErrorHandle(model.ErrorBadRequest, errMsg)
+		return
+	}
+	current.TenantId, current.Revision = tenantId, rev
+
+	result, err := db.C.UpdateQuota(ctx, current)
+	if err != nil {
+		errMsg := fmt.Sprintf("update quota of tenant %s failed: %s", tenantId, err.Error())
+		q.ErrorHandle(updateErrorType(err, rev), errMsg)
+		return
+	}
+
+	q.SetETag(result.BaseModel)
+
+	// Marshal the result.
+	body, err := json.Marshal(result)
+	if err != nil {
+		errMsg := fmt.Sprintf("marshal quota failed: %s", err.Error())
+		q.ErrorHandle(model.ErrorInternalServer, errMsg)
+		return
+	}
+
+	q.SuccessHandle(StatusOK, body)
+	return
+}
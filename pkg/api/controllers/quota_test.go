@@ -0,0 +1,163 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/astaxie/beego"
+	"github.com/astaxie/beego/context"
+	c "github.com/opensds/opensds/pkg/context"
+	"github.com/opensds/opensds/pkg/db"
+	"github.com/opensds/opensds/pkg/model"
+	. "github.com/opensds/opensds/testutils/collection"
+	dbtest "github.com/opensds/opensds/testutils/db/testing"
+	"github.com/stretchr/testify/mock"
+)
+
+func init() {
+	var quotaPortal QuotaPortal
+	beego.Router("/v1beta/:tenantId/quotas", &quotaPortal, "get:GetQuota;put:UpdateQuota")
+}
+
+// sampleQuota returns a copy of the sample quota, which may be modified by
+// the handlers.
+func sampleQuota() *model.QuotaSpec {
+	var quota = SampleQuotas[0]
+	var base = *quota.BaseModel
+	quota.BaseModel = &base
+	return &quota
+}
+
+func TestGetQuota(t *testing.T) {
+	var tenantId = SampleQuotas[0].TenantId
+
+	t.Run("Should return 200 if everything works well", func(t *testing.T) {
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetQuota", c.NewAdminContext(), tenantId).Return(sampleQuota(), nil)
+		mockClient.On("GetQuotaUsage", c.NewAdminContext(), tenantId).Return(&SampleQuotaUsages[0], nil)
+		db.C = mockClient
+
+		r, _ := http.NewRequest("GET", "/v1beta/"+tenantId+"/quotas", nil)
+		w := httptest.NewRecorder()
+		beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+			httpCtx.Input.SetData("context", c.NewAdminContext())
+		})
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		var output, expected model.QuotaSpec
+		json.Unmarshal(w.Body.Bytes(), &output)
+		json.Unmarshal([]byte(ByteQuota), &expected)
+		assertTestResult(t, w.Code, 200)
+		assertTestResult(t, &output, &expected)
+	})
+
+	t.Run("Should return 500 if get quota usage failed", func(t *testing.T) {
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetQuota", c.NewAdminContext(), tenantId).Return(sampleQuota(), nil)
+		mockClient.On("GetQuotaUsage", c.NewAdminContext(), tenantId).Return(nil, errors.New("db error"))
+		db.C = mockClient
+
+		r, _ := http.NewRequest("GET", "/v1beta/"+tenantId+"/quotas", nil)
+		w := httptest.NewRecorder()
+		beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+			httpCtx.Input.SetData("context", c.NewAdminContext())
+		})
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		assertTestResult(t, w.Code, 500)
+	})
+}
+
+func TestUpdateQuota(t *testing.T) {
+	var tenantId = SampleQuotas[0].TenantId
+
+	t.Run("Should keep the limits not specified", func(t *testing.T) {
+		var current = sampleQuota()
+		current.Revision = 3
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetQuota", c.NewAdminContext(), tenantId).Return(current, nil)
+		mockClient.On("UpdateQuota", c.NewAdminContext(), mock.Anything).Return(current, nil)
+		db.C = mockClient
+
+		r, _ := http.NewRequest("PUT", "/v1beta/"+tenantId+"/quotas", bytes.NewBufferString(`{"volumes": 5}`))
+		w := httptest.NewRecorder()
+		beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+			httpCtx.Input.SetData("context", c.NewAdminContext())
+		})
+		r.Header.Set("Content-Type", "application/JSON")
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		assertTestResult(t, w.Code, 200)
+		assertTestResult(t, current.QuotaSet, model.QuotaSet{Volumes: 5, Snapshots: 20, Capacity: 1000, FileShares: -1})
+		// The update is unconditional without If-Match.
+		assertTestResult(t, current.Revision, int64(0))
+	})
+
+	t.Run("Should start from the default quota if no quota is set", func(t *testing.T) {
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetQuota", c.NewAdminContext(), tenantId).Return(nil, model.NewNotFoundError("quota is not set"))
+		mockClient.On("UpdateQuota", c.NewAdminContext(), mock.Anything).Return(sampleQuota(), nil)
+		db.C = mockClient
+
+		r, _ := http.NewRequest("PUT", "/v1beta/"+tenantId+"/quotas", bytes.NewBufferString(`{"capacity": 100}`))
+		w := httptest.NewRecorder()
+		beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+			httpCtx.Input.SetData("context", c.NewAdminContext())
+		})
+		r.Header.Set("Content-Type", "application/JSON")
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		assertTestResult(t, w.Code, 200)
+		quota := mockClient.Calls[1].Arguments.Get(1).(*model.QuotaSpec)
+		assertTestResult(t, quota.TenantId, tenantId)
+		assertTestResult(t, quota.Capacity, int64(100))
+	})
+
+	t.Run("Should return 400 if the limit is invalid", func(t *testing.T) {
+		var current = sampleQuota()
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetQuota", c.NewAdminContext(), tenantId).Return(current, nil)
+		db.C = mockClient
+
+		r, _ := http.NewRequest("PUT", "/v1beta/"+tenantId+"/quotas", bytes.NewBufferString(`{"snapshots": -2}`))
+		w := httptest.NewRecorder()
+		beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+			httpCtx.Input.SetData("context", c.NewAdminContext())
+		})
+		r.Header.Set("Content-Type", "application/JSON")
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		assertTestResult(t, w.Code, 400)
+	})
+
+	t.Run("Should return 412 if the quota has been modified", func(t *testing.T) {
+		var current = sampleQuota()
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetQuota", c.NewAdminContext(), tenantId).Return(current, nil)
+		mockClient.On("UpdateQuota", c.NewAdminContext(), mock.Anything).Return(nil, model.NewConflictError("conflict"))
+		db.C = mockClient
+
+		r, _ := http.NewRequest("PUT", "/v1beta/"+tenantId+"/quotas", bytes.NewBufferString(`{"volumes": 5}`))
+		w := httptest.NewRecorder()
+		beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+			httpCtx.Input.SetData("context", c.NewAdminContext())
+		})
+		r.Header.Set("Content-Type", "application/JSON")
+		r.Header.Set("If-Match", `"2"`)
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		assertTestResult(t, w.Code, 412)
+	})
+}
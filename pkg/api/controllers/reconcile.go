@@ -0,0 +1,118 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+This module implements a entry into the OpenSDS northbound service.
+
+*/
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	log "github.com/golang/glog"
+	"github.com/opensds/opensds/pkg/api/policy"
+	c "github.com/opensds/opensds/pkg/context"
+	"github.com/opensds/opensds/pkg/controller/client"
+	"github.com/opensds/opensds/pkg/model"
+	pb "github.com/opensds/opensds/pkg/model/proto"
+	. "github.com/opensds/opensds/pkg/utils/config"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func NewReconcilePortal() *ReconcilePortal {
+	return &ReconcilePortal{
+		CtrClient: client.NewClient(),
+	}
+}
+
+// ReconcilePortal exposes the reconciliation between the database and the
+// backends run by osdslet, it's used by admin only.
+type ReconcilePortal struct {
+	BasePortal
+
+	CtrClient client.Client
+}
+
+// GetReconcileReport returns the report of the last reconciliation, or the
+// status of the running one.
+func (r *ReconcilePortal) GetReconcileReport() {
+	if !policy.Authorize(r.Ctx, "reconcile:get") {
+		return
+	}
+	ctx := c.GetContext(r.Ctx)
+
+	if err := r.CtrClient.Connect(CONF.OsdsLet.ApiEndpoint); err != nil {
+		log.Error("when connecting controller client:", err)
+		r.ErrorHandle(model.ErrorInternalServer, err.Error())
+		return
+	}
+	defer r.CtrClient.Close()
+
+	opt := &pb.ReconcileOpts{Context: ctx.ToJson()}
+	response, err := r.CtrClient.GetReconcileReport(context.Background(), opt)
+	if err != nil {
+		errMsg := fmt.Sprintf("get reconcile report failed: %s", status.Convert(err).Message())
+		if status.Code(err) == codes.NotFound {
+			r.ErrorHandle(model.ErrorNotFound, errMsg)
+			return
+		}
+		r.ErrorHandle(model.ErrorInternalServer, errMsg)
+		return
+	}
+
+	r.SuccessHandle(StatusOK, []byte(response.GetResult().GetMessage()))
+	return
+}
+
+// StartReconcile starts a reconciliation in osdslet unless one is running,
+// and returns the status of the running one. The report is fetched through
+// GetReconcileReport after it finishes.
+func (r *ReconcilePortal) StartReconcile() {
+	if !policy.Authorize(r.Ctx, "reconcile:run") {
+		return
+	}
+	ctx := c.GetContext(r.Ctx)
+
+	// The body is optional, auto fix is disabled without it.
+	var in = model.ReconcileSpec{}
+	if err := json.NewDecoder(r.Ctx.Request.Body).Decode(&in); err != nil && err != io.EOF {
+		errMsg := fmt.Sprintf("parse reconcile request body failed: %s", err.Error())
+		r.ErrorHandle(model.ErrorBadRequest, errMsg)
+		return
+	}
+
+	if err := r.CtrClient.Connect(CONF.OsdsLet.ApiEndpoint); err != nil {
+		log.Error("when connecting controller client:", err)
+		r.ErrorHandle(model.ErrorInternalServer, err.Error())
+		return
+	}
+	defer r.CtrClient.Close()
+
+	opt := &pb.ReconcileOpts{AutoFix: in.AutoFix, Context: ctx.ToJson()}
+	response, err := r.CtrClient.Reconcile(context.Background(), opt)
+	if err != nil {
+		errMsg := fmt.Sprintf("start reconciliation failed: %s", status.Convert(err).Message())
+		r.ErrorHandle(model.ErrorInternalServer, errMsg)
+		return
+	}
+
+	r.SuccessHandle(StatusAccepted, []byte(response.GetResult().GetMessage()))
+	return
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllers
+
+import (
+	ctx "context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/astaxie/beego"
+	"github.com/astaxie/beego/context"
+	c "github.com/opensds/opensds/pkg/context"
+	"github.com/opensds/opensds/pkg/model"
+	pb "github.com/opensds/opensds/pkg/model/proto"
+	ctrtest "github.com/opensds/opensds/testutils/controller/testing"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// reconcilePortal is routed by beego, which copies its fields into the
+// portal serving every request, so each test sets its own CtrClient.
+var reconcilePortal = &ReconcilePortal{}
+
+func init() {
+	beego.Router("/v1beta/admin/reconcile", reconcilePortal,
+		"get:GetReconcileReport;post:StartReconcile")
+}
+
+func newFakeReconcileCtrClient() *ctrtest.Client {
+	mockClient := new(ctrtest.Client)
+	mockClient.On("Connect", "localhost:50049").Return(nil)
+	mockClient.On("Close").Return(nil)
+	return mockClient
+}
+
+func TestReconcile(t *testing.T) {
+	running, _ := json.Marshal(&model.ReconcileReportSpec{
+		Status:    model.ReconcileRunning,
+		AutoFix:   true,
+		StartedAt: "2019-05-01T08:00:00",
+		Drifts:    []*model.DriftSpec{},
+	})
+	beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+		httpCtx.Input.SetData("context", c.NewAdminContext())
+	})
+
+	t.Run("Should return 404 if no reconciliation has been run", func(t *testing.T) {
+		mockClient := newFakeReconcileCtrClient()
+		mockClient.On("GetReconcileReport", ctx.Background(), mock.Anything).
+			Return(nil, status.Error(codes.NotFound, "no reconciliation has been run"))
+		reconcilePortal.CtrClient = mockClient
+
+		r, _ := http.NewRequest("GET", "/v1beta/admin/reconcile", nil)
+		w := httptest.NewRecorder()
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		assertTestResult(t, w.Code, 404)
+	})
+
+	t.Run("Should start reconciliation with auto fix", func(t *testing.T) {
+		mockClient := newFakeReconcileCtrClient()
+		mockClient.On("Reconcile", ctx.Background(), mock.Anything).
+			Return(pb.GenericResponseResult(string(running)), nil)
+		reconcilePortal.CtrClient = mockClient
+
+		r, _ := http.NewRequest("POST", "/v1beta/admin/reconcile", strings.NewReader(`{"autoFix":true}`))
+		w := httptest.NewRecorder()
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		assertTestResult(t, w.Code, 202)
+		opt := mockClient.Calls[len(mockClient.Calls)-2].Arguments.Get(1).(*pb.ReconcileOpts)
+		assertTestResult(t, opt.AutoFix, true)
+		var output model.ReconcileReportSpec
+		json.Unmarshal(w.Body.Bytes(), &output)
+		assertTestResult(t, output.Status, model.ReconcileRunning)
+	})
+
+	t.Run("Should return the report", func(t *testing.T) {
+		mockClient := newFakeReconcileCtrClient()
+		mockClient.On("GetReconcileReport", ctx.Background(), mock.Anything).
+			Return(pb.GenericResponseResult(string(running)), nil)
+		reconcilePortal.CtrClient = mockClient
+
+		r, _ := http.NewRequest("GET", "/v1beta/admin/reconcile", nil)
+		w := httptest.NewRecorder()
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		assertTestResult(t, w.Code, 200)
+		var output model.ReconcileReportSpec
+		json.Unmarshal(w.Body.Bytes(), &output)
+		assertTestResult(t, output.StartedAt, "2019-05-01T08:00:00")
+	})
+
+	t.Run("Should return 400 if the body is invalid", func(t *testing.T) {
+		reconcilePortal.CtrClient = newFakeReconcileCtrClient()
+
+		r, _ := http.NewRequest("POST", "/v1beta/admin/reconcile", strings.NewReader(`{"autoFix":"yes"}`))
+		w := httptest.NewRecorder()
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		assertTestResult(t, w.Code, 400)
+	})
+}
@@ -77,6 +77,12 @@ func (r *ReplicationPortal) CreateReplication() {
 		r.ErrorHandle(model.ErrorInternalServer, errMsg)
 		return
 	}
+	opId := r.TrackOperation(ctx, &model.OperationSpec{
+		Action:       "CreateReplication",
+		ResourceType: model.OperationResourceReplication,
+		ResourceId:   result.Id,
+		Request:      string(body),
+	})
 	r.SuccessHandle(StatusAccepted, body)
 
 	// NOTE:The real volume replication creation process.
@@ -84,6 +90,7 @@ func (r *ReplicationPortal) CreateReplication() {
 	// after volume replication creation is completed.
 	if err = r.CtrClient.Connect(CONF.OsdsLet.ApiEndpoint); err != nil {
 		log.Error("when connecting controller client:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
 		return
 	}
 	defer r.CtrClient.Close()
@@ -97,9 +104,11 @@ func (r *ReplicationPortal) CreateReplication() {
 		AvailabilityZone:  result.AvailabilityZone,
 		ProfileId:         result.ProfileId,
 		Context:           ctx.ToJson(),
+		OperationId:       opId,
 	}
 	if _, err = r.CtrClient.CreateReplication(context.Background(), opt); err != nil {
 		log.Error("create volume replication failed in controller service:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
 		return
 	}
 
@@ -185,6 +194,8 @@ func (r *ReplicationPortal) GetReplication() {
 		return
 	}
 
+	r.SetETag(result.BaseModel)
+
 	// Marshal the result.
 	body, err := json.Marshal(r.outputFilter(result, whiteList))
 	if err != nil {
@@ -212,6 +223,13 @@ func (r *ReplicationPortal) UpdateReplication() {
 		return
 	}
 
+	rev, err := r.GetIfMatch()
+	if err != nil {
+		r.ErrorHandle(model.ErrorBadRequest, err.Error())
+		return
+	}
+	mr.Revision = rev
+
 	if mr.ProfileId != "" {
 		if _, err := db.C.GetProfile(c.GetContext(r.Ctx), mr.ProfileId); err != nil {
 			errMsg := fmt.Sprintf("get profile failed: %s", err.Error())
@@ -224,10 +242,12 @@ func (r *ReplicationPortal) UpdateReplication() {
 	result, err := db.C.UpdateReplication(c.GetContext(r.Ctx), id, &mr)
 	if err != nil {
 		errMsg := fmt.Sprintf("update replication failed: %s", err.Error())
-		r.ErrorHandle(model.ErrorInternalServer, errMsg)
+		r.ErrorHandle(updateErrorType(err, rev), errMsg)
 		return
 	}
 
+	r.SetETag(result.BaseModel)
+
 	// Marshal the result.
 	body, err := json.Marshal(result)
 	if err != nil {
@@ -258,6 +278,11 @@ func (r *ReplicationPortal) DeleteReplication() {
 		r.ErrorHandle(model.ErrorBadRequest, err.Error())
 		return
 	}
+	opId := r.TrackOperation(ctx, &model.OperationSpec{
+		Action:       "DeleteReplication",
+		ResourceType: model.OperationResourceReplication,
+		ResourceId:   rep.Id,
+	})
 	r.SuccessHandle(StatusAccepted, nil)
 
 	// NOTE:The real volume replication deletion process.
@@ -265,6 +290,7 @@ func (r *ReplicationPortal) DeleteReplication() {
 	// replicaiton record after volume replication creation is completed.
 	if err = r.CtrClient.Connect(CONF.OsdsLet.ApiEndpoint); err != nil {
 		log.Error("when connecting controller client:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
 		return
 	}
 	defer r.CtrClient.Close()
@@ -277,15 +303,67 @@ func (r *ReplicationPortal) DeleteReplication() {
 		ProfileId:         rep.ProfileId,
 		Metadata:          rep.Metadata,
 		Context:           ctx.ToJson(),
+		OperationId:       opId,
 	}
 	if _, err = r.CtrClient.DeleteReplication(context.Background(), opt); err != nil {
 		log.Error("delete volume replication failed in controller service:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
 		return
 	}
 
 	return
 }
 
+// ResetReplicationStatus is used by admin to repair the replication stuck
+// in a transient status.
+func (r *ReplicationPortal) ResetReplicationStatus() {
+	r.ResetStatus("replication:reset_status", ":replicationId", model.OperationResourceReplication,
+		func(ctx *c.Context, id string) (interface{}, error) { return db.C.GetReplication(ctx, id) })
+}
+
+// ForceDeleteReplication deletes the replication whatever its status is. The
+// deletion from the backend is best-effort, the DB entry is always removed.
+func (r *ReplicationPortal) ForceDeleteReplication() {
+	if !policy.Authorize(r.Ctx, "replication:force_delete") {
+		return
+	}
+	ctx := c.GetContext(r.Ctx)
+
+	id := r.Ctx.Input.Param(":replicationId")
+	rep, err := db.C.GetReplication(ctx, id)
+	if err != nil {
+		errMsg := fmt.Sprintf("get replication failed: %s", err.Error())
+		r.ErrorHandle(model.ErrorNotFound, errMsg)
+		return
+	}
+	if err := db.C.UpdateStatus(ctx, rep, model.ReplicationDeleting); err != nil {
+		errMsg := fmt.Sprintf("update status of replication %s failed: %s", id, err.Error())
+		r.ErrorHandle(updateErrorType(err, 0), errMsg)
+		return
+	}
+
+	deleteBackend := func() error {
+		opt := &pb.DeleteReplicationOpts{
+			Id:                rep.Id,
+			PrimaryVolumeId:   rep.PrimaryVolumeId,
+			SecondaryVolumeId: rep.SecondaryVolumeId,
+			AvailabilityZone:  rep.AvailabilityZone,
+			ProfileId:         rep.ProfileId,
+			Metadata:          rep.Metadata,
+			Context:           ctx.ToJson(),
+		}
+		return callController(r.CtrClient, func(cctx context.Context) error {
+			_, err := r.CtrClient.DeleteReplication(cctx, opt)
+			return err
+		})
+	}
+	r.ForceDelete(ctx, &model.OperationSpec{
+		Action:       "ForceDeleteReplication",
+		ResourceType: model.OperationResourceReplication,
+		ResourceId:   rep.Id,
+	}, deleteBackend, func() error { return util.ForceDeleteReplicationDBEntry(ctx, rep) })
+}
+
 func (r *ReplicationPortal) EnableReplication() {
 	if !policy.Authorize(r.Ctx, "replication:enable") {
 		return
@@ -304,6 +382,11 @@ func (r *ReplicationPortal) EnableReplication() {
 		r.ErrorHandle(model.ErrorBadRequest, err.Error())
 		return
 	}
+	opId := r.TrackOperation(ctx, &model.OperationSpec{
+		Action:       "EnableReplication",
+		ResourceType: model.OperationResourceReplication,
+		ResourceId:   rep.Id,
+	})
 	r.SuccessHandle(StatusAccepted, nil)
 
 	// NOTE:The real volume replication enable process.
@@ -312,6 +395,7 @@ func (r *ReplicationPortal) EnableReplication() {
 	// operation is completed.
 	if err = r.CtrClient.Connect(CONF.OsdsLet.ApiEndpoint); err != nil {
 		log.Error("when connecting controller client:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
 		return
 	}
 	defer r.CtrClient.Close()
@@ -324,9 +408,11 @@ func (r *ReplicationPortal) EnableReplication() {
 		ProfileId:         rep.ProfileId,
 		Metadata:          rep.Metadata,
 		Context:           ctx.ToJson(),
+		OperationId:       opId,
 	}
 	if _, err = r.CtrClient.EnableReplication(context.Background(), opt); err != nil {
 		log.Error("enable volume replication failed in controller service:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
 		return
 	}
 
@@ -351,6 +437,11 @@ func (r *ReplicationPortal) DisableReplication() {
 		r.ErrorHandle(model.ErrorBadRequest, err.Error())
 		return
 	}
+	opId := r.TrackOperation(ctx, &model.OperationSpec{
+		Action:       "DisableReplication",
+		ResourceType: model.OperationResourceReplication,
+		ResourceId:   rep.Id,
+	})
 	r.SuccessHandle(StatusAccepted, nil)
 
 	// NOTE:The real volume replication disable process.
@@ -359,6 +450,7 @@ func (r *ReplicationPortal) DisableReplication() {
 	// operation is completed.
 	if err = r.CtrClient.Connect(CONF.OsdsLet.ApiEndpoint); err != nil {
 		log.Error("when connecting controller client:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
 		return
 	}
 	defer r.CtrClient.Close()
@@ -371,9 +463,11 @@ func (r *ReplicationPortal) DisableReplication() {
 		ProfileId:         rep.ProfileId,
 		Metadata:          rep.Metadata,
 		Context:           ctx.ToJson(),
+		OperationId:       opId,
 	}
 	if _, err = r.CtrClient.DisableReplication(context.Background(), opt); err != nil {
 		log.Error("disable volume replication failed in controller service:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
 		return
 	}
 
@@ -405,6 +499,13 @@ func (r *ReplicationPortal) FailoverReplication() {
 		r.ErrorHandle(model.ErrorBadRequest, err.Error())
 		return
 	}
+	reqBody, _ := json.Marshal(failover)
+	opId := r.TrackOperation(ctx, &model.OperationSpec{
+		Action:       "FailoverReplication",
+		ResourceType: model.OperationResourceReplication,
+		ResourceId:   rep.Id,
+		Request:      string(reqBody),
+	})
 	r.SuccessHandle(StatusAccepted, nil)
 
 	// NOTE:The real volume replication failover process.
@@ -413,6 +514,7 @@ func (r *ReplicationPortal) FailoverReplication() {
 	// operation is completed.
 	if err = r.CtrClient.Connect(CONF.OsdsLet.ApiEndpoint); err != nil {
 		log.Error("when connecting controller client:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
 		return
 	}
 	defer r.CtrClient.Close()
@@ -427,9 +529,11 @@ func (r *ReplicationPortal) FailoverReplication() {
 		AllowAttachedVolume: failover.AllowAttachedVolume,
 		SecondaryBackendId:  failover.SecondaryBackendId,
 		Context:             ctx.ToJson(),
+		OperationId:         opId,
 	}
 	if _, err = r.CtrClient.FailoverReplication(context.Background(), opt); err != nil {
 		log.Error("failover volume replication failed in controller service:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
 		return
 	}
 
@@ -32,6 +32,7 @@ import (
 	"github.com/opensds/opensds/pkg/db"
 	"github.com/opensds/opensds/pkg/model"
 	pb "github.com/opensds/opensds/pkg/model/proto"
+	"github.com/opensds/opensds/pkg/quota"
 	. "github.com/opensds/opensds/pkg/utils/config"
 )
 
@@ -85,7 +86,7 @@ func (v *VolumePortal) CreateVolume() {
 	result, err := util.CreateVolumeDBEntry(ctx, &volume)
 	if err != nil {
 		errMsg := fmt.Sprintf("create volume failed: %s", err.Error())
-		v.ErrorHandle(model.ErrorBadRequest, errMsg)
+		v.ErrorHandle(createErrorType(err), errMsg)
 		return
 	}
 
@@ -93,6 +94,12 @@ func (v *VolumePortal) CreateVolume() {
 
 	// Marshal the result.
 	body, _ := json.Marshal(result)
+	opId := v.TrackOperation(ctx, &model.OperationSpec{
+		Action:       "CreateVolume",
+		ResourceType: model.OperationResourceVolume,
+		ResourceId:   result.Id,
+		Request:      string(body),
+	})
 	v.SuccessHandle(StatusAccepted, body)
 
 	// NOTE:The real volume creation process.
@@ -100,6 +107,7 @@ func (v *VolumePortal) CreateVolume() {
 	// after volume creation is completed.
 	if err := v.CtrClient.Connect(CONF.OsdsLet.ApiEndpoint); err != nil {
 		log.Error("when connecting controller client:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
 		return
 	}
 	defer v.CtrClient.Close()
@@ -115,12 +123,15 @@ func (v *VolumePortal) CreateVolume() {
 		Profile:           prf.ToJson(),
 		PoolId:            result.PoolId,
 		SnapshotId:        result.SnapshotId,
+		SourceVolumeId:    result.SourceVolumeId,
 		Metadata:          result.Metadata,
 		SnapshotFromCloud: result.SnapshotFromCloud,
 		Context:           ctx.ToJson(),
+		OperationId:       opId,
 	}
 	if _, err = v.CtrClient.CreateVolume(context.Background(), opt); err != nil {
 		log.Error("create volume failed in controller service:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
 		return
 	}
 
@@ -167,6 +178,8 @@ func (v *VolumePortal) GetVolume() {
 		return
 	}
 
+	v.SetETag(result.BaseModel)
+
 	// Marshal the result.
 	body, _ := json.Marshal(result)
 	v.SuccessHandle(StatusOK, body)
@@ -189,14 +202,25 @@ func (v *VolumePortal) UpdateVolume() {
 		return
 	}
 
+	rev, err := v.GetIfMatch()
+	if err != nil {
+		v.ErrorHandle(model.ErrorBadRequest, err.Error())
+		return
+	}
 	volume.Id = id
+	volume.Revision = rev
+	// The snapshot schedule is maintained by osdslet only.
+	volume.SnapshotSchedule = nil
+
 	result, err := db.C.UpdateVolume(c.GetContext(v.Ctx), &volume)
 	if err != nil {
 		errMsg := fmt.Sprintf("update volume failed: %s", err.Error())
-		v.ErrorHandle(model.ErrorInternalServer, errMsg)
+		v.ErrorHandle(updateErrorType(err, rev), errMsg)
 		return
 	}
 
+	v.SetETag(result.BaseModel)
+
 	// Marshal the result.
 	body, _ := json.Marshal(result)
 	v.SuccessHandle(StatusOK, body)
@@ -238,12 +262,19 @@ func (v *VolumePortal) ExtendVolume() {
 	result, err := util.ExtendVolumeDBEntry(ctx, id, &extendRequestBody)
 	if err != nil {
 		errMsg := fmt.Sprintf("extend volume failed: %s", err.Error())
-		v.ErrorHandle(model.ErrorBadRequest, errMsg)
+		v.ErrorHandle(createErrorType(err), errMsg)
 		return
 	}
 
 	// Marshal the result.
 	body, _ := json.Marshal(result)
+	reqBody, _ := json.Marshal(extendRequestBody)
+	opId := v.TrackOperation(ctx, &model.OperationSpec{
+		Action:       "ExtendVolume",
+		ResourceType: model.OperationResourceVolume,
+		ResourceId:   id,
+		Request:      string(reqBody),
+	})
 	v.SuccessHandle(StatusAccepted, body)
 
 	// NOTE:The real volume extension process.
@@ -251,19 +282,360 @@ func (v *VolumePortal) ExtendVolume() {
 	// after volume extension is completed.
 	if err = v.CtrClient.Connect(CONF.OsdsLet.ApiEndpoint); err != nil {
 		log.Error("when connecting controller client:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
 		return
 	}
 	defer v.CtrClient.Close()
 
 	opt := &pb.ExtendVolumeOpts{
-		Id:       id,
-		Size:     extendRequestBody.NewSize,
-		Metadata: result.Metadata,
-		Context:  ctx.ToJson(),
-		Profile:  prf.ToJson(),
+		Id:          id,
+		Size:        extendRequestBody.NewSize,
+		Metadata:    result.Metadata,
+		Context:     ctx.ToJson(),
+		Profile:     prf.ToJson(),
+		OperationId: opId,
 	}
 	if _, err = v.CtrClient.ExtendVolume(context.Background(), opt); err != nil {
 		log.Error("extend volume failed in controller service:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
+		return
+	}
+
+	return
+}
+
+func (v *VolumePortal) RetypeVolume() {
+	if !policy.Authorize(v.Ctx, "volume:retype") {
+		return
+	}
+	ctx := c.GetContext(v.Ctx)
+	var retypeRequestBody = model.RetypeVolumeSpec{}
+
+	if err := json.NewDecoder(v.Ctx.Request.Body).Decode(&retypeRequestBody); err != nil {
+		errMsg := fmt.Sprintf("parse volume request body failed: %s", err.Error())
+		v.ErrorHandle(model.ErrorBadRequest, errMsg)
+		return
+	}
+
+	id := v.Ctx.Input.Param(":volumeId")
+	volume, err := db.C.GetVolume(ctx, id)
+	if err != nil {
+		errMsg := fmt.Sprintf("volume %s not found: %s", id, err.Error())
+		v.ErrorHandle(model.ErrorNotFound, errMsg)
+		return
+	}
+
+	// NOTE:It will update the the status of the volume waiting for retype in
+	// the database to "retyping" and return the result immediately.
+	result, err := util.RetypeVolumeDBEntry(ctx, volume, &retypeRequestBody)
+	if err != nil {
+		errMsg := fmt.Sprintf("retype volume failed: %s", err.Error())
+		v.ErrorHandle(createErrorType(err), errMsg)
+		return
+	}
+
+	// Marshal the result.
+	body, _ := json.Marshal(result)
+	reqBody, _ := json.Marshal(retypeRequestBody)
+	opId := v.TrackOperation(ctx, &model.OperationSpec{
+		Action:       "RetypeVolume",
+		ResourceType: model.OperationResourceVolume,
+		ResourceId:   id,
+		Request:      string(reqBody),
+	})
+	v.SuccessHandle(StatusAccepted, body)
+
+	// NOTE:The real volume retype process.
+	// Volume retype request is sent to the controller, which will migrate the
+	// volume if it's needed and update volume status to "available" after the
+	// volume is retyped.
+	if err = v.CtrClient.Connect(CONF.OsdsLet.ApiEndpoint); err != nil {
+		log.Error("when connecting controller client:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
+		return
+	}
+	defer v.CtrClient.Close()
+
+	opt := &pb.RetypeVolumeOpts{
+		Id:              id,
+		ProfileId:       retypeRequestBody.ProfileId,
+		PoolId:          retypeRequestBody.PoolId,
+		MigrationPolicy: retypeRequestBody.MigrationPolicy,
+		Context:         ctx.ToJson(),
+		OperationId:     opId,
+	}
+	if _, err = v.CtrClient.RetypeVolume(context.Background(), opt); err != nil {
+		log.Error("retype volume failed in controller service:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
+		return
+	}
+
+	return
+}
+
+func (v *VolumePortal) MigrateVolume() {
+	if !policy.Authorize(v.Ctx, "volume:migrate") {
+		return
+	}
+	ctx := c.GetContext(v.Ctx)
+	var migrateRequestBody = model.MigrateVolumeSpec{}
+
+	if err := json.NewDecoder(v.Ctx.Request.Body).Decode(&migrateRequestBody); err != nil {
+		errMsg := fmt.Sprintf("parse volume request body failed: %s", err.Error())
+		v.ErrorHandle(model.ErrorBadRequest, errMsg)
+		return
+	}
+
+	id := v.Ctx.Input.Param(":volumeId")
+	volume, err := db.C.GetVolume(ctx, id)
+	if err != nil {
+		errMsg := fmt.Sprintf("volume %s not found: %s", id, err.Error())
+		v.ErrorHandle(model.ErrorNotFound, errMsg)
+		return
+	}
+
+	// NOTE:It will update the the status of the volume waiting for migration
+	// in the database to "migrating" and return the result immediately.
+	result, err := util.MigrateVolumeDBEntry(ctx, volume, &migrateRequestBody)
+	if err != nil {
+		errMsg := fmt.Sprintf("migrate volume failed: %s", err.Error())
+		v.ErrorHandle(createErrorType(err), errMsg)
+		return
+	}
+
+	// Marshal the result.
+	body, _ := json.Marshal(result)
+	reqBody, _ := json.Marshal(migrateRequestBody)
+	opId := v.TrackOperation(ctx, &model.OperationSpec{
+		Action:       "MigrateVolume",
+		ResourceType: model.OperationResourceVolume,
+		ResourceId:   id,
+		Request:      string(reqBody),
+	})
+	v.SuccessHandle(StatusAccepted, body)
+
+	// NOTE:The real volume migration process.
+	// Volume migration request is sent to the controller, which will update
+	// volume status to "available" after the volume is migrated.
+	if err = v.CtrClient.Connect(CONF.OsdsLet.ApiEndpoint); err != nil {
+		log.Error("when connecting controller client:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
+		return
+	}
+	defer v.CtrClient.Close()
+
+	opt := &pb.MigrateVolumeOpts{
+		Id:          id,
+		PoolId:      migrateRequestBody.PoolId,
+		Context:     ctx.ToJson(),
+		OperationId: opId,
+	}
+	if _, err = v.CtrClient.MigrateVolume(context.Background(), opt); err != nil {
+		log.Error("migrate volume failed in controller service:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
+		return
+	}
+
+	return
+}
+
+func (v *VolumePortal) RevertVolume() {
+	if !policy.Authorize(v.Ctx, "volume:revert") {
+		return
+	}
+	ctx := c.GetContext(v.Ctx)
+	var revertRequestBody = model.RevertVolumeSpec{}
+
+	if err := json.NewDecoder(v.Ctx.Request.Body).Decode(&revertRequestBody); err != nil {
+		errMsg := fmt.Sprintf("parse volume request body failed: %s", err.Error())
+		v.ErrorHandle(model.ErrorBadRequest, errMsg)
+		return
+	}
+
+	id := v.Ctx.Input.Param(":volumeId")
+	volume, err := db.C.GetVolume(ctx, id)
+	if err != nil {
+		errMsg := fmt.Sprintf("volume %s not found: %s", id, err.Error())
+		v.ErrorHandle(model.ErrorNotFound, errMsg)
+		return
+	}
+
+	// NOTE:It will update the the status of the volume waiting for reverting
+	// in the database to "reverting" and return the result immediately.
+	result, err := util.RevertVolumeDBEntry(ctx, volume, &revertRequestBody)
+	if err != nil {
+		errMsg := fmt.Sprintf("revert volume failed: %s", err.Error())
+		v.ErrorHandle(createErrorType(err), errMsg)
+		return
+	}
+
+	// Marshal the result.
+	body, _ := json.Marshal(result)
+	reqBody, _ := json.Marshal(revertRequestBody)
+	opId := v.TrackOperation(ctx, &model.OperationSpec{
+		Action:       "RevertVolume",
+		ResourceType: model.OperationResourceVolume,
+		ResourceId:   id,
+		Request:      string(reqBody),
+	})
+	v.SuccessHandle(StatusAccepted, body)
+
+	// NOTE:The real volume reverting process.
+	// Volume reverting request is sent to the controller, which will update
+	// volume status back once the volume is reverted.
+	if err = v.CtrClient.Connect(CONF.OsdsLet.ApiEndpoint); err != nil {
+		log.Error("when connecting controller client:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
+		return
+	}
+	defer v.CtrClient.Close()
+
+	opt := &pb.RevertVolumeToSnapshotOpts{
+		Id:          id,
+		SnapshotId:  revertRequestBody.SnapshotId,
+		Force:       revertRequestBody.Force,
+		Context:     ctx.ToJson(),
+		OperationId: opId,
+	}
+	if _, err = v.CtrClient.RevertVolumeToSnapshot(context.Background(), opt); err != nil {
+		log.Error("revert volume failed in controller service:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
+		return
+	}
+
+	return
+}
+
+func (v *VolumePortal) ManageVolume() {
+	if !policy.Authorize(v.Ctx, "volume:manage") {
+		return
+	}
+	ctx := c.GetContext(v.Ctx)
+	var manageRequestBody = model.ManageVolumeSpec{}
+
+	if err := json.NewDecoder(v.Ctx.Request.Body).Decode(&manageRequestBody); err != nil {
+		errMsg := fmt.Sprintf("parse volume request body failed: %s", err.Error())
+		v.ErrorHandle(model.ErrorBadRequest, errMsg)
+		return
+	}
+
+	// get profile
+	var prf *model.ProfileSpec
+	var err error
+	if manageRequestBody.ProfileId == "" {
+		log.Warning("Use default profile when user doesn't specify profile.")
+		prf, err = db.C.GetDefaultProfile(ctx)
+	} else {
+		prf, err = db.C.GetProfile(ctx, manageRequestBody.ProfileId)
+	}
+	if err != nil {
+		errMsg := fmt.Sprintf("get profile failed: %s", err.Error())
+		v.ErrorHandle(model.ErrorBadRequest, errMsg)
+		return
+	}
+	manageRequestBody.ProfileId = prf.Id
+
+	// NOTE:It will create a volume entry into the database and initialize its
+	// status as "managing". It will not wait for the volume to be taken over
+	// and will return result immediately.
+	result, err := util.ManageVolumeDBEntry(ctx, &manageRequestBody)
+	if err != nil {
+		errMsg := fmt.Sprintf("manage volume failed: %s", err.Error())
+		v.ErrorHandle(createErrorType(err), errMsg)
+		return
+	}
+
+	// Marshal the result.
+	body, _ := json.Marshal(result)
+	reqBody, _ := json.Marshal(manageRequestBody)
+	opId := v.TrackOperation(ctx, &model.OperationSpec{
+		Action:       "ManageVolume",
+		ResourceType: model.OperationResourceVolume,
+		ResourceId:   result.Id,
+		Request:      string(reqBody),
+	})
+	v.SuccessHandle(StatusAccepted, body)
+
+	// NOTE:The real volume managing process.
+	// Volume managing request is sent to the controller, which will update
+	// volume status to "available" after the volume is taken over.
+	if err = v.CtrClient.Connect(CONF.OsdsLet.ApiEndpoint); err != nil {
+		log.Error("when connecting controller client:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
+		return
+	}
+	defer v.CtrClient.Close()
+
+	opt := &pb.ManageVolumeOpts{
+		Id:          result.Id,
+		Name:        result.Name,
+		Description: result.Description,
+		Identifier:  manageRequestBody.Identifier,
+		PoolId:      result.PoolId,
+		ProfileId:   result.ProfileId,
+		Profile:     prf.ToJson(),
+		Metadata:    result.Metadata,
+		Context:     ctx.ToJson(),
+		OperationId: opId,
+	}
+	if _, err = v.CtrClient.ManageVolume(context.Background(), opt); err != nil {
+		log.Error("manage volume failed in controller service:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
+		return
+	}
+
+	return
+}
+
+func (v *VolumePortal) UnmanageVolume() {
+	if !policy.Authorize(v.Ctx, "volume:unmanage") {
+		return
+	}
+	ctx := c.GetContext(v.Ctx)
+
+	id := v.Ctx.Input.Param(":volumeId")
+	volume, err := db.C.GetVolume(ctx, id)
+	if err != nil {
+		errMsg := fmt.Sprintf("volume %s not found: %s", id, err.Error())
+		v.ErrorHandle(model.ErrorNotFound, errMsg)
+		return
+	}
+
+	// NOTE:It will update the the status of the volume waiting for unmanaging
+	// in the database to "unmanaging" and return the result immediately.
+	if err = util.UnmanageVolumeDBEntry(ctx, volume); err != nil {
+		errMsg := fmt.Sprintf("unmanage volume failed: %s", err.Error())
+		v.ErrorHandle(model.ErrorBadRequest, errMsg)
+		return
+	}
+
+	opId := v.TrackOperation(ctx, &model.OperationSpec{
+		Action:       "UnmanageVolume",
+		ResourceType: model.OperationResourceVolume,
+		ResourceId:   volume.Id,
+	})
+	v.SuccessHandle(StatusAccepted, nil)
+
+	// NOTE:The real volume unmanaging process.
+	// Volume unmanaging request is sent to the controller, which will remove
+	// the volume from database and leave its data in the backend.
+	if err = v.CtrClient.Connect(CONF.OsdsLet.ApiEndpoint); err != nil {
+		log.Error("when connecting controller client:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
+		return
+	}
+	defer v.CtrClient.Close()
+
+	opt := &pb.UnmanageVolumeOpts{
+		Id:          volume.Id,
+		PoolId:      volume.PoolId,
+		Metadata:    volume.Metadata,
+		Context:     ctx.ToJson(),
+		OperationId: opId,
+	}
+	if _, err = v.CtrClient.UnmanageVolume(context.Background(), opt); err != nil {
+		log.Error("unmanage volume failed in controller service:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
 		return
 	}
 
@@ -295,6 +667,7 @@ func (v *VolumePortal) DeleteVolume() {
 			v.ErrorHandle(model.ErrorInternalServer, errMsg)
 			return
 		}
+		quota.Release(ctx, volume.TenantId, volume.Id)
 		v.SuccessHandle(StatusAccepted, nil)
 		return
 	}
@@ -314,6 +687,11 @@ func (v *VolumePortal) DeleteVolume() {
 		return
 	}
 
+	opId := v.TrackOperation(ctx, &model.OperationSpec{
+		Action:       "DeleteVolume",
+		ResourceType: model.OperationResourceVolume,
+		ResourceId:   volume.Id,
+	})
 	v.SuccessHandle(StatusAccepted, nil)
 
 	// NOTE:The real volume deletion process.
@@ -321,26 +699,87 @@ func (v *VolumePortal) DeleteVolume() {
 	// and database or update volume status to "errorDeleting" if deletion from driver faild.
 	if err := v.CtrClient.Connect(CONF.OsdsLet.ApiEndpoint); err != nil {
 		log.Error("when connecting controller client:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
 		return
 	}
 	defer v.CtrClient.Close()
 
 	opt := &pb.DeleteVolumeOpts{
-		Id:        volume.Id,
-		ProfileId: volume.ProfileId,
-		PoolId:    volume.PoolId,
-		Metadata:  volume.Metadata,
-		Context:   ctx.ToJson(),
-		Profile:   prf.ToJson(),
+		Id:          volume.Id,
+		ProfileId:   volume.ProfileId,
+		PoolId:      volume.PoolId,
+		Metadata:    volume.Metadata,
+		Context:     ctx.ToJson(),
+		Profile:     prf.ToJson(),
+		OperationId: opId,
 	}
 	if _, err = v.CtrClient.DeleteVolume(context.Background(), opt); err != nil {
 		log.Error("delete volume failed in controller service:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
 		return
 	}
 
 	return
 }
 
+// ResetVolumeStatus is used by admin to repair the volume stuck in a
+// transient status.
+func (v *VolumePortal) ResetVolumeStatus() {
+	v.ResetStatus("volume:reset_status", ":volumeId", model.OperationResourceVolume,
+		func(ctx *c.Context, id string) (interface{}, error) { return db.C.GetVolume(ctx, id) })
+}
+
+// ForceDeleteVolume deletes the volume whatever its status is, along with its
+// snapshots, attachments and replication in the DB. The deletion from the
+// backend is best-effort, the DB entries are always removed.
+func (v *VolumePortal) ForceDeleteVolume() {
+	if !policy.Authorize(v.Ctx, "volume:force_delete") {
+		return
+	}
+	ctx := c.GetContext(v.Ctx)
+
+	id := v.Ctx.Input.Param(":volumeId")
+	volume, err := db.C.GetVolume(ctx, id)
+	if err != nil {
+		errMsg := fmt.Sprintf("volume %s not found: %s", id, err.Error())
+		v.ErrorHandle(model.ErrorNotFound, errMsg)
+		return
+	}
+	if err := db.C.UpdateStatus(ctx, volume, model.VolumeDeleting); err != nil {
+		errMsg := fmt.Sprintf("update status of volume %s failed: %s", id, err.Error())
+		v.ErrorHandle(updateErrorType(err, 0), errMsg)
+		return
+	}
+
+	var deleteBackend func() error
+	// The volume without profileId or poolId has never reached the backend.
+	if volume.ProfileId != "" && volume.PoolId != "" {
+		deleteBackend = func() error {
+			prf, err := db.C.GetProfile(ctx, volume.ProfileId)
+			if err != nil {
+				return err
+			}
+			opt := &pb.DeleteVolumeOpts{
+				Id:        volume.Id,
+				ProfileId: volume.ProfileId,
+				PoolId:    volume.PoolId,
+				Metadata:  volume.Metadata,
+				Context:   ctx.ToJson(),
+				Profile:   prf.ToJson(),
+			}
+			return callController(v.CtrClient, func(cctx context.Context) error {
+				_, err := v.CtrClient.DeleteVolume(cctx, opt)
+				return err
+			})
+		}
+	}
+	v.ForceDelete(ctx, &model.OperationSpec{
+		Action:       "ForceDeleteVolume",
+		ResourceType: model.OperationResourceVolume,
+		ResourceId:   volume.Id,
+	}, deleteBackend, func() error { return util.ForceDeleteVolumeDBEntry(ctx, volume) })
+}
+
 func NewVolumeAttachmentPortal() *VolumeAttachmentPortal {
 	return &VolumeAttachmentPortal{
 		CtrClient: client.NewClient(),
@@ -380,6 +819,12 @@ func (v *VolumeAttachmentPortal) CreateVolumeAttachment() {
 
 	// Marshal the result.
 	body, _ := json.Marshal(result)
+	opId := v.TrackOperation(ctx, &model.OperationSpec{
+		Action:       "CreateVolumeAttachment",
+		ResourceType: model.OperationResourceAttachment,
+		ResourceId:   result.Id,
+		Request:      string(body),
+	})
 	v.SuccessHandle(StatusAccepted, body)
 
 	// NOTE:The real volume attachment creation process.
@@ -387,6 +832,7 @@ func (v *VolumeAttachmentPortal) CreateVolumeAttachment() {
 	// after volume attachment creation is completed.
 	if err := v.CtrClient.Connect(CONF.OsdsLet.ApiEndpoint); err != nil {
 		log.Error("when connecting controller client:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
 		return
 	}
 	defer v.CtrClient.Close()
@@ -401,11 +847,13 @@ func (v *VolumeAttachmentPortal) CreateVolumeAttachment() {
 			Host:      result.Host,
 			Initiator: result.Initiator,
 		},
-		Metadata: result.Metadata,
-		Context:  ctx.ToJson(),
+		Metadata:    result.Metadata,
+		Context:     ctx.ToJson(),
+		OperationId: opId,
 	}
 	if _, err = v.CtrClient.CreateVolumeAttachment(context.Background(), opt); err != nil {
 		log.Error("create volume attachment failed in controller service:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
 		return
 	}
 
@@ -451,6 +899,8 @@ func (v *VolumeAttachmentPortal) GetVolumeAttachment() {
 		return
 	}
 
+	v.SetETag(result.BaseModel)
+
 	// Marshal the result.
 	body, _ := json.Marshal(result)
 	v.SuccessHandle(StatusOK, body)
@@ -472,15 +922,24 @@ func (v *VolumeAttachmentPortal) UpdateVolumeAttachment() {
 		v.ErrorHandle(model.ErrorBadRequest, errMsg)
 		return
 	}
+
+	rev, err := v.GetIfMatch()
+	if err != nil {
+		v.ErrorHandle(model.ErrorBadRequest, err.Error())
+		return
+	}
 	attachment.Id = id
+	attachment.Revision = rev
 
 	result, err := db.C.UpdateVolumeAttachment(c.GetContext(v.Ctx), id, &attachment)
 	if err != nil {
 		errMsg := fmt.Sprintf("update volume attachment failed: %s", err.Error())
-		v.ErrorHandle(model.ErrorInternalServer, errMsg)
+		v.ErrorHandle(updateErrorType(err, rev), errMsg)
 		return
 	}
 
+	v.SetETag(result.BaseModel)
+
 	// Marshal the result.
 	body, _ := json.Marshal(result)
 	v.SuccessHandle(StatusOK, body)
@@ -504,6 +963,11 @@ func (v *VolumeAttachmentPortal) DeleteVolumeAttachment() {
 	}
 	// NOTE:It will not wait for the real volume attachment deletion to complete
 	// and will return ok immediately.
+	opId := v.TrackOperation(ctx, &model.OperationSpec{
+		Action:       "DeleteVolumeAttachment",
+		ResourceType: model.OperationResourceAttachment,
+		ResourceId:   attachment.Id,
+	})
 	v.SuccessHandle(StatusAccepted, nil)
 
 	// NOTE:The real volume attachment deletion process.
@@ -511,6 +975,7 @@ func (v *VolumeAttachmentPortal) DeleteVolumeAttachment() {
 	// or update its status to "errorDeleting" if volume connection termination failed.
 	if err := v.CtrClient.Connect(CONF.OsdsLet.ApiEndpoint); err != nil {
 		log.Error("when connecting controller client:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
 		return
 	}
 	defer v.CtrClient.Close()
@@ -526,17 +991,70 @@ func (v *VolumeAttachmentPortal) DeleteVolumeAttachment() {
 			Host:      attachment.Host,
 			Initiator: attachment.Initiator,
 		},
-		Metadata: attachment.Metadata,
-		Context:  ctx.ToJson(),
+		Metadata:    attachment.Metadata,
+		Context:     ctx.ToJson(),
+		OperationId: opId,
 	}
 	if _, err = v.CtrClient.DeleteVolumeAttachment(context.Background(), opt); err != nil {
 		log.Error("delete volume attachment failed in controller service:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
 		return
 	}
 
 	return
 }
 
+// ResetVolumeAttachmentStatus is used by admin to repair the volume
+// attachment stuck in a transient status.
+func (v *VolumeAttachmentPortal) ResetVolumeAttachmentStatus() {
+	v.ResetStatus("volume:reset_attachment_status", ":attachmentId", model.OperationResourceAttachment,
+		func(ctx *c.Context, id string) (interface{}, error) { return db.C.GetVolumeAttachment(ctx, id) })
+}
+
+// ForceDeleteVolumeAttachment deletes the volume attachment whatever its
+// status is. The connection is terminated on a best-effort basis, the DB
+// entry is always removed.
+func (v *VolumeAttachmentPortal) ForceDeleteVolumeAttachment() {
+	if !policy.Authorize(v.Ctx, "volume:force_delete_attachment") {
+		return
+	}
+	ctx := c.GetContext(v.Ctx)
+
+	id := v.Ctx.Input.Param(":attachmentId")
+	attachment, err := db.C.GetVolumeAttachment(ctx, id)
+	if err != nil {
+		errMsg := fmt.Sprintf("volume attachment %s not found: %s", id, err.Error())
+		v.ErrorHandle(model.ErrorNotFound, errMsg)
+		return
+	}
+
+	deleteBackend := func() error {
+		opt := &pb.DeleteVolumeAttachmentOpts{
+			Id:             attachment.Id,
+			VolumeId:       attachment.VolumeId,
+			AccessProtocol: attachment.AccessProtocol,
+			HostInfo: &pb.HostInfo{
+				Platform:  attachment.Platform,
+				OsType:    attachment.OsType,
+				Ip:        attachment.Ip,
+				Host:      attachment.Host,
+				Initiator: attachment.Initiator,
+			},
+			Metadata: attachment.Metadata,
+			Context:  ctx.ToJson(),
+		}
+		return callController(v.CtrClient, func(cctx context.Context) error {
+			_, err := v.CtrClient.DeleteVolumeAttachment(cctx, opt)
+			return err
+		})
+	}
+	v.ForceDelete(ctx, &model.OperationSpec{
+		Action:       "ForceDeleteVolumeAttachment",
+		ResourceType: model.OperationResourceAttachment,
+		ResourceId:   attachment.Id,
+	}, deleteBackend, func() error { return util.ForceDeleteVolumeAttachmentDBEntry(ctx, attachment) })
+}
+
 func NewVolumeSnapshotPortal() *VolumeSnapshotPortal {
 	return &VolumeSnapshotPortal{
 		CtrClient: client.NewClient(),
@@ -588,12 +1106,18 @@ func (v *VolumeSnapshotPortal) CreateVolumeSnapshot() {
 	result, err := util.CreateVolumeSnapshotDBEntry(ctx, &snapshot)
 	if err != nil {
 		errMsg := fmt.Sprintf("create volume snapshot failed: %s", err.Error())
-		v.ErrorHandle(model.ErrorBadRequest, errMsg)
+		v.ErrorHandle(createErrorType(err), errMsg)
 		return
 	}
 
 	// Marshal the result.
 	body, _ := json.Marshal(result)
+	opId := v.TrackOperation(ctx, &model.OperationSpec{
+		Action:       "CreateVolumeSnapshot",
+		ResourceType: model.OperationResourceSnapshot,
+		ResourceId:   result.Id,
+		Request:      string(body),
+	})
 	v.SuccessHandle(StatusAccepted, body)
 
 	// NOTE:The real volume snapshot creation process.
@@ -601,6 +1125,7 @@ func (v *VolumeSnapshotPortal) CreateVolumeSnapshot() {
 	// after volume snapshot creation complete.
 	if err := v.CtrClient.Connect(CONF.OsdsLet.ApiEndpoint); err != nil {
 		log.Error("when connecting controller client:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
 		return
 	}
 	defer v.CtrClient.Close()
@@ -614,9 +1139,11 @@ func (v *VolumeSnapshotPortal) CreateVolumeSnapshot() {
 		Metadata:    result.Metadata,
 		Context:     ctx.ToJson(),
 		Profile:     prf.ToJson(),
+		OperationId: opId,
 	}
 	if _, err = v.CtrClient.CreateVolumeSnapshot(context.Background(), opt); err != nil {
 		log.Error("create volume snapthot failed in controller service:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
 		return
 	}
 
@@ -661,6 +1188,8 @@ func (v *VolumeSnapshotPortal) GetVolumeSnapshot() {
 		return
 	}
 
+	v.SetETag(result.BaseModel)
+
 	// Marshal the result.
 	body, _ := json.Marshal(result)
 	v.SuccessHandle(StatusOK, body)
@@ -683,15 +1212,24 @@ func (v *VolumeSnapshotPortal) UpdateVolumeSnapshot() {
 		v.ErrorHandle(model.ErrorBadRequest, errMsg)
 		return
 	}
+
+	rev, err := v.GetIfMatch()
+	if err != nil {
+		v.ErrorHandle(model.ErrorBadRequest, err.Error())
+		return
+	}
 	snapshot.Id = id
+	snapshot.Revision = rev
 
 	result, err := db.C.UpdateVolumeSnapshot(c.GetContext(v.Ctx), id, &snapshot)
 	if err != nil {
 		errMsg := fmt.Sprintf("update volume snapshot failed: %s", err.Error())
-		v.ErrorHandle(model.ErrorInternalServer, errMsg)
+		v.ErrorHandle(updateErrorType(err, rev), errMsg)
 		return
 	}
 
+	v.SetETag(result.BaseModel)
+
 	// Marshal the result.
 	body, _ := json.Marshal(result)
 	v.SuccessHandle(StatusOK, body)
@@ -729,6 +1267,11 @@ func (v *VolumeSnapshotPortal) DeleteVolumeSnapshot() {
 		return
 	}
 
+	opId := v.TrackOperation(ctx, &model.OperationSpec{
+		Action:       "DeleteVolumeSnapshot",
+		ResourceType: model.OperationResourceSnapshot,
+		ResourceId:   snapshot.Id,
+	})
 	v.SuccessHandle(StatusAccepted, nil)
 
 	// NOTE:The real volume snapshot deletion process.
@@ -736,19 +1279,194 @@ func (v *VolumeSnapshotPortal) DeleteVolumeSnapshot() {
 	// database or update its status to "errorDeleting" if volume snapshot deletion from driver failed.
 	if err := v.CtrClient.Connect(CONF.OsdsLet.ApiEndpoint); err != nil {
 		log.Error("when connecting controller client:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
 		return
 	}
 	defer v.CtrClient.Close()
 
 	opt := &pb.DeleteVolumeSnapshotOpts{
-		Id:       snapshot.Id,
-		VolumeId: snapshot.VolumeId,
-		Metadata: snapshot.Metadata,
-		Context:  ctx.ToJson(),
-		Profile:  prf.ToJson(),
+		Id:          snapshot.Id,
+		VolumeId:    snapshot.VolumeId,
+		Metadata:    snapshot.Metadata,
+		Context:     ctx.ToJson(),
+		Profile:     prf.ToJson(),
+		OperationId: opId,
 	}
 	if _, err = v.CtrClient.DeleteVolumeSnapshot(context.Background(), opt); err != nil {
 		log.Error("delete volume snapthot failed in controller service:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
+		return
+	}
+
+	return
+}
+
+// ResetVolumeSnapshotStatus is used by admin to repair the volume snapshot
+// stuck in a transient status.
+func (v *VolumeSnapshotPortal) ResetVolumeSnapshotStatus() {
+	v.ResetStatus("snapshot:reset_status", ":snapshotId", model.OperationResourceSnapshot,
+		func(ctx *c.Context, id string) (interface{}, error) { return db.C.GetVolumeSnapshot(ctx, id) })
+}
+
+// ForceDeleteVolumeSnapshot deletes the volume snapshot whatever its status
+// is. The deletion from the backend is best-effort, the DB entry is always
+// removed.
+func (v *VolumeSnapshotPortal) ForceDeleteVolumeSnapshot() {
+	if !policy.Authorize(v.Ctx, "snapshot:force_delete") {
+		return
+	}
+	ctx := c.GetContext(v.Ctx)
+
+	id := v.Ctx.Input.Param(":snapshotId")
+	snapshot, err := db.C.GetVolumeSnapshot(ctx, id)
+	if err != nil {
+		errMsg := fmt.Sprintf("volume snapshot %s not found: %s", id, err.Error())
+		v.ErrorHandle(model.ErrorNotFound, errMsg)
+		return
+	}
+	if err := db.C.UpdateStatus(ctx, snapshot, model.VolumeSnapDeleting); err != nil {
+		errMsg := fmt.Sprintf("update status of volume snapshot %s failed: %s", id, err.Error())
+		v.ErrorHandle(updateErrorType(err, 0), errMsg)
+		return
+	}
+
+	deleteBackend := func() error {
+		prf, err := db.C.GetProfile(ctx, snapshot.ProfileId)
+		if err != nil {
+			return err
+		}
+		opt := &pb.DeleteVolumeSnapshotOpts{
+			Id:       snapshot.Id,
+			VolumeId: snapshot.VolumeId,
+			Metadata: snapshot.Metadata,
+			Context:  ctx.ToJson(),
+			Profile:  prf.ToJson(),
+		}
+		return callController(v.CtrClient, func(cctx context.Context) error {
+			_, err := v.CtrClient.DeleteVolumeSnapshot(cctx, opt)
+			return err
+		})
+	}
+	v.ForceDelete(ctx, &model.OperationSpec{
+		Action:       "ForceDeleteVolumeSnapshot",
+		ResourceType: model.OperationResourceSnapshot,
+		ResourceId:   snapshot.Id,
+	}, deleteBackend, func() error { return util.ForceDeleteVolumeSnapshotDBEntry(ctx, snapshot) })
+}
+
+func (v *VolumeSnapshotPortal) ManageVolumeSnapshot() {
+	if !policy.Authorize(v.Ctx, "snapshot:manage") {
+		return
+	}
+	ctx := c.GetContext(v.Ctx)
+	var manageRequestBody = model.ManageVolumeSnapshotSpec{}
+
+	if err := json.NewDecoder(v.Ctx.Request.Body).Decode(&manageRequestBody); err != nil {
+		errMsg := fmt.Sprintf("parse volume snapshot request body failed: %s", err.Error())
+		v.ErrorHandle(model.ErrorBadRequest, errMsg)
+		return
+	}
+
+	// NOTE:It will create a volume snapshot entry into the database and
+	// initialize its status as "managing". It will not wait for the snapshot
+	// to be taken over and will return result immediately.
+	result, err := util.ManageVolumeSnapshotDBEntry(ctx, &manageRequestBody)
+	if err != nil {
+		errMsg := fmt.Sprintf("manage volume snapshot failed: %s", err.Error())
+		v.ErrorHandle(createErrorType(err), errMsg)
+		return
+	}
+
+	// Marshal the result.
+	body, _ := json.Marshal(result)
+	reqBody, _ := json.Marshal(manageRequestBody)
+	opId := v.TrackOperation(ctx, &model.OperationSpec{
+		Action:       "ManageVolumeSnapshot",
+		ResourceType: model.OperationResourceSnapshot,
+		ResourceId:   result.Id,
+		Request:      string(reqBody),
+	})
+	v.SuccessHandle(StatusAccepted, body)
+
+	// NOTE:The real volume snapshot managing process.
+	// Volume snapshot managing request is sent to the controller, which will
+	// update snapshot status to "available" after the snapshot is taken over.
+	if err = v.CtrClient.Connect(CONF.OsdsLet.ApiEndpoint); err != nil {
+		log.Error("when connecting controller client:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
+		return
+	}
+	defer v.CtrClient.Close()
+
+	opt := &pb.ManageVolumeSnapshotOpts{
+		Id:          result.Id,
+		Name:        result.Name,
+		Description: result.Description,
+		Identifier:  manageRequestBody.Identifier,
+		VolumeId:    result.VolumeId,
+		Size:        result.Size,
+		Context:     ctx.ToJson(),
+		OperationId: opId,
+	}
+	if _, err = v.CtrClient.ManageVolumeSnapshot(context.Background(), opt); err != nil {
+		log.Error("manage volume snapshot failed in controller service:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
+		return
+	}
+
+	return
+}
+
+func (v *VolumeSnapshotPortal) UnmanageVolumeSnapshot() {
+	if !policy.Authorize(v.Ctx, "snapshot:unmanage") {
+		return
+	}
+	ctx := c.GetContext(v.Ctx)
+	id := v.Ctx.Input.Param(":snapshotId")
+
+	snapshot, err := db.C.GetVolumeSnapshot(ctx, id)
+	if err != nil {
+		errMsg := fmt.Sprintf("volume snapshot %s not found: %s", id, err.Error())
+		v.ErrorHandle(model.ErrorNotFound, errMsg)
+		return
+	}
+
+	// NOTE:It will update the the status of the volume snapshot waiting for
+	// unmanaging in the database to "unmanaging" and return the result
+	// immediately.
+	if err = util.UnmanageVolumeSnapshotDBEntry(ctx, snapshot); err != nil {
+		errMsg := fmt.Sprintf("unmanage volume snapshot failed: %s", err.Error())
+		v.ErrorHandle(model.ErrorBadRequest, errMsg)
+		return
+	}
+
+	opId := v.TrackOperation(ctx, &model.OperationSpec{
+		Action:       "UnmanageVolumeSnapshot",
+		ResourceType: model.OperationResourceSnapshot,
+		ResourceId:   snapshot.Id,
+	})
+	v.SuccessHandle(StatusAccepted, nil)
+
+	// NOTE:The real volume snapshot unmanaging process.
+	// Volume snapshot unmanaging request is sent to the controller, which will
+	// remove the snapshot from database and leave its data in the backend.
+	if err = v.CtrClient.Connect(CONF.OsdsLet.ApiEndpoint); err != nil {
+		log.Error("when connecting controller client:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
+		return
+	}
+	defer v.CtrClient.Close()
+
+	opt := &pb.UnmanageVolumeSnapshotOpts{
+		Id:          snapshot.Id,
+		VolumeId:    snapshot.VolumeId,
+		Metadata:    snapshot.Metadata,
+		Context:     ctx.ToJson(),
+		OperationId: opId,
+	}
+	if _, err = v.CtrClient.UnmanageVolumeSnapshot(context.Background(), opt); err != nil {
+		log.Error("unmanage volume snapshot failed in controller service:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
 		return
 	}
 
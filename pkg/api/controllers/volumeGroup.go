@@ -75,6 +75,12 @@ func (v *VolumeGroupPortal) CreateVolumeGroup() {
 		v.ErrorHandle(model.ErrorInternalServer, errMsg)
 		return
 	}
+	opId := v.TrackOperation(ctx, &model.OperationSpec{
+		Action:       "CreateVolumeGroup",
+		ResourceType: model.OperationResourceVolumeGroup,
+		ResourceId:   result.Id,
+		Request:      string(body),
+	})
 	v.SuccessHandle(StatusAccepted, body)
 
 	// NOTE:The real volume group creation process.
@@ -83,6 +89,7 @@ func (v *VolumeGroupPortal) CreateVolumeGroup() {
 	// is completed.
 	if err = v.CtrClient.Connect(CONF.OsdsLet.ApiEndpoint); err != nil {
 		log.Error("when connecting controller client:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
 		return
 	}
 	defer v.CtrClient.Close()
@@ -94,10 +101,13 @@ func (v *VolumeGroupPortal) CreateVolumeGroup() {
 		AvailabilityZone: result.AvailabilityZone,
 		AddVolumes:       result.AddVolumes,
 		RemoveVolumes:    result.RemoveVolumes,
+		GroupSnapshotId:  result.GroupSnapshotId,
 		Context:          ctx.ToJson(),
+		OperationId:      opId,
 	}
 	if _, err = v.CtrClient.CreateVolumeGroup(context.Background(), opt); err != nil {
 		log.Error("create volume group failed in controller service:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
 		return
 	}
 
@@ -134,6 +144,12 @@ func (v *VolumeGroupPortal) UpdateVolumeGroup() {
 		v.ErrorHandle(model.ErrorInternalServer, errMsg)
 		return
 	}
+	opId := v.TrackOperation(ctx, &model.OperationSpec{
+		Action:       "UpdateVolumeGroup",
+		ResourceType: model.OperationResourceVolumeGroup,
+		ResourceId:   result.Id,
+		Request:      string(body),
+	})
 	v.SuccessHandle(StatusAccepted, body)
 
 	// NOTE:The real volume group update process.
@@ -142,6 +158,7 @@ func (v *VolumeGroupPortal) UpdateVolumeGroup() {
 	// is completed.
 	if err = v.CtrClient.Connect(CONF.OsdsLet.ApiEndpoint); err != nil {
 		log.Error("when connecting controller client:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
 		return
 	}
 	defer v.CtrClient.Close()
@@ -152,9 +169,11 @@ func (v *VolumeGroupPortal) UpdateVolumeGroup() {
 		RemoveVolumes: result.RemoveVolumes,
 		PoolId:        result.PoolId,
 		Context:       ctx.ToJson(),
+		OperationId:   opId,
 	}
 	if _, err = v.CtrClient.UpdateVolumeGroup(context.Background(), opt); err != nil {
 		log.Error("update volume group failed in controller service:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
 		return
 	}
 
@@ -181,6 +200,11 @@ func (v *VolumeGroupPortal) DeleteVolumeGroup() {
 		return
 	}
 
+	opId := v.TrackOperation(ctx, &model.OperationSpec{
+		Action:       "DeleteVolumeGroup",
+		ResourceType: model.OperationResourceVolumeGroup,
+		ResourceId:   id,
+	})
 	v.SuccessHandle(StatusAccepted, nil)
 
 	// NOTE:The real volume group deletion process.
@@ -188,36 +212,88 @@ func (v *VolumeGroupPortal) DeleteVolumeGroup() {
 	// volume group record after volume group deletion operation is completed.
 	if err = v.CtrClient.Connect(CONF.OsdsLet.ApiEndpoint); err != nil {
 		log.Error("when connecting controller client:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
 		return
 	}
 	defer v.CtrClient.Close()
 
 	opt := &pb.DeleteVolumeGroupOpts{
-		Id:      id,
-		PoolId:  vg.PoolId,
-		Context: ctx.ToJson(),
+		Id:          id,
+		PoolId:      vg.PoolId,
+		Context:     ctx.ToJson(),
+		OperationId: opId,
 	}
 	if _, err = v.CtrClient.DeleteVolumeGroup(context.Background(), opt); err != nil {
 		log.Error("delete volume group failed in controller service:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
 		return
 	}
 
 	return
 }
 
+// ResetVolumeGroupStatus is used by admin to repair the volume group stuck
+// in a transient status.
+func (v *VolumeGroupPortal) ResetVolumeGroupStatus() {
+	v.ResetStatus("volume_group:reset_status", ":groupId", model.OperationResourceVolumeGroup,
+		func(ctx *c.Context, id string) (interface{}, error) { return db.C.GetVolumeGroup(ctx, id) })
+}
+
+// ForceDeleteVolumeGroup deletes the volume group along with its volumes
+// whatever their statuses are. The deletion from the backend is best-effort,
+// the DB entries are always removed.
+func (v *VolumeGroupPortal) ForceDeleteVolumeGroup() {
+	if !policy.Authorize(v.Ctx, "volume_group:force_delete") {
+		return
+	}
+	ctx := c.GetContext(v.Ctx)
+
+	id := v.Ctx.Input.Param(":groupId")
+	vg, err := db.C.GetVolumeGroup(ctx, id)
+	if err != nil {
+		errMsg := fmt.Sprintf("volume group %s not found: %s", id, err.Error())
+		v.ErrorHandle(model.ErrorNotFound, errMsg)
+		return
+	}
+	if err := db.C.UpdateStatus(ctx, vg, model.VolumeGroupDeleting); err != nil {
+		errMsg := fmt.Sprintf("update status of volume group %s failed: %s", id, err.Error())
+		v.ErrorHandle(updateErrorType(err, 0), errMsg)
+		return
+	}
+
+	deleteBackend := func() error {
+		opt := &pb.DeleteVolumeGroupOpts{
+			Id:      vg.Id,
+			PoolId:  vg.PoolId,
+			Context: ctx.ToJson(),
+		}
+		return callController(v.CtrClient, func(cctx context.Context) error {
+			_, err := v.CtrClient.DeleteVolumeGroup(cctx, opt)
+			return err
+		})
+	}
+	v.ForceDelete(ctx, &model.OperationSpec{
+		Action:       "ForceDeleteVolumeGroup",
+		ResourceType: model.OperationResourceVolumeGroup,
+		ResourceId:   vg.Id,
+	}, deleteBackend, func() error { return util.ForceDeleteVolumeGroupDBEntry(ctx, vg) })
+}
+
 func (v *VolumeGroupPortal) GetVolumeGroup() {
 	if !policy.Authorize(v.Ctx, "volume_group:get") {
 		return
 	}
 
+	ctx := c.GetContext(v.Ctx)
 	id := v.Ctx.Input.Param(":groupId")
 	// Call db api module to handle get volume request.
-	result, err := db.C.GetVolumeGroup(c.GetContext(v.Ctx), id)
+	result, err := db.C.GetVolumeGroup(ctx, id)
 	if err != nil {
 		errMsg := fmt.Sprintf("volume group %s not found: %s", id, err.Error())
 		v.ErrorHandle(model.ErrorNotFound, errMsg)
 		return
 	}
+	fillGroupSnapshots(ctx, result)
 
 	// Marshal the result.
 	body, err := json.Marshal(result)
@@ -243,12 +319,16 @@ func (v *VolumeGroupPortal) ListVolumeGroups() {
 		return
 	}
 
-	result, err := db.C.ListVolumeGroupsWithFilter(c.GetContext(v.Ctx), m)
+	ctx := c.GetContext(v.Ctx)
+	result, err := db.C.ListVolumeGroupsWithFilter(ctx, m)
 	if err != nil {
 		errMsg := fmt.Sprintf("list volume groups failed: %s", err.Error())
 		v.ErrorHandle(model.ErrorInternalServer, errMsg)
 		return
 	}
+	for _, vg := range result {
+		fillGroupSnapshots(ctx, vg)
+	}
 
 	// Marshal the result.
 	body, err := json.Marshal(result)
@@ -261,3 +341,230 @@ func (v *VolumeGroupPortal) ListVolumeGroups() {
 	v.SuccessHandle(StatusOK, body)
 	return
 }
+
+// fillGroupSnapshots sets the uuids of the group snapshots taken of the
+// volume group, they are not stored along with the group.
+func fillGroupSnapshots(ctx *c.Context, vg *model.VolumeGroupSpec) {
+	gss, err := db.C.ListGroupSnapshotsByGroupId(ctx, vg.Id)
+	if err != nil {
+		log.Errorf("list group snapshots of volume group %s failed: %v", vg.Id, err)
+		return
+	}
+	vg.GroupSnapshots = nil
+	for _, gs := range gss {
+		vg.GroupSnapshots = append(vg.GroupSnapshots, gs.Id)
+	}
+}
+
+func (v *VolumeGroupPortal) CreateGroupSnapshot() {
+	if !policy.Authorize(v.Ctx, "volume_group_snapshot:create") {
+		return
+	}
+	ctx := c.GetContext(v.Ctx)
+
+	var gs = &model.VolumeGroupSnapshotSpec{
+		BaseModel: &model.BaseModel{},
+	}
+	if err := json.NewDecoder(v.Ctx.Request.Body).Decode(&gs); err != nil {
+		errMsg := fmt.Sprintf("parse group snapshot request body failed: %s", err.Error())
+		v.ErrorHandle(model.ErrorBadRequest, errMsg)
+		return
+	}
+	gs.GroupId = v.Ctx.Input.Param(":groupId")
+
+	// NOTE:It will create the group snapshot and its member snapshots into
+	// the database and initialize their statuses as "creating". It will not
+	// wait for the real snapshot creation to complete and will return result
+	// immediately.
+	result, snps, err := util.CreateVolumeGroupSnapshotDBEntry(ctx, gs)
+	if err != nil {
+		errMsg := fmt.Sprintf("create group snapshot failed: %s", err.Error())
+		v.ErrorHandle(model.ErrorBadRequest, errMsg)
+		return
+	}
+
+	// Marshal the result.
+	body, err := json.Marshal(result)
+	if err != nil {
+		errMsg := fmt.Sprintf("marshal group snapshot created result failed: %s", err.Error())
+		v.ErrorHandle(model.ErrorInternalServer, errMsg)
+		return
+	}
+	opId := v.TrackOperation(ctx, &model.OperationSpec{
+		Action:       "CreateGroupSnapshot",
+		ResourceType: model.OperationResourceGroupSnapshot,
+		ResourceId:   result.Id,
+		Request:      string(body),
+	})
+	v.SuccessHandle(StatusAccepted, body)
+
+	// NOTE:The real group snapshot creation process.
+	// Group snapshot creation request is sent to the Dock. Dock will set
+	// group snapshot status to 'available' after group snapshot creation
+	// operation is completed.
+	if err = v.CtrClient.Connect(CONF.OsdsLet.ApiEndpoint); err != nil {
+		log.Error("when connecting controller client:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
+		return
+	}
+	defer v.CtrClient.Close()
+
+	opt := &pb.CreateGroupSnapshotOpts{
+		Id:          result.Id,
+		Name:        result.Name,
+		Description: result.Description,
+		GroupId:     result.GroupId,
+		Metadata:    result.Metadata,
+		Context:     ctx.ToJson(),
+		OperationId: opId,
+	}
+	for _, snp := range snps {
+		opt.Snapshots = append(opt.Snapshots, &pb.CreateVolumeSnapshotOpts{
+			Id:          snp.Id,
+			Name:        snp.Name,
+			Description: snp.Description,
+			VolumeId:    snp.VolumeId,
+			ProfileId:   snp.ProfileId,
+			Metadata:    snp.Metadata,
+		})
+	}
+	if _, err = v.CtrClient.CreateGroupSnapshot(context.Background(), opt); err != nil {
+		log.Error("create group snapshot failed in controller service:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
+		return
+	}
+
+	return
+}
+
+// getGroupSnapshot returns the group snapshot in the url, and it's regarded
+// as not found if it isn't taken of the volume group in the url.
+func (v *VolumeGroupPortal) getGroupSnapshot(ctx *c.Context) (*model.VolumeGroupSnapshotSpec, error) {
+	groupId := v.Ctx.Input.Param(":groupId")
+	id := v.Ctx.Input.Param(":groupSnapshotId")
+	gs, err := db.C.GetVolumeGroupSnapshot(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if gs.GroupId != groupId {
+		return nil, model.NewNotFoundError(fmt.Sprintf("group snapshot %s isn't taken of volume group %s", id, groupId))
+	}
+	return gs, nil
+}
+
+func (v *VolumeGroupPortal) DeleteGroupSnapshot() {
+	if !policy.Authorize(v.Ctx, "volume_group_snapshot:delete") {
+		return
+	}
+	ctx := c.GetContext(v.Ctx)
+
+	gs, err := v.getGroupSnapshot(ctx)
+	if err != nil {
+		errMsg := fmt.Sprintf("group snapshot %s not found: %s", v.Ctx.Input.Param(":groupSnapshotId"), err.Error())
+		v.ErrorHandle(model.ErrorNotFound, errMsg)
+		return
+	}
+
+	snps, err := util.DeleteVolumeGroupSnapshotDBEntry(ctx, gs)
+	if err != nil {
+		errMsg := fmt.Sprintf("delete group snapshot failed: %s", err.Error())
+		v.ErrorHandle(model.ErrorBadRequest, errMsg)
+		return
+	}
+
+	opId := v.TrackOperation(ctx, &model.OperationSpec{
+		Action:       "DeleteGroupSnapshot",
+		ResourceType: model.OperationResourceGroupSnapshot,
+		ResourceId:   gs.Id,
+	})
+	v.SuccessHandle(StatusAccepted, nil)
+
+	// NOTE:The real group snapshot deletion process.
+	// Group snapshot deletion request is sent to the Dock. Dock will remove
+	// group snapshot record after group snapshot deletion operation is
+	// completed.
+	if err = v.CtrClient.Connect(CONF.OsdsLet.ApiEndpoint); err != nil {
+		log.Error("when connecting controller client:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
+		return
+	}
+	defer v.CtrClient.Close()
+
+	opt := &pb.DeleteGroupSnapshotOpts{
+		Id:          gs.Id,
+		GroupId:     gs.GroupId,
+		Metadata:    gs.Metadata,
+		Context:     ctx.ToJson(),
+		OperationId: opId,
+	}
+	for _, snp := range snps {
+		opt.Snapshots = append(opt.Snapshots, &pb.DeleteVolumeSnapshotOpts{
+			Id:       snp.Id,
+			VolumeId: snp.VolumeId,
+			Metadata: snp.Metadata,
+		})
+	}
+	if _, err = v.CtrClient.DeleteGroupSnapshot(context.Background(), opt); err != nil {
+		log.Error("delete group snapshot failed in controller service:", err)
+		util.FailOperationDBEntry(ctx, opId, err)
+		return
+	}
+
+	return
+}
+
+func (v *VolumeGroupPortal) GetGroupSnapshot() {
+	if !policy.Authorize(v.Ctx, "volume_group_snapshot:get") {
+		return
+	}
+
+	result, err := v.getGroupSnapshot(c.GetContext(v.Ctx))
+	if err != nil {
+		errMsg := fmt.Sprintf("group snapshot %s not found: %s", v.Ctx.Input.Param(":groupSnapshotId"), err.Error())
+		v.ErrorHandle(model.ErrorNotFound, errMsg)
+		return
+	}
+
+	// Marshal the result.
+	body, err := json.Marshal(result)
+	if err != nil {
+		errMsg := fmt.Sprintf("marshal group snapshot showed result failed: %s", err.Error())
+		v.ErrorHandle(model.ErrorInternalServer, errMsg)
+		return
+	}
+
+	v.SuccessHandle(StatusOK, body)
+	return
+}
+
+func (v *VolumeGroupPortal) ListGroupSnapshots() {
+	if !policy.Authorize(v.Ctx, "volume_group_snapshot:get") {
+		return
+	}
+	ctx := c.GetContext(v.Ctx)
+
+	groupId := v.Ctx.Input.Param(":groupId")
+	if _, err := db.C.GetVolumeGroup(ctx, groupId); err != nil {
+		errMsg := fmt.Sprintf("volume group %s not found: %s", groupId, err.Error())
+		v.ErrorHandle(model.ErrorNotFound, errMsg)
+		return
+	}
+
+	result, err := db.C.ListGroupSnapshotsByGroupId(ctx, groupId)
+	if err != nil {
+		errMsg := fmt.Sprintf("list group snapshots failed: %s", err.Error())
+		v.ErrorHandle(model.ErrorInternalServer, errMsg)
+		return
+	}
+
+	// Marshal the result.
+	body, err := json.Marshal(result)
+	if err != nil {
+		errMsg := fmt.Sprintf("marshal group snapshots listed result failed: %s", err.Error())
+		v.ErrorHandle(model.ErrorInternalServer, errMsg)
+		return
+	}
+
+	v.SuccessHandle(StatusOK, body)
+	return
+}
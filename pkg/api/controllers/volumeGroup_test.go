@@ -32,6 +32,8 @@ import (
 func init() {
 	beego.Router("/v1beta/block/volumeGroups", &VolumeGroupPortal{}, "post:CreateVolumeGroup;get:ListVolumeGroups")
 	beego.Router("/v1beta/block/volumeGroups/:groupId", &VolumeGroupPortal{}, "put:UpdateVolumeGroup;get:GetVolumeGroup;delete:DeleteVolumeGroup")
+	beego.Router("/v1beta/block/volumeGroups/:groupId/snapshots", &VolumeGroupPortal{}, "get:ListGroupSnapshots")
+	beego.Router("/v1beta/block/volumeGroups/:groupId/snapshots/:groupSnapshotId", &VolumeGroupPortal{}, "get:GetGroupSnapshot")
 }
 
 func TestListVolumeGroups(t *testing.T) {
@@ -46,6 +48,7 @@ func TestListVolumeGroups(t *testing.T) {
 			"sortKey": {"name"},
 		}
 		mockClient.On("ListVolumeGroupsWithFilter", c.NewAdminContext(), m).Return(sampleVGs, nil)
+		mockClient.On("ListGroupSnapshotsByGroupId", c.NewAdminContext(), SampleVolumeGroups[0].Id).Return(nil, nil)
 		db.C = mockClient
 
 		r, _ := http.NewRequest("GET", "/v1beta/block/volumeGroups?offset=0&limit=1&sortDir=asc&sortKey=name", nil)
@@ -78,8 +81,11 @@ func TestListVolumeGroups(t *testing.T) {
 func TestGetVolumeGroup(t *testing.T) {
 
 	t.Run("Should return 200 if everything works well", func(t *testing.T) {
+		var vg = SampleVolumeGroups[0]
 		mockClient := new(dbtest.Client)
-		mockClient.On("GetVolumeGroup", c.NewAdminContext(), "3769855c-a102-11e7-b772-17b880d2f555").Return(&SampleVolumeGroups[0], nil)
+		mockClient.On("GetVolumeGroup", c.NewAdminContext(), "3769855c-a102-11e7-b772-17b880d2f555").Return(&vg, nil)
+		mockClient.On("ListGroupSnapshotsByGroupId", c.NewAdminContext(), "3769855c-a102-11e7-b772-17b880d2f555").Return(
+			[]*model.VolumeGroupSnapshotSpec{&SampleGroupSnapshots[0]}, nil)
 		db.C = mockClient
 
 		r, _ := http.NewRequest("GET", "/v1beta/block/volumeGroups/3769855c-a102-11e7-b772-17b880d2f555", nil)
@@ -87,8 +93,10 @@ func TestGetVolumeGroup(t *testing.T) {
 		beego.BeeApp.Handlers.ServeHTTP(w, r)
 		var output model.VolumeGroupSpec
 		json.Unmarshal(w.Body.Bytes(), &output)
+		var expected = SampleVolumeGroups[0]
+		expected.GroupSnapshots = []string{SampleGroupSnapshots[0].Id}
 		assertTestResult(t, w.Code, 200)
-		assertTestResult(t, &output, &SampleVolumeGroups[0])
+		assertTestResult(t, &output, &expected)
 	})
 
 	t.Run("Should return 404 if get volume group with bad request", func(t *testing.T) {
@@ -102,3 +110,61 @@ func TestGetVolumeGroup(t *testing.T) {
 		assertTestResult(t, w.Code, 404)
 	})
 }
+
+func TestListGroupSnapshots(t *testing.T) {
+
+	t.Run("Should return 200 if everything works well", func(t *testing.T) {
+		var sampleGSs = []*model.VolumeGroupSnapshotSpec{&SampleGroupSnapshots[0]}
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetVolumeGroup", c.NewAdminContext(), "3769855c-a102-11e7-b772-17b880d2f555").Return(&SampleVolumeGroups[0], nil)
+		mockClient.On("ListGroupSnapshotsByGroupId", c.NewAdminContext(), "3769855c-a102-11e7-b772-17b880d2f555").Return(sampleGSs, nil)
+		db.C = mockClient
+
+		r, _ := http.NewRequest("GET", "/v1beta/block/volumeGroups/3769855c-a102-11e7-b772-17b880d2f555/snapshots", nil)
+		w := httptest.NewRecorder()
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		var output []*model.VolumeGroupSnapshotSpec
+		json.Unmarshal(w.Body.Bytes(), &output)
+		assertTestResult(t, w.Code, 200)
+		assertTestResult(t, output, sampleGSs)
+	})
+
+	t.Run("Should return 404 if volume group doesn't exist", func(t *testing.T) {
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetVolumeGroup", c.NewAdminContext(), "3769855c-a102-11e7-b772-17b880d2f555").Return(nil, errors.New("db error"))
+		db.C = mockClient
+
+		r, _ := http.NewRequest("GET", "/v1beta/block/volumeGroups/3769855c-a102-11e7-b772-17b880d2f555/snapshots", nil)
+		w := httptest.NewRecorder()
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		assertTestResult(t, w.Code, 404)
+	})
+}
+
+func TestGetGroupSnapshot(t *testing.T) {
+
+	t.Run("Should return 200 if everything works well", func(t *testing.T) {
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetVolumeGroupSnapshot", c.NewAdminContext(), "9c8a3d2e-7f41-11e9-a6b2-3f5e8c1d4b90").Return(&SampleGroupSnapshots[0], nil)
+		db.C = mockClient
+
+		r, _ := http.NewRequest("GET", "/v1beta/block/volumeGroups/3769855c-a102-11e7-b772-17b880d2f555/snapshots/9c8a3d2e-7f41-11e9-a6b2-3f5e8c1d4b90", nil)
+		w := httptest.NewRecorder()
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		var output model.VolumeGroupSnapshotSpec
+		json.Unmarshal(w.Body.Bytes(), &output)
+		assertTestResult(t, w.Code, 200)
+		assertTestResult(t, &output, &SampleGroupSnapshots[0])
+	})
+
+	t.Run("Should return 404 if group snapshot isn't taken of the volume group", func(t *testing.T) {
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetVolumeGroupSnapshot", c.NewAdminContext(), "9c8a3d2e-7f41-11e9-a6b2-3f5e8c1d4b90").Return(&SampleGroupSnapshots[0], nil)
+		db.C = mockClient
+
+		r, _ := http.NewRequest("GET", "/v1beta/block/volumeGroups/f4a5e666-c669-4c64-a2a1-8f9ecd560c78/snapshots/9c8a3d2e-7f41-11e9-a6b2-3f5e8c1d4b90", nil)
+		w := httptest.NewRecorder()
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		assertTestResult(t, w.Code, 404)
+	})
+}
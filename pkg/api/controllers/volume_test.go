@@ -32,6 +32,7 @@ import (
 	. "github.com/opensds/opensds/testutils/collection"
 	ctrtest "github.com/opensds/opensds/testutils/controller/testing"
 	dbtest "github.com/opensds/opensds/testutils/db/testing"
+	"github.com/stretchr/testify/mock"
 )
 
 ////////////////////////////////////////////////////////////////////////////////
@@ -41,10 +42,24 @@ import (
 func init() {
 	beego.Router("/v1beta/block/volumes", NewFakeVolumePortal(),
 		"post:CreateVolume;get:ListVolumes")
+	beego.Router("/v1beta/block/volumes/manage", NewFakeVolumePortal(),
+		"post:ManageVolume")
 	beego.Router("/v1beta/block/volumes/:volumeId", NewFakeVolumePortal(),
 		"get:GetVolume;put:UpdateVolume;delete:DeleteVolume")
 	beego.Router("/v1beta/block/volumes/:volumeId/resize", NewFakeVolumePortal(),
 		"post:ExtendVolume")
+	beego.Router("/v1beta/block/volumes/:volumeId/retype", NewFakeVolumePortal(),
+		"post:RetypeVolume")
+	beego.Router("/v1beta/block/volumes/:volumeId/migrate", NewFakeVolumePortal(),
+		"post:MigrateVolume")
+	beego.Router("/v1beta/block/volumes/:volumeId/revert", NewFakeVolumePortal(),
+		"post:RevertVolume")
+	beego.Router("/v1beta/block/volumes/:volumeId/unmanage", NewFakeVolumePortal(),
+		"post:UnmanageVolume")
+	beego.Router("/v1beta/block/volumes/:volumeId/os-reset_status", NewFakeVolumePortal(),
+		"post:ResetVolumeStatus")
+	beego.Router("/v1beta/block/volumes/:volumeId/force-delete", NewFakeVolumePortal(),
+		"post:ForceDeleteVolume")
 
 	beego.Router("/v1beta/block/attachments", &VolumeAttachmentPortal{},
 		"post:CreateVolumeAttachment;get:ListVolumeAttachments")
@@ -53,8 +68,12 @@ func init() {
 
 	beego.Router("/v1beta/block/snapshots", &VolumeSnapshotPortal{},
 		"post:CreateVolumeSnapshot;get:ListVolumeSnapshots")
+	beego.Router("/v1beta/block/snapshots/manage", NewFakeVolumeSnapshotPortal(),
+		"post:ManageVolumeSnapshot")
 	beego.Router("/v1beta/block/snapshots/:snapshotId", &VolumeSnapshotPortal{},
 		"get:GetVolumeSnapshot;put:UpdateVolumeSnapshot;delete:DeleteVolumeSnapshot")
+	beego.Router("/v1beta/block/snapshots/:snapshotId/unmanage", NewFakeVolumeSnapshotPortal(),
+		"post:UnmanageVolumeSnapshot")
 }
 
 func NewFakeVolumePortal() *VolumePortal {
@@ -73,12 +92,31 @@ func NewFakeVolumePortal() *VolumePortal {
 	mockClient.On("DeleteVolume", ctx.Background(), &pb.DeleteVolumeOpts{
 		Context: c.NewAdminContext().ToJson(),
 	}).Return(&pb.GenericResponse{}, nil)
+	mockClient.On("RetypeVolume", ctx.Background(), mock.Anything).Return(&pb.GenericResponse{}, nil)
+	mockClient.On("MigrateVolume", ctx.Background(), mock.Anything).Return(&pb.GenericResponse{}, nil)
+	mockClient.On("ManageVolume", ctx.Background(), mock.Anything).Return(&pb.GenericResponse{}, nil)
+	mockClient.On("UnmanageVolume", ctx.Background(), mock.Anything).Return(&pb.GenericResponse{}, nil)
+	// The backend of the volume force deleted is down.
+	mockClient.On("DeleteVolume", mock.Anything, mock.Anything).Return(nil, errors.New("backend is down"))
 
 	return &VolumePortal{
 		CtrClient: mockClient,
 	}
 }
 
+func NewFakeVolumeSnapshotPortal() *VolumeSnapshotPortal {
+	mockClient := new(ctrtest.Client)
+
+	mockClient.On("Connect", "localhost:50049").Return(nil)
+	mockClient.On("Close").Return(nil)
+	mockClient.On("ManageVolumeSnapshot", ctx.Background(), mock.Anything).Return(&pb.GenericResponse{}, nil)
+	mockClient.On("UnmanageVolumeSnapshot", ctx.Background(), mock.Anything).Return(&pb.GenericResponse{}, nil)
+
+	return &VolumeSnapshotPortal{
+		CtrClient: mockClient,
+	}
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 //                            Tests for volume                                //
 ////////////////////////////////////////////////////////////////////////////////
@@ -236,6 +274,84 @@ func TestUpdateVolume(t *testing.T) {
 		beego.BeeApp.Handlers.ServeHTTP(w, r)
 		assertTestResult(t, w.Code, 500)
 	})
+
+	t.Run("Should return 200 and the new ETag if the revision matches", func(t *testing.T) {
+		volume := model.VolumeSpec{BaseModel: &model.BaseModel{}}
+		json.NewDecoder(bytes.NewBuffer(jsonStr)).Decode(&volume)
+		volume.Revision = 3
+		updated := expected
+		updated.BaseModel = &model.BaseModel{Id: expected.Id, Revision: 4}
+		mockClient := new(dbtest.Client)
+		mockClient.On("UpdateVolume", c.NewAdminContext(), &volume).Return(&updated, nil)
+		db.C = mockClient
+
+		r, _ := http.NewRequest("PUT", "/v1beta/block/volumes/bd5b12a8-a101-11e7-941e-d77981b584d8", bytes.NewBuffer(jsonStr))
+		w := httptest.NewRecorder()
+		r.Header.Set("Content-Type", "application/JSON")
+		r.Header.Set("If-Match", `"3"`)
+		beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+			httpCtx.Input.SetData("context", c.NewAdminContext())
+		})
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		assertTestResult(t, w.Code, 200)
+		assertTestResult(t, w.Header().Get("ETag"), `"4"`)
+	})
+
+	t.Run("Should return 412 if the volume has been modified since the revision", func(t *testing.T) {
+		volume := model.VolumeSpec{BaseModel: &model.BaseModel{}}
+		json.NewDecoder(bytes.NewBuffer(jsonStr)).Decode(&volume)
+		volume.Revision = 3
+		mockClient := new(dbtest.Client)
+		mockClient.On("UpdateVolume", c.NewAdminContext(), &volume).Return(nil, model.NewConflictError("volume has been modified"))
+		db.C = mockClient
+
+		r, _ := http.NewRequest("PUT", "/v1beta/block/volumes/bd5b12a8-a101-11e7-941e-d77981b584d8", bytes.NewBuffer(jsonStr))
+		w := httptest.NewRecorder()
+		r.Header.Set("Content-Type", "application/JSON")
+		r.Header.Set("If-Match", `W/"3"`)
+		beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+			httpCtx.Input.SetData("context", c.NewAdminContext())
+		})
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		assertTestResult(t, w.Code, 412)
+	})
+
+	t.Run("Should return 409 if the volume is modified concurrently", func(t *testing.T) {
+		volume := model.VolumeSpec{BaseModel: &model.BaseModel{}}
+		json.NewDecoder(bytes.NewBuffer(jsonStr)).Decode(&volume)
+		mockClient := new(dbtest.Client)
+		mockClient.On("UpdateVolume", c.NewAdminContext(), &volume).Return(nil, model.NewConflictError("volume has been modified"))
+		db.C = mockClient
+
+		r, _ := http.NewRequest("PUT", "/v1beta/block/volumes/bd5b12a8-a101-11e7-941e-d77981b584d8", bytes.NewBuffer(jsonStr))
+		w := httptest.NewRecorder()
+		r.Header.Set("Content-Type", "application/JSON")
+		beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+			httpCtx.Input.SetData("context", c.NewAdminContext())
+		})
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		assertTestResult(t, w.Code, 409)
+	})
+
+	t.Run("Should return 400 if If-Match isn't a revision", func(t *testing.T) {
+		r, _ := http.NewRequest("PUT", "/v1beta/block/volumes/bd5b12a8-a101-11e7-941e-d77981b584d8", bytes.NewBuffer(jsonStr))
+		w := httptest.NewRecorder()
+		r.Header.Set("Content-Type", "application/JSON")
+		r.Header.Set("If-Match", "abc")
+		beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+			httpCtx.Input.SetData("context", c.NewAdminContext())
+		})
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		assertTestResult(t, w.Code, 400)
+	})
+}
+
+// mockQuota lets the tenant be limited by the default quota and have
+// nothing charged yet.
+func mockQuota(m *dbtest.Client) {
+	m.On("GetQuota", mock.Anything, mock.Anything).Return(nil, model.NewNotFoundError("quota is not set"))
+	m.On("GetQuotaUsage", mock.Anything, mock.Anything).Return(nil, model.NewNotFoundError("quota usage can't find"))
+	m.On("UpdateQuotaUsage", mock.Anything, mock.Anything).Return(&SampleQuotaUsages[0], nil)
 }
 
 func TestExtendVolume(t *testing.T) {
@@ -256,9 +372,18 @@ func TestExtendVolume(t *testing.T) {
 
 	t.Run("Should return 200 if everything works well", func(t *testing.T) {
 		mockClient := new(dbtest.Client)
+		mockQuota(mockClient)
 		mockClient.On("GetVolume", c.NewAdminContext(), "bd5b12a8-a101-11e7-941e-d77981b584d8").Return(&SampleVolumes[0], nil)
 		mockClient.On("ExtendVolume", c.NewAdminContext(), &expected).Return(&expected, nil)
 		mockClient.On("GetProfile", c.NewAdminContext(), SampleReplications[0].ProfileId).Return(&SampleProfiles[0], nil)
+		mockClient.On("CreateOperation", c.NewAdminContext(), &model.OperationSpec{
+			BaseModel:    &model.BaseModel{},
+			Action:       "ExtendVolume",
+			ResourceType: "volume",
+			ResourceId:   "bd5b12a8-a101-11e7-941e-d77981b584d8",
+			Request:      `{"newSize":20}`,
+			Status:       "accepted",
+		}).Return(&SampleOperations[0], nil)
 		db.C = mockClient
 
 		r, _ := http.NewRequest("POST", "/v1beta/block/volumes/bd5b12a8-a101-11e7-941e-d77981b584d8/resize", bytes.NewBuffer(jsonStr))
@@ -272,6 +397,7 @@ func TestExtendVolume(t *testing.T) {
 		json.Unmarshal(w.Body.Bytes(), &output)
 		assertTestResult(t, w.Code, 202)
 		assertTestResult(t, &output, &expected)
+		assertTestResult(t, w.Header().Get("Location"), "/v1beta/operations/8b3ac17a-4c4f-11e9-9d5b-7b2ad6b2b1ea")
 	})
 
 	t.Run("Should return 400 if extend volume with bad request", func(t *testing.T) {
@@ -279,6 +405,7 @@ func TestExtendVolume(t *testing.T) {
 			"newSize": 1
 		}`)
 		mockClient := new(dbtest.Client)
+		mockQuota(mockClient)
 		mockClient.On("GetVolume", c.NewAdminContext(), "bd5b12a8-a101-11e7-941e-d77981b584d8").Return(&SampleVolumes[0], nil)
 		mockClient.On("ExtendVolume", c.NewAdminContext(), &expected).Return(&expected, nil)
 		mockClient.On("GetProfile", c.NewAdminContext(), SampleReplications[0].ProfileId).Return(&SampleProfiles[0], nil)
@@ -293,6 +420,404 @@ func TestExtendVolume(t *testing.T) {
 		beego.BeeApp.Handlers.ServeHTTP(w, r)
 		assertTestResult(t, w.Code, 400)
 	})
+
+	t.Run("Should return 413 if extend volume over the capacity quota", func(t *testing.T) {
+		jsonStr = []byte(`{
+			"newSize": 20
+		}`)
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetQuota", mock.Anything, mock.Anything).Return(&model.QuotaSpec{
+			BaseModel: &model.BaseModel{},
+			QuotaSet:  model.QuotaSet{Volumes: -1, Snapshots: -1, Capacity: 10, FileShares: -1},
+		}, nil)
+		mockClient.On("GetQuotaUsage", mock.Anything, mock.Anything).Return(nil, model.NewNotFoundError("quota usage can't find"))
+		vol := SampleVolumes[0]
+		vol.Status = model.VolumeAvailable
+		mockClient.On("GetVolume", c.NewAdminContext(), "bd5b12a8-a101-11e7-941e-d77981b584d8").Return(&vol, nil)
+		mockClient.On("GetProfile", c.NewAdminContext(), SampleReplications[0].ProfileId).Return(&SampleProfiles[0], nil)
+		db.C = mockClient
+
+		r, _ := http.NewRequest("POST", "/v1beta/block/volumes/bd5b12a8-a101-11e7-941e-d77981b584d8/resize", bytes.NewBuffer(jsonStr))
+		w := httptest.NewRecorder()
+		r.Header.Set("Content-Type", "application/JSON")
+		beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+			httpCtx.Input.SetData("context", c.NewAdminContext())
+		})
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		assertTestResult(t, w.Code, 413)
+		mockClient.AssertNotCalled(t, "ExtendVolume", mock.Anything, mock.Anything)
+	})
+}
+
+func TestRetypeVolume(t *testing.T) {
+	var jsonStr = []byte(`{
+		"profileId": "2f9c0a04-66ef-11e7-ade2-43158893e017"
+	}`)
+	var expectedJson = []byte(`{
+		"id": "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		"name": "sample-volume",
+		"description": "This is a sample volume for testing",
+		"size": 1,
+		"status": "retyping",
+		"poolId": "084bf71e-a102-11e7-88a8-e31fe6d52248",
+		"profileId": "1106b972-66ef-11e7-b172-db03f3689c9c"
+	}`)
+	var expected model.VolumeSpec
+	json.Unmarshal(expectedJson, &expected)
+
+	t.Run("Should return 202 if everything works well", func(t *testing.T) {
+		vol := SampleVolumes[0]
+		vol.Status = model.VolumeAvailable
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetVolume", c.NewAdminContext(), vol.Id).Return(&vol, nil)
+		mockClient.On("GetProfile", c.NewAdminContext(), SampleProfiles[1].Id).Return(&SampleProfiles[1], nil)
+		mockClient.On("ListSnapshotsByVolumeId", c.NewAdminContext(), vol.Id).Return(nil, nil)
+		mockClient.On("UpdateVolume", c.NewAdminContext(), &expected).Return(&expected, nil)
+		mockClient.On("CreateOperation", c.NewAdminContext(), &model.OperationSpec{
+			BaseModel:    &model.BaseModel{},
+			Action:       "RetypeVolume",
+			ResourceType: "volume",
+			ResourceId:   vol.Id,
+			Request:      `{"profileId":"2f9c0a04-66ef-11e7-ade2-43158893e017","migrationPolicy":"onDemand"}`,
+			Status:       "accepted",
+		}).Return(&SampleOperations[0], nil)
+		db.C = mockClient
+
+		r, _ := http.NewRequest("POST", "/v1beta/block/volumes/bd5b12a8-a101-11e7-941e-d77981b584d8/retype", bytes.NewBuffer(jsonStr))
+		w := httptest.NewRecorder()
+		r.Header.Set("Content-Type", "application/JSON")
+		beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+			httpCtx.Input.SetData("context", c.NewAdminContext())
+		})
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		var output model.VolumeSpec
+		json.Unmarshal(w.Body.Bytes(), &output)
+		assertTestResult(t, w.Code, 202)
+		assertTestResult(t, &output, &expected)
+		assertTestResult(t, w.Header().Get("Location"), "/v1beta/operations/8b3ac17a-4c4f-11e9-9d5b-7b2ad6b2b1ea")
+	})
+
+	t.Run("Should return 400 if retype volume to its own profile", func(t *testing.T) {
+		jsonStr = []byte(`{
+			"profileId": "1106b972-66ef-11e7-b172-db03f3689c9c"
+		}`)
+		vol := SampleVolumes[0]
+		vol.Status = model.VolumeAvailable
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetVolume", c.NewAdminContext(), vol.Id).Return(&vol, nil)
+		db.C = mockClient
+
+		r, _ := http.NewRequest("POST", "/v1beta/block/volumes/bd5b12a8-a101-11e7-941e-d77981b584d8/retype", bytes.NewBuffer(jsonStr))
+		w := httptest.NewRecorder()
+		r.Header.Set("Content-Type", "application/JSON")
+		beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+			httpCtx.Input.SetData("context", c.NewAdminContext())
+		})
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		assertTestResult(t, w.Code, 400)
+		mockClient.AssertNotCalled(t, "UpdateVolume", mock.Anything, mock.Anything)
+	})
+}
+
+func TestMigrateVolume(t *testing.T) {
+	var jsonStr = []byte(`{
+		"poolId": "a594b8ac-a103-11e7-985f-d723bcf01b5f"
+	}`)
+
+	t.Run("Should return 202 if everything works well", func(t *testing.T) {
+		vol := SampleVolumes[0]
+		vol.Status = model.VolumeAvailable
+		expected := vol
+		expected.Status = model.VolumeMigrating
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetVolume", c.NewAdminContext(), vol.Id).Return(&vol, nil)
+		mockClient.On("GetPool", c.NewAdminContext(), SamplePools[1].Id).Return(&SamplePools[1], nil)
+		mockClient.On("ListSnapshotsByVolumeId", c.NewAdminContext(), vol.Id).Return(nil, nil)
+		mockClient.On("UpdateVolume", c.NewAdminContext(), &expected).Return(&expected, nil)
+		mockClient.On("CreateOperation", c.NewAdminContext(), &model.OperationSpec{
+			BaseModel:    &model.BaseModel{},
+			Action:       "MigrateVolume",
+			ResourceType: "volume",
+			ResourceId:   vol.Id,
+			Request:      `{"poolId":"a594b8ac-a103-11e7-985f-d723bcf01b5f"}`,
+			Status:       "accepted",
+		}).Return(&SampleOperations[0], nil)
+		db.C = mockClient
+
+		r, _ := http.NewRequest("POST", "/v1beta/block/volumes/bd5b12a8-a101-11e7-941e-d77981b584d8/migrate", bytes.NewBuffer(jsonStr))
+		w := httptest.NewRecorder()
+		r.Header.Set("Content-Type", "application/JSON")
+		beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+			httpCtx.Input.SetData("context", c.NewAdminContext())
+		})
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		var output model.VolumeSpec
+		json.Unmarshal(w.Body.Bytes(), &output)
+		assertTestResult(t, w.Code, 202)
+		assertTestResult(t, &output, &expected)
+	})
+
+	t.Run("Should return 400 if migrate volume which has snapshots", func(t *testing.T) {
+		vol := SampleVolumes[0]
+		vol.Status = model.VolumeAvailable
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetVolume", c.NewAdminContext(), vol.Id).Return(&vol, nil)
+		mockClient.On("GetPool", c.NewAdminContext(), SamplePools[1].Id).Return(&SamplePools[1], nil)
+		mockClient.On("ListSnapshotsByVolumeId", c.NewAdminContext(), vol.Id).Return(
+			[]*model.VolumeSnapshotSpec{&SampleSnapshots[0]}, nil)
+		db.C = mockClient
+
+		r, _ := http.NewRequest("POST", "/v1beta/block/volumes/bd5b12a8-a101-11e7-941e-d77981b584d8/migrate", bytes.NewBuffer(jsonStr))
+		w := httptest.NewRecorder()
+		r.Header.Set("Content-Type", "application/JSON")
+		beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+			httpCtx.Input.SetData("context", c.NewAdminContext())
+		})
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		assertTestResult(t, w.Code, 400)
+		mockClient.AssertNotCalled(t, "UpdateVolume", mock.Anything, mock.Anything)
+	})
+}
+
+func TestRevertVolume(t *testing.T) {
+	var jsonStr = []byte(`{
+		"snapshotId": "3769855c-a102-11e7-b772-17b880d2f537"
+	}`)
+
+	t.Run("Should return 202 if everything works well", func(t *testing.T) {
+		vol := SampleVolumes[0]
+		vol.Status = model.VolumeAvailable
+		expected := vol
+		expected.Status = model.VolumeReverting
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetVolume", c.NewAdminContext(), vol.Id).Return(&vol, nil)
+		mockClient.On("GetVolumeSnapshot", c.NewAdminContext(), SampleSnapshots[0].Id).Return(&SampleSnapshots[0], nil)
+		mockClient.On("ListSnapshotsByVolumeId", c.NewAdminContext(), vol.Id).Return(
+			[]*model.VolumeSnapshotSpec{&SampleSnapshots[0]}, nil)
+		mockClient.On("UpdateVolume", c.NewAdminContext(), &expected).Return(&expected, nil)
+		mockClient.On("CreateOperation", c.NewAdminContext(), &model.OperationSpec{
+			BaseModel:    &model.BaseModel{},
+			Action:       "RevertVolume",
+			ResourceType: "volume",
+			ResourceId:   vol.Id,
+			Request:      `{"snapshotId":"3769855c-a102-11e7-b772-17b880d2f537"}`,
+			Status:       "accepted",
+		}).Return(&SampleOperations[0], nil)
+		db.C = mockClient
+
+		r, _ := http.NewRequest("POST", "/v1beta/block/volumes/bd5b12a8-a101-11e7-941e-d77981b584d8/revert", bytes.NewBuffer(jsonStr))
+		w := httptest.NewRecorder()
+		r.Header.Set("Content-Type", "application/JSON")
+		beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+			httpCtx.Input.SetData("context", c.NewAdminContext())
+		})
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		var output model.VolumeSpec
+		json.Unmarshal(w.Body.Bytes(), &output)
+		assertTestResult(t, w.Code, 202)
+		assertTestResult(t, &output, &expected)
+	})
+
+	t.Run("Should return 400 if revert volume which is attached", func(t *testing.T) {
+		vol := SampleVolumes[0]
+		vol.Status = model.VolumeInUse
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetVolume", c.NewAdminContext(), vol.Id).Return(&vol, nil)
+		mockClient.On("GetVolumeSnapshot", c.NewAdminContext(), SampleSnapshots[0].Id).Return(&SampleSnapshots[0], nil)
+		db.C = mockClient
+
+		r, _ := http.NewRequest("POST", "/v1beta/block/volumes/bd5b12a8-a101-11e7-941e-d77981b584d8/revert", bytes.NewBuffer(jsonStr))
+		w := httptest.NewRecorder()
+		r.Header.Set("Content-Type", "application/JSON")
+		beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+			httpCtx.Input.SetData("context", c.NewAdminContext())
+		})
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		assertTestResult(t, w.Code, 400)
+		mockClient.AssertNotCalled(t, "UpdateVolume", mock.Anything, mock.Anything)
+	})
+}
+
+func TestManageVolume(t *testing.T) {
+	var jsonStr = []byte(`{
+		"identifier": "lv001",
+		"poolId": "084bf71e-a102-11e7-88a8-e31fe6d52248",
+		"profileId": "1106b972-66ef-11e7-b172-db03f3689c9c"
+	}`)
+
+	t.Run("Should return 202 if everything works well", func(t *testing.T) {
+		mockClient := new(dbtest.Client)
+		mockQuota(mockClient)
+		mockClient.On("GetProfile", c.NewAdminContext(), SampleProfiles[0].Id).Return(&SampleProfiles[0], nil)
+		mockClient.On("GetPool", c.NewAdminContext(), SamplePools[0].Id).Return(&SamplePools[0], nil)
+		mockClient.On("CreateVolume", c.NewAdminContext(), mock.MatchedBy(func(vol *model.VolumeSpec) bool {
+			return vol.Name == "lv001" && vol.Status == model.VolumeManaging &&
+				vol.PoolId == SamplePools[0].Id && vol.ProfileId == SampleProfiles[0].Id
+		})).Return(&SampleVolumes[0], nil)
+		mockClient.On("CreateOperation", c.NewAdminContext(), mock.Anything).Return(&SampleOperations[0], nil)
+		db.C = mockClient
+
+		r, _ := http.NewRequest("POST", "/v1beta/block/volumes/manage", bytes.NewBuffer(jsonStr))
+		w := httptest.NewRecorder()
+		r.Header.Set("Content-Type", "application/JSON")
+		beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+			httpCtx.Input.SetData("context", c.NewAdminContext())
+		})
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		assertTestResult(t, w.Code, 202)
+		mockClient.AssertCalled(t, "CreateVolume", c.NewAdminContext(), mock.Anything)
+	})
+
+	t.Run("Should return 400 if manage volume without identifier", func(t *testing.T) {
+		jsonStr := []byte(`{
+			"poolId": "084bf71e-a102-11e7-88a8-e31fe6d52248",
+			"profileId": "1106b972-66ef-11e7-b172-db03f3689c9c"
+		}`)
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetProfile", c.NewAdminContext(), SampleProfiles[0].Id).Return(&SampleProfiles[0], nil)
+		db.C = mockClient
+
+		r, _ := http.NewRequest("POST", "/v1beta/block/volumes/manage", bytes.NewBuffer(jsonStr))
+		w := httptest.NewRecorder()
+		r.Header.Set("Content-Type", "application/JSON")
+		beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+			httpCtx.Input.SetData("context", c.NewAdminContext())
+		})
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		assertTestResult(t, w.Code, 400)
+		mockClient.AssertNotCalled(t, "CreateVolume", mock.Anything, mock.Anything)
+	})
+}
+
+func TestUnmanageVolume(t *testing.T) {
+	t.Run("Should return 202 if everything works well", func(t *testing.T) {
+		vol := SampleVolumes[0]
+		vol.Status = model.VolumeAvailable
+		expected := vol
+		expected.Status = model.VolumeUnmanaging
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetVolume", c.NewAdminContext(), vol.Id).Return(&vol, nil)
+		mockClient.On("ListSnapshotsByVolumeId", c.NewAdminContext(), vol.Id).Return(nil, nil)
+		mockClient.On("UpdateVolume", c.NewAdminContext(), &expected).Return(&expected, nil)
+		mockClient.On("CreateOperation", c.NewAdminContext(), mock.Anything).Return(&SampleOperations[0], nil)
+		db.C = mockClient
+
+		r, _ := http.NewRequest("POST", "/v1beta/block/volumes/bd5b12a8-a101-11e7-941e-d77981b584d8/unmanage", nil)
+		w := httptest.NewRecorder()
+		beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+			httpCtx.Input.SetData("context", c.NewAdminContext())
+		})
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		assertTestResult(t, w.Code, 202)
+		mockClient.AssertCalled(t, "UpdateVolume", c.NewAdminContext(), &expected)
+	})
+
+	t.Run("Should return 400 if unmanage volume which has snapshots", func(t *testing.T) {
+		vol := SampleVolumes[0]
+		vol.Status = model.VolumeAvailable
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetVolume", c.NewAdminContext(), vol.Id).Return(&vol, nil)
+		mockClient.On("ListSnapshotsByVolumeId", c.NewAdminContext(), vol.Id).Return(
+			[]*model.VolumeSnapshotSpec{&SampleSnapshots[0]}, nil)
+		db.C = mockClient
+
+		r, _ := http.NewRequest("POST", "/v1beta/block/volumes/bd5b12a8-a101-11e7-941e-d77981b584d8/unmanage", nil)
+		w := httptest.NewRecorder()
+		beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+			httpCtx.Input.SetData("context", c.NewAdminContext())
+		})
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		assertTestResult(t, w.Code, 400)
+		mockClient.AssertNotCalled(t, "UpdateVolume", mock.Anything, mock.Anything)
+	})
+}
+
+func TestResetVolumeStatus(t *testing.T) {
+	t.Run("Should return 200 if the status is valid", func(t *testing.T) {
+		vol := SampleVolumes[0]
+		vol.Status = model.VolumeDeleting
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetVolume", c.NewAdminContext(), vol.Id).Return(&vol, nil)
+		mockClient.On("UpdateStatus", c.NewAdminContext(), &vol, model.VolumeError).Return(nil)
+		db.C = mockClient
+
+		body := bytes.NewBuffer([]byte(`{"status":"error"}`))
+		r, _ := http.NewRequest("POST", "/v1beta/block/volumes/bd5b12a8-a101-11e7-941e-d77981b584d8/os-reset_status", body)
+		w := httptest.NewRecorder()
+		beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+			httpCtx.Input.SetData("context", c.NewAdminContext())
+		})
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		assertTestResult(t, w.Code, 200)
+		mockClient.AssertCalled(t, "UpdateStatus", c.NewAdminContext(), &vol, model.VolumeError)
+	})
+
+	t.Run("Should return 400 if the status is invalid", func(t *testing.T) {
+		vol := SampleVolumes[0]
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetVolume", c.NewAdminContext(), vol.Id).Return(&vol, nil)
+		db.C = mockClient
+
+		body := bytes.NewBuffer([]byte(`{"status":"failing_over"}`))
+		r, _ := http.NewRequest("POST", "/v1beta/block/volumes/bd5b12a8-a101-11e7-941e-d77981b584d8/os-reset_status", body)
+		w := httptest.NewRecorder()
+		beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+			httpCtx.Input.SetData("context", c.NewAdminContext())
+		})
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		assertTestResult(t, w.Code, 400)
+		mockClient.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestForceDeleteVolume(t *testing.T) {
+	t.Run("Should remove the volume from db even if the backend fails", func(t *testing.T) {
+		vol := SampleVolumes[0]
+		vol.Status = model.VolumeErrorExtending
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetVolume", c.NewAdminContext(), vol.Id).Return(&vol, nil)
+		mockClient.On("UpdateStatus", c.NewAdminContext(), &vol, model.VolumeDeleting).Return(nil)
+		mockClient.On("GetProfile", c.NewAdminContext(), vol.ProfileId).Return(&SampleProfiles[0], nil)
+		mockClient.On("CreateOperation", c.NewAdminContext(), mock.Anything).Return(&SampleOperations[0], nil)
+		mockClient.On("UpdateOperation", c.NewAdminContext(), SampleOperations[0].Id, mock.Anything).Return(&SampleOperations[0], nil)
+		mockClient.On("ListSnapshotsByVolumeId", c.NewAdminContext(), vol.Id).Return(nil, nil)
+		mockClient.On("ListAttachmentsByVolumeId", c.NewAdminContext(), vol.Id).Return(nil, nil)
+		mockClient.On("GetReplicationByVolumeId", c.NewAdminContext(), vol.Id).Return(nil, errors.New("not found"))
+		mockClient.On("DeleteVolume", c.NewAdminContext(), vol.Id).Return(nil)
+		mockClient.On("GetQuotaUsage", c.NewAdminContext(), vol.TenantId).Return(nil, model.NewNotFoundError("no usage"))
+		db.C = mockClient
+
+		r, _ := http.NewRequest("POST", "/v1beta/block/volumes/bd5b12a8-a101-11e7-941e-d77981b584d8/force-delete", nil)
+		w := httptest.NewRecorder()
+		beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+			httpCtx.Input.SetData("context", c.NewAdminContext())
+		})
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		assertTestResult(t, w.Code, 202)
+		mockClient.AssertCalled(t, "DeleteVolume", c.NewAdminContext(), vol.Id)
+		op := mockClient.Calls[len(mockClient.Calls)-1].Arguments.Get(2).(*model.OperationSpec)
+		assertTestResult(t, op.Status, model.OperationSucceeded)
+	})
+
+	t.Run("Should return 409 if the volume status can't be updated", func(t *testing.T) {
+		vol := SampleVolumes[0]
+		vol.Status = model.VolumeErrorExtending
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetVolume", c.NewAdminContext(), vol.Id).Return(&vol, nil)
+		mockClient.On("UpdateStatus", c.NewAdminContext(), &vol, model.VolumeDeleting).Return(
+			model.NewConflictError("volume has been modified"))
+		db.C = mockClient
+
+		r, _ := http.NewRequest("POST", "/v1beta/block/volumes/bd5b12a8-a101-11e7-941e-d77981b584d8/force-delete", nil)
+		w := httptest.NewRecorder()
+		beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+			httpCtx.Input.SetData("context", c.NewAdminContext())
+		})
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		assertTestResult(t, w.Code, 409)
+		mockClient.AssertNotCalled(t, "CreateOperation", mock.Anything, mock.Anything)
+		mockClient.AssertNotCalled(t, "DeleteVolume", mock.Anything, mock.Anything)
+	})
 }
 
 ////////////////////////////////////////////////////////////////////////////////
@@ -438,6 +963,95 @@ func TestUpdateVolumeSnapshot(t *testing.T) {
 	})
 }
 
+func TestManageVolumeSnapshot(t *testing.T) {
+	var jsonStr = []byte(`{
+		"identifier": "snap001",
+		"volumeId": "bd5b12a8-a101-11e7-941e-d77981b584d8"
+	}`)
+
+	t.Run("Should return 202 if everything works well", func(t *testing.T) {
+		vol := SampleVolumes[0]
+		vol.Status = model.VolumeAvailable
+		mockClient := new(dbtest.Client)
+		mockQuota(mockClient)
+		mockClient.On("GetVolume", c.NewAdminContext(), vol.Id).Return(&vol, nil)
+		mockClient.On("CreateVolumeSnapshot", c.NewAdminContext(), mock.MatchedBy(func(snp *model.VolumeSnapshotSpec) bool {
+			return snp.Name == "snap001" && snp.Status == model.VolumeSnapManaging &&
+				snp.VolumeId == vol.Id && snp.Size == vol.Size
+		})).Return(&SampleSnapshots[0], nil)
+		mockClient.On("CreateOperation", c.NewAdminContext(), mock.Anything).Return(&SampleOperations[0], nil)
+		db.C = mockClient
+
+		r, _ := http.NewRequest("POST", "/v1beta/block/snapshots/manage", bytes.NewBuffer(jsonStr))
+		w := httptest.NewRecorder()
+		r.Header.Set("Content-Type", "application/JSON")
+		beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+			httpCtx.Input.SetData("context", c.NewAdminContext())
+		})
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		assertTestResult(t, w.Code, 202)
+		mockClient.AssertCalled(t, "CreateVolumeSnapshot", c.NewAdminContext(), mock.Anything)
+	})
+
+	t.Run("Should return 400 if the volume isn't available", func(t *testing.T) {
+		vol := SampleVolumes[0]
+		vol.Status = model.VolumeError
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetVolume", c.NewAdminContext(), vol.Id).Return(&vol, nil)
+		db.C = mockClient
+
+		r, _ := http.NewRequest("POST", "/v1beta/block/snapshots/manage", bytes.NewBuffer(jsonStr))
+		w := httptest.NewRecorder()
+		r.Header.Set("Content-Type", "application/JSON")
+		beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+			httpCtx.Input.SetData("context", c.NewAdminContext())
+		})
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		assertTestResult(t, w.Code, 400)
+		mockClient.AssertNotCalled(t, "CreateVolumeSnapshot", mock.Anything, mock.Anything)
+	})
+}
+
+func TestUnmanageVolumeSnapshot(t *testing.T) {
+	t.Run("Should return 202 if everything works well", func(t *testing.T) {
+		snp := SampleSnapshots[0]
+		snp.Status = model.VolumeSnapAvailable
+		expected := snp
+		expected.Status = model.VolumeSnapUnmanaging
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetVolumeSnapshot", c.NewAdminContext(), snp.Id).Return(&snp, nil)
+		mockClient.On("UpdateVolumeSnapshot", c.NewAdminContext(), snp.Id, &expected).Return(&expected, nil)
+		mockClient.On("CreateOperation", c.NewAdminContext(), mock.Anything).Return(&SampleOperations[0], nil)
+		db.C = mockClient
+
+		r, _ := http.NewRequest("POST", "/v1beta/block/snapshots/3769855c-a102-11e7-b772-17b880d2f537/unmanage", nil)
+		w := httptest.NewRecorder()
+		beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+			httpCtx.Input.SetData("context", c.NewAdminContext())
+		})
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		assertTestResult(t, w.Code, 202)
+		mockClient.AssertCalled(t, "UpdateVolumeSnapshot", c.NewAdminContext(), snp.Id, &expected)
+	})
+
+	t.Run("Should return 400 if the snapshot isn't available", func(t *testing.T) {
+		snp := SampleSnapshots[0]
+		snp.Status = model.VolumeSnapCreating
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetVolumeSnapshot", c.NewAdminContext(), snp.Id).Return(&snp, nil)
+		db.C = mockClient
+
+		r, _ := http.NewRequest("POST", "/v1beta/block/snapshots/3769855c-a102-11e7-b772-17b880d2f537/unmanage", nil)
+		w := httptest.NewRecorder()
+		beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+			httpCtx.Input.SetData("context", c.NewAdminContext())
+		})
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		assertTestResult(t, w.Code, 400)
+		mockClient.AssertNotCalled(t, "UpdateVolumeSnapshot", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 //                         Tests for volume attachment                          //
 ////////////////////////////////////////////////////////////////////////////////
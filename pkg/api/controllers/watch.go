@@ -0,0 +1,201 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+This module implements a entry into the OpenSDS northbound service.
+
+*/
+
+package controllers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/golang/glog"
+	"github.com/opensds/opensds/pkg/api/policy"
+	c "github.com/opensds/opensds/pkg/context"
+	"github.com/opensds/opensds/pkg/db"
+	"github.com/opensds/opensds/pkg/model"
+	"github.com/opensds/opensds/pkg/utils/constants"
+)
+
+var (
+	// watchKeepAlive is how often a comment is sent on an idle stream, which
+	// keeps the proxies in between from closing it.
+	watchKeepAlive = 15 * time.Second
+	// watchMaxTimeout bounds a stream a little shorter than the write timeout
+	// of the server, so it's always ended cleanly and the client can resume
+	// the watch from the last event it has got.
+	watchMaxTimeout = (constants.BeegoServerTimeOut - 5) * time.Second
+)
+
+// watchResource tells which resource type of db a watched resource is, and
+// which policy rule allows to watch it.
+type watchResource struct {
+	resourceType string
+	rule         string
+}
+
+var watchResources = map[string]watchResource{
+	"volumes":      {model.OperationResourceVolume, "volume:list"},
+	"snapshots":    {model.OperationResourceSnapshot, "snapshot:list"},
+	"attachments":  {model.OperationResourceAttachment, "volume:list_attachments"},
+	"replications": {model.OperationResourceReplication, "replication:list"},
+	"pools":        {model.WatchResourcePool, "pool:list"},
+}
+
+// WatchPortal streams the changes of the resources as server-sent events,
+// which saves the clients from polling the list of the resources.
+type WatchPortal struct {
+	BasePortal
+}
+
+// WatchEvents streams the ADDED, MODIFIED and DELETED events of the resource
+// specified by the query parameter resource, after the resourceVersion or the
+// Last-Event-ID header if any. The id of every event is the resource version
+// to resume the watch from. The stream is ended after timeoutSeconds, and
+// after an ERROR event which means the watch can't be resumed, such as the
+// resource version is too old, then the client should list the resources
+// again and watch from the latest revision of them.
+func (w *WatchPortal) WatchEvents() {
+	name := w.Ctx.Input.Query("resource")
+	res, ok := watchResources[name]
+	if !ok {
+		errMsg := fmt.Sprintf("resource %q can't be watched, it should be one of %s",
+			name, strings.Join(watchResourceNames(), ", "))
+		w.ErrorHandle(model.ErrorBadRequest, errMsg)
+		return
+	}
+	if !policy.Authorize(w.Ctx, res.rule) {
+		return
+	}
+
+	rev, err := w.getResourceVersion()
+	if err != nil {
+		w.ErrorHandle(model.ErrorBadRequest, err.Error())
+		return
+	}
+	timeout := watchMaxTimeout
+	if v := w.Ctx.Input.Query("timeoutSeconds"); v != "" {
+		secs, err := strconv.Atoi(v)
+		if err != nil || secs <= 0 {
+			errMsg := fmt.Sprintf("invalid timeoutSeconds %q", v)
+			w.ErrorHandle(model.ErrorBadRequest, errMsg)
+			return
+		}
+		if d := time.Duration(secs) * time.Second; d < timeout {
+			timeout = d
+		}
+	}
+
+	stop := make(chan struct{})
+	defer close(stop)
+	events, err := db.C.Watch(c.GetContext(w.Ctx), res.resourceType, rev, stop)
+	if err != nil {
+		errMsg := fmt.Sprintf("watch %s failed: %s", name, err.Error())
+		if _, ok := err.(*model.NotImplementError); ok {
+			w.ErrorHandle(model.ErrorNotImplemented, errMsg)
+			return
+		}
+		w.ErrorHandle(model.ErrorInternalServer, errMsg)
+		return
+	}
+
+	resp := w.Ctx.ResponseWriter
+	resp.Header().Set("Content-Type", "text/event-stream")
+	resp.Header().Set("Cache-Control", "no-cache")
+	resp.Header().Set("X-Accel-Buffering", "no")
+	resp.WriteHeader(StatusOK)
+	resp.Flush()
+
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+	keepAlive := time.NewTicker(watchKeepAlive)
+	defer keepAlive.Stop()
+	done := w.Ctx.Request.Context().Done()
+	for {
+		select {
+		case e, ok := <-events:
+			if !ok {
+				return
+			}
+			if err := writeWatchEvent(resp, e); err != nil {
+				log.Error("write watch event failed: ", err)
+				return
+			}
+			resp.Flush()
+			if e.Type == model.WatchError {
+				return
+			}
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(resp, ": keepalive\n\n"); err != nil {
+				return
+			}
+			resp.Flush()
+		case <-deadline.C:
+			return
+		case <-done:
+			return
+		}
+	}
+}
+
+// getResourceVersion returns the resource version to watch from, the query
+// parameter takes precedence over the Last-Event-ID header sent by the
+// browsers when they reconnect.
+func (w *WatchPortal) getResourceVersion() (int64, error) {
+	v := w.Ctx.Input.Query("resourceVersion")
+	if v == "" {
+		v = strings.TrimSpace(w.Ctx.Input.Header("Last-Event-ID"))
+	}
+	if v == "" {
+		return 0, nil
+	}
+	rev, err := strconv.ParseInt(v, 10, 64)
+	if err != nil || rev < 0 {
+		return 0, fmt.Errorf("invalid resource version %q", v)
+	}
+	return rev, nil
+}
+
+// writeWatchEvent writes the event in the format of server-sent events.
+func writeWatchEvent(out io.Writer, e *model.WatchEvent) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	var frame bytes.Buffer
+	if e.ResourceVersion != 0 {
+		fmt.Fprintf(&frame, "id: %d\n", e.ResourceVersion)
+	}
+	fmt.Fprintf(&frame, "event: %s\ndata: %s\n\n", e.Type, data)
+	_, err = out.Write(frame.Bytes())
+	return err
+}
+
+func watchResourceNames() []string {
+	var names []string
+	for name := range watchResources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/astaxie/beego"
+	"github.com/astaxie/beego/context"
+	c "github.com/opensds/opensds/pkg/context"
+	"github.com/opensds/opensds/pkg/db"
+	"github.com/opensds/opensds/pkg/model"
+	dbtest "github.com/opensds/opensds/testutils/db/testing"
+	"github.com/stretchr/testify/mock"
+)
+
+func init() {
+	var watchPortal WatchPortal
+	beego.Router("/v1beta/events", &watchPortal, "get:WatchEvents")
+}
+
+func TestWatchEvents(t *testing.T) {
+	t.Run("Should stream the events until the watch is ended", func(t *testing.T) {
+		events := make(chan *model.WatchEvent, 2)
+		events <- &model.WatchEvent{Type: model.WatchAdded, ResourceType: model.OperationResourceVolume,
+			ResourceVersion: 8, Object: []byte(`{"id":"vol1"}`)}
+		events <- &model.WatchEvent{Type: model.WatchError, ErrorMessage: "compacted"}
+		close(events)
+		var recv <-chan *model.WatchEvent = events
+
+		mockClient := new(dbtest.Client)
+		mockClient.On("Watch", c.NewAdminContext(), model.OperationResourceVolume, int64(7), mock.Anything).Return(recv, nil)
+		db.C = mockClient
+
+		r, _ := http.NewRequest("GET", "/v1beta/events?resource=volumes", nil)
+		r.Header.Set("Last-Event-ID", "7")
+		w := httptest.NewRecorder()
+		beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+			httpCtx.Input.SetData("context", c.NewAdminContext())
+		})
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		assertTestResult(t, w.Code, 200)
+		assertTestResult(t, w.Header().Get("Content-Type"), "text/event-stream")
+		expected := "id: 8\nevent: ADDED\ndata: " +
+			`{"type":"ADDED","resourceType":"volume","resourceVersion":8,"object":{"id":"vol1"}}` + "\n\n" +
+			"event: ERROR\ndata: " + `{"type":"ERROR","errorMessage":"compacted"}` + "\n\n"
+		assertTestResult(t, w.Body.String(), expected)
+	})
+
+	t.Run("Should return 400 if the resource can't be watched", func(t *testing.T) {
+		mockClient := new(dbtest.Client)
+		db.C = mockClient
+
+		for _, url := range []string{
+			"/v1beta/events?resource=backups",
+			"/v1beta/events?resource=volumes&resourceVersion=latest",
+			"/v1beta/events?resource=volumes&timeoutSeconds=0",
+		} {
+			r, _ := http.NewRequest("GET", url, nil)
+			w := httptest.NewRecorder()
+			beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+				httpCtx.Input.SetData("context", c.NewAdminContext())
+			})
+			beego.BeeApp.Handlers.ServeHTTP(w, r)
+			assertTestResult(t, w.Code, 400)
+		}
+		mockClient.AssertNotCalled(t, "Watch", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Should return 501 if the db can't watch", func(t *testing.T) {
+		mockClient := new(dbtest.Client)
+		mockClient.On("Watch", c.NewAdminContext(), model.WatchResourcePool, int64(0), mock.Anything).Return(
+			nil, &model.NotImplementError{S: "watch is not supported"})
+		db.C = mockClient
+
+		r, _ := http.NewRequest("GET", "/v1beta/events?resource=pools", nil)
+		w := httptest.NewRecorder()
+		beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+			httpCtx.Input.SetData("context", c.NewAdminContext())
+		})
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		assertTestResult(t, w.Code, 501)
+		if !strings.Contains(w.Body.String(), "watch is not supported") {
+			t.Errorf("Expected the reason in the response, got %s", w.Body.String())
+		}
+	})
+}
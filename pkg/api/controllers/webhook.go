@@ -0,0 +1,204 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+This module implements a entry into the OpenSDS northbound service.
+
+*/
+
+package controllers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/opensds/opensds/pkg/api/policy"
+	c "github.com/opensds/opensds/pkg/context"
+	"github.com/opensds/opensds/pkg/db"
+	"github.com/opensds/opensds/pkg/model"
+)
+
+// WebhookPortal manages the webhooks which the tenant is notified of the
+// status transitions of its resources through.
+type WebhookPortal struct {
+	BasePortal
+}
+
+func (w *WebhookPortal) CreateWebhook() {
+	if !policy.Authorize(w.Ctx, "webhook:create") {
+		return
+	}
+	ctx := c.GetContext(w.Ctx)
+
+	var hook = model.WebhookSpec{
+		BaseModel: &model.BaseModel{},
+	}
+	if err := json.NewDecoder(w.Ctx.Request.Body).Decode(&hook); err != nil {
+		errMsg := fmt.Sprintf("parse webhook request body failed: %s", err.Error())
+		w.ErrorHandle(model.ErrorBadRequest, errMsg)
+		return
+	}
+	if err := validateWebhook(&hook); err != nil {
+		w.ErrorHandle(model.ErrorBadRequest, err.Error())
+		return
+	}
+
+	result, err := db.C.CreateWebhook(ctx, &hook)
+	if err != nil {
+		errMsg := fmt.Sprintf("create webhook failed: %s", err.Error())
+		w.ErrorHandle(model.ErrorInternalServer, errMsg)
+		return
+	}
+
+	// Marshal the result.
+	body, err := json.Marshal(hideSecret(result))
+	if err != nil {
+		errMsg := fmt.Sprintf("marshal webhook created result failed: %s", err.Error())
+		w.ErrorHandle(model.ErrorInternalServer, errMsg)
+		return
+	}
+
+	w.SuccessHandle(StatusOK, body)
+	return
+}
+
+func (w *WebhookPortal) ListWebhooks() {
+	if !policy.Authorize(w.Ctx, "webhook:list") {
+		return
+	}
+
+	result, err := db.C.ListWebhooks(c.GetContext(w.Ctx))
+	if err != nil {
+		errMsg := fmt.Sprintf("list webhooks failed: %s", err.Error())
+		w.ErrorHandle(model.ErrorInternalServer, errMsg)
+		return
+	}
+	var hooks = []*model.WebhookSpec{}
+	for _, hook := range result {
+		hooks = append(hooks, hideSecret(hook))
+	}
+
+	// Marshal the result.
+	body, err := json.Marshal(hooks)
+	if err != nil {
+		errMsg := fmt.Sprintf("marshal webhooks failed: %s", err.Error())
+		w.ErrorHandle(model.ErrorInternalServer, errMsg)
+		return
+	}
+
+	w.SuccessHandle(StatusOK, body)
+	return
+}
+
+func (w *WebhookPortal) GetWebhook() {
+	if !policy.Authorize(w.Ctx, "webhook:get") {
+		return
+	}
+	id := w.Ctx.Input.Param(":webhookId")
+
+	result, err := db.C.GetWebhook(c.GetContext(w.Ctx), id)
+	if err != nil {
+		errMsg := fmt.Sprintf("webhook %s not found: %s", id, err.Error())
+		w.ErrorHandle(model.ErrorNotFound, errMsg)
+		return
+	}
+
+	// Marshal the result.
+	body, err := json.Marshal(hideSecret(result))
+	if err != nil {
+		errMsg := fmt.Sprintf("marshal webhook failed: %s", err.Error())
+		w.ErrorHandle(model.ErrorInternalServer, errMsg)
+		return
+	}
+
+	w.SuccessHandle(StatusOK, body)
+	return
+}
+
+func (w *WebhookPortal) DeleteWebhook() {
+	if !policy.Authorize(w.Ctx, "webhook:delete") {
+		return
+	}
+	ctx := c.GetContext(w.Ctx)
+	id := w.Ctx.Input.Param(":webhookId")
+
+	if _, err := db.C.GetWebhook(ctx, id); err != nil {
+		errMsg := fmt.Sprintf("webhook %s not found: %s", id, err.Error())
+		w.ErrorHandle(model.ErrorNotFound, errMsg)
+		return
+	}
+	if err := db.C.DeleteWebhook(ctx, id); err != nil {
+		errMsg := fmt.Sprintf("delete webhook %s failed: %s", id, err.Error())
+		w.ErrorHandle(model.ErrorInternalServer, errMsg)
+		return
+	}
+
+	w.SuccessHandle(StatusOK, nil)
+	return
+}
+
+// ListWebhookDeliveries returns the delivery log of the webhook.
+func (w *WebhookPortal) ListWebhookDeliveries() {
+	if !policy.Authorize(w.Ctx, "webhook:get") {
+		return
+	}
+	ctx := c.GetContext(w.Ctx)
+	id := w.Ctx.Input.Param(":webhookId")
+
+	if _, err := db.C.GetWebhook(ctx, id); err != nil {
+		errMsg := fmt.Sprintf("webhook %s not found: %s", id, err.Error())
+		w.ErrorHandle(model.ErrorNotFound, errMsg)
+		return
+	}
+	result, err := db.C.ListWebhookDeliveries(ctx, id)
+	if err != nil {
+		errMsg := fmt.Sprintf("list deliveries of webhook %s failed: %s", id, err.Error())
+		w.ErrorHandle(model.ErrorInternalServer, errMsg)
+		return
+	}
+
+	// Marshal the result.
+	body, err := json.Marshal(result)
+	if err != nil {
+		errMsg := fmt.Sprintf("marshal webhook deliveries failed: %s", err.Error())
+		w.ErrorHandle(model.ErrorInternalServer, errMsg)
+		return
+	}
+
+	w.SuccessHandle(StatusOK, body)
+	return
+}
+
+func validateWebhook(hook *model.WebhookSpec) error {
+	u, err := url.Parse(hook.Url)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid webhook url %q, an absolute http or https url is required", hook.Url)
+	}
+	for _, event := range hook.Events {
+		if strings.TrimSpace(event) == "" {
+			return fmt.Errorf("invalid webhook events %v, empty event type is not allowed", hook.Events)
+		}
+	}
+	return nil
+}
+
+// hideSecret returns a copy of the webhook without its secret, which is
+// never sent back to the users.
+func hideSecret(hook *model.WebhookSpec) *model.WebhookSpec {
+	result := *hook
+	result.Secret = ""
+	return &result
+}
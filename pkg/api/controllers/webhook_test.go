@@ -0,0 +1,163 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/astaxie/beego"
+	"github.com/astaxie/beego/context"
+	c "github.com/opensds/opensds/pkg/context"
+	"github.com/opensds/opensds/pkg/db"
+	"github.com/opensds/opensds/pkg/model"
+	. "github.com/opensds/opensds/testutils/collection"
+	dbtest "github.com/opensds/opensds/testutils/db/testing"
+	"github.com/stretchr/testify/mock"
+)
+
+func init() {
+	var webhookPortal WebhookPortal
+	beego.Router("/v1beta/webhooks", &webhookPortal, "post:CreateWebhook;get:ListWebhooks")
+	beego.Router("/v1beta/webhooks/:webhookId", &webhookPortal, "get:GetWebhook;delete:DeleteWebhook")
+	beego.Router("/v1beta/webhooks/:webhookId/deliveries", &webhookPortal, "get:ListWebhookDeliveries")
+}
+
+func TestCreateWebhook(t *testing.T) {
+	t.Run("Should return 200 without the secret", func(t *testing.T) {
+		mockClient := new(dbtest.Client)
+		mockClient.On("CreateWebhook", c.NewAdminContext(), mock.Anything).Return(&SampleWebhooks[0], nil)
+		db.C = mockClient
+
+		var jsonStr = []byte(`{"name": "volume-watcher", "url": "https://hooks.example.com/opensds",
+			"events": ["volume.*"], "secret": "s3cr3t"}`)
+		r, _ := http.NewRequest("POST", "/v1beta/webhooks", bytes.NewBuffer(jsonStr))
+		w := httptest.NewRecorder()
+		beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+			httpCtx.Input.SetData("context", c.NewAdminContext())
+		})
+		r.Header.Set("Content-Type", "application/JSON")
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		var output model.WebhookSpec
+		json.Unmarshal(w.Body.Bytes(), &output)
+		assertTestResult(t, w.Code, 200)
+		assertTestResult(t, output.Url, SampleWebhooks[0].Url)
+		assertTestResult(t, output.Secret, "")
+		hook := mockClient.Calls[0].Arguments.Get(1).(*model.WebhookSpec)
+		assertTestResult(t, hook.Secret, "s3cr3t")
+		assertTestResult(t, hook.Events, []string{"volume.*"})
+	})
+
+	t.Run("Should return 400 if the url is invalid", func(t *testing.T) {
+		for _, body := range []string{`{"url": "ftp://hooks.example.com"}`, `{"url": "/opensds"}`, `{}`} {
+			mockClient := new(dbtest.Client)
+			db.C = mockClient
+
+			r, _ := http.NewRequest("POST", "/v1beta/webhooks", bytes.NewBufferString(body))
+			w := httptest.NewRecorder()
+			beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+				httpCtx.Input.SetData("context", c.NewAdminContext())
+			})
+			r.Header.Set("Content-Type", "application/JSON")
+			beego.BeeApp.Handlers.ServeHTTP(w, r)
+			assertTestResult(t, w.Code, 400)
+			mockClient.AssertNotCalled(t, "CreateWebhook", mock.Anything, mock.Anything)
+		}
+	})
+}
+
+func TestListWebhooks(t *testing.T) {
+	t.Run("Should return 200 without the secrets", func(t *testing.T) {
+		mockClient := new(dbtest.Client)
+		mockClient.On("ListWebhooks", c.NewAdminContext()).Return([]*model.WebhookSpec{&SampleWebhooks[0]}, nil)
+		db.C = mockClient
+
+		r, _ := http.NewRequest("GET", "/v1beta/webhooks", nil)
+		w := httptest.NewRecorder()
+		beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+			httpCtx.Input.SetData("context", c.NewAdminContext())
+		})
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		var output []*model.WebhookSpec
+		json.Unmarshal(w.Body.Bytes(), &output)
+		assertTestResult(t, w.Code, 200)
+		assertTestResult(t, len(output), 1)
+		assertTestResult(t, output[0].Id, SampleWebhooks[0].Id)
+		assertTestResult(t, output[0].Secret, "")
+		// The webhook in db is left untouched.
+		assertTestResult(t, SampleWebhooks[0].Secret, "s3cr3t")
+	})
+}
+
+func TestDeleteWebhook(t *testing.T) {
+	var id = SampleWebhooks[0].Id
+
+	t.Run("Should return 200 if everything works well", func(t *testing.T) {
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetWebhook", c.NewAdminContext(), id).Return(&SampleWebhooks[0], nil)
+		mockClient.On("DeleteWebhook", c.NewAdminContext(), id).Return(nil)
+		db.C = mockClient
+
+		r, _ := http.NewRequest("DELETE", "/v1beta/webhooks/"+id, nil)
+		w := httptest.NewRecorder()
+		beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+			httpCtx.Input.SetData("context", c.NewAdminContext())
+		})
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		assertTestResult(t, w.Code, 200)
+		mockClient.AssertCalled(t, "DeleteWebhook", c.NewAdminContext(), id)
+	})
+
+	t.Run("Should return 404 if the webhook doesn't exist", func(t *testing.T) {
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetWebhook", c.NewAdminContext(), id).Return(nil, model.NewNotFoundError("not found"))
+		db.C = mockClient
+
+		r, _ := http.NewRequest("DELETE", "/v1beta/webhooks/"+id, nil)
+		w := httptest.NewRecorder()
+		beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+			httpCtx.Input.SetData("context", c.NewAdminContext())
+		})
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		assertTestResult(t, w.Code, 404)
+		mockClient.AssertNotCalled(t, "DeleteWebhook", mock.Anything, mock.Anything)
+	})
+}
+
+func TestListWebhookDeliveries(t *testing.T) {
+	var id = SampleWebhooks[0].Id
+
+	t.Run("Should return 200 if everything works well", func(t *testing.T) {
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetWebhook", c.NewAdminContext(), id).Return(&SampleWebhooks[0], nil)
+		mockClient.On("ListWebhookDeliveries", c.NewAdminContext(), id).Return(
+			[]*model.WebhookDeliverySpec{&SampleWebhookDeliveries[0]}, nil)
+		db.C = mockClient
+
+		r, _ := http.NewRequest("GET", "/v1beta/webhooks/"+id+"/deliveries", nil)
+		w := httptest.NewRecorder()
+		beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+			httpCtx.Input.SetData("context", c.NewAdminContext())
+		})
+		beego.BeeApp.Handlers.ServeHTTP(w, r)
+		var output []*model.WebhookDeliverySpec
+		json.Unmarshal(w.Body.Bytes(), &output)
+		assertTestResult(t, w.Code, 200)
+		assertTestResult(t, output, []*model.WebhookDeliverySpec{&SampleWebhookDeliveries[0]})
+	})
+}
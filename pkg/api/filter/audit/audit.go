@@ -0,0 +1,123 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+This module implements the audit trail of the api server. Every mutating
+request is recorded along with the caller, the policy rule authorized and
+the outcome after it's handled, the records are appended to the database.
+
+*/
+
+package audit
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/astaxie/beego"
+	bctx "github.com/astaxie/beego/context"
+	log "github.com/golang/glog"
+	c "github.com/opensds/opensds/pkg/context"
+	"github.com/opensds/opensds/pkg/db"
+	"github.com/opensds/opensds/pkg/model"
+	"github.com/opensds/opensds/pkg/utils/constants"
+)
+
+const (
+	actionKey   = "auditAction"
+	resourceKey = "auditResource"
+)
+
+// SetAction tells the audit filter which policy rule the request is
+// authorized against.
+func SetAction(httpCtx *bctx.Context, action string) {
+	httpCtx.Input.SetData(actionKey, action)
+}
+
+// SetResource tells the audit filter the resource targeted by the request,
+// which is used if there is no resource id in the url.
+func SetResource(httpCtx *bctx.Context, resourceId string) {
+	httpCtx.Input.SetData(resourceKey, resourceId)
+}
+
+// Factory returns the filter recording the mutating requests, it should be
+// inserted at beego.FinishRouter and run even if the output has started.
+func Factory() beego.FilterFunc {
+	return func(httpCtx *bctx.Context) {
+		switch httpCtx.Input.Method() {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			return
+		}
+		if _, err := db.C.CreateAuditRecord(c.NewAdminContext(), NewRecord(httpCtx)); err != nil {
+			log.Errorf("create audit record of %s %s failed: %v", httpCtx.Input.Method(), httpCtx.Input.URL(), err)
+		}
+	}
+}
+
+// NewRecord returns the audit record of the request which has been handled.
+func NewRecord(httpCtx *bctx.Context) *model.AuditRecordSpec {
+	ctx := c.GetContext(httpCtx)
+	action, _ := httpCtx.Input.GetData(actionKey).(string)
+
+	code := httpCtx.ResponseWriter.Status
+	if code == 0 {
+		code = http.StatusOK
+	}
+	outcome := model.AuditOutcomeSuccess
+	switch {
+	case code == http.StatusForbidden:
+		outcome = model.AuditOutcomeDenied
+	case code >= http.StatusBadRequest:
+		outcome = model.AuditOutcomeFailure
+	}
+
+	return &model.AuditRecordSpec{
+		BaseModel: &model.BaseModel{
+			CreatedAt: time.Now().Format(constants.TimeFormat),
+		},
+		TenantId:   ctx.TenantId,
+		UserId:     ctx.UserId,
+		Roles:      ctx.Roles,
+		Action:     action,
+		Method:     httpCtx.Input.Method(),
+		Path:       httpCtx.Input.URL(),
+		ResourceId: resourceId(httpCtx),
+		RequestId:  ctx.RequestId,
+		Outcome:    outcome,
+		StatusCode: code,
+	}
+}
+
+// resourceId returns the last resource id in the url, such as the snapshot
+// id of "/block/volumes/{volumeId}/snapshots/{snapshotId}". The resource
+// set by the controller is used if there is none, which is usually the
+// resource created by the request.
+func resourceId(httpCtx *bctx.Context) string {
+	var id string
+	pos := -1
+	path := httpCtx.Input.URL()
+	for k, v := range httpCtx.Input.Params() {
+		if v == "" || k == ":tenantId" || !strings.HasSuffix(k, "Id") {
+			continue
+		}
+		if i := strings.LastIndex(path, "/"+v); i > pos {
+			id, pos = v, i
+		}
+	}
+	if id == "" {
+		id, _ = httpCtx.Input.GetData(resourceKey).(string)
+	}
+	return id
+}
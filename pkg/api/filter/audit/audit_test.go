@@ -0,0 +1,118 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	bctx "github.com/astaxie/beego/context"
+	c "github.com/opensds/opensds/pkg/context"
+	"github.com/opensds/opensds/pkg/db"
+	"github.com/opensds/opensds/pkg/model"
+	dbtest "github.com/opensds/opensds/testutils/db/testing"
+	"github.com/stretchr/testify/mock"
+)
+
+func newHttpContext(method, url string, params map[string]string) *bctx.Context {
+	r, _ := http.NewRequest(method, url, nil)
+	httpCtx := bctx.NewContext()
+	httpCtx.Reset(httptest.NewRecorder(), r)
+	for k, v := range params {
+		httpCtx.Input.SetParam(k, v)
+	}
+	httpCtx.Input.SetData("context", &c.Context{
+		TenantId:  "tenant",
+		UserId:    "user",
+		Roles:     []string{"member"},
+		RequestId: "request",
+	})
+	return httpCtx
+}
+
+func TestNewRecord(t *testing.T) {
+	httpCtx := newHttpContext("DELETE", "/v1beta/tenant/block/volumes/vol/snapshots/snap", map[string]string{
+		":tenantId":   "tenant",
+		":volumeId":   "vol",
+		":snapshotId": "snap",
+	})
+	SetAction(httpCtx, "snapshot:delete")
+	httpCtx.Output.SetStatus(http.StatusAccepted)
+	httpCtx.Output.Body([]byte("{}"))
+
+	rec := NewRecord(httpCtx)
+	if rec.CreatedAt == "" {
+		t.Error("Expected the time of the record")
+	}
+	rec.BaseModel = nil
+	expected := &model.AuditRecordSpec{
+		TenantId:   "tenant",
+		UserId:     "user",
+		Roles:      []string{"member"},
+		Action:     "snapshot:delete",
+		Method:     "DELETE",
+		Path:       "/v1beta/tenant/block/volumes/vol/snapshots/snap",
+		ResourceId: "snap",
+		RequestId:  "request",
+		Outcome:    model.AuditOutcomeSuccess,
+		StatusCode: http.StatusAccepted,
+	}
+	if !reflect.DeepEqual(rec, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, rec)
+	}
+}
+
+func TestNewRecordOutcome(t *testing.T) {
+	for code, outcome := range map[int]string{
+		http.StatusOK:                  model.AuditOutcomeSuccess,
+		http.StatusForbidden:           model.AuditOutcomeDenied,
+		http.StatusBadRequest:          model.AuditOutcomeFailure,
+		http.StatusInternalServerError: model.AuditOutcomeFailure,
+	} {
+		httpCtx := newHttpContext("POST", "/v1beta/tenant/block/volumes", nil)
+		httpCtx.Output.SetStatus(code)
+		httpCtx.Output.Body([]byte("{}"))
+		if rec := NewRecord(httpCtx); rec.Outcome != outcome {
+			t.Errorf("Expected outcome %s of status %d, got %s", outcome, code, rec.Outcome)
+		}
+	}
+}
+
+func TestNewRecordCreatedResource(t *testing.T) {
+	httpCtx := newHttpContext("POST", "/v1beta/tenant/block/volumes", map[string]string{":tenantId": "tenant"})
+	SetResource(httpCtx, "vol")
+	if rec := NewRecord(httpCtx); rec.ResourceId != "vol" {
+		t.Errorf("Expected resource vol, got %s", rec.ResourceId)
+	}
+}
+
+func TestFactory(t *testing.T) {
+	mockClient := new(dbtest.Client)
+	mockClient.On("CreateAuditRecord", c.NewAdminContext(), mock.Anything).Return(nil, nil)
+	db.C = mockClient
+
+	filter := Factory()
+	filter(newHttpContext("GET", "/v1beta/tenant/block/volumes", nil))
+	mockClient.AssertNotCalled(t, "CreateAuditRecord", mock.Anything, mock.Anything)
+
+	filter(newHttpContext("PUT", "/v1beta/tenant/block/volumes/vol", map[string]string{":volumeId": "vol"}))
+	mockClient.AssertNumberOfCalls(t, "CreateAuditRecord", 1)
+	rec := mockClient.Calls[0].Arguments.Get(1).(*model.AuditRecordSpec)
+	if rec.Method != "PUT" || rec.ResourceId != "vol" {
+		t.Errorf("Unexpected record %+v", rec)
+	}
+}
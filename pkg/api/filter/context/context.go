@@ -18,12 +18,24 @@ import (
 	"github.com/astaxie/beego"
 	bctx "github.com/astaxie/beego/context"
 	c "github.com/opensds/opensds/pkg/context"
+	uuid "github.com/satori/go.uuid"
 )
 
+// RequestIdHeader carries the id of the request, a new id is generated if
+// the client doesn't send one. It's always returned in the response.
+const RequestIdHeader = "X-Request-Id"
+
 func Factory() beego.FilterFunc {
 	return func(httpCtx *bctx.Context) {
+		requestId := httpCtx.Input.Header(RequestIdHeader)
+		if requestId == "" {
+			requestId = uuid.NewV4().String()
+		}
+		httpCtx.Output.Header(RequestIdHeader, requestId)
+
 		c.UpdateContext(httpCtx, map[string]interface{}{
-			"Uri": httpCtx.Input.URI(),
+			"Uri":       httpCtx.Input.URI(),
+			"RequestId": requestId,
 		})
 	}
 }
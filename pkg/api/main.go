@@ -26,6 +26,7 @@ import (
 
 	"github.com/astaxie/beego"
 	"github.com/opensds/opensds/pkg/api/filter/accesslog"
+	"github.com/opensds/opensds/pkg/api/filter/audit"
 	"github.com/opensds/opensds/pkg/api/filter/auth"
 	"github.com/opensds/opensds/pkg/api/filter/context"
 	cfg "github.com/opensds/opensds/pkg/utils/config"
@@ -78,6 +79,9 @@ func Run(apiServerCfg cfg.OsdsApiServer) {
 	beego.InsertFilter(pattern, beego.BeforeExec, context.Factory())
 	beego.InsertFilter(pattern, beego.BeforeExec, auth.Factory())
 	beego.InsertFilter("*", beego.BeforeExec, accesslog.Factory())
+	// The mutating requests are audited after they're handled, whether or not
+	// the response has been written.
+	beego.InsertFilter(pattern, beego.FinishRouter, audit.Factory(), false)
 
 	// start service
 	beego.Run(apiServerCfg.ApiEndpoint)
@@ -24,6 +24,7 @@ import (
 
 	bctx "github.com/astaxie/beego/context"
 	log "github.com/golang/glog"
+	"github.com/opensds/opensds/pkg/api/filter/audit"
 	"github.com/opensds/opensds/pkg/context"
 	"github.com/opensds/opensds/pkg/utils"
 	"github.com/opensds/opensds/pkg/utils/config"
@@ -42,10 +43,30 @@ type DefaultRule struct {
 	CheckStr string
 }
 
+// adminActions are the actions which repair the resources stuck in a
+// transient status or drifting from the backends, they're admin only unless
+// the policy file says else.
+var adminActions = []string{
+	"volume:reset_status", "volume:force_delete",
+	"volume:reset_attachment_status", "volume:force_delete_attachment",
+	"snapshot:reset_status", "snapshot:force_delete",
+	"backup:reset_status", "backup:force_delete",
+	"replication:reset_status", "replication:force_delete",
+	"volume_group:reset_status", "volume_group:force_delete",
+	"fileshare:reset_status", "fileshare:force_delete",
+	"fileshare_snapshot:reset_status", "fileshare_snapshot:force_delete",
+	"fileshare_acl:reset_status", "fileshare_acl:force_delete",
+	"reconcile:get", "reconcile:run",
+}
+
 func listRules() []DefaultRule {
-	return []DefaultRule{
+	rules := []DefaultRule{
 		{Name: "context_is_admin", CheckStr: "role:admin"},
 	}
+	for _, action := range adminActions {
+		rules = append(rules, DefaultRule{Name: action, CheckStr: "rule:admin_api"})
+	}
+	return rules
 }
 
 func RegisterRules(e *Enforcer) {
@@ -185,6 +206,7 @@ func (r *Rules) String() string {
 }
 
 func Authorize(httpCtx *bctx.Context, action string) bool {
+	audit.SetAction(httpCtx, action)
 	if config.CONF.AuthStrategy != "keystone" {
 		return true
 	}
@@ -90,6 +90,11 @@ func TestPolicy(t *testing.T) {
 		"volume:delete":  true,
 		"volume:get":     false,
 		"volume:get_all": true,
+		// The actions repairing the resources are admin only by default.
+		"volume:reset_status": false,
+		"volume:force_delete": false,
+		"reconcile:get":       false,
+		"reconcile:run":       false,
 	}
 	for k, r := range rules.Rules {
 		if strings.Contains(k, ":") {
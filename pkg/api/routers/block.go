@@ -29,18 +29,45 @@ func init() {
 			// Volume is the logical description of a piece of storage, which can be directly used by users.
 			// All operations of volume can be used for both admin and users.
 			beego.NSRouter("/volumes", controllers.NewVolumePortal(), "post:CreateVolume;get:ListVolumes"),
+			// Take over a volume existing in the backend
+			beego.NSRouter("/volumes/manage", controllers.NewVolumePortal(), "post:ManageVolume"),
 			beego.NSRouter("/volumes/:volumeId", controllers.NewVolumePortal(), "get:GetVolume;put:UpdateVolume;delete:DeleteVolume"),
 			// Extend Volume
 			beego.NSRouter("/volumes/:volumeId/resize", controllers.NewVolumePortal(), "post:ExtendVolume"),
+			// Change the profile of volume, or move volume to another pool
+			beego.NSRouter("/volumes/:volumeId/retype", controllers.NewVolumePortal(), "post:RetypeVolume"),
+			beego.NSRouter("/volumes/:volumeId/migrate", controllers.NewVolumePortal(), "post:MigrateVolume"),
+			// Revert volume to one of its snapshots
+			beego.NSRouter("/volumes/:volumeId/revert", controllers.NewVolumePortal(), "post:RevertVolume"),
+			// Remove volume from OpenSDS without deleting its data
+			beego.NSRouter("/volumes/:volumeId/unmanage", controllers.NewVolumePortal(), "post:UnmanageVolume"),
+			// Admin only, repair the volume stuck in a transient status by resetting its status in db,
+			// or by deleting it along with its snapshots, attachments and replication whatever the status is.
+			beego.NSRouter("/volumes/:volumeId/os-reset_status", controllers.NewVolumePortal(), "post:ResetVolumeStatus"),
+			beego.NSRouter("/volumes/:volumeId/force-delete", controllers.NewVolumePortal(), "post:ForceDeleteVolume"),
 
 			// Creates, shows, lists, unpdates and deletes attachment.
 			beego.NSRouter("/attachments", controllers.NewVolumeAttachmentPortal(), "post:CreateVolumeAttachment;get:ListVolumeAttachments"),
 			beego.NSRouter("/attachments/:attachmentId", controllers.NewVolumeAttachmentPortal(), "get:GetVolumeAttachment;put:UpdateVolumeAttachment;delete:DeleteVolumeAttachment"),
+			beego.NSRouter("/attachments/:attachmentId/os-reset_status", controllers.NewVolumeAttachmentPortal(), "post:ResetVolumeAttachmentStatus"),
+			beego.NSRouter("/attachments/:attachmentId/force-delete", controllers.NewVolumeAttachmentPortal(), "post:ForceDeleteVolumeAttachment"),
 
 			// Snapshot is a point-in-time copy of the data that a volume contains.
 			// Creates, shows, lists, unpdates and deletes snapshot.
 			beego.NSRouter("/snapshots", controllers.NewVolumeSnapshotPortal(), "post:CreateVolumeSnapshot;get:ListVolumeSnapshots"),
+			beego.NSRouter("/snapshots/manage", controllers.NewVolumeSnapshotPortal(), "post:ManageVolumeSnapshot"),
 			beego.NSRouter("/snapshots/:snapshotId", controllers.NewVolumeSnapshotPortal(), "get:GetVolumeSnapshot;put:UpdateVolumeSnapshot;delete:DeleteVolumeSnapshot"),
+			beego.NSRouter("/snapshots/:snapshotId/unmanage", controllers.NewVolumeSnapshotPortal(), "post:UnmanageVolumeSnapshot"),
+			beego.NSRouter("/snapshots/:snapshotId/os-reset_status", controllers.NewVolumeSnapshotPortal(), "post:ResetVolumeSnapshotStatus"),
+			beego.NSRouter("/snapshots/:snapshotId/force-delete", controllers.NewVolumeSnapshotPortal(), "post:ForceDeleteVolumeSnapshot"),
+
+			// Backup is a copy of the data that a volume or snapshot contains, which is stored
+			// by the backup driver and can be restored into a new or existing volume.
+			beego.NSRouter("/backups", controllers.NewBackupPortal(), "post:CreateBackup;get:ListBackups"),
+			beego.NSRouter("/backups/:backupId", controllers.NewBackupPortal(), "get:GetBackup;put:UpdateBackup;delete:DeleteBackup"),
+			beego.NSRouter("/backups/:backupId/restore", controllers.NewBackupPortal(), "post:RestoreBackup"),
+			beego.NSRouter("/backups/:backupId/os-reset_status", controllers.NewBackupPortal(), "post:ResetBackupStatus"),
+			beego.NSRouter("/backups/:backupId/force-delete", controllers.NewBackupPortal(), "post:ForceDeleteBackup"),
 
 			// Creates, shows, lists, unpdates and deletes replication.
 			beego.NSRouter("/replications", controllers.NewReplicationPortal(), "post:CreateReplication;get:ListReplications"),
@@ -49,9 +76,15 @@ func init() {
 			beego.NSRouter("/replications/:replicationId/enable", controllers.NewReplicationPortal(), "post:EnableReplication"),
 			beego.NSRouter("/replications/:replicationId/disable", controllers.NewReplicationPortal(), "post:DisableReplication"),
 			beego.NSRouter("/replications/:replicationId/failover", controllers.NewReplicationPortal(), "post:FailoverReplication"),
+			beego.NSRouter("/replications/:replicationId/os-reset_status", controllers.NewReplicationPortal(), "post:ResetReplicationStatus"),
+			beego.NSRouter("/replications/:replicationId/force-delete", controllers.NewReplicationPortal(), "post:ForceDeleteReplication"),
 			// Volume group contains a list of volumes that are used in the same application.
 			beego.NSRouter("/volumeGroups", controllers.NewVolumeGroupPortal(), "post:CreateVolumeGroup;get:ListVolumeGroups"),
 			beego.NSRouter("/volumeGroups/:groupId", controllers.NewVolumeGroupPortal(), "put:UpdateVolumeGroup;get:GetVolumeGroup;delete:DeleteVolumeGroup"),
+			beego.NSRouter("/volumeGroups/:groupId/os-reset_status", controllers.NewVolumeGroupPortal(), "post:ResetVolumeGroupStatus"),
+			beego.NSRouter("/volumeGroups/:groupId/force-delete", controllers.NewVolumeGroupPortal(), "post:ForceDeleteVolumeGroup"),
+			beego.NSRouter("/volumeGroups/:groupId/snapshots", controllers.NewVolumeGroupPortal(), "post:CreateGroupSnapshot;get:ListGroupSnapshots"),
+			beego.NSRouter("/volumeGroups/:groupId/snapshots/:groupSnapshotId", controllers.NewVolumeGroupPortal(), "get:GetGroupSnapshot;delete:DeleteGroupSnapshot"),
 		)
 	beego.AddNamespace(blockns)
 }
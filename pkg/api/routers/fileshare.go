@@ -28,13 +28,21 @@ func init() {
 		beego.NewNamespace("/"+constants.APIVersion+"/:tenantId/file",
 			beego.NSRouter("/shares", controllers.NewFileSharePortal(), "post:CreateFileShare;get:ListFileShares"),
 			beego.NSRouter("/shares/:fileshareId", controllers.NewFileSharePortal(), "get:GetFileShare;put:UpdateFileShare;delete:DeleteFileShare"),
+			// Admin only, repair the resource stuck in a transient status by resetting its status in db,
+			// or by deleting it whatever the status is.
+			beego.NSRouter("/shares/:fileshareId/os-reset_status", controllers.NewFileSharePortal(), "post:ResetFileShareStatus"),
+			beego.NSRouter("/shares/:fileshareId/force-delete", controllers.NewFileSharePortal(), "post:ForceDeleteFileShare"),
 			// Snapshot is a point-in-time copy of the data that a FileShare contains.
 			// Creates, shows, lists, unpdates and deletes snapshot.
 			beego.NSRouter("/snapshots", controllers.NewFileShareSnapshotPortal(), "post:CreateFileShareSnapshot;get:ListFileShareSnapshots"),
 			beego.NSRouter("/snapshots/:snapshotId", controllers.NewFileShareSnapshotPortal(), "get:GetFileShareSnapshot;put:UpdateFileShareSnapshot;delete:DeleteFileShareSnapshot"),
+			beego.NSRouter("/snapshots/:snapshotId/os-reset_status", controllers.NewFileShareSnapshotPortal(), "post:ResetFileShareSnapshotStatus"),
+			beego.NSRouter("/snapshots/:snapshotId/force-delete", controllers.NewFileShareSnapshotPortal(), "post:ForceDeleteFileShareSnapshot"),
       // Access is to set acl's for fileshare
 			beego.NSRouter("/acls", controllers.NewFileSharePortal(), "post:CreateFileShareAcl;get:ListFileSharesAcl"),
 			beego.NSRouter("/acls/:aclId", controllers.NewFileSharePortal(), "get:GetFileShareAcl;delete:DeleteFileShareAcl"),
+			beego.NSRouter("/acls/:aclId/os-reset_status", controllers.NewFileSharePortal(), "post:ResetFileShareAclStatus"),
+			beego.NSRouter("/acls/:aclId/force-delete", controllers.NewFileSharePortal(), "post:ForceDeleteFileShareAcl"),
 		)
 	beego.AddNamespace(filens)
 }
@@ -61,6 +61,31 @@ func init() {
 			beego.NSRouter("/:tenantId/pools", &controllers.PoolPortal{}, "get:ListPools"),
 			beego.NSRouter("/:tenantId/pools/:poolId", &controllers.PoolPortal{}, "get:GetPool"),
 			beego.NSRouter("/:tenantId/availabilityZones", &controllers.PoolPortal{}, "get:ListAvailabilityZones"),
+
+			// Operation is created by every asynchronous request which is accepted by the api server,
+			// it records the progress and the final error of the request.
+			beego.NSRouter("/:tenantId/operations", &controllers.OperationPortal{}, "get:ListOperations"),
+			beego.NSRouter("/:tenantId/operations/:operationId", &controllers.OperationPortal{}, "get:GetOperation"),
+
+			// Quota limits the resources which the tenant can consume, it's set by admin only.
+			beego.NSRouter("/:tenantId/quotas", &controllers.QuotaPortal{}, "get:GetQuota;put:UpdateQuota"),
+
+			// Webhook notifies the tenant of the status transitions of its resources, the events
+			// posted to the webhook are recorded in its delivery log.
+			beego.NSRouter("/:tenantId/webhooks", &controllers.WebhookPortal{}, "post:CreateWebhook;get:ListWebhooks"),
+			beego.NSRouter("/:tenantId/webhooks/:webhookId", &controllers.WebhookPortal{}, "get:GetWebhook;delete:DeleteWebhook"),
+			beego.NSRouter("/:tenantId/webhooks/:webhookId/deliveries", &controllers.WebhookPortal{}, "get:ListWebhookDeliveries"),
+
+			// Audit records every mutating request handled by the api server, it's used for admin only.
+			beego.NSRouter("/:tenantId/audit", &controllers.AuditPortal{}, "get:ListAuditRecords"),
+
+			// Events streams the changes of volumes, snapshots, attachments, replications and pools
+			// as server-sent events, which can be resumed from the resource version of the last one.
+			beego.NSRouter("/:tenantId/events", &controllers.WatchPortal{}, "get:WatchEvents"),
+
+			// Reconcile reports the drifts between the database and the backends found by osdslet,
+			// it's used for admin only.
+			beego.NSRouter("/admin/reconcile", controllers.NewReconcilePortal(), "get:GetReconcileReport;post:StartReconcile"),
 		)
 	beego.AddNamespace(ns)
 
@@ -21,6 +21,7 @@ package util
 import (
 	"errors"
 	"fmt"
+	"net"
 	"strings"
 	"time"
 
@@ -28,17 +29,63 @@ import (
 	c "github.com/opensds/opensds/pkg/context"
 	"github.com/opensds/opensds/pkg/db"
 	"github.com/opensds/opensds/pkg/model"
+	"github.com/opensds/opensds/pkg/quota"
 	"github.com/opensds/opensds/pkg/utils"
 	"github.com/opensds/opensds/pkg/utils/constants"
+	"github.com/opensds/opensds/pkg/volume"
 	uuid "github.com/satori/go.uuid"
 )
 
 //function to store filesahreAcl metadata into database
 func CreateFileShareAclDBEntry(ctx *c.Context, in *model.FileShareAclSpec) (*model.FileShareAclSpec, error) {
+	if in.Type != constants.IpAccess {
+		errMsg := fmt.Sprintf("invalid fileshare acl type: %s, only %s is supported", in.Type, constants.IpAccess)
+		log.Error(errMsg)
+		return nil, errors.New(errMsg)
+	}
+	if len(in.AccessTo) == 0 {
+		var errMsg = "accessTo of fileshare acl can not be empty"
+		log.Error(errMsg)
+		return nil, errors.New(errMsg)
+	}
+	for _, client := range in.AccessTo {
+		if net.ParseIP(client) != nil {
+			continue
+		}
+		if _, _, err := net.ParseCIDR(client); err != nil {
+			errMsg := fmt.Sprintf("invalid accessTo of fileshare acl: %s is neither an ip nor a cidr", client)
+			log.Error(errMsg)
+			return nil, errors.New(errMsg)
+		}
+	}
+	if len(in.AccessCapability) == 0 {
+		var errMsg = "accessCapability of fileshare acl can not be empty"
+		log.Error(errMsg)
+		return nil, errors.New(errMsg)
+	}
+	validCapabilities := []string{constants.Read, constants.Write, constants.Execute}
+	for _, capability := range in.AccessCapability {
+		if !utils.Contained(capability, validCapabilities) {
+			errMsg := fmt.Sprintf("invalid accessCapability of fileshare acl: %s", capability)
+			log.Error(errMsg)
+			return nil, errors.New(errMsg)
+		}
+	}
+
+	fshare, err := db.C.GetFileShare(ctx, in.FileShareId)
+	if err != nil {
+		log.Error("file shareid is not valid: ", err)
+		return nil, err
+	}
+	if fshare.Status != model.FileShareAvailable && fshare.Status != model.FileShareInUse {
+		var errMsg = "only the status of fileshare is available or in-use, the acl can be created"
+		log.Error(errMsg)
+		return nil, errors.New(errMsg)
+	}
+
 	if in.Id == "" {
 		in.Id = uuid.NewV4().String()
 	}
-
 	if in.CreatedAt == "" {
 		in.CreatedAt = time.Now().Format(constants.TimeFormat)
 	}
@@ -46,19 +93,35 @@ func CreateFileShareAclDBEntry(ctx *c.Context, in *model.FileShareAclSpec) (*mod
 		in.UpdatedAt = time.Now().Format(constants.TimeFormat)
 	}
 
-	in.Description = in.Description
+	in.Status = model.FileShareAclCreating
+	// Store the fileshare acl metadata into database.
+	return db.C.CreateFileShareAcl(ctx, in)
+}
 
-	in.Type = in.Type
-	in.AccessTo = in.AccessTo
-	in.AccessCapability = in.AccessCapability
-	_, err := db.C.GetFileShare(ctx, in.FileShareId)
-	if err != nil {
-		log.Error("file shareid is not valid: ", err)
-		return nil, err
+func DeleteFileShareAclDBEntry(ctx *c.Context, in *model.FileShareAclSpec) error {
+	validStatus := []string{model.FileShareAclAvailable, model.FileShareAclError,
+		model.FileShareAclErrorDeleting}
+	if !utils.Contained(in.Status, validStatus) {
+		errMsg := fmt.Sprintf("only the fileshare acl with the status available, error, error_deleting can be deleted, the fileshare acl status is %s", in.Status)
+		log.Error(errMsg)
+		return errors.New(errMsg)
 	}
-	in.FileShareId = in.FileShareId
-	// Store the fileshare meadata into database.
-	return db.C.CreateFileShareAcl(ctx, in)
+
+	// If fileshare id is invalid, the acl can't be exported any more, so
+	// delete its db entry directly.
+	if _, err := db.C.GetFileShare(ctx, in.FileShareId); err != nil {
+		if err := db.C.DeleteFileShareAcl(ctx, in.Id); err != nil {
+			log.Error("when delete fileshare acl in db:", err)
+			return err
+		}
+		return nil
+	}
+
+	in.Status = model.FileShareAclDeleting
+	if _, err := db.C.UpdateFileShareAcl(ctx, in); err != nil {
+		return err
+	}
+	return nil
 }
 
 // Function to store metadeta of fileshare into database
@@ -88,8 +151,17 @@ func CreateFileShareDBEntry(ctx *c.Context, in *model.FileShareSpec) (*model.Fil
 	in.UserId = ctx.UserId
 	in.Status = model.FileShareCreating
 	in.ExportLocations = in.ExportLocations
+	if err := quota.Reserve(ctx, ctx.TenantId, in.Id, model.QuotaSet{FileShares: 1, Capacity: in.Size}); err != nil {
+		log.Error("reserve quota failed in create fileshare method: ", err)
+		return nil, err
+	}
 	// Store the fileshare meadata into database.
-	return db.C.CreateFileShare(ctx, in)
+	result, err := db.C.CreateFileShare(ctx, in)
+	if err != nil {
+		quota.Rollback(ctx, ctx.TenantId, in.Id)
+		return nil, err
+	}
+	return result, nil
 }
 
 // DeleteFileShareDBEntry just modifies the state of the fileshare to be deleting in
@@ -172,6 +244,11 @@ func CreateVolumeDBEntry(ctx *c.Context, in *model.VolumeSpec) (*model.VolumeSpe
 		log.Error(errMsg)
 		return nil, errors.New(errMsg)
 	}
+	if in.SnapshotId != "" && in.SourceVolumeId != "" {
+		var errMsg = "snapshotId and sourceVolumeId can not be specified at the same time"
+		log.Error(errMsg)
+		return nil, errors.New(errMsg)
+	}
 	if in.SnapshotId != "" {
 		snap, err := db.C.GetVolumeSnapshot(ctx, in.SnapshotId)
 		if err != nil {
@@ -189,6 +266,23 @@ func CreateVolumeDBEntry(ctx *c.Context, in *model.VolumeSpec) (*model.VolumeSpe
 			return nil, errors.New(errMsg)
 		}
 	}
+	if in.SourceVolumeId != "" {
+		srcVol, err := db.C.GetVolume(ctx, in.SourceVolumeId)
+		if err != nil {
+			log.Error("get source volume failed in create volume method: ", err)
+			return nil, err
+		}
+		if srcVol.Status != model.VolumeAvailable {
+			var errMsg = "only if the source volume is available, the volume can be cloned"
+			log.Error(errMsg)
+			return nil, errors.New(errMsg)
+		}
+		if srcVol.Size > in.Size {
+			var errMsg = "size of volume must be equal to or bigger than size of the source volume"
+			log.Error(errMsg)
+			return nil, errors.New(errMsg)
+		}
+	}
 	if in.AvailabilityZone == "" {
 		log.Warning("Use default availability zone when user doesn't specify availabilityZone.")
 		in.AvailabilityZone = "default"
@@ -199,8 +293,17 @@ func CreateVolumeDBEntry(ctx *c.Context, in *model.VolumeSpec) (*model.VolumeSpe
 
 	in.UserId = ctx.UserId
 	in.Status = model.VolumeCreating
+	if err := quota.Reserve(ctx, ctx.TenantId, in.Id, model.QuotaSet{Volumes: 1, Capacity: in.Size}); err != nil {
+		log.Error("reserve quota failed in create volume method: ", err)
+		return nil, err
+	}
 	// Store the volume data into database.
-	return db.C.CreateVolume(ctx, in)
+	result, err := db.C.CreateVolume(ctx, in)
+	if err != nil {
+		quota.Rollback(ctx, ctx.TenantId, in.Id)
+		return nil, err
+	}
+	return result, nil
 }
 
 // DeleteVolumeDBEntry just modifies the state of the volume to be deleting in
@@ -260,9 +363,249 @@ func ExtendVolumeDBEntry(ctx *c.Context, volID string, in *model.ExtendVolumeSpe
 		return nil, errors.New(errMsg)
 	}
 
+	// The reservation is committed or rolled back by the controller once the
+	// volume is extended.
+	if err = quota.Reserve(ctx, volume.TenantId, volume.Id, model.QuotaSet{Capacity: in.NewSize - volume.Size}); err != nil {
+		log.Error("reserve quota failed in extend volume method: ", err)
+		return nil, err
+	}
+
 	volume.Status = model.VolumeExtending
 	// Store the volume data into database.
-	return db.C.ExtendVolume(ctx, volume)
+	result, err := db.C.ExtendVolume(ctx, volume)
+	if err != nil {
+		quota.Rollback(ctx, volume.TenantId, volume.Id)
+		return nil, err
+	}
+	return result, nil
+}
+
+// checkVolumeMovable checks whether the data of the volume can be moved to
+// another pool, the snapshots and group of the volume can't follow it.
+func checkVolumeMovable(ctx *c.Context, volume *model.VolumeSpec) error {
+	if volume.Status != model.VolumeAvailable {
+		return fmt.Errorf("the status of the volume must be available, the volume status is %s", volume.Status)
+	}
+	if volume.GroupId != "" {
+		return fmt.Errorf("volume %s can not be moved, because it belongs to group %s", volume.Id, volume.GroupId)
+	}
+
+	snaps, err := db.C.ListSnapshotsByVolumeId(ctx, volume.Id)
+	if err != nil {
+		return err
+	}
+	if len(snaps) > 0 {
+		return fmt.Errorf("volume %s can not be moved, because it still has snapshots", volume.Id)
+	}
+	return nil
+}
+
+// RetypeVolumeDBEntry just modifies the state of the volume to be retyping in
+// the DB, the real operation would be executed in another new thread, and the
+// new profile would be updated in controller module.
+func RetypeVolumeDBEntry(ctx *c.Context, volume *model.VolumeSpec, in *model.RetypeVolumeSpec) (*model.VolumeSpec, error) {
+	if in.ProfileId == "" {
+		errMsg := "profile id of the retype request can not be empty"
+		log.Error(errMsg)
+		return nil, errors.New(errMsg)
+	}
+	if in.ProfileId == volume.ProfileId {
+		errMsg := fmt.Sprintf("volume %s already has profile %s", volume.Id, in.ProfileId)
+		log.Error(errMsg)
+		return nil, errors.New(errMsg)
+	}
+	if in.MigrationPolicy == "" {
+		in.MigrationPolicy = model.MigrationPolicyOnDemand
+	}
+	validPolicy := []string{model.MigrationPolicyNever, model.MigrationPolicyOnDemand}
+	if !utils.Contained(in.MigrationPolicy, validPolicy) {
+		errMsg := fmt.Sprintf("invalid migration policy %s, it should be one of %v", in.MigrationPolicy, validPolicy)
+		log.Error(errMsg)
+		return nil, errors.New(errMsg)
+	}
+	if _, err := db.C.GetProfile(ctx, in.ProfileId); err != nil {
+		log.Error("get profile failed in retype volume method: ", err)
+		return nil, err
+	}
+	if in.PoolId != "" {
+		if _, err := db.C.GetPool(ctx, in.PoolId); err != nil {
+			log.Error("get pool failed in retype volume method: ", err)
+			return nil, err
+		}
+	}
+	if err := checkVolumeMovable(ctx, volume); err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	volume.Status = model.VolumeRetyping
+	return db.C.UpdateVolume(ctx, volume)
+}
+
+// MigrateVolumeDBEntry just modifies the state of the volume to be migrating
+// in the DB, the real operation would be executed in another new thread, and
+// the new pool would be updated in controller module.
+func MigrateVolumeDBEntry(ctx *c.Context, volume *model.VolumeSpec, in *model.MigrateVolumeSpec) (*model.VolumeSpec, error) {
+	if in.PoolId != "" {
+		if in.PoolId == volume.PoolId {
+			errMsg := fmt.Sprintf("volume %s is already placed in pool %s", volume.Id, in.PoolId)
+			log.Error(errMsg)
+			return nil, errors.New(errMsg)
+		}
+		if _, err := db.C.GetPool(ctx, in.PoolId); err != nil {
+			log.Error("get pool failed in migrate volume method: ", err)
+			return nil, err
+		}
+	}
+	if err := checkVolumeMovable(ctx, volume); err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	volume.Status = model.VolumeMigrating
+	return db.C.UpdateVolume(ctx, volume)
+}
+
+// RevertVolumeDBEntry just modifies the state of the volume to be reverting
+// in the DB, the real operation would be executed in another new thread. The
+// volume which is attached can only be reverted by force, so does the one
+// reverted to a snapshot other than its latest one.
+func RevertVolumeDBEntry(ctx *c.Context, volume *model.VolumeSpec, in *model.RevertVolumeSpec) (*model.VolumeSpec, error) {
+	if in.SnapshotId == "" {
+		errMsg := "snapshot id of the volume to be reverted to can not be empty"
+		log.Error(errMsg)
+		return nil, errors.New(errMsg)
+	}
+	snap, err := db.C.GetVolumeSnapshot(ctx, in.SnapshotId)
+	if err != nil {
+		log.Error("get snapshot failed in revert volume method: ", err)
+		return nil, err
+	}
+	if snap.VolumeId != volume.Id {
+		errMsg := fmt.Sprintf("snapshot %s doesn't belong to volume %s", snap.Id, volume.Id)
+		log.Error(errMsg)
+		return nil, errors.New(errMsg)
+	}
+	if snap.Status != model.VolumeSnapAvailable {
+		errMsg := fmt.Sprintf("only the volume snapshot with the status available can be reverted to, the volume snapshot status is %s", snap.Status)
+		log.Error(errMsg)
+		return nil, errors.New(errMsg)
+	}
+
+	switch volume.Status {
+	case model.VolumeAvailable:
+	case model.VolumeInUse:
+		if !in.Force {
+			errMsg := fmt.Sprintf("volume %s is attached, it can only be reverted by force", volume.Id)
+			log.Error(errMsg)
+			return nil, errors.New(errMsg)
+		}
+	default:
+		errMsg := fmt.Sprintf("only the volume with the status available or in-use can be reverted, the volume status is %s", volume.Status)
+		log.Error(errMsg)
+		return nil, errors.New(errMsg)
+	}
+
+	if !in.Force {
+		snaps, err := db.C.ListSnapshotsByVolumeId(ctx, volume.Id)
+		if err != nil {
+			log.Error("list snapshots failed in revert volume method: ", err)
+			return nil, err
+		}
+		for _, s := range snaps {
+			if s.Id != snap.Id && s.CreatedAt > snap.CreatedAt {
+				errMsg := fmt.Sprintf("snapshot %s isn't the latest snapshot of volume %s, it can only be reverted to by force", snap.Id, volume.Id)
+				log.Error(errMsg)
+				return nil, errors.New(errMsg)
+			}
+		}
+	}
+
+	volume.Status = model.VolumeReverting
+	return db.C.UpdateVolume(ctx, volume)
+}
+
+// ManageVolumeDBEntry stores the volume to be taken over into database and
+// initializes its status as "managing", the size of the volume is updated
+// by the controller once the volume is found in the backend.
+func ManageVolumeDBEntry(ctx *c.Context, in *model.ManageVolumeSpec) (*model.VolumeSpec, error) {
+	if in.Identifier == "" {
+		errMsg := "identifier of the volume to be managed can not be empty"
+		log.Error(errMsg)
+		return nil, errors.New(errMsg)
+	}
+	if in.PoolId == "" {
+		errMsg := "pool id of the volume to be managed can not be empty"
+		log.Error(errMsg)
+		return nil, errors.New(errMsg)
+	}
+	pool, err := db.C.GetPool(ctx, in.PoolId)
+	if err != nil {
+		log.Error("get pool failed in manage volume method: ", err)
+		return nil, err
+	}
+
+	vol := &model.VolumeSpec{
+		BaseModel: &model.BaseModel{
+			Id:        uuid.NewV4().String(),
+			CreatedAt: time.Now().Format(constants.TimeFormat),
+		},
+		Name:             in.Name,
+		Description:      in.Description,
+		AvailabilityZone: pool.AvailabilityZone,
+		PoolId:           in.PoolId,
+		ProfileId:        in.ProfileId,
+		Metadata:         in.Metadata,
+		UserId:           ctx.UserId,
+		Status:           model.VolumeManaging,
+	}
+	if vol.Name == "" {
+		vol.Name = in.Identifier
+	}
+	if vol.AvailabilityZone == "" {
+		vol.AvailabilityZone = "default"
+	}
+
+	// The capacity is reserved by the controller since the size of the
+	// volume is unknown here.
+	if err = quota.Reserve(ctx, ctx.TenantId, vol.Id, model.QuotaSet{Volumes: 1}); err != nil {
+		log.Error("reserve quota failed in manage volume method: ", err)
+		return nil, err
+	}
+	result, err := db.C.CreateVolume(ctx, vol)
+	if err != nil {
+		quota.Rollback(ctx, ctx.TenantId, vol.Id)
+		return nil, err
+	}
+	return result, nil
+}
+
+// UnmanageVolumeDBEntry just modifies the state of the volume to be
+// unmanaging in the DB, the volume entry would be removed in another new
+// thread while the data of the volume is kept in the backend.
+func UnmanageVolumeDBEntry(ctx *c.Context, volume *model.VolumeSpec) error {
+	if volume.Status != model.VolumeAvailable {
+		errMsg := fmt.Sprintf("only the volume with the status available can be unmanaged, the volume status is %s", volume.Status)
+		log.Error(errMsg)
+		return errors.New(errMsg)
+	}
+	if volume.GroupId != "" {
+		errMsg := fmt.Sprintf("volume %s can not be unmanaged, because it belongs to group %s", volume.Id, volume.GroupId)
+		log.Error(errMsg)
+		return errors.New(errMsg)
+	}
+
+	snaps, err := db.C.ListSnapshotsByVolumeId(ctx, volume.Id)
+	if err != nil {
+		return err
+	}
+	if len(snaps) > 0 {
+		return fmt.Errorf("volume %s can not be unmanaged, because it still has snapshots", volume.Id)
+	}
+
+	volume.Status = model.VolumeUnmanaging
+	_, err = db.C.UpdateVolume(ctx, volume)
+	return err
 }
 
 func CreateVolumeAttachmentDBEntry(ctx *c.Context, volAttachment *model.VolumeAttachmentSpec) (*model.VolumeAttachmentSpec, error) {
@@ -306,14 +649,114 @@ func CreateVolumeAttachmentDBEntry(ctx *c.Context, volAttachment *model.VolumeAt
 	return db.C.CreateVolumeAttachment(ctx, volAttachment)
 }
 
+// CreateVolumeSnapshotDBEntry stores the volume snapshot into database with
+// the status "creating", see volume.CreateSnapshotDBEntry.
 func CreateVolumeSnapshotDBEntry(ctx *c.Context, in *model.VolumeSnapshotSpec) (*model.VolumeSnapshotSpec, error) {
+	return volume.CreateSnapshotDBEntry(ctx, in)
+}
+
+// DeleteVolumeSnapshotDBEntry just modifies the state of the volume snapshot to
+// be deleting in the DB, see volume.DeleteSnapshotDBEntry.
+func DeleteVolumeSnapshotDBEntry(ctx *c.Context, in *model.VolumeSnapshotSpec) error {
+	return volume.DeleteSnapshotDBEntry(ctx, in)
+}
+
+// ManageVolumeSnapshotDBEntry stores the volume snapshot to be taken over
+// into database and initializes its status as "managing".
+func ManageVolumeSnapshotDBEntry(ctx *c.Context, in *model.ManageVolumeSnapshotSpec) (*model.VolumeSnapshotSpec, error) {
+	if in.Identifier == "" {
+		errMsg := "identifier of the volume snapshot to be managed can not be empty"
+		log.Error(errMsg)
+		return nil, errors.New(errMsg)
+	}
 	vol, err := db.C.GetVolume(ctx, in.VolumeId)
 	if err != nil {
-		log.Error("get volume failed in create volume snapshot method: ", err)
+		log.Error("get volume failed in manage volume snapshot method: ", err)
 		return nil, err
 	}
 	if vol.Status != model.VolumeAvailable && vol.Status != model.VolumeInUse {
-		var errMsg = "only the status of volume is available or in-use, the snapshot can be created"
+		var errMsg = "only the status of volume is available or in-use, the snapshot can be managed"
+		log.Error(errMsg)
+		return nil, errors.New(errMsg)
+	}
+
+	snap := &model.VolumeSnapshotSpec{
+		BaseModel: &model.BaseModel{
+			Id:        uuid.NewV4().String(),
+			CreatedAt: time.Now().Format(constants.TimeFormat),
+		},
+		Name:        in.Name,
+		Description: in.Description,
+		VolumeId:    vol.Id,
+		ProfileId:   vol.ProfileId,
+		Size:        vol.Size,
+		Metadata:    in.Metadata,
+		UserId:      ctx.UserId,
+		Status:      model.VolumeSnapManaging,
+	}
+	if snap.Name == "" {
+		snap.Name = in.Identifier
+	}
+
+	if err = quota.Reserve(ctx, ctx.TenantId, snap.Id, model.QuotaSet{Snapshots: 1, Capacity: vol.Size}); err != nil {
+		log.Error("reserve quota failed in manage volume snapshot method: ", err)
+		return nil, err
+	}
+	result, err := db.C.CreateVolumeSnapshot(ctx, snap)
+	if err != nil {
+		quota.Rollback(ctx, ctx.TenantId, snap.Id)
+		return nil, err
+	}
+	return result, nil
+}
+
+// UnmanageVolumeSnapshotDBEntry just modifies the state of the volume
+// snapshot to be unmanaging in the DB, the snapshot entry would be removed in
+// another new thread while the data of the snapshot is kept in the backend.
+func UnmanageVolumeSnapshotDBEntry(ctx *c.Context, in *model.VolumeSnapshotSpec) error {
+	if in.Status != model.VolumeSnapAvailable {
+		errMsg := fmt.Sprintf("only the volume snapshot with the status available can be unmanaged, the volume snapshot status is %s", in.Status)
+		log.Error(errMsg)
+		return errors.New(errMsg)
+	}
+
+	in.Status = model.VolumeSnapUnmanaging
+	_, err := db.C.UpdateVolumeSnapshot(ctx, in.Id, in)
+	return err
+}
+
+// CreateBackupDBEntry stores the volume backup into database and initializes
+// its status as "creating". The backup will be taken from the snapshot if the
+// snapshot id is specified, otherwise from the volume directly.
+func CreateBackupDBEntry(ctx *c.Context, in *model.BackupSpec) (*model.BackupSpec, error) {
+	if in.SnapshotId != "" {
+		snap, err := db.C.GetVolumeSnapshot(ctx, in.SnapshotId)
+		if err != nil {
+			log.Error("get snapshot failed in create volume backup method: ", err)
+			return nil, err
+		}
+		if snap.Status != model.VolumeSnapAvailable {
+			var errMsg = "only if the snapshot is available, the backup can be created"
+			log.Error(errMsg)
+			return nil, errors.New(errMsg)
+		}
+		if in.VolumeId == "" {
+			in.VolumeId = snap.VolumeId
+		}
+		if in.VolumeId != snap.VolumeId {
+			errMsg := fmt.Sprintf("snapshot %s doesn't belong to volume %s", in.SnapshotId, in.VolumeId)
+			log.Error(errMsg)
+			return nil, errors.New(errMsg)
+		}
+	}
+
+	vol, err := db.C.GetVolume(ctx, in.VolumeId)
+	if err != nil {
+		log.Error("get volume failed in create volume backup method: ", err)
+		return nil, err
+	}
+	if in.SnapshotId == "" && vol.Status != model.VolumeAvailable {
+		var errMsg = "only if the volume is available, the backup can be created"
 		log.Error(errMsg)
 		return nil, errors.New(errMsg)
 	}
@@ -325,41 +768,97 @@ func CreateVolumeSnapshotDBEntry(ctx *c.Context, in *model.VolumeSnapshotSpec) (
 		in.CreatedAt = time.Now().Format(constants.TimeFormat)
 	}
 
-	in.Status = model.VolumeSnapCreating
-	return db.C.CreateVolumeSnapshot(ctx, in)
+	in.Size = vol.Size
+	in.PoolId = vol.PoolId
+	in.Status = model.BackupCreating
+	return db.C.CreateBackup(ctx, in)
 }
 
-// DeleteVolumeSnapshotDBEntry just modifies the state of the volume snapshot to
-// be deleting in the DB, the real deletion operation would be executed in
+// DeleteBackupDBEntry just modifies the state of the volume backup to be
+// deleting in the DB, the real deletion operation would be executed in
 // another new thread.
-func DeleteVolumeSnapshotDBEntry(ctx *c.Context, in *model.VolumeSnapshotSpec) error {
-	validStatus := []string{model.VolumeSnapAvailable, model.VolumeSnapError,
-		model.VolumeSnapErrorDeleting}
+func DeleteBackupDBEntry(ctx *c.Context, in *model.BackupSpec) error {
+	validStatus := []string{model.BackupAvailable, model.BackupError,
+		model.BackupErrorDeleting}
 	if !utils.Contained(in.Status, validStatus) {
-		errMsg := fmt.Sprintf("only the volume snapshot with the status available, error, error_deleting can be deleted, the volume status is %s", in.Status)
+		errMsg := fmt.Sprintf("only the volume backup with the status available, error, errorDeleting can be deleted, the volume backup status is %s", in.Status)
 		log.Error(errMsg)
 		return errors.New(errMsg)
 	}
 
-	// If volume id is invalid, it would mean that volume snapshot creation failed before the create method
-	// in storage driver was called, and delete its db entry directly.
-	_, err := db.C.GetVolume(ctx, in.VolumeId)
-	if err != nil {
-		if err := db.C.DeleteVolumeSnapshot(ctx, in.Id); err != nil {
-			log.Error("when delete volume snapshot in db:", err)
+	// If backup driver is empty, it would mean that backup creation failed
+	// before any data was stored, and delete its db entry directly.
+	if in.BackupDriver == "" {
+		if err := db.C.DeleteBackup(ctx, in.Id); err != nil {
+			log.Error("when delete volume backup in db:", err)
 			return err
 		}
 		return nil
 	}
 
-	in.Status = model.VolumeSnapDeleting
-	_, err = db.C.UpdateVolumeSnapshot(ctx, in.Id, in)
+	in.Status = model.BackupDeleting
+	_, err := db.C.UpdateBackup(ctx, in.Id, in)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
+// RestoreBackupDBEntry checks whether the volume backup can be restored into
+// the volume specified by the restore request, and modifies the state of the
+// volume backup to be restoring. If no volume is specified, a new volume
+// entry will be created in the DB with the size of the backup.
+func RestoreBackupDBEntry(ctx *c.Context, backup *model.BackupSpec, in *model.RestoreBackupSpec) (*model.VolumeSpec, error) {
+	if backup.Status != model.BackupAvailable {
+		errMsg := fmt.Sprintf("only the volume backup with the status available can be restored, the volume backup status is %s", backup.Status)
+		log.Error(errMsg)
+		return nil, errors.New(errMsg)
+	}
+
+	var vol *model.VolumeSpec
+	var err error
+	if in.VolumeId != "" {
+		if vol, err = db.C.GetVolume(ctx, in.VolumeId); err != nil {
+			log.Error("get volume failed in restore volume backup method: ", err)
+			return nil, err
+		}
+		if vol.Status != model.VolumeAvailable {
+			var errMsg = "only if the volume is available, the backup can be restored into it"
+			log.Error(errMsg)
+			return nil, errors.New(errMsg)
+		}
+		if backup.Size > vol.Size {
+			var errMsg = "size of volume must be equal to or bigger than size of the backup"
+			log.Error(errMsg)
+			return nil, errors.New(errMsg)
+		}
+		if err = db.C.UpdateStatus(ctx, vol, model.VolumeRestoring); err != nil {
+			return nil, err
+		}
+	} else {
+		var name = in.Name
+		if name == "" {
+			name = "restore-" + backup.Id
+		}
+		vol, err = CreateVolumeDBEntry(ctx, &model.VolumeSpec{
+			BaseModel:        &model.BaseModel{},
+			Name:             name,
+			Description:      "Volume restored from backup " + backup.Id,
+			Size:             backup.Size,
+			ProfileId:        in.ProfileId,
+			AvailabilityZone: in.AvailabilityZone,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err = db.C.UpdateStatus(ctx, backup, model.BackupRestoring); err != nil {
+		return nil, err
+	}
+	return vol, nil
+}
+
 func CreateReplicationDBEntry(ctx *c.Context, in *model.ReplicationSpec) (*model.ReplicationSpec, error) {
 	pVol, err := db.C.GetVolume(ctx, in.PrimaryVolumeId)
 	if err != nil {
@@ -509,6 +1008,9 @@ func FailoverReplicationDBEntry(ctx *c.Context, in *model.ReplicationSpec, secon
 }
 
 func CreateVolumeGroupDBEntry(ctx *c.Context, in *model.VolumeGroupSpec) (*model.VolumeGroupSpec, error) {
+	if in.GroupSnapshotId != "" {
+		return createVolumeGroupFromSnapshotDBEntry(ctx, in)
+	}
 	if len(in.Profiles) == 0 {
 		msg := fmt.Sprintf("profiles must be provided to create volume group.")
 		log.Error(msg)
@@ -530,6 +1032,86 @@ func CreateVolumeGroupDBEntry(ctx *c.Context, in *model.VolumeGroupSpec) (*model
 	return db.C.CreateVolumeGroup(ctx, in)
 }
 
+// createVolumeGroupFromSnapshotDBEntry stores the volume group to be created
+// from the group snapshot along with its volumes, each of which is created
+// from a member snapshot. The group inherits the profiles and the pool of the
+// source group, and the volumes are added into it by the controller.
+func createVolumeGroupFromSnapshotDBEntry(ctx *c.Context, in *model.VolumeGroupSpec) (*model.VolumeGroupSpec, error) {
+	gs, err := db.C.GetVolumeGroupSnapshot(ctx, in.GroupSnapshotId)
+	if err != nil {
+		log.Error("get group snapshot failed in create volume group method: ", err)
+		return nil, err
+	}
+	if gs.Status != model.VolumeGroupSnapAvailable {
+		var errMsg = "only if the group snapshot is available, the volume group can be created"
+		log.Error(errMsg)
+		return nil, errors.New(errMsg)
+	}
+	srcVg, err := db.C.GetVolumeGroup(ctx, gs.GroupId)
+	if err != nil {
+		log.Error("get source volume group failed in create volume group method: ", err)
+		return nil, err
+	}
+	if len(in.Profiles) == 0 {
+		in.Profiles = srcVg.Profiles
+	}
+	in.PoolId = srcVg.PoolId
+	if in.AvailabilityZone == "" {
+		in.AvailabilityZone = srcVg.AvailabilityZone
+	}
+	if in.Id == "" {
+		in.Id = uuid.NewV4().String()
+	}
+
+	// Remove the volumes already stored if any of them can't be created.
+	var vols []*model.VolumeSpec
+	defer func() {
+		if err != nil {
+			for _, vol := range vols {
+				db.C.DeleteVolume(ctx, vol.Id)
+				quota.Rollback(ctx, ctx.TenantId, vol.Id)
+			}
+		}
+	}()
+	in.AddVolumes = nil
+	for _, snpId := range gs.Snapshots {
+		var snp *model.VolumeSnapshotSpec
+		if snp, err = db.C.GetVolumeSnapshot(ctx, snpId); err != nil {
+			log.Error("get member snapshot failed in create volume group method: ", err)
+			return nil, err
+		}
+		profileId := snp.ProfileId
+		if profileId == "" && len(in.Profiles) > 0 {
+			profileId = in.Profiles[0]
+		}
+		var vol *model.VolumeSpec
+		if vol, err = CreateVolumeDBEntry(ctx, &model.VolumeSpec{
+			BaseModel:        &model.BaseModel{},
+			Name:             snp.Name,
+			Description:      snp.Description,
+			Size:             snp.Size,
+			SnapshotId:       snp.Id,
+			ProfileId:        profileId,
+			PoolId:           in.PoolId,
+			AvailabilityZone: in.AvailabilityZone,
+		}); err != nil {
+			return nil, err
+		}
+		vols = append(vols, vol)
+		in.AddVolumes = append(in.AddVolumes, vol.Id)
+	}
+
+	if in.CreatedAt == "" {
+		in.CreatedAt = time.Now().Format(constants.TimeFormat)
+	}
+	in.Status = model.VolumeGroupCreating
+	result, err := db.C.CreateVolumeGroup(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func UpdateVolumeGroupDBEntry(ctx *c.Context, vgUpdate *model.VolumeGroupSpec) (*model.VolumeGroupSpec, error) {
 	vg, err := db.C.GetVolumeGroup(ctx, vgUpdate.Id)
 	if err != nil {
@@ -713,7 +1295,11 @@ func DeleteVolumeGroupDBEntry(ctx *c.Context, volumeGroupId string) error {
 		return errors.New(msg)
 	}
 
-	if vg.GroupSnapshots != nil {
+	groupSnapshots, err := db.C.ListGroupSnapshotsByGroupId(ctx, vg.Id)
+	if err != nil {
+		return err
+	}
+	if len(groupSnapshots) > 0 {
 		msg := fmt.Sprintf("group can not be deleted, because group has existing snapshots")
 		log.Error(msg)
 		return errors.New(msg)
@@ -762,3 +1348,395 @@ func DeleteVolumeGroupDBEntry(ctx *c.Context, volumeGroupId string) error {
 
 	return nil
 }
+
+// CreateVolumeGroupSnapshotDBEntry stores the group snapshot along with the
+// snapshots of all the volumes in the group into database, and initializes
+// their statuses as "creating". The member snapshots are returned so that
+// they can be sent to the controller.
+func CreateVolumeGroupSnapshotDBEntry(ctx *c.Context, in *model.VolumeGroupSnapshotSpec) (*model.VolumeGroupSnapshotSpec, []*model.VolumeSnapshotSpec, error) {
+	vg, err := db.C.GetVolumeGroup(ctx, in.GroupId)
+	if err != nil {
+		log.Error("get volume group failed in create group snapshot method: ", err)
+		return nil, nil, err
+	}
+	if vg.Status != model.VolumeGroupAvailable {
+		var errMsg = "only the status of volume group is available, the group snapshot can be created"
+		log.Error(errMsg)
+		return nil, nil, errors.New(errMsg)
+	}
+	volumes, err := db.C.ListVolumesByGroupId(ctx, vg.Id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(volumes) == 0 {
+		var errMsg = fmt.Sprintf("volume group %s doesn't contain any volume", vg.Id)
+		log.Error(errMsg)
+		return nil, nil, errors.New(errMsg)
+	}
+	for _, vol := range volumes {
+		if vol.Status != model.VolumeAvailable && vol.Status != model.VolumeInUse {
+			var errMsg = fmt.Sprintf("volume %s in group is %s, only if all the volumes are available or in-use, the group snapshot can be created", vol.Id, vol.Status)
+			log.Error(errMsg)
+			return nil, nil, errors.New(errMsg)
+		}
+	}
+
+	if in.Id == "" {
+		in.Id = uuid.NewV4().String()
+	}
+	if in.CreatedAt == "" {
+		in.CreatedAt = time.Now().Format(constants.TimeFormat)
+	}
+
+	// Remove the member snapshots already stored if any of them can't be
+	// stored.
+	var snps []*model.VolumeSnapshotSpec
+	defer func() {
+		if err != nil {
+			for _, snp := range snps {
+				db.C.DeleteVolumeSnapshot(ctx, snp.Id)
+				quota.Rollback(ctx, ctx.TenantId, snp.Id)
+			}
+		}
+	}()
+	in.Snapshots = nil
+	for _, vol := range volumes {
+		snp := &model.VolumeSnapshotSpec{
+			BaseModel: &model.BaseModel{
+				Id:        uuid.NewV4().String(),
+				CreatedAt: in.CreatedAt,
+			},
+			Name:            in.Name,
+			Description:     in.Description,
+			Size:            vol.Size,
+			VolumeId:        vol.Id,
+			ProfileId:       vol.ProfileId,
+			GroupSnapshotId: in.Id,
+			Status:          model.VolumeSnapCreating,
+		}
+		if err = quota.Reserve(ctx, ctx.TenantId, snp.Id, model.QuotaSet{Snapshots: 1, Capacity: vol.Size}); err != nil {
+			log.Error("reserve quota failed in create group snapshot method: ", err)
+			return nil, nil, err
+		}
+		var result *model.VolumeSnapshotSpec
+		if result, err = db.C.CreateVolumeSnapshot(ctx, snp); err != nil {
+			quota.Rollback(ctx, ctx.TenantId, snp.Id)
+			return nil, nil, err
+		}
+		snps = append(snps, result)
+		in.Snapshots = append(in.Snapshots, result.Id)
+	}
+
+	in.Status = model.VolumeGroupSnapCreating
+	result, err := db.C.CreateVolumeGroupSnapshot(ctx, in)
+	if err != nil {
+		return nil, nil, err
+	}
+	return result, snps, nil
+}
+
+// DeleteVolumeGroupSnapshotDBEntry just modifies the states of the group
+// snapshot and its member snapshots to be deleting in the DB, and returns the
+// member snapshots. The real deletion operation would be executed in another
+// new thread.
+func DeleteVolumeGroupSnapshotDBEntry(ctx *c.Context, in *model.VolumeGroupSnapshotSpec) ([]*model.VolumeSnapshotSpec, error) {
+	validStatus := []string{model.VolumeGroupSnapAvailable, model.VolumeGroupSnapError,
+		model.VolumeGroupSnapErrorDeleting}
+	if !utils.Contained(in.Status, validStatus) {
+		errMsg := fmt.Sprintf("only the group snapshot with the status available, error, errorDeleting can be deleted, the group snapshot status is %s", in.Status)
+		log.Error(errMsg)
+		return nil, errors.New(errMsg)
+	}
+
+	var snps []*model.VolumeSnapshotSpec
+	for _, snpId := range in.Snapshots {
+		snp, err := db.C.GetVolumeSnapshot(ctx, snpId)
+		if err != nil {
+			log.Error("get member snapshot failed in delete group snapshot method: ", err)
+			return nil, err
+		}
+		snps = append(snps, snp)
+	}
+	for _, snp := range snps {
+		db.C.UpdateStatus(ctx, snp, model.VolumeSnapDeleting)
+	}
+	db.C.UpdateStatus(ctx, in, model.VolumeGroupSnapDeleting)
+	return snps, nil
+}
+
+// CreateOperationDBEntry stores the operation which tracks an asynchronous
+// request into database and initializes its status as "accepted".
+func CreateOperationDBEntry(ctx *c.Context, in *model.OperationSpec) (*model.OperationSpec, error) {
+	if in.BaseModel == nil {
+		in.BaseModel = &model.BaseModel{}
+	}
+	if in.Action == "" || in.ResourceType == "" {
+		errMsg := "action and resource type of operation are required"
+		log.Error(errMsg)
+		return nil, errors.New(errMsg)
+	}
+	in.Status = model.OperationAccepted
+
+	return db.C.CreateOperation(ctx, in)
+}
+
+// FailOperationDBEntry marks the operation as failed with the reason if the
+// controller didn't get the chance to record the result of the request, for
+// example when the controller service is unreachable.
+func FailOperationDBEntry(ctx *c.Context, opId string, reason error) {
+	if opId == "" {
+		return
+	}
+	op, err := db.C.GetOperation(ctx, opId)
+	if err != nil {
+		log.Errorf("get operation %s failed: %v", opId, err)
+		return
+	}
+	if op.IsFinished() {
+		return
+	}
+	if err = db.UpdateOperationStatus(ctx, db.C, opId, model.OperationFailed, reason); err != nil {
+		log.Errorf("update operation %s failed: %v", opId, err)
+	}
+}
+
+// FinishOperationDBEntry marks the operation as succeeded, or failed with the
+// reason, for the requests whose result is known by the api server itself.
+func FinishOperationDBEntry(ctx *c.Context, opId string, reason error) {
+	status := model.OperationSucceeded
+	if reason != nil {
+		status = model.OperationFailed
+	}
+	if err := db.UpdateOperationStatus(ctx, db.C, opId, status, reason); err != nil {
+		log.Errorf("update operation %s failed: %v", opId, err)
+	}
+}
+
+// resetStatuses holds the statuses which a resource can be reset to.
+var resetStatuses = map[string][]string{
+	model.OperationResourceVolume: {model.VolumeCreating, model.VolumeAvailable, model.VolumeInUse,
+		model.VolumeDeleting, model.VolumeError, model.VolumeErrorDeleting, model.VolumeErrorExtending,
+		model.VolumeExtending, model.VolumeRestoring, model.VolumeErrorRestoring},
+	model.OperationResourceSnapshot: {model.VolumeSnapCreating, model.VolumeSnapAvailable,
+		model.VolumeSnapDeleting, model.VolumeSnapError, model.VolumeSnapErrorDeleting},
+	model.OperationResourceAttachment: {model.VolumeAttachCreating, model.VolumeAttachAvailable,
+		model.VolumeAttachError, model.VolumeAttachErrorDeleting},
+	model.OperationResourceBackup: {model.BackupCreating, model.BackupAvailable, model.BackupDeleting,
+		model.BackupRestoring, model.BackupError, model.BackupErrorDeleting},
+	model.OperationResourceReplication: {model.ReplicationCreating, model.ReplicationDeleting,
+		model.ReplicationAvailable, model.ReplicationEnabled, model.ReplicationDisabled,
+		model.ReplicationFailover, model.ReplicationError, model.ReplicationErrorDeleting,
+		model.ReplicationErrorEnabling, model.ReplicationErrorDisabling, model.ReplicationErrorFailover,
+		model.ReplicationErrorFailback},
+	model.OperationResourceVolumeGroup: {model.VolumeGroupCreating, model.VolumeGroupAvailable,
+		model.VolumeGroupInUse, model.VolumeGroupDeleting, model.VolumeGroupError,
+		model.VolumeGroupErrorDeleting},
+	model.OperationResourceFileShare: {model.FileShareCreating, model.FileShareAvailable,
+		model.FileShareInUse, model.FileShareDeleting, model.FileShareError, model.FileShareErrorDeleting},
+	model.OperationResourceFileShareSnapshot: {model.FileShareSnapCreating, model.FileShareSnapAvailable,
+		model.FileShareSnapDeleting, model.FileShareSnapError, model.FileShareSnapErrorDeleting},
+	model.OperationResourceFileShareAcl: {model.FileShareAclCreating, model.FileShareAclAvailable,
+		model.FileShareAclDeleting, model.FileShareAclError, model.FileShareAclErrorDeleting},
+}
+
+// ResetStatusDBEntry sets the status of the resource in the DB only, which
+// is used by admin to repair the resource stuck in a transient status. The
+// status should be one of the statuses of the resource type.
+func ResetStatusDBEntry(ctx *c.Context, resourceType string, in interface{}, status string) error {
+	validStatus, ok := resetStatuses[resourceType]
+	if !ok {
+		return fmt.Errorf("the status of %s can't be reset", resourceType)
+	}
+	if !utils.Contained(status, validStatus) {
+		errMsg := fmt.Sprintf("invalid %s status %q, it should be one of %s",
+			resourceType, status, strings.Join(validStatus, ", "))
+		log.Error(errMsg)
+		return errors.New(errMsg)
+	}
+	return db.C.UpdateStatus(ctx, in, status)
+}
+
+// ForceDeleteVolumeDBEntry removes the volume and its snapshots, attachments
+// and replication from the DB whatever their statuses are. It's called after
+// the volume is deleted from the backend or failed to, so the entries which
+// have been removed by the controller are skipped.
+func ForceDeleteVolumeDBEntry(ctx *c.Context, in *model.VolumeSpec) error {
+	snaps, err := db.C.ListSnapshotsByVolumeId(ctx, in.Id)
+	if err != nil {
+		return err
+	}
+	for _, snap := range snaps {
+		if err := ForceDeleteVolumeSnapshotDBEntry(ctx, snap); err != nil {
+			return err
+		}
+	}
+	atts, err := db.C.ListAttachmentsByVolumeId(ctx, in.Id)
+	if err != nil {
+		return err
+	}
+	for _, att := range atts {
+		if err := ForceDeleteVolumeAttachmentDBEntry(ctx, att); err != nil {
+			return err
+		}
+	}
+	// A volume has one replication at most, and it's not found mostly.
+	if rep, err := db.C.GetReplicationByVolumeId(ctx, in.Id); err == nil {
+		if err := ForceDeleteReplicationDBEntry(ctx, rep); err != nil {
+			return err
+		}
+	}
+
+	if _, err := db.C.GetVolume(ctx, in.Id); err != nil {
+		log.Warningf("volume %s has been removed from db: %v", in.Id, err)
+	} else if err := db.C.DeleteVolume(ctx, in.Id); err != nil {
+		log.Error("when delete volume in db:", err)
+		return err
+	}
+	quota.Release(ctx, in.TenantId, in.Id)
+	return nil
+}
+
+// ForceDeleteVolumeSnapshotDBEntry removes the volume snapshot from the DB
+// whatever its status is.
+func ForceDeleteVolumeSnapshotDBEntry(ctx *c.Context, in *model.VolumeSnapshotSpec) error {
+	if _, err := db.C.GetVolumeSnapshot(ctx, in.Id); err != nil {
+		log.Warningf("volume snapshot %s has been removed from db: %v", in.Id, err)
+	} else if err := db.C.DeleteVolumeSnapshot(ctx, in.Id); err != nil {
+		log.Error("when delete volume snapshot in db:", err)
+		return err
+	}
+	quota.Release(ctx, in.TenantId, in.Id)
+	return nil
+}
+
+// ForceDeleteVolumeAttachmentDBEntry removes the volume attachment from the
+// DB whatever its status is.
+func ForceDeleteVolumeAttachmentDBEntry(ctx *c.Context, in *model.VolumeAttachmentSpec) error {
+	if _, err := db.C.GetVolumeAttachment(ctx, in.Id); err != nil {
+		log.Warningf("volume attachment %s has been removed from db: %v", in.Id, err)
+		return nil
+	}
+	if err := db.C.DeleteVolumeAttachment(ctx, in.Id); err != nil {
+		log.Error("when delete volume attachment in db:", err)
+		return err
+	}
+	return nil
+}
+
+// ForceDeleteBackupDBEntry removes the volume backup from the DB whatever
+// its status is.
+func ForceDeleteBackupDBEntry(ctx *c.Context, in *model.BackupSpec) error {
+	if _, err := db.C.GetBackup(ctx, in.Id); err != nil {
+		log.Warningf("volume backup %s has been removed from db: %v", in.Id, err)
+		return nil
+	}
+	if err := db.C.DeleteBackup(ctx, in.Id); err != nil {
+		log.Error("when delete volume backup in db:", err)
+		return err
+	}
+	return nil
+}
+
+// ForceDeleteReplicationDBEntry removes the replication from the DB whatever
+// its status is.
+func ForceDeleteReplicationDBEntry(ctx *c.Context, in *model.ReplicationSpec) error {
+	if _, err := db.C.GetReplication(ctx, in.Id); err != nil {
+		log.Warningf("replication %s has been removed from db: %v", in.Id, err)
+		return nil
+	}
+	if err := db.C.DeleteReplication(ctx, in.Id); err != nil {
+		log.Error("when delete replication in db:", err)
+		return err
+	}
+	return nil
+}
+
+// ForceDeleteVolumeGroupDBEntry removes the volume group and its volumes from
+// the DB whatever their statuses are, since a volume group is deleted along
+// with its volumes.
+func ForceDeleteVolumeGroupDBEntry(ctx *c.Context, in *model.VolumeGroupSpec) error {
+	vols, err := db.C.ListVolumesByGroupId(ctx, in.Id)
+	if err != nil {
+		return err
+	}
+	for _, vol := range vols {
+		if err := ForceDeleteVolumeDBEntry(ctx, vol); err != nil {
+			return err
+		}
+	}
+	if _, err := db.C.GetVolumeGroup(ctx, in.Id); err != nil {
+		log.Warningf("volume group %s has been removed from db: %v", in.Id, err)
+		return nil
+	}
+	if err := db.C.DeleteVolumeGroup(ctx, in.Id); err != nil {
+		log.Error("when delete volume group in db:", err)
+		return err
+	}
+	return nil
+}
+
+// ForceDeleteFileShareDBEntry removes the fileshare and its snapshots and
+// acls from the DB whatever their statuses are.
+func ForceDeleteFileShareDBEntry(ctx *c.Context, in *model.FileShareSpec) error {
+	snaps, err := db.C.ListFileShareSnapshots(ctx)
+	if err != nil {
+		return err
+	}
+	for _, snap := range snaps {
+		if snap.FileShareId != in.Id {
+			continue
+		}
+		if err := ForceDeleteFileShareSnapshotDBEntry(ctx, snap); err != nil {
+			return err
+		}
+	}
+	acls, err := db.C.ListFileSharesAcl(ctx)
+	if err != nil {
+		return err
+	}
+	for _, acl := range acls {
+		if acl.FileShareId != in.Id {
+			continue
+		}
+		if err := ForceDeleteFileShareAclDBEntry(ctx, acl); err != nil {
+			return err
+		}
+	}
+
+	if _, err := db.C.GetFileShare(ctx, in.Id); err != nil {
+		log.Warningf("fileshare %s has been removed from db: %v", in.Id, err)
+	} else if err := db.C.DeleteFileShare(ctx, in.Id); err != nil {
+		log.Error("when delete fileshare in db:", err)
+		return err
+	}
+	quota.Release(ctx, in.TenantId, in.Id)
+	return nil
+}
+
+// ForceDeleteFileShareSnapshotDBEntry removes the fileshare snapshot from the
+// DB whatever its status is.
+func ForceDeleteFileShareSnapshotDBEntry(ctx *c.Context, in *model.FileShareSnapshotSpec) error {
+	if _, err := db.C.GetFileShareSnapshot(ctx, in.Id); err != nil {
+		log.Warningf("fileshare snapshot %s has been removed from db: %v", in.Id, err)
+		return nil
+	}
+	if err := db.C.DeleteFileShareSnapshot(ctx, in.Id); err != nil {
+		log.Error("when delete fileshare snapshot in db:", err)
+		return err
+	}
+	return nil
+}
+
+// ForceDeleteFileShareAclDBEntry removes the fileshare acl from the DB
+// whatever its status is.
+func ForceDeleteFileShareAclDBEntry(ctx *c.Context, in *model.FileShareAclSpec) error {
+	if _, err := db.C.GetFileShareAcl(ctx, in.Id); err != nil {
+		log.Warningf("fileshare acl %s has been removed from db: %v", in.Id, err)
+		return nil
+	}
+	if err := db.C.DeleteFileShareAcl(ctx, in.Id); err != nil {
+		log.Error("when delete fileshare acl in db:", err)
+		return err
+	}
+	return nil
+}
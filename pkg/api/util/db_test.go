@@ -15,6 +15,7 @@
 package util
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 	"testing"
@@ -24,8 +25,17 @@ import (
 	"github.com/opensds/opensds/pkg/model"
 	. "github.com/opensds/opensds/testutils/collection"
 	dbtest "github.com/opensds/opensds/testutils/db/testing"
+	"github.com/stretchr/testify/mock"
 )
 
+// mockQuota lets the tenant be limited by the default quota and have
+// nothing charged yet.
+func mockQuota(m *dbtest.Client) {
+	m.On("GetQuota", mock.Anything, mock.Anything).Return(nil, model.NewNotFoundError("quota is not set"))
+	m.On("GetQuotaUsage", mock.Anything, mock.Anything).Return(nil, model.NewNotFoundError("quota usage can't find"))
+	m.On("UpdateQuotaUsage", mock.Anything, mock.Anything).Return(&SampleQuotaUsages[0], nil)
+}
+
 var assertTestResult = func(t *testing.T, got, expected interface{}) {
 	t.Helper()
 	if !reflect.DeepEqual(got, expected) {
@@ -44,6 +54,7 @@ func TestCreateVolumeDBEntry(t *testing.T) {
 
 	t.Run("Everything should work well", func(t *testing.T) {
 		mockClient := new(dbtest.Client)
+		mockQuota(mockClient)
 		mockClient.On("CreateVolume", context.NewAdminContext(), in).Return(&SampleVolumes[0], nil)
 		db.C = mockClient
 
@@ -58,6 +69,7 @@ func TestCreateVolumeDBEntry(t *testing.T) {
 	t.Run("The size of volume created should be greater than zero", func(t *testing.T) {
 		in.Size = int64(-2)
 		mockClient := new(dbtest.Client)
+		mockQuota(mockClient)
 		mockClient.On("CreateVolume", context.NewAdminContext(), in).Return(&SampleVolumes[0], nil)
 		db.C = mockClient
 
@@ -86,6 +98,7 @@ func TestCreateVolumeFromSnapshotDBEntry(t *testing.T) {
 
 	t.Run("Everything should work well", func(t *testing.T) {
 		mockClient := new(dbtest.Client)
+		mockQuota(mockClient)
 		mockClient.On("CreateVolume", context.NewAdminContext(), in).Return(&SampleVolumes[1], nil)
 		mockClient.On("GetVolumeSnapshot", context.NewAdminContext(), "3769855c-a102-11e7-b772-17b880d2f537").Return(snap, nil)
 		db.C = mockClient
@@ -101,6 +114,7 @@ func TestCreateVolumeFromSnapshotDBEntry(t *testing.T) {
 	t.Run("The status of volume snapshot should always be available", func(t *testing.T) {
 		snap.Status = model.VolumeSnapError
 		mockClient := new(dbtest.Client)
+		mockQuota(mockClient)
 		mockClient.On("CreateVolume", context.NewAdminContext(), in).Return(&SampleVolumes[1], nil)
 		mockClient.On("GetVolumeSnapshot", context.NewAdminContext(), "3769855c-a102-11e7-b772-17b880d2f537").Return(snap, nil)
 		db.C = mockClient
@@ -113,6 +127,7 @@ func TestCreateVolumeFromSnapshotDBEntry(t *testing.T) {
 	t.Run("Size of volume should always be equal to or bigger than size of the snapshot", func(t *testing.T) {
 		snap.Status, snap.Size = model.VolumeSnapAvailable, 10
 		mockClient := new(dbtest.Client)
+		mockQuota(mockClient)
 		mockClient.On("CreateVolume", context.NewAdminContext(), in).Return(&SampleVolumes[1], nil)
 		mockClient.On("GetVolumeSnapshot", context.NewAdminContext(), "3769855c-a102-11e7-b772-17b880d2f537").Return(snap, nil)
 		db.C = mockClient
@@ -123,6 +138,74 @@ func TestCreateVolumeFromSnapshotDBEntry(t *testing.T) {
 	})
 }
 
+func TestCreateVolumeFromSourceVolumeDBEntry(t *testing.T) {
+	var in = &model.VolumeSpec{
+		BaseModel:      &model.BaseModel{},
+		Name:           "volume sample",
+		Description:    "This is a sample volume for testing",
+		Size:           int64(1),
+		Status:         model.VolumeCreating,
+		SourceVolumeId: "bd5b12a8-a101-11e7-941e-d77981b584d8",
+	}
+	var srcVol = &model.VolumeSpec{
+		BaseModel: &model.BaseModel{
+			Id: "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		},
+		Size:   int64(1),
+		Status: model.VolumeAvailable,
+	}
+
+	t.Run("Everything should work well", func(t *testing.T) {
+		mockClient := new(dbtest.Client)
+		mockQuota(mockClient)
+		mockClient.On("CreateVolume", context.NewAdminContext(), in).Return(&SampleVolumes[1], nil)
+		mockClient.On("GetVolume", context.NewAdminContext(), "bd5b12a8-a101-11e7-941e-d77981b584d8").Return(srcVol, nil)
+		db.C = mockClient
+
+		var expected = &SampleVolumes[1]
+		result, err := CreateVolumeDBEntry(context.NewAdminContext(), in)
+		if err != nil {
+			t.Errorf("failed to clone volume, err is %v\n", err)
+		}
+		assertTestResult(t, result, expected)
+	})
+
+	t.Run("The status of source volume should always be available", func(t *testing.T) {
+		srcVol.Status = model.VolumeInUse
+		mockClient := new(dbtest.Client)
+		mockQuota(mockClient)
+		mockClient.On("GetVolume", context.NewAdminContext(), "bd5b12a8-a101-11e7-941e-d77981b584d8").Return(srcVol, nil)
+		db.C = mockClient
+
+		_, err := CreateVolumeDBEntry(context.NewAdminContext(), in)
+		expectedError := "only if the source volume is available, the volume can be cloned"
+		assertTestResult(t, err.Error(), expectedError)
+	})
+
+	t.Run("Size of volume should always be equal to or bigger than size of the source volume", func(t *testing.T) {
+		srcVol.Status, srcVol.Size = model.VolumeAvailable, 10
+		mockClient := new(dbtest.Client)
+		mockQuota(mockClient)
+		mockClient.On("GetVolume", context.NewAdminContext(), "bd5b12a8-a101-11e7-941e-d77981b584d8").Return(srcVol, nil)
+		db.C = mockClient
+
+		_, err := CreateVolumeDBEntry(context.NewAdminContext(), in)
+		expectedError := "size of volume must be equal to or bigger than size of the source volume"
+		assertTestResult(t, err.Error(), expectedError)
+	})
+
+	t.Run("Snapshot and source volume should not be specified at the same time", func(t *testing.T) {
+		in.SnapshotId = "3769855c-a102-11e7-b772-17b880d2f537"
+		mockClient := new(dbtest.Client)
+		mockQuota(mockClient)
+		db.C = mockClient
+
+		_, err := CreateVolumeDBEntry(context.NewAdminContext(), in)
+		expectedError := "snapshotId and sourceVolumeId can not be specified at the same time"
+		assertTestResult(t, err.Error(), expectedError)
+	})
+}
+
 func TestDeleteVolumeDBEntry(t *testing.T) {
 	var vol = &model.VolumeSpec{
 		BaseModel: &model.BaseModel{
@@ -208,6 +291,7 @@ func TestExtendVolumeDBEntry(t *testing.T) {
 
 	t.Run("Everything should work well", func(t *testing.T) {
 		mockClient := new(dbtest.Client)
+		mockQuota(mockClient)
 		mockClient.On("GetVolume", context.NewAdminContext(), "bd5b12a8-a101-11e7-941e-d77981b584d8").Return(vol, nil)
 		mockClient.On("ExtendVolume", context.NewAdminContext(), in).Return(nil, nil)
 		db.C = mockClient
@@ -221,6 +305,7 @@ func TestExtendVolumeDBEntry(t *testing.T) {
 	t.Run("The status of volume should always be available", func(t *testing.T) {
 		vol.Status = model.VolumeCreating
 		mockClient := new(dbtest.Client)
+		mockQuota(mockClient)
 		mockClient.On("GetVolume", context.NewAdminContext(), "bd5b12a8-a101-11e7-941e-d77981b584d8").Return(vol, nil)
 		mockClient.On("ExtendVolume", context.NewAdminContext(), in).Return(nil, nil)
 		db.C = mockClient
@@ -234,6 +319,7 @@ func TestExtendVolumeDBEntry(t *testing.T) {
 		vol.Size, vol.Status = 20, model.VolumeAvailable
 		in.Size = 20
 		mockClient := new(dbtest.Client)
+		mockQuota(mockClient)
 		mockClient.On("GetVolume", context.NewAdminContext(), "bd5b12a8-a101-11e7-941e-d77981b584d8").Return(vol, nil)
 		mockClient.On("ExtendVolume", context.NewAdminContext(), in).Return(nil, nil)
 		db.C = mockClient
@@ -245,6 +331,82 @@ func TestExtendVolumeDBEntry(t *testing.T) {
 	})
 }
 
+func TestRevertVolumeDBEntry(t *testing.T) {
+	var snap, later = SampleSnapshots[0], SampleSnapshots[1]
+	snap.CreatedAt, later.CreatedAt = "2019-05-20T10:00:00", "2019-05-21T10:00:00"
+	var snaps = []*model.VolumeSnapshotSpec{&snap, &later}
+
+	t.Run("Everything should work well", func(t *testing.T) {
+		vol := SampleVolumes[0]
+		vol.Status = model.VolumeAvailable
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetVolumeSnapshot", context.NewAdminContext(), later.Id).Return(&later, nil)
+		mockClient.On("ListSnapshotsByVolumeId", context.NewAdminContext(), vol.Id).Return(snaps, nil)
+		mockClient.On("UpdateVolume", context.NewAdminContext(), &vol).Return(&vol, nil)
+		db.C = mockClient
+
+		result, err := RevertVolumeDBEntry(context.NewAdminContext(), &vol, &model.RevertVolumeSpec{SnapshotId: later.Id})
+		if err != nil {
+			t.Errorf("failed to revert volume: %v\n", err)
+		}
+		assertTestResult(t, result.Status, model.VolumeReverting)
+	})
+
+	t.Run("The snapshot should be the latest one unless forced", func(t *testing.T) {
+		vol := SampleVolumes[0]
+		vol.Status = model.VolumeAvailable
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetVolumeSnapshot", context.NewAdminContext(), snap.Id).Return(&snap, nil)
+		mockClient.On("ListSnapshotsByVolumeId", context.NewAdminContext(), vol.Id).Return(snaps, nil)
+		mockClient.On("UpdateVolume", context.NewAdminContext(), &vol).Return(&vol, nil)
+		db.C = mockClient
+
+		_, err := RevertVolumeDBEntry(context.NewAdminContext(), &vol, &model.RevertVolumeSpec{SnapshotId: snap.Id})
+		expectedError := "snapshot 3769855c-a102-11e7-b772-17b880d2f537 isn't the latest snapshot of volume " +
+			"bd5b12a8-a101-11e7-941e-d77981b584d8, it can only be reverted to by force"
+		assertTestResult(t, err.Error(), expectedError)
+
+		if _, err = RevertVolumeDBEntry(context.NewAdminContext(), &vol,
+			&model.RevertVolumeSpec{SnapshotId: snap.Id, Force: true}); err != nil {
+			t.Errorf("failed to revert volume by force: %v\n", err)
+		}
+	})
+
+	t.Run("The volume attached should only be reverted by force", func(t *testing.T) {
+		vol := SampleVolumes[0]
+		vol.Status = model.VolumeInUse
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetVolumeSnapshot", context.NewAdminContext(), later.Id).Return(&later, nil)
+		mockClient.On("UpdateVolume", context.NewAdminContext(), &vol).Return(&vol, nil)
+		db.C = mockClient
+
+		_, err := RevertVolumeDBEntry(context.NewAdminContext(), &vol, &model.RevertVolumeSpec{SnapshotId: later.Id})
+		expectedError := "volume bd5b12a8-a101-11e7-941e-d77981b584d8 is attached, it can only be reverted by force"
+		assertTestResult(t, err.Error(), expectedError)
+
+		if _, err = RevertVolumeDBEntry(context.NewAdminContext(), &vol,
+			&model.RevertVolumeSpec{SnapshotId: later.Id, Force: true}); err != nil {
+			t.Errorf("failed to revert volume by force: %v\n", err)
+		}
+	})
+
+	t.Run("The snapshot should belong to the volume", func(t *testing.T) {
+		vol := model.VolumeSpec{
+			BaseModel: &model.BaseModel{Id: "c2a5f7b0-a101-11e7-941e-d77981b584d8"},
+			Status:    model.VolumeAvailable,
+		}
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetVolumeSnapshot", context.NewAdminContext(), later.Id).Return(&later, nil)
+		db.C = mockClient
+
+		if _, err := RevertVolumeDBEntry(context.NewAdminContext(), &vol,
+			&model.RevertVolumeSpec{SnapshotId: later.Id, Force: true}); err == nil {
+			t.Error("expected error when revert volume to snapshot of another volume")
+		}
+		mockClient.AssertNotCalled(t, "UpdateVolume", mock.Anything, mock.Anything)
+	})
+}
+
 func TestCreateVolumeAttachmentDBEntry(t *testing.T) {
 	var req = &model.VolumeAttachmentSpec{
 		BaseModel: &model.BaseModel{},
@@ -345,6 +507,7 @@ func TestCreateVolumeSnapshotDBEntry(t *testing.T) {
 
 	t.Run("Everything should work well", func(t *testing.T) {
 		mockClient := new(dbtest.Client)
+		mockQuota(mockClient)
 		mockClient.On("GetVolume", context.NewAdminContext(), "bd5b12a8-a101-11e7-941e-d77981b584d8").Return(vol, nil)
 		mockClient.On("CreateVolumeSnapshot", context.NewAdminContext(), req).Return(&SampleSnapshots[0], nil)
 		db.C = mockClient
@@ -369,6 +532,7 @@ func TestDeleteVolumeSnapshotDBEntry(t *testing.T) {
 
 	t.Run("Everything should work well", func(t *testing.T) {
 		mockClient := new(dbtest.Client)
+		mockQuota(mockClient)
 		mockClient.On("UpdateVolumeSnapshot", context.NewAdminContext(), "3769855c-a102-11e7-b772-17b880d2f537", req).Return(nil, nil)
 		mockClient.On("GetVolume", context.NewAdminContext(), req.VolumeId).Return(nil, nil)
 		db.C = mockClient
@@ -379,3 +543,390 @@ func TestDeleteVolumeSnapshotDBEntry(t *testing.T) {
 		}
 	})
 }
+
+func TestCreateVolumeGroupSnapshotDBEntry(t *testing.T) {
+	var vols = []*model.VolumeSpec{
+		{BaseModel: &model.BaseModel{Id: "bd5b12a8-a101-11e7-941e-d77981b584d8"}, Size: 1, Status: model.VolumeAvailable},
+		{BaseModel: &model.BaseModel{Id: "e0c4b4d2-7f41-11e9-a6b2-3f5e8c1d4b90"}, Size: 2, Status: model.VolumeInUse},
+	}
+	var newReq = func() *model.VolumeGroupSnapshotSpec {
+		return &model.VolumeGroupSnapshotSpec{
+			BaseModel: &model.BaseModel{},
+			Name:      "sample-group-snapshot-01",
+			GroupId:   SampleVolumeGroups[0].Id,
+		}
+	}
+
+	t.Run("Everything should work well", func(t *testing.T) {
+		req := newReq()
+		mockClient := new(dbtest.Client)
+		mockQuota(mockClient)
+		mockClient.On("GetVolumeGroup", context.NewAdminContext(), req.GroupId).Return(&SampleVolumeGroups[0], nil)
+		mockClient.On("ListVolumesByGroupId", context.NewAdminContext(), req.GroupId).Return(vols, nil)
+		mockClient.On("CreateVolumeSnapshot", context.NewAdminContext(), mock.Anything).Return(
+			func(ctx *context.Context, snp *model.VolumeSnapshotSpec) *model.VolumeSnapshotSpec { return snp }, nil)
+		mockClient.On("CreateVolumeGroupSnapshot", context.NewAdminContext(), req).Return(req, nil)
+		db.C = mockClient
+
+		result, snps, err := CreateVolumeGroupSnapshotDBEntry(context.NewAdminContext(), req)
+		if err != nil {
+			t.Errorf("failed to create group snapshot, err is %v\n", err)
+		}
+		assertTestResult(t, result.Status, model.VolumeGroupSnapCreating)
+		if len(snps) != len(vols) {
+			t.Fatalf("expected %d member snapshots, got %d", len(vols), len(snps))
+		}
+		for i, snp := range snps {
+			assertTestResult(t, snp.VolumeId, vols[i].Id)
+			assertTestResult(t, snp.Size, vols[i].Size)
+			assertTestResult(t, snp.GroupSnapshotId, result.Id)
+			assertTestResult(t, snp.Status, model.VolumeSnapCreating)
+			assertTestResult(t, result.Snapshots[i], snp.Id)
+		}
+	})
+
+	t.Run("Member volumes must be available or in-use", func(t *testing.T) {
+		req := newReq()
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetVolumeGroup", context.NewAdminContext(), req.GroupId).Return(&SampleVolumeGroups[0], nil)
+		mockClient.On("ListVolumesByGroupId", context.NewAdminContext(), req.GroupId).Return([]*model.VolumeSpec{
+			{BaseModel: &model.BaseModel{Id: "bd5b12a8-a101-11e7-941e-d77981b584d8"}, Size: 1, Status: model.VolumeCreating},
+		}, nil)
+		db.C = mockClient
+
+		if _, _, err := CreateVolumeGroupSnapshotDBEntry(context.NewAdminContext(), req); err == nil {
+			t.Error("expected an error when a member volume is creating")
+		}
+		mockClient.AssertNotCalled(t, "CreateVolumeSnapshot", mock.Anything, mock.Anything)
+	})
+}
+
+func TestDeleteVolumeSnapshotDBEntryInGroupSnapshot(t *testing.T) {
+	var req = &model.VolumeSnapshotSpec{
+		BaseModel:       &model.BaseModel{Id: "3769855c-a102-11e7-b772-17b880d2f537"},
+		VolumeId:        "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		GroupSnapshotId: SampleGroupSnapshots[0].Id,
+		Status:          "available",
+	}
+	mockClient := new(dbtest.Client)
+	db.C = mockClient
+
+	if err := DeleteVolumeSnapshotDBEntry(context.NewAdminContext(), req); err == nil {
+		t.Error("expected an error when deleting a member snapshot of group snapshot")
+	}
+	mockClient.AssertNotCalled(t, "UpdateVolumeSnapshot", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCreateFileShareAclDBEntry(t *testing.T) {
+	var newReq = func() *model.FileShareAclSpec {
+		return &model.FileShareAclSpec{
+			BaseModel:        &model.BaseModel{},
+			FileShareId:      "d2975ebe-d82c-430f-b28e-f373746a71ca",
+			Type:             "ip",
+			AccessTo:         []string{"10.0.0.0/24", "192.168.1.10"},
+			AccessCapability: []string{"Read", "Write"},
+		}
+	}
+
+	t.Run("Everything should work well", func(t *testing.T) {
+		req := newReq()
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetFileShare", context.NewAdminContext(), req.FileShareId).Return(&SampleFileShares[0], nil)
+		mockClient.On("CreateFileShareAcl", context.NewAdminContext(), req).Return(&SampleFileSharesAcl[0], nil)
+		db.C = mockClient
+
+		result, err := CreateFileShareAclDBEntry(context.NewAdminContext(), req)
+		if err != nil {
+			t.Errorf("failed to create fileshare acl, err is %v\n", err)
+		}
+		assertTestResult(t, result, &SampleFileSharesAcl[0])
+		assertTestResult(t, req.Status, model.FileShareAclCreating)
+	})
+
+	var invalidCases = map[string]func(*model.FileShareAclSpec){
+		"invalid type":              func(in *model.FileShareAclSpec) { in.Type = "user" },
+		"no client":                 func(in *model.FileShareAclSpec) { in.AccessTo = nil },
+		"invalid client":            func(in *model.FileShareAclSpec) { in.AccessTo = []string{"*(rw)"} },
+		"no access capability":      func(in *model.FileShareAclSpec) { in.AccessCapability = nil },
+		"invalid access capability": func(in *model.FileShareAclSpec) { in.AccessCapability = []string{"Delete"} },
+	}
+	for name, modify := range invalidCases {
+		t.Run(fmt.Sprintf("Acl with %s should be rejected", name), func(t *testing.T) {
+			req := newReq()
+			modify(req)
+			db.C = new(dbtest.Client)
+
+			if _, err := CreateFileShareAclDBEntry(context.NewAdminContext(), req); err == nil {
+				t.Errorf("expected an error for the acl with %s", name)
+			}
+		})
+	}
+}
+
+func TestCreateBackupDBEntry(t *testing.T) {
+	var vol = &model.VolumeSpec{
+		BaseModel: &model.BaseModel{
+			Id: "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		},
+		Size:   1,
+		Status: model.VolumeAvailable,
+		PoolId: "084bf71e-a102-11e7-88a8-e31fe6d52248",
+	}
+	var snap = &model.VolumeSnapshotSpec{
+		BaseModel: &model.BaseModel{
+			Id: "3769855c-a102-11e7-b772-17b880d2f537",
+		},
+		VolumeId: "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		Status:   model.VolumeSnapAvailable,
+	}
+
+	t.Run("Everything should work well", func(t *testing.T) {
+		var req = &model.BackupSpec{
+			BaseModel: &model.BaseModel{},
+			VolumeId:  "bd5b12a8-a101-11e7-941e-d77981b584d8",
+			Name:      "sample-backup-01",
+		}
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetVolume", context.NewAdminContext(), req.VolumeId).Return(vol, nil)
+		mockClient.On("CreateBackup", context.NewAdminContext(), req).Return(&SampleBackups[0], nil)
+		db.C = mockClient
+
+		var expected = &SampleBackups[0]
+		result, err := CreateBackupDBEntry(context.NewAdminContext(), req)
+		if err != nil {
+			t.Errorf("failed to create volume backup, err is %v\n", err)
+		}
+		assertTestResult(t, result, expected)
+		assertTestResult(t, req.Status, model.BackupCreating)
+		assertTestResult(t, req.PoolId, vol.PoolId)
+	})
+
+	t.Run("Volume of backup should be the one which snapshot belongs to", func(t *testing.T) {
+		var req = &model.BackupSpec{
+			BaseModel:  &model.BaseModel{},
+			SnapshotId: "3769855c-a102-11e7-b772-17b880d2f537",
+		}
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetVolumeSnapshot", context.NewAdminContext(), req.SnapshotId).Return(snap, nil)
+		mockClient.On("GetVolume", context.NewAdminContext(), snap.VolumeId).Return(vol, nil)
+		mockClient.On("CreateBackup", context.NewAdminContext(), req).Return(&SampleBackups[1], nil)
+		db.C = mockClient
+
+		if _, err := CreateBackupDBEntry(context.NewAdminContext(), req); err != nil {
+			t.Errorf("failed to create volume backup, err is %v\n", err)
+		}
+		assertTestResult(t, req.VolumeId, snap.VolumeId)
+
+		req.VolumeId = "3fbd2b55-5e1c-11e9-a1c5-e7fd1d4f8cbd"
+		_, err := CreateBackupDBEntry(context.NewAdminContext(), req)
+		expectedError := "snapshot 3769855c-a102-11e7-b772-17b880d2f537 doesn't belong to volume 3fbd2b55-5e1c-11e9-a1c5-e7fd1d4f8cbd"
+		assertTestResult(t, err.Error(), expectedError)
+	})
+
+	t.Run("The status of volume should always be available", func(t *testing.T) {
+		var req = &model.BackupSpec{
+			BaseModel: &model.BaseModel{},
+			VolumeId:  "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		}
+		var inUseVol = &model.VolumeSpec{
+			BaseModel: vol.BaseModel,
+			Status:    model.VolumeInUse,
+		}
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetVolume", context.NewAdminContext(), req.VolumeId).Return(inUseVol, nil)
+		db.C = mockClient
+
+		_, err := CreateBackupDBEntry(context.NewAdminContext(), req)
+		expectedError := "only if the volume is available, the backup can be created"
+		assertTestResult(t, err.Error(), expectedError)
+	})
+}
+
+func TestDeleteBackupDBEntry(t *testing.T) {
+	t.Run("Everything should work well", func(t *testing.T) {
+		var req = &model.BackupSpec{
+			BaseModel: &model.BaseModel{
+				Id: "5d8c2a5e-5e0f-11e9-8cf1-6f3c2b7e2c11",
+			},
+			BackupDriver: "multi-cloud",
+			Status:       model.BackupAvailable,
+		}
+		mockClient := new(dbtest.Client)
+		mockClient.On("UpdateBackup", context.NewAdminContext(), req.Id, req).Return(nil, nil)
+		db.C = mockClient
+
+		if err := DeleteBackupDBEntry(context.NewAdminContext(), req); err != nil {
+			t.Errorf("failed to delete volume backup, err is %v\n", err)
+		}
+		assertTestResult(t, req.Status, model.BackupDeleting)
+	})
+
+	t.Run("Backup which has no data stored should be deleted directly", func(t *testing.T) {
+		var req = &model.BackupSpec{
+			BaseModel: &model.BaseModel{
+				Id: "5d8c2a5e-5e0f-11e9-8cf1-6f3c2b7e2c11",
+			},
+			Status: model.BackupError,
+		}
+		mockClient := new(dbtest.Client)
+		mockClient.On("DeleteBackup", context.NewAdminContext(), req.Id).Return(nil)
+		db.C = mockClient
+
+		if err := DeleteBackupDBEntry(context.NewAdminContext(), req); err != nil {
+			t.Errorf("failed to delete volume backup, err is %v\n", err)
+		}
+		mockClient.AssertCalled(t, "DeleteBackup", context.NewAdminContext(), req.Id)
+	})
+
+	t.Run("Backup which is being created should not be deleted", func(t *testing.T) {
+		var req = &model.BackupSpec{
+			BaseModel: &model.BaseModel{},
+			Status:    model.BackupCreating,
+		}
+		db.C = new(dbtest.Client)
+
+		err := DeleteBackupDBEntry(context.NewAdminContext(), req)
+		expectedError := "only the volume backup with the status available, error, errorDeleting can be deleted, the volume backup status is creating"
+		assertTestResult(t, err.Error(), expectedError)
+	})
+}
+
+func TestRestoreBackupDBEntry(t *testing.T) {
+	var backup = &model.BackupSpec{
+		BaseModel: &model.BaseModel{
+			Id: "5d8c2a5e-5e0f-11e9-8cf1-6f3c2b7e2c11",
+		},
+		Size:   2,
+		Status: model.BackupAvailable,
+	}
+
+	t.Run("Backup should be restored into existing volume", func(t *testing.T) {
+		var vol = &model.VolumeSpec{
+			BaseModel: &model.BaseModel{
+				Id: "bd5b12a8-a101-11e7-941e-d77981b584d8",
+			},
+			Size:   2,
+			Status: model.VolumeAvailable,
+		}
+		mockClient := new(dbtest.Client)
+		mockQuota(mockClient)
+		mockClient.On("GetVolume", context.NewAdminContext(), vol.Id).Return(vol, nil)
+		mockClient.On("UpdateStatus", context.NewAdminContext(), vol, model.VolumeRestoring).Return(nil)
+		mockClient.On("UpdateStatus", context.NewAdminContext(), backup, model.BackupRestoring).Return(nil)
+		db.C = mockClient
+
+		result, err := RestoreBackupDBEntry(context.NewAdminContext(), backup, &model.RestoreBackupSpec{VolumeId: vol.Id})
+		if err != nil {
+			t.Errorf("failed to restore volume backup, err is %v\n", err)
+		}
+		assertTestResult(t, result, vol)
+	})
+
+	t.Run("Size of volume should always be equal to or bigger than size of the backup", func(t *testing.T) {
+		var vol = &model.VolumeSpec{
+			BaseModel: &model.BaseModel{
+				Id: "bd5b12a8-a101-11e7-941e-d77981b584d8",
+			},
+			Size:   1,
+			Status: model.VolumeAvailable,
+		}
+		mockClient := new(dbtest.Client)
+		mockQuota(mockClient)
+		mockClient.On("GetVolume", context.NewAdminContext(), vol.Id).Return(vol, nil)
+		db.C = mockClient
+
+		_, err := RestoreBackupDBEntry(context.NewAdminContext(), backup, &model.RestoreBackupSpec{VolumeId: vol.Id})
+		expectedError := "size of volume must be equal to or bigger than size of the backup"
+		assertTestResult(t, err.Error(), expectedError)
+	})
+
+	t.Run("New volume should be created if no volume is specified", func(t *testing.T) {
+		mockClient := new(dbtest.Client)
+		mockQuota(mockClient)
+		mockClient.On("CreateVolume", context.NewAdminContext(), mock.Anything).Return(&SampleVolumes[0], nil)
+		mockClient.On("UpdateStatus", context.NewAdminContext(), backup, model.BackupRestoring).Return(nil)
+		db.C = mockClient
+
+		result, err := RestoreBackupDBEntry(context.NewAdminContext(), backup, &model.RestoreBackupSpec{})
+		if err != nil {
+			t.Errorf("failed to restore volume backup, err is %v\n", err)
+		}
+		assertTestResult(t, result, &SampleVolumes[0])
+		// The quota is reserved before the volume is created.
+		vol := mockClient.Calls[3].Arguments.Get(1).(*model.VolumeSpec)
+		assertTestResult(t, vol.Size, backup.Size)
+		assertTestResult(t, vol.Name, "restore-"+backup.Id)
+	})
+}
+
+func TestResetStatusDBEntry(t *testing.T) {
+	t.Run("Status should be reset to a valid one", func(t *testing.T) {
+		snap := &model.VolumeSnapshotSpec{BaseModel: &model.BaseModel{Id: "snap"}, Status: model.VolumeSnapDeleting}
+		mockClient := new(dbtest.Client)
+		mockClient.On("UpdateStatus", context.NewAdminContext(), snap, model.VolumeSnapAvailable).Return(nil)
+		db.C = mockClient
+
+		if err := ResetStatusDBEntry(context.NewAdminContext(), model.OperationResourceSnapshot, snap, model.VolumeSnapAvailable); err != nil {
+			t.Errorf("failed to reset status of snapshot, err is %v\n", err)
+		}
+	})
+
+	t.Run("Status of other resource type should be rejected", func(t *testing.T) {
+		vol := &model.VolumeSpec{BaseModel: &model.BaseModel{Id: "vol"}, Status: model.VolumeDeleting}
+		mockClient := new(dbtest.Client)
+		db.C = mockClient
+
+		// "inUse" is a status of volume, but the status of fileshare is "in_Use".
+		err := ResetStatusDBEntry(context.NewAdminContext(), model.OperationResourceFileShare, vol, model.VolumeInUse)
+		if err == nil {
+			t.Error("expected an error when resetting to an invalid status")
+		}
+		mockClient.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestForceDeleteVolumeDBEntry(t *testing.T) {
+	vol := &model.VolumeSpec{
+		BaseModel: &model.BaseModel{Id: "bd5b12a8-a101-11e7-941e-d77981b584d8"},
+		Status:    model.VolumeDeleting,
+	}
+	snap := &model.VolumeSnapshotSpec{BaseModel: &model.BaseModel{Id: "snap"}, VolumeId: vol.Id}
+	att := &model.VolumeAttachmentSpec{BaseModel: &model.BaseModel{Id: "att"}, VolumeId: vol.Id}
+
+	t.Run("Volume and its dependents should be removed", func(t *testing.T) {
+		mockClient := new(dbtest.Client)
+		mockQuota(mockClient)
+		mockClient.On("ListSnapshotsByVolumeId", context.NewAdminContext(), vol.Id).Return([]*model.VolumeSnapshotSpec{snap}, nil)
+		mockClient.On("GetVolumeSnapshot", context.NewAdminContext(), snap.Id).Return(snap, nil)
+		mockClient.On("DeleteVolumeSnapshot", context.NewAdminContext(), snap.Id).Return(nil)
+		mockClient.On("ListAttachmentsByVolumeId", context.NewAdminContext(), vol.Id).Return([]*model.VolumeAttachmentSpec{att}, nil)
+		mockClient.On("GetVolumeAttachment", context.NewAdminContext(), att.Id).Return(att, nil)
+		mockClient.On("DeleteVolumeAttachment", context.NewAdminContext(), att.Id).Return(nil)
+		mockClient.On("GetReplicationByVolumeId", context.NewAdminContext(), vol.Id).Return(nil, errors.New("not found"))
+		mockClient.On("GetVolume", context.NewAdminContext(), vol.Id).Return(vol, nil)
+		mockClient.On("DeleteVolume", context.NewAdminContext(), vol.Id).Return(nil)
+		db.C = mockClient
+
+		if err := ForceDeleteVolumeDBEntry(context.NewAdminContext(), vol); err != nil {
+			t.Errorf("failed to force delete volume, err is %v\n", err)
+		}
+		mockClient.AssertCalled(t, "DeleteVolumeSnapshot", context.NewAdminContext(), snap.Id)
+		mockClient.AssertCalled(t, "DeleteVolumeAttachment", context.NewAdminContext(), att.Id)
+		mockClient.AssertCalled(t, "DeleteVolume", context.NewAdminContext(), vol.Id)
+	})
+
+	t.Run("Volume removed by the controller should be skipped", func(t *testing.T) {
+		mockClient := new(dbtest.Client)
+		mockQuota(mockClient)
+		mockClient.On("ListSnapshotsByVolumeId", context.NewAdminContext(), vol.Id).Return(nil, nil)
+		mockClient.On("ListAttachmentsByVolumeId", context.NewAdminContext(), vol.Id).Return(nil, nil)
+		mockClient.On("GetReplicationByVolumeId", context.NewAdminContext(), vol.Id).Return(nil, errors.New("not found"))
+		mockClient.On("GetVolume", context.NewAdminContext(), vol.Id).Return(nil, errors.New("not found"))
+		db.C = mockClient
+
+		if err := ForceDeleteVolumeDBEntry(context.NewAdminContext(), vol); err != nil {
+			t.Errorf("failed to force delete volume, err is %v\n", err)
+		}
+		mockClient.AssertNotCalled(t, "DeleteVolume", mock.Anything, mock.Anything)
+	})
+}
@@ -29,15 +29,22 @@ import (
 	osdsCtx "github.com/opensds/opensds/pkg/context"
 	"github.com/opensds/opensds/pkg/controller/dr"
 	"github.com/opensds/opensds/pkg/controller/fileshare"
+	"github.com/opensds/opensds/pkg/controller/heartbeat"
 	"github.com/opensds/opensds/pkg/controller/metrics"
 	"github.com/opensds/opensds/pkg/controller/policy"
+	"github.com/opensds/opensds/pkg/controller/policy/schedule"
+	"github.com/opensds/opensds/pkg/controller/reconcile"
 	"github.com/opensds/opensds/pkg/controller/selector"
 	"github.com/opensds/opensds/pkg/controller/volume"
 	"github.com/opensds/opensds/pkg/db"
 	"github.com/opensds/opensds/pkg/model"
 	pb "github.com/opensds/opensds/pkg/model/proto"
+	"github.com/opensds/opensds/pkg/quota"
 	"github.com/opensds/opensds/pkg/utils"
+	"github.com/opensds/opensds/pkg/utils/config"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -52,14 +59,21 @@ func NewController(port string) *Controller {
 	volCtrl := volume.NewController()
 	fileShareCtrl := fileshare.NewController()
 	metricsCtrl := metrics.NewController()
-	return &Controller{
+	ctr := &Controller{
 		selector:            selector.NewSelector(),
 		volumeController:    volCtrl,
 		fileshareController: fileShareCtrl,
 		metricsController:   metricsCtrl,
 		drController:        dr.NewController(volCtrl),
+		monitor:             heartbeat.NewMonitor(config.CONF.OsdsLet.DockHeartbeatTimeout),
 		Port:                port,
 	}
+	ctr.scheduler = schedule.NewScheduler(ctr, config.CONF.OsdsLet.SnapshotScheduleInterval)
+	// The reconciler has its own volume controller since it switches the
+	// docks while the requests are handled.
+	ctr.reconciler = reconcile.NewReconciler(volume.NewController(),
+		config.CONF.OsdsLet.ReconcileInterval, config.CONF.OsdsLet.ReconcileAutoFix)
+	return ctr
 }
 
 type Controller struct {
@@ -69,6 +83,12 @@ type Controller struct {
 	metricsController   metrics.Controller
 	drController        dr.Controller
 	policyController    policy.Controller
+	// monitor marks the docks which stop sending heartbeat unavailable.
+	monitor *heartbeat.Monitor
+	// scheduler takes the scheduled snapshots of the volumes.
+	scheduler *schedule.Scheduler
+	// reconciler finds the drifts between the database and the backends.
+	reconciler *reconcile.Reconciler
 
 	Port string
 }
@@ -81,6 +101,13 @@ func (c *Controller) Run() error {
 	pb.RegisterControllerServer(s, c)
 	pb.RegisterFileShareControllerServer(s, c)
 
+	// Start checking the heartbeat of the docks.
+	go c.monitor.Run(nil)
+	// Start taking the scheduled snapshots.
+	go c.scheduler.Run(nil)
+	// Start reconciling the database with the backends.
+	go c.reconciler.Run(nil)
+
 	// Listen the controller server port.
 	lis, err := net.Listen("tcp", c.Port)
 	if err != nil {
@@ -95,15 +122,57 @@ func (c *Controller) Run() error {
 	return s.Serve(lis)
 }
 
+// finishOperation records the result of the request into the operation which
+// tracks it, so that the caller can find out why the request failed.
+func finishOperation(ctx *osdsCtx.Context, opId string, err error) {
+	var status = model.OperationSucceeded
+	if err != nil {
+		status = model.OperationFailed
+	}
+	if err := db.UpdateOperationStatus(ctx, db.C, opId, status, err); err != nil {
+		log.Errorf("update operation %s failed: %v", opId, err)
+	}
+}
+
+// commitQuota turns the resources reserved by the api server for the
+// resource into use once the request succeeds.
+func commitQuota(ctx *osdsCtx.Context, tenantId, resourceId string) {
+	if err := quota.Commit(ctx, tenantId, resourceId); err != nil {
+		log.Errorf("commit quota of %s failed: %v", resourceId, err)
+	}
+}
+
+// rollbackQuota gives the resources reserved by the api server for the
+// resource back to the tenant if the request fails.
+func rollbackQuota(ctx *osdsCtx.Context, tenantId, resourceId string) {
+	if err := quota.Rollback(ctx, tenantId, resourceId); err != nil {
+		log.Errorf("rollback quota of %s failed: %v", resourceId, err)
+	}
+}
+
+// releaseQuota gives the resources charged for the resource back to the
+// tenant once the resource is deleted.
+func releaseQuota(ctx *osdsCtx.Context, tenantId, resourceId string) {
+	if err := quota.Release(ctx, tenantId, resourceId); err != nil {
+		log.Errorf("release quota of %s failed: %v", resourceId, err)
+	}
+}
+
 // CreateVolume implements pb.ControllerServer.CreateVolume
-func (c *Controller) CreateVolume(contx context.Context, opt *pb.CreateVolumeOpts) (*pb.GenericResponse, error) {
-	var err error
+func (c *Controller) CreateVolume(contx context.Context, opt *pb.CreateVolumeOpts) (res *pb.GenericResponse, err error) {
 	var snap *model.VolumeSnapshotSpec
 	var snapVol *model.VolumeSpec
 
 	log.Info("Controller server receive create volume request, vr =", opt)
 
 	ctx := osdsCtx.NewContextFromJson(opt.GetContext())
+	db.UpdateOperationStatus(ctx, db.C, opt.OperationId, model.OperationRunning, nil)
+	defer func() { finishOperation(ctx, opt.OperationId, err) }()
+	defer func() {
+		if err != nil {
+			rollbackQuota(ctx, ctx.TenantId, opt.Id)
+		}
+	}()
 	prf := model.NewProfileFromJson(opt.Profile)
 
 	if opt.SnapshotId != "" {
@@ -124,6 +193,18 @@ func (c *Controller) CreateVolume(contx context.Context, opt *pb.CreateVolumeOpt
 		opt.Metadata = utils.MergeStringMaps(opt.Metadata, snap.Metadata)
 	}
 
+	var srcVol *model.VolumeSpec
+	if opt.SourceVolumeId != "" {
+		srcVol, err = db.C.GetVolume(ctx, opt.SourceVolumeId)
+		if err != nil {
+			db.UpdateVolumeStatus(ctx, db.C, opt.Id, model.VolumeError)
+			log.Error("get source volume failed in create volume method: ", err)
+			return pb.GenericResponseError(err), err
+		}
+		opt.SourceVolumeSize = srcVol.Size
+		opt.Metadata = utils.MergeStringMaps(opt.Metadata, srcVol.Metadata)
+	}
+
 	// This vol structure is currently fetched from database, but eventually
 	// it will be removed after SelectSupportedPoolForVolume method in selector
 	// is updated.
@@ -135,7 +216,12 @@ func (c *Controller) CreateVolume(contx context.Context, opt *pb.CreateVolumeOpt
 
 	log.V(8).Infof("controller create volume:  get volume from db %+v", vol)
 
-	polInfo, err := c.selector.SelectSupportedPoolForVolume(vol)
+	// The cloned volume should be placed in the pool of its source volume.
+	if srcVol != nil {
+		vol.PoolId = srcVol.PoolId
+	}
+
+	pools, err := c.selector.SelectSupportedPoolsForVolume(vol)
 	if err != nil {
 		db.UpdateVolumeStatus(ctx, db.C, opt.Id, model.VolumeError)
 		return pb.GenericResponseError(err), err
@@ -145,34 +231,49 @@ func (c *Controller) CreateVolume(contx context.Context, opt *pb.CreateVolumeOpt
 	log.V(8).Infof("update volume %+v", vol)
 
 	if vol.MultiAttach {
-		db.C.UpdateVolume(ctx, vol)
-	}
-
-	// whether specify a pool or not, opt's poolid and pool name should be
-	// assigned by polInfo
-	opt.PoolId = polInfo.Id
-	opt.PoolName = polInfo.Name
+		// Only merge the changed fields, vol may be out of date already.
+		db.C.UpdateVolume(ctx, &model.VolumeSpec{
+			BaseModel:   &model.BaseModel{Id: vol.Id},
+			PoolId:      vol.PoolId,
+			MultiAttach: vol.MultiAttach,
+		})
+	}
+
+	// Try the candidate pools in order of their weights, and fall back to
+	// the next one if the volume can't be created in the current pool.
+	var result *model.VolumeSpec
+	var dockInfo *model.DockSpec
+	for _, polInfo := range pools {
+		// whether specify a pool or not, opt's poolid and pool name should be
+		// assigned by polInfo
+		opt.PoolId = polInfo.Id
+		opt.PoolName = polInfo.Name
+
+		dockInfo, err = db.C.GetDock(ctx, polInfo.DockId)
+		if err != nil {
+			log.Error("when search supported dock resource:", err.Error())
+			continue
+		}
+		c.volumeController.SetDock(dockInfo)
+		db.UpdateOperationDock(ctx, db.C, opt.OperationId, dockInfo.Id)
+		opt.DriverName = dockInfo.DriverName
 
-	dockInfo, err := db.C.GetDock(ctx, polInfo.DockId)
-	if err != nil {
-		db.UpdateVolumeStatus(ctx, db.C, opt.Id, model.VolumeError)
-		log.Error("when search supported dock resource:", err.Error())
-		return pb.GenericResponseError(err), err
+		result, err = c.volumeController.CreateVolume(opt)
+		if err == nil {
+			break
+		}
+		log.Errorf("when create volume in pool %s: %v", polInfo.Id, err)
 	}
-	c.volumeController.SetDock(dockInfo)
-	opt.DriverName = dockInfo.DriverName
-
-	result, err := c.volumeController.CreateVolume(opt)
 	if err != nil {
 		// Change the status of the volume to error when the creation faild
-		defer db.UpdateVolumeStatus(ctx, db.C, opt.Id, model.VolumeError)
-		log.Error("when create volume:", err.Error())
+		db.UpdateVolumeStatus(ctx, db.C, opt.Id, model.VolumeError)
 		return pb.GenericResponseError(err), err
 	}
 	result.PoolId, result.ProfileId = opt.GetPoolId(), opt.GetProfileId()
 
 	// Update the volume data in database.
 	db.C.UpdateStatus(ctx, result, model.VolumeAvailable)
+	commitQuota(ctx, ctx.TenantId, opt.Id)
 
 	// Select the storage tag according to the lifecycle flag.
 	c.policyController = policy.NewController(prf)
@@ -191,11 +292,13 @@ func (c *Controller) CreateVolume(contx context.Context, opt *pb.CreateVolumeOpt
 }
 
 // DeleteVolume implements pb.ControllerServer.DeleteVolume
-func (c *Controller) DeleteVolume(contx context.Context, opt *pb.DeleteVolumeOpts) (*pb.GenericResponse, error) {
+func (c *Controller) DeleteVolume(contx context.Context, opt *pb.DeleteVolumeOpts) (res *pb.GenericResponse, err error) {
 
 	log.Info("Controller server receive delete volume request, vr =", opt)
 
 	ctx := osdsCtx.NewContextFromJson(opt.GetContext())
+	db.UpdateOperationStatus(ctx, db.C, opt.OperationId, model.OperationRunning, nil)
+	defer func() { finishOperation(ctx, opt.OperationId, err) }()
 	prf := model.NewProfileFromJson(opt.Profile)
 	// Select the storage tag according to the lifecycle flag.
 	c.policyController = policy.NewController(prf)
@@ -209,6 +312,7 @@ func (c *Controller) DeleteVolume(contx context.Context, opt *pb.DeleteVolumeOpt
 	}
 	c.policyController.SetDock(dockInfo)
 	c.volumeController.SetDock(dockInfo)
+	db.UpdateOperationDock(ctx, db.C, opt.OperationId, dockInfo.Id)
 	opt.DriverName = dockInfo.DriverName
 
 	var errChan = make(chan error, 1)
@@ -226,19 +330,28 @@ func (c *Controller) DeleteVolume(contx context.Context, opt *pb.DeleteVolumeOpt
 		return pb.GenericResponseError(err), err
 	}
 
+	// The volume is read before its entry is deleted, so that the quota can
+	// be released to the tenant owning it.
+	vol, err := db.C.GetVolume(ctx, opt.GetId())
+	if err != nil {
+		return pb.GenericResponseError(err), err
+	}
 	if err = db.C.DeleteVolume(ctx, opt.GetId()); err != nil {
 		return pb.GenericResponseError(err), err
 	}
+	releaseQuota(ctx, vol.TenantId, vol.Id)
 
 	return pb.GenericResponseResult(nil), nil
 }
 
 // ExtendVolume implements pb.ControllerServer.ExtendVolume
-func (c *Controller) ExtendVolume(contx context.Context, opt *pb.ExtendVolumeOpts) (*pb.GenericResponse, error) {
+func (c *Controller) ExtendVolume(contx context.Context, opt *pb.ExtendVolumeOpts) (res *pb.GenericResponse, err error) {
 
 	log.Info("Controller server receive extend volume request, vr =", opt)
 
 	ctx := osdsCtx.NewContextFromJson(opt.GetContext())
+	db.UpdateOperationStatus(ctx, db.C, opt.OperationId, model.OperationRunning, nil)
+	defer func() { finishOperation(ctx, opt.OperationId, err) }()
 	vol, err := db.C.GetVolume(ctx, opt.Id)
 	if err != nil {
 		log.Error("get volume failed in extend volume method: ", err.Error())
@@ -251,6 +364,9 @@ func (c *Controller) ExtendVolume(contx context.Context, opt *pb.ExtendVolumeOpt
 		if rollBack {
 			db.UpdateVolumeStatus(ctx, db.C, opt.Id, model.VolumeAvailable)
 		}
+		if err != nil {
+			rollbackQuota(ctx, vol.TenantId, vol.Id)
+		}
 	}()
 
 	pool, err := db.C.GetPool(ctx, vol.PoolId)
@@ -284,6 +400,7 @@ func (c *Controller) ExtendVolume(contx context.Context, opt *pb.ExtendVolumeOpt
 	}
 	c.policyController.SetDock(dockInfo)
 	c.volumeController.SetDock(dockInfo)
+	db.UpdateOperationDock(ctx, db.C, opt.OperationId, dockInfo.Id)
 	opt.DriverName = dockInfo.DriverName
 
 	result, err := c.volumeController.ExtendVolume(opt)
@@ -297,6 +414,7 @@ func (c *Controller) ExtendVolume(contx context.Context, opt *pb.ExtendVolumeOpt
 	result.Size = newSize
 	result.PoolId, result.ProfileId = opt.GetPoolId(), opt.GetProfileId()
 	db.C.UpdateStatus(ctx, result, model.VolumeAvailable)
+	commitQuota(ctx, vol.TenantId, vol.Id)
 
 	volBody, _ := json.Marshal(result)
 	var errChan = make(chan error, 1)
@@ -311,12 +429,339 @@ func (c *Controller) ExtendVolume(contx context.Context, opt *pb.ExtendVolumeOpt
 	return pb.GenericResponseResult(result), nil
 }
 
+// RetypeVolume implements pb.ControllerServer.RetypeVolume
+func (c *Controller) RetypeVolume(contx context.Context, opt *pb.RetypeVolumeOpts) (res *pb.GenericResponse, err error) {
+
+	log.Info("Controller server receive retype volume request, vr =", opt)
+
+	ctx := osdsCtx.NewContextFromJson(opt.GetContext())
+	db.UpdateOperationStatus(ctx, db.C, opt.OperationId, model.OperationRunning, nil)
+	defer func() { finishOperation(ctx, opt.OperationId, err) }()
+	vol, err := db.C.GetVolume(ctx, opt.Id)
+	if err != nil {
+		log.Error("get volume failed in retype volume method: ", err.Error())
+		return pb.GenericResponseError(err), err
+	}
+
+	// The volume stays in its pool with the old profile if it fails to be
+	// retyped, so roll back the status only.
+	var rollBack = false
+	defer func() {
+		if rollBack {
+			db.UpdateVolumeStatus(ctx, db.C, opt.Id, model.VolumeAvailable)
+		}
+	}()
+
+	pools, err := c.selector.SelectSupportedPoolsForVolume(&model.VolumeSpec{
+		BaseModel:        &model.BaseModel{Id: vol.Id},
+		TenantId:         vol.TenantId,
+		Size:             vol.Size,
+		AvailabilityZone: vol.AvailabilityZone,
+		ProfileId:        opt.ProfileId,
+		PoolId:           opt.PoolId,
+	})
+	if err != nil {
+		log.Error("select pool failed in retype volume method: ", err.Error())
+		rollBack = true
+		return pb.GenericResponseError(err), err
+	}
+
+	// The volume needn't be moved if its pool satisfies the new profile.
+	var result *model.VolumeSpec
+	for _, pool := range pools {
+		if pool.Id == vol.PoolId {
+			result = &model.VolumeSpec{
+				BaseModel: &model.BaseModel{Id: vol.Id},
+				ProfileId: opt.ProfileId,
+			}
+			break
+		}
+	}
+	if result == nil {
+		if opt.MigrationPolicy == model.MigrationPolicyNever {
+			err = fmt.Errorf("pool %s doesn't satisfy profile %s and volume %s is not allowed to be migrated",
+				vol.PoolId, opt.ProfileId, vol.Id)
+			log.Error(err)
+			rollBack = true
+			return pb.GenericResponseError(err), err
+		}
+		if result, err = c.migrateVolume(ctx, vol, pools, opt.ProfileId, opt.OperationId); err != nil {
+			log.Error("migrate volume failed in retype volume method: ", err.Error())
+			rollBack = true
+			return pb.GenericResponseError(err), err
+		}
+	}
+
+	// Swap the owner of the volume in database.
+	result.Status = model.VolumeAvailable
+	if result, err = db.C.UpdateVolume(ctx, result); err != nil {
+		log.Error("update volume failed in retype volume method: ", err.Error())
+		db.UpdateVolumeStatus(ctx, db.C, opt.Id, model.VolumeError)
+		return pb.GenericResponseError(err), err
+	}
+
+	return pb.GenericResponseResult(result), nil
+}
+
+// MigrateVolume implements pb.ControllerServer.MigrateVolume
+func (c *Controller) MigrateVolume(contx context.Context, opt *pb.MigrateVolumeOpts) (res *pb.GenericResponse, err error) {
+
+	log.Info("Controller server receive migrate volume request, vr =", opt)
+
+	ctx := osdsCtx.NewContextFromJson(opt.GetContext())
+	db.UpdateOperationStatus(ctx, db.C, opt.OperationId, model.OperationRunning, nil)
+	defer func() { finishOperation(ctx, opt.OperationId, err) }()
+	vol, err := db.C.GetVolume(ctx, opt.Id)
+	if err != nil {
+		log.Error("get volume failed in migrate volume method: ", err.Error())
+		return pb.GenericResponseError(err), err
+	}
+
+	// The volume stays in its pool if it fails to be migrated, so roll back
+	// the status only.
+	var rollBack = false
+	defer func() {
+		if rollBack {
+			db.UpdateVolumeStatus(ctx, db.C, opt.Id, model.VolumeAvailable)
+		}
+	}()
+
+	pools, err := c.selector.SelectSupportedPoolsForVolume(&model.VolumeSpec{
+		BaseModel:        &model.BaseModel{Id: vol.Id},
+		TenantId:         vol.TenantId,
+		Size:             vol.Size,
+		AvailabilityZone: vol.AvailabilityZone,
+		ProfileId:        vol.ProfileId,
+		PoolId:           opt.PoolId,
+	})
+	if err != nil {
+		log.Error("select pool failed in migrate volume method: ", err.Error())
+		rollBack = true
+		return pb.GenericResponseError(err), err
+	}
+
+	// The volume has to be moved out of the pool it's placed in.
+	var candidates []*model.StoragePoolSpec
+	for _, pool := range pools {
+		if pool.Id != vol.PoolId {
+			candidates = append(candidates, pool)
+		}
+	}
+	if len(candidates) == 0 {
+		err = fmt.Errorf("no other pool satisfies profile %s of volume %s", vol.ProfileId, vol.Id)
+		log.Error(err)
+		rollBack = true
+		return pb.GenericResponseError(err), err
+	}
+
+	result, err := c.migrateVolume(ctx, vol, candidates, vol.ProfileId, opt.OperationId)
+	if err != nil {
+		log.Error("migrate volume failed: ", err.Error())
+		rollBack = true
+		return pb.GenericResponseError(err), err
+	}
+
+	// Swap the owner of the volume in database.
+	result.Status = model.VolumeAvailable
+	if result, err = db.C.UpdateVolume(ctx, result); err != nil {
+		log.Error("update volume failed in migrate volume method: ", err.Error())
+		db.UpdateVolumeStatus(ctx, db.C, opt.Id, model.VolumeError)
+		return pb.GenericResponseError(err), err
+	}
+
+	return pb.GenericResponseResult(result), nil
+}
+
+// RevertVolumeToSnapshot implements pb.ControllerServer.RevertVolumeToSnapshot
+func (c *Controller) RevertVolumeToSnapshot(contx context.Context, opt *pb.RevertVolumeToSnapshotOpts) (res *pb.GenericResponse, err error) {
+
+	log.Info("Controller server receive revert volume to snapshot request, vr =", opt)
+
+	ctx := osdsCtx.NewContextFromJson(opt.GetContext())
+	db.UpdateOperationStatus(ctx, db.C, opt.OperationId, model.OperationRunning, nil)
+	defer func() { finishOperation(ctx, opt.OperationId, err) }()
+
+	// The data of the volume may be partly overwritten once the revert is
+	// started, so the volume is set to error if it fails after that. The
+	// PreconditionError means the revert is refused before it's started.
+	var started = false
+	defer func() {
+		if err == nil {
+			return
+		}
+		if _, ok := err.(*model.PreconditionError); started && !ok {
+			db.UpdateVolumeStatus(ctx, db.C, opt.Id, model.VolumeError)
+		} else {
+			db.UpdateVolumeStatus(ctx, db.C, opt.Id, idleVolumeStatus(ctx, opt.Id))
+		}
+	}()
+
+	vol, err := db.C.GetVolume(ctx, opt.Id)
+	if err != nil {
+		log.Error("get volume failed in revert volume method: ", err)
+		return pb.GenericResponseError(err), err
+	}
+	snap, err := db.C.GetVolumeSnapshot(ctx, opt.SnapshotId)
+	if err != nil {
+		log.Error("get snapshot failed in revert volume method: ", err)
+		return pb.GenericResponseError(err), err
+	}
+	pool, err := db.C.GetPool(ctx, vol.PoolId)
+	if err != nil {
+		log.Error("get pool failed in revert volume method: ", err)
+		return pb.GenericResponseError(err), err
+	}
+	dockInfo, err := db.C.GetDock(ctx, pool.DockId)
+	if err != nil {
+		log.Error("when search supported dock resource: ", err)
+		return pb.GenericResponseError(err), err
+	}
+	c.volumeController.SetDock(dockInfo)
+	db.UpdateOperationDock(ctx, db.C, opt.OperationId, dockInfo.Id)
+
+	opt.Size = vol.Size
+	opt.PoolId, opt.PoolName = pool.Id, pool.Name
+	opt.Metadata = vol.Metadata
+	opt.SnapshotSize = snap.Size
+	opt.SnapshotMetadata = snap.Metadata
+	opt.DriverName = dockInfo.DriverName
+
+	started = true
+	err = c.volumeController.RevertVolumeToSnapshot(opt)
+	if _, ok := err.(*model.NotImplementError); ok {
+		log.Info("Driver doesn't support reverting natively, revert volume through an attacher dock.")
+		err = c.revertVolumeByHost(ctx, vol, snap, pool, dockInfo)
+	}
+	if err != nil {
+		log.Error("revert volume to snapshot failed: ", err)
+		return pb.GenericResponseError(err), err
+	}
+
+	db.UpdateVolumeStatus(ctx, db.C, opt.Id, idleVolumeStatus(ctx, opt.Id))
+	return pb.GenericResponseResult(nil), nil
+}
+
+// ManageVolume implements pb.ControllerServer.ManageVolume
+func (c *Controller) ManageVolume(contx context.Context, opt *pb.ManageVolumeOpts) (res *pb.GenericResponse, err error) {
+
+	log.Info("Controller server receive manage volume request, vr =", opt)
+
+	ctx := osdsCtx.NewContextFromJson(opt.GetContext())
+	db.UpdateOperationStatus(ctx, db.C, opt.OperationId, model.OperationRunning, nil)
+	defer func() { finishOperation(ctx, opt.OperationId, err) }()
+	// Nothing is changed in the backend if the volume fails to be managed,
+	// so its entry is removed instead of being left in error status.
+	defer func() {
+		if err != nil {
+			if err := db.C.DeleteVolume(ctx, opt.Id); err != nil {
+				log.Errorf("delete volume %s failed: %v", opt.Id, err)
+			}
+			rollbackQuota(ctx, ctx.TenantId, opt.Id)
+		}
+	}()
+
+	pool, err := db.C.GetPool(ctx, opt.PoolId)
+	if err != nil {
+		log.Error("get pool failed in manage volume method: ", err)
+		return pb.GenericResponseError(err), err
+	}
+	opt.PoolName = pool.Name
+
+	dockInfo, err := db.C.GetDock(ctx, pool.DockId)
+	if err != nil {
+		log.Error("when search supported dock resource: ", err)
+		return pb.GenericResponseError(err), err
+	}
+	c.volumeController.SetDock(dockInfo)
+	db.UpdateOperationDock(ctx, db.C, opt.OperationId, dockInfo.Id)
+	opt.DriverName = dockInfo.DriverName
+
+	result, err := c.volumeController.ManageVolume(opt)
+	if err != nil {
+		log.Error("manage volume failed: ", err)
+		return pb.GenericResponseError(err), err
+	}
+
+	// The size of the volume is unknown until it's found in the backend, so
+	// its capacity is reserved here rather than in the api server.
+	if err = quota.Reserve(ctx, ctx.TenantId, opt.Id, model.QuotaSet{Capacity: result.Size}); err != nil {
+		log.Error("reserve quota failed in manage volume method: ", err)
+		if err := c.volumeController.UnmanageVolume(&pb.UnmanageVolumeOpts{
+			Id:         opt.Id,
+			PoolId:     opt.PoolId,
+			Metadata:   result.Metadata,
+			DriverName: opt.DriverName,
+			Context:    opt.Context,
+		}); err != nil {
+			log.Errorf("unmanage volume %s failed: %v", opt.Id, err)
+		}
+		return pb.GenericResponseError(err), err
+	}
+	result.PoolId, result.ProfileId = opt.GetPoolId(), opt.GetProfileId()
+
+	db.C.UpdateStatus(ctx, result, model.VolumeAvailable)
+	commitQuota(ctx, ctx.TenantId, opt.Id)
+	return pb.GenericResponseResult(result), nil
+}
+
+// UnmanageVolume implements pb.ControllerServer.UnmanageVolume
+func (c *Controller) UnmanageVolume(contx context.Context, opt *pb.UnmanageVolumeOpts) (res *pb.GenericResponse, err error) {
+
+	log.Info("Controller server receive unmanage volume request, vr =", opt)
+
+	ctx := osdsCtx.NewContextFromJson(opt.GetContext())
+	db.UpdateOperationStatus(ctx, db.C, opt.OperationId, model.OperationRunning, nil)
+	defer func() { finishOperation(ctx, opt.OperationId, err) }()
+	vol, err := db.C.GetVolume(ctx, opt.Id)
+	if err != nil {
+		log.Error("get volume failed in unmanage volume method: ", err)
+		return pb.GenericResponseError(err), err
+	}
+
+	// The volume is still managed if the backend object fails to be
+	// released, so roll back the status only.
+	var rollBack = false
+	defer func() {
+		if rollBack {
+			db.UpdateVolumeStatus(ctx, db.C, opt.Id, model.VolumeAvailable)
+		}
+	}()
+
+	dockInfo, err := db.C.GetDockByPoolId(ctx, vol.PoolId)
+	if err != nil {
+		log.Error("when search supported dock resource: ", err)
+		rollBack = true
+		return pb.GenericResponseError(err), err
+	}
+	c.volumeController.SetDock(dockInfo)
+	db.UpdateOperationDock(ctx, db.C, opt.OperationId, dockInfo.Id)
+	opt.PoolId, opt.Metadata = vol.PoolId, vol.Metadata
+	opt.DriverName = dockInfo.DriverName
+
+	if err = c.volumeController.UnmanageVolume(opt); err != nil {
+		log.Error("unmanage volume failed: ", err)
+		rollBack = true
+		return pb.GenericResponseError(err), err
+	}
+
+	if err = db.C.DeleteVolume(ctx, opt.Id); err != nil {
+		log.Error("delete volume failed in unmanage volume method: ", err)
+		db.UpdateVolumeStatus(ctx, db.C, opt.Id, model.VolumeError)
+		return pb.GenericResponseError(err), err
+	}
+	releaseQuota(ctx, vol.TenantId, vol.Id)
+
+	return pb.GenericResponseResult(nil), nil
+}
+
 // CreateVolumeAttachment implements pb.ControllerServer.CreateVolumeAttachment
-func (c *Controller) CreateVolumeAttachment(contx context.Context, opt *pb.CreateVolumeAttachmentOpts) (*pb.GenericResponse, error) {
+func (c *Controller) CreateVolumeAttachment(contx context.Context, opt *pb.CreateVolumeAttachmentOpts) (res *pb.GenericResponse, err error) {
 
 	log.Info("Controller server receive create volume attachment request, vr =", opt)
 
 	ctx := osdsCtx.NewContextFromJson(opt.GetContext())
+	db.UpdateOperationStatus(ctx, db.C, opt.OperationId, model.OperationRunning, nil)
+	defer func() { finishOperation(ctx, opt.OperationId, err) }()
 	vol, err := db.C.GetVolume(ctx, opt.VolumeId)
 	if err != nil {
 		msg := fmt.Sprintf("get volume failed in create volume attachment method: %v", err)
@@ -348,6 +793,7 @@ func (c *Controller) CreateVolumeAttachment(contx context.Context, opt *pb.Creat
 		return pb.GenericResponseError(msg), err
 	}
 	c.volumeController.SetDock(dockInfo)
+	db.UpdateOperationDock(ctx, db.C, opt.OperationId, dockInfo.Id)
 	opt.DriverName = dockInfo.DriverName
 
 	result, err := c.volumeController.CreateVolumeAttachment(opt)
@@ -368,149 +814,463 @@ func (c *Controller) CreateVolumeAttachment(contx context.Context, opt *pb.Creat
 		return pb.GenericResponseError(msg), err
 	}
 
-	result.Status = model.VolumeAttachAvailable
+	result.Status = model.VolumeAttachAvailable
+
+	log.V(8).Infof("Create volume attachment successfully, the info is %v", result)
+	// Save changes to db.
+	db.C.UpdateVolumeAttachment(ctx, opt.Id, result)
+
+	return pb.GenericResponseResult(result), nil
+}
+
+// DeleteVolumeAttachment implements pb.ControllerServer.DeleteVolumeAttachment
+func (c *Controller) DeleteVolumeAttachment(contx context.Context, opt *pb.DeleteVolumeAttachmentOpts) (res *pb.GenericResponse, err error) {
+
+	log.Info("Controller server receive delete volume attachment request, vr =", opt)
+
+	ctx := osdsCtx.NewContextFromJson(opt.GetContext())
+	db.UpdateOperationStatus(ctx, db.C, opt.OperationId, model.OperationRunning, nil)
+	defer func() { finishOperation(ctx, opt.OperationId, err) }()
+	vol, err := db.C.GetVolume(ctx, opt.VolumeId)
+	if err != nil {
+		msg := fmt.Sprintf("get volume failed in delete volume attachment method: %v", err)
+		log.Error(msg)
+		return pb.GenericResponseError(msg), err
+	}
+	opt.Metadata = utils.MergeStringMaps(opt.Metadata, vol.Metadata)
+
+	dockInfo, err := db.C.GetDockByPoolId(ctx, vol.PoolId)
+	if err != nil {
+		msg := fmt.Sprintf("when search supported dock resource: %v", err)
+		log.Error(msg)
+		return pb.GenericResponseError(msg), err
+	}
+
+	c.volumeController.SetDock(dockInfo)
+	db.UpdateOperationDock(ctx, db.C, opt.OperationId, dockInfo.Id)
+	opt.DriverName = dockInfo.DriverName
+
+	if err = c.volumeController.DeleteVolumeAttachment(opt); err != nil {
+		msg := fmt.Sprintf("delete volume attachment failed: %v", err)
+		log.Error(msg)
+		db.UpdateVolumeAttachmentStatus(ctx, db.C, opt.Id, model.VolumeAttachErrorDeleting)
+		return pb.GenericResponseError(msg), err
+	}
+
+	if err = db.C.DeleteVolumeAttachment(ctx, opt.Id); err != nil {
+		msg := fmt.Sprintf("error occurred in dock module when delete volume attachment in db: %v", err)
+		log.Error(msg)
+		return pb.GenericResponseError(msg), err
+	}
+
+	db.UpdateVolumeStatus(ctx, db.C, vol.Id, model.VolumeAvailable)
+
+	return pb.GenericResponseResult(nil), nil
+}
+
+// CreateVolumeSnapshot implements pb.ControllerServer.CreateVolumeSnapshot
+func (c *Controller) CreateVolumeSnapshot(contx context.Context, opt *pb.CreateVolumeSnapshotOpts) (res *pb.GenericResponse, err error) {
+
+	log.Info("Controller server receive create volume snapshot request, vr =", opt)
+
+	ctx := osdsCtx.NewContextFromJson(opt.GetContext())
+	db.UpdateOperationStatus(ctx, db.C, opt.OperationId, model.OperationRunning, nil)
+	defer func() { finishOperation(ctx, opt.OperationId, err) }()
+	defer func() {
+		if err != nil {
+			rollbackQuota(ctx, ctx.TenantId, opt.Id)
+		}
+	}()
+	if opt.Metadata == nil {
+		opt.Metadata = map[string]string{}
+	}
+
+	profile := model.NewProfileFromJson(opt.Profile)
+	if profile.SnapshotProperties.Topology.Bucket != "" {
+		opt.Metadata["bucket"] = profile.SnapshotProperties.Topology.Bucket
+	}
+
+	vol, err := db.C.GetVolume(ctx, opt.VolumeId)
+	if err != nil {
+		log.Error("get volume failed in create volume snapshot method: ", err)
+		db.UpdateVolumeSnapshotStatus(ctx, db.C, opt.Id, model.VolumeSnapError)
+		return pb.GenericResponseError(err), err
+	}
+	opt.Size = vol.Size
+	opt.Metadata = utils.MergeStringMaps(opt.Metadata, vol.Metadata)
+
+	dockInfo, err := db.C.GetDockByPoolId(ctx, vol.PoolId)
+	if err != nil {
+		log.Error("when search supported dock resource: ", err)
+		db.UpdateVolumeSnapshotStatus(ctx, db.C, opt.Id, model.VolumeSnapError)
+		return pb.GenericResponseError(err), err
+	}
+	c.volumeController.SetDock(dockInfo)
+	db.UpdateOperationDock(ctx, db.C, opt.OperationId, dockInfo.Id)
+	opt.DriverName = dockInfo.DriverName
+
+	result, err := c.volumeController.CreateVolumeSnapshot(opt)
+	if err != nil {
+		db.UpdateVolumeSnapshotStatus(ctx, db.C, opt.Id, model.VolumeSnapError)
+		return pb.GenericResponseError(err), err
+	}
+
+	db.C.UpdateStatus(ctx, result, model.VolumeSnapAvailable)
+	commitQuota(ctx, ctx.TenantId, opt.Id)
+	return pb.GenericResponseResult(result), nil
+}
+
+// DeleteVolumeSnapshot implements pb.ControllerServer.DeleteVolumeSnapshot
+func (c *Controller) DeleteVolumeSnapshot(contx context.Context, opt *pb.DeleteVolumeSnapshotOpts) (res *pb.GenericResponse, err error) {
+
+	log.Info("Controller server receive delete volume snapshot request, vr =", opt)
+
+	ctx := osdsCtx.NewContextFromJson(opt.GetContext())
+	db.UpdateOperationStatus(ctx, db.C, opt.OperationId, model.OperationRunning, nil)
+	defer func() { finishOperation(ctx, opt.OperationId, err) }()
+	vol, err := db.C.GetVolume(ctx, opt.VolumeId)
+	if err != nil {
+		log.Error("get volume failed in delete volume snapshot method: ", err)
+		db.UpdateVolumeSnapshotStatus(ctx, db.C, opt.Id, model.VolumeSnapErrorDeleting)
+		return pb.GenericResponseError(err), err
+	}
+
+	opt.Metadata = utils.MergeStringMaps(opt.Metadata, vol.Metadata)
+	prf := model.NewProfileFromJson(opt.Profile)
+	// Select the storage tag according to the lifecycle flag.
+	c.policyController = policy.NewController(prf)
+	c.policyController.Setup(DELETE_LIFECIRCLE_FLAG)
+
+	dockInfo, err := db.C.GetDockByPoolId(ctx, vol.PoolId)
+	if err != nil {
+		log.Error("when search supported dock resource: ", err)
+		db.UpdateVolumeSnapshotStatus(ctx, db.C, opt.Id, model.VolumeSnapErrorDeleting)
+		return pb.GenericResponseError(err), err
+	}
+	c.volumeController.SetDock(dockInfo)
+	db.UpdateOperationDock(ctx, db.C, opt.OperationId, dockInfo.Id)
+	opt.DriverName = dockInfo.DriverName
+
+	if err = c.volumeController.DeleteVolumeSnapshot(opt); err != nil {
+		log.Error("error occurred in controller module when delete volume snapshot: ", err)
+		db.UpdateVolumeSnapshotStatus(ctx, db.C, opt.Id, model.VolumeSnapErrorDeleting)
+		return pb.GenericResponseError(err), err
+	}
+	// The snapshot is read before its entry is deleted, so that the quota
+	// can be released to the tenant owning it.
+	snap, err := db.C.GetVolumeSnapshot(ctx, opt.Id)
+	if err != nil {
+		log.Error("get volume snapshot failed in delete volume snapshot method: ", err)
+		return pb.GenericResponseError(err), err
+	}
+	if err = db.C.DeleteVolumeSnapshot(ctx, opt.Id); err != nil {
+		log.Error("error occurred in controller module when delete volume snapshot in db: ", err)
+		db.UpdateVolumeSnapshotStatus(ctx, db.C, opt.Id, model.VolumeSnapErrorDeleting)
+		return pb.GenericResponseError(err), err
+	}
+	releaseQuota(ctx, snap.TenantId, snap.Id)
+
+	return pb.GenericResponseResult(nil), nil
+}
+
+// ManageVolumeSnapshot implements pb.ControllerServer.ManageVolumeSnapshot
+func (c *Controller) ManageVolumeSnapshot(contx context.Context, opt *pb.ManageVolumeSnapshotOpts) (res *pb.GenericResponse, err error) {
+
+	log.Info("Controller server receive manage volume snapshot request, vr =", opt)
+
+	ctx := osdsCtx.NewContextFromJson(opt.GetContext())
+	db.UpdateOperationStatus(ctx, db.C, opt.OperationId, model.OperationRunning, nil)
+	defer func() { finishOperation(ctx, opt.OperationId, err) }()
+	// Nothing is changed in the backend if the snapshot fails to be managed,
+	// so its entry is removed instead of being left in error status.
+	defer func() {
+		if err != nil {
+			if err := db.C.DeleteVolumeSnapshot(ctx, opt.Id); err != nil {
+				log.Errorf("delete volume snapshot %s failed: %v", opt.Id, err)
+			}
+			rollbackQuota(ctx, ctx.TenantId, opt.Id)
+		}
+	}()
+
+	vol, err := db.C.GetVolume(ctx, opt.VolumeId)
+	if err != nil {
+		log.Error("get volume failed in manage volume snapshot method: ", err)
+		return pb.GenericResponseError(err), err
+	}
+	opt.Size = vol.Size
+	opt.Metadata = utils.MergeStringMaps(opt.Metadata, vol.Metadata)
+
+	dockInfo, err := db.C.GetDockByPoolId(ctx, vol.PoolId)
+	if err != nil {
+		log.Error("when search supported dock resource: ", err)
+		return pb.GenericResponseError(err), err
+	}
+	c.volumeController.SetDock(dockInfo)
+	db.UpdateOperationDock(ctx, db.C, opt.OperationId, dockInfo.Id)
+	opt.DriverName = dockInfo.DriverName
+
+	result, err := c.volumeController.ManageVolumeSnapshot(opt)
+	if err != nil {
+		log.Error("manage volume snapshot failed: ", err)
+		return pb.GenericResponseError(err), err
+	}
+
+	db.C.UpdateStatus(ctx, result, model.VolumeSnapAvailable)
+	commitQuota(ctx, ctx.TenantId, opt.Id)
+	return pb.GenericResponseResult(result), nil
+}
+
+// UnmanageVolumeSnapshot implements pb.ControllerServer.UnmanageVolumeSnapshot
+func (c *Controller) UnmanageVolumeSnapshot(contx context.Context, opt *pb.UnmanageVolumeSnapshotOpts) (res *pb.GenericResponse, err error) {
+
+	log.Info("Controller server receive unmanage volume snapshot request, vr =", opt)
+
+	ctx := osdsCtx.NewContextFromJson(opt.GetContext())
+	db.UpdateOperationStatus(ctx, db.C, opt.OperationId, model.OperationRunning, nil)
+	defer func() { finishOperation(ctx, opt.OperationId, err) }()
+	snap, err := db.C.GetVolumeSnapshot(ctx, opt.Id)
+	if err != nil {
+		log.Error("get volume snapshot failed in unmanage volume snapshot method: ", err)
+		return pb.GenericResponseError(err), err
+	}
+
+	// The snapshot is still managed if the backend object fails to be
+	// released, so roll back the status only.
+	var rollBack = false
+	defer func() {
+		if rollBack {
+			db.UpdateVolumeSnapshotStatus(ctx, db.C, opt.Id, model.VolumeSnapAvailable)
+		}
+	}()
+
+	vol, err := db.C.GetVolume(ctx, snap.VolumeId)
+	if err != nil {
+		log.Error("get volume failed in unmanage volume snapshot method: ", err)
+		rollBack = true
+		return pb.GenericResponseError(err), err
+	}
+	// The metadata of the snapshot takes precedence, which records the name
+	// the snapshot is restored to.
+	opt.VolumeId = vol.Id
+	opt.Metadata = utils.MergeStringMaps(vol.Metadata, snap.Metadata)
+
+	dockInfo, err := db.C.GetDockByPoolId(ctx, vol.PoolId)
+	if err != nil {
+		log.Error("when search supported dock resource: ", err)
+		rollBack = true
+		return pb.GenericResponseError(err), err
+	}
+	c.volumeController.SetDock(dockInfo)
+	db.UpdateOperationDock(ctx, db.C, opt.OperationId, dockInfo.Id)
+	opt.DriverName = dockInfo.DriverName
+
+	if err = c.volumeController.UnmanageVolumeSnapshot(opt); err != nil {
+		log.Error("unmanage volume snapshot failed: ", err)
+		rollBack = true
+		return pb.GenericResponseError(err), err
+	}
 
-	log.V(8).Infof("Create volume attachment successfully, the info is %v", result)
-	// Save changes to db.
-	db.C.UpdateVolumeAttachment(ctx, opt.Id, result)
+	if err = db.C.DeleteVolumeSnapshot(ctx, opt.Id); err != nil {
+		log.Error("delete volume snapshot failed in unmanage volume snapshot method: ", err)
+		db.UpdateVolumeSnapshotStatus(ctx, db.C, opt.Id, model.VolumeSnapError)
+		return pb.GenericResponseError(err), err
+	}
+	releaseQuota(ctx, snap.TenantId, snap.Id)
 
-	return pb.GenericResponseResult(result), nil
+	return pb.GenericResponseResult(nil), nil
 }
 
-// DeleteVolumeAttachment implements pb.ControllerServer.DeleteVolumeAttachment
-func (c *Controller) DeleteVolumeAttachment(contx context.Context, opt *pb.DeleteVolumeAttachmentOpts) (*pb.GenericResponse, error) {
+// getAccessProtocol returns the protocol used to attach the volumes in the
+// pool, the default protocol is iscsi.
+func getAccessProtocol(pol *model.StoragePoolSpec) string {
+	if pol.Extras.IOConnectivity.AccessProtocol == "" {
+		return "iscsi"
+	}
+	return pol.Extras.IOConnectivity.AccessProtocol
+}
 
-	log.Info("Controller server receive delete volume attachment request, vr =", opt)
+// CreateVolumeBackup implements pb.ControllerServer.CreateVolumeBackup
+func (c *Controller) CreateVolumeBackup(contx context.Context, opt *pb.CreateVolumeBackupOpts) (res *pb.GenericResponse, err error) {
+
+	log.Info("Controller server receive create volume backup request, vr =", opt)
 
 	ctx := osdsCtx.NewContextFromJson(opt.GetContext())
+	db.UpdateOperationStatus(ctx, db.C, opt.OperationId, model.OperationRunning, nil)
+	defer func() { finishOperation(ctx, opt.OperationId, err) }()
+
 	vol, err := db.C.GetVolume(ctx, opt.VolumeId)
 	if err != nil {
-		msg := fmt.Sprintf("get volume failed in delete volume attachment method: %v", err)
-		log.Error(msg)
-		return pb.GenericResponseError(msg), err
+		log.Error("get volume failed in create volume backup method: ", err)
+		db.UpdateBackupStatus(ctx, db.C, opt.Id, model.BackupError)
+		return pb.GenericResponseError(err), err
 	}
-	opt.Metadata = utils.MergeStringMaps(opt.Metadata, vol.Metadata)
+	opt.Size = vol.Size
+	opt.SourceMetadata = utils.MergeStringMaps(opt.SourceMetadata, vol.Metadata)
 
-	dockInfo, err := db.C.GetDockByPoolId(ctx, vol.PoolId)
+	if opt.SnapshotId != "" {
+		snap, err := db.C.GetVolumeSnapshot(ctx, opt.SnapshotId)
+		if err != nil {
+			log.Error("get snapshot failed in create volume backup method: ", err)
+			db.UpdateBackupStatus(ctx, db.C, opt.Id, model.BackupError)
+			return pb.GenericResponseError(err), err
+		}
+		opt.SourceMetadata = utils.MergeStringMaps(opt.SourceMetadata, snap.Metadata)
+	}
+
+	pol, err := db.C.GetPool(ctx, vol.PoolId)
 	if err != nil {
-		msg := fmt.Sprintf("when search supported dock resource: %v", err)
-		log.Error(msg)
-		return pb.GenericResponseError(msg), err
+		log.Error("get pool failed in create volume backup method: ", err)
+		db.UpdateBackupStatus(ctx, db.C, opt.Id, model.BackupError)
+		return pb.GenericResponseError(err), err
 	}
+	opt.AccessProtocol = getAccessProtocol(pol)
 
+	dockInfo, err := db.C.GetDock(ctx, pol.DockId)
+	if err != nil {
+		log.Error("when search supported dock resource: ", err)
+		db.UpdateBackupStatus(ctx, db.C, opt.Id, model.BackupError)
+		return pb.GenericResponseError(err), err
+	}
 	c.volumeController.SetDock(dockInfo)
+	db.UpdateOperationDock(ctx, db.C, opt.OperationId, dockInfo.Id)
 	opt.DriverName = dockInfo.DriverName
 
-	if err = c.volumeController.DeleteVolumeAttachment(opt); err != nil {
-		msg := fmt.Sprintf("delete volume attachment failed: %v", err)
-		log.Error(msg)
-		db.UpdateVolumeAttachmentStatus(ctx, db.C, opt.Id, model.VolumeAttachErrorDeleting)
-		return pb.GenericResponseError(msg), err
+	result, err := c.volumeController.CreateVolumeBackup(opt)
+	if err != nil {
+		log.Error("error occurred in controller module when create volume backup: ", err)
+		db.UpdateBackupStatus(ctx, db.C, opt.Id, model.BackupError)
+		return pb.GenericResponseError(err), err
 	}
 
-	if err = db.C.DeleteVolumeAttachment(ctx, opt.Id); err != nil {
-		msg := fmt.Sprintf("error occurred in dock module when delete volume attachment in db: %v", err)
-		log.Error(msg)
-		return pb.GenericResponseError(msg), err
+	result.Size, result.PoolId = vol.Size, vol.PoolId
+	result.Status = model.BackupAvailable
+	if result, err = db.C.UpdateBackup(ctx, opt.Id, result); err != nil {
+		log.Error("error occurred in controller module when update volume backup in db: ", err)
+		return pb.GenericResponseError(err), err
 	}
-
-	db.UpdateVolumeStatus(ctx, db.C, vol.Id, model.VolumeAvailable)
-
-	return pb.GenericResponseResult(nil), nil
+	return pb.GenericResponseResult(result), nil
 }
 
-// CreateVolumeSnapshot implements pb.ControllerServer.CreateVolumeSnapshot
-func (c *Controller) CreateVolumeSnapshot(contx context.Context, opt *pb.CreateVolumeSnapshotOpts) (*pb.GenericResponse, error) {
+// DeleteVolumeBackup implements pb.ControllerServer.DeleteVolumeBackup
+func (c *Controller) DeleteVolumeBackup(contx context.Context, opt *pb.DeleteVolumeBackupOpts) (res *pb.GenericResponse, err error) {
 
-	log.Info("Controller server receive create volume snapshot request, vr =", opt)
+	log.Info("Controller server receive delete volume backup request, vr =", opt)
 
 	ctx := osdsCtx.NewContextFromJson(opt.GetContext())
-	if opt.Metadata == nil {
-		opt.Metadata = map[string]string{}
-	}
-
-	profile := model.NewProfileFromJson(opt.Profile)
-	if profile.SnapshotProperties.Topology.Bucket != "" {
-		opt.Metadata["bucket"] = profile.SnapshotProperties.Topology.Bucket
-	}
+	db.UpdateOperationStatus(ctx, db.C, opt.OperationId, model.OperationRunning, nil)
+	defer func() { finishOperation(ctx, opt.OperationId, err) }()
 
-	vol, err := db.C.GetVolume(ctx, opt.VolumeId)
+	backup, err := db.C.GetBackup(ctx, opt.Id)
 	if err != nil {
-		log.Error("get volume failed in create volume snapshot method: ", err)
-		db.UpdateVolumeSnapshotStatus(ctx, db.C, opt.Id, model.VolumeSnapError)
+		log.Error("get volume backup failed in delete volume backup method: ", err)
+		db.UpdateBackupStatus(ctx, db.C, opt.Id, model.BackupErrorDeleting)
 		return pb.GenericResponseError(err), err
 	}
-	opt.Size = vol.Size
-	opt.Metadata = utils.MergeStringMaps(opt.Metadata, vol.Metadata)
+	opt.Metadata = utils.MergeStringMaps(opt.Metadata, backup.Metadata)
+	opt.BackupDriver = backup.BackupDriver
 
-	dockInfo, err := db.C.GetDockByPoolId(ctx, vol.PoolId)
+	// The backup data is kept by the backup driver instead of the storage
+	// backend, so any dock serving the pool of the backed up volume can
+	// remove it.
+	dockInfo, err := db.C.GetDockByPoolId(ctx, backup.PoolId)
 	if err != nil {
 		log.Error("when search supported dock resource: ", err)
-		db.UpdateVolumeSnapshotStatus(ctx, db.C, opt.Id, model.VolumeSnapError)
+		db.UpdateBackupStatus(ctx, db.C, opt.Id, model.BackupErrorDeleting)
 		return pb.GenericResponseError(err), err
 	}
 	c.volumeController.SetDock(dockInfo)
+	db.UpdateOperationDock(ctx, db.C, opt.OperationId, dockInfo.Id)
 	opt.DriverName = dockInfo.DriverName
 
-	result, err := c.volumeController.CreateVolumeSnapshot(opt)
-	if err != nil {
-		db.UpdateVolumeSnapshotStatus(ctx, db.C, opt.Id, model.VolumeSnapError)
+	if err = c.volumeController.DeleteVolumeBackup(opt); err != nil {
+		log.Error("error occurred in controller module when delete volume backup: ", err)
+		db.UpdateBackupStatus(ctx, db.C, opt.Id, model.BackupErrorDeleting)
+		return pb.GenericResponseError(err), err
+	}
+	if err = db.C.DeleteBackup(ctx, opt.Id); err != nil {
+		log.Error("error occurred in controller module when delete volume backup in db: ", err)
+		db.UpdateBackupStatus(ctx, db.C, opt.Id, model.BackupErrorDeleting)
 		return pb.GenericResponseError(err), err
 	}
 
-	db.C.UpdateStatus(ctx, result, model.VolumeSnapAvailable)
-	return pb.GenericResponseResult(result), nil
+	return pb.GenericResponseResult(nil), nil
 }
 
-// DeleteVolumeSnapshot implements pb.ControllerServer.DeleteVolumeSnapshot
-func (c *Controller) DeleteVolumeSnapshot(contx context.Context, opt *pb.DeleteVolumeSnapshotOpts) (*pb.GenericResponse, error) {
+// RestoreVolumeBackup implements pb.ControllerServer.RestoreVolumeBackup
+func (c *Controller) RestoreVolumeBackup(contx context.Context, opt *pb.RestoreVolumeBackupOpts) (res *pb.GenericResponse, err error) {
 
-	log.Info("Controller server receive delete volume snapshot request, vr =", opt)
+	log.Info("Controller server receive restore volume backup request, vr =", opt)
 
 	ctx := osdsCtx.NewContextFromJson(opt.GetContext())
+	db.UpdateOperationStatus(ctx, db.C, opt.OperationId, model.OperationRunning, nil)
+	defer func() { finishOperation(ctx, opt.OperationId, err) }()
+	// The backup itself is not changed by restoring, so it will always be
+	// available again no matter whether the restoring succeeds or not.
+	defer db.UpdateBackupStatus(ctx, db.C, opt.Id, model.BackupAvailable)
+
+	backup, err := db.C.GetBackup(ctx, opt.Id)
+	if err != nil {
+		log.Error("get volume backup failed in restore volume backup method: ", err)
+		db.UpdateVolumeStatus(ctx, db.C, opt.VolumeId, model.VolumeErrorRestoring)
+		return pb.GenericResponseError(err), err
+	}
+	opt.Size = backup.Size
+	opt.Metadata = utils.MergeStringMaps(opt.Metadata, backup.Metadata)
+	opt.BackupDriver = backup.BackupDriver
+
+	if newVol := opt.GetNewVolume(); newVol != nil {
+		if _, err = c.CreateVolume(contx, newVol); err != nil {
+			log.Error("create volume failed in restore volume backup method: ", err)
+			return pb.GenericResponseError(err), err
+		}
+		db.UpdateVolumeStatus(ctx, db.C, opt.VolumeId, model.VolumeRestoring)
+	}
+
 	vol, err := db.C.GetVolume(ctx, opt.VolumeId)
 	if err != nil {
-		log.Error("get volume failed in delete volume snapshot method: ", err)
-		db.UpdateVolumeSnapshotStatus(ctx, db.C, opt.Id, model.VolumeSnapErrorDeleting)
+		log.Error("get volume failed in restore volume backup method: ", err)
+		db.UpdateVolumeStatus(ctx, db.C, opt.VolumeId, model.VolumeErrorRestoring)
 		return pb.GenericResponseError(err), err
 	}
+	opt.VolumeMetadata = utils.MergeStringMaps(opt.VolumeMetadata, vol.Metadata)
 
-	opt.Metadata = utils.MergeStringMaps(opt.Metadata, vol.Metadata)
-	prf := model.NewProfileFromJson(opt.Profile)
-	// Select the storage tag according to the lifecycle flag.
-	c.policyController = policy.NewController(prf)
-	c.policyController.Setup(DELETE_LIFECIRCLE_FLAG)
+	pol, err := db.C.GetPool(ctx, vol.PoolId)
+	if err != nil {
+		log.Error("get pool failed in restore volume backup method: ", err)
+		db.UpdateVolumeStatus(ctx, db.C, opt.VolumeId, model.VolumeErrorRestoring)
+		return pb.GenericResponseError(err), err
+	}
+	opt.AccessProtocol = getAccessProtocol(pol)
 
-	dockInfo, err := db.C.GetDockByPoolId(ctx, vol.PoolId)
+	dockInfo, err := db.C.GetDock(ctx, pol.DockId)
 	if err != nil {
 		log.Error("when search supported dock resource: ", err)
-		db.UpdateVolumeSnapshotStatus(ctx, db.C, opt.Id, model.VolumeSnapErrorDeleting)
+		db.UpdateVolumeStatus(ctx, db.C, opt.VolumeId, model.VolumeErrorRestoring)
 		return pb.GenericResponseError(err), err
 	}
 	c.volumeController.SetDock(dockInfo)
+	db.UpdateOperationDock(ctx, db.C, opt.OperationId, dockInfo.Id)
 	opt.DriverName = dockInfo.DriverName
 
-	if err = c.volumeController.DeleteVolumeSnapshot(opt); err != nil {
-		log.Error("error occurred in controller module when delete volume snapshot: ", err)
-		db.UpdateVolumeSnapshotStatus(ctx, db.C, opt.Id, model.VolumeSnapErrorDeleting)
-		return pb.GenericResponseError(err), err
-	}
-	if err = db.C.DeleteVolumeSnapshot(ctx, opt.Id); err != nil {
-		log.Error("error occurred in controller module when delete volume snapshot in db: ", err)
-		db.UpdateVolumeSnapshotStatus(ctx, db.C, opt.Id, model.VolumeSnapErrorDeleting)
+	if err = c.volumeController.RestoreVolumeBackup(opt); err != nil {
+		log.Error("error occurred in controller module when restore volume backup: ", err)
+		db.UpdateVolumeStatus(ctx, db.C, opt.VolumeId, model.VolumeErrorRestoring)
 		return pb.GenericResponseError(err), err
 	}
 
+	db.UpdateVolumeStatus(ctx, db.C, opt.VolumeId, model.VolumeAvailable)
 	return pb.GenericResponseResult(nil), nil
 }
 
 // CreateReplication implements pb.ControllerServer.CreateReplication
-func (c *Controller) CreateReplication(contx context.Context, opt *pb.CreateReplicationOpts) (*pb.GenericResponse, error) {
+func (c *Controller) CreateReplication(contx context.Context, opt *pb.CreateReplicationOpts) (res *pb.GenericResponse, err error) {
 	// TODO: Get profile and do some policy action.
 
 	log.Info("Controller server receive create volume replication request, vr =", opt)
 
 	ctx := osdsCtx.NewContextFromJson(opt.GetContext())
+	db.UpdateOperationStatus(ctx, db.C, opt.OperationId, model.OperationRunning, nil)
+	defer func() { finishOperation(ctx, opt.OperationId, err) }()
 	pvol, err := db.C.GetVolume(ctx, opt.PrimaryVolumeId)
 	if err != nil {
 		db.UpdateReplicationStatus(ctx, db.C, opt.Id, model.ReplicationError)
@@ -547,11 +1307,13 @@ func (c *Controller) CreateReplication(contx context.Context, opt *pb.CreateRepl
 }
 
 // DeleteReplication implements pb.ControllerServer.DeleteReplication
-func (c *Controller) DeleteReplication(contx context.Context, opt *pb.DeleteReplicationOpts) (*pb.GenericResponse, error) {
+func (c *Controller) DeleteReplication(contx context.Context, opt *pb.DeleteReplicationOpts) (res *pb.GenericResponse, err error) {
 
 	log.Info("Controller server receive delete volume replication request, vr =", opt)
 
 	ctx := osdsCtx.NewContextFromJson(opt.GetContext())
+	db.UpdateOperationStatus(ctx, db.C, opt.OperationId, model.OperationRunning, nil)
+	defer func() { finishOperation(ctx, opt.OperationId, err) }()
 	pvol, err := db.C.GetVolume(ctx, opt.PrimaryVolumeId)
 	if err != nil {
 		db.UpdateReplicationStatus(ctx, db.C, opt.Id, model.ReplicationErrorDeleting)
@@ -586,11 +1348,13 @@ func (c *Controller) DeleteReplication(contx context.Context, opt *pb.DeleteRepl
 }
 
 // EnableReplication implements pb.ControllerServer.EnableReplication
-func (c *Controller) EnableReplication(contx context.Context, opt *pb.EnableReplicationOpts) (*pb.GenericResponse, error) {
+func (c *Controller) EnableReplication(contx context.Context, opt *pb.EnableReplicationOpts) (res *pb.GenericResponse, err error) {
 
 	log.Info("Controller server receive enable volume replication request, vr =", opt)
 
 	ctx := osdsCtx.NewContextFromJson(opt.GetContext())
+	db.UpdateOperationStatus(ctx, db.C, opt.OperationId, model.OperationRunning, nil)
+	defer func() { finishOperation(ctx, opt.OperationId, err) }()
 	pvol, err := db.C.GetVolume(ctx, opt.PrimaryVolumeId)
 	if err != nil {
 		db.UpdateReplicationStatus(ctx, db.C, opt.Id, model.ReplicationErrorEnabling)
@@ -620,11 +1384,13 @@ func (c *Controller) EnableReplication(contx context.Context, opt *pb.EnableRepl
 }
 
 // DisableReplication implements pb.ControllerServer.DisableReplication
-func (c *Controller) DisableReplication(contx context.Context, opt *pb.DisableReplicationOpts) (*pb.GenericResponse, error) {
+func (c *Controller) DisableReplication(contx context.Context, opt *pb.DisableReplicationOpts) (res *pb.GenericResponse, err error) {
 
 	log.Info("Controller server receive disable volume replication request, vr =", opt)
 
 	ctx := osdsCtx.NewContextFromJson(opt.GetContext())
+	db.UpdateOperationStatus(ctx, db.C, opt.OperationId, model.OperationRunning, nil)
+	defer func() { finishOperation(ctx, opt.OperationId, err) }()
 	pvol, err := db.C.GetVolume(ctx, opt.PrimaryVolumeId)
 	if err != nil {
 		db.UpdateReplicationStatus(ctx, db.C, opt.Id, model.ReplicationErrorDisabling)
@@ -654,11 +1420,13 @@ func (c *Controller) DisableReplication(contx context.Context, opt *pb.DisableRe
 }
 
 // FailoverReplication implements pb.ControllerServer.FailoverReplication
-func (c *Controller) FailoverReplication(contx context.Context, opt *pb.FailoverReplicationOpts) (*pb.GenericResponse, error) {
+func (c *Controller) FailoverReplication(contx context.Context, opt *pb.FailoverReplicationOpts) (res *pb.GenericResponse, err error) {
 
 	log.Info("Controller server receive failover volume replication request, vr =", opt)
 
 	ctx := osdsCtx.NewContextFromJson(opt.GetContext())
+	db.UpdateOperationStatus(ctx, db.C, opt.OperationId, model.OperationRunning, nil)
+	defer func() { finishOperation(ctx, opt.OperationId, err) }()
 	pvol, err := db.C.GetVolume(ctx, opt.PrimaryVolumeId)
 	if err != nil {
 		db.UpdateReplicationStatus(ctx, db.C, opt.Id, model.ReplicationErrorFailover)
@@ -703,11 +1471,13 @@ func (c *Controller) FailoverReplication(contx context.Context, opt *pb.Failover
 }
 
 // CreateVolumeGroup implements pb.ControllerServer.CreateVolumeGroup
-func (c *Controller) CreateVolumeGroup(contx context.Context, opt *pb.CreateVolumeGroupOpts) (*pb.GenericResponse, error) {
+func (c *Controller) CreateVolumeGroup(contx context.Context, opt *pb.CreateVolumeGroupOpts) (res *pb.GenericResponse, err error) {
 
 	log.Info("Controller server receive create volume group request, vr =", opt)
 
 	ctx := osdsCtx.NewContextFromJson(opt.GetContext())
+	db.UpdateOperationStatus(ctx, db.C, opt.OperationId, model.OperationRunning, nil)
+	defer func() { finishOperation(ctx, opt.OperationId, err) }()
 	// This vg structure is currently fetched from database, but eventually
 	// it will be removed after SelectSupportedPoolForVG method in selector
 	// is updated.
@@ -716,7 +1486,14 @@ func (c *Controller) CreateVolumeGroup(contx context.Context, opt *pb.CreateVolu
 		db.UpdateVolumeGroupStatus(ctx, db.C, opt.Id, model.VolumeGroupError)
 		return pb.GenericResponseError(err), err
 	}
-	polInfo, err := c.selector.SelectSupportedPoolForVG(vg)
+	var polInfo *model.StoragePoolSpec
+	if opt.GroupSnapshotId != "" {
+		// The group created from a group snapshot is placed in the pool of
+		// its source group, which is set by api server.
+		polInfo, err = db.C.GetPool(ctx, vg.PoolId)
+	} else {
+		polInfo, err = c.selector.SelectSupportedPoolForVG(vg)
+	}
 	if err != nil {
 		log.Error("no valid pool find for group: ", err)
 		db.UpdateVolumeGroupStatus(ctx, db.C, opt.Id, model.VolumeGroupError)
@@ -731,8 +1508,17 @@ func (c *Controller) CreateVolumeGroup(contx context.Context, opt *pb.CreateVolu
 		return pb.GenericResponseError(err), err
 	}
 	c.volumeController.SetDock(dockInfo)
+	db.UpdateOperationDock(ctx, db.C, opt.OperationId, dockInfo.Id)
 	opt.DriverName = dockInfo.DriverName
 
+	if opt.GroupSnapshotId != "" {
+		if err = c.createGroupVolumesFromSnapshot(ctx, opt, polInfo, dockInfo); err != nil {
+			db.UpdateVolumeGroupStatus(ctx, db.C, opt.Id, model.VolumeGroupError)
+			return pb.GenericResponseError(err), err
+		}
+		c.volumeController.SetDock(dockInfo)
+	}
+
 	result, err := c.volumeController.CreateVolumeGroup(opt)
 	if err != nil {
 		db.UpdateVolumeGroupStatus(ctx, db.C, opt.Id, model.VolumeGroupError)
@@ -740,14 +1526,8 @@ func (c *Controller) CreateVolumeGroup(contx context.Context, opt *pb.CreateVolu
 	}
 	result.PoolId = polInfo.Id
 
-	// Update group id in the volumes
-	for _, addVolId := range opt.AddVolumes {
-		if _, err = db.C.UpdateVolume(ctx, &model.VolumeSpec{
-			BaseModel: &model.BaseModel{Id: addVolId},
-			GroupId:   opt.GetId(),
-		}); err != nil {
-			return pb.GenericResponseError(err), err
-		}
+	if err = updateGroupVolumes(ctx, opt.GetId(), result, opt.AddVolumes, nil); err != nil {
+		return pb.GenericResponseError(err), err
 	}
 
 	// TODO Policy controller for the vg need to be modified.
@@ -770,17 +1550,20 @@ func (c *Controller) CreateVolumeGroup(contx context.Context, opt *pb.CreateVolu
 }
 
 // UpdateVolumeGroup implements pb.ControllerServer.UpdateVolumeGroup
-func (c *Controller) UpdateVolumeGroup(contx context.Context, opt *pb.UpdateVolumeGroupOpts) (*pb.GenericResponse, error) {
+func (c *Controller) UpdateVolumeGroup(contx context.Context, opt *pb.UpdateVolumeGroupOpts) (res *pb.GenericResponse, err error) {
 
 	log.Info("Controller server receive update volume group request, vr =", opt)
 
 	ctx := osdsCtx.NewContextFromJson(opt.GetContext())
+	db.UpdateOperationStatus(ctx, db.C, opt.OperationId, model.OperationRunning, nil)
+	defer func() { finishOperation(ctx, opt.OperationId, err) }()
 	dock, err := db.C.GetDockByPoolId(ctx, opt.PoolId)
 	if err != nil {
 		db.UpdateVolumeGroupStatus(ctx, db.C, opt.Id, model.VolumeGroupError)
 		return pb.GenericResponseError(err), err
 	}
 	c.volumeController.SetDock(dock)
+	db.UpdateOperationDock(ctx, db.C, opt.OperationId, dock.Id)
 	opt.DriverName = dock.DriverName
 
 	vg, err := c.volumeController.UpdateVolumeGroup(opt)
@@ -798,23 +1581,8 @@ func (c *Controller) UpdateVolumeGroup(contx context.Context, opt *pb.UpdateVolu
 		return pb.GenericResponseError(err), err
 	}
 
-	// Update group id in the volumes
-	for _, addVolId := range opt.AddVolumes {
-		if _, err = db.C.UpdateVolume(ctx, &model.VolumeSpec{
-			BaseModel: &model.BaseModel{Id: addVolId},
-			GroupId:   opt.GetId(),
-		}); err != nil {
-			return pb.GenericResponseError(err), err
-		}
-	}
-
-	for _, rmVolId := range opt.RemoveVolumes {
-		if _, err = db.C.UpdateVolume(ctx, &model.VolumeSpec{
-			BaseModel: &model.BaseModel{Id: rmVolId},
-			GroupId:   "",
-		}); err != nil {
-			return pb.GenericResponseError(err), err
-		}
+	if err = updateGroupVolumes(ctx, opt.GetId(), vg, opt.AddVolumes, opt.RemoveVolumes); err != nil {
+		return pb.GenericResponseError(err), err
 	}
 
 	// TODO Policy controller for the vg need to be modified.
@@ -836,9 +1604,45 @@ func (c *Controller) UpdateVolumeGroup(contx context.Context, opt *pb.UpdateVolu
 	return pb.GenericResponseResult(vg), nil
 }
 
+// updateGroupVolumes writes the members reported by the driver back to the
+// volumes, the ones the driver doesn't report are updated as if they were
+// added to or removed from the group successfully.
+func updateGroupVolumes(ctx *osdsCtx.Context, vgId string, vg *model.VolumeGroupSpec, addVolumes, removeVolumes []string) error {
+	var vols = vg.Volumes
+	vg.Volumes = nil
+
+	var reported = make(map[string]bool)
+	for _, vol := range vols {
+		reported[vol.Id] = true
+	}
+	for _, addVolId := range addVolumes {
+		if !reported[addVolId] {
+			vols = append(vols, &model.VolumeSpec{
+				BaseModel: &model.BaseModel{Id: addVolId},
+				GroupId:   vgId,
+			})
+		}
+	}
+	for _, rmVolId := range removeVolumes {
+		if !reported[rmVolId] {
+			vols = append(vols, &model.VolumeSpec{
+				BaseModel: &model.BaseModel{Id: rmVolId},
+				GroupId:   "",
+			})
+		}
+	}
+	if len(vols) == 0 {
+		return nil
+	}
+	_, err := db.C.VolumesToUpdate(ctx, vols)
+	return err
+}
+
 // DeleteVolumeGroup implements pb.ControllerServer.DeleteVolumeGroup
-func (c *Controller) DeleteVolumeGroup(contx context.Context, opt *pb.DeleteVolumeGroupOpts) (*pb.GenericResponse, error) {
+func (c *Controller) DeleteVolumeGroup(contx context.Context, opt *pb.DeleteVolumeGroupOpts) (res *pb.GenericResponse, err error) {
 	ctx := osdsCtx.NewContextFromJson(opt.GetContext())
+	db.UpdateOperationStatus(ctx, db.C, opt.OperationId, model.OperationRunning, nil)
+	defer func() { finishOperation(ctx, opt.OperationId, err) }()
 
 	log.Info("Controller server receive delete volume group request, vr =", opt)
 
@@ -848,6 +1652,7 @@ func (c *Controller) DeleteVolumeGroup(contx context.Context, opt *pb.DeleteVolu
 		return pb.GenericResponseError(err), err
 	}
 	c.volumeController.SetDock(dock)
+	db.UpdateOperationDock(ctx, db.C, opt.OperationId, dock.Id)
 	opt.DriverName = dock.DriverName
 
 	if err = c.volumeController.DeleteVolumeGroup(opt); err != nil {
@@ -867,13 +1672,19 @@ func (c *Controller) DeleteVolumeGroup(contx context.Context, opt *pb.DeleteVolu
 }
 
 // CreateFileShare implements pb.ControllerServer.CreateFileShare
-func (c *Controller) CreateFileShare(contx context.Context, opt *pb.CreateFileShareOpts) (*pb.GenericResponse, error) {
-	var err error
+func (c *Controller) CreateFileShare(contx context.Context, opt *pb.CreateFileShareOpts) (res *pb.GenericResponse, err error) {
 	var prf *model.ProfileSpec
 
 	log.Info("Controller server receive create file share request, vr =", opt)
 
 	ctx := osdsCtx.NewContextFromJson(opt.GetContext())
+	db.UpdateOperationStatus(ctx, db.C, opt.OperationId, model.OperationRunning, nil)
+	defer func() { finishOperation(ctx, opt.OperationId, err) }()
+	defer func() {
+		if err != nil {
+			rollbackQuota(ctx, ctx.TenantId, opt.Id)
+		}
+	}()
 	if opt.ProfileId == "" {
 		log.Warning("Use default profile when user doesn't specify profile.")
 		prf, err = db.C.GetDefaultProfile(ctx)
@@ -912,6 +1723,7 @@ func (c *Controller) CreateFileShare(contx context.Context, opt *pb.CreateFileSh
 		return pb.GenericResponseError(err), err
 	}
 	c.fileshareController.SetDock(dockInfo)
+	db.UpdateOperationDock(ctx, db.C, opt.OperationId, dockInfo.Id)
 	opt.DriverName = dockInfo.DriverName
 
 	result, err := c.fileshareController.CreateFileShare((*pb.CreateFileShareOpts)(opt))
@@ -925,16 +1737,19 @@ func (c *Controller) CreateFileShare(contx context.Context, opt *pb.CreateFileSh
 
 	// Update the file share data in database.
 	db.C.UpdateStatus(ctx, result, model.FileShareAvailable)
+	commitQuota(ctx, ctx.TenantId, opt.Id)
 
 	return pb.GenericResponseResult(result), nil
 }
 
 // DeleteFileShare implements pb.ControllerServer.DeleteFileShare
-func (c *Controller) DeleteFileShare(contx context.Context, opt *pb.DeleteFileShareOpts) (*pb.GenericResponse, error) {
+func (c *Controller) DeleteFileShare(contx context.Context, opt *pb.DeleteFileShareOpts) (res *pb.GenericResponse, err error) {
 
 	log.Info("Controller server receive delete file share request, vr =", opt)
 
 	ctx := osdsCtx.NewContextFromJson(opt.GetContext())
+	db.UpdateOperationStatus(ctx, db.C, opt.OperationId, model.OperationRunning, nil)
+	defer func() { finishOperation(ctx, opt.OperationId, err) }()
 
 	dockInfo, err := db.C.GetDockByPoolId(ctx, opt.PoolId)
 	if err != nil {
@@ -944,6 +1759,7 @@ func (c *Controller) DeleteFileShare(contx context.Context, opt *pb.DeleteFileSh
 	}
 
 	c.fileshareController.SetDock(dockInfo)
+	db.UpdateOperationDock(ctx, db.C, opt.OperationId, dockInfo.Id)
 	opt.DriverName = dockInfo.DriverName
 
 	if err = c.fileshareController.DeleteFileShare(opt); err != nil {
@@ -951,13 +1767,182 @@ func (c *Controller) DeleteFileShare(contx context.Context, opt *pb.DeleteFileSh
 		return pb.GenericResponseError(err), err
 	}
 
+	// The file share is read before its entry is deleted, so that the quota
+	// can be released to the tenant owning it.
+	fshare, err := db.C.GetFileShare(ctx, opt.GetId())
+	if err != nil {
+		return pb.GenericResponseError(err), err
+	}
 	if err = db.C.DeleteFileShare(ctx, opt.GetId()); err != nil {
 		return pb.GenericResponseError(err), err
 	}
+	releaseQuota(ctx, fshare.TenantId, fshare.Id)
 
 	return pb.GenericResponseResult(nil), err
 }
 
+// CreateFileShareSnapshot implements pb.FileShareControllerServer.CreateFileShareSnapshot
+func (c *Controller) CreateFileShareSnapshot(contx context.Context, opt *pb.CreateFileShareSnapshotOpts) (res *pb.GenericResponse, err error) {
+
+	log.Info("Controller server receive create file share snapshot request, vr =", opt)
+
+	ctx := osdsCtx.NewContextFromJson(opt.GetContext())
+	db.UpdateOperationStatus(ctx, db.C, opt.OperationId, model.OperationRunning, nil)
+	defer func() { finishOperation(ctx, opt.OperationId, err) }()
+
+	fshare, err := db.C.GetFileShare(ctx, opt.FileshareId)
+	if err != nil {
+		log.Error("get file share failed in create file share snapshot method: ", err)
+		db.UpdateFileShareSnapshotStatus(ctx, db.C, opt.Id, model.FileShareSnapError)
+		return pb.GenericResponseError(err), err
+	}
+	opt.Size = fshare.Size
+	opt.Metadata = utils.MergeStringMaps(opt.Metadata, fshare.Metadata)
+
+	dockInfo, err := db.C.GetDockByPoolId(ctx, fshare.PoolId)
+	if err != nil {
+		log.Error("when search supported dock resource: ", err)
+		db.UpdateFileShareSnapshotStatus(ctx, db.C, opt.Id, model.FileShareSnapError)
+		return pb.GenericResponseError(err), err
+	}
+	c.fileshareController.SetDock(dockInfo)
+	db.UpdateOperationDock(ctx, db.C, opt.OperationId, dockInfo.Id)
+	opt.DriverName = dockInfo.DriverName
+
+	result, err := c.fileshareController.CreateFileShareSnapshot(opt)
+	if err != nil {
+		log.Error("error occurred in controller module when create file share snapshot: ", err)
+		db.UpdateFileShareSnapshotStatus(ctx, db.C, opt.Id, model.FileShareSnapError)
+		return pb.GenericResponseError(err), err
+	}
+
+	db.C.UpdateStatus(ctx, result, model.FileShareSnapAvailable)
+	return pb.GenericResponseResult(result), nil
+}
+
+// DeleteFileShareSnapshot implements pb.FileShareControllerServer.DeleteFileShareSnapshot
+func (c *Controller) DeleteFileShareSnapshot(contx context.Context, opt *pb.DeleteFileShareSnapshotOpts) (res *pb.GenericResponse, err error) {
+
+	log.Info("Controller server receive delete file share snapshot request, vr =", opt)
+
+	ctx := osdsCtx.NewContextFromJson(opt.GetContext())
+	db.UpdateOperationStatus(ctx, db.C, opt.OperationId, model.OperationRunning, nil)
+	defer func() { finishOperation(ctx, opt.OperationId, err) }()
+
+	fshare, err := db.C.GetFileShare(ctx, opt.FileshareId)
+	if err != nil {
+		log.Error("get file share failed in delete file share snapshot method: ", err)
+		db.UpdateFileShareSnapshotStatus(ctx, db.C, opt.Id, model.FileShareSnapErrorDeleting)
+		return pb.GenericResponseError(err), err
+	}
+	// The metadata of the snapshot takes precedence over the file share's.
+	opt.Metadata = utils.MergeStringMaps(fshare.Metadata, opt.Metadata)
+
+	dockInfo, err := db.C.GetDockByPoolId(ctx, fshare.PoolId)
+	if err != nil {
+		log.Error("when search supported dock resource: ", err)
+		db.UpdateFileShareSnapshotStatus(ctx, db.C, opt.Id, model.FileShareSnapErrorDeleting)
+		return pb.GenericResponseError(err), err
+	}
+	c.fileshareController.SetDock(dockInfo)
+	db.UpdateOperationDock(ctx, db.C, opt.OperationId, dockInfo.Id)
+	opt.DriverName = dockInfo.DriverName
+
+	if err = c.fileshareController.DeleteFileShareSnapshot(opt); err != nil {
+		log.Error("error occurred in controller module when delete file share snapshot: ", err)
+		db.UpdateFileShareSnapshotStatus(ctx, db.C, opt.Id, model.FileShareSnapErrorDeleting)
+		return pb.GenericResponseError(err), err
+	}
+
+	if err = db.C.DeleteFileShareSnapshot(ctx, opt.Id); err != nil {
+		log.Error("error occurred in controller module when delete file share snapshot in db: ", err)
+		db.UpdateFileShareSnapshotStatus(ctx, db.C, opt.Id, model.FileShareSnapErrorDeleting)
+		return pb.GenericResponseError(err), err
+	}
+
+	return pb.GenericResponseResult(nil), nil
+}
+
+// CreateFileShareAcl implements pb.FileShareControllerServer.CreateFileShareAcl
+func (c *Controller) CreateFileShareAcl(contx context.Context, opt *pb.CreateFileShareAclOpts) (res *pb.GenericResponse, err error) {
+
+	log.Info("Controller server receive create file share acl request, vr =", opt)
+
+	ctx := osdsCtx.NewContextFromJson(opt.GetContext())
+	db.UpdateOperationStatus(ctx, db.C, opt.OperationId, model.OperationRunning, nil)
+	defer func() { finishOperation(ctx, opt.OperationId, err) }()
+
+	fshare, err := db.C.GetFileShare(ctx, opt.FileshareId)
+	if err != nil {
+		log.Error("get file share failed in create file share acl method: ", err)
+		db.UpdateFileShareAclStatus(ctx, db.C, opt.Id, model.FileShareAclError)
+		return pb.GenericResponseError(err), err
+	}
+	opt.Metadata = utils.MergeStringMaps(opt.Metadata, fshare.Metadata)
+
+	dockInfo, err := db.C.GetDockByPoolId(ctx, fshare.PoolId)
+	if err != nil {
+		log.Error("when search supported dock resource: ", err)
+		db.UpdateFileShareAclStatus(ctx, db.C, opt.Id, model.FileShareAclError)
+		return pb.GenericResponseError(err), err
+	}
+	c.fileshareController.SetDock(dockInfo)
+	db.UpdateOperationDock(ctx, db.C, opt.OperationId, dockInfo.Id)
+	opt.DriverName = dockInfo.DriverName
+
+	result, err := c.fileshareController.CreateFileShareAcl(opt)
+	if err != nil {
+		log.Error("error occurred in controller module when create file share acl: ", err)
+		db.UpdateFileShareAclStatus(ctx, db.C, opt.Id, model.FileShareAclError)
+		return pb.GenericResponseError(err), err
+	}
+
+	db.C.UpdateStatus(ctx, result, model.FileShareAclAvailable)
+	return pb.GenericResponseResult(result), nil
+}
+
+// DeleteFileShareAcl implements pb.FileShareControllerServer.DeleteFileShareAcl
+func (c *Controller) DeleteFileShareAcl(contx context.Context, opt *pb.DeleteFileShareAclOpts) (res *pb.GenericResponse, err error) {
+
+	log.Info("Controller server receive delete file share acl request, vr =", opt)
+
+	ctx := osdsCtx.NewContextFromJson(opt.GetContext())
+	db.UpdateOperationStatus(ctx, db.C, opt.OperationId, model.OperationRunning, nil)
+	defer func() { finishOperation(ctx, opt.OperationId, err) }()
+
+	fshare, err := db.C.GetFileShare(ctx, opt.FileshareId)
+	if err != nil {
+		log.Error("get file share failed in delete file share acl method: ", err)
+		db.UpdateFileShareAclStatus(ctx, db.C, opt.Id, model.FileShareAclErrorDeleting)
+		return pb.GenericResponseError(err), err
+	}
+	opt.Metadata = utils.MergeStringMaps(opt.Metadata, fshare.Metadata)
+
+	dockInfo, err := db.C.GetDockByPoolId(ctx, fshare.PoolId)
+	if err != nil {
+		log.Error("when search supported dock resource: ", err)
+		db.UpdateFileShareAclStatus(ctx, db.C, opt.Id, model.FileShareAclErrorDeleting)
+		return pb.GenericResponseError(err), err
+	}
+	c.fileshareController.SetDock(dockInfo)
+	db.UpdateOperationDock(ctx, db.C, opt.OperationId, dockInfo.Id)
+	opt.DriverName = dockInfo.DriverName
+
+	if err = c.fileshareController.DeleteFileShareAcl(opt); err != nil {
+		log.Error("error occurred in controller module when delete file share acl: ", err)
+		db.UpdateFileShareAclStatus(ctx, db.C, opt.Id, model.FileShareAclErrorDeleting)
+		return pb.GenericResponseError(err), err
+	}
+
+	if err = db.C.DeleteFileShareAcl(ctx, opt.Id); err != nil {
+		log.Error("error occurred in controller module when delete file share acl in db: ", err)
+		db.UpdateFileShareAclStatus(ctx, db.C, opt.Id, model.FileShareAclErrorDeleting)
+		return pb.GenericResponseError(err), err
+	}
+
+	return pb.GenericResponseResult(nil), nil
+}
+
 func (c *Controller) GetMetrics(context context.Context, opt *pb.GetMetricsOpts) (*pb.GenericResponse, error) {
 	log.Info("in controller get metrics methods")
 
@@ -983,3 +1968,28 @@ func (c *Controller) GetMetrics(context context.Context, opt *pb.GetMetricsOpts)
 
 	return pb.GenericResponseResult(result), err
 }
+
+// Heartbeat implements pb.ControllerServer.Heartbeat
+func (c *Controller) Heartbeat(contx context.Context, opt *pb.HeartbeatOpts) (*pb.GenericResponse, error) {
+	ctx := osdsCtx.NewContextFromJson(opt.GetContext())
+	if err := c.monitor.Beat(ctx, opt.GetDockIds()); err != nil {
+		log.Error("record dock heartbeat failed:", err)
+		return pb.GenericResponseError(err), err
+	}
+	return pb.GenericResponseResult(nil), nil
+}
+
+// GetReconcileReport implements pb.ControllerServer.GetReconcileReport
+func (c *Controller) GetReconcileReport(contx context.Context, opt *pb.ReconcileOpts) (*pb.GenericResponse, error) {
+	rpt := c.reconciler.Report()
+	if rpt == nil {
+		return nil, status.Error(codes.NotFound, "no reconciliation has been run")
+	}
+	return pb.GenericResponseResult(rpt), nil
+}
+
+// Reconcile implements pb.ControllerServer.Reconcile
+func (c *Controller) Reconcile(contx context.Context, opt *pb.ReconcileOpts) (*pb.GenericResponse, error) {
+	log.Infof("reconciliation is requested, auto fix: %v", opt.GetAutoFix())
+	return pb.GenericResponseResult(c.reconciler.Start(opt.GetAutoFix())), nil
+}
@@ -16,21 +16,29 @@ package controller
 
 import (
 	"context"
+	"errors"
+	"reflect"
+	"strings"
 	"testing"
 
 	c "github.com/opensds/opensds/pkg/context"
 	"github.com/opensds/opensds/pkg/controller/dr"
+	"github.com/opensds/opensds/pkg/controller/fileshare"
 	"github.com/opensds/opensds/pkg/controller/volume"
 	"github.com/opensds/opensds/pkg/db"
 	"github.com/opensds/opensds/pkg/model"
 	pb "github.com/opensds/opensds/pkg/model/proto"
+	"github.com/opensds/opensds/pkg/webhook"
 	. "github.com/opensds/opensds/testutils/collection"
 	dbtest "github.com/opensds/opensds/testutils/db/testing"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/mock"
 )
 
 type fakeSelector struct {
-	res *model.StoragePoolSpec
-	err error
+	res  *model.StoragePoolSpec
+	pols []*model.StoragePoolSpec
+	err  error
 }
 
 func (s *fakeSelector) SelectSupportedPoolForVolume(vol *model.VolumeSpec) (*model.StoragePoolSpec, error) {
@@ -40,6 +48,16 @@ func (s *fakeSelector) SelectSupportedPoolForVolume(vol *model.VolumeSpec) (*mod
 	return s.res, nil
 }
 
+func (s *fakeSelector) SelectSupportedPoolsForVolume(vol *model.VolumeSpec) ([]*model.StoragePoolSpec, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	if s.pols != nil {
+		return s.pols, nil
+	}
+	return []*model.StoragePoolSpec{s.res}, nil
+}
+
 func (s *fakeSelector) SelectSupportedPoolForFileShare(vol *model.FileShareSpec) (*model.StoragePoolSpec, error) {
 	if s.err != nil {
 		return nil, s.err
@@ -106,6 +124,22 @@ func (fvc *fakeVolumeController) ExtendVolume(*pb.ExtendVolumeOpts) (*model.Volu
 	return &SampleVolumes[0], nil
 }
 
+func (fvc *fakeVolumeController) MigrateVolume(*pb.MigrateVolumeOpts) (*model.VolumeSpec, error) {
+	return &SampleVolumes[0], nil
+}
+
+func (fvc *fakeVolumeController) RevertVolumeToSnapshot(*pb.RevertVolumeToSnapshotOpts) error {
+	return nil
+}
+
+func (fvc *fakeVolumeController) ManageVolume(*pb.ManageVolumeOpts) (*model.VolumeSpec, error) {
+	return &SampleVolumes[0], nil
+}
+
+func (fvc *fakeVolumeController) UnmanageVolume(*pb.UnmanageVolumeOpts) error {
+	return nil
+}
+
 func (fvc *fakeVolumeController) CreateVolumeAttachment(*pb.CreateVolumeAttachmentOpts) (*model.VolumeAttachmentSpec, error) {
 	return &SampleAttachments[0], nil
 }
@@ -122,6 +156,26 @@ func (fvc *fakeVolumeController) DeleteVolumeSnapshot(*pb.DeleteVolumeSnapshotOp
 	return nil
 }
 
+func (fvc *fakeVolumeController) ManageVolumeSnapshot(*pb.ManageVolumeSnapshotOpts) (*model.VolumeSnapshotSpec, error) {
+	return &SampleSnapshots[0], nil
+}
+
+func (fvc *fakeVolumeController) UnmanageVolumeSnapshot(*pb.UnmanageVolumeSnapshotOpts) error {
+	return nil
+}
+
+func (fvc *fakeVolumeController) CreateVolumeBackup(*pb.CreateVolumeBackupOpts) (*model.BackupSpec, error) {
+	return &SampleBackups[0], nil
+}
+
+func (fvc *fakeVolumeController) DeleteVolumeBackup(*pb.DeleteVolumeBackupOpts) error {
+	return nil
+}
+
+func (fvc *fakeVolumeController) RestoreVolumeBackup(*pb.RestoreVolumeBackupOpts) error {
+	return nil
+}
+
 func (fvc *fakeVolumeController) AttachVolume(*pb.AttachVolumeOpts) (string, error) {
 	return "", nil
 }
@@ -130,6 +184,10 @@ func (fvc *fakeVolumeController) DetachVolume(*pb.DetachVolumeOpts) error {
 	return nil
 }
 
+func (fvc *fakeVolumeController) CopyVolume(*pb.CopyVolumeOpts) error {
+	return nil
+}
+
 func (fvc *fakeVolumeController) CreateReplication(opts *pb.CreateReplicationOpts) (*model.ReplicationSpec, error) {
 	return &SampleReplications[0], nil
 }
@@ -161,8 +219,200 @@ func (fvc *fakeVolumeController) UpdateVolumeGroup(*pb.UpdateVolumeGroupOpts) (*
 func (fvc *fakeVolumeController) DeleteVolumeGroup(*pb.DeleteVolumeGroupOpts) error {
 	return nil
 }
+
+func (fvc *fakeVolumeController) CreateGroupSnapshot(*pb.CreateGroupSnapshotOpts) ([]*model.VolumeSnapshotSpec, error) {
+	return nil, nil
+}
+
+func (fvc *fakeVolumeController) DeleteGroupSnapshot(*pb.DeleteGroupSnapshotOpts) error {
+	return nil
+}
+
+func (fvc *fakeVolumeController) FreezeVolume(*pb.FreezeVolumeOpts) error {
+	return nil
+}
+
+func (fvc *fakeVolumeController) ThawVolume(*pb.FreezeVolumeOpts) error {
+	return nil
+}
+
+func (fvc *fakeVolumeController) PullVolume(*pb.PullVolumeOpts) (*model.VolumeSpec, error) {
+	return &SampleVolumes[0], nil
+}
+
+func (fvc *fakeVolumeController) PullVolumeSnapshot(*pb.PullVolumeSnapshotOpts) (*model.VolumeSnapshotSpec, error) {
+	return &SampleSnapshots[0], nil
+}
+
+func (fvc *fakeVolumeController) ListVolumes(*pb.ListVolumesOpts) ([]*model.VolumeSpec, error) {
+	return nil, nil
+}
+
+func (fvc *fakeVolumeController) ListVolumeSnapshots(*pb.ListVolumesOpts) ([]*model.VolumeSnapshotSpec, error) {
+	return nil, nil
+}
+
 func (fvc *fakeVolumeController) SetDock(dockInfo *model.DockSpec) { return }
 
+// fakeFailingPoolVolumeController fails to create volume in the specified pool.
+type fakeFailingPoolVolumeController struct {
+	fakeVolumeController
+	failedPoolId string
+}
+
+func (fvc *fakeFailingPoolVolumeController) CreateVolume(opt *pb.CreateVolumeOpts) (*model.VolumeSpec, error) {
+	if opt.PoolId == fvc.failedPoolId {
+		return nil, errors.New("no space left in pool")
+	}
+	return &SampleVolumes[0], nil
+}
+
+// fakeMigrationVolumeController records the requests sent to the docks, the
+// driver can't migrate volume natively if notImplemented is set, and revertErr
+// is returned when reverting volume if it's set.
+type fakeMigrationVolumeController struct {
+	fakeVolumeController
+	notImplemented bool
+	revertErr      error
+	calls          []string
+	copyOpt        *pb.CopyVolumeOpts
+}
+
+func (fvc *fakeMigrationVolumeController) record(dck *model.DockSpec, method string) {
+	fvc.calls = append(fvc.calls, dck.Id+":"+method)
+}
+
+func (fvc *fakeMigrationVolumeController) SetDock(dockInfo *model.DockSpec) {
+	fvc.record(dockInfo, "SetDock")
+}
+
+func (fvc *fakeMigrationVolumeController) MigrateVolume(opt *pb.MigrateVolumeOpts) (*model.VolumeSpec, error) {
+	fvc.calls = append(fvc.calls, "MigrateVolume")
+	if fvc.notImplemented {
+		return nil, &model.NotImplementError{S: "method MigrateVolume has not been implemented yet"}
+	}
+	return &model.VolumeSpec{
+		BaseModel: &model.BaseModel{Id: opt.Id},
+		Metadata:  map[string]string{"lvPath": "/dev/" + opt.PoolName + "/volume-" + opt.Id},
+	}, nil
+}
+
+func (fvc *fakeMigrationVolumeController) RevertVolumeToSnapshot(opt *pb.RevertVolumeToSnapshotOpts) error {
+	fvc.calls = append(fvc.calls, "RevertVolumeToSnapshot")
+	if fvc.notImplemented {
+		return &model.NotImplementError{S: "method RevertVolumeToSnapshot has not been implemented yet"}
+	}
+	return fvc.revertErr
+}
+
+func (fvc *fakeMigrationVolumeController) CreateVolume(opt *pb.CreateVolumeOpts) (*model.VolumeSpec, error) {
+	fvc.calls = append(fvc.calls, "CreateVolume")
+	return &model.VolumeSpec{
+		BaseModel: &model.BaseModel{Id: opt.Id},
+		Metadata:  map[string]string{"lvPath": "/dev/" + opt.PoolName + "/volume-" + opt.Id},
+	}, nil
+}
+
+func (fvc *fakeMigrationVolumeController) DeleteVolume(*pb.DeleteVolumeOpts) error {
+	fvc.calls = append(fvc.calls, "DeleteVolume")
+	return nil
+}
+
+func (fvc *fakeMigrationVolumeController) CreateVolumeAttachment(opt *pb.CreateVolumeAttachmentOpts) (*model.VolumeAttachmentSpec, error) {
+	fvc.calls = append(fvc.calls, "CreateVolumeAttachment")
+	return &model.VolumeAttachmentSpec{
+		BaseModel: &model.BaseModel{Id: opt.Id},
+		ConnectionInfo: model.ConnectionInfo{
+			DriverVolumeType: "iscsi",
+			ConnectionData:   map[string]interface{}{"targetLun": opt.Metadata["lvPath"]},
+		},
+	}, nil
+}
+
+func (fvc *fakeMigrationVolumeController) DeleteVolumeAttachment(*pb.DeleteVolumeAttachmentOpts) error {
+	fvc.calls = append(fvc.calls, "DeleteVolumeAttachment")
+	return nil
+}
+
+func (fvc *fakeMigrationVolumeController) AttachVolume(opt *pb.AttachVolumeOpts) (string, error) {
+	fvc.calls = append(fvc.calls, "AttachVolume")
+	if strings.Contains(opt.ConnectionData, "sample-pool-01") {
+		return "/dev/sdb", nil
+	}
+	return "/dev/sdc", nil
+}
+
+func (fvc *fakeMigrationVolumeController) DetachVolume(*pb.DetachVolumeOpts) error {
+	fvc.calls = append(fvc.calls, "DetachVolume")
+	return nil
+}
+
+func (fvc *fakeMigrationVolumeController) CopyVolume(opt *pb.CopyVolumeOpts) error {
+	fvc.calls = append(fvc.calls, "CopyVolume")
+	fvc.copyOpt = opt
+	return nil
+}
+
+func NewFakeFileShareController() *fakeFileShareController {
+	return &fakeFileShareController{}
+}
+
+// fakeFileShareController records the requests sent to the dock, the snapshot
+// creation fails if err is set.
+type fakeFileShareController struct {
+	err           error
+	createSnapOpt *pb.CreateFileShareSnapshotOpts
+	deleteSnapOpt *pb.DeleteFileShareSnapshotOpts
+	createAclOpt  *pb.CreateFileShareAclOpts
+	deleteAclOpt  *pb.DeleteFileShareAclOpts
+}
+
+var _ fileshare.Controller = &fakeFileShareController{}
+
+func (ffc *fakeFileShareController) CreateFileShare(*pb.CreateFileShareOpts) (*model.FileShareSpec, error) {
+	return &SampleFileShares[0], nil
+}
+
+func (ffc *fakeFileShareController) DeleteFileShare(*pb.DeleteFileShareOpts) error {
+	return nil
+}
+
+func (ffc *fakeFileShareController) CreateFileShareSnapshot(opt *pb.CreateFileShareSnapshotOpts) (*model.FileShareSnapshotSpec, error) {
+	ffc.createSnapOpt = opt
+	if ffc.err != nil {
+		return nil, ffc.err
+	}
+	return &SampleFileShareSnapshots[0], nil
+}
+
+func (ffc *fakeFileShareController) DeleteFileShareSnapshot(opt *pb.DeleteFileShareSnapshotOpts) error {
+	ffc.deleteSnapOpt = opt
+	return ffc.err
+}
+
+func (ffc *fakeFileShareController) CreateFileShareAcl(opt *pb.CreateFileShareAclOpts) (*model.FileShareAclSpec, error) {
+	ffc.createAclOpt = opt
+	if ffc.err != nil {
+		return nil, ffc.err
+	}
+	return &SampleFileSharesAcl[0], nil
+}
+
+func (ffc *fakeFileShareController) DeleteFileShareAcl(opt *pb.DeleteFileShareAclOpts) error {
+	ffc.deleteAclOpt = opt
+	return ffc.err
+}
+
+func (ffc *fakeFileShareController) SetDock(dockInfo *model.DockSpec) { return }
+
+// mockQuota lets the tenant be limited by the default quota and have
+// nothing charged yet.
+func mockQuota(m *dbtest.Client) {
+	m.On("GetQuota", mock.Anything, mock.Anything).Return(nil, model.NewNotFoundError("quota is not set"))
+	m.On("GetQuotaUsage", mock.Anything, mock.Anything).Return(nil, model.NewNotFoundError("quota usage can't find"))
+	m.On("UpdateQuotaUsage", mock.Anything, mock.Anything).Return(&SampleQuotaUsages[0], nil)
+}
+
 func TestCreateVolume(t *testing.T) {
 	var req = &pb.CreateVolumeOpts{
 		Id:          "bd5b12a8-a101-11e7-941e-d77981b584d8",
@@ -174,6 +424,7 @@ func TestCreateVolume(t *testing.T) {
 	}
 	var vol = &SampleVolumes[0]
 	mockClient := new(dbtest.Client)
+	mockQuota(mockClient)
 	mockClient.On("GetVolume", c.NewAdminContext(), "bd5b12a8-a101-11e7-941e-d77981b584d8").Return(&SampleVolumes[0], nil)
 	mockClient.On("GetDock", c.NewAdminContext(), "b7602e18-771e-11e7-8f38-dbd6d291f4e0").Return(&SampleDocks[0], nil)
 	mockClient.On("GetDefaultProfile", c.NewAdminContext()).Return(&SampleProfiles[0], nil)
@@ -199,6 +450,67 @@ func TestCreateVolume(t *testing.T) {
 	}
 }
 
+// fakeDriverVolumeController returns the volume created like the drivers do,
+// which has neither the owner nor the status.
+type fakeDriverVolumeController struct {
+	fakeVolumeController
+}
+
+func (fvc *fakeDriverVolumeController) CreateVolume(opt *pb.CreateVolumeOpts) (*model.VolumeSpec, error) {
+	return &model.VolumeSpec{
+		BaseModel: &model.BaseModel{Id: opt.Id},
+		Size:      opt.Size,
+		Metadata:  map[string]string{"lvPath": "/dev/sample-vg/volume-" + opt.Id},
+	}, nil
+}
+
+func TestCreateVolumeNotifiesOwner(t *testing.T) {
+	var req = &pb.CreateVolumeOpts{
+		Id:        "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		Name:      "sample-volume",
+		Size:      int64(1),
+		ProfileId: "1106b972-66ef-11e7-b172-db03f3689c9c",
+		Context:   c.NewAdminContext().ToJson(),
+	}
+	var vol = SampleVolumes[0]
+	vol.TenantId, vol.Status = "tenant", model.VolumeCreating
+	mockClient := new(dbtest.Client)
+	mockQuota(mockClient)
+	mockClient.On("GetVolume", c.NewAdminContext(), req.Id).Return(&vol, nil)
+	mockClient.On("GetDock", c.NewAdminContext(), "b7602e18-771e-11e7-8f38-dbd6d291f4e0").Return(&SampleDocks[0], nil)
+	mockClient.On("GetProfile", c.NewAdminContext(), req.ProfileId).Return(&SampleProfiles[0], nil)
+	mockClient.On("UpdateStatus", c.NewAdminContext(), mock.Anything, model.VolumeAvailable).Return(nil)
+
+	var events []*model.EventSpec
+	db.C = db.WithStatusHook(mockClient, func(ctx *c.Context, in interface{}, previous, status string) {
+		events = append(events, webhook.NewEvent(in, previous, status))
+	})
+	defer func() { db.C = mockClient }()
+
+	var ctrl = &Controller{
+		selector: &fakeSelector{
+			res: &model.StoragePoolSpec{
+				BaseModel: &model.BaseModel{
+					Id: "084bf71e-a102-11e7-88a8-e31fe6d52248",
+				},
+				DockId: "b7602e18-771e-11e7-8f38-dbd6d291f4e0",
+			},
+		},
+		volumeController: &fakeDriverVolumeController{},
+	}
+
+	if _, err := ctrl.CreateVolume(context.Background(), req); err != nil {
+		t.Errorf("Failed to create volume, err is %v\n", err)
+	}
+	if len(events) != 1 {
+		t.Fatalf("Expected 1 event, got %d\n", len(events))
+	}
+	if events[0].Type != "volume.available" || events[0].TenantId != "tenant" ||
+		events[0].PreviousStatus != model.VolumeCreating {
+		t.Errorf("Unexpected event %+v\n", events[0])
+	}
+}
+
 func TestCreateVolumeFromSnapshot(t *testing.T) {
 	var req = &pb.CreateVolumeOpts{
 		Id:          "bd5b12a8-a101-11e7-941e-d77981b584d8",
@@ -211,6 +523,7 @@ func TestCreateVolumeFromSnapshot(t *testing.T) {
 	}
 	var vol = &SampleVolumes[0]
 	mockClient := new(dbtest.Client)
+	mockQuota(mockClient)
 	mockClient.On("GetDock", c.NewAdminContext(), "b7602e18-771e-11e7-8f38-dbd6d291f4e0").Return(&SampleDocks[0], nil)
 	mockClient.On("GetVolumeSnapshot", c.NewAdminContext(), "3769855c-a102-11e7-b772-17b880d2f537").Return(&SampleSnapshots[0], nil)
 	mockClient.On("GetVolume", c.NewAdminContext(), "bd5b12a8-a101-11e7-941e-d77981b584d8").Return(&SampleVolumes[0], nil)
@@ -236,6 +549,150 @@ func TestCreateVolumeFromSnapshot(t *testing.T) {
 	}
 }
 
+func TestCloneVolume(t *testing.T) {
+	var req = &pb.CreateVolumeOpts{
+		Id:             "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		Name:           "sample-volume",
+		Description:    "This is a sample volume for testing",
+		Size:           int64(1),
+		ProfileId:      "1106b972-66ef-11e7-b172-db03f3689c9c",
+		SourceVolumeId: "f2dda3d2-bf79-11e7-8665-f750b088f63e",
+		Context:        c.NewAdminContext().ToJson(),
+	}
+	var srcVol = &model.VolumeSpec{
+		BaseModel: &model.BaseModel{
+			Id: "f2dda3d2-bf79-11e7-8665-f750b088f63e",
+		},
+		Size:     int64(1),
+		PoolId:   "084bf71e-a102-11e7-88a8-e31fe6d52248",
+		Metadata: map[string]string{"lvPath": "/dev/vg001/volume-f2dda3d2-bf79-11e7-8665-f750b088f63e"},
+	}
+	var vol = &SampleVolumes[0]
+	mockClient := new(dbtest.Client)
+	mockQuota(mockClient)
+	mockClient.On("GetVolume", c.NewAdminContext(), "bd5b12a8-a101-11e7-941e-d77981b584d8").Return(&SampleVolumes[0], nil)
+	mockClient.On("GetVolume", c.NewAdminContext(), "f2dda3d2-bf79-11e7-8665-f750b088f63e").Return(srcVol, nil)
+	mockClient.On("GetDock", c.NewAdminContext(), "b7602e18-771e-11e7-8f38-dbd6d291f4e0").Return(&SampleDocks[0], nil)
+	mockClient.On("GetProfile", c.NewAdminContext(), "1106b972-66ef-11e7-b172-db03f3689c9c").Return(&SampleProfiles[0], nil)
+	mockClient.On("UpdateStatus", c.NewAdminContext(), vol, vol.Status).Return(nil)
+	db.C = mockClient
+
+	var ctrl = &Controller{
+		selector: &fakeSelector{
+			res: &model.StoragePoolSpec{
+				BaseModel: &model.BaseModel{
+					Id: "084bf71e-a102-11e7-88a8-e31fe6d52248",
+				},
+				DockId: "b7602e18-771e-11e7-8f38-dbd6d291f4e0",
+			},
+			err: nil,
+		},
+		volumeController: NewFakeVolumeController(),
+	}
+
+	if _, err := ctrl.CreateVolume(context.Background(), req); err != nil {
+		t.Errorf("Failed to clone volume, err is %v\n", err)
+	}
+	if req.SourceVolumeSize != srcVol.Size || req.Metadata["lvPath"] != srcVol.Metadata["lvPath"] {
+		t.Errorf("Expected source volume size %d and metadata %v, got %d and %v\n",
+			srcVol.Size, srcVol.Metadata, req.SourceVolumeSize, req.Metadata)
+	}
+}
+
+func TestCreateVolumeWithPoolFallback(t *testing.T) {
+	var req = &pb.CreateVolumeOpts{
+		Id:          "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		Name:        "sample-volume",
+		Description: "This is a sample volume for testing",
+		Size:        int64(1),
+		ProfileId:   "1106b972-66ef-11e7-b172-db03f3689c9c",
+		Context:     c.NewAdminContext().ToJson(),
+	}
+	var vol = &SampleVolumes[0]
+	mockClient := new(dbtest.Client)
+	mockQuota(mockClient)
+	mockClient.On("GetVolume", c.NewAdminContext(), "bd5b12a8-a101-11e7-941e-d77981b584d8").Return(&SampleVolumes[0], nil)
+	mockClient.On("GetDock", c.NewAdminContext(), "b7602e18-771e-11e7-8f38-dbd6d291f4e0").Return(&SampleDocks[0], nil)
+	mockClient.On("GetProfile", c.NewAdminContext(), "1106b972-66ef-11e7-b172-db03f3689c9c").Return(&SampleProfiles[0], nil)
+	mockClient.On("UpdateStatus", c.NewAdminContext(), vol, vol.Status).Return(nil)
+	db.C = mockClient
+
+	var ctrl = &Controller{
+		selector: &fakeSelector{
+			pols: []*model.StoragePoolSpec{
+				{
+					BaseModel: &model.BaseModel{
+						Id: "a594b8ac-a103-11e7-985f-d723bcf01b5f",
+					},
+					DockId: "b7602e18-771e-11e7-8f38-dbd6d291f4e0",
+				},
+				{
+					BaseModel: &model.BaseModel{
+						Id: "084bf71e-a102-11e7-88a8-e31fe6d52248",
+					},
+					DockId: "b7602e18-771e-11e7-8f38-dbd6d291f4e0",
+				},
+			},
+		},
+		volumeController: &fakeFailingPoolVolumeController{
+			failedPoolId: "a594b8ac-a103-11e7-985f-d723bcf01b5f",
+		},
+	}
+
+	if _, err := ctrl.CreateVolume(context.Background(), req); err != nil {
+		t.Errorf("Failed to create volume, err is %v\n", err)
+	}
+	if req.PoolId != "084bf71e-a102-11e7-88a8-e31fe6d52248" {
+		t.Errorf("Expected volume to be created in pool %s, got %s\n",
+			"084bf71e-a102-11e7-88a8-e31fe6d52248", req.PoolId)
+	}
+}
+
+func TestCreateVolumeWithOperation(t *testing.T) {
+	var opID = "8b3ac17a-4c4f-11e9-9d5b-7b2ad6b2b1ea"
+	var req = &pb.CreateVolumeOpts{
+		Id:          "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		Name:        "sample-volume",
+		Description: "This is a sample volume for testing",
+		Size:        int64(1),
+		ProfileId:   "1106b972-66ef-11e7-b172-db03f3689c9c",
+		Context:     c.NewAdminContext().ToJson(),
+		OperationId: opID,
+	}
+	var vol = &SampleVolumes[0]
+	mockClient := new(dbtest.Client)
+	mockQuota(mockClient)
+	mockClient.On("GetVolume", c.NewAdminContext(), "bd5b12a8-a101-11e7-941e-d77981b584d8").Return(&SampleVolumes[0], nil)
+	mockClient.On("GetDock", c.NewAdminContext(), "b7602e18-771e-11e7-8f38-dbd6d291f4e0").Return(&SampleDocks[0], nil)
+	mockClient.On("GetProfile", c.NewAdminContext(), "1106b972-66ef-11e7-b172-db03f3689c9c").Return(&SampleProfiles[0], nil)
+	mockClient.On("UpdateStatus", c.NewAdminContext(), vol, vol.Status).Return(nil)
+	mockClient.On("UpdateOperation", c.NewAdminContext(), opID,
+		&model.OperationSpec{Status: model.OperationRunning}).Return(&SampleOperations[0], nil)
+	mockClient.On("UpdateOperation", c.NewAdminContext(), opID,
+		&model.OperationSpec{DockId: "b7602e18-771e-11e7-8f38-dbd6d291f4e0", Status: model.OperationRunning}).Return(&SampleOperations[0], nil)
+	mockClient.On("UpdateOperation", c.NewAdminContext(), opID,
+		&model.OperationSpec{Status: model.OperationSucceeded}).Return(&SampleOperations[0], nil)
+	db.C = mockClient
+
+	var ctrl = &Controller{
+		selector: &fakeSelector{
+			res: &model.StoragePoolSpec{
+				BaseModel: &model.BaseModel{
+					Id: "084bf71e-a102-11e7-88a8-e31fe6d52248",
+				},
+				DockId: "b7602e18-771e-11e7-8f38-dbd6d291f4e0",
+			},
+			err: nil,
+		},
+		volumeController: NewFakeVolumeController(),
+	}
+
+	if _, err := ctrl.CreateVolume(context.Background(), req); err != nil {
+		t.Errorf("Failed to create volume, err is %v\n", err)
+	}
+	mockClient.AssertNumberOfCalls(t, "UpdateOperation", 3)
+}
+
 func TestDeleteVolume(t *testing.T) {
 	var req = &pb.DeleteVolumeOpts{
 		Id:        "bd5b12a8-a101-11e7-941e-d77981b584d8",
@@ -244,75 +701,558 @@ func TestDeleteVolume(t *testing.T) {
 		Context:   c.NewAdminContext().ToJson(),
 	}
 
+	var usage = &model.QuotaUsageSpec{
+		BaseModel: &model.BaseModel{},
+		Charges: map[string]*model.QuotaCharge{
+			req.Id: {InUse: model.QuotaSet{Volumes: 1, Capacity: 1}},
+		},
+	}
+	mockClient := new(dbtest.Client)
+	mockClient.On("GetProfile", c.NewAdminContext(), req.ProfileId).Return(&SampleProfiles[0], nil)
+	mockClient.On("GetDockByPoolId", c.NewAdminContext(), req.PoolId).Return(&SampleDocks[0], nil)
+	mockClient.On("GetVolume", c.NewAdminContext(), req.Id).Return(&SampleVolumes[0], nil)
+	mockClient.On("DeleteVolume", c.NewAdminContext(), req.Id).Return(nil)
+	mockClient.On("GetQuotaUsage", c.NewAdminContext(), SampleVolumes[0].TenantId).Return(usage, nil)
+	mockClient.On("UpdateQuotaUsage", c.NewAdminContext(), usage).Return(usage, nil)
+	db.C = mockClient
+
+	var ctrl = &Controller{
+		selector: &fakeSelector{
+			res: &model.StoragePoolSpec{
+				BaseModel: &model.BaseModel{
+					Id: "084bf71e-a102-11e7-88a8-e31fe6d52248",
+				},
+				DockId: "b7602e18-771e-11e7-8f38-dbd6d291f4e0",
+			},
+			err: nil,
+		},
+		volumeController: NewFakeVolumeController(),
+	}
+
+	if _, err := ctrl.DeleteVolume(context.Background(), req); err != nil {
+		t.Errorf("Failed to delete volume, err is %v\n", err)
+	}
+	if _, ok := usage.Charges[req.Id]; ok {
+		t.Error("Quota of the deleted volume should be released")
+	}
+}
+
+func TestExtendVolume(t *testing.T) {
+	var req = &pb.ExtendVolumeOpts{
+		Id:        "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		PoolId:    "084bf71e-a102-11e7-88a8-e31fe6d52248",
+		ProfileId: "1106b972-66ef-11e7-b172-db03f3689c9c",
+		Size:      int64(1),
+		Context:   c.NewAdminContext().ToJson(),
+	}
+	var vol2 = &SampleVolumes[0]
+	mockClient := new(dbtest.Client)
+	mockQuota(mockClient)
+	mockClient.On("GetVolume", c.NewAdminContext(), req.Id).Return(vol2, nil)
+	mockClient.On("GetPool", c.NewAdminContext(), req.PoolId).Return(&SamplePools[0], nil)
+	mockClient.On("GetDefaultProfile", c.NewAdminContext()).Return(&SampleProfiles[0], nil)
+	mockClient.On("GetProfile", c.NewAdminContext(), req.ProfileId).Return(&SampleProfiles[0], nil)
+	mockClient.On("GetDockByPoolId", c.NewAdminContext(), req.PoolId).Return(&SampleDocks[0], nil)
+	mockClient.On("UpdateStatus", c.NewAdminContext(), vol2, vol2.Status).Return(nil)
+	db.C = mockClient
+
+	var ctrl = &Controller{
+		selector: &fakeSelector{
+			res: &model.StoragePoolSpec{
+				BaseModel: &model.BaseModel{
+					Id: "084bf71e-a102-11e7-88a8-e31fe6d52248",
+				},
+				DockId: "b7602e18-771e-11e7-8f38-dbd6d291f4e0",
+			},
+			err: nil,
+		},
+		volumeController: NewFakeVolumeController(),
+	}
+
+	req.Size = int64(92)
+	_, err := ctrl.ExtendVolume(context.Background(), req)
+	expectedError := "pool free capacity(90) < new size(92) - old size(1)"
+	if err == nil {
+		t.Errorf("Expected Non-%v, got %v\n", nil, err)
+	} else {
+		if expectedError != err.Error() {
+			t.Errorf("Expected Non-%v, got %v\n", expectedError, err.Error())
+		}
+	}
+
+	req.Size = int64(2)
+	if _, err = ctrl.ExtendVolume(context.Background(), req); err != nil {
+		t.Errorf("Failed to extend volume: %v\n", err)
+	}
+}
+
+func TestRetypeVolume(t *testing.T) {
+	var req = &pb.RetypeVolumeOpts{
+		Id:        "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		ProfileId: "2f9c0a04-66ef-11e7-ade2-43158893e017",
+		Context:   c.NewAdminContext().ToJson(),
+	}
+	var vol = SampleVolumes[0]
+	mockClient := new(dbtest.Client)
+	mockClient.On("GetVolume", c.NewAdminContext(), req.Id).Return(&vol, nil)
+	mockClient.On("UpdateVolume", c.NewAdminContext(), &model.VolumeSpec{
+		BaseModel: &model.BaseModel{Id: req.Id},
+		ProfileId: req.ProfileId,
+		Status:    model.VolumeAvailable,
+	}).Return(&vol, nil)
+	db.C = mockClient
+
+	fvc := &fakeMigrationVolumeController{}
+	var ctrl = &Controller{
+		selector: &fakeSelector{
+			pols: []*model.StoragePoolSpec{&SamplePools[1], &SamplePools[0]},
+		},
+		volumeController: fvc,
+	}
+
+	// The volume is retyped in place because its pool satisfies the new
+	// profile, even though it's not the most preferable one.
+	if _, err := ctrl.RetypeVolume(context.Background(), req); err != nil {
+		t.Errorf("Failed to retype volume, err is %v\n", err)
+	}
+	if len(fvc.calls) != 0 {
+		t.Errorf("Expected no request sent to the dock, got %v\n", fvc.calls)
+	}
+	mockClient.AssertExpectations(t)
+}
+
+func TestRetypeVolumeWithoutMigration(t *testing.T) {
+	var req = &pb.RetypeVolumeOpts{
+		Id:              "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		ProfileId:       "2f9c0a04-66ef-11e7-ade2-43158893e017",
+		MigrationPolicy: model.MigrationPolicyNever,
+		Context:         c.NewAdminContext().ToJson(),
+	}
+	var vol = SampleVolumes[0]
+	mockClient := new(dbtest.Client)
+	mockClient.On("GetVolume", c.NewAdminContext(), req.Id).Return(&vol, nil)
+	mockClient.On("UpdateStatus", c.NewAdminContext(), &vol, model.VolumeAvailable).Return(nil)
+	db.C = mockClient
+
+	fvc := &fakeMigrationVolumeController{}
+	var ctrl = &Controller{
+		selector: &fakeSelector{
+			pols: []*model.StoragePoolSpec{&SamplePools[1]},
+		},
+		volumeController: fvc,
+	}
+
+	if _, err := ctrl.RetypeVolume(context.Background(), req); err == nil {
+		t.Error("Expected error when the volume has to be migrated, got nil")
+	}
+	if len(fvc.calls) != 0 {
+		t.Errorf("Expected no request sent to the dock, got %v\n", fvc.calls)
+	}
+	mockClient.AssertCalled(t, "UpdateStatus", c.NewAdminContext(), &vol, model.VolumeAvailable)
+	mockClient.AssertNotCalled(t, "UpdateVolume", mock.Anything, mock.Anything)
+}
+
+func TestMigrateVolume(t *testing.T) {
+	var req = &pb.MigrateVolumeOpts{
+		Id:      "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		Context: c.NewAdminContext().ToJson(),
+	}
+	var vol = SampleVolumes[0]
+	var dck = &SampleDocks[0]
+	mockClient := new(dbtest.Client)
+	mockClient.On("GetVolume", c.NewAdminContext(), req.Id).Return(&vol, nil)
+	mockClient.On("GetProfile", c.NewAdminContext(), vol.ProfileId).Return(&SampleProfiles[0], nil)
+	mockClient.On("GetPool", c.NewAdminContext(), vol.PoolId).Return(&SamplePools[0], nil)
+	mockClient.On("GetDock", c.NewAdminContext(), dck.Id).Return(dck, nil)
+	mockClient.On("UpdateVolume", c.NewAdminContext(), &model.VolumeSpec{
+		BaseModel: &model.BaseModel{Id: req.Id},
+		PoolId:    SamplePools[1].Id,
+		ProfileId: vol.ProfileId,
+		Status:    model.VolumeAvailable,
+		Metadata:  map[string]string{"lvPath": "/dev/sample-pool-02/volume-" + req.Id},
+	}).Return(&vol, nil)
+	db.C = mockClient
+
+	fvc := &fakeMigrationVolumeController{}
+	var ctrl = &Controller{
+		selector: &fakeSelector{
+			pols: []*model.StoragePoolSpec{&SamplePools[0], &SamplePools[1]},
+		},
+		volumeController: fvc,
+	}
+
+	if _, err := ctrl.MigrateVolume(context.Background(), req); err != nil {
+		t.Errorf("Failed to migrate volume, err is %v\n", err)
+	}
+	// Both pools belong to the same dock, so the volume is migrated by the
+	// driver natively.
+	expected := []string{dck.Id + ":SetDock", "MigrateVolume"}
+	if !reflect.DeepEqual(fvc.calls, expected) {
+		t.Errorf("Expected %v, got %v\n", expected, fvc.calls)
+	}
+	mockClient.AssertExpectations(t)
+}
+
+func TestMigrateVolumeByHost(t *testing.T) {
+	var req = &pb.MigrateVolumeOpts{
+		Id:      "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		PoolId:  "a594b8ac-a103-11e7-985f-d723bcf01b5f",
+		Context: c.NewAdminContext().ToJson(),
+	}
+	var vol = SampleVolumes[0]
+	var srcDock = &SampleDocks[0]
+	var dstDock = &model.DockSpec{
+		BaseModel:  &model.BaseModel{Id: "5f5c806d-2d3f-4d6b-9a4e-40fd9c2a8e19"},
+		Endpoint:   "192.168.0.2:50050",
+		NodeId:     "node-2",
+		DriverName: "sample",
+		Type:       model.DockTypeProvioner,
+	}
+	var attacher = &model.DockSpec{
+		BaseModel: &model.BaseModel{
+			Id: uuid.NewV5(uuid.NamespaceOID, "node-2:192.168.0.2").String(),
+		},
+		Endpoint: "192.168.0.2:50050",
+		NodeId:   "node-2",
+		Type:     model.DockTypeAttacher,
+		Metadata: map[string]string{"HostIp": "192.168.0.2"},
+	}
+	var dstPool = SamplePools[1]
+	dstPool.DockId = dstDock.Id
+
+	mockClient := new(dbtest.Client)
+	mockClient.On("GetVolume", c.NewAdminContext(), req.Id).Return(&vol, nil)
+	mockClient.On("GetProfile", c.NewAdminContext(), vol.ProfileId).Return(&SampleProfiles[0], nil)
+	mockClient.On("GetPool", c.NewAdminContext(), vol.PoolId).Return(&SamplePools[0], nil)
+	mockClient.On("GetDock", c.NewAdminContext(), srcDock.Id).Return(srcDock, nil)
+	mockClient.On("GetDock", c.NewAdminContext(), dstDock.Id).Return(dstDock, nil)
+	mockClient.On("GetDock", c.NewAdminContext(), attacher.Id).Return(attacher, nil)
+	mockClient.On("UpdateVolume", c.NewAdminContext(), &model.VolumeSpec{
+		BaseModel: &model.BaseModel{Id: req.Id},
+		PoolId:    dstPool.Id,
+		ProfileId: vol.ProfileId,
+		Status:    model.VolumeAvailable,
+		Metadata:  map[string]string{"lvPath": "/dev/sample-pool-02/volume-" + req.Id},
+	}).Return(&vol, nil)
+	db.C = mockClient
+
+	vol.Metadata = map[string]string{"lvPath": "/dev/sample-pool-01/volume-" + req.Id}
+	fvc := &fakeMigrationVolumeController{}
+	var ctrl = &Controller{
+		selector: &fakeSelector{
+			pols: []*model.StoragePoolSpec{&dstPool},
+		},
+		volumeController: fvc,
+	}
+
+	if _, err := ctrl.MigrateVolume(context.Background(), req); err != nil {
+		t.Errorf("Failed to migrate volume, err is %v\n", err)
+	}
+	expected := []string{
+		// Create the volume in the target pool.
+		dstDock.Id + ":SetDock", "CreateVolume",
+		// Attach the source and target volume to the host of the attacher.
+		srcDock.Id + ":SetDock", "CreateVolumeAttachment", attacher.Id + ":SetDock", "AttachVolume",
+		dstDock.Id + ":SetDock", "CreateVolumeAttachment", attacher.Id + ":SetDock", "AttachVolume",
+		attacher.Id + ":SetDock", "CopyVolume",
+		// Detach and remove the source volume.
+		attacher.Id + ":SetDock", "DetachVolume", srcDock.Id + ":SetDock", "DeleteVolumeAttachment",
+		srcDock.Id + ":SetDock", "DeleteVolume",
+		// Detach the target volume.
+		attacher.Id + ":SetDock", "DetachVolume", dstDock.Id + ":SetDock", "DeleteVolumeAttachment",
+	}
+	if !reflect.DeepEqual(fvc.calls, expected) {
+		t.Errorf("Expected %v, got %v\n", expected, fvc.calls)
+	}
+	if fvc.copyOpt.SrcPath != "/dev/sdb" || fvc.copyOpt.DstPath != "/dev/sdc" || fvc.copyOpt.Size != vol.Size {
+		t.Errorf("Unexpected copy volume request %+v\n", fvc.copyOpt)
+	}
+	mockClient.AssertExpectations(t)
+}
+
+func TestRevertVolumeToSnapshot(t *testing.T) {
+	var req = &pb.RevertVolumeToSnapshotOpts{
+		Id:         "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		SnapshotId: "3769855c-a102-11e7-b772-17b880d2f537",
+		Context:    c.NewAdminContext().ToJson(),
+	}
+	var vol = SampleVolumes[0]
+	var dck = &SampleDocks[0]
+	mockClient := new(dbtest.Client)
+	mockClient.On("GetVolume", c.NewAdminContext(), req.Id).Return(&vol, nil)
+	mockClient.On("GetVolumeSnapshot", c.NewAdminContext(), req.SnapshotId).Return(&SampleSnapshots[0], nil)
+	mockClient.On("GetPool", c.NewAdminContext(), vol.PoolId).Return(&SamplePools[0], nil)
+	mockClient.On("GetDock", c.NewAdminContext(), dck.Id).Return(dck, nil)
+	mockClient.On("ListAttachmentsByVolumeId", c.NewAdminContext(), req.Id).Return(nil, nil)
+	mockClient.On("UpdateStatus", c.NewAdminContext(), &vol, model.VolumeAvailable).Return(nil)
+	db.C = mockClient
+
+	fvc := &fakeMigrationVolumeController{}
+	var ctrl = &Controller{volumeController: fvc}
+
+	if _, err := ctrl.RevertVolumeToSnapshot(context.Background(), req); err != nil {
+		t.Errorf("Failed to revert volume to snapshot, err is %v\n", err)
+	}
+	expected := []string{dck.Id + ":SetDock", "RevertVolumeToSnapshot"}
+	if !reflect.DeepEqual(fvc.calls, expected) {
+		t.Errorf("Expected %v, got %v\n", expected, fvc.calls)
+	}
+	if req.PoolName != SamplePools[0].Name || req.SnapshotSize != SampleSnapshots[0].Size ||
+		req.DriverName != dck.DriverName {
+		t.Errorf("Unexpected revert volume request %+v\n", req)
+	}
+	mockClient.AssertExpectations(t)
+}
+
+func TestRevertVolumeToSnapshotFailed(t *testing.T) {
+	var req = &pb.RevertVolumeToSnapshotOpts{
+		Id:         "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		SnapshotId: "3769855c-a102-11e7-b772-17b880d2f537",
+		Context:    c.NewAdminContext().ToJson(),
+	}
+	var dck = &SampleDocks[0]
+	var testCases = []struct {
+		revertErr error
+		status    string
+	}{
+		// The volume is left untouched if the revert is refused by the driver.
+		{model.NewPreconditionError("logic volume is opened"), model.VolumeAvailable},
+		{errors.New("merge snapshot failed"), model.VolumeError},
+	}
+
+	for _, tc := range testCases {
+		var vol = SampleVolumes[0]
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetVolume", c.NewAdminContext(), req.Id).Return(&vol, nil)
+		mockClient.On("GetVolumeSnapshot", c.NewAdminContext(), req.SnapshotId).Return(&SampleSnapshots[0], nil)
+		mockClient.On("GetPool", c.NewAdminContext(), vol.PoolId).Return(&SamplePools[0], nil)
+		mockClient.On("GetDock", c.NewAdminContext(), dck.Id).Return(dck, nil)
+		if tc.status != model.VolumeError {
+			mockClient.On("ListAttachmentsByVolumeId", c.NewAdminContext(), req.Id).Return(nil, nil)
+		}
+		mockClient.On("UpdateStatus", c.NewAdminContext(), &vol, tc.status).Return(nil)
+		db.C = mockClient
+
+		fvc := &fakeMigrationVolumeController{revertErr: tc.revertErr}
+		var ctrl = &Controller{volumeController: fvc}
+
+		if _, err := ctrl.RevertVolumeToSnapshot(context.Background(), req); err != tc.revertErr {
+			t.Errorf("Expected %v, got %v\n", tc.revertErr, err)
+		}
+		mockClient.AssertExpectations(t)
+	}
+}
+
+func TestRevertVolumeToSnapshotByHostFailed(t *testing.T) {
+	var req = &pb.RevertVolumeToSnapshotOpts{
+		Id:         "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		SnapshotId: "3769855c-a102-11e7-b772-17b880d2f537",
+		Context:    c.NewAdminContext().ToJson(),
+	}
+	var vol = SampleVolumes[0]
+	var dck = &model.DockSpec{
+		BaseModel:  &model.BaseModel{Id: SampleDocks[0].Id},
+		Endpoint:   "192.168.0.2:50050",
+		NodeId:     "node-2",
+		DriverName: "sample",
+		Type:       model.DockTypeProvioner,
+	}
+	attacherId := uuid.NewV5(uuid.NamespaceOID, "node-2:192.168.0.2").String()
+	mockClient := new(dbtest.Client)
+	mockClient.On("GetVolume", c.NewAdminContext(), req.Id).Return(&vol, nil)
+	mockClient.On("GetVolumeSnapshot", c.NewAdminContext(), req.SnapshotId).Return(&SampleSnapshots[0], nil)
+	mockClient.On("GetPool", c.NewAdminContext(), vol.PoolId).Return(&SamplePools[0], nil)
+	mockClient.On("GetDock", c.NewAdminContext(), dck.Id).Return(dck, nil)
+	mockClient.On("GetDock", c.NewAdminContext(), attacherId).Return(nil, errors.New("dock not found"))
+	mockClient.On("ListAttachmentsByVolumeId", c.NewAdminContext(), req.Id).Return(
+		[]*model.VolumeAttachmentSpec{&SampleAttachments[0]}, nil)
+	mockClient.On("UpdateStatus", c.NewAdminContext(), &vol, model.VolumeInUse).Return(nil)
+	db.C = mockClient
+
+	fvc := &fakeMigrationVolumeController{notImplemented: true}
+	var ctrl = &Controller{volumeController: fvc}
+
+	_, err := ctrl.RevertVolumeToSnapshot(context.Background(), req)
+	if _, ok := err.(*model.PreconditionError); !ok {
+		t.Errorf("Expected PreconditionError, got %v\n", err)
+	}
+	expected := []string{dck.Id + ":SetDock", "RevertVolumeToSnapshot"}
+	if !reflect.DeepEqual(fvc.calls, expected) {
+		t.Errorf("Expected %v, got %v\n", expected, fvc.calls)
+	}
+	mockClient.AssertExpectations(t)
+}
+
+func TestRevertVolumeToSnapshotByHost(t *testing.T) {
+	var req = &pb.RevertVolumeToSnapshotOpts{
+		Id:         "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		SnapshotId: "3769855c-a102-11e7-b772-17b880d2f537",
+		Context:    c.NewAdminContext().ToJson(),
+	}
+	var vol = SampleVolumes[0]
+	var dck = &model.DockSpec{
+		BaseModel:  &model.BaseModel{Id: SampleDocks[0].Id},
+		Endpoint:   "192.168.0.2:50050",
+		NodeId:     "node-2",
+		DriverName: "sample",
+		Type:       model.DockTypeProvioner,
+	}
+	var attacher = &model.DockSpec{
+		BaseModel: &model.BaseModel{
+			Id: uuid.NewV5(uuid.NamespaceOID, "node-2:192.168.0.2").String(),
+		},
+		Endpoint: "192.168.0.2:50050",
+		NodeId:   "node-2",
+		Type:     model.DockTypeAttacher,
+		Metadata: map[string]string{"HostIp": "192.168.0.2"},
+	}
+	mockClient := new(dbtest.Client)
+	mockClient.On("GetVolume", c.NewAdminContext(), req.Id).Return(&vol, nil)
+	mockClient.On("GetVolumeSnapshot", c.NewAdminContext(), req.SnapshotId).Return(&SampleSnapshots[0], nil)
+	mockClient.On("GetPool", c.NewAdminContext(), vol.PoolId).Return(&SamplePools[0], nil)
+	mockClient.On("GetDock", c.NewAdminContext(), dck.Id).Return(dck, nil)
+	mockClient.On("GetDock", c.NewAdminContext(), attacher.Id).Return(attacher, nil)
+	mockClient.On("ListAttachmentsByVolumeId", c.NewAdminContext(), req.Id).Return(
+		[]*model.VolumeAttachmentSpec{&SampleAttachments[0]}, nil)
+	mockClient.On("UpdateStatus", c.NewAdminContext(), &vol, model.VolumeInUse).Return(nil)
+	db.C = mockClient
+
+	vol.Metadata = map[string]string{"lvPath": "/dev/sample-vg/volume-" + req.Id}
+	fvc := &fakeMigrationVolumeController{notImplemented: true}
+	var ctrl = &Controller{volumeController: fvc}
+
+	if _, err := ctrl.RevertVolumeToSnapshot(context.Background(), req); err != nil {
+		t.Errorf("Failed to revert volume to snapshot, err is %v\n", err)
+	}
+	expected := []string{
+		dck.Id + ":SetDock", "RevertVolumeToSnapshot",
+		// Create a temporary volume from the snapshot.
+		dck.Id + ":SetDock", "CreateVolume",
+		// Attach the temporary volume and the volume to the host of the attacher.
+		dck.Id + ":SetDock", "CreateVolumeAttachment", attacher.Id + ":SetDock", "AttachVolume",
+		dck.Id + ":SetDock", "CreateVolumeAttachment", attacher.Id + ":SetDock", "AttachVolume",
+		attacher.Id + ":SetDock", "CopyVolume",
+		// Detach both of them and remove the temporary volume.
+		attacher.Id + ":SetDock", "DetachVolume", dck.Id + ":SetDock", "DeleteVolumeAttachment",
+		attacher.Id + ":SetDock", "DetachVolume", dck.Id + ":SetDock", "DeleteVolumeAttachment",
+		dck.Id + ":SetDock", "DeleteVolume",
+	}
+	if !reflect.DeepEqual(fvc.calls, expected) {
+		t.Errorf("Expected %v, got %v\n", expected, fvc.calls)
+	}
+	if fvc.copyOpt.SrcPath != "/dev/sdb" || fvc.copyOpt.DstPath != "/dev/sdc" || fvc.copyOpt.Size != vol.Size {
+		t.Errorf("Unexpected copy volume request %+v\n", fvc.copyOpt)
+	}
+	mockClient.AssertExpectations(t)
+}
+
+// fakeManageVolumeController records whether the volume is given back to the
+// backend.
+type fakeManageVolumeController struct {
+	fakeVolumeController
+	unmanaged bool
+}
+
+func (fvc *fakeManageVolumeController) UnmanageVolume(*pb.UnmanageVolumeOpts) error {
+	fvc.unmanaged = true
+	return nil
+}
+
+func TestManageVolume(t *testing.T) {
+	var req = &pb.ManageVolumeOpts{
+		Id:         "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		Identifier: "lv001",
+		PoolId:     "084bf71e-a102-11e7-88a8-e31fe6d52248",
+		ProfileId:  "1106b972-66ef-11e7-b172-db03f3689c9c",
+		Context:    c.NewAdminContext().ToJson(),
+	}
+	mockClient := new(dbtest.Client)
+	mockQuota(mockClient)
+	mockClient.On("GetPool", c.NewAdminContext(), req.PoolId).Return(&SamplePools[0], nil)
+	mockClient.On("GetDock", c.NewAdminContext(), SamplePools[0].DockId).Return(&SampleDocks[0], nil)
+	mockClient.On("UpdateStatus", c.NewAdminContext(), mock.Anything, model.VolumeAvailable).Return(nil)
+	db.C = mockClient
+
+	var ctrl = &Controller{
+		volumeController: NewFakeVolumeController(),
+	}
+
+	if _, err := ctrl.ManageVolume(context.Background(), req); err != nil {
+		t.Errorf("Failed to manage volume: %v\n", err)
+	}
+	if req.PoolName != SamplePools[0].Name {
+		t.Errorf("Expected pool name %s, got %s\n", SamplePools[0].Name, req.PoolName)
+	}
+	mockClient.AssertNotCalled(t, "DeleteVolume", mock.Anything, mock.Anything)
+}
+
+func TestManageVolumeOverQuota(t *testing.T) {
+	var req = &pb.ManageVolumeOpts{
+		Id:         "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		Identifier: "lv001",
+		PoolId:     "084bf71e-a102-11e7-88a8-e31fe6d52248",
+		ProfileId:  "1106b972-66ef-11e7-b172-db03f3689c9c",
+		Context:    c.NewAdminContext().ToJson(),
+	}
+	var quota = &model.QuotaSpec{
+		BaseModel: &model.BaseModel{},
+		QuotaSet:  model.QuotaSet{Volumes: -1, Snapshots: -1, Capacity: 0, FileShares: -1},
+	}
 	mockClient := new(dbtest.Client)
-	mockClient.On("GetProfile", c.NewAdminContext(), req.ProfileId).Return(&SampleProfiles[0], nil)
-	mockClient.On("GetDockByPoolId", c.NewAdminContext(), req.PoolId).Return(&SampleDocks[0], nil)
+	mockClient.On("GetQuota", mock.Anything, mock.Anything).Return(quota, nil)
+	mockClient.On("GetQuotaUsage", mock.Anything, mock.Anything).Return(nil, model.NewNotFoundError("quota usage can't find"))
+	mockClient.On("UpdateQuotaUsage", mock.Anything, mock.Anything).Return(&SampleQuotaUsages[0], nil)
+	mockClient.On("GetPool", c.NewAdminContext(), req.PoolId).Return(&SamplePools[0], nil)
+	mockClient.On("GetDock", c.NewAdminContext(), SamplePools[0].DockId).Return(&SampleDocks[0], nil)
 	mockClient.On("DeleteVolume", c.NewAdminContext(), req.Id).Return(nil)
 	db.C = mockClient
 
+	fvc := &fakeManageVolumeController{}
 	var ctrl = &Controller{
-		selector: &fakeSelector{
-			res: &model.StoragePoolSpec{
-				BaseModel: &model.BaseModel{
-					Id: "084bf71e-a102-11e7-88a8-e31fe6d52248",
-				},
-				DockId: "b7602e18-771e-11e7-8f38-dbd6d291f4e0",
-			},
-			err: nil,
-		},
-		volumeController: NewFakeVolumeController(),
+		volumeController: fvc,
 	}
 
-	if _, err := ctrl.DeleteVolume(context.Background(), req); err != nil {
-		t.Errorf("Failed to delete volume, err is %v\n", err)
+	_, err := ctrl.ManageVolume(context.Background(), req)
+	if !model.IsOverQuotaError(err) {
+		t.Errorf("Expected over quota error, got %v\n", err)
+	}
+	// The volume is handed back to the backend and forgotten by OpenSDS.
+	if !fvc.unmanaged {
+		t.Error("Expected the volume to be unmanaged")
 	}
+	mockClient.AssertCalled(t, "DeleteVolume", c.NewAdminContext(), req.Id)
+	mockClient.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything, mock.Anything)
 }
 
-func TestExtendVolume(t *testing.T) {
-	var req = &pb.ExtendVolumeOpts{
-		Id:        "bd5b12a8-a101-11e7-941e-d77981b584d8",
-		PoolId:    "084bf71e-a102-11e7-88a8-e31fe6d52248",
-		ProfileId: "1106b972-66ef-11e7-b172-db03f3689c9c",
-		Size:      int64(1),
-		Context:   c.NewAdminContext().ToJson(),
+func TestUnmanageVolume(t *testing.T) {
+	var req = &pb.UnmanageVolumeOpts{
+		Id:      "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		Context: c.NewAdminContext().ToJson(),
+	}
+	var vol = SampleVolumes[0]
+	var usage = &model.QuotaUsageSpec{
+		BaseModel: &model.BaseModel{},
+		Charges: map[string]*model.QuotaCharge{
+			req.Id: {InUse: model.QuotaSet{Volumes: 1, Capacity: 1}},
+		},
 	}
-	var vol2 = &SampleVolumes[0]
 	mockClient := new(dbtest.Client)
-	mockClient.On("GetVolume", c.NewAdminContext(), req.Id).Return(vol2, nil)
-	mockClient.On("GetPool", c.NewAdminContext(), req.PoolId).Return(&SamplePools[0], nil)
-	mockClient.On("GetDefaultProfile", c.NewAdminContext()).Return(&SampleProfiles[0], nil)
-	mockClient.On("GetProfile", c.NewAdminContext(), req.ProfileId).Return(&SampleProfiles[0], nil)
-	mockClient.On("GetDockByPoolId", c.NewAdminContext(), req.PoolId).Return(&SampleDocks[0], nil)
-	mockClient.On("UpdateStatus", c.NewAdminContext(), vol2, vol2.Status).Return(nil)
+	mockClient.On("GetVolume", c.NewAdminContext(), req.Id).Return(&vol, nil)
+	mockClient.On("GetDockByPoolId", c.NewAdminContext(), vol.PoolId).Return(&SampleDocks[0], nil)
+	mockClient.On("DeleteVolume", c.NewAdminContext(), req.Id).Return(nil)
+	mockClient.On("GetQuotaUsage", c.NewAdminContext(), vol.TenantId).Return(usage, nil)
+	mockClient.On("UpdateQuotaUsage", c.NewAdminContext(), usage).Return(usage, nil)
 	db.C = mockClient
 
 	var ctrl = &Controller{
-		selector: &fakeSelector{
-			res: &model.StoragePoolSpec{
-				BaseModel: &model.BaseModel{
-					Id: "084bf71e-a102-11e7-88a8-e31fe6d52248",
-				},
-				DockId: "b7602e18-771e-11e7-8f38-dbd6d291f4e0",
-			},
-			err: nil,
-		},
 		volumeController: NewFakeVolumeController(),
 	}
 
-	req.Size = int64(92)
-	_, err := ctrl.ExtendVolume(context.Background(), req)
-	expectedError := "pool free capacity(90) < new size(92) - old size(1)"
-	if err == nil {
-		t.Errorf("Expected Non-%v, got %v\n", nil, err)
-	} else {
-		if expectedError != err.Error() {
-			t.Errorf("Expected Non-%v, got %v\n", expectedError, err.Error())
-		}
+	if _, err := ctrl.UnmanageVolume(context.Background(), req); err != nil {
+		t.Errorf("Failed to unmanage volume: %v\n", err)
 	}
-
-	req.Size = int64(2)
-	if _, err = ctrl.ExtendVolume(context.Background(), req); err != nil {
-		t.Errorf("Failed to extend volume: %v\n", err)
+	if _, ok := usage.Charges[req.Id]; ok {
+		t.Error("Quota of the unmanaged volume should be released")
 	}
 }
 
@@ -379,6 +1319,7 @@ func TestCreateVolumeSnapshot(t *testing.T) {
 	var vol = &SampleVolumes[0]
 	var snp = &SampleSnapshots[0]
 	mockClient := new(dbtest.Client)
+	mockQuota(mockClient)
 	mockClient.On("GetVolume", c.NewAdminContext(), req.VolumeId).Return(vol, nil)
 	mockClient.On("GetDockByPoolId", c.NewAdminContext(), vol.PoolId).Return(&SampleDocks[0], nil)
 	mockClient.On("UpdateStatus", c.NewAdminContext(), snp, "available").Return(nil)
@@ -402,8 +1343,10 @@ func TestDeleteVolumeSnapshot(t *testing.T) {
 	}
 	var vol = &SampleVolumes[0]
 	mockClient := new(dbtest.Client)
+	mockQuota(mockClient)
 	mockClient.On("GetVolume", c.NewAdminContext(), req.VolumeId).Return(vol, nil)
 	mockClient.On("GetDockByPoolId", c.NewAdminContext(), vol.PoolId).Return(&SampleDocks[0], nil)
+	mockClient.On("GetVolumeSnapshot", c.NewAdminContext(), req.Id).Return(&SampleSnapshots[0], nil)
 	mockClient.On("DeleteVolumeSnapshot", c.NewAdminContext(), req.Id).Return(nil)
 
 	db.C = mockClient
@@ -417,6 +1360,146 @@ func TestDeleteVolumeSnapshot(t *testing.T) {
 	}
 }
 
+func TestManageVolumeSnapshot(t *testing.T) {
+	var req = &pb.ManageVolumeSnapshotOpts{
+		Id:         "3769855c-a102-11e7-b772-17b880d2f537",
+		Identifier: "snap001",
+		VolumeId:   "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		Context:    c.NewAdminContext().ToJson(),
+	}
+	var vol = &SampleVolumes[0]
+	var snp = &SampleSnapshots[0]
+	mockClient := new(dbtest.Client)
+	mockQuota(mockClient)
+	mockClient.On("GetVolume", c.NewAdminContext(), req.VolumeId).Return(vol, nil)
+	mockClient.On("GetDockByPoolId", c.NewAdminContext(), vol.PoolId).Return(&SampleDocks[0], nil)
+	mockClient.On("UpdateStatus", c.NewAdminContext(), snp, model.VolumeSnapAvailable).Return(nil)
+	db.C = mockClient
+
+	var ctrl = &Controller{
+		volumeController: NewFakeVolumeController(),
+	}
+
+	if _, err := ctrl.ManageVolumeSnapshot(context.Background(), req); err != nil {
+		t.Errorf("Failed to manage volume snapshot: %v\n", err)
+	}
+	if req.Size != vol.Size {
+		t.Errorf("Expected size %d, got %d\n", vol.Size, req.Size)
+	}
+	mockClient.AssertNotCalled(t, "DeleteVolumeSnapshot", mock.Anything, mock.Anything)
+}
+
+func TestUnmanageVolumeSnapshot(t *testing.T) {
+	var req = &pb.UnmanageVolumeSnapshotOpts{
+		Id:      "3769855c-a102-11e7-b772-17b880d2f537",
+		Context: c.NewAdminContext().ToJson(),
+	}
+	var vol = &SampleVolumes[0]
+	mockClient := new(dbtest.Client)
+	mockQuota(mockClient)
+	mockClient.On("GetVolumeSnapshot", c.NewAdminContext(), req.Id).Return(&SampleSnapshots[0], nil)
+	mockClient.On("GetVolume", c.NewAdminContext(), SampleSnapshots[0].VolumeId).Return(vol, nil)
+	mockClient.On("GetDockByPoolId", c.NewAdminContext(), vol.PoolId).Return(&SampleDocks[0], nil)
+	mockClient.On("DeleteVolumeSnapshot", c.NewAdminContext(), req.Id).Return(nil)
+	db.C = mockClient
+
+	var ctrl = &Controller{
+		volumeController: NewFakeVolumeController(),
+	}
+
+	if _, err := ctrl.UnmanageVolumeSnapshot(context.Background(), req); err != nil {
+		t.Errorf("Failed to unmanage volume snapshot: %v\n", err)
+	}
+	mockClient.AssertCalled(t, "DeleteVolumeSnapshot", c.NewAdminContext(), req.Id)
+}
+
+func TestCreateVolumeBackup(t *testing.T) {
+	var req = &pb.CreateVolumeBackupOpts{
+		Id:       "5d8c2a5e-5e0f-11e9-8cf1-6f3c2b7e2c11",
+		VolumeId: "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		Name:     "sample-backup-01",
+		Context:  c.NewAdminContext().ToJson(),
+	}
+	var vol, backup = &SampleVolumes[0], &SampleBackups[0]
+	mockClient := new(dbtest.Client)
+	mockClient.On("GetVolume", c.NewAdminContext(), req.VolumeId).Return(vol, nil)
+	mockClient.On("GetPool", c.NewAdminContext(), vol.PoolId).Return(&SamplePools[0], nil)
+	mockClient.On("GetDock", c.NewAdminContext(), "b7602e18-771e-11e7-8f38-dbd6d291f4e0").Return(&SampleDocks[0], nil)
+	mockClient.On("UpdateBackup", c.NewAdminContext(), req.Id, backup).Return(backup, nil)
+
+	db.C = mockClient
+
+	var ctrl = &Controller{
+		volumeController: NewFakeVolumeController(),
+	}
+
+	if _, err := ctrl.CreateVolumeBackup(context.Background(), req); err != nil {
+		t.Errorf("Failed to create volume backup: %v\n", err)
+	}
+	if backup.Status != model.BackupAvailable || backup.PoolId != vol.PoolId {
+		t.Errorf("Expected backup in pool %s to be available, got %+v\n", vol.PoolId, backup)
+	}
+	if req.AccessProtocol != "rbd" {
+		t.Errorf("Expected %v, got %v\n", "rbd", req.AccessProtocol)
+	}
+}
+
+func TestDeleteVolumeBackup(t *testing.T) {
+	var req = &pb.DeleteVolumeBackupOpts{
+		Id:      "5d8c2a5e-5e0f-11e9-8cf1-6f3c2b7e2c11",
+		Context: c.NewAdminContext().ToJson(),
+	}
+	var backup = &SampleBackups[0]
+	mockClient := new(dbtest.Client)
+	mockClient.On("GetBackup", c.NewAdminContext(), req.Id).Return(backup, nil)
+	mockClient.On("GetDockByPoolId", c.NewAdminContext(), backup.PoolId).Return(&SampleDocks[0], nil)
+	mockClient.On("DeleteBackup", c.NewAdminContext(), req.Id).Return(nil)
+
+	db.C = mockClient
+
+	var ctrl = &Controller{
+		volumeController: NewFakeVolumeController(),
+	}
+
+	if _, err := ctrl.DeleteVolumeBackup(context.Background(), req); err != nil {
+		t.Errorf("Failed to delete volume backup: %v\n", err)
+	}
+	if req.BackupDriver != backup.BackupDriver {
+		t.Errorf("Expected %v, got %v\n", backup.BackupDriver, req.BackupDriver)
+	}
+}
+
+func TestRestoreVolumeBackup(t *testing.T) {
+	var req = &pb.RestoreVolumeBackupOpts{
+		Id:       "5d8c2a5e-5e0f-11e9-8cf1-6f3c2b7e2c11",
+		VolumeId: "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		Context:  c.NewAdminContext().ToJson(),
+	}
+	var vol, backup = &SampleVolumes[0], &SampleBackups[0]
+	mockClient := new(dbtest.Client)
+	mockQuota(mockClient)
+	mockClient.On("GetBackup", c.NewAdminContext(), req.Id).Return(backup, nil)
+	mockClient.On("GetVolume", c.NewAdminContext(), req.VolumeId).Return(vol, nil)
+	mockClient.On("GetPool", c.NewAdminContext(), vol.PoolId).Return(&SamplePools[0], nil)
+	mockClient.On("GetDock", c.NewAdminContext(), "b7602e18-771e-11e7-8f38-dbd6d291f4e0").Return(&SampleDocks[0], nil)
+	mockClient.On("UpdateStatus", c.NewAdminContext(), vol, model.VolumeAvailable).Return(nil)
+	mockClient.On("UpdateStatus", c.NewAdminContext(), backup, model.BackupAvailable).Return(nil)
+
+	db.C = mockClient
+
+	var ctrl = &Controller{
+		volumeController: NewFakeVolumeController(),
+	}
+
+	if _, err := ctrl.RestoreVolumeBackup(context.Background(), req); err != nil {
+		t.Errorf("Failed to restore volume backup: %v\n", err)
+	}
+	if req.Size != backup.Size || req.BackupDriver != backup.BackupDriver {
+		t.Errorf("Expected backup %+v, got %+v\n", backup, req)
+	}
+	mockClient.AssertCalled(t, "UpdateStatus", c.NewAdminContext(), vol, model.VolumeAvailable)
+}
+
 func TestCreateReplication(t *testing.T) {
 	var req = &pb.CreateReplicationOpts{
 		Id:              "c299a978-4f3e-11e8-8a5c-977218a83359",
@@ -572,10 +1655,9 @@ func TestCreateVolumeGroup(t *testing.T) {
 	mockClient := new(dbtest.Client)
 	mockClient.On("GetVolumeGroup", c.NewAdminContext(), req.Id).Return(vg, nil)
 	mockClient.On("GetDock", c.NewAdminContext(), "b7602e18-771e-11e7-8f38-dbd6d291f4e0").Return(&SampleDocks[0], nil)
-	mockClient.On("UpdateVolume", c.NewAdminContext(), &model.VolumeSpec{
-		BaseModel: &model.BaseModel{Id: req.AddVolumes[0]},
-		GroupId:   req.Id,
-	}).Return(&SampleVolumes[0], nil)
+	mockClient.On("VolumesToUpdate", c.NewAdminContext(), []*model.VolumeSpec{
+		{BaseModel: &model.BaseModel{Id: req.AddVolumes[0]}, GroupId: req.Id},
+	}).Return([]*model.VolumeSpec{&SampleVolumes[0]}, nil)
 	mockClient.On("UpdateStatus", c.NewAdminContext(), vg, model.VolumeGroupAvailable).Return(nil)
 	db.C = mockClient
 
@@ -607,10 +1689,9 @@ func TestUpdateVolumeGroup(t *testing.T) {
 
 	mockClient := new(dbtest.Client)
 	mockClient.On("GetDockByPoolId", c.NewAdminContext(), req.PoolId).Return(&SampleDocks[0], nil)
-	mockClient.On("UpdateVolume", c.NewAdminContext(), &model.VolumeSpec{
-		BaseModel: &model.BaseModel{Id: req.AddVolumes[0]},
-		GroupId:   req.Id,
-	}).Return(&SampleVolumes[0], nil)
+	mockClient.On("VolumesToUpdate", c.NewAdminContext(), []*model.VolumeSpec{
+		{BaseModel: &model.BaseModel{Id: req.AddVolumes[0]}, GroupId: req.Id},
+	}).Return([]*model.VolumeSpec{&SampleVolumes[0]}, nil)
 	mockClient.On("UpdateStatus", c.NewAdminContext(), &SampleVolumeGroups[0], model.VolumeGroupAvailable).Return(nil)
 	db.C = mockClient
 
@@ -623,6 +1704,57 @@ func TestUpdateVolumeGroup(t *testing.T) {
 	}
 }
 
+type fakeGroupVolumeController struct {
+	fakeVolumeController
+	vg *model.VolumeGroupSpec
+}
+
+func (fvc *fakeGroupVolumeController) UpdateVolumeGroup(*pb.UpdateVolumeGroupOpts) (*model.VolumeGroupSpec, error) {
+	return fvc.vg, nil
+}
+
+func TestUpdateVolumeGroupWithMembersReported(t *testing.T) {
+	var req = &pb.UpdateVolumeGroupOpts{
+		Id:            "3769855c-a102-11e7-b772-17b880d2f555",
+		AddVolumes:    []string{"bd5b12a8-a101-11e7-941e-d77981b584d8", "e0c4b4d2-7f41-11e9-a6b2-3f5e8c1d4b90"},
+		RemoveVolumes: []string{"9c8a3d2e-7f41-11e9-a6b2-3f5e8c1d4b90"},
+		PoolId:        "084bf71e-a102-11e7-88a8-e31fe6d52248",
+		Context:       c.NewAdminContext().ToJson(),
+	}
+	// The driver failed to add the second volume and reports it in error, the
+	// volume which isn't reported is put into the group.
+	var failed = &model.VolumeSpec{
+		BaseModel: &model.BaseModel{Id: req.AddVolumes[1]},
+		Status:    model.VolumeError,
+	}
+	var vg = &model.VolumeGroupSpec{
+		BaseModel: &model.BaseModel{Id: req.Id},
+		Volumes:   []*model.VolumeSpec{failed},
+	}
+
+	mockClient := new(dbtest.Client)
+	mockClient.On("GetDockByPoolId", c.NewAdminContext(), req.PoolId).Return(&SampleDocks[0], nil)
+	mockClient.On("VolumesToUpdate", c.NewAdminContext(), []*model.VolumeSpec{
+		failed,
+		{BaseModel: &model.BaseModel{Id: req.AddVolumes[0]}, GroupId: req.Id},
+		{BaseModel: &model.BaseModel{Id: req.RemoveVolumes[0]}},
+	}).Return(nil, nil)
+	mockClient.On("UpdateStatus", c.NewAdminContext(), vg, model.VolumeGroupAvailable).Return(nil)
+	db.C = mockClient
+
+	var ctrl = &Controller{
+		volumeController: &fakeGroupVolumeController{vg: vg},
+	}
+
+	if _, err := ctrl.UpdateVolumeGroup(context.Background(), req); err != nil {
+		t.Errorf("Failed to update volume group: %v\n", err)
+	}
+	mockClient.AssertExpectations(t)
+	if vg.Volumes != nil {
+		t.Errorf("Expected the members not to be kept with the group, got %v\n", vg.Volumes)
+	}
+}
+
 func TestDeleteVolumeGroup(t *testing.T) {
 	var req = &pb.DeleteVolumeGroupOpts{
 		Id:      "3769855c-a102-11e7-b772-17b880d2f555",
@@ -643,3 +1775,319 @@ func TestDeleteVolumeGroup(t *testing.T) {
 		t.Errorf("Failed to delete volume group: %v\n", err)
 	}
 }
+
+func TestCreateFileShareSnapshot(t *testing.T) {
+	var req = &pb.CreateFileShareSnapshotOpts{
+		Id:          "3769855c-a102-11e7-b772-17b880d2f537",
+		FileshareId: "d2975ebe-d82c-430f-b28e-f373746a71ca",
+		Name:        "sample-snapshot-01",
+		Context:     c.NewAdminContext().ToJson(),
+	}
+	var fshare = SampleFileShares[0]
+	fshare.Metadata = map[string]string{"lvPath": "/dev/opensds-files-default/sample-fileshare"}
+
+	t.Run("Snapshot should be available after it's created", func(t *testing.T) {
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetFileShare", c.NewAdminContext(), req.FileshareId).Return(&fshare, nil)
+		mockClient.On("GetDockByPoolId", c.NewAdminContext(), fshare.PoolId).Return(&SampleDocks[0], nil)
+		mockClient.On("UpdateStatus", c.NewAdminContext(), &SampleFileShareSnapshots[0], model.FileShareSnapAvailable).Return(nil)
+		db.C = mockClient
+
+		fc := NewFakeFileShareController()
+		var ctrl = &Controller{
+			fileshareController: fc,
+		}
+
+		if _, err := ctrl.CreateFileShareSnapshot(context.Background(), req); err != nil {
+			t.Errorf("Failed to create file share snapshot: %v\n", err)
+		}
+		if fc.createSnapOpt.Size != fshare.Size || fc.createSnapOpt.Metadata["lvPath"] != fshare.Metadata["lvPath"] {
+			t.Errorf("Expected the size and metadata of the file share, got %+v", fc.createSnapOpt)
+		}
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("Snapshot should be in error if the driver fails", func(t *testing.T) {
+		var snap = SampleFileShareSnapshots[0]
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetFileShare", c.NewAdminContext(), req.FileshareId).Return(&fshare, nil)
+		mockClient.On("GetDockByPoolId", c.NewAdminContext(), fshare.PoolId).Return(&SampleDocks[0], nil)
+		mockClient.On("GetFileShareSnapshot", c.NewAdminContext(), req.Id).Return(&snap, nil)
+		mockClient.On("UpdateStatus", c.NewAdminContext(), &snap, model.FileShareSnapError).Return(nil)
+		db.C = mockClient
+
+		var ctrl = &Controller{
+			fileshareController: &fakeFileShareController{err: errors.New("lvcreate failed")},
+		}
+
+		if _, err := ctrl.CreateFileShareSnapshot(context.Background(), req); err == nil {
+			t.Error("Expected an error when the driver fails")
+		}
+		mockClient.AssertExpectations(t)
+	})
+}
+
+func TestDeleteFileShareSnapshot(t *testing.T) {
+	var req = &pb.DeleteFileShareSnapshotOpts{
+		Id:          "3769855c-a102-11e7-b772-17b880d2f537",
+		FileshareId: "d2975ebe-d82c-430f-b28e-f373746a71ca",
+		Metadata:    map[string]string{"lvsPath": "/dev/opensds-files-default/_snapshot-3769855c-a102-11e7-b772-17b880d2f537"},
+		Context:     c.NewAdminContext().ToJson(),
+	}
+	var fshare = &SampleFileShares[0]
+
+	mockClient := new(dbtest.Client)
+	mockClient.On("GetFileShare", c.NewAdminContext(), req.FileshareId).Return(fshare, nil)
+	mockClient.On("GetDockByPoolId", c.NewAdminContext(), fshare.PoolId).Return(&SampleDocks[0], nil)
+	mockClient.On("DeleteFileShareSnapshot", c.NewAdminContext(), req.Id).Return(nil)
+	db.C = mockClient
+
+	fc := NewFakeFileShareController()
+	var ctrl = &Controller{
+		fileshareController: fc,
+	}
+
+	if _, err := ctrl.DeleteFileShareSnapshot(context.Background(), req); err != nil {
+		t.Errorf("Failed to delete file share snapshot: %v\n", err)
+	}
+	if fc.deleteSnapOpt.Metadata["lvsPath"] != req.Metadata["lvsPath"] {
+		t.Errorf("Expected the metadata of the snapshot, got %+v", fc.deleteSnapOpt.Metadata)
+	}
+	mockClient.AssertExpectations(t)
+}
+
+func TestCreateFileShareAcl(t *testing.T) {
+	var req = &pb.CreateFileShareAclOpts{
+		Id:               "d2975ebe-d82c-430f-b28e-f373746a71ca",
+		FileshareId:      "d2975ebe-d82c-430f-b28e-f373746a71ca",
+		Type:             "ip",
+		AccessTo:         []string{"10.0.0.0/24"},
+		AccessCapability: []string{"Read", "Write"},
+		Context:          c.NewAdminContext().ToJson(),
+	}
+	var fshare = SampleFileShares[0]
+	fshare.Metadata = map[string]string{"lvmFileshareName": "sample-fileshare"}
+
+	t.Run("Acl should be available after it's exported", func(t *testing.T) {
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetFileShare", c.NewAdminContext(), req.FileshareId).Return(&fshare, nil)
+		mockClient.On("GetDockByPoolId", c.NewAdminContext(), fshare.PoolId).Return(&SampleDocks[0], nil)
+		mockClient.On("UpdateStatus", c.NewAdminContext(), &SampleFileSharesAcl[0], model.FileShareAclAvailable).Return(nil)
+		db.C = mockClient
+
+		fc := NewFakeFileShareController()
+		var ctrl = &Controller{
+			fileshareController: fc,
+		}
+
+		if _, err := ctrl.CreateFileShareAcl(context.Background(), req); err != nil {
+			t.Errorf("Failed to create file share acl: %v\n", err)
+		}
+		if fc.createAclOpt.Metadata["lvmFileshareName"] != "sample-fileshare" {
+			t.Errorf("Expected the metadata of the file share, got %+v", fc.createAclOpt.Metadata)
+		}
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("Acl should be in error if the driver fails", func(t *testing.T) {
+		var acl = SampleFileSharesAcl[0]
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetFileShare", c.NewAdminContext(), req.FileshareId).Return(&fshare, nil)
+		mockClient.On("GetDockByPoolId", c.NewAdminContext(), fshare.PoolId).Return(&SampleDocks[0], nil)
+		mockClient.On("GetFileShareAcl", c.NewAdminContext(), req.Id).Return(&acl, nil)
+		mockClient.On("UpdateStatus", c.NewAdminContext(), &acl, model.FileShareAclError).Return(nil)
+		db.C = mockClient
+
+		var ctrl = &Controller{
+			fileshareController: &fakeFileShareController{err: errors.New("exportfs failed")},
+		}
+
+		if _, err := ctrl.CreateFileShareAcl(context.Background(), req); err == nil {
+			t.Error("Expected an error when the driver fails")
+		}
+		mockClient.AssertExpectations(t)
+	})
+}
+
+func TestDeleteFileShareAcl(t *testing.T) {
+	var req = &pb.DeleteFileShareAclOpts{
+		Id:          "d2975ebe-d82c-430f-b28e-f373746a71ca",
+		FileshareId: "d2975ebe-d82c-430f-b28e-f373746a71ca",
+		Context:     c.NewAdminContext().ToJson(),
+	}
+	var fshare = &SampleFileShares[0]
+
+	t.Run("Acl should be deleted after it's unexported", func(t *testing.T) {
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetFileShare", c.NewAdminContext(), req.FileshareId).Return(fshare, nil)
+		mockClient.On("GetDockByPoolId", c.NewAdminContext(), fshare.PoolId).Return(&SampleDocks[0], nil)
+		mockClient.On("DeleteFileShareAcl", c.NewAdminContext(), req.Id).Return(nil)
+		db.C = mockClient
+
+		fc := NewFakeFileShareController()
+		var ctrl = &Controller{
+			fileshareController: fc,
+		}
+
+		if _, err := ctrl.DeleteFileShareAcl(context.Background(), req); err != nil {
+			t.Errorf("Failed to delete file share acl: %v\n", err)
+		}
+		if fc.deleteAclOpt.DriverName != SampleDocks[0].DriverName {
+			t.Errorf("Expected driver %s, got %s", SampleDocks[0].DriverName, fc.deleteAclOpt.DriverName)
+		}
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("Acl should be in errorDeleting if the driver fails", func(t *testing.T) {
+		var acl = SampleFileSharesAcl[0]
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetFileShare", c.NewAdminContext(), req.FileshareId).Return(fshare, nil)
+		mockClient.On("GetDockByPoolId", c.NewAdminContext(), fshare.PoolId).Return(&SampleDocks[0], nil)
+		mockClient.On("GetFileShareAcl", c.NewAdminContext(), req.Id).Return(&acl, nil)
+		mockClient.On("UpdateStatus", c.NewAdminContext(), &acl, model.FileShareAclErrorDeleting).Return(nil)
+		db.C = mockClient
+
+		var ctrl = &Controller{
+			fileshareController: &fakeFileShareController{err: errors.New("exportfs failed")},
+		}
+
+		if _, err := ctrl.DeleteFileShareAcl(context.Background(), req); err == nil {
+			t.Error("Expected an error when the driver fails")
+		}
+		mockClient.AssertExpectations(t)
+	})
+}
+
+// fakeGroupSnapshotVolumeController can't take group snapshots natively, and
+// it fails to take the snapshot of the volume specified by failedVolId.
+type fakeGroupSnapshotVolumeController struct {
+	fakeVolumeController
+	failedVolId string
+	calls       []string
+}
+
+func (fvc *fakeGroupSnapshotVolumeController) SetDock(dockInfo *model.DockSpec) {
+	fvc.calls = append(fvc.calls, dockInfo.Id+":SetDock")
+}
+
+func (fvc *fakeGroupSnapshotVolumeController) CreateGroupSnapshot(*pb.CreateGroupSnapshotOpts) ([]*model.VolumeSnapshotSpec, error) {
+	fvc.calls = append(fvc.calls, "CreateGroupSnapshot")
+	return nil, &model.NotImplementError{S: "method CreateGroupSnapshot has not been implemented yet"}
+}
+
+func (fvc *fakeGroupSnapshotVolumeController) FreezeVolume(opt *pb.FreezeVolumeOpts) error {
+	fvc.calls = append(fvc.calls, "FreezeVolume:"+opt.Mountpoint)
+	return nil
+}
+
+func (fvc *fakeGroupSnapshotVolumeController) ThawVolume(opt *pb.FreezeVolumeOpts) error {
+	fvc.calls = append(fvc.calls, "ThawVolume:"+opt.Mountpoint)
+	return nil
+}
+
+func (fvc *fakeGroupSnapshotVolumeController) CreateVolumeSnapshot(opt *pb.CreateVolumeSnapshotOpts) (*model.VolumeSnapshotSpec, error) {
+	fvc.calls = append(fvc.calls, "CreateVolumeSnapshot:"+opt.VolumeId)
+	if opt.VolumeId == fvc.failedVolId {
+		return nil, errors.New("no space left in pool")
+	}
+	return &model.VolumeSnapshotSpec{
+		BaseModel: &model.BaseModel{Id: opt.Id},
+		VolumeId:  opt.VolumeId,
+	}, nil
+}
+
+func (fvc *fakeGroupSnapshotVolumeController) DeleteVolumeSnapshot(opt *pb.DeleteVolumeSnapshotOpts) error {
+	fvc.calls = append(fvc.calls, "DeleteVolumeSnapshot:"+opt.VolumeId)
+	return nil
+}
+
+func TestCreateGroupSnapshotGeneric(t *testing.T) {
+	var vg = &SampleVolumeGroups[0]
+	var provisioner = &SampleDocks[0]
+	var attacher = &model.DockSpec{
+		BaseModel: &model.BaseModel{Id: "7c5ba4e5-7f41-11e9-a6b2-3f5e8c1d4b90"},
+		NodeId:    "node-2",
+		Type:      model.DockTypeAttacher,
+	}
+	var vols = []*model.VolumeSpec{
+		{BaseModel: &model.BaseModel{Id: "bd5b12a8-a101-11e7-941e-d77981b584d8"}, Size: 1, PoolId: vg.PoolId},
+		{BaseModel: &model.BaseModel{Id: "e0c4b4d2-7f41-11e9-a6b2-3f5e8c1d4b90"}, Size: 2, PoolId: vg.PoolId},
+	}
+	newReq := func() *pb.CreateGroupSnapshotOpts {
+		return &pb.CreateGroupSnapshotOpts{
+			Id:      SampleGroupSnapshots[0].Id,
+			GroupId: vg.Id,
+			Snapshots: []*pb.CreateVolumeSnapshotOpts{
+				{Id: "3769855c-a102-11e7-b772-17b880d2f537", VolumeId: vols[0].Id},
+				{Id: "3bfaf2cc-a102-11e7-8ecb-63aea739d755", VolumeId: vols[1].Id},
+			},
+			Context: c.NewAdminContext().ToJson(),
+		}
+	}
+	newMockClient := func() *dbtest.Client {
+		mockClient := new(dbtest.Client)
+		mockQuota(mockClient)
+		mockClient.On("GetVolumeGroup", c.NewAdminContext(), vg.Id).Return(vg, nil)
+		mockClient.On("GetDockByPoolId", c.NewAdminContext(), vg.PoolId).Return(provisioner, nil)
+		mockClient.On("ListDocks", c.NewAdminContext()).Return([]*model.DockSpec{provisioner, attacher}, nil)
+		for _, vol := range vols {
+			mockClient.On("GetVolume", c.NewAdminContext(), vol.Id).Return(vol, nil)
+		}
+		// Only the file system mounted on the first volume can be frozen.
+		mockClient.On("ListAttachmentsByVolumeId", c.NewAdminContext(), vols[0].Id).Return([]*model.VolumeAttachmentSpec{
+			{Mountpoint: "/mnt/data", HostInfo: model.HostInfo{Host: "node-2"}},
+		}, nil)
+		mockClient.On("ListAttachmentsByVolumeId", c.NewAdminContext(), vols[1].Id).Return([]*model.VolumeAttachmentSpec{
+			{HostInfo: model.HostInfo{Host: "node-2"}},
+		}, nil)
+		mockClient.On("GetVolumeGroupSnapshot", c.NewAdminContext(), SampleGroupSnapshots[0].Id).Return(&SampleGroupSnapshots[0], nil)
+		mockClient.On("GetVolumeSnapshot", c.NewAdminContext(), mock.Anything).Return(&SampleSnapshots[0], nil)
+		mockClient.On("UpdateStatus", c.NewAdminContext(), mock.Anything, mock.Anything).Return(nil)
+		return mockClient
+	}
+
+	t.Run("members are snapshotted one by one while frozen", func(t *testing.T) {
+		mockClient := newMockClient()
+		db.C = mockClient
+		fvc := &fakeGroupSnapshotVolumeController{}
+		var ctrl = &Controller{volumeController: fvc}
+
+		if _, err := ctrl.CreateGroupSnapshot(context.Background(), newReq()); err != nil {
+			t.Errorf("Failed to create group snapshot: %v\n", err)
+		}
+		expected := []string{
+			provisioner.Id + ":SetDock", "CreateGroupSnapshot",
+			attacher.Id + ":SetDock", "FreezeVolume:/mnt/data",
+			provisioner.Id + ":SetDock", "CreateVolumeSnapshot:" + vols[0].Id,
+			provisioner.Id + ":SetDock", "CreateVolumeSnapshot:" + vols[1].Id,
+			attacher.Id + ":SetDock", "ThawVolume:/mnt/data",
+		}
+		if !reflect.DeepEqual(fvc.calls, expected) {
+			t.Errorf("Expected %v, got %v\n", expected, fvc.calls)
+		}
+		mockClient.AssertCalled(t, "UpdateStatus", c.NewAdminContext(), &SampleGroupSnapshots[0], model.VolumeGroupSnapAvailable)
+	})
+
+	t.Run("taken snapshots are removed after thawing if a member fails", func(t *testing.T) {
+		mockClient := newMockClient()
+		db.C = mockClient
+		fvc := &fakeGroupSnapshotVolumeController{failedVolId: vols[1].Id}
+		var ctrl = &Controller{volumeController: fvc}
+
+		if _, err := ctrl.CreateGroupSnapshot(context.Background(), newReq()); err == nil {
+			t.Error("Expected an error when a member snapshot fails")
+		}
+		expected := []string{
+			provisioner.Id + ":SetDock", "CreateGroupSnapshot",
+			attacher.Id + ":SetDock", "FreezeVolume:/mnt/data",
+			provisioner.Id + ":SetDock", "CreateVolumeSnapshot:" + vols[0].Id,
+			provisioner.Id + ":SetDock", "CreateVolumeSnapshot:" + vols[1].Id,
+			attacher.Id + ":SetDock", "ThawVolume:/mnt/data",
+			provisioner.Id + ":SetDock", "DeleteVolumeSnapshot:" + vols[0].Id,
+		}
+		if !reflect.DeepEqual(fvc.calls, expected) {
+			t.Errorf("Expected %v, got %v\n", expected, fvc.calls)
+		}
+		mockClient.AssertCalled(t, "UpdateStatus", c.NewAdminContext(), &SampleGroupSnapshots[0], model.VolumeGroupSnapError)
+	})
+}
@@ -136,14 +136,14 @@ func (d *DrController) CreateReplication(ctx *c.Context, replica *ReplicationSpe
 	primaryVol.ReplicationDriverData = pResult.PrimaryReplicationDriverData
 	if primaryVol.ReplicationDriverData != nil {
 		primaryVol.ReplicationDriverData["IsPrimary"] = "true"
-		db.C.UpdateVolume(ctx, primaryVol)
+		updateReplicationDriverData(ctx, primaryVol)
 
 	}
 
 	secondaryVol.ReplicationDriverData = sResult.SecondaryReplicationDriverData
 	if secondaryVol.ReplicationDriverData != nil {
 		secondaryVol.ReplicationDriverData["IsPrimary"] = "false"
-		db.C.UpdateVolume(ctx, secondaryVol)
+		updateReplicationDriverData(ctx, secondaryVol)
 	}
 
 	return replica, nil
@@ -183,12 +183,24 @@ func (d *DrController) DeleteReplication(ctx *c.Context, replica *ReplicationSpe
 
 	// clean up replication driver data in volume database
 	primaryVol.ReplicationDriverData = make(map[string]string)
-	db.C.UpdateVolume(ctx, primaryVol)
+	updateReplicationDriverData(ctx, primaryVol)
 	secondaryVol.ReplicationDriverData = make(map[string]string)
-	db.C.UpdateVolume(ctx, secondaryVol)
+	updateReplicationDriverData(ctx, secondaryVol)
 	return db.C.DeleteReplication(ctx, replica.Id)
 }
 
+// updateReplicationDriverData only stores the replication driver data of the
+// volume, so that the changes made by others since vol was read are kept.
+func updateReplicationDriverData(ctx *c.Context, vol *VolumeSpec) {
+	_, err := db.C.UpdateVolume(ctx, &VolumeSpec{
+		BaseModel:             &BaseModel{Id: vol.Id},
+		ReplicationDriverData: vol.ReplicationDriverData,
+	})
+	if err != nil {
+		log.Errorf("Update replication driver data of volume %s failed, %s", vol.Id, err)
+	}
+}
+
 func (d *DrController) EnableReplication(ctx *c.Context, replica *ReplicationSpec, primaryVol, secondaryVol *VolumeSpec) error {
 	d.LoadOperator(ctx, primaryVol, secondaryVol)
 	err := d.primaryOp.Enable(ctx, replica, primaryVol)
@@ -47,6 +47,22 @@ func (fvc *fakeVolumeController) ExtendVolume(*pb.ExtendVolumeOpts) (*model.Volu
 	return &SampleVolumes[0], nil
 }
 
+func (fvc *fakeVolumeController) MigrateVolume(*pb.MigrateVolumeOpts) (*model.VolumeSpec, error) {
+	return &SampleVolumes[0], nil
+}
+
+func (fvc *fakeVolumeController) RevertVolumeToSnapshot(*pb.RevertVolumeToSnapshotOpts) error {
+	return nil
+}
+
+func (fvc *fakeVolumeController) ManageVolume(*pb.ManageVolumeOpts) (*model.VolumeSpec, error) {
+	return &SampleVolumes[0], nil
+}
+
+func (fvc *fakeVolumeController) UnmanageVolume(*pb.UnmanageVolumeOpts) error {
+	return nil
+}
+
 func (fvc *fakeVolumeController) CreateVolumeAttachment(*pb.CreateVolumeAttachmentOpts) (*model.VolumeAttachmentSpec, error) {
 	return &SampleAttachments[0], nil
 }
@@ -63,6 +79,26 @@ func (fvc *fakeVolumeController) DeleteVolumeSnapshot(*pb.DeleteVolumeSnapshotOp
 	return nil
 }
 
+func (fvc *fakeVolumeController) ManageVolumeSnapshot(*pb.ManageVolumeSnapshotOpts) (*model.VolumeSnapshotSpec, error) {
+	return &SampleSnapshots[0], nil
+}
+
+func (fvc *fakeVolumeController) UnmanageVolumeSnapshot(*pb.UnmanageVolumeSnapshotOpts) error {
+	return nil
+}
+
+func (fvc *fakeVolumeController) CreateVolumeBackup(*pb.CreateVolumeBackupOpts) (*model.BackupSpec, error) {
+	return &SampleBackups[0], nil
+}
+
+func (fvc *fakeVolumeController) DeleteVolumeBackup(*pb.DeleteVolumeBackupOpts) error {
+	return nil
+}
+
+func (fvc *fakeVolumeController) RestoreVolumeBackup(*pb.RestoreVolumeBackupOpts) error {
+	return nil
+}
+
 func (fvc *fakeVolumeController) AttachVolume(*pb.AttachVolumeOpts) (string, error) {
 	return "/dev/disk/by-path/ip-192.168.56.100:3260-iscsi-iqn.2017-10.io.opensds:baec258b-8f79-4bbc-bf97-28addfa903d3-lun-1", nil
 }
@@ -71,6 +107,10 @@ func (fvc *fakeVolumeController) DetachVolume(*pb.DetachVolumeOpts) error {
 	return nil
 }
 
+func (fvc *fakeVolumeController) CopyVolume(*pb.CopyVolumeOpts) error {
+	return nil
+}
+
 func (fvc *fakeVolumeController) CreateReplication(opts *pb.CreateReplicationOpts) (*model.ReplicationSpec, error) {
 	return &SampleReplications[0], nil
 }
@@ -104,6 +144,38 @@ func (fvc *fakeVolumeController) DeleteVolumeGroup(*pb.DeleteVolumeGroupOpts) er
 	return nil
 }
 
+func (fvc *fakeVolumeController) CreateGroupSnapshot(*pb.CreateGroupSnapshotOpts) ([]*model.VolumeSnapshotSpec, error) {
+	return nil, nil
+}
+
+func (fvc *fakeVolumeController) DeleteGroupSnapshot(*pb.DeleteGroupSnapshotOpts) error {
+	return nil
+}
+
+func (fvc *fakeVolumeController) FreezeVolume(*pb.FreezeVolumeOpts) error {
+	return nil
+}
+
+func (fvc *fakeVolumeController) ThawVolume(*pb.FreezeVolumeOpts) error {
+	return nil
+}
+
+func (fvc *fakeVolumeController) PullVolume(*pb.PullVolumeOpts) (*model.VolumeSpec, error) {
+	return &SampleVolumes[0], nil
+}
+
+func (fvc *fakeVolumeController) PullVolumeSnapshot(*pb.PullVolumeSnapshotOpts) (*model.VolumeSnapshotSpec, error) {
+	return &SampleSnapshots[0], nil
+}
+
+func (fvc *fakeVolumeController) ListVolumes(*pb.ListVolumesOpts) ([]*model.VolumeSpec, error) {
+	return nil, nil
+}
+
+func (fvc *fakeVolumeController) ListVolumeSnapshots(*pb.ListVolumesOpts) ([]*model.VolumeSnapshotSpec, error) {
+	return nil, nil
+}
+
 func (fvc *fakeVolumeController) SetDock(dockInfo *model.DockSpec) { return }
 
 var (
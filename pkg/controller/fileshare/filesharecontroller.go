@@ -36,6 +36,10 @@ type Controller interface {
 	SetDock(dockInfo *model.DockSpec)
 	CreateFileShare(opt *pb.CreateFileShareOpts) (*model.FileShareSpec, error)
 	DeleteFileShare(opt *pb.DeleteFileShareOpts) error
+	CreateFileShareSnapshot(opt *pb.CreateFileShareSnapshotOpts) (*model.FileShareSnapshotSpec, error)
+	DeleteFileShareSnapshot(opt *pb.DeleteFileShareSnapshotOpts) error
+	CreateFileShareAcl(opt *pb.CreateFileShareAclOpts) (*model.FileShareAclSpec, error)
+	DeleteFileShareAcl(opt *pb.DeleteFileShareAclOpts) error
 }
 
 // NewController method creates a controller structure and expose its pointer.
@@ -99,6 +103,102 @@ func (c *controller) DeleteFileShare(opt *pb.DeleteFileShareOpts) error {
 	return nil
 }
 
+func (c *controller) CreateFileShareSnapshot(opt *pb.CreateFileShareSnapshotOpts) (*model.FileShareSnapshotSpec, error) {
+	if err := c.Client.Connect(c.DockInfo.Endpoint); err != nil {
+		log.Error("when connecting dock client:", err)
+		return nil, err
+	}
+
+	response, err := c.Client.CreateFileShareSnapshot(context.Background(), opt)
+	if err != nil {
+		log.Error("create file share snapshot failed in file share controller:", err)
+		return nil, err
+	}
+	defer c.Client.Close()
+
+	if errorMsg := response.GetError(); errorMsg != nil {
+		return nil,
+			fmt.Errorf("failed to create file share snapshot in file share controller, code: %v, message: %v",
+				errorMsg.GetCode(), errorMsg.GetDescription())
+	}
+
+	var snapshot = &model.FileShareSnapshotSpec{}
+	if err = json.Unmarshal([]byte(response.GetResult().GetMessage()), snapshot); err != nil {
+		log.Error("create file share snapshot failed in file share controller:", err)
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+func (c *controller) DeleteFileShareSnapshot(opt *pb.DeleteFileShareSnapshotOpts) error {
+	if err := c.Client.Connect(c.DockInfo.Endpoint); err != nil {
+		log.Error("when connecting dock client:", err)
+		return err
+	}
+
+	response, err := c.Client.DeleteFileShareSnapshot(context.Background(), opt)
+	if err != nil {
+		log.Error("delete file share snapshot failed in file share controller:", err)
+		return err
+	}
+	defer c.Client.Close()
+
+	if errorMsg := response.GetError(); errorMsg != nil {
+		return errors.New(errorMsg.GetDescription())
+	}
+
+	return nil
+}
+
+func (c *controller) CreateFileShareAcl(opt *pb.CreateFileShareAclOpts) (*model.FileShareAclSpec, error) {
+	if err := c.Client.Connect(c.DockInfo.Endpoint); err != nil {
+		log.Error("when connecting dock client:", err)
+		return nil, err
+	}
+
+	response, err := c.Client.CreateFileShareAcl(context.Background(), opt)
+	if err != nil {
+		log.Error("create file share acl failed in file share controller:", err)
+		return nil, err
+	}
+	defer c.Client.Close()
+
+	if errorMsg := response.GetError(); errorMsg != nil {
+		return nil,
+			fmt.Errorf("failed to create file share acl in file share controller, code: %v, message: %v",
+				errorMsg.GetCode(), errorMsg.GetDescription())
+	}
+
+	var acl = &model.FileShareAclSpec{}
+	if err = json.Unmarshal([]byte(response.GetResult().GetMessage()), acl); err != nil {
+		log.Error("create file share acl failed in file share controller:", err)
+		return nil, err
+	}
+
+	return acl, nil
+}
+
+func (c *controller) DeleteFileShareAcl(opt *pb.DeleteFileShareAclOpts) error {
+	if err := c.Client.Connect(c.DockInfo.Endpoint); err != nil {
+		log.Error("when connecting dock client:", err)
+		return err
+	}
+
+	response, err := c.Client.DeleteFileShareAcl(context.Background(), opt)
+	if err != nil {
+		log.Error("delete file share acl failed in file share controller:", err)
+		return err
+	}
+	defer c.Client.Close()
+
+	if errorMsg := response.GetError(); errorMsg != nil {
+		return errors.New(errorMsg.GetDescription())
+	}
+
+	return nil
+}
+
 func (c *controller) SetDock(dockInfo *model.DockSpec) {
 	c.DockInfo = dockInfo
 }
@@ -0,0 +1,336 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	log "github.com/golang/glog"
+	osdsCtx "github.com/opensds/opensds/pkg/context"
+	"github.com/opensds/opensds/pkg/db"
+	"github.com/opensds/opensds/pkg/model"
+	pb "github.com/opensds/opensds/pkg/model/proto"
+	"github.com/opensds/opensds/pkg/utils"
+)
+
+// CreateGroupSnapshot implements pb.ControllerServer.CreateGroupSnapshot
+func (c *Controller) CreateGroupSnapshot(contx context.Context, opt *pb.CreateGroupSnapshotOpts) (res *pb.GenericResponse, err error) {
+
+	log.Info("Controller server receive create group snapshot request, vr =", opt)
+
+	ctx := osdsCtx.NewContextFromJson(opt.GetContext())
+	db.UpdateOperationStatus(ctx, db.C, opt.OperationId, model.OperationRunning, nil)
+	defer func() { finishOperation(ctx, opt.OperationId, err) }()
+	defer func() {
+		if err != nil {
+			db.UpdateVolumeGroupSnapshotStatus(ctx, db.C, opt.Id, model.VolumeGroupSnapError)
+			for _, snpOpt := range opt.Snapshots {
+				db.UpdateVolumeSnapshotStatus(ctx, db.C, snpOpt.Id, model.VolumeSnapError)
+				rollbackQuota(ctx, ctx.TenantId, snpOpt.Id)
+			}
+		}
+	}()
+
+	vg, err := db.C.GetVolumeGroup(ctx, opt.GroupId)
+	if err != nil {
+		log.Error("get volume group failed in create group snapshot method: ", err)
+		return pb.GenericResponseError(err), err
+	}
+	dockInfo, err := db.C.GetDockByPoolId(ctx, vg.PoolId)
+	if err != nil {
+		log.Error("when search supported dock resource: ", err)
+		return pb.GenericResponseError(err), err
+	}
+	c.volumeController.SetDock(dockInfo)
+	db.UpdateOperationDock(ctx, db.C, opt.OperationId, dockInfo.Id)
+	opt.PoolId = vg.PoolId
+	opt.DriverName = dockInfo.DriverName
+
+	for _, snpOpt := range opt.Snapshots {
+		var vol *model.VolumeSpec
+		if vol, err = db.C.GetVolume(ctx, snpOpt.VolumeId); err != nil {
+			log.Error("get volume failed in create group snapshot method: ", err)
+			return pb.GenericResponseError(err), err
+		}
+		snpOpt.Size = vol.Size
+		snpOpt.Metadata = utils.MergeStringMaps(snpOpt.Metadata, vol.Metadata)
+		snpOpt.DriverName = dockInfo.DriverName
+		snpOpt.Context = opt.Context
+	}
+
+	snps, err := c.volumeController.CreateGroupSnapshot(opt)
+	if _, ok := err.(*model.NotImplementError); ok {
+		log.Info("Driver doesn't support group snapshot natively, take the snapshots one by one.")
+		snps, err = c.createGroupSnapshotGeneric(ctx, opt, dockInfo)
+	}
+	if err != nil {
+		log.Error("error occurred in controller module when create group snapshot: ", err)
+		return pb.GenericResponseError(err), err
+	}
+
+	for _, snp := range snps {
+		db.C.UpdateStatus(ctx, snp, model.VolumeSnapAvailable)
+		commitQuota(ctx, ctx.TenantId, snp.Id)
+	}
+	db.UpdateVolumeGroupSnapshotStatus(ctx, db.C, opt.Id, model.VolumeGroupSnapAvailable)
+	return pb.GenericResponseResult(snps), nil
+}
+
+// createGroupSnapshotGeneric quiesces the file systems on the member volumes
+// and takes their snapshots one after another, so that the snapshots are
+// consistent even if the driver can't take them atomically.
+func (c *Controller) createGroupSnapshotGeneric(ctx *osdsCtx.Context, opt *pb.CreateGroupSnapshotOpts,
+	dockInfo *model.DockSpec) ([]*model.VolumeSnapshotSpec, error) {
+	var volIds []string
+	for _, snpOpt := range opt.Snapshots {
+		volIds = append(volIds, snpOpt.VolumeId)
+	}
+	thaw, err := c.quiesceVolumes(ctx, volIds)
+	if err != nil {
+		log.Error("quiesce volumes failed: ", err)
+		return nil, err
+	}
+	defer thaw()
+
+	var snps []*model.VolumeSnapshotSpec
+	for _, snpOpt := range opt.Snapshots {
+		c.volumeController.SetDock(dockInfo)
+		snp, err := c.volumeController.CreateVolumeSnapshot(snpOpt)
+		if err != nil {
+			log.Errorf("when create snapshot of volume %s: %v", snpOpt.VolumeId, err)
+			// Don't keep the file systems frozen while cleaning up.
+			thaw()
+			c.volumeController.SetDock(dockInfo)
+			for _, taken := range snps {
+				if err := c.volumeController.DeleteVolumeSnapshot(&pb.DeleteVolumeSnapshotOpts{
+					Id:         taken.Id,
+					VolumeId:   taken.VolumeId,
+					Metadata:   taken.Metadata,
+					DriverName: dockInfo.DriverName,
+					Context:    ctx.ToJson(),
+				}); err != nil {
+					log.Errorf("when delete volume snapshot %s: %v", taken.Id, err)
+				}
+			}
+			return nil, err
+		}
+		snps = append(snps, snp)
+	}
+	return snps, nil
+}
+
+// quiesceVolumes freezes the file systems mounted from the volumes on the
+// hosts of their attachments, the returned function thaws all of them and
+// does nothing if it's called again.
+func (c *Controller) quiesceVolumes(ctx *osdsCtx.Context, volIds []string) (func(), error) {
+	type frozenVolume struct {
+		attacher   *model.DockSpec
+		mountpoint string
+	}
+	var frozen []frozenVolume
+	var thawed = false
+	thaw := func() {
+		if thawed {
+			return
+		}
+		thawed = true
+		for i := len(frozen) - 1; i >= 0; i-- {
+			c.volumeController.SetDock(frozen[i].attacher)
+			if err := c.volumeController.ThawVolume(&pb.FreezeVolumeOpts{
+				Mountpoint: frozen[i].mountpoint,
+				Context:    ctx.ToJson(),
+			}); err != nil {
+				log.Errorf("when thaw %s on host %s: %v", frozen[i].mountpoint, frozen[i].attacher.NodeId, err)
+			}
+		}
+	}
+
+	docks, err := db.C.ListDocks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, volId := range volIds {
+		atms, err := db.C.ListAttachmentsByVolumeId(ctx, volId)
+		if err != nil {
+			thaw()
+			return nil, err
+		}
+		for _, atm := range atms {
+			if atm.Mountpoint == "" {
+				log.Warningf("volume %s is attached to host %s without mountpoint, its snapshot may be inconsistent", volId, atm.Host)
+				continue
+			}
+			attacher := findAttacherDock(docks, atm.Host)
+			if attacher == nil {
+				thaw()
+				return nil, fmt.Errorf("no attacher dock found on host %s of volume %s", atm.Host, volId)
+			}
+			c.volumeController.SetDock(attacher)
+			if err = c.volumeController.FreezeVolume(&pb.FreezeVolumeOpts{
+				Mountpoint: atm.Mountpoint,
+				Context:    ctx.ToJson(),
+			}); err != nil {
+				thaw()
+				return nil, err
+			}
+			frozen = append(frozen, frozenVolume{attacher: attacher, mountpoint: atm.Mountpoint})
+		}
+	}
+	return thaw, nil
+}
+
+// findAttacherDock returns the attacher dock running on the host, or nil if
+// there isn't one.
+func findAttacherDock(docks []*model.DockSpec, host string) *model.DockSpec {
+	for _, dck := range docks {
+		if dck.Type == model.DockTypeAttacher && dck.NodeId == host {
+			return dck
+		}
+	}
+	return nil
+}
+
+// DeleteGroupSnapshot implements pb.ControllerServer.DeleteGroupSnapshot
+func (c *Controller) DeleteGroupSnapshot(contx context.Context, opt *pb.DeleteGroupSnapshotOpts) (res *pb.GenericResponse, err error) {
+
+	log.Info("Controller server receive delete group snapshot request, vr =", opt)
+
+	ctx := osdsCtx.NewContextFromJson(opt.GetContext())
+	db.UpdateOperationStatus(ctx, db.C, opt.OperationId, model.OperationRunning, nil)
+	defer func() { finishOperation(ctx, opt.OperationId, err) }()
+	defer func() {
+		if err != nil {
+			db.UpdateVolumeGroupSnapshotStatus(ctx, db.C, opt.Id, model.VolumeGroupSnapErrorDeleting)
+		}
+	}()
+
+	vg, err := db.C.GetVolumeGroup(ctx, opt.GroupId)
+	if err != nil {
+		log.Error("get volume group failed in delete group snapshot method: ", err)
+		return pb.GenericResponseError(err), err
+	}
+	dockInfo, err := db.C.GetDockByPoolId(ctx, vg.PoolId)
+	if err != nil {
+		log.Error("when search supported dock resource: ", err)
+		return pb.GenericResponseError(err), err
+	}
+	c.volumeController.SetDock(dockInfo)
+	db.UpdateOperationDock(ctx, db.C, opt.OperationId, dockInfo.Id)
+	opt.PoolId = vg.PoolId
+	opt.DriverName = dockInfo.DriverName
+
+	for _, snpOpt := range opt.Snapshots {
+		// The volume may be gone already, its metadata is only used by the
+		// drivers which don't record enough in the snapshot metadata.
+		if vol, err := db.C.GetVolume(ctx, snpOpt.VolumeId); err == nil {
+			snpOpt.Metadata = utils.MergeStringMaps(snpOpt.Metadata, vol.Metadata)
+		}
+		snpOpt.DriverName = dockInfo.DriverName
+		snpOpt.Context = opt.Context
+	}
+
+	err = c.volumeController.DeleteGroupSnapshot(opt)
+	if _, ok := err.(*model.NotImplementError); ok {
+		log.Info("Driver doesn't support group snapshot natively, delete the snapshots one by one.")
+		for _, snpOpt := range opt.Snapshots {
+			if err = c.volumeController.DeleteVolumeSnapshot(snpOpt); err != nil {
+				break
+			}
+		}
+	}
+	if err != nil {
+		log.Error("error occurred in controller module when delete group snapshot: ", err)
+		return pb.GenericResponseError(err), err
+	}
+
+	for _, snpOpt := range opt.Snapshots {
+		// The snapshot is read before its entry is deleted, so that the quota
+		// can be released to the tenant owning it.
+		var snp *model.VolumeSnapshotSpec
+		if snp, err = db.C.GetVolumeSnapshot(ctx, snpOpt.Id); err != nil {
+			log.Error("get volume snapshot failed in delete group snapshot method: ", err)
+			return pb.GenericResponseError(err), err
+		}
+		if err = db.C.DeleteVolumeSnapshot(ctx, snpOpt.Id); err != nil {
+			log.Error("error occurred in controller module when delete volume snapshot in db: ", err)
+			return pb.GenericResponseError(err), err
+		}
+		releaseQuota(ctx, snp.TenantId, snp.Id)
+	}
+	if err = db.C.DeleteVolumeGroupSnapshot(ctx, opt.Id); err != nil {
+		log.Error("error occurred in controller module when delete group snapshot in db: ", err)
+		return pb.GenericResponseError(err), err
+	}
+
+	return pb.GenericResponseResult(nil), nil
+}
+
+// createGroupVolumesFromSnapshot creates the volumes to be added into the
+// group from the member snapshots of the group snapshot, they are created in
+// the pool of the source group where the snapshots are.
+func (c *Controller) createGroupVolumesFromSnapshot(ctx *osdsCtx.Context, opt *pb.CreateVolumeGroupOpts,
+	polInfo *model.StoragePoolSpec, dockInfo *model.DockSpec) (err error) {
+	var created int
+	defer func() {
+		if err != nil {
+			for _, volId := range opt.AddVolumes[created:] {
+				db.UpdateVolumeStatus(ctx, db.C, volId, model.VolumeError)
+				rollbackQuota(ctx, ctx.TenantId, volId)
+			}
+		}
+	}()
+
+	for _, volId := range opt.AddVolumes {
+		var vol *model.VolumeSpec
+		if vol, err = db.C.GetVolume(ctx, volId); err != nil {
+			return err
+		}
+		var snp *model.VolumeSnapshotSpec
+		if snp, err = db.C.GetVolumeSnapshot(ctx, vol.SnapshotId); err != nil {
+			return err
+		}
+		volOpt := &pb.CreateVolumeOpts{
+			Id:               vol.Id,
+			Name:             vol.Name,
+			Description:      vol.Description,
+			Size:             vol.Size,
+			AvailabilityZone: vol.AvailabilityZone,
+			ProfileId:        vol.ProfileId,
+			PoolId:           polInfo.Id,
+			PoolName:         polInfo.Name,
+			SnapshotId:       snp.Id,
+			SnapshotSize:     snp.Size,
+			Metadata:         snp.Metadata,
+			DriverName:       dockInfo.DriverName,
+			Context:          ctx.ToJson(),
+		}
+		if prf, err := db.C.GetProfile(ctx, vol.ProfileId); err == nil {
+			volOpt.Profile = prf.ToJson()
+		}
+
+		c.volumeController.SetDock(dockInfo)
+		var result *model.VolumeSpec
+		if result, err = c.volumeController.CreateVolume(volOpt); err != nil {
+			log.Errorf("when create volume %s from snapshot %s: %v", vol.Id, snp.Id, err)
+			return err
+		}
+		result.PoolId, result.ProfileId = polInfo.Id, vol.ProfileId
+		db.C.UpdateStatus(ctx, result, model.VolumeAvailable)
+		commitQuota(ctx, ctx.TenantId, vol.Id)
+		created++
+	}
+	return nil
+}
@@ -0,0 +1,227 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+This module implements the liveness check of the docks, the docks which stop
+sending heartbeat are marked unavailable together with their pools so that no
+more resources are scheduled onto them.
+*/
+
+package heartbeat
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	log "github.com/golang/glog"
+	c "github.com/opensds/opensds/pkg/context"
+	"github.com/opensds/opensds/pkg/db"
+	"github.com/opensds/opensds/pkg/model"
+	"github.com/opensds/opensds/pkg/utils/constants"
+)
+
+// AlertName is the name of the alert raised when a dock goes offline.
+const AlertName = "DockUnavailable"
+
+// Monitor records the last heartbeat of every dock and marks the docks which
+// haven't sent heartbeat within the timeout unavailable. It's the only one to
+// change the status of the registered docks and pools, the docks keep it when
+// they are reported again.
+type Monitor struct {
+	c       db.Client
+	timeout time.Duration
+	// alert sends the alerts raised or resolved by the monitor.
+	alert func(alerts []*model.PostableAlertSpec) error
+	// now returns the current time, it's replaced in tests.
+	now func() time.Time
+
+	mu sync.Mutex
+	// started is regarded as the last heartbeat of the docks which haven't
+	// sent any since the monitor started, so that they get the full timeout
+	// to report after osdslet restarts.
+	started  time.Time
+	lastSeen map[string]time.Time
+}
+
+// NewMonitor returns a Monitor which marks the docks unavailable after timeout.
+func NewMonitor(timeout time.Duration) *Monitor {
+	return &Monitor{
+		c:        db.C,
+		timeout:  timeout,
+		alert:    postAlerts,
+		now:      time.Now,
+		started:  time.Now(),
+		lastSeen: map[string]time.Time{},
+	}
+}
+
+// Run checks the docks periodically until stopChan is closed, a zero timeout
+// disables the check.
+func (m *Monitor) Run(stopChan <-chan bool) {
+	if m.timeout <= 0 {
+		log.Warning("dock heartbeat timeout isn't set, the docks won't be marked unavailable")
+		return
+	}
+	interval := m.timeout / 3
+	if interval < time.Second {
+		interval = time.Second
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stopChan:
+			return
+		case <-ticker.C:
+			if err := m.Check(c.NewAdminContext()); err != nil {
+				log.Error("when checking dock heartbeat:", err)
+			}
+		}
+	}
+}
+
+// Beat records the heartbeat of the docks, the docks which have been marked
+// unavailable are brought back together with their pools.
+func (m *Monitor) Beat(ctx *c.Context, dockIds []string) error {
+	now := m.now()
+	m.mu.Lock()
+	for _, id := range dockIds {
+		m.lastSeen[id] = now
+	}
+	m.mu.Unlock()
+
+	var resolved []*model.PostableAlertSpec
+	for _, id := range dockIds {
+		dck, err := m.c.GetDock(ctx, id)
+		if err != nil {
+			// The dock may not be registered yet.
+			log.Warningf("get dock %s failed: %v", id, err)
+			continue
+		}
+		if dck.Status != model.DockUnavailable {
+			continue
+		}
+		log.Infof("dock %s is back online", id)
+		if err := m.setStatus(ctx, dck, model.DockAvailable, model.PoolAvailable); err != nil {
+			return err
+		}
+		alert := newAlert(dck, now)
+		alert.EndAt = now
+		resolved = append(resolved, alert)
+	}
+	m.send(resolved)
+	return nil
+}
+
+// Check marks the docks which haven't sent heartbeat within the timeout and
+// their pools unavailable, and raises an alert for each of them.
+func (m *Monitor) Check(ctx *c.Context) error {
+	dcks, err := m.c.ListDocks(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := m.now()
+	var alerts []*model.PostableAlertSpec
+	for _, dck := range dcks {
+		if dck.Status == model.DockUnavailable {
+			continue
+		}
+		m.mu.Lock()
+		last, ok := m.lastSeen[dck.Id]
+		if !ok {
+			last = m.started
+		}
+		m.mu.Unlock()
+		if now.Sub(last) <= m.timeout {
+			continue
+		}
+
+		log.Warningf("no heartbeat from dock %s since %v, mark it unavailable", dck.Id, last)
+		if err := m.setStatus(ctx, dck, model.DockUnavailable, model.PoolUnavailable); err != nil {
+			log.Errorf("mark dock %s unavailable failed: %v", dck.Id, err)
+			continue
+		}
+		alerts = append(alerts, newAlert(dck, now))
+	}
+	m.send(alerts)
+	return nil
+}
+
+// setStatus updates the status of the dock and all the pools belonging to it.
+func (m *Monitor) setStatus(ctx *c.Context, dck *model.DockSpec, dockStatus, poolStatus string) error {
+	pols, err := m.c.ListPools(ctx)
+	if err != nil {
+		return err
+	}
+	for _, pol := range pols {
+		if pol.DockId != dck.Id || pol.Status == poolStatus {
+			continue
+		}
+		if err := m.c.UpdateStatus(ctx, pol, poolStatus); err != nil {
+			return err
+		}
+	}
+	// The dock is updated at last, so that the pools are checked again next
+	// time if any of them fails to be updated.
+	return m.c.UpdateStatus(ctx, dck, dockStatus)
+}
+
+func (m *Monitor) send(alerts []*model.PostableAlertSpec) {
+	if len(alerts) == 0 {
+		return
+	}
+	if err := m.alert(alerts); err != nil {
+		log.Error("send dock alerts failed:", err)
+	}
+}
+
+func newAlert(dck *model.DockSpec, now time.Time) *model.PostableAlertSpec {
+	return &model.PostableAlertSpec{
+		Annotations: model.LabelSet{
+			"summary": fmt.Sprintf("Dock %s on node %s stopped sending heartbeat, its pools are unavailable", dck.Name, dck.NodeId),
+		},
+		StartAt: now,
+		AlertSpec: model.AlertSpec{
+			Labels: model.LabelSet{
+				"alertname": AlertName,
+				"severity":  "critical",
+				"dock_id":   dck.Id,
+				"dock_name": dck.Name,
+				"node_id":   dck.NodeId,
+			},
+		},
+	}
+}
+
+// postAlerts sends the alerts to the alert manager co-located on the server.
+func postAlerts(alerts []*model.PostableAlertSpec) error {
+	body, err := json.Marshal(alerts)
+	if err != nil {
+		return err
+	}
+	resp, err := http.Post(constants.AlertmanagerEndpoint, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("alert manager responded with %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package heartbeat
+
+import (
+	"testing"
+	"time"
+
+	c "github.com/opensds/opensds/pkg/context"
+	"github.com/opensds/opensds/pkg/model"
+	. "github.com/opensds/opensds/testutils/collection"
+	dbtest "github.com/opensds/opensds/testutils/db/testing"
+	"github.com/stretchr/testify/mock"
+)
+
+type fakeAlerter struct {
+	alerts []*model.PostableAlertSpec
+}
+
+func (f *fakeAlerter) alert(alerts []*model.PostableAlertSpec) error {
+	f.alerts = append(f.alerts, alerts...)
+	return nil
+}
+
+func newFakeMonitor(mockClient *dbtest.Client, now time.Time, alerter *fakeAlerter) *Monitor {
+	return &Monitor{
+		c:        mockClient,
+		timeout:  90 * time.Second,
+		alert:    alerter.alert,
+		now:      func() time.Time { return now },
+		started:  now,
+		lastSeen: map[string]time.Time{},
+	}
+}
+
+func samplePools() []*model.StoragePoolSpec {
+	var pols []*model.StoragePoolSpec
+	for i := range SamplePools {
+		pol := SamplePools[i]
+		pols = append(pols, &pol)
+	}
+	return pols
+}
+
+func TestCheck(t *testing.T) {
+	ctx := c.NewAdminContext()
+	now := time.Now()
+	dck := SampleDocks[0]
+	pols := samplePools()
+
+	mockClient := new(dbtest.Client)
+	mockClient.On("ListDocks", ctx).Return([]*model.DockSpec{&dck}, nil)
+	mockClient.On("ListPools", ctx).Return(pols, nil)
+	mockClient.On("UpdateStatus", ctx, mock.Anything, mock.Anything).Return(nil)
+	alerter := &fakeAlerter{}
+	m := newFakeMonitor(mockClient, now, alerter)
+
+	// The dock gets the full timeout to report after the monitor starts.
+	m.now = func() time.Time { return now.Add(time.Minute) }
+	if err := m.Check(ctx); err != nil {
+		t.Errorf("Failed to check docks: %v\n", err)
+	}
+	mockClient.AssertNotCalled(t, "UpdateStatus", ctx, mock.Anything, mock.Anything)
+
+	m.lastSeen[dck.Id] = now.Add(time.Minute)
+	m.now = func() time.Time { return now.Add(2 * time.Minute) }
+	if err := m.Check(ctx); err != nil {
+		t.Errorf("Failed to check docks: %v\n", err)
+	}
+	mockClient.AssertNotCalled(t, "UpdateStatus", ctx, mock.Anything, mock.Anything)
+
+	m.now = func() time.Time { return now.Add(3 * time.Minute) }
+	if err := m.Check(ctx); err != nil {
+		t.Errorf("Failed to check docks: %v\n", err)
+	}
+	mockClient.AssertCalled(t, "UpdateStatus", ctx, &dck, model.DockUnavailable)
+	for _, pol := range pols {
+		mockClient.AssertCalled(t, "UpdateStatus", ctx, pol, model.PoolUnavailable)
+	}
+	if len(alerter.alerts) != 1 {
+		t.Fatalf("Expected 1 alert, got %d\n", len(alerter.alerts))
+	}
+	if alert := alerter.alerts[0]; alert.Labels["alertname"] != AlertName || alert.Labels["dock_id"] != dck.Id {
+		t.Errorf("Unexpected alert %+v\n", alert)
+	}
+}
+
+func TestCheckSkipUnavailableDock(t *testing.T) {
+	ctx := c.NewAdminContext()
+	now := time.Now()
+	dck := SampleDocks[0]
+	dck.Status = model.DockUnavailable
+
+	mockClient := new(dbtest.Client)
+	mockClient.On("ListDocks", ctx).Return([]*model.DockSpec{&dck}, nil)
+	alerter := &fakeAlerter{}
+	m := newFakeMonitor(mockClient, now, alerter)
+	m.now = func() time.Time { return now.Add(time.Hour) }
+
+	if err := m.Check(ctx); err != nil {
+		t.Errorf("Failed to check docks: %v\n", err)
+	}
+	mockClient.AssertNotCalled(t, "UpdateStatus", ctx, mock.Anything, mock.Anything)
+	if len(alerter.alerts) != 0 {
+		t.Errorf("Expected no alert, got %d\n", len(alerter.alerts))
+	}
+}
+
+func TestBeat(t *testing.T) {
+	ctx := c.NewAdminContext()
+	now := time.Now()
+	dck := SampleDocks[0]
+	dck.Status = model.DockUnavailable
+	pols := samplePools()
+	for _, pol := range pols {
+		pol.Status = model.PoolUnavailable
+	}
+
+	mockClient := new(dbtest.Client)
+	mockClient.On("GetDock", ctx, dck.Id).Return(&dck, nil)
+	mockClient.On("ListPools", ctx).Return(pols, nil)
+	mockClient.On("UpdateStatus", ctx, mock.Anything, mock.Anything).Return(nil)
+	alerter := &fakeAlerter{}
+	m := newFakeMonitor(mockClient, now, alerter)
+
+	if err := m.Beat(ctx, []string{dck.Id}); err != nil {
+		t.Errorf("Failed to record heartbeat: %v\n", err)
+	}
+	if last := m.lastSeen[dck.Id]; !last.Equal(now) {
+		t.Errorf("Expected last heartbeat %v, got %v\n", now, last)
+	}
+	mockClient.AssertCalled(t, "UpdateStatus", ctx, &dck, model.DockAvailable)
+	for _, pol := range pols {
+		mockClient.AssertCalled(t, "UpdateStatus", ctx, pol, model.PoolAvailable)
+	}
+	// The alert raised before is resolved.
+	if len(alerter.alerts) != 1 || !alerter.alerts[0].EndAt.Equal(now) {
+		t.Errorf("Expected a resolved alert, got %+v\n", alerter.alerts)
+	}
+}
@@ -0,0 +1,353 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"encoding/json"
+	"strings"
+
+	log "github.com/golang/glog"
+	"github.com/opensds/opensds/contrib/drivers/utils/config"
+	osdsCtx "github.com/opensds/opensds/pkg/context"
+	"github.com/opensds/opensds/pkg/db"
+	"github.com/opensds/opensds/pkg/model"
+	pb "github.com/opensds/opensds/pkg/model/proto"
+	"github.com/opensds/opensds/pkg/utils"
+	uuid "github.com/satori/go.uuid"
+)
+
+// migrateVolume moves the data of the volume to the first candidate pool
+// which it can be moved to, and returns the fields of the volume which should
+// be updated in db so that the volume is owned by the new pool.
+func (c *Controller) migrateVolume(ctx *osdsCtx.Context, vol *model.VolumeSpec,
+	pools []*model.StoragePoolSpec, profileId, opId string) (*model.VolumeSpec, error) {
+	prf, err := db.C.GetProfile(ctx, profileId)
+	if err != nil {
+		log.Error("get profile failed in migrate volume method: ", err)
+		return nil, err
+	}
+	srcPool, err := db.C.GetPool(ctx, vol.PoolId)
+	if err != nil {
+		log.Error("get pool failed in migrate volume method: ", err)
+		return nil, err
+	}
+	srcDock, err := db.C.GetDock(ctx, srcPool.DockId)
+	if err != nil {
+		log.Error("get dock failed in migrate volume method: ", err)
+		return nil, err
+	}
+
+	var result *model.VolumeSpec
+	var dstPool *model.StoragePoolSpec
+	for _, dstPool = range pools {
+		var dstDock *model.DockSpec
+		dstDock, err = db.C.GetDock(ctx, dstPool.DockId)
+		if err != nil {
+			log.Error("when search supported dock resource:", err.Error())
+			continue
+		}
+		db.UpdateOperationDock(ctx, db.C, opId, dstDock.Id)
+
+		opt := &pb.MigrateVolumeOpts{
+			Id:             vol.Id,
+			Name:           vol.Name,
+			Size:           vol.Size,
+			PoolId:         dstPool.Id,
+			PoolName:       dstPool.Name,
+			ProfileId:      profileId,
+			Profile:        prf.ToJson(),
+			SourcePoolId:   srcPool.Id,
+			SourcePoolName: srcPool.Name,
+			Metadata:       vol.Metadata,
+			DriverName:     dstDock.DriverName,
+			Context:        ctx.ToJson(),
+			OperationId:    opId,
+		}
+		// Both pools are managed by the same dock, so let the driver migrate
+		// the volume natively if it's able to.
+		if dstDock.Id == srcDock.Id {
+			c.volumeController.SetDock(srcDock)
+			result, err = c.volumeController.MigrateVolume(opt)
+			if _, ok := err.(*model.NotImplementError); ok {
+				log.Info("Driver doesn't support migration natively, migrate volume through an attacher dock.")
+				result, err = c.copyVolumeByHost(ctx, vol, opt, srcPool, dstPool, srcDock, dstDock)
+			}
+		} else {
+			result, err = c.copyVolumeByHost(ctx, vol, opt, srcPool, dstPool, srcDock, dstDock)
+		}
+		if err == nil {
+			break
+		}
+		log.Errorf("when migrate volume to pool %s: %v", dstPool.Id, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.VolumeSpec{
+		BaseModel: &model.BaseModel{Id: vol.Id},
+		PoolId:    dstPool.Id,
+		ProfileId: profileId,
+		Metadata:  result.Metadata,
+	}, nil
+}
+
+// copyVolumeByHost creates the volume in the target pool, copies the data to
+// it on the host of the attacher dock, and then removes the source volume.
+// The new volume takes the same id, so it will replace the source volume
+// once its metadata is updated in db.
+func (c *Controller) copyVolumeByHost(ctx *osdsCtx.Context, vol *model.VolumeSpec, opt *pb.MigrateVolumeOpts,
+	srcPool, dstPool *model.StoragePoolSpec, srcDock, dstDock *model.DockSpec) (*model.VolumeSpec, error) {
+	attacher, err := getAttacherDock(ctx, dstDock)
+	if err != nil {
+		log.Error("get attacher dock failed in migrate volume method: ", err)
+		return nil, err
+	}
+
+	c.volumeController.SetDock(dstDock)
+	dstVol, err := c.volumeController.CreateVolume(&pb.CreateVolumeOpts{
+		Id:               vol.Id,
+		Name:             vol.Name,
+		Description:      vol.Description,
+		Size:             vol.Size,
+		AvailabilityZone: vol.AvailabilityZone,
+		ProfileId:        opt.ProfileId,
+		Profile:          opt.Profile,
+		PoolId:           dstPool.Id,
+		PoolName:         dstPool.Name,
+		DriverName:       dstDock.DriverName,
+		Context:          ctx.ToJson(),
+	})
+	if err != nil {
+		log.Error("create volume in target pool failed: ", err)
+		return nil, err
+	}
+
+	// Remove the new volume if the data isn't copied to it.
+	var copied = false
+	defer func() {
+		if !copied {
+			c.deleteVolumeOnDock(ctx, vol.Id, dstVol.Metadata, dstDock)
+		}
+	}()
+
+	srcPath, detachSrc, err := c.attachVolume(ctx, vol.Id, vol.Metadata, srcPool, srcDock, attacher)
+	if err != nil {
+		log.Error("attach source volume failed: ", err)
+		return nil, err
+	}
+	defer detachSrc()
+	dstPath, detachDst, err := c.attachVolume(ctx, vol.Id, dstVol.Metadata, dstPool, dstDock, attacher)
+	if err != nil {
+		log.Error("attach target volume failed: ", err)
+		return nil, err
+	}
+	defer detachDst()
+
+	c.volumeController.SetDock(attacher)
+	if err = c.volumeController.CopyVolume(&pb.CopyVolumeOpts{
+		SrcPath: srcPath,
+		DstPath: dstPath,
+		Size:    vol.Size,
+		Context: ctx.ToJson(),
+	}); err != nil {
+		log.Error("copy volume failed: ", err)
+		return nil, err
+	}
+	copied = true
+
+	// The data has been copied, so the source volume is only removed on a
+	// best-effort basis.
+	detachSrc()
+	c.deleteVolumeOnDock(ctx, vol.Id, vol.Metadata, srcDock)
+	return dstVol, nil
+}
+
+// revertVolumeByHost creates a temporary volume from the snapshot in the pool
+// of the volume, and copies its data back to the volume on the host of the
+// attacher dock. The temporary volume is removed at last. The volume isn't
+// changed until the copying, so the errors before it are PreconditionError.
+func (c *Controller) revertVolumeByHost(ctx *osdsCtx.Context, vol *model.VolumeSpec, snap *model.VolumeSnapshotSpec,
+	pool *model.StoragePoolSpec, dck *model.DockSpec) error {
+	attacher, err := getAttacherDock(ctx, dck)
+	if err != nil {
+		log.Error("get attacher dock failed in revert volume method: ", err)
+		return model.NewPreconditionError(err.Error())
+	}
+
+	tmpId := uuid.NewV4().String()
+	c.volumeController.SetDock(dck)
+	tmpVol, err := c.volumeController.CreateVolume(&pb.CreateVolumeOpts{
+		Id:               tmpId,
+		Name:             "revert-" + vol.Id,
+		Size:             vol.Size,
+		AvailabilityZone: vol.AvailabilityZone,
+		ProfileId:        vol.ProfileId,
+		PoolId:           pool.Id,
+		PoolName:         pool.Name,
+		SnapshotId:       snap.Id,
+		SnapshotSize:     snap.Size,
+		Metadata:         snap.Metadata,
+		DriverName:       dck.DriverName,
+		Context:          ctx.ToJson(),
+	})
+	if err != nil {
+		log.Error("create temporary volume from snapshot failed: ", err)
+		return model.NewPreconditionError(err.Error())
+	}
+	defer c.deleteVolumeOnDock(ctx, tmpId, tmpVol.Metadata, dck)
+
+	srcPath, detachSrc, err := c.attachVolume(ctx, tmpId, tmpVol.Metadata, pool, dck, attacher)
+	if err != nil {
+		log.Error("attach temporary volume failed: ", err)
+		return model.NewPreconditionError(err.Error())
+	}
+	defer detachSrc()
+	dstPath, detachDst, err := c.attachVolume(ctx, vol.Id, vol.Metadata, pool, dck, attacher)
+	if err != nil {
+		log.Error("attach volume failed: ", err)
+		return model.NewPreconditionError(err.Error())
+	}
+	defer detachDst()
+
+	c.volumeController.SetDock(attacher)
+	if err = c.volumeController.CopyVolume(&pb.CopyVolumeOpts{
+		SrcPath: srcPath,
+		DstPath: dstPath,
+		Size:    vol.Size,
+		Context: ctx.ToJson(),
+	}); err != nil {
+		log.Error("copy volume failed: ", err)
+		return err
+	}
+	return nil
+}
+
+// attachVolume exports the volume on its provisioner dock and attaches it to
+// the host of the attacher dock, the returned function undoes both of them
+// and does nothing if it's called again.
+func (c *Controller) attachVolume(ctx *osdsCtx.Context, volId string, metadata map[string]string,
+	pool *model.StoragePoolSpec, provisioner, attacher *model.DockSpec) (string, func(), error) {
+	protocol := getAccessProtocol(pool)
+	initiator := attacher.Metadata["Initiator"]
+	if protocol == config.FCProtocol {
+		initiator = attacher.Metadata["WWPNS"]
+	}
+	hostInfo := &pb.HostInfo{
+		Platform:  attacher.Metadata["Platform"],
+		OsType:    attacher.Metadata["OsType"],
+		Ip:        attacher.Metadata["HostIp"],
+		Host:      attacher.NodeId,
+		Initiator: initiator,
+	}
+
+	atmId := uuid.NewV4().String()
+	c.volumeController.SetDock(provisioner)
+	atm, err := c.volumeController.CreateVolumeAttachment(&pb.CreateVolumeAttachmentOpts{
+		Id:             atmId,
+		VolumeId:       volId,
+		HostInfo:       hostInfo,
+		AccessProtocol: protocol,
+		Metadata:       metadata,
+		DriverName:     provisioner.DriverName,
+		Context:        ctx.ToJson(),
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	terminate := func() {
+		c.volumeController.SetDock(provisioner)
+		if err := c.volumeController.DeleteVolumeAttachment(&pb.DeleteVolumeAttachmentOpts{
+			Id:             atmId,
+			VolumeId:       volId,
+			HostInfo:       hostInfo,
+			AccessProtocol: protocol,
+			Metadata:       utils.MergeStringMaps(atm.Metadata, metadata),
+			DriverName:     provisioner.DriverName,
+			Context:        ctx.ToJson(),
+		}); err != nil {
+			log.Errorf("when terminate connection of volume %s: %v", volId, err)
+		}
+	}
+
+	connData, _ := json.Marshal(atm.ConnectionData)
+	c.volumeController.SetDock(attacher)
+	devPath, err := c.volumeController.AttachVolume(&pb.AttachVolumeOpts{
+		AccessProtocol: atm.DriverVolumeType,
+		ConnectionData: string(connData),
+		Metadata:       map[string]string{},
+		Context:        ctx.ToJson(),
+	})
+	if err != nil {
+		terminate()
+		return "", nil, err
+	}
+
+	var detached = false
+	detach := func() {
+		if detached {
+			return
+		}
+		detached = true
+		c.volumeController.SetDock(attacher)
+		if err := c.volumeController.DetachVolume(&pb.DetachVolumeOpts{
+			AccessProtocol: atm.DriverVolumeType,
+			ConnectionData: string(connData),
+			Metadata:       map[string]string{},
+			Context:        ctx.ToJson(),
+		}); err != nil {
+			log.Errorf("when detach volume %s: %v", volId, err)
+		}
+		terminate()
+	}
+	return devPath, detach, nil
+}
+
+// deleteVolumeOnDock removes the volume from the backend of the dock, it's
+// used to clean up the copies of a volume which are no longer needed.
+func (c *Controller) deleteVolumeOnDock(ctx *osdsCtx.Context, volId string, metadata map[string]string, dck *model.DockSpec) {
+	c.volumeController.SetDock(dck)
+	if err := c.volumeController.DeleteVolume(&pb.DeleteVolumeOpts{
+		Id:         volId,
+		Metadata:   metadata,
+		DriverName: dck.DriverName,
+		Context:    ctx.ToJson(),
+	}); err != nil {
+		log.Errorf("when delete volume %s on dock %s: %v", volId, dck.Id, err)
+	}
+}
+
+// idleVolumeStatus returns the status which the volume should be in when no
+// operation is running on it, which depends on whether it's attached.
+func idleVolumeStatus(ctx *osdsCtx.Context, volId string) string {
+	atms, err := db.C.ListAttachmentsByVolumeId(ctx, volId)
+	if err != nil {
+		log.Errorf("list attachments of volume %s failed: %v", volId, err)
+	}
+	if len(atms) > 0 {
+		return model.VolumeInUse
+	}
+	return model.VolumeAvailable
+}
+
+// getAttacherDock returns the attacher dock which runs on the same node as
+// the provisioner dock.
+func getAttacherDock(ctx *osdsCtx.Context, provisioner *model.DockSpec) (*model.DockSpec, error) {
+	segments := strings.Split(provisioner.Endpoint, ":")
+	endpointIp := segments[len(segments)-2]
+	// The attacher UUID is generated by node id and endpoint ip.
+	attacherId := uuid.NewV5(uuid.NamespaceOID, provisioner.NodeId+":"+endpointIp)
+	return db.C.GetDock(ctx, attacherId.String())
+}
@@ -48,19 +48,8 @@ func RegisterAsynchronizedWorkflow(
 	var asynWorkflow = AsynchronizedWorkflow{}
 	for key := range tags {
 		switch key {
-		case "intervalSnapshot":
-			ise := &IntervalSnapshotExecutor{
-				Request:  req.(*pb.CreateVolumeSnapshotOpts),
-				Interval: tags[key],
-				DockInfo: dockInfo,
-			}
-
-			if err := ise.Init(in); err != nil {
-				log.Errorf("When register async policy %s: %v\n", key, err)
-				return asynWorkflow, err
-			}
-			asynWorkflow[key] = ise
-
+		// The intervalSnapshot policy is taken by the snapshot scheduler of
+		// osdslet, see pkg/controller/policy/schedule.
 		case "deleteSnapshotPolicy":
 			ise := &DeleteSnapshotExecutor{
 				Request:  req.(*pb.DeleteVolumeSnapshotOpts),
@@ -0,0 +1,201 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+This module implements the scheduled snapshots configured by the snapshot
+properties of the profiles, the state of every volume is persisted on the
+volume itself so that the schedule survives osdslet restarts.
+
+*/
+
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/opensds/opensds/pkg/model"
+	"github.com/opensds/opensds/pkg/utils/constants"
+)
+
+// The occurrences supported by the snapshot schedule.
+const (
+	Daily   = "Daily"
+	Weekly  = "Weekly"
+	Monthly = "Monthly"
+)
+
+// IntervalSnapshotKey is the custom property of the profile which takes the
+// snapshots at a fixed interval, such as "30m", "12h" or "7d".
+const IntervalSnapshotKey = "intervalSnapshot"
+
+// Policy describes when the snapshots of a volume are taken and how long they
+// are kept.
+type Policy struct {
+	// Start is the first run of the schedule, the later runs are repeated by
+	// Occurrence. The schedule only runs once if Occurrence is empty.
+	Start      time.Time
+	Occurrence string
+	// Interval takes the snapshots at a fixed interval since the last run.
+	Interval time.Duration
+
+	// RetentionNumber is how many scheduled snapshots are kept, zero means
+	// unlimited.
+	RetentionNumber int64
+	// RetentionDuration is how long the scheduled snapshots are kept, zero
+	// means forever.
+	RetentionDuration time.Duration
+}
+
+// NewPolicy parses the snapshot policy of the profile, nil is returned if the
+// profile doesn't schedule any snapshot.
+func NewPolicy(prf *model.ProfileSpec) (*Policy, error) {
+	sp := prf.SnapshotProperties
+	p := &Policy{}
+
+	if sp.Schedule.Datetime != "" {
+		start, err := time.ParseInLocation(constants.TimeFormat, sp.Schedule.Datetime, time.Local)
+		if err != nil {
+			return nil, fmt.Errorf("invalid snapshot schedule datetime %s: %v", sp.Schedule.Datetime, err)
+		}
+		p.Start = start
+	}
+	if occ := sp.Schedule.Occurrence; occ != "" {
+		switch strings.ToLower(occ) {
+		case "daily":
+			p.Occurrence = Daily
+		case "weekly":
+			p.Occurrence = Weekly
+		case "monthly":
+			p.Occurrence = Monthly
+		default:
+			return nil, fmt.Errorf("invalid snapshot schedule occurrence %s, it should be one of %s, %s and %s",
+				occ, Daily, Weekly, Monthly)
+		}
+		if p.Start.IsZero() {
+			return nil, fmt.Errorf("datetime of the snapshot schedule is required by occurrence %s", occ)
+		}
+	}
+	if v, ok := prf.CustomProperties[IntervalSnapshotKey]; ok {
+		interval, err := parseInterval(fmt.Sprint(v))
+		if err != nil {
+			return nil, err
+		}
+		p.Interval = interval
+	}
+
+	if sp.Retention.Number < 0 || sp.Retention.Duration < 0 {
+		return nil, fmt.Errorf("snapshot retention number and duration can't be negative")
+	}
+	p.RetentionNumber = sp.Retention.Number
+	p.RetentionDuration = time.Duration(sp.Retention.Duration) * 24 * time.Hour
+
+	if p.Start.IsZero() && p.Interval == 0 {
+		return nil, nil
+	}
+	return p, nil
+}
+
+// parseInterval parses the interval made up of a number and one of the units
+// s, m, h and d.
+func parseInterval(interval string) (time.Duration, error) {
+	if len(interval) < 2 {
+		return 0, fmt.Errorf("invalid snapshot interval %s", interval)
+	}
+	var unit time.Duration
+	switch strings.ToLower(interval[len(interval)-1:]) {
+	case "s":
+		unit = time.Second
+	case "m":
+		unit = time.Minute
+	case "h":
+		unit = time.Hour
+	case "d":
+		unit = 24 * time.Hour
+	default:
+		return 0, fmt.Errorf("invalid snapshot interval %s, the unit should be one of s, m, h and d", interval)
+	}
+	num, err := strconv.Atoi(interval[:len(interval)-1])
+	if err != nil || num <= 0 {
+		return 0, fmt.Errorf("invalid snapshot interval %s", interval)
+	}
+	return time.Duration(num) * unit, nil
+}
+
+// String identifies the schedule of the policy, the retention isn't included
+// since it doesn't affect when the snapshots are taken.
+func (p *Policy) String() string {
+	var s []string
+	if !p.Start.IsZero() {
+		s = append(s, strings.TrimSpace(p.Start.Format(constants.TimeFormat)+" "+p.Occurrence))
+	}
+	if p.Interval != 0 {
+		s = append(s, "every "+p.Interval.String())
+	}
+	return strings.Join(s, ", ")
+}
+
+// Next returns the first run after last, which is the time of the last run
+// or the time when the schedule was set up. A zero time means that no more run
+// is scheduled.
+func (p *Policy) Next(last time.Time) time.Time {
+	var next time.Time
+	if !p.Start.IsZero() {
+		next = p.nextOccurrence(last)
+	}
+	if p.Interval != 0 {
+		if t := last.Add(p.Interval); next.IsZero() || t.Before(next) {
+			next = t
+		}
+	}
+	return next
+}
+
+// nextOccurrence returns the first run of the schedule after the given time.
+// The runs are computed from Start by calendar, so that they stay at the same
+// time of the day across daylight saving changes.
+func (p *Policy) nextOccurrence(after time.Time) time.Time {
+	if p.Start.After(after) {
+		return p.Start
+	}
+
+	var step func(n int) time.Time
+	var n int
+	switch p.Occurrence {
+	case Daily:
+		step = func(n int) time.Time { return p.Start.AddDate(0, 0, n) }
+		n = int(after.Sub(p.Start) / (24 * time.Hour))
+	case Weekly:
+		step = func(n int) time.Time { return p.Start.AddDate(0, 0, 7*n) }
+		n = int(after.Sub(p.Start) / (7 * 24 * time.Hour))
+	case Monthly:
+		step = func(n int) time.Time { return p.Start.AddDate(0, n, 0) }
+		n = (after.Year()-p.Start.Year())*12 + int(after.Month()-p.Start.Month())
+	default:
+		// The one-shot schedule has run.
+		return time.Time{}
+	}
+	// The estimation may be one step off, start from the previous step.
+	if n > 0 {
+		n--
+	}
+	t := step(n)
+	for !t.After(after) {
+		n++
+		t = step(n)
+	}
+	return t
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/opensds/opensds/pkg/model"
+)
+
+func newProfile(datetime, occurrence string) *model.ProfileSpec {
+	prf := &model.ProfileSpec{BaseModel: &model.BaseModel{}}
+	prf.SnapshotProperties.Schedule.Datetime = datetime
+	prf.SnapshotProperties.Schedule.Occurrence = occurrence
+	return prf
+}
+
+func TestNewPolicy(t *testing.T) {
+	p, err := NewPolicy(newProfile("", ""))
+	if err != nil || p != nil {
+		t.Errorf("Expected no policy, got %v, %v\n", p, err)
+	}
+
+	prf := newProfile("2019-01-31T02:00:00", "daily")
+	prf.SnapshotProperties.Retention.Number = 7
+	prf.SnapshotProperties.Retention.Duration = 30
+	prf.CustomProperties = model.CustomPropertiesSpec{IntervalSnapshotKey: "12h"}
+	p, err = NewPolicy(prf)
+	if err != nil {
+		t.Fatalf("Failed to parse policy: %v\n", err)
+	}
+	if p.Occurrence != Daily || p.Interval != 12*time.Hour || p.RetentionNumber != 7 ||
+		p.RetentionDuration != 30*24*time.Hour {
+		t.Errorf("Unexpected policy %+v\n", p)
+	}
+	if expected := "2019-01-31T02:00:00 Daily, every 12h0m0s"; p.String() != expected {
+		t.Errorf("Expected %s, got %s\n", expected, p.String())
+	}
+
+	for _, prf := range []*model.ProfileSpec{
+		newProfile("2019-01-31", ""),
+		newProfile("2019-01-31T02:00:00", "yearly"),
+		newProfile("", "daily"),
+		{CustomProperties: model.CustomPropertiesSpec{IntervalSnapshotKey: "12x"}},
+		{CustomProperties: model.CustomPropertiesSpec{IntervalSnapshotKey: "0h"}},
+	} {
+		if _, err := NewPolicy(prf); err == nil {
+			t.Errorf("Expected error for invalid policy %+v\n", prf.SnapshotProperties)
+		}
+	}
+}
+
+func TestNext(t *testing.T) {
+	start := time.Date(2019, 1, 31, 2, 0, 0, 0, time.Local)
+	testCases := []struct {
+		policy   Policy
+		last     time.Time
+		expected time.Time
+	}{
+		{Policy{Start: start}, start.Add(-time.Hour), start},
+		{Policy{Start: start}, start, time.Time{}},
+		{Policy{Start: start, Occurrence: Daily}, start, start.AddDate(0, 0, 1)},
+		{Policy{Start: start, Occurrence: Daily}, start.AddDate(0, 0, 10).Add(time.Hour), start.AddDate(0, 0, 11)},
+		{Policy{Start: start, Occurrence: Weekly}, start.AddDate(0, 0, 8), start.AddDate(0, 0, 14)},
+		{Policy{Start: start, Occurrence: Monthly}, start.AddDate(0, 0, 45), start.AddDate(0, 2, 0)},
+		{Policy{Interval: time.Hour}, start, start.Add(time.Hour)},
+		{Policy{Start: start, Occurrence: Daily, Interval: 36 * time.Hour}, start, start.AddDate(0, 0, 1)},
+		{Policy{Start: start, Occurrence: Weekly, Interval: 36 * time.Hour}, start, start.Add(36 * time.Hour)},
+	}
+
+	for i, tc := range testCases {
+		if next := tc.policy.Next(tc.last); !next.Equal(tc.expected) {
+			t.Errorf("Case %d: expected %v, got %v\n", i, tc.expected, next)
+		}
+	}
+}
@@ -0,0 +1,289 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedule
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	log "github.com/golang/glog"
+	c "github.com/opensds/opensds/pkg/context"
+	"github.com/opensds/opensds/pkg/db"
+	"github.com/opensds/opensds/pkg/model"
+	pb "github.com/opensds/opensds/pkg/model/proto"
+	"github.com/opensds/opensds/pkg/utils/constants"
+	"github.com/opensds/opensds/pkg/volume"
+)
+
+// ScheduledKey marks the snapshots taken by the scheduler in their metadata,
+// only these snapshots are pruned by the retention of the policy.
+const ScheduledKey = "scheduledSnapshot"
+
+// SnapshotController takes and deletes the snapshots for the scheduler, it's
+// implemented by the controller server.
+type SnapshotController interface {
+	CreateVolumeSnapshot(context.Context, *pb.CreateVolumeSnapshotOpts) (*pb.GenericResponse, error)
+	DeleteVolumeSnapshot(context.Context, *pb.DeleteVolumeSnapshotOpts) (*pb.GenericResponse, error)
+}
+
+// Scheduler takes the snapshots of the volumes according to the snapshot
+// policies of their profiles, and prunes the ones beyond the retention.
+type Scheduler struct {
+	c        db.Client
+	ctr      SnapshotController
+	interval time.Duration
+	// now returns the current time, it's replaced in tests.
+	now func() time.Time
+}
+
+// NewScheduler returns a Scheduler which checks the volumes every interval.
+func NewScheduler(ctr SnapshotController, interval time.Duration) *Scheduler {
+	return &Scheduler{
+		c:        db.C,
+		ctr:      ctr,
+		interval: interval,
+		now:      time.Now,
+	}
+}
+
+// Run checks the volumes periodically until stopChan is closed, a zero
+// interval disables the scheduled snapshots.
+func (s *Scheduler) Run(stopChan <-chan bool) {
+	if s.interval <= 0 {
+		log.Warning("snapshot schedule interval isn't set, no scheduled snapshot will be taken")
+		return
+	}
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stopChan:
+			return
+		case <-ticker.C:
+			if err := s.Schedule(c.NewAdminContext()); err != nil {
+				log.Error("when scheduling volume snapshots:", err)
+			}
+		}
+	}
+}
+
+// profile is the profile of the volumes together with its snapshot policy.
+type profile struct {
+	*model.ProfileSpec
+	policy *Policy
+}
+
+// Schedule takes the snapshots which are due and prunes the expired ones for
+// all the volumes.
+func (s *Scheduler) Schedule(ctx *c.Context) error {
+	vols, err := s.c.ListVolumes(ctx)
+	if err != nil {
+		return err
+	}
+
+	prfs := map[string]*profile{}
+	for _, vol := range vols {
+		if vol.ProfileId == "" {
+			continue
+		}
+		prf, ok := prfs[vol.ProfileId]
+		if !ok {
+			if prf, err = s.getProfile(ctx, vol.ProfileId); err != nil {
+				log.Errorf("get snapshot policy of profile %s failed: %v", vol.ProfileId, err)
+			}
+			prfs[vol.ProfileId] = prf
+		}
+		if prf == nil {
+			continue
+		}
+		if err := s.scheduleVolume(ctx, vol, prf); err != nil {
+			log.Errorf("schedule snapshots of volume %s failed: %v", vol.Id, err)
+		}
+	}
+	return nil
+}
+
+func (s *Scheduler) getProfile(ctx *c.Context, prfId string) (*profile, error) {
+	prf, err := s.c.GetProfile(ctx, prfId)
+	if err != nil {
+		return nil, err
+	}
+	p, err := NewPolicy(prf)
+	if err != nil {
+		return nil, err
+	}
+	return &profile{ProfileSpec: prf, policy: p}, nil
+}
+
+func (s *Scheduler) scheduleVolume(ctx *c.Context, vol *model.VolumeSpec, prf *profile) error {
+	now := s.now()
+	p, state := prf.policy, vol.SnapshotSchedule
+	if p == nil {
+		if state == nil || state.Policy == "" {
+			return nil
+		}
+		// The schedule has been removed from the profile of the volume.
+		return s.update(ctx, vol, &model.SnapshotScheduleSpec{LastRunAt: state.LastRunAt})
+	}
+	if state == nil || state.Policy != p.String() {
+		// The volume is newly scheduled or its schedule has been changed.
+		next := &model.SnapshotScheduleSpec{Policy: p.String(), NextRunAt: formatTime(p.Next(now))}
+		if state != nil {
+			next.LastRunAt = state.LastRunAt
+		}
+		return s.update(ctx, vol, next)
+	}
+
+	if err := s.prune(ctx, vol, prf, now); err != nil {
+		log.Errorf("prune scheduled snapshots of volume %s failed: %v", vol.Id, err)
+	}
+
+	if state.NextRunAt == "" {
+		return nil
+	}
+	due, err := time.ParseInLocation(constants.TimeFormat, state.NextRunAt, time.Local)
+	if err != nil {
+		return err
+	}
+	if now.Before(due) {
+		return nil
+	}
+	// The run is taken later when the volume is ready.
+	if vol.Status != model.VolumeAvailable && vol.Status != model.VolumeInUse {
+		return nil
+	}
+
+	// The run is claimed before the snapshot is taken, the update fails if the
+	// volume has been modified since it was listed, so that no run is taken
+	// twice across restarts or by the osdslets sharing the database. The runs
+	// missed while osdslet was down are merged into this one.
+	next := &model.SnapshotScheduleSpec{
+		Policy:    p.String(),
+		LastRunAt: formatTime(now),
+		NextRunAt: formatTime(p.Next(now)),
+	}
+	if err := s.update(ctx, vol, next); err != nil {
+		return err
+	}
+	return s.take(vol, prf, now)
+}
+
+// update stores the schedule state of the volume, the update is based on the
+// revision of the volume when it was listed.
+func (s *Scheduler) update(ctx *c.Context, vol *model.VolumeSpec, state *model.SnapshotScheduleSpec) error {
+	_, err := s.c.UpdateVolume(ctx, &model.VolumeSpec{
+		BaseModel:        &model.BaseModel{Id: vol.Id, Revision: vol.Revision},
+		SnapshotSchedule: state,
+	})
+	return err
+}
+
+// take takes a snapshot of the volume on behalf of its tenant.
+func (s *Scheduler) take(vol *model.VolumeSpec, prf *profile, now time.Time) error {
+	ctx := c.NewInternalTenantContext(vol.TenantId, vol.UserId)
+	snap, err := volume.CreateSnapshotDBEntry(ctx, &model.VolumeSnapshotSpec{
+		BaseModel:   &model.BaseModel{},
+		Name:        fmt.Sprintf("%s-%s", vol.Name, now.Format("20060102150405")),
+		Description: fmt.Sprintf("Scheduled snapshot of profile %s", prf.Name),
+		VolumeId:    vol.Id,
+		ProfileId:   vol.ProfileId,
+		UserId:      vol.UserId,
+		Size:        vol.Size,
+		Metadata:    map[string]string{ScheduledKey: "true"},
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Infof("take scheduled snapshot %s of volume %s", snap.Id, vol.Id)
+	_, err = s.ctr.CreateVolumeSnapshot(context.Background(), &pb.CreateVolumeSnapshotOpts{
+		Id:          snap.Id,
+		Name:        snap.Name,
+		Description: snap.Description,
+		VolumeId:    snap.VolumeId,
+		Size:        snap.Size,
+		Metadata:    snap.Metadata,
+		Context:     ctx.ToJson(),
+		Profile:     prf.ToJson(),
+	})
+	return err
+}
+
+// prune deletes the scheduled snapshots of the volume beyond the retention
+// number or older than the retention duration, the newest ones are kept.
+func (s *Scheduler) prune(ctx *c.Context, vol *model.VolumeSpec, prf *profile, now time.Time) error {
+	p := prf.policy
+	if p.RetentionNumber == 0 && p.RetentionDuration == 0 {
+		return nil
+	}
+	snaps, err := s.c.ListSnapshotsByVolumeId(ctx, vol.Id)
+	if err != nil {
+		return err
+	}
+
+	var scheduled []*model.VolumeSnapshotSpec
+	for _, snap := range snaps {
+		if snap.Metadata[ScheduledKey] == "true" && snap.Status == model.VolumeSnapAvailable {
+			scheduled = append(scheduled, snap)
+		}
+	}
+	sort.Slice(scheduled, func(i, j int) bool {
+		return scheduled[i].CreatedAt > scheduled[j].CreatedAt
+	})
+
+	for i, snap := range scheduled {
+		expired := p.RetentionNumber > 0 && int64(i) >= p.RetentionNumber
+		if p.RetentionDuration > 0 {
+			created, err := time.ParseInLocation(constants.TimeFormat, snap.CreatedAt, time.Local)
+			if err == nil && now.Sub(created) > p.RetentionDuration {
+				expired = true
+			}
+		}
+		if !expired {
+			continue
+		}
+		if err := s.remove(snap, prf); err != nil {
+			log.Errorf("delete expired snapshot %s failed: %v", snap.Id, err)
+		}
+	}
+	return nil
+}
+
+// remove deletes the snapshot on behalf of its tenant.
+func (s *Scheduler) remove(snap *model.VolumeSnapshotSpec, prf *profile) error {
+	ctx := c.NewInternalTenantContext(snap.TenantId, snap.UserId)
+	if err := volume.DeleteSnapshotDBEntry(ctx, snap); err != nil {
+		return err
+	}
+
+	log.Infof("delete expired snapshot %s of volume %s", snap.Id, snap.VolumeId)
+	_, err := s.ctr.DeleteVolumeSnapshot(context.Background(), &pb.DeleteVolumeSnapshotOpts{
+		Id:       snap.Id,
+		VolumeId: snap.VolumeId,
+		Metadata: snap.Metadata,
+		Context:  ctx.ToJson(),
+		Profile:  prf.ToJson(),
+	})
+	return err
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(constants.TimeFormat)
+}
@@ -0,0 +1,179 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedule
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	c "github.com/opensds/opensds/pkg/context"
+	"github.com/opensds/opensds/pkg/db"
+	"github.com/opensds/opensds/pkg/model"
+	pb "github.com/opensds/opensds/pkg/model/proto"
+	"github.com/opensds/opensds/pkg/utils/constants"
+	. "github.com/opensds/opensds/testutils/collection"
+	dbtest "github.com/opensds/opensds/testutils/db/testing"
+	"github.com/stretchr/testify/mock"
+)
+
+type fakeController struct {
+	created []*pb.CreateVolumeSnapshotOpts
+	deleted []*pb.DeleteVolumeSnapshotOpts
+}
+
+func (f *fakeController) CreateVolumeSnapshot(ctx context.Context, opt *pb.CreateVolumeSnapshotOpts) (*pb.GenericResponse, error) {
+	f.created = append(f.created, opt)
+	return &pb.GenericResponse{}, nil
+}
+
+func (f *fakeController) DeleteVolumeSnapshot(ctx context.Context, opt *pb.DeleteVolumeSnapshotOpts) (*pb.GenericResponse, error) {
+	f.deleted = append(f.deleted, opt)
+	return &pb.GenericResponse{}, nil
+}
+
+func mockQuota(m *dbtest.Client) {
+	m.On("GetQuota", mock.Anything, mock.Anything).Return(nil, model.NewNotFoundError("quota is not set"))
+	m.On("GetQuotaUsage", mock.Anything, mock.Anything).Return(nil, model.NewNotFoundError("quota usage can't find"))
+	m.On("UpdateQuotaUsage", mock.Anything, mock.Anything).Return(&SampleQuotaUsages[0], nil)
+}
+
+func newFakeScheduler(mockClient *dbtest.Client, now time.Time, ctr *fakeController) *Scheduler {
+	db.C = mockClient
+	return &Scheduler{
+		c:        mockClient,
+		ctr:      ctr,
+		interval: time.Minute,
+		now:      func() time.Time { return now },
+	}
+}
+
+func newScheduledProfile() *model.ProfileSpec {
+	prf := SampleProfiles[0]
+	prf.CustomProperties = model.CustomPropertiesSpec{IntervalSnapshotKey: "1h"}
+	prf.SnapshotProperties.Retention.Number = 1
+	return &prf
+}
+
+func newVolume(state *model.SnapshotScheduleSpec) *model.VolumeSpec {
+	vol := SampleVolumes[0]
+	vol.BaseModel = &model.BaseModel{Id: vol.Id, Revision: 5}
+	vol.ProfileId = SampleProfiles[0].Id
+	vol.SnapshotSchedule = state
+	return &vol
+}
+
+func TestScheduleNewVolume(t *testing.T) {
+	ctx := c.NewAdminContext()
+	now := time.Now()
+	vol := newVolume(nil)
+
+	mockClient := new(dbtest.Client)
+	mockClient.On("ListVolumes", ctx).Return([]*model.VolumeSpec{vol}, nil)
+	mockClient.On("GetProfile", ctx, vol.ProfileId).Return(newScheduledProfile(), nil)
+	mockClient.On("UpdateVolume", ctx, mock.Anything).Return(vol, nil)
+	ctr := &fakeController{}
+	s := newFakeScheduler(mockClient, now, ctr)
+
+	if err := s.Schedule(ctx); err != nil {
+		t.Errorf("Failed to schedule snapshots: %v\n", err)
+	}
+	mockClient.AssertCalled(t, "UpdateVolume", ctx, &model.VolumeSpec{
+		BaseModel: &model.BaseModel{Id: vol.Id, Revision: 5},
+		SnapshotSchedule: &model.SnapshotScheduleSpec{
+			Policy:    "every 1h0m0s",
+			NextRunAt: now.Add(time.Hour).Format(constants.TimeFormat),
+		},
+	})
+	if len(ctr.created) != 0 {
+		t.Errorf("Expected no snapshot taken, got %d\n", len(ctr.created))
+	}
+}
+
+func TestScheduleDueVolume(t *testing.T) {
+	ctx := c.NewAdminContext()
+	now := time.Now()
+	vol := newVolume(&model.SnapshotScheduleSpec{
+		Policy:    "every 1h0m0s",
+		NextRunAt: now.Add(-time.Minute).Format(constants.TimeFormat),
+	})
+	scheduled := SampleSnapshots[0]
+	scheduled.Metadata = map[string]string{ScheduledKey: "true"}
+	scheduled.CreatedAt = now.Add(-2 * time.Hour).Format(constants.TimeFormat)
+	manual := SampleSnapshots[1]
+	manual.CreatedAt = now.Add(-3 * time.Hour).Format(constants.TimeFormat)
+	newer := SampleSnapshots[1]
+	newer.BaseModel = &model.BaseModel{Id: "f1e1c3b6-9a7e-11e9-8c4e-2b4a3e5f6a71", CreatedAt: now.Add(-time.Hour).Format(constants.TimeFormat)}
+	newer.Metadata = map[string]string{ScheduledKey: "true"}
+
+	mockClient := new(dbtest.Client)
+	mockQuota(mockClient)
+	mockClient.On("ListVolumes", ctx).Return([]*model.VolumeSpec{vol}, nil)
+	mockClient.On("GetProfile", ctx, vol.ProfileId).Return(newScheduledProfile(), nil)
+	mockClient.On("UpdateVolume", ctx, mock.Anything).Return(vol, nil)
+	mockClient.On("ListSnapshotsByVolumeId", ctx, vol.Id).Return(
+		[]*model.VolumeSnapshotSpec{&scheduled, &manual, &newer}, nil)
+	mockClient.On("GetVolume", mock.Anything, vol.Id).Return(vol, nil)
+	mockClient.On("UpdateVolumeSnapshot", mock.Anything, scheduled.Id, mock.Anything).Return(&scheduled, nil)
+	mockClient.On("CreateVolumeSnapshot", mock.Anything, mock.Anything).Return(&SampleSnapshots[0], nil)
+	ctr := &fakeController{}
+	s := newFakeScheduler(mockClient, now, ctr)
+
+	if err := s.Schedule(ctx); err != nil {
+		t.Errorf("Failed to schedule snapshots: %v\n", err)
+	}
+	// The run is claimed before the snapshot is taken.
+	mockClient.AssertCalled(t, "UpdateVolume", ctx, &model.VolumeSpec{
+		BaseModel: &model.BaseModel{Id: vol.Id, Revision: 5},
+		SnapshotSchedule: &model.SnapshotScheduleSpec{
+			Policy:    "every 1h0m0s",
+			LastRunAt: now.Format(constants.TimeFormat),
+			NextRunAt: now.Add(time.Hour).Format(constants.TimeFormat),
+		},
+	})
+	if len(ctr.created) != 1 || ctr.created[0].VolumeId != vol.Id {
+		t.Errorf("Expected one snapshot of volume %s taken, got %v\n", vol.Id, ctr.created)
+	}
+	// Only the older scheduled snapshot is beyond the retention.
+	if len(ctr.deleted) != 1 || ctr.deleted[0].Id != scheduled.Id {
+		t.Errorf("Expected snapshot %s deleted, got %v\n", scheduled.Id, ctr.deleted)
+	}
+}
+
+func TestScheduleClaimConflict(t *testing.T) {
+	ctx := c.NewAdminContext()
+	now := time.Now()
+	vol := newVolume(&model.SnapshotScheduleSpec{
+		Policy:    "every 1h0m0s",
+		NextRunAt: now.Add(-time.Minute).Format(constants.TimeFormat),
+	})
+	prf := newScheduledProfile()
+	prf.SnapshotProperties.Retention.Number = 0
+
+	mockClient := new(dbtest.Client)
+	mockClient.On("ListVolumes", ctx).Return([]*model.VolumeSpec{vol}, nil)
+	mockClient.On("GetProfile", ctx, vol.ProfileId).Return(prf, nil)
+	mockClient.On("UpdateVolume", ctx, mock.Anything).Return(nil, model.NewConflictError("volume has been modified"))
+	ctr := &fakeController{}
+	s := newFakeScheduler(mockClient, now, ctr)
+
+	if err := s.Schedule(ctx); err != nil {
+		t.Errorf("Failed to schedule snapshots: %v\n", err)
+	}
+	// The run claimed by another osdslet isn't taken again.
+	if len(ctr.created) != 0 {
+		t.Errorf("Expected no snapshot taken, got %d\n", len(ctr.created))
+	}
+}
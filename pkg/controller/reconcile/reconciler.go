@@ -0,0 +1,457 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+This module implements the reconciliation between the database and the
+backends. The records of the volumes and snapshots are checked against the
+objects pulled from the docks, and the objects named by opensds in each pool
+are checked against the records, so that the drifts caused by the objects
+deleted by hand or the docks crashed in the middle of requests are found.
+
+*/
+
+package reconcile
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/golang/glog"
+	c "github.com/opensds/opensds/pkg/context"
+	"github.com/opensds/opensds/pkg/controller/volume"
+	"github.com/opensds/opensds/pkg/db"
+	"github.com/opensds/opensds/pkg/model"
+	pb "github.com/opensds/opensds/pkg/model/proto"
+	"github.com/opensds/opensds/pkg/utils/constants"
+)
+
+// SettleTime is how long the resources in a transitional status are left
+// alone, the requests handling them may still be running.
+var SettleTime = 10 * time.Minute
+
+// transitional statuses of volumes and snapshots
+var transitionalStatus = map[string]bool{
+	model.VolumeCreating:   true,
+	model.VolumeDeleting:   true,
+	model.VolumeExtending:  true,
+	model.VolumeRestoring:  true,
+	model.VolumeRetyping:   true,
+	model.VolumeMigrating:  true,
+	model.VolumeReverting:  true,
+	model.VolumeManaging:   true,
+	model.VolumeUnmanaging: true,
+}
+
+// Reconciler checks the records in the database against the backends
+// periodically and keeps the report of the last reconciliation.
+type Reconciler struct {
+	c        db.Client
+	ctrl     volume.Controller
+	interval time.Duration
+	autoFix  bool
+	// now returns the current time, it's replaced in tests.
+	now func() time.Time
+
+	mu sync.Mutex
+	// report is the report of the last reconciliation, or the header of
+	// the running one.
+	report  *model.ReconcileReportSpec
+	running bool
+}
+
+// NewReconciler returns a Reconciler which reconciles every interval, the
+// safe drifts are fixed in the periodic reconciliations if autoFix is set.
+func NewReconciler(ctrl volume.Controller, interval time.Duration, autoFix bool) *Reconciler {
+	return &Reconciler{
+		c:        db.C,
+		ctrl:     ctrl,
+		interval: interval,
+		autoFix:  autoFix,
+		now:      time.Now,
+	}
+}
+
+// Run reconciles periodically until stopChan is closed, a zero interval
+// disables the periodic reconciliation, but it can still be started through
+// Start.
+func (r *Reconciler) Run(stopChan <-chan bool) {
+	if r.interval <= 0 {
+		log.Warning("reconcile interval isn't set, the database won't be reconciled with the backends periodically")
+		return
+	}
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stopChan:
+			return
+		case <-ticker.C:
+			if _, err := r.Reconcile(c.NewAdminContext(), r.autoFix); err != nil {
+				log.Error("when reconciling the database with the backends:", err)
+			}
+		}
+	}
+}
+
+// Start runs a reconciliation in the background unless one is running, and
+// returns the header of the running reconciliation.
+func (r *Reconciler) Start(autoFix bool) *model.ReconcileReportSpec {
+	rpt, ok := r.begin(autoFix)
+	if ok {
+		go r.finish(r.reconcile(c.NewAdminContext(), rpt))
+	}
+	return r.Report()
+}
+
+// Report returns the report of the last reconciliation, or the header of the
+// running one. Nil is returned if no reconciliation has been run.
+func (r *Reconciler) Report() *model.ReconcileReportSpec {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.report == nil {
+		return nil
+	}
+	rpt := *r.report
+	return &rpt
+}
+
+// Reconcile checks all the volumes and snapshots against the backends, the
+// drifts which are safe to be fixed are fixed if autoFix is set. The records
+// found missing in the backend or reported as error by the backend are
+// marked error, the other drifts are only reported because fixing them may
+// lose data or affect the quota.
+func (r *Reconciler) Reconcile(ctx *c.Context, autoFix bool) (*model.ReconcileReportSpec, error) {
+	rpt, ok := r.begin(autoFix)
+	if !ok {
+		return nil, fmt.Errorf("a reconciliation started at %s is running", r.Report().StartedAt)
+	}
+	rpt = r.reconcile(ctx, rpt)
+	r.finish(rpt)
+	return rpt, nil
+}
+
+// begin marks the reconciliation running, false is returned if another one
+// is running.
+func (r *Reconciler) begin(autoFix bool) (*model.ReconcileReportSpec, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.running {
+		return nil, false
+	}
+	r.running = true
+	rpt := &model.ReconcileReportSpec{
+		Status:    model.ReconcileRunning,
+		AutoFix:   autoFix,
+		StartedAt: r.now().Format(constants.TimeFormat),
+		Drifts:    []*model.DriftSpec{},
+	}
+	header := *rpt
+	r.report = &header
+	return rpt, true
+}
+
+func (r *Reconciler) finish(rpt *model.ReconcileReportSpec) {
+	rpt.Status = model.ReconcileFinished
+	rpt.FinishedAt = r.now().Format(constants.TimeFormat)
+	log.Infof("reconciliation started at %s finished with %d drifts and %d errors",
+		rpt.StartedAt, len(rpt.Drifts), len(rpt.Errors))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.report = rpt
+	r.running = false
+}
+
+// pool is a pool together with the records placed in it.
+type pool struct {
+	*model.StoragePoolSpec
+	dock  *model.DockSpec
+	vols  []*model.VolumeSpec
+	snaps []*model.VolumeSnapshotSpec
+}
+
+func (r *Reconciler) reconcile(ctx *c.Context, rpt *model.ReconcileReportSpec) *model.ReconcileReportSpec {
+	pols, err := r.c.ListPools(ctx)
+	if err != nil {
+		r.errorf(rpt, "list pools failed: %v", err)
+		return rpt
+	}
+	vols, err := r.c.ListVolumes(ctx)
+	if err != nil {
+		r.errorf(rpt, "list volumes failed: %v", err)
+		return rpt
+	}
+	snaps, err := r.c.ListVolumeSnapshots(ctx)
+	if err != nil {
+		r.errorf(rpt, "list volume snapshots failed: %v", err)
+		return rpt
+	}
+
+	var pools = map[string]*pool{}
+	for _, pol := range pols {
+		pools[pol.Id] = &pool{StoragePoolSpec: pol}
+	}
+	var volPools = map[string]*pool{}
+	for _, vol := range vols {
+		if p, ok := pools[vol.PoolId]; ok {
+			p.vols = append(p.vols, vol)
+			volPools[vol.Id] = p
+		}
+	}
+	for _, snap := range snaps {
+		if p, ok := volPools[snap.VolumeId]; ok {
+			p.snaps = append(p.snaps, snap)
+		}
+	}
+
+	for _, pol := range pols {
+		r.reconcilePool(ctx, rpt, pools[pol.Id])
+	}
+	return rpt
+}
+
+// reconcilePool pulls the records placed in the pool from the backend, and
+// finds the objects in the pool whose records are lost.
+func (r *Reconciler) reconcilePool(ctx *c.Context, rpt *model.ReconcileReportSpec, p *pool) {
+	if p.Status == model.PoolUnavailable {
+		r.errorf(rpt, "pool %s is unavailable, skip it", p.Id)
+		return
+	}
+	dck, err := r.c.GetDock(ctx, p.DockId)
+	if err != nil {
+		r.errorf(rpt, "get dock %s of pool %s failed: %v", p.DockId, p.Id, err)
+		return
+	}
+	p.dock = dck
+	r.ctrl.SetDock(dck)
+
+	r.pullVolumes(ctx, rpt, p)
+	r.pullSnapshots(ctx, rpt, p)
+	r.listVolumes(ctx, rpt, p)
+	r.listSnapshots(ctx, rpt, p)
+}
+
+func (r *Reconciler) pullVolumes(ctx *c.Context, rpt *model.ReconcileReportSpec, p *pool) {
+	for _, vol := range p.vols {
+		if r.settling(vol.Status, vol.BaseModel) {
+			continue
+		}
+		actual, err := r.ctrl.PullVolume(&pb.PullVolumeOpts{
+			Id:         vol.Id,
+			PoolId:     p.Id,
+			PoolName:   p.Name,
+			Metadata:   vol.Metadata,
+			DriverName: p.dock.DriverName,
+			Context:    ctx.ToJson(),
+		})
+		switch err.(type) {
+		case nil:
+			r.compare(ctx, rpt, model.OperationResourceVolume, vol, vol.Id, p.Id,
+				vol.Status, vol.Size, actual.Status, actual.Size)
+		case *model.NotFoundError:
+			r.drift(ctx, rpt, vol, &model.DriftSpec{
+				Type:         model.DriftMissing,
+				ResourceType: model.OperationResourceVolume,
+				ResourceId:   vol.Id,
+				PoolId:       p.Id,
+				Expected:     vol.Status,
+			}, vol.Status)
+		case *model.NotImplementError:
+			r.errorf(rpt, "driver %s of pool %s can't pull volumes: %v", p.dock.DriverName, p.Id, err)
+			return
+		default:
+			r.errorf(rpt, "pull volume %s failed: %v", vol.Id, err)
+		}
+	}
+}
+
+func (r *Reconciler) pullSnapshots(ctx *c.Context, rpt *model.ReconcileReportSpec, p *pool) {
+	for _, snap := range p.snaps {
+		if r.settling(snap.Status, snap.BaseModel) {
+			continue
+		}
+		actual, err := r.ctrl.PullVolumeSnapshot(&pb.PullVolumeSnapshotOpts{
+			Id:         snap.Id,
+			VolumeId:   snap.VolumeId,
+			PoolName:   p.Name,
+			Metadata:   snap.Metadata,
+			DriverName: p.dock.DriverName,
+			Context:    ctx.ToJson(),
+		})
+		switch err.(type) {
+		case nil:
+			r.compare(ctx, rpt, model.OperationResourceSnapshot, snap, snap.Id, p.Id,
+				snap.Status, snap.Size, actual.Status, actual.Size)
+		case *model.NotFoundError:
+			r.drift(ctx, rpt, snap, &model.DriftSpec{
+				Type:         model.DriftMissing,
+				ResourceType: model.OperationResourceSnapshot,
+				ResourceId:   snap.Id,
+				PoolId:       p.Id,
+				Expected:     snap.Status,
+			}, snap.Status)
+		case *model.NotImplementError:
+			r.errorf(rpt, "driver %s of pool %s can't pull snapshots: %v", p.dock.DriverName, p.Id, err)
+			return
+		default:
+			r.errorf(rpt, "pull snapshot %s failed: %v", snap.Id, err)
+		}
+	}
+}
+
+// compare reports the size and status of the object in the backend which
+// differ from the record. The object reported as error by the backend is
+// marked error, the size is never fixed since it's charged to the quota.
+func (r *Reconciler) compare(ctx *c.Context, rpt *model.ReconcileReportSpec, resourceType string, obj interface{},
+	id, poolId, status string, size int64, actualStatus string, actualSize int64) {
+	if actualSize > 0 && actualSize != size {
+		r.drift(ctx, rpt, nil, &model.DriftSpec{
+			Type:         model.DriftSizeMismatch,
+			ResourceType: resourceType,
+			ResourceId:   id,
+			PoolId:       poolId,
+			Expected:     strconv.FormatInt(size, 10),
+			Actual:       strconv.FormatInt(actualSize, 10),
+		}, status)
+	}
+
+	switch {
+	case isError(actualStatus) && !isError(status):
+		r.drift(ctx, rpt, obj, &model.DriftSpec{
+			Type:         model.DriftStatusMismatch,
+			ResourceType: resourceType,
+			ResourceId:   id,
+			PoolId:       poolId,
+			Expected:     status,
+			Actual:       actualStatus,
+		}, status)
+	case transitionalStatus[status]:
+		// The request handling the record has gone, but the object is
+		// there. It's up to the admin to tell whether the object is usable.
+		if actualStatus == "" {
+			actualStatus = model.VolumeAvailable
+		}
+		r.drift(ctx, rpt, nil, &model.DriftSpec{
+			Type:         model.DriftStatusMismatch,
+			ResourceType: resourceType,
+			ResourceId:   id,
+			PoolId:       poolId,
+			Expected:     status,
+			Actual:       actualStatus,
+		}, status)
+	}
+}
+
+func (r *Reconciler) listVolumes(ctx *c.Context, rpt *model.ReconcileReportSpec, p *pool) {
+	objs, err := r.ctrl.ListVolumes(&pb.ListVolumesOpts{
+		PoolId:     p.Id,
+		PoolName:   p.Name,
+		DriverName: p.dock.DriverName,
+		Context:    ctx.ToJson(),
+	})
+	if err != nil {
+		r.errorf(rpt, "list volumes in pool %s failed: %v", p.Id, err)
+		return
+	}
+	for _, obj := range objs {
+		// The volume may be created after the records are listed.
+		if _, err := r.c.GetVolume(ctx, obj.Id); err == nil {
+			continue
+		}
+		r.drift(ctx, rpt, nil, &model.DriftSpec{
+			Type:         model.DriftOrphaned,
+			ResourceType: model.OperationResourceVolume,
+			ResourceId:   obj.Id,
+			PoolId:       p.Id,
+			Actual:       obj.Name,
+		}, "")
+	}
+}
+
+func (r *Reconciler) listSnapshots(ctx *c.Context, rpt *model.ReconcileReportSpec, p *pool) {
+	objs, err := r.ctrl.ListVolumeSnapshots(&pb.ListVolumesOpts{
+		PoolId:     p.Id,
+		PoolName:   p.Name,
+		DriverName: p.dock.DriverName,
+		Context:    ctx.ToJson(),
+	})
+	if err != nil {
+		r.errorf(rpt, "list snapshots in pool %s failed: %v", p.Id, err)
+		return
+	}
+	for _, obj := range objs {
+		// The snapshot may be created after the records are listed.
+		if _, err := r.c.GetVolumeSnapshot(ctx, obj.Id); err == nil {
+			continue
+		}
+		r.drift(ctx, rpt, nil, &model.DriftSpec{
+			Type:         model.DriftOrphaned,
+			ResourceType: model.OperationResourceSnapshot,
+			ResourceId:   obj.Id,
+			PoolId:       p.Id,
+			Actual:       obj.Name,
+		}, "")
+	}
+}
+
+// drift adds the drift into the report, and the record is marked error if
+// it's fixable and the report is auto fixed. A nil record means the drift
+// isn't fixable.
+func (r *Reconciler) drift(ctx *c.Context, rpt *model.ReconcileReportSpec, record interface{}, d *model.DriftSpec, status string) {
+	log.Warningf("%s %s in pool %s drifts from the backend: %s, expected %q, actual %q",
+		d.ResourceType, d.ResourceId, d.PoolId, d.Type, d.Expected, d.Actual)
+	rpt.Drifts = append(rpt.Drifts, d)
+	if !rpt.AutoFix || record == nil || isError(status) {
+		return
+	}
+	if err := r.c.UpdateStatus(ctx, record, model.VolumeError); err != nil {
+		r.errorf(rpt, "mark %s %s error failed: %v", d.ResourceType, d.ResourceId, err)
+		return
+	}
+	log.Infof("%s %s is marked error", d.ResourceType, d.ResourceId)
+	d.Fixed = true
+}
+
+// settling tells whether the record is in a transitional status and has been
+// updated recently, so that the request handling it may still be running.
+func (r *Reconciler) settling(status string, m *model.BaseModel) bool {
+	if !transitionalStatus[status] || m == nil {
+		return false
+	}
+	updatedAt := m.UpdatedAt
+	if updatedAt == "" {
+		updatedAt = m.CreatedAt
+	}
+	t, err := time.ParseInLocation(constants.TimeFormat, updatedAt, time.Local)
+	if err != nil {
+		return false
+	}
+	return r.now().Sub(t) < SettleTime
+}
+
+func (r *Reconciler) errorf(rpt *model.ReconcileReportSpec, format string, args ...interface{}) {
+	msg := fmt.Sprintf(format, args...)
+	log.Error(msg)
+	rpt.Errors = append(rpt.Errors, msg)
+}
+
+// isError tells whether the status is one of the error statuses, such as
+// error and errorDeleting, the statuses of cinder like error_deleting are
+// included.
+func isError(status string) bool {
+	return strings.HasPrefix(status, model.VolumeError)
+}
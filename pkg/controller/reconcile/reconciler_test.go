@@ -0,0 +1,194 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reconcile
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	c "github.com/opensds/opensds/pkg/context"
+	"github.com/opensds/opensds/pkg/controller/volume"
+	"github.com/opensds/opensds/pkg/model"
+	pb "github.com/opensds/opensds/pkg/model/proto"
+	"github.com/opensds/opensds/pkg/utils/constants"
+	. "github.com/opensds/opensds/testutils/collection"
+	dbtest "github.com/opensds/opensds/testutils/db/testing"
+	"github.com/stretchr/testify/mock"
+)
+
+// fakeVolumeController pulls the volumes and snapshots from a fake backend.
+type fakeVolumeController struct {
+	volume.Controller
+	vols  map[string]*model.VolumeSpec
+	snaps map[string]*model.VolumeSnapshotSpec
+	objs  []*model.VolumeSpec
+}
+
+func (f *fakeVolumeController) SetDock(dck *model.DockSpec) {}
+
+func (f *fakeVolumeController) PullVolume(opt *pb.PullVolumeOpts) (*model.VolumeSpec, error) {
+	if vol, ok := f.vols[opt.GetId()]; ok {
+		return vol, nil
+	}
+	return nil, model.NewNotFoundError("volume " + opt.GetId())
+}
+
+func (f *fakeVolumeController) PullVolumeSnapshot(opt *pb.PullVolumeSnapshotOpts) (*model.VolumeSnapshotSpec, error) {
+	if snap, ok := f.snaps[opt.GetId()]; ok {
+		return snap, nil
+	}
+	return nil, model.NewNotFoundError("snapshot " + opt.GetId())
+}
+
+func (f *fakeVolumeController) ListVolumes(opt *pb.ListVolumesOpts) ([]*model.VolumeSpec, error) {
+	return f.objs, nil
+}
+
+func (f *fakeVolumeController) ListVolumeSnapshots(opt *pb.ListVolumesOpts) ([]*model.VolumeSnapshotSpec, error) {
+	return nil, &model.NotImplementError{S: "method ListSnapshots has not been implemented yet"}
+}
+
+func newVolume(id, status string, size int64, updatedAt time.Time) *model.VolumeSpec {
+	return &model.VolumeSpec{
+		BaseModel: &model.BaseModel{Id: id, UpdatedAt: updatedAt.Format(constants.TimeFormat)},
+		Status:    status,
+		Size:      size,
+		PoolId:    SamplePools[0].Id,
+	}
+}
+
+func newFakeReconciler(ctx *c.Context, now time.Time) (*Reconciler, *dbtest.Client) {
+	pol, dck := SamplePools[0], SampleDocks[0]
+	old := now.Add(-time.Hour)
+	vols := []*model.VolumeSpec{
+		newVolume("present", model.VolumeAvailable, 1, old),
+		newVolume("missing", model.VolumeAvailable, 1, old),
+		newVolume("resized", model.VolumeAvailable, 1, old),
+		newVolume("creating", model.VolumeCreating, 1, now.Add(-time.Minute)),
+		newVolume("stuck", model.VolumeCreating, 1, old),
+	}
+	snaps := []*model.VolumeSnapshotSpec{{
+		BaseModel: &model.BaseModel{Id: "snapshot"},
+		Status:    model.VolumeSnapAvailable,
+		Size:      1,
+		VolumeId:  "present",
+	}}
+
+	mockClient := new(dbtest.Client)
+	mockClient.On("ListPools", ctx).Return([]*model.StoragePoolSpec{&pol}, nil)
+	mockClient.On("ListVolumes", ctx).Return(vols, nil)
+	mockClient.On("ListVolumeSnapshots", ctx).Return(snaps, nil)
+	mockClient.On("GetDock", ctx, pol.DockId).Return(&dck, nil)
+	mockClient.On("GetVolume", ctx, "present").Return(vols[0], nil)
+	mockClient.On("GetVolume", ctx, "orphan").Return(nil, errors.New("not found"))
+	mockClient.On("UpdateStatus", ctx, mock.Anything, mock.Anything).Return(nil)
+
+	ctrl := &fakeVolumeController{
+		vols: map[string]*model.VolumeSpec{
+			"present":  newVolume("present", "", 1, old),
+			"resized":  newVolume("resized", "", 2, old),
+			"creating": newVolume("creating", "", 1, old),
+			"stuck":    newVolume("stuck", "", 1, old),
+		},
+		snaps: map[string]*model.VolumeSnapshotSpec{
+			"snapshot": {BaseModel: &model.BaseModel{Id: "snapshot"}, Size: 1},
+		},
+		objs: []*model.VolumeSpec{
+			{BaseModel: &model.BaseModel{Id: "present"}, Name: "volume-present"},
+			{BaseModel: &model.BaseModel{Id: "orphan"}, Name: "volume-orphan"},
+		},
+	}
+	r := NewReconciler(ctrl, 0, false)
+	r.c = mockClient
+	r.now = func() time.Time { return now }
+	return r, mockClient
+}
+
+func TestReconcile(t *testing.T) {
+	ctx := c.NewAdminContext()
+	r, mockClient := newFakeReconciler(ctx, time.Now())
+
+	rpt, err := r.Reconcile(ctx, false)
+	if err != nil {
+		t.Fatalf("Failed to reconcile: %v\n", err)
+	}
+	if rpt.Status != model.ReconcileFinished {
+		t.Errorf("Expected status %s, got %s\n", model.ReconcileFinished, rpt.Status)
+	}
+	expected := map[string]string{
+		"missing": model.DriftMissing,
+		"resized": model.DriftSizeMismatch,
+		"stuck":   model.DriftStatusMismatch,
+		"orphan":  model.DriftOrphaned,
+	}
+	if len(rpt.Drifts) != len(expected) {
+		t.Errorf("Expected %d drifts, got %d\n", len(expected), len(rpt.Drifts))
+	}
+	for _, d := range rpt.Drifts {
+		if expected[d.ResourceId] != d.Type {
+			t.Errorf("Expected drift %q of %s, got %q\n", expected[d.ResourceId], d.ResourceId, d.Type)
+		}
+		if d.Fixed {
+			t.Errorf("Drift of %s shouldn't be fixed\n", d.ResourceId)
+		}
+	}
+	// The driver can't list the snapshots.
+	if len(rpt.Errors) != 1 {
+		t.Errorf("Expected 1 error, got %v\n", rpt.Errors)
+	}
+	mockClient.AssertNotCalled(t, "UpdateStatus", ctx, mock.Anything, mock.Anything)
+
+	if r.Report().FinishedAt != rpt.FinishedAt {
+		t.Errorf("Expected the report of the last reconciliation, got %v\n", r.Report())
+	}
+}
+
+func TestReconcileAutoFix(t *testing.T) {
+	ctx := c.NewAdminContext()
+	r, mockClient := newFakeReconciler(ctx, time.Now())
+
+	rpt, err := r.Reconcile(ctx, true)
+	if err != nil {
+		t.Fatalf("Failed to reconcile: %v\n", err)
+	}
+	for _, d := range rpt.Drifts {
+		if d.Fixed != (d.ResourceId == "missing") {
+			t.Errorf("Drift %s of %s fixed: %v\n", d.Type, d.ResourceId, d.Fixed)
+		}
+	}
+	mockClient.AssertNumberOfCalls(t, "UpdateStatus", 1)
+	mockClient.AssertCalled(t, "UpdateStatus", ctx, mock.MatchedBy(func(vol *model.VolumeSpec) bool {
+		return vol.Id == "missing"
+	}), model.VolumeError)
+}
+
+func TestReconcileRunning(t *testing.T) {
+	ctx := c.NewAdminContext()
+	r, _ := newFakeReconciler(ctx, time.Now())
+
+	if r.Report() != nil {
+		t.Errorf("Expected no report before reconciling, got %v\n", r.Report())
+	}
+	if _, ok := r.begin(false); !ok {
+		t.Fatal("Failed to begin reconciliation")
+	}
+	if rpt := r.Report(); rpt == nil || rpt.Status != model.ReconcileRunning {
+		t.Errorf("Expected running report, got %v\n", rpt)
+	}
+	if _, err := r.Reconcile(ctx, false); err == nil {
+		t.Error("Expected error when a reconciliation is running")
+	}
+}
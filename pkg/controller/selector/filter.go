@@ -83,6 +83,13 @@ func GetPoolCapabilityMap(pool *model.StoragePoolSpec) (map[string]interface{},
 
 // IsAvailablePool ...
 func IsAvailablePool(filterReq map[string]interface{}, pool *model.StoragePoolSpec) (bool, error) {
+	// The pools of the docks which stop sending heartbeat can't serve any
+	// request until the docks are back.
+	if pool.Status == model.PoolUnavailable {
+		log.Infof("pool %s is unavailable, skip it", pool.Name)
+		return false, nil
+	}
+
 	poolMap, err := GetPoolCapabilityMap(pool)
 	if nil != err {
 		return false, err
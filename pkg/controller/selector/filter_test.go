@@ -129,6 +129,17 @@ func TestIsAvailablePool(t *testing.T) {
 		t.Errorf("Expected %v, get %v", false, isAvailable)
 	}
 
+	// The pool of an offline dock is never available.
+	pool := SamplePools[1]
+	pool.Status = model.PoolUnavailable
+	isAvailable, err = IsAvailablePool(filterReq, &pool)
+	if nil != err {
+		t.Errorf("Expected %v, get %v", nil, err)
+	}
+
+	if false != isAvailable {
+		t.Errorf("Expected %v, get %v", false, isAvailable)
+	}
 }
 
 func TestMatch(t *testing.T) {
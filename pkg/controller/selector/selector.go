@@ -28,24 +28,41 @@ import (
 	c "github.com/opensds/opensds/pkg/context"
 	"github.com/opensds/opensds/pkg/db"
 	"github.com/opensds/opensds/pkg/model"
+	"github.com/opensds/opensds/pkg/utils/config"
 )
 
 // Selector is an interface that exposes some operation of different selectors.
 type Selector interface {
 	SelectSupportedPoolForVolume(*model.VolumeSpec) (*model.StoragePoolSpec, error)
+	SelectSupportedPoolsForVolume(*model.VolumeSpec) ([]*model.StoragePoolSpec, error)
 	SelectSupportedPoolForVG(*model.VolumeGroupSpec) (*model.StoragePoolSpec, error)
 	SelectSupportedPoolForFileShare(*model.FileShareSpec) (*model.StoragePoolSpec, error)
 }
 
-type selector struct{}
+type selector struct {
+	weighers []*weigherEntry
+}
 
 // NewSelector method creates a new selector structure and return its pointer.
 func NewSelector() Selector {
-	return &selector{}
+	return &selector{
+		weighers: newWeigherEntries(&config.CONF.OsdsLet),
+	}
 }
 
-// SelectSupportedPoolForVolume
+// SelectSupportedPoolForVolume returns the most preferable pool for volume.
 func (s *selector) SelectSupportedPoolForVolume(vol *model.VolumeSpec) (*model.StoragePoolSpec, error) {
+	pools, err := s.SelectSupportedPoolsForVolume(vol)
+	if err != nil {
+		return nil, err
+	}
+	return pools[0], nil
+}
+
+// SelectSupportedPoolsForVolume filters the pools which are able to serve
+// the volume and returns them ranked by the configured weighers, so that
+// the caller can fall back to the next one if the first pool fails.
+func (s *selector) SelectSupportedPoolsForVolume(vol *model.VolumeSpec) ([]*model.StoragePoolSpec, error) {
 	var prf *model.ProfileSpec
 	var err error
 
@@ -138,14 +155,14 @@ func (s *selector) SelectSupportedPoolForVolume(vol *model.VolumeSpec) (*model.S
 	}(prf, vol)
 
 	log.Infof("The filter request for pool is %v", fltRequest)
-	supportedPools, err := SelectSupportedPools(1, fltRequest, pools)
+	supportedPools, err := SelectSupportedPools(len(pools), fltRequest, pools)
 	if err != nil {
 		log.Error("Filter supported pools failed: ", err)
 		return nil, err
 	}
-	// Now, we just return the first supported pool which will be improved in
-	// the future.
-	return supportedPools[0], nil
+
+	req := &WeighRequest{TenantId: vol.TenantId, Size: vol.Size}
+	return weighPools(s.weighers, req, supportedPools)
 }
 
 func (s *selector) SelectSupportedPoolForVG(in *model.VolumeGroupSpec) (*model.StoragePoolSpec, error) {
@@ -271,14 +288,18 @@ func (s *selector) SelectSupportedPoolForFileShare(in *model.FileShareSpec) (*mo
 		return filterRequest
 	}(prf, in)
 
-	supportedPools, err := SelectSupportedPools(1, fltRequest, pools)
+	supportedPools, err := SelectSupportedPools(len(pools), fltRequest, pools)
 	if err != nil {
 		log.Error("filter supported pools failed: ", err)
 		return nil, err
 	}
-	// Now, we just return the first supported pool which will be improved in
-	// the future.
-	return supportedPools[0], nil
+
+	req := &WeighRequest{TenantId: in.TenantId, Size: in.Size}
+	rankedPools, err := weighPools(s.weighers, req, supportedPools)
+	if err != nil {
+		return nil, err
+	}
+	return rankedPools[0], nil
 }
 
 // SelectSupportedPools ...
@@ -0,0 +1,251 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+This module implements the weighers which rank the pools that passed the
+filters, so that the most suitable pool is tried first.
+
+*/
+
+package selector
+
+import (
+	"fmt"
+	"sort"
+
+	log "github.com/golang/glog"
+	c "github.com/opensds/opensds/pkg/context"
+	"github.com/opensds/opensds/pkg/db"
+	"github.com/opensds/opensds/pkg/model"
+	"github.com/opensds/opensds/pkg/utils/config"
+)
+
+const (
+	FreeCapacityWeigherName      = "free_capacity"
+	FreeCapacityRatioWeigherName = "free_capacity_ratio"
+	VolumeCountWeigherName       = "volume_count"
+	AffinityWeigherName          = "affinity"
+)
+
+// WeighRequest contains the information of the resource to be placed which
+// may be taken into account by the weighers.
+type WeighRequest struct {
+	TenantId string
+	Size     int64
+}
+
+// Weigher is an interface that exposes the weigh operation of pools. The
+// returned raw weights must be in the same order as the input pools, and the
+// bigger the weight is, the more preferable the pool is.
+type Weigher interface {
+	Name() string
+	Weigh(req *WeighRequest, pools []*model.StoragePoolSpec) ([]float64, error)
+}
+
+// freeCapacityWeigher prefers the pool which has more free capacity.
+type freeCapacityWeigher struct{}
+
+func (w *freeCapacityWeigher) Name() string { return FreeCapacityWeigherName }
+
+func (w *freeCapacityWeigher) Weigh(req *WeighRequest, pools []*model.StoragePoolSpec) ([]float64, error) {
+	var weights []float64
+	for _, pool := range pools {
+		weights = append(weights, float64(pool.FreeCapacity))
+	}
+	return weights, nil
+}
+
+// freeCapacityRatioWeigher prefers the pool which has a larger proportion of
+// its total capacity free, so that big and small pools are filled evenly.
+type freeCapacityRatioWeigher struct{}
+
+func (w *freeCapacityRatioWeigher) Name() string { return FreeCapacityRatioWeigherName }
+
+func (w *freeCapacityRatioWeigher) Weigh(req *WeighRequest, pools []*model.StoragePoolSpec) ([]float64, error) {
+	var weights []float64
+	for _, pool := range pools {
+		if pool.TotalCapacity <= 0 {
+			weights = append(weights, 0)
+			continue
+		}
+		weights = append(weights, float64(pool.FreeCapacity)/float64(pool.TotalCapacity))
+	}
+	return weights, nil
+}
+
+// volumeCountWeigher weighs the pool by the number of volumes allocated in
+// it. It is usually configured with a negative multiplier so that the pool
+// which has fewer volumes is preferred.
+type volumeCountWeigher struct{}
+
+func (w *volumeCountWeigher) Name() string { return VolumeCountWeigherName }
+
+func (w *volumeCountWeigher) Weigh(req *WeighRequest, pools []*model.StoragePoolSpec) ([]float64, error) {
+	vols, err := db.C.ListVolumes(c.NewAdminContext())
+	if err != nil {
+		return nil, err
+	}
+	var counts = make(map[string]int)
+	for _, vol := range vols {
+		counts[vol.PoolId]++
+	}
+
+	var weights []float64
+	for _, pool := range pools {
+		weights = append(weights, float64(counts[pool.Id]))
+	}
+	return weights, nil
+}
+
+// affinityWeigher spreads the volumes of a tenant across the docks of an
+// availability zone. The pool whose dock serves fewer volumes of the tenant
+// gets a bigger weight, and a negative multiplier turns the spreading into
+// packing them together.
+type affinityWeigher struct{}
+
+func (w *affinityWeigher) Name() string { return AffinityWeigherName }
+
+func (w *affinityWeigher) Weigh(req *WeighRequest, pools []*model.StoragePoolSpec) ([]float64, error) {
+	allPools, err := db.C.ListPools(c.NewAdminContext())
+	if err != nil {
+		return nil, err
+	}
+	var poolDocks = make(map[string]string)
+	for _, pool := range allPools {
+		poolDocks[pool.Id] = pool.DockId
+	}
+
+	vols, err := db.C.ListVolumes(c.NewAdminContext())
+	if err != nil {
+		return nil, err
+	}
+	var counts = make(map[string]int)
+	for _, vol := range vols {
+		if vol.TenantId != req.TenantId {
+			continue
+		}
+		counts[poolDocks[vol.PoolId]]++
+	}
+
+	var weights []float64
+	for _, pool := range pools {
+		weights = append(weights, -float64(counts[pool.DockId]))
+	}
+	return weights, nil
+}
+
+// weigherEntry binds a weigher with the multiplier of its normalized weight.
+type weigherEntry struct {
+	Weigher
+	multiplier float64
+}
+
+// NewWeigher returns the weigher specified by name.
+func NewWeigher(name string) (Weigher, error) {
+	switch name {
+	case FreeCapacityWeigherName:
+		return &freeCapacityWeigher{}, nil
+	case FreeCapacityRatioWeigherName:
+		return &freeCapacityRatioWeigher{}, nil
+	case VolumeCountWeigherName:
+		return &volumeCountWeigher{}, nil
+	case AffinityWeigherName:
+		return &affinityWeigher{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported weigher %s", name)
+	}
+}
+
+// newWeigherEntries builds the enabled weighers and their multipliers from
+// the osdslet configuration. Unsupported weighers are skipped.
+func newWeigherEntries(cfg *config.OsdsLet) []*weigherEntry {
+	var multipliers = map[string]float64{
+		FreeCapacityWeigherName:      cfg.FreeCapacityWeightMultiplier,
+		FreeCapacityRatioWeigherName: cfg.FreeCapacityRatioWeightMultiplier,
+		VolumeCountWeigherName:       cfg.VolumeCountWeightMultiplier,
+		AffinityWeigherName:          cfg.AffinityWeightMultiplier,
+	}
+
+	var entries []*weigherEntry
+	for _, name := range cfg.SchedulerWeighers {
+		w, err := NewWeigher(name)
+		if err != nil {
+			log.Warning("Skip weigher: ", err)
+			continue
+		}
+		entries = append(entries, &weigherEntry{Weigher: w, multiplier: multipliers[name]})
+	}
+	return entries
+}
+
+// normalize scales the raw weights into the range [0, 1]. All weights are
+// set to zero if they are the same.
+func normalize(weights []float64) []float64 {
+	var result = make([]float64, len(weights))
+	if len(weights) == 0 {
+		return result
+	}
+	min, max := weights[0], weights[0]
+	for _, w := range weights {
+		if w < min {
+			min = w
+		}
+		if w > max {
+			max = w
+		}
+	}
+	if max == min {
+		return result
+	}
+	for i, w := range weights {
+		result[i] = (w - min) / (max - min)
+	}
+	return result
+}
+
+// weighPools ranks the pools by the sum of the normalized weights multiplied
+// by their multipliers, the most preferable pool comes first. Pools with the
+// same weight keep their original order.
+func weighPools(entries []*weigherEntry, req *WeighRequest, pools []*model.StoragePoolSpec) ([]*model.StoragePoolSpec, error) {
+	if len(pools) <= 1 || len(entries) == 0 {
+		return pools, nil
+	}
+
+	var totals = make([]float64, len(pools))
+	for _, entry := range entries {
+		weights, err := entry.Weigh(req, pools)
+		if err != nil {
+			log.Errorf("Weigher %s failed: %v", entry.Name(), err)
+			return nil, err
+		}
+		for i, w := range normalize(weights) {
+			totals[i] += entry.multiplier * w
+		}
+	}
+
+	var idx = make([]int, len(pools))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool {
+		return totals[idx[i]] > totals[idx[j]]
+	})
+
+	var ranked []*model.StoragePoolSpec
+	for _, i := range idx {
+		log.V(5).Infof("Pool %s gets weight %f", pools[i].Id, totals[i])
+		ranked = append(ranked, pools[i])
+	}
+	return ranked, nil
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package selector
+
+import (
+	"reflect"
+	"testing"
+
+	c "github.com/opensds/opensds/pkg/context"
+	"github.com/opensds/opensds/pkg/db"
+	"github.com/opensds/opensds/pkg/model"
+	"github.com/opensds/opensds/pkg/utils/config"
+	dbtest "github.com/opensds/opensds/testutils/db/testing"
+)
+
+var fakeWeighPools = []*model.StoragePoolSpec{
+	{
+		BaseModel:     &model.BaseModel{Id: "pool-01"},
+		TotalCapacity: 100,
+		FreeCapacity:  80,
+		DockId:        "dock-01",
+	},
+	{
+		BaseModel:     &model.BaseModel{Id: "pool-02"},
+		TotalCapacity: 1000,
+		FreeCapacity:  200,
+		DockId:        "dock-01",
+	},
+	{
+		BaseModel:     &model.BaseModel{Id: "pool-03"},
+		TotalCapacity: 400,
+		FreeCapacity:  100,
+		DockId:        "dock-02",
+	},
+}
+
+var fakeWeighVolumes = []*model.VolumeSpec{
+	{BaseModel: &model.BaseModel{Id: "vol-01"}, TenantId: "tenant-01", PoolId: "pool-01"},
+	{BaseModel: &model.BaseModel{Id: "vol-02"}, TenantId: "tenant-01", PoolId: "pool-02"},
+	{BaseModel: &model.BaseModel{Id: "vol-03"}, TenantId: "tenant-02", PoolId: "pool-02"},
+	{BaseModel: &model.BaseModel{Id: "vol-04"}, TenantId: "tenant-02", PoolId: "pool-02"},
+}
+
+func poolIds(pools []*model.StoragePoolSpec) []string {
+	var ids []string
+	for _, pool := range pools {
+		ids = append(ids, pool.Id)
+	}
+	return ids
+}
+
+func TestWeighPools(t *testing.T) {
+	mockClient := new(dbtest.Client)
+	mockClient.On("ListPools", c.NewAdminContext()).Return(fakeWeighPools, nil)
+	mockClient.On("ListVolumes", c.NewAdminContext()).Return(fakeWeighVolumes, nil)
+	db.C = mockClient
+
+	testCases := []struct {
+		weighers    []string
+		multipliers []float64
+		expected    []string
+	}{
+		{
+			weighers:    []string{FreeCapacityWeigherName},
+			multipliers: []float64{1.0},
+			expected:    []string{"pool-02", "pool-03", "pool-01"},
+		},
+		{
+			weighers:    []string{FreeCapacityWeigherName},
+			multipliers: []float64{-1.0},
+			expected:    []string{"pool-01", "pool-03", "pool-02"},
+		},
+		{
+			weighers:    []string{FreeCapacityRatioWeigherName},
+			multipliers: []float64{1.0},
+			expected:    []string{"pool-01", "pool-03", "pool-02"},
+		},
+		{
+			weighers:    []string{VolumeCountWeigherName},
+			multipliers: []float64{-1.0},
+			expected:    []string{"pool-03", "pool-01", "pool-02"},
+		},
+		{
+			weighers:    []string{AffinityWeigherName},
+			multipliers: []float64{1.0},
+			expected:    []string{"pool-03", "pool-01", "pool-02"},
+		},
+		{
+			weighers:    []string{FreeCapacityWeigherName, VolumeCountWeigherName},
+			multipliers: []float64{1.0, -2.0},
+			expected:    []string{"pool-03", "pool-01", "pool-02"},
+		},
+	}
+
+	for _, tc := range testCases {
+		var entries []*weigherEntry
+		for i, name := range tc.weighers {
+			w, err := NewWeigher(name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			entries = append(entries, &weigherEntry{Weigher: w, multiplier: tc.multipliers[i]})
+		}
+		req := &WeighRequest{TenantId: "tenant-01", Size: 1}
+		result, err := weighPools(entries, req, fakeWeighPools)
+		if err != nil {
+			t.Errorf("Weigh pools with %v failed: %v", tc.weighers, err)
+			continue
+		}
+		if !reflect.DeepEqual(poolIds(result), tc.expected) {
+			t.Errorf("Weigh pools with %v, expected %v, get %v", tc.weighers, tc.expected, poolIds(result))
+		}
+	}
+}
+
+func TestNewWeigherEntries(t *testing.T) {
+	cfg := &config.OsdsLet{
+		SchedulerWeighers:           []string{VolumeCountWeigherName, "unknown"},
+		VolumeCountWeightMultiplier: -1.0,
+	}
+	entries := newWeigherEntries(cfg)
+	if len(entries) != 1 {
+		t.Fatalf("Expected 1 weigher, get %d", len(entries))
+	}
+	if entries[0].Name() != VolumeCountWeigherName || entries[0].multiplier != -1.0 {
+		t.Errorf("Expected weigher %s with multiplier -1, get %s with %v",
+			VolumeCountWeigherName, entries[0].Name(), entries[0].multiplier)
+	}
+}
@@ -29,6 +29,8 @@ import (
 	"github.com/opensds/opensds/pkg/dock/client"
 	"github.com/opensds/opensds/pkg/model"
 	pb "github.com/opensds/opensds/pkg/model/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // Controller is an interface for exposing some operations of different volume
@@ -40,6 +42,14 @@ type Controller interface {
 
 	ExtendVolume(opt *pb.ExtendVolumeOpts) (*model.VolumeSpec, error)
 
+	MigrateVolume(opt *pb.MigrateVolumeOpts) (*model.VolumeSpec, error)
+
+	RevertVolumeToSnapshot(opt *pb.RevertVolumeToSnapshotOpts) error
+
+	ManageVolume(opt *pb.ManageVolumeOpts) (*model.VolumeSpec, error)
+
+	UnmanageVolume(opt *pb.UnmanageVolumeOpts) error
+
 	CreateVolumeAttachment(opt *pb.CreateVolumeAttachmentOpts) (*model.VolumeAttachmentSpec, error)
 
 	DeleteVolumeAttachment(opt *pb.DeleteVolumeAttachmentOpts) error
@@ -48,6 +58,16 @@ type Controller interface {
 
 	DeleteVolumeSnapshot(opt *pb.DeleteVolumeSnapshotOpts) error
 
+	ManageVolumeSnapshot(opt *pb.ManageVolumeSnapshotOpts) (*model.VolumeSnapshotSpec, error)
+
+	UnmanageVolumeSnapshot(opt *pb.UnmanageVolumeSnapshotOpts) error
+
+	CreateVolumeBackup(opt *pb.CreateVolumeBackupOpts) (*model.BackupSpec, error)
+
+	DeleteVolumeBackup(opt *pb.DeleteVolumeBackupOpts) error
+
+	RestoreVolumeBackup(opt *pb.RestoreVolumeBackupOpts) error
+
 	CreateReplication(opt *pb.CreateReplicationOpts) (*model.ReplicationSpec, error)
 
 	DeleteReplication(opt *pb.DeleteReplicationOpts) error
@@ -62,12 +82,30 @@ type Controller interface {
 
 	DetachVolume(opt *pb.DetachVolumeOpts) error
 
+	CopyVolume(opt *pb.CopyVolumeOpts) error
+
 	CreateVolumeGroup(*pb.CreateVolumeGroupOpts) (*model.VolumeGroupSpec, error)
 
 	UpdateVolumeGroup(*pb.UpdateVolumeGroupOpts) (*model.VolumeGroupSpec, error)
 
 	DeleteVolumeGroup(*pb.DeleteVolumeGroupOpts) error
 
+	CreateGroupSnapshot(opt *pb.CreateGroupSnapshotOpts) ([]*model.VolumeSnapshotSpec, error)
+
+	DeleteGroupSnapshot(opt *pb.DeleteGroupSnapshotOpts) error
+
+	FreezeVolume(opt *pb.FreezeVolumeOpts) error
+
+	ThawVolume(opt *pb.FreezeVolumeOpts) error
+
+	PullVolume(opt *pb.PullVolumeOpts) (*model.VolumeSpec, error)
+
+	PullVolumeSnapshot(opt *pb.PullVolumeSnapshotOpts) (*model.VolumeSnapshotSpec, error)
+
+	ListVolumes(opt *pb.ListVolumesOpts) ([]*model.VolumeSpec, error)
+
+	ListVolumeSnapshots(opt *pb.ListVolumesOpts) ([]*model.VolumeSnapshotSpec, error)
+
 	SetDock(dockInfo *model.DockSpec)
 }
 
@@ -160,6 +198,105 @@ func (c *controller) ExtendVolume(opt *pb.ExtendVolumeOpts) (*model.VolumeSpec,
 	return vol, nil
 }
 
+// MigrateVolume migrates the volume through the driver of the dock, and
+// NotImplementError is returned if the driver can't migrate volume natively.
+func (c *controller) MigrateVolume(opt *pb.MigrateVolumeOpts) (*model.VolumeSpec, error) {
+	if err := c.Client.Connect(c.DockInfo.Endpoint); err != nil {
+		log.Error("when connecting dock client:", err)
+		return nil, err
+	}
+
+	response, err := c.Client.MigrateVolume(context.Background(), opt)
+	if err != nil {
+		if status.Code(err) == codes.Unimplemented {
+			return nil, &model.NotImplementError{S: status.Convert(err).Message()}
+		}
+		log.Error("migrate volume failed in volume controller:", err)
+		return nil, err
+	}
+	defer c.Client.Close()
+
+	if errorMsg := response.GetError(); errorMsg != nil {
+		return nil,
+			fmt.Errorf("failed to migrate volume in volume controller, code: %v, message: %v",
+				errorMsg.GetCode(), errorMsg.GetDescription())
+	}
+
+	var vol = &model.VolumeSpec{}
+	if err = json.Unmarshal([]byte(response.GetResult().GetMessage()), vol); err != nil {
+		log.Error("migrate volume failed in volume controller:", err)
+		return nil, err
+	}
+
+	return vol, nil
+}
+
+// RevertVolumeToSnapshot reverts the volume through the driver of the dock,
+// and NotImplementError is returned if the driver can't revert volume
+// natively.
+func (c *controller) RevertVolumeToSnapshot(opt *pb.RevertVolumeToSnapshotOpts) error {
+	if err := c.Client.Connect(c.DockInfo.Endpoint); err != nil {
+		log.Error("when connecting dock client:", err)
+		return err
+	}
+	defer c.Client.Close()
+
+	response, err := c.Client.RevertVolumeToSnapshot(context.Background(), opt)
+	if err = parseResponse(response, err, nil); err != nil {
+		log.Error("revert volume to snapshot failed in volume controller:", err)
+		return err
+	}
+	return nil
+}
+
+func (c *controller) ManageVolume(opt *pb.ManageVolumeOpts) (*model.VolumeSpec, error) {
+	if err := c.Client.Connect(c.DockInfo.Endpoint); err != nil {
+		log.Error("when connecting dock client:", err)
+		return nil, err
+	}
+
+	response, err := c.Client.ManageVolume(context.Background(), opt)
+	if err != nil {
+		log.Error("manage volume failed in volume controller:", err)
+		return nil, err
+	}
+	defer c.Client.Close()
+
+	if errorMsg := response.GetError(); errorMsg != nil {
+		return nil,
+			fmt.Errorf("failed to manage volume in volume controller, code: %v, message: %v",
+				errorMsg.GetCode(), errorMsg.GetDescription())
+	}
+
+	var vol = &model.VolumeSpec{}
+	if err = json.Unmarshal([]byte(response.GetResult().GetMessage()), vol); err != nil {
+		log.Error("manage volume failed in volume controller:", err)
+		return nil, err
+	}
+
+	return vol, nil
+}
+
+func (c *controller) UnmanageVolume(opt *pb.UnmanageVolumeOpts) error {
+	if err := c.Client.Connect(c.DockInfo.Endpoint); err != nil {
+		log.Error("when connecting dock client:", err)
+		return err
+	}
+
+	response, err := c.Client.UnmanageVolume(context.Background(), opt)
+	if err != nil {
+		log.Error("unmanage volume failed in volume controller:", err)
+		return err
+	}
+	defer c.Client.Close()
+
+	if errorMsg := response.GetError(); errorMsg != nil {
+		return errors.New(errorMsg.GetDescription())
+	}
+
+	return nil
+}
+
 func (c *controller) CreateVolumeAttachment(opt *pb.CreateVolumeAttachmentOpts) (*model.VolumeAttachmentSpec, error) {
 	if err := c.Client.Connect(c.DockInfo.Endpoint); err != nil {
 		log.Error("when connecting dock client:", err)
@@ -258,6 +395,122 @@ func (c *controller) DeleteVolumeSnapshot(opt *pb.DeleteVolumeSnapshotOpts) erro
 	return nil
 }
 
+func (c *controller) ManageVolumeSnapshot(opt *pb.ManageVolumeSnapshotOpts) (*model.VolumeSnapshotSpec, error) {
+	if err := c.Client.Connect(c.DockInfo.Endpoint); err != nil {
+		log.Error("when connecting dock client:", err)
+		return nil, err
+	}
+
+	response, err := c.Client.ManageVolumeSnapshot(context.Background(), opt)
+	if err != nil {
+		log.Error("manage volume snapshot failed in volume controller:", err)
+		return nil, err
+	}
+	defer c.Client.Close()
+
+	if errorMsg := response.GetError(); errorMsg != nil {
+		return nil,
+			fmt.Errorf("failed to manage volume snapshot in volume controller, code: %v, message: %v",
+				errorMsg.GetCode(), errorMsg.GetDescription())
+	}
+
+	var snp = &model.VolumeSnapshotSpec{}
+	if err = json.Unmarshal([]byte(response.GetResult().GetMessage()), snp); err != nil {
+		log.Error("manage volume snapshot failed in volume controller:", err)
+		return nil, err
+	}
+
+	return snp, nil
+}
+
+func (c *controller) UnmanageVolumeSnapshot(opt *pb.UnmanageVolumeSnapshotOpts) error {
+	if err := c.Client.Connect(c.DockInfo.Endpoint); err != nil {
+		log.Error("when connecting dock client:", err)
+		return err
+	}
+
+	response, err := c.Client.UnmanageVolumeSnapshot(context.Background(), opt)
+	if err != nil {
+		log.Error("unmanage volume snapshot failed in volume controller:", err)
+		return err
+	}
+	defer c.Client.Close()
+
+	if errorMsg := response.GetError(); errorMsg != nil {
+		return errors.New(errorMsg.GetDescription())
+	}
+
+	return nil
+}
+
+func (c *controller) CreateVolumeBackup(opt *pb.CreateVolumeBackupOpts) (*model.BackupSpec, error) {
+	if err := c.Client.Connect(c.DockInfo.Endpoint); err != nil {
+		log.Error("when connecting dock client:", err)
+		return nil, err
+	}
+
+	response, err := c.Client.CreateVolumeBackup(context.Background(), opt)
+	if err != nil {
+		log.Error("create volume backup failed in volume controller:", err)
+		return nil, err
+	}
+	defer c.Client.Close()
+
+	if errorMsg := response.GetError(); errorMsg != nil {
+		return nil,
+			fmt.Errorf("failed to create volume backup in volume controller, code: %v, message: %v",
+				errorMsg.GetCode(), errorMsg.GetDescription())
+	}
+
+	var backup = &model.BackupSpec{}
+	if err = json.Unmarshal([]byte(response.GetResult().GetMessage()), backup); err != nil {
+		log.Error("create volume backup failed in volume controller:", err)
+		return nil, err
+	}
+
+	return backup, nil
+}
+
+func (c *controller) DeleteVolumeBackup(opt *pb.DeleteVolumeBackupOpts) error {
+	if err := c.Client.Connect(c.DockInfo.Endpoint); err != nil {
+		log.Error("when connecting dock client:", err)
+		return err
+	}
+
+	response, err := c.Client.DeleteVolumeBackup(context.Background(), opt)
+	if err != nil {
+		log.Error("delete volume backup failed in volume controller:", err)
+		return err
+	}
+	defer c.Client.Close()
+
+	if errorMsg := response.GetError(); errorMsg != nil {
+		return errors.New(errorMsg.GetDescription())
+	}
+
+	return nil
+}
+
+func (c *controller) RestoreVolumeBackup(opt *pb.RestoreVolumeBackupOpts) error {
+	if err := c.Client.Connect(c.DockInfo.Endpoint); err != nil {
+		log.Error("when connecting dock client:", err)
+		return err
+	}
+
+	response, err := c.Client.RestoreVolumeBackup(context.Background(), opt)
+	if err != nil {
+		log.Error("restore volume backup failed in volume controller:", err)
+		return err
+	}
+	defer c.Client.Close()
+
+	if errorMsg := response.GetError(); errorMsg != nil {
+		return errors.New(errorMsg.GetDescription())
+	}
+
+	return nil
+}
+
 func (c *controller) CreateReplication(opt *pb.CreateReplicationOpts) (*model.ReplicationSpec, error) {
 	if err := c.Client.Connect(c.DockInfo.Endpoint); err != nil {
 		log.Error("when connecting dock client:", err)
@@ -407,6 +660,25 @@ func (c *controller) DetachVolume(opt *pb.DetachVolumeOpts) error {
 	return nil
 }
 
+func (c *controller) CopyVolume(opt *pb.CopyVolumeOpts) error {
+	if err := c.Client.Connect(c.DockInfo.Endpoint); err != nil {
+		log.Error("when connecting dock client:", err)
+		return err
+	}
+	response, err := c.Client.CopyVolume(context.Background(), opt)
+	if err != nil {
+		log.Error("copy volume failed in volume controller:", err)
+		return err
+	}
+	defer c.Client.Close()
+
+	if errorMsg := response.GetError(); errorMsg != nil {
+		return errors.New(errorMsg.GetDescription())
+	}
+
+	return nil
+}
+
 func (c *controller) CreateVolumeGroup(opt *pb.CreateVolumeGroupOpts) (*model.VolumeGroupSpec, error) {
 	if err := c.Client.Connect(c.DockInfo.Endpoint); err != nil {
 		log.Error("when connecting dock client:", err)
@@ -483,6 +755,170 @@ func (c *controller) DeleteVolumeGroup(opt *pb.DeleteVolumeGroupOpts) error {
 	return nil
 }
 
+// CreateGroupSnapshot takes the snapshots of the volumes in the group, and
+// NotImplementError is returned if the driver can't take them consistently.
+func (c *controller) CreateGroupSnapshot(opt *pb.CreateGroupSnapshotOpts) ([]*model.VolumeSnapshotSpec, error) {
+	if err := c.Client.Connect(c.DockInfo.Endpoint); err != nil {
+		log.Error("when connecting dock client:", err)
+		return nil, err
+	}
+	defer c.Client.Close()
+
+	var snps []*model.VolumeSnapshotSpec
+	response, err := c.Client.CreateGroupSnapshot(context.Background(), opt)
+	if err = parseResponse(response, err, &snps); err != nil {
+		log.Error("create group snapshot failed in volume controller:", err)
+		return nil, err
+	}
+	return snps, nil
+}
+
+// DeleteGroupSnapshot is the same as CreateGroupSnapshot, but the snapshots
+// are deleted.
+func (c *controller) DeleteGroupSnapshot(opt *pb.DeleteGroupSnapshotOpts) error {
+	if err := c.Client.Connect(c.DockInfo.Endpoint); err != nil {
+		log.Error("when connecting dock client:", err)
+		return err
+	}
+	defer c.Client.Close()
+
+	response, err := c.Client.DeleteGroupSnapshot(context.Background(), opt)
+	if err = parseResponse(response, err, nil); err != nil {
+		log.Error("delete group snapshot failed in volume controller:", err)
+		return err
+	}
+	return nil
+}
+
+func (c *controller) FreezeVolume(opt *pb.FreezeVolumeOpts) error {
+	if err := c.Client.Connect(c.DockInfo.Endpoint); err != nil {
+		log.Error("when connecting dock client:", err)
+		return err
+	}
+	response, err := c.Client.FreezeVolume(context.Background(), opt)
+	if err != nil {
+		log.Error("freeze volume failed in volume controller:", err)
+		return err
+	}
+	defer c.Client.Close()
+
+	if errorMsg := response.GetError(); errorMsg != nil {
+		return errors.New(errorMsg.GetDescription())
+	}
+
+	return nil
+}
+
+func (c *controller) ThawVolume(opt *pb.FreezeVolumeOpts) error {
+	if err := c.Client.Connect(c.DockInfo.Endpoint); err != nil {
+		log.Error("when connecting dock client:", err)
+		return err
+	}
+	response, err := c.Client.ThawVolume(context.Background(), opt)
+	if err != nil {
+		log.Error("thaw volume failed in volume controller:", err)
+		return err
+	}
+	defer c.Client.Close()
+
+	if errorMsg := response.GetError(); errorMsg != nil {
+		return errors.New(errorMsg.GetDescription())
+	}
+
+	return nil
+}
+
+// PullVolume pulls the volume from the backend, NotFoundError is returned if
+// the volume doesn't exist and NotImplementError if the driver can't pull it.
+func (c *controller) PullVolume(opt *pb.PullVolumeOpts) (*model.VolumeSpec, error) {
+	if err := c.Client.Connect(c.DockInfo.Endpoint); err != nil {
+		log.Error("when connecting dock client:", err)
+		return nil, err
+	}
+	defer c.Client.Close()
+
+	var vol = &model.VolumeSpec{}
+	response, err := c.Client.PullVolume(context.Background(), opt)
+	if err = parseResponse(response, err, vol); err != nil {
+		return nil, err
+	}
+	return vol, nil
+}
+
+// PullVolumeSnapshot is the same as PullVolume, but the snapshot is pulled.
+func (c *controller) PullVolumeSnapshot(opt *pb.PullVolumeSnapshotOpts) (*model.VolumeSnapshotSpec, error) {
+	if err := c.Client.Connect(c.DockInfo.Endpoint); err != nil {
+		log.Error("when connecting dock client:", err)
+		return nil, err
+	}
+	defer c.Client.Close()
+
+	var snp = &model.VolumeSnapshotSpec{}
+	response, err := c.Client.PullVolumeSnapshot(context.Background(), opt)
+	if err = parseResponse(response, err, snp); err != nil {
+		return nil, err
+	}
+	return snp, nil
+}
+
+// ListVolumes lists the volumes created by opensds in the pool, and
+// NotImplementError is returned if the driver can't list them.
+func (c *controller) ListVolumes(opt *pb.ListVolumesOpts) ([]*model.VolumeSpec, error) {
+	if err := c.Client.Connect(c.DockInfo.Endpoint); err != nil {
+		log.Error("when connecting dock client:", err)
+		return nil, err
+	}
+	defer c.Client.Close()
+
+	var vols []*model.VolumeSpec
+	response, err := c.Client.ListVolumes(context.Background(), opt)
+	if err = parseResponse(response, err, &vols); err != nil {
+		return nil, err
+	}
+	return vols, nil
+}
+
+// ListVolumeSnapshots is the same as ListVolumes, but the snapshots are
+// listed.
+func (c *controller) ListVolumeSnapshots(opt *pb.ListVolumesOpts) ([]*model.VolumeSnapshotSpec, error) {
+	if err := c.Client.Connect(c.DockInfo.Endpoint); err != nil {
+		log.Error("when connecting dock client:", err)
+		return nil, err
+	}
+	defer c.Client.Close()
+
+	var snps []*model.VolumeSnapshotSpec
+	response, err := c.Client.ListVolumeSnapshots(context.Background(), opt)
+	if err = parseResponse(response, err, &snps); err != nil {
+		return nil, err
+	}
+	return snps, nil
+}
+
+// parsePullResponse turns the response of the dock into result, the
+// NotFoundError, NotImplementError and PreconditionError sent as the status
+// codes are restored.
+func parseResponse(response *pb.GenericResponse, err error, result interface{}) error {
+	if err != nil {
+		switch status.Code(err) {
+		case codes.NotFound:
+			return model.NewNotFoundError(status.Convert(err).Message())
+		case codes.Unimplemented:
+			return &model.NotImplementError{S: status.Convert(err).Message()}
+		case codes.FailedPrecondition:
+			return model.NewPreconditionError(status.Convert(err).Message())
+		}
+		return err
+	}
+	if errorMsg := response.GetError(); errorMsg != nil {
+		return fmt.Errorf("code: %v, message: %v", errorMsg.GetCode(), errorMsg.GetDescription())
+	}
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal([]byte(response.GetResult().GetMessage()), result)
+}
+
 func (c *controller) SetDock(dockInfo *model.DockSpec) {
 	c.DockInfo = dockInfo
 }
@@ -24,6 +24,8 @@ import (
 	pb "github.com/opensds/opensds/pkg/model/proto"
 	. "github.com/opensds/opensds/testutils/collection"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type fakeClient struct{}
@@ -110,6 +112,35 @@ func (fc *fakeClient) DeleteVolumeSnapshot(ctx context.Context, in *pb.DeleteVol
 	}, nil
 }
 
+// Create a volume backup
+func (fc *fakeClient) CreateVolumeBackup(ctx context.Context, in *pb.CreateVolumeBackupOpts, opts ...grpc.CallOption) (*pb.GenericResponse, error) {
+	return &pb.GenericResponse{
+		Reply: &pb.GenericResponse_Result_{
+			Result: &pb.GenericResponse_Result{
+				Message: ByteBackup,
+			},
+		},
+	}, nil
+}
+
+// Delete a volume backup
+func (fc *fakeClient) DeleteVolumeBackup(ctx context.Context, in *pb.DeleteVolumeBackupOpts, opts ...grpc.CallOption) (*pb.GenericResponse, error) {
+	return &pb.GenericResponse{
+		Reply: &pb.GenericResponse_Result_{
+			Result: &pb.GenericResponse_Result{},
+		},
+	}, nil
+}
+
+// Restore a volume backup
+func (fc *fakeClient) RestoreVolumeBackup(ctx context.Context, in *pb.RestoreVolumeBackupOpts, opts ...grpc.CallOption) (*pb.GenericResponse, error) {
+	return &pb.GenericResponse{
+		Reply: &pb.GenericResponse_Result_{
+			Result: &pb.GenericResponse_Result{},
+		},
+	}, nil
+}
+
 // Create a volume snapshot
 func (fc *fakeClient) CreateVolumeGroup(ctx context.Context, in *pb.CreateVolumeGroupOpts, opts ...grpc.CallOption) (*pb.GenericResponse, error) {
 	return &pb.GenericResponse{
@@ -141,6 +172,20 @@ func (fc *fakeClient) DeleteVolumeGroup(ctx context.Context, in *pb.DeleteVolume
 	}, nil
 }
 
+func (fc *fakeClient) CreateGroupSnapshot(ctx context.Context, in *pb.CreateGroupSnapshotOpts, opts ...grpc.CallOption) (*pb.GenericResponse, error) {
+	return &pb.GenericResponse{
+		Reply: &pb.GenericResponse_Result_{
+			Result: &pb.GenericResponse_Result{
+				Message: ByteSnapshots,
+			},
+		},
+	}, nil
+}
+
+func (fc *fakeClient) DeleteGroupSnapshot(ctx context.Context, in *pb.DeleteGroupSnapshotOpts, opts ...grpc.CallOption) (*pb.GenericResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteGroupSnapshot has not been implemented yet")
+}
+
 // Attach a volume
 func (fc *fakeClient) AttachVolume(ctx context.Context, in *pb.AttachVolumeOpts, opts ...grpc.CallOption) (*pb.GenericResponse, error) {
 	return &pb.GenericResponse{
@@ -217,6 +262,137 @@ func (fc *fakeClient) DeleteFileShare(ctx context.Context, in *pb.DeleteFileShar
 	return nil, nil
 }
 
+func (fc *fakeClient) CreateFileShareSnapshot(ctx context.Context, in *pb.CreateFileShareSnapshotOpts, opts ...grpc.CallOption) (*pb.GenericResponse, error) {
+	return nil, nil
+}
+
+func (fc *fakeClient) DeleteFileShareSnapshot(ctx context.Context, in *pb.DeleteFileShareSnapshotOpts, opts ...grpc.CallOption) (*pb.GenericResponse, error) {
+	return nil, nil
+}
+
+func (fc *fakeClient) CreateFileShareAcl(ctx context.Context, in *pb.CreateFileShareAclOpts, opts ...grpc.CallOption) (*pb.GenericResponse, error) {
+	return nil, nil
+}
+
+func (fc *fakeClient) DeleteFileShareAcl(ctx context.Context, in *pb.DeleteFileShareAclOpts, opts ...grpc.CallOption) (*pb.GenericResponse, error) {
+	return nil, nil
+}
+
+func (fc *fakeClient) MigrateVolume(ctx context.Context, in *pb.MigrateVolumeOpts, opts ...grpc.CallOption) (*pb.GenericResponse, error) {
+	return &pb.GenericResponse{
+		Reply: &pb.GenericResponse_Result_{
+			Result: &pb.GenericResponse_Result{
+				Message: ByteVolume,
+			},
+		},
+	}, nil
+}
+
+func (fc *fakeClient) RevertVolumeToSnapshot(ctx context.Context, in *pb.RevertVolumeToSnapshotOpts, opts ...grpc.CallOption) (*pb.GenericResponse, error) {
+	return &pb.GenericResponse{
+		Reply: &pb.GenericResponse_Result_{
+			Result: &pb.GenericResponse_Result{},
+		},
+	}, nil
+}
+
+func (fc *fakeClient) CopyVolume(ctx context.Context, in *pb.CopyVolumeOpts, opts ...grpc.CallOption) (*pb.GenericResponse, error) {
+	return &pb.GenericResponse{
+		Reply: &pb.GenericResponse_Result_{
+			Result: &pb.GenericResponse_Result{},
+		},
+	}, nil
+}
+
+func (fc *fakeClient) FreezeVolume(ctx context.Context, in *pb.FreezeVolumeOpts, opts ...grpc.CallOption) (*pb.GenericResponse, error) {
+	return &pb.GenericResponse{
+		Reply: &pb.GenericResponse_Result_{
+			Result: &pb.GenericResponse_Result{},
+		},
+	}, nil
+}
+
+func (fc *fakeClient) ThawVolume(ctx context.Context, in *pb.FreezeVolumeOpts, opts ...grpc.CallOption) (*pb.GenericResponse, error) {
+	return &pb.GenericResponse{
+		Reply: &pb.GenericResponse_Result_{
+			Result: &pb.GenericResponse_Result{},
+		},
+	}, nil
+}
+
+func (fc *fakeClient) ManageVolume(ctx context.Context, in *pb.ManageVolumeOpts, opts ...grpc.CallOption) (*pb.GenericResponse, error) {
+	return &pb.GenericResponse{
+		Reply: &pb.GenericResponse_Result_{
+			Result: &pb.GenericResponse_Result{
+				Message: ByteVolume,
+			},
+		},
+	}, nil
+}
+
+func (fc *fakeClient) UnmanageVolume(ctx context.Context, in *pb.UnmanageVolumeOpts, opts ...grpc.CallOption) (*pb.GenericResponse, error) {
+	return &pb.GenericResponse{
+		Reply: &pb.GenericResponse_Result_{
+			Result: &pb.GenericResponse_Result{},
+		},
+	}, nil
+}
+
+func (fc *fakeClient) ManageVolumeSnapshot(ctx context.Context, in *pb.ManageVolumeSnapshotOpts, opts ...grpc.CallOption) (*pb.GenericResponse, error) {
+	return &pb.GenericResponse{
+		Reply: &pb.GenericResponse_Result_{
+			Result: &pb.GenericResponse_Result{
+				Message: ByteSnapshot,
+			},
+		},
+	}, nil
+}
+
+func (fc *fakeClient) UnmanageVolumeSnapshot(ctx context.Context, in *pb.UnmanageVolumeSnapshotOpts, opts ...grpc.CallOption) (*pb.GenericResponse, error) {
+	return &pb.GenericResponse{
+		Reply: &pb.GenericResponse_Result_{
+			Result: &pb.GenericResponse_Result{},
+		},
+	}, nil
+}
+
+func (fc *fakeClient) PullVolume(ctx context.Context, in *pb.PullVolumeOpts, opts ...grpc.CallOption) (*pb.GenericResponse, error) {
+	if in.GetId() != SampleVolumes[0].Id {
+		return nil, status.Error(codes.NotFound, "volume "+in.GetId()+" not found")
+	}
+	return &pb.GenericResponse{
+		Reply: &pb.GenericResponse_Result_{
+			Result: &pb.GenericResponse_Result{
+				Message: ByteVolume,
+			},
+		},
+	}, nil
+}
+
+func (fc *fakeClient) PullVolumeSnapshot(ctx context.Context, in *pb.PullVolumeSnapshotOpts, opts ...grpc.CallOption) (*pb.GenericResponse, error) {
+	return &pb.GenericResponse{
+		Reply: &pb.GenericResponse_Result_{
+			Result: &pb.GenericResponse_Result{
+				Message: ByteSnapshot,
+			},
+		},
+	}, nil
+}
+
+func (fc *fakeClient) ListVolumes(ctx context.Context, in *pb.ListVolumesOpts, opts ...grpc.CallOption) (*pb.GenericResponse, error) {
+	return &pb.GenericResponse{
+		Reply: &pb.GenericResponse_Result_{
+			Result: &pb.GenericResponse_Result{
+				Message: ByteVolumes,
+			},
+		},
+	}, nil
+}
+
+func (fc *fakeClient) ListVolumeSnapshots(ctx context.Context, in *pb.ListVolumesOpts, opts ...grpc.CallOption) (*pb.GenericResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSnapshots has not been implemented yet")
+}
+
 func NewFakeController() Controller {
 	return &controller{
 		Client:   NewFakeClient(),
@@ -261,6 +437,37 @@ func TestExtendVolume(t *testing.T) {
 	}
 }
 
+func TestMigrateVolume(t *testing.T) {
+	fc := NewFakeController()
+	var expected = &SampleVolumes[0]
+
+	result, err := fc.MigrateVolume(&pb.MigrateVolumeOpts{})
+	if err != nil {
+		t.Errorf("Failed to migrate volume, err is %v\n", err)
+	}
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v\n", expected, result)
+	}
+}
+
+func TestRevertVolumeToSnapshot(t *testing.T) {
+	fc := NewFakeController()
+
+	if err := fc.RevertVolumeToSnapshot(&pb.RevertVolumeToSnapshotOpts{}); err != nil {
+		t.Errorf("Failed to revert volume to snapshot, err is %v\n", err)
+	}
+}
+
+func TestCopyVolume(t *testing.T) {
+	fc := NewFakeController()
+
+	result := fc.CopyVolume(&pb.CopyVolumeOpts{})
+	if result != nil {
+		t.Errorf("Expected %v, got %v\n", nil, result)
+	}
+}
+
 func TestCreateVolumeAttachment(t *testing.T) {
 	fc := NewFakeController()
 	var expected = &SampleAttachments[0]
@@ -307,6 +514,59 @@ func TestDeleteVolumeSnapshot(t *testing.T) {
 	}
 }
 
+func TestCreateGroupSnapshot(t *testing.T) {
+	fc := NewFakeController()
+
+	result, err := fc.CreateGroupSnapshot(&pb.CreateGroupSnapshotOpts{})
+	if err != nil {
+		t.Errorf("Failed to create group snapshot, err is %v\n", err)
+	}
+	if len(result) != 2 || result[0].Id != SampleSnapshots[0].Id {
+		t.Errorf("Expected snapshots %v, got %v\n", SampleSnapshots, result)
+	}
+}
+
+func TestDeleteGroupSnapshot(t *testing.T) {
+	fc := NewFakeController()
+
+	err := fc.DeleteGroupSnapshot(&pb.DeleteGroupSnapshotOpts{})
+	if _, ok := err.(*model.NotImplementError); !ok {
+		t.Errorf("Expected NotImplementError, got %v\n", err)
+	}
+}
+
+func TestCreateVolumeBackup(t *testing.T) {
+	fc := NewFakeController()
+	var expected = &SampleBackups[0]
+
+	result, err := fc.CreateVolumeBackup(&pb.CreateVolumeBackupOpts{})
+	if err != nil {
+		t.Errorf("Failed to create volume backup, err is %v\n", err)
+	}
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v\n", expected, result)
+	}
+}
+
+func TestDeleteVolumeBackup(t *testing.T) {
+	fc := NewFakeController()
+
+	result := fc.DeleteVolumeBackup(&pb.DeleteVolumeBackupOpts{})
+	if result != nil {
+		t.Errorf("Expected %v, got %v\n", nil, result)
+	}
+}
+
+func TestRestoreVolumeBackup(t *testing.T) {
+	fc := NewFakeController()
+
+	result := fc.RestoreVolumeBackup(&pb.RestoreVolumeBackupOpts{})
+	if result != nil {
+		t.Errorf("Expected %v, got %v\n", nil, result)
+	}
+}
+
 func TestCreateReplication(t *testing.T) {
 	fc := NewFakeController()
 	var expected = &SampleReplications[0]
@@ -347,3 +607,38 @@ func TestDisableReplication(t *testing.T) {
 		t.Errorf("Expected %v, got %v\n", nil, result)
 	}
 }
+
+func TestPullVolume(t *testing.T) {
+	fc := NewFakeController()
+	var expected = &SampleVolumes[0]
+
+	result, err := fc.PullVolume(&pb.PullVolumeOpts{Id: SampleVolumes[0].Id})
+	if err != nil {
+		t.Errorf("Failed to pull volume, err is %v\n", err)
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v\n", expected, result)
+	}
+
+	_, err = fc.PullVolume(&pb.PullVolumeOpts{Id: "missing"})
+	if _, ok := err.(*model.NotFoundError); !ok {
+		t.Errorf("Expected NotFoundError, got %v\n", err)
+	}
+}
+
+func TestListVolumes(t *testing.T) {
+	fc := NewFakeController()
+
+	result, err := fc.ListVolumes(&pb.ListVolumesOpts{})
+	if err != nil {
+		t.Errorf("Failed to list volumes, err is %v\n", err)
+	}
+	if len(result) == 0 || result[0].Id != SampleVolumes[0].Id {
+		t.Errorf("Expected volume %s, got %v\n", SampleVolumes[0].Id, result)
+	}
+
+	_, err = fc.ListVolumeSnapshots(&pb.ListVolumesOpts{})
+	if _, ok := err.(*model.NotImplementError); !ok {
+		t.Errorf("Expected NotImplementError, got %v\n", err)
+	}
+}
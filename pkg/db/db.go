@@ -24,8 +24,10 @@ import (
 	"fmt"
 	"strings"
 
+	log "github.com/golang/glog"
 	c "github.com/opensds/opensds/pkg/context"
 	"github.com/opensds/opensds/pkg/db/drivers/etcd"
+	"github.com/opensds/opensds/pkg/db/drivers/mysql"
 	"github.com/opensds/opensds/pkg/model"
 	. "github.com/opensds/opensds/pkg/utils/config"
 	fakedb "github.com/opensds/opensds/testutils/db"
@@ -37,9 +39,8 @@ var C Client
 // Init function can perform some initialization work of different databases.
 func Init(db *Database) {
 	switch db.Driver {
-	case "mysql":
-		// C = mysql.Init(db.Driver, db.Crendential)
-		fmt.Printf("mysql is not implemented right now!")
+	case "mysql", "sqlite3":
+		C = mysql.Init(db.Driver, db.Credential)
 		return
 	case "etcd":
 		C = etcd.NewClient(strings.Split(db.Endpoint, ","))
@@ -73,6 +74,8 @@ type Client interface {
 
 	UpdateFileShare(ctx *c.Context, fshare *model.FileShareSpec) (*model.FileShareSpec, error)
 
+	UpdateFileShareAcl(ctx *c.Context, acl *model.FileShareAclSpec) (*model.FileShareAclSpec, error)
+
 	DeleteFileShare(ctx *c.Context, fshareID string) error
 
 	DeleteFileShareAcl(ctx *c.Context, aclID string) error
@@ -175,6 +178,18 @@ type Client interface {
 
 	DeleteVolumeSnapshot(ctx *c.Context, snapshotID string) error
 
+	CreateBackup(ctx *c.Context, backup *model.BackupSpec) (*model.BackupSpec, error)
+
+	GetBackup(ctx *c.Context, backupId string) (*model.BackupSpec, error)
+
+	ListBackups(ctx *c.Context) ([]*model.BackupSpec, error)
+
+	ListBackupsWithFilter(ctx *c.Context, m map[string][]string) ([]*model.BackupSpec, error)
+
+	UpdateBackup(ctx *c.Context, backupId string, backup *model.BackupSpec) (*model.BackupSpec, error)
+
+	DeleteBackup(ctx *c.Context, backupId string) error
+
 	CreateReplication(ctx *c.Context, replication *model.ReplicationSpec) (*model.ReplicationSpec, error)
 
 	GetReplication(ctx *c.Context, replicationId string) (*model.ReplicationSpec, error)
@@ -210,13 +225,78 @@ type Client interface {
 	VolumesToUpdate(ctx *c.Context, volumeList []*model.VolumeSpec) ([]*model.VolumeSpec, error)
 
 	ListVolumeGroupsWithFilter(ctx *c.Context, m map[string][]string) ([]*model.VolumeGroupSpec, error)
+
+	CreateVolumeGroupSnapshot(ctx *c.Context, gs *model.VolumeGroupSnapshotSpec) (*model.VolumeGroupSnapshotSpec, error)
+
+	GetVolumeGroupSnapshot(ctx *c.Context, gsId string) (*model.VolumeGroupSnapshotSpec, error)
+
+	ListVolumeGroupSnapshots(ctx *c.Context) ([]*model.VolumeGroupSnapshotSpec, error)
+
+	ListGroupSnapshotsByGroupId(ctx *c.Context, vgId string) ([]*model.VolumeGroupSnapshotSpec, error)
+
+	UpdateVolumeGroupSnapshot(ctx *c.Context, gsId string, gs *model.VolumeGroupSnapshotSpec) (*model.VolumeGroupSnapshotSpec, error)
+
+	DeleteVolumeGroupSnapshot(ctx *c.Context, gsId string) error
+
+	CreateOperation(ctx *c.Context, op *model.OperationSpec) (*model.OperationSpec, error)
+
+	GetOperation(ctx *c.Context, opId string) (*model.OperationSpec, error)
+
+	ListOperations(ctx *c.Context) ([]*model.OperationSpec, error)
+
+	ListOperationsWithFilter(ctx *c.Context, m map[string][]string) ([]*model.OperationSpec, error)
+
+	UpdateOperation(ctx *c.Context, opId string, op *model.OperationSpec) (*model.OperationSpec, error)
+
+	DeleteOperation(ctx *c.Context, opId string) error
+
+	GetQuota(ctx *c.Context, tenantId string) (*model.QuotaSpec, error)
+
+	UpdateQuota(ctx *c.Context, quota *model.QuotaSpec) (*model.QuotaSpec, error)
+
+	GetQuotaUsage(ctx *c.Context, tenantId string) (*model.QuotaUsageSpec, error)
+
+	UpdateQuotaUsage(ctx *c.Context, usage *model.QuotaUsageSpec) (*model.QuotaUsageSpec, error)
+
+	CreateWebhook(ctx *c.Context, hook *model.WebhookSpec) (*model.WebhookSpec, error)
+
+	GetWebhook(ctx *c.Context, hookId string) (*model.WebhookSpec, error)
+
+	ListWebhooks(ctx *c.Context) ([]*model.WebhookSpec, error)
+
+	DeleteWebhook(ctx *c.Context, hookId string) error
+
+	CreateWebhookDelivery(ctx *c.Context, dlv *model.WebhookDeliverySpec) (*model.WebhookDeliverySpec, error)
+
+	UpdateWebhookDelivery(ctx *c.Context, dlvId string, dlv *model.WebhookDeliverySpec) (*model.WebhookDeliverySpec, error)
+
+	ListWebhookDeliveries(ctx *c.Context, hookId string) ([]*model.WebhookDeliverySpec, error)
+
+	CreateAuditRecord(ctx *c.Context, rec *model.AuditRecordSpec) (*model.AuditRecordSpec, error)
+
+	ListAuditRecords(ctx *c.Context, m map[string][]string) ([]*model.AuditRecordSpec, error)
+
+	// Watch streams the changes of the resources of the type after the
+	// revision until stop is closed, the changes from now on are streamed if
+	// revision is zero. The channel returned is closed when the watch stops.
+	Watch(ctx *c.Context, resourceType string, revision int64, stop <-chan struct{}) (<-chan *model.WatchEvent, error)
 }
 
 func UpdateFileShareStatus(ctx *c.Context, client Client, fileID, status string) error {
-	file, _ := client.GetVolume(ctx, fileID)
+	file, _ := client.GetFileShare(ctx, fileID)
 	return client.UpdateStatus(ctx, file, status)
 }
 
+func UpdateFileShareSnapshotStatus(ctx *c.Context, client Client, snapID, status string) error {
+	snap, _ := client.GetFileShareSnapshot(ctx, snapID)
+	return client.UpdateStatus(ctx, snap, status)
+}
+
+func UpdateFileShareAclStatus(ctx *c.Context, client Client, aclID, status string) error {
+	acl, _ := client.GetFileShareAcl(ctx, aclID)
+	return client.UpdateStatus(ctx, acl, status)
+}
+
 func UpdateVolumeStatus(ctx *c.Context, client Client, volID, status string) error {
 	vol, _ := client.GetVolume(ctx, volID)
 	return client.UpdateStatus(ctx, vol, status)
@@ -232,8 +312,16 @@ func UpdateVolumeSnapshotStatus(ctx *c.Context, client Client, snapID, status st
 	return client.UpdateStatus(ctx, snap, status)
 }
 
+func UpdateBackupStatus(ctx *c.Context, client Client, backupID, status string) error {
+	backup, _ := client.GetBackup(ctx, backupID)
+	return client.UpdateStatus(ctx, backup, status)
+}
+
 func UpdateReplicationStatus(ctx *c.Context, client Client, replicaID, status string) error {
-	replica, _ := client.GetReplication(ctx, replicaID)
+	replica, err := client.GetReplication(ctx, replicaID)
+	if err != nil {
+		return err
+	}
 	return client.UpdateStatus(ctx, replica, status)
 }
 
@@ -241,3 +329,134 @@ func UpdateVolumeGroupStatus(ctx *c.Context, client Client, vgID, status string)
 	vg, _ := client.GetVolumeGroup(ctx, vgID)
 	return client.UpdateStatus(ctx, vg, status)
 }
+
+func UpdateVolumeGroupSnapshotStatus(ctx *c.Context, client Client, gsID, status string) error {
+	gs, _ := client.GetVolumeGroupSnapshot(ctx, gsID)
+	return client.UpdateStatus(ctx, gs, status)
+}
+
+// UpdateOperationStatus updates the status of the operation specified by
+// opId, the error message will be recorded if the operation failed. It
+// does nothing if no operation is tracked by the caller.
+func UpdateOperationStatus(ctx *c.Context, client Client, opId, status string, opErr error) error {
+	if opId == "" {
+		return nil
+	}
+	op := &model.OperationSpec{Status: status}
+	if opErr != nil {
+		op.ErrorMessage = opErr.Error()
+	}
+	_, err := client.UpdateOperation(ctx, opId, op)
+	return err
+}
+
+// UpdateOperationDock records the dock chosen to serve the operation
+// specified by opId and marks the operation as running.
+func UpdateOperationDock(ctx *c.Context, client Client, opId, dockId string) error {
+	if opId == "" {
+		return nil
+	}
+	op := &model.OperationSpec{
+		DockId: dockId,
+		Status: model.OperationRunning,
+	}
+	_, err := client.UpdateOperation(ctx, opId, op)
+	return err
+}
+
+// StatusHook is called after the status of an object has been updated
+// through UpdateStatus, previous is the status of the object before.
+type StatusHook func(ctx *c.Context, in interface{}, previous, status string)
+
+// WithStatusHook returns a client which calls the hook after every status
+// transition written through the UpdateStatus of client, including the ones
+// written by the Update*Status helpers.
+func WithStatusHook(client Client, hook StatusHook) Client {
+	return &hookedClient{Client: client, hook: hook}
+}
+
+type hookedClient struct {
+	Client
+	hook StatusHook
+}
+
+func (h *hookedClient) UpdateStatus(ctx *c.Context, in interface{}, status string) error {
+	// The object passed in may be the one returned by the driver, which has
+	// neither the owner nor the status stored, so the transition is taken
+	// from the stored one.
+	stored, err := h.stored(ctx, in)
+	if err != nil {
+		log.Warningf("get stored object before updating status failed: %v", err)
+		stored = in
+	}
+	previous := statusOf(stored)
+	if err := h.Client.UpdateStatus(ctx, in, status); err != nil {
+		return err
+	}
+	if previous != status {
+		h.hook(ctx, stored, previous, status)
+	}
+	return nil
+}
+
+// stored reads the object passed to UpdateStatus from db.
+func (h *hookedClient) stored(ctx *c.Context, in interface{}) (interface{}, error) {
+	switch in := in.(type) {
+	case *model.VolumeSpec:
+		return h.GetVolume(ctx, in.Id)
+	case *model.VolumeSnapshotSpec:
+		return h.GetVolumeSnapshot(ctx, in.Id)
+	case *model.VolumeAttachmentSpec:
+		return h.GetVolumeAttachment(ctx, in.Id)
+	case *model.BackupSpec:
+		return h.GetBackup(ctx, in.Id)
+	case *model.ReplicationSpec:
+		return h.GetReplication(ctx, in.Id)
+	case *model.VolumeGroupSpec:
+		return h.GetVolumeGroup(ctx, in.Id)
+	case *model.VolumeGroupSnapshotSpec:
+		return h.GetVolumeGroupSnapshot(ctx, in.Id)
+	case *model.FileShareSpec:
+		return h.GetFileShare(ctx, in.Id)
+	case *model.FileShareSnapshotSpec:
+		return h.GetFileShareSnapshot(ctx, in.Id)
+	case *model.FileShareAclSpec:
+		return h.GetFileShareAcl(ctx, in.Id)
+	case *model.DockSpec:
+		return h.GetDock(ctx, in.Id)
+	case *model.StoragePoolSpec:
+		return h.GetPool(ctx, in.Id)
+	}
+	return in, nil
+}
+
+// statusOf returns the status of the object passed to UpdateStatus.
+func statusOf(in interface{}) string {
+	switch in := in.(type) {
+	case *model.VolumeSpec:
+		return in.Status
+	case *model.VolumeSnapshotSpec:
+		return in.Status
+	case *model.VolumeAttachmentSpec:
+		return in.Status
+	case *model.BackupSpec:
+		return in.Status
+	case *model.ReplicationSpec:
+		return in.ReplicationStatus
+	case *model.VolumeGroupSpec:
+		return in.Status
+	case *model.VolumeGroupSnapshotSpec:
+		return in.Status
+	case *model.FileShareSpec:
+		return in.Status
+	case *model.FileShareSnapshotSpec:
+		return in.Status
+	case *model.FileShareAclSpec:
+		return in.Status
+	case *model.DockSpec:
+		return in.Status
+	case *model.StoragePoolSpec:
+		return in.Status
+	}
+	return ""
+}
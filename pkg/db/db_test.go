@@ -0,0 +1,66 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"errors"
+	"testing"
+
+	c "github.com/opensds/opensds/pkg/context"
+	"github.com/opensds/opensds/pkg/model"
+	dbtest "github.com/opensds/opensds/testutils/db/testing"
+)
+
+type transition struct {
+	previous, status string
+}
+
+func TestWithStatusHook(t *testing.T) {
+	ctx := c.NewAdminContext()
+	vol := &model.VolumeSpec{BaseModel: &model.BaseModel{Id: "vol"}, Status: model.VolumeCreating, TenantId: "tenant"}
+	failed := &model.VolumeSpec{BaseModel: &model.BaseModel{Id: "failed"}, Status: model.VolumeCreating}
+	// The result of the driver has neither the owner nor the status.
+	result := &model.VolumeSpec{BaseModel: &model.BaseModel{Id: "vol"}}
+
+	m := new(dbtest.Client)
+	m.On("GetVolume", ctx, "vol").Return(vol, nil)
+	m.On("GetVolume", ctx, "failed").Return(failed, nil)
+	m.On("UpdateStatus", ctx, result, model.VolumeAvailable).Return(nil)
+	m.On("UpdateStatus", ctx, result, model.VolumeCreating).Return(nil)
+	m.On("UpdateStatus", ctx, failed, model.VolumeAvailable).Return(errors.New("db error"))
+
+	var got []transition
+	var owners []string
+	client := WithStatusHook(m, func(ctx *c.Context, in interface{}, previous, status string) {
+		got = append(got, transition{previous, status})
+		owners = append(owners, in.(*model.VolumeSpec).TenantId)
+	})
+
+	if err := client.UpdateStatus(ctx, result, model.VolumeAvailable); err != nil {
+		t.Fatal(err)
+	}
+	// The status doesn't change, so nothing is notified.
+	client.UpdateStatus(ctx, result, model.VolumeCreating)
+	if err := client.UpdateStatus(ctx, failed, model.VolumeAvailable); err == nil {
+		t.Error("Expected the error of the wrapped client")
+	}
+
+	if len(got) != 1 || got[0] != (transition{model.VolumeCreating, model.VolumeAvailable}) {
+		t.Errorf("Unexpected transitions %+v", got)
+	}
+	if len(owners) != 1 || owners[0] != "tenant" {
+		t.Errorf("Expected the stored owner, got %v", owners)
+	}
+}
@@ -16,6 +16,7 @@ package etcd
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"time"
 
@@ -34,6 +35,9 @@ type Request struct {
 	Url        string `json:"url"`
 	Content    string `json:"content"`
 	NewContent string `json:"newContent"`
+	// Revision is the modification revision which the key must still have
+	// for an update to be applied, zero means the update is unconditional.
+	Revision int64 `json:"revision"`
 }
 
 // Response
@@ -41,6 +45,17 @@ type Response struct {
 	Status  string   `json:"status"`
 	Message []string `json:"message"`
 	Error   string   `json:"error"`
+	// Revisions holds the modification revision of each returned message.
+	Revisions []int64 `json:"revisions"`
+}
+
+// Revision returns the modification revision of the i-th message, or zero
+// if it is unknown.
+func (r *Response) Revision(i int) int64 {
+	if i < len(r.Revisions) {
+		return r.Revisions[i]
+	}
+	return 0
 }
 
 type clientInterface interface {
@@ -53,6 +68,27 @@ type clientInterface interface {
 	Update(req *Request) *Response
 
 	Delete(req *Request) *Response
+
+	Watch(req *Request, stop <-chan struct{}) <-chan *Event
+}
+
+// Event types of the keys watched.
+const (
+	EventPut    = "PUT"
+	EventDelete = "DELETE"
+	EventError  = "ERROR"
+)
+
+// Event is a change of a key under the prefix watched.
+type Event struct {
+	Type string `json:"type"`
+	Key  string `json:"key"`
+	// Value is the last value of the key if it has been deleted.
+	Value string `json:"value"`
+	// Created tells whether the key is created by the put.
+	Created  bool   `json:"created"`
+	Revision int64  `json:"revision"`
+	Error    string `json:"error"`
 }
 
 // Init
@@ -121,13 +157,14 @@ func (c *client) Get(req *Request) *Response {
 
 	if len(resp.Kvs) == 0 {
 		return &Response{
-			Status: "Failure",
+			Status: "NotFound",
 			Error:  "Wrong resource uuid provided!",
 		}
 	}
 	return &Response{
-		Status:  "Success",
-		Message: []string{string(resp.Kvs[0].Value)},
+		Status:    "Success",
+		Message:   []string{string(resp.Kvs[0].Value)},
+		Revisions: []int64{resp.Kvs[0].ModRevision},
 	}
 }
 
@@ -148,12 +185,15 @@ func (c *client) List(req *Request) *Response {
 	}
 
 	var message = []string{}
+	var revisions = []int64{}
 	for _, v := range resp.Kvs {
 		message = append(message, string(v.Value))
+		revisions = append(revisions, v.ModRevision)
 	}
 	return &Response{
-		Status:  "Success",
-		Message: message,
+		Status:    "Success",
+		Message:   message,
+		Revisions: revisions,
 	}
 }
 
@@ -164,7 +204,28 @@ func (c *client) Update(req *Request) *Response {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
-	_, err := c.cli.Put(ctx, req.Url, req.NewContent)
+	if req.Revision == 0 {
+		resp, err := c.cli.Put(ctx, req.Url, req.NewContent)
+		if err != nil {
+			log.Error("When update db request:", err)
+			return &Response{
+				Status: "Failure",
+				Error:  err.Error(),
+			}
+		}
+		return &Response{
+			Status:    "Success",
+			Message:   []string{req.NewContent},
+			Revisions: []int64{resp.Header.Revision},
+		}
+	}
+
+	// Only write the new content if nobody else has modified the key since
+	// the revision which the update is based on.
+	resp, err := c.cli.Txn(ctx).
+		If(clientv3.Compare(clientv3.ModRevision(req.Url), "=", req.Revision)).
+		Then(clientv3.OpPut(req.Url, req.NewContent)).
+		Commit()
 	if err != nil {
 		log.Error("When update db request:", err)
 		return &Response{
@@ -172,10 +233,17 @@ func (c *client) Update(req *Request) *Response {
 			Error:  err.Error(),
 		}
 	}
+	if !resp.Succeeded {
+		return &Response{
+			Status: "Conflict",
+			Error:  fmt.Sprintf("%s has been modified since revision %d", req.Url, req.Revision),
+		}
+	}
 
 	return &Response{
-		Status:  "Success",
-		Message: []string{req.NewContent},
+		Status:    "Success",
+		Message:   []string{req.NewContent},
+		Revisions: []int64{resp.Header.Revision},
 	}
 }
 
@@ -199,3 +267,67 @@ func (c *client) Delete(req *Request) *Response {
 		Status: "Success",
 	}
 }
+
+// Watch streams the changes of the keys under the prefix in req.Url after
+// req.Revision until stop is closed, the channel returned is closed when the
+// watch stops. A watch failed, such as the revision has been compacted, ends
+// with an ERROR event.
+func (c *client) Watch(req *Request, stop <-chan struct{}) <-chan *Event {
+	ctx, cancel := context.WithCancel(clientv3.WithRequireLeader(context.Background()))
+	opts := []clientv3.OpOption{clientv3.WithPrefix(), clientv3.WithPrevKV()}
+	if req.Revision > 0 {
+		opts = append(opts, clientv3.WithRev(req.Revision+1))
+	}
+	wch := c.cli.Watch(ctx, req.Url, opts...)
+
+	events := make(chan *Event)
+	go func() {
+		defer close(events)
+		defer cancel()
+		send := func(e *Event) bool {
+			select {
+			case events <- e:
+				return true
+			case <-stop:
+				return false
+			}
+		}
+		for {
+			var resp clientv3.WatchResponse
+			var ok bool
+			select {
+			case resp, ok = <-wch:
+			case <-stop:
+				return
+			}
+			if !ok {
+				send(&Event{Type: EventError, Error: "watch channel closed"})
+				return
+			}
+			if err := resp.Err(); err != nil {
+				log.Error("When watch db request:", err)
+				send(&Event{Type: EventError, Error: err.Error(), Revision: resp.CompactRevision})
+				return
+			}
+			for _, ev := range resp.Events {
+				e := &Event{
+					Type:     EventPut,
+					Key:      string(ev.Kv.Key),
+					Value:    string(ev.Kv.Value),
+					Created:  ev.IsCreate(),
+					Revision: ev.Kv.ModRevision,
+				}
+				if ev.Type == clientv3.EventTypeDelete {
+					e.Type = EventDelete
+					if ev.PrevKv != nil {
+						e.Value = string(ev.PrevKv.Value)
+					}
+				}
+				if !send(e) {
+					return
+				}
+			}
+		}
+	}()
+	return events
+}
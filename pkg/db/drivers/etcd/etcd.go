@@ -58,6 +58,55 @@ func AuthorizeProjectContext(ctx *c.Context, tenantId string) bool {
 	return ctx.TenantId == tenantId
 }
 
+// setRevision records the modification revision of an object read from db.
+func setRevision(m *model.BaseModel, rev int64) {
+	if m != nil {
+		m.Revision = rev
+	}
+}
+
+// revisionOf returns the revision which an update of the object is based on.
+func revisionOf(m *model.BaseModel) int64 {
+	if m == nil {
+		return 0
+	}
+	return m.Revision
+}
+
+// checkRevision makes sure that the object in db still has the revision which
+// the update is based on, zero means the update is based on any revision.
+func checkRevision(id string, expected, actual int64) error {
+	if expected != 0 && expected != actual {
+		return model.NewConflictError(fmt.Sprintf("%s has been modified, current revision is %d", id, actual))
+	}
+	return nil
+}
+
+// updateError converts a failed update response into an error.
+func updateError(dbRes *Response) error {
+	if dbRes.Status == "Conflict" {
+		return model.NewConflictError(dbRes.Error)
+	}
+	return errors.New(dbRes.Error)
+}
+
+// retryOnConflict calls fn again when it lost a race with another writer. An
+// update based on a specific revision is never retried, the conflict is
+// returned to the caller which has to decide how to resolve it.
+func retryOnConflict(rev int64, fn func() error) error {
+	if rev != 0 {
+		return fn()
+	}
+	var err error
+	for i := 0; i < retryNum; i++ {
+		if err = fn(); !model.IsConflictError(err) {
+			return err
+		}
+		log.Warningf("Update conflicted with another writer, retry %d", i+1)
+	}
+	return err
+}
+
 // NewClient
 func NewClient(edps []string) *Client {
 	return &Client{
@@ -366,12 +415,13 @@ func (c *Client) ListFileSharesAcl(ctx *c.Context) ([]*model.FileShareAclSpec, e
 	if len(dbRes.Message) == 0 {
 		return fileshares, nil
 	}
-	for _, msg := range dbRes.Message {
+	for i, msg := range dbRes.Message {
 		var share = &model.FileShareAclSpec{}
 		if err := json.Unmarshal([]byte(msg), share); err != nil {
 			log.Error("when parsing fileshare in db:", dbRes.Error)
 			return nil, errors.New(dbRes.Error)
 		}
+		setRevision(share.BaseModel, dbRes.Revision(i))
 		fileshares = append(fileshares, share)
 	}
 	return fileshares, nil
@@ -410,12 +460,13 @@ func (c *Client) ListFileShares(ctx *c.Context) ([]*model.FileShareSpec, error)
 	if len(dbRes.Message) == 0 {
 		return fileshares, nil
 	}
-	for _, msg := range dbRes.Message {
+	for i, msg := range dbRes.Message {
 		var share = &model.FileShareSpec{}
 		if err := json.Unmarshal([]byte(msg), share); err != nil {
 			log.Error("when parsing fileshare in db:", dbRes.Error)
 			return nil, errors.New(dbRes.Error)
 		}
+		setRevision(share.BaseModel, dbRes.Revision(i))
 		fileshares = append(fileshares, share)
 	}
 	return fileshares, nil
@@ -454,6 +505,7 @@ func (c *Client) getFileShareAcl(ctx *c.Context, aclID string) (*model.FileShare
 		log.Error("when parsing fileshare acl in db:", dbRes.Error)
 		return nil, errors.New(dbRes.Error)
 	}
+	setRevision(acl.BaseModel, dbRes.Revision(0))
 	return acl, nil
 }
 
@@ -490,21 +542,49 @@ func (c *Client) getFileShare(ctx *c.Context, fshareID string) (*model.FileShare
 		log.Error("when parsing fileshare in db:", dbRes.Error)
 		return nil, errors.New(dbRes.Error)
 	}
+	setRevision(fshare.BaseModel, dbRes.Revision(0))
 	return fshare, nil
 }
 
 // UpdateFileShare ...
 func (c *Client) UpdateFileShare(ctx *c.Context, fshare *model.FileShareSpec) (*model.FileShareSpec, error) {
+	var result *model.FileShareSpec
+	err := retryOnConflict(revisionOf(fshare.BaseModel), func() (err error) {
+		result, err = c.updateFileShare(ctx, fshare)
+		return err
+	})
+	return result, err
+}
+
+func (c *Client) updateFileShare(ctx *c.Context, fshare *model.FileShareSpec) (*model.FileShareSpec, error) {
 	result, err := c.GetFileShare(ctx, fshare.Id)
 	if err != nil {
 		return nil, err
 	}
+	if err := checkRevision(fshare.Id, revisionOf(fshare.BaseModel), result.Revision); err != nil {
+		return nil, err
+	}
 	if fshare.Name != "" {
 		result.Name = fshare.Name
 	}
 	if fshare.Description != "" {
 		result.Description = fshare.Description
 	}
+	if fshare.Metadata != nil {
+		result.Metadata = utils.MergeStringMaps(result.Metadata, fshare.Metadata)
+	}
+	if fshare.ExportLocations != nil {
+		result.ExportLocations = fshare.ExportLocations
+	}
+	if fshare.PoolId != "" {
+		result.PoolId = fshare.PoolId
+	}
+	if fshare.ProfileId != "" {
+		result.ProfileId = fshare.ProfileId
+	}
+	if fshare.Status != "" {
+		result.Status = fshare.Status
+	}
 
 	// Set update time
 	result.UpdatedAt = time.Now().Format(constants.TimeFormat)
@@ -522,13 +602,68 @@ func (c *Client) UpdateFileShare(ctx *c.Context, fshare *model.FileShareSpec) (*
 	dbReq := &Request{
 		Url:        urls.GenerateFileShareURL(urls.Etcd, result.TenantId, fshare.Id),
 		NewContent: string(body),
+		Revision:   result.Revision,
 	}
 
 	dbRes := c.Update(dbReq)
 	if dbRes.Status != "Success" {
 		log.Error("when update fileshare in db:", dbRes.Error)
-		return nil, errors.New(dbRes.Error)
+		return nil, updateError(dbRes)
+	}
+	result.Revision = dbRes.Revision(0)
+	return result, nil
+}
+
+// UpdateFileShareAcl
+func (c *Client) UpdateFileShareAcl(ctx *c.Context, acl *model.FileShareAclSpec) (*model.FileShareAclSpec, error) {
+	var result *model.FileShareAclSpec
+	err := retryOnConflict(revisionOf(acl.BaseModel), func() (err error) {
+		result, err = c.updateFileShareAcl(ctx, acl)
+		return err
+	})
+	return result, err
+}
+
+func (c *Client) updateFileShareAcl(ctx *c.Context, acl *model.FileShareAclSpec) (*model.FileShareAclSpec, error) {
+	result, err := c.GetFileShareAcl(ctx, acl.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkRevision(acl.Id, revisionOf(acl.BaseModel), result.Revision); err != nil {
+		return nil, err
+	}
+	if acl.Description != "" {
+		result.Description = acl.Description
+	}
+	if acl.Status != "" {
+		result.Status = acl.Status
+	}
+
+	// Set update time
+	result.UpdatedAt = time.Now().Format(constants.TimeFormat)
+
+	body, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+
+	// If an admin want to access other tenant's resource just fake other's tenantId.
+	if !IsAdminContext(ctx) && !AuthorizeProjectContext(ctx, result.TenantId) {
+		return nil, fmt.Errorf("opertaion is not permitted")
+	}
+
+	dbReq := &Request{
+		Url:        urls.GenerateFileShareAclURL(urls.Etcd, result.TenantId, acl.Id),
+		NewContent: string(body),
+		Revision:   result.Revision,
+	}
+
+	dbRes := c.Update(dbReq)
+	if dbRes.Status != "Success" {
+		log.Error("when update fileshare acl in db:", dbRes.Error)
+		return nil, updateError(dbRes)
 	}
+	result.Revision = dbRes.Revision(0)
 	return result, nil
 }
 
@@ -635,6 +770,7 @@ func (c *Client) getFileShareSnapshot(ctx *c.Context, snpID string) (*model.File
 		log.Error("when parsing fileshare snapshot in db:", dbRes.Error)
 		return nil, errors.New(dbRes.Error)
 	}
+	setRevision(fs.BaseModel, dbRes.Revision(0))
 	return fs, nil
 }
 
@@ -656,12 +792,13 @@ func (c *Client) ListFileShareSnapshots(ctx *c.Context) ([]*model.FileShareSnaps
 	if len(dbRes.Message) == 0 {
 		return fss, nil
 	}
-	for _, msg := range dbRes.Message {
+	for i, msg := range dbRes.Message {
 		var fs = &model.FileShareSnapshotSpec{}
 		if err := json.Unmarshal([]byte(msg), fs); err != nil {
 			log.Error("When parsing fileshare snapshot in db:", dbRes.Error)
 			return nil, errors.New(dbRes.Error)
 		}
+		setRevision(fs.BaseModel, dbRes.Revision(i))
 		fss = append(fss, fs)
 	}
 	return fss, nil
@@ -776,10 +913,22 @@ func (c *Client) ListFileShareSnapshotsWithFilter(ctx *c.Context, m map[string][
 
 // UpdateFileShareSnapshot
 func (c *Client) UpdateFileShareSnapshot(ctx *c.Context, snpID string, snp *model.FileShareSnapshotSpec) (*model.FileShareSnapshotSpec, error) {
+	var result *model.FileShareSnapshotSpec
+	err := retryOnConflict(revisionOf(snp.BaseModel), func() (err error) {
+		result, err = c.updateFileShareSnapshot(ctx, snpID, snp)
+		return err
+	})
+	return result, err
+}
+
+func (c *Client) updateFileShareSnapshot(ctx *c.Context, snpID string, snp *model.FileShareSnapshotSpec) (*model.FileShareSnapshotSpec, error) {
 	result, err := c.GetFileShareSnapshot(ctx, snpID)
 	if err != nil {
 		return nil, err
 	}
+	if err := checkRevision(snpID, revisionOf(snp.BaseModel), result.Revision); err != nil {
+		return nil, err
+	}
 	if snp.Name != "" {
 		result.Name = snp.Name
 	}
@@ -789,6 +938,12 @@ func (c *Client) UpdateFileShareSnapshot(ctx *c.Context, snpID string, snp *mode
 	if snp.Status != "" {
 		result.Status = snp.Status
 	}
+	if snp.SnapshotSize != 0 {
+		result.SnapshotSize = snp.SnapshotSize
+	}
+	if snp.Metadata != nil {
+		result.Metadata = utils.MergeStringMaps(result.Metadata, snp.Metadata)
+	}
 	// Set update time
 	result.UpdatedAt = time.Now().Format(constants.TimeFormat)
 
@@ -805,13 +960,15 @@ func (c *Client) UpdateFileShareSnapshot(ctx *c.Context, snpID string, snp *mode
 	dbReq := &Request{
 		Url:        urls.GenerateFileShareSnapshotURL(urls.Etcd, result.TenantId, snpID),
 		NewContent: string(atcBody),
+		Revision:   result.Revision,
 	}
 
 	dbRes := c.Update(dbReq)
 	if dbRes.Status != "Success" {
 		log.Error("when update fileshare snapshot in db:", dbRes.Error)
-		return nil, errors.New(dbRes.Error)
+		return nil, updateError(dbRes)
 	}
+	result.Revision = dbRes.Revision(0)
 	return result, nil
 }
 
@@ -885,6 +1042,7 @@ func (c *Client) GetDock(ctx *c.Context, dckID string) (*model.DockSpec, error)
 		log.Error("when parsing dock in db:", dbRes.Error)
 		return nil, errors.New(dbRes.Error)
 	}
+	setRevision(dck.BaseModel, dbRes.Revision(0))
 	return dck, nil
 }
 
@@ -924,12 +1082,13 @@ func (c *Client) ListDocks(ctx *c.Context) ([]*model.DockSpec, error) {
 	if len(dbRes.Message) == 0 {
 		return dcks, nil
 	}
-	for _, msg := range dbRes.Message {
+	for i, msg := range dbRes.Message {
 		var dck = &model.DockSpec{}
 		if err := json.Unmarshal([]byte(msg), dck); err != nil {
 			log.Error("When parsing dock in db:", dbRes.Error)
 			return nil, errors.New(dbRes.Error)
 		}
+		setRevision(dck.BaseModel, dbRes.Revision(i))
 		dcks = append(dcks, dck)
 	}
 	return dcks, nil
@@ -1037,6 +1196,15 @@ func (c *Client) ListDocksWithFilter(ctx *c.Context, m map[string][]string) ([]*
 
 // UpdateDock
 func (c *Client) UpdateDock(ctx *c.Context, dckID, name, desp string) (*model.DockSpec, error) {
+	var result *model.DockSpec
+	err := retryOnConflict(0, func() (err error) {
+		result, err = c.updateDock(ctx, dckID, name, desp)
+		return err
+	})
+	return result, err
+}
+
+func (c *Client) updateDock(ctx *c.Context, dckID, name, desp string) (*model.DockSpec, error) {
 	dck, err := c.GetDock(ctx, dckID)
 	if err != nil {
 		return nil, err
@@ -1049,21 +1217,32 @@ func (c *Client) UpdateDock(ctx *c.Context, dckID, name, desp string) (*model.Do
 	}
 	dck.UpdatedAt = time.Now().Format(constants.TimeFormat)
 
+	if err = c.putDock(dck); err != nil {
+		return nil, err
+	}
+	return dck, nil
+}
+
+// putDock writes the dock back to db only if it hasn't been modified since
+// it was read.
+func (c *Client) putDock(dck *model.DockSpec) error {
 	dckBody, err := json.Marshal(dck)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	dbReq := &Request{
-		Url:        urls.GenerateDockURL(urls.Etcd, "", dckID),
+		Url:        urls.GenerateDockURL(urls.Etcd, "", dck.Id),
 		NewContent: string(dckBody),
+		Revision:   dck.Revision,
 	}
 	dbRes := c.Update(dbReq)
 	if dbRes.Status != "Success" {
 		log.Error("When update dock in db:", dbRes.Error)
-		return nil, errors.New(dbRes.Error)
+		return updateError(dbRes)
 	}
-	return dck, nil
+	dck.Revision = dbRes.Revision(0)
+	return nil
 }
 
 // DeleteDock
@@ -1227,6 +1406,7 @@ func (c *Client) GetPool(ctx *c.Context, polID string) (*model.StoragePoolSpec,
 		log.Error("When parsing pool in db:", dbRes.Error)
 		return nil, errors.New(dbRes.Error)
 	}
+	setRevision(pol.BaseModel, dbRes.Revision(0))
 	return pol, nil
 }
 
@@ -1244,12 +1424,13 @@ func (c *Client) ListAvailabilityZones(ctx *c.Context) ([]string, error) {
 	if len(dbRes.Message) == 0 {
 		return azs, nil
 	}
-	for _, msg := range dbRes.Message {
+	for i, msg := range dbRes.Message {
 		var pol = &model.StoragePoolSpec{}
 		if err := json.Unmarshal([]byte(msg), pol); err != nil {
 			log.Error("When parsing pool in db:", dbRes.Error)
 			return nil, errors.New(dbRes.Error)
 		}
+		setRevision(pol.BaseModel, dbRes.Revision(i))
 		azs = append(azs, pol.AvailabilityZone)
 	}
 	//remove redundant AZ
@@ -1272,12 +1453,13 @@ func (c *Client) ListPools(ctx *c.Context) ([]*model.StoragePoolSpec, error) {
 	if len(dbRes.Message) == 0 {
 		return pols, nil
 	}
-	for _, msg := range dbRes.Message {
+	for i, msg := range dbRes.Message {
 		var pol = &model.StoragePoolSpec{}
 		if err := json.Unmarshal([]byte(msg), pol); err != nil {
 			log.Error("When parsing pool in db:", dbRes.Error)
 			return nil, errors.New(dbRes.Error)
 		}
+		setRevision(pol.BaseModel, dbRes.Revision(i))
 		pols = append(pols, pol)
 	}
 	return pols, nil
@@ -1285,6 +1467,15 @@ func (c *Client) ListPools(ctx *c.Context) ([]*model.StoragePoolSpec, error) {
 
 // UpdatePool
 func (c *Client) UpdatePool(ctx *c.Context, polID, name, desp string, usedCapacity int64, used bool) (*model.StoragePoolSpec, error) {
+	var result *model.StoragePoolSpec
+	err := retryOnConflict(0, func() (err error) {
+		result, err = c.updatePool(ctx, polID, name, desp, usedCapacity, used)
+		return err
+	})
+	return result, err
+}
+
+func (c *Client) updatePool(ctx *c.Context, polID, name, desp string, usedCapacity int64, used bool) (*model.StoragePoolSpec, error) {
 	pol, err := c.GetPool(ctx, polID)
 	if err != nil {
 		return nil, err
@@ -1297,21 +1488,32 @@ func (c *Client) UpdatePool(ctx *c.Context, polID, name, desp string, usedCapaci
 	}
 	pol.UpdatedAt = time.Now().Format(constants.TimeFormat)
 
+	if err = c.putPool(pol); err != nil {
+		return nil, err
+	}
+	return pol, nil
+}
+
+// putPool writes the pool back to db only if it hasn't been modified since
+// it was read.
+func (c *Client) putPool(pol *model.StoragePoolSpec) error {
 	polBody, err := json.Marshal(pol)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	dbReq := &Request{
-		Url:        urls.GeneratePoolURL(urls.Etcd, "", polID),
+		Url:        urls.GeneratePoolURL(urls.Etcd, "", pol.Id),
 		NewContent: string(polBody),
+		Revision:   pol.Revision,
 	}
 	dbRes := c.Update(dbReq)
 	if dbRes.Status != "Success" {
 		log.Error("When update pool in db:", dbRes.Error)
-		return nil, errors.New(dbRes.Error)
+		return updateError(dbRes)
 	}
-	return pol, nil
+	pol.Revision = dbRes.Revision(0)
+	return nil
 }
 
 // DeletePool
@@ -1370,6 +1572,7 @@ func (c *Client) GetProfile(ctx *c.Context, prfID string) (*model.ProfileSpec, e
 		log.Error("When parsing profile in db:", dbRes.Error)
 		return nil, errors.New(dbRes.Error)
 	}
+	setRevision(prf.BaseModel, dbRes.Revision(0))
 	return prf, nil
 }
 
@@ -1405,12 +1608,13 @@ func (c *Client) ListProfiles(ctx *c.Context) ([]*model.ProfileSpec, error) {
 	if len(dbRes.Message) == 0 {
 		return prfs, nil
 	}
-	for _, msg := range dbRes.Message {
+	for i, msg := range dbRes.Message {
 		var prf = &model.ProfileSpec{}
 		if err := json.Unmarshal([]byte(msg), prf); err != nil {
 			log.Error("When parsing profile in db:", dbRes.Error)
 			return nil, errors.New(dbRes.Error)
 		}
+		setRevision(prf.BaseModel, dbRes.Revision(i))
 		prfs = append(prfs, prf)
 	}
 	return prfs, nil
@@ -1509,10 +1713,22 @@ func (c *Client) ListProfilesWithFilter(ctx *c.Context, m map[string][]string) (
 
 // UpdateProfile
 func (c *Client) UpdateProfile(ctx *c.Context, prfID string, input *model.ProfileSpec) (*model.ProfileSpec, error) {
+	var result *model.ProfileSpec
+	err := retryOnConflict(revisionOf(input.BaseModel), func() (err error) {
+		result, err = c.updateProfile(ctx, prfID, input)
+		return err
+	})
+	return result, err
+}
+
+func (c *Client) updateProfile(ctx *c.Context, prfID string, input *model.ProfileSpec) (*model.ProfileSpec, error) {
 	prf, err := c.GetProfile(ctx, prfID)
 	if err != nil {
 		return nil, err
 	}
+	if err := checkRevision(prfID, revisionOf(input.BaseModel), prf.Revision); err != nil {
+		return nil, err
+	}
 	if name := input.Name; name != "" {
 		prf.Name = name
 	}
@@ -1540,12 +1756,14 @@ func (c *Client) UpdateProfile(ctx *c.Context, prfID string, input *model.Profil
 	dbReq := &Request{
 		Url:        urls.GenerateProfileURL(urls.Etcd, "", prfID),
 		NewContent: string(prfBody),
+		Revision:   prf.Revision,
 	}
 	dbRes := c.Update(dbReq)
 	if dbRes.Status != "Success" {
 		log.Error("When update profile in db:", dbRes.Error)
-		return nil, errors.New(dbRes.Error)
+		return nil, updateError(dbRes)
 	}
+	prf.Revision = dbRes.Revision(0)
 	return prf, nil
 }
 
@@ -1669,6 +1887,7 @@ func (c *Client) getVolume(ctx *c.Context, volID string) (*model.VolumeSpec, err
 		log.Error("When parsing volume in db:", dbRes.Error)
 		return nil, errors.New(dbRes.Error)
 	}
+	setRevision(vol.BaseModel, dbRes.Revision(0))
 	return vol, nil
 }
 
@@ -1693,12 +1912,13 @@ func (c *Client) ListVolumes(ctx *c.Context) ([]*model.VolumeSpec, error) {
 	if len(dbRes.Message) == 0 {
 		return vols, nil
 	}
-	for _, msg := range dbRes.Message {
+	for i, msg := range dbRes.Message {
 		var vol = &model.VolumeSpec{}
 		if err := json.Unmarshal([]byte(msg), vol); err != nil {
 			log.Error("When parsing volume in db:", dbRes.Error)
 			return nil, errors.New(dbRes.Error)
 		}
+		setRevision(vol.BaseModel, dbRes.Revision(i))
 		vols = append(vols, vol)
 	}
 	return vols, nil
@@ -1827,10 +2047,22 @@ func (c *Client) ListVolumesWithFilter(ctx *c.Context, m map[string][]string) ([
 
 // UpdateVolume ...
 func (c *Client) UpdateVolume(ctx *c.Context, vol *model.VolumeSpec) (*model.VolumeSpec, error) {
+	var result *model.VolumeSpec
+	err := retryOnConflict(revisionOf(vol.BaseModel), func() (err error) {
+		result, err = c.updateVolume(ctx, vol)
+		return err
+	})
+	return result, err
+}
+
+func (c *Client) updateVolume(ctx *c.Context, vol *model.VolumeSpec) (*model.VolumeSpec, error) {
 	result, err := c.GetVolume(ctx, vol.Id)
 	if err != nil {
 		return nil, err
 	}
+	if err := checkRevision(vol.Id, revisionOf(vol.BaseModel), result.Revision); err != nil {
+		return nil, err
+	}
 	if vol.Name != "" {
 		result.Name = vol.Name
 	}
@@ -1864,6 +2096,9 @@ func (c *Client) UpdateVolume(ctx *c.Context, vol *model.VolumeSpec) (*model.Vol
 	if vol.GroupId != "" {
 		result.GroupId = vol.GroupId
 	}
+	if vol.SnapshotSchedule != nil {
+		result.SnapshotSchedule = vol.SnapshotSchedule
+	}
 
 	// Set update time
 	result.UpdatedAt = time.Now().Format(constants.TimeFormat)
@@ -1881,13 +2116,15 @@ func (c *Client) UpdateVolume(ctx *c.Context, vol *model.VolumeSpec) (*model.Vol
 	dbReq := &Request{
 		Url:        urls.GenerateVolumeURL(urls.Etcd, result.TenantId, vol.Id),
 		NewContent: string(body),
+		Revision:   result.Revision,
 	}
 
 	dbRes := c.Update(dbReq)
 	if dbRes.Status != "Success" {
 		log.Error("When update volume in db:", dbRes.Error)
-		return nil, errors.New(dbRes.Error)
+		return nil, updateError(dbRes)
 	}
+	result.Revision = dbRes.Revision(0)
 	return result, nil
 }
 
@@ -1917,10 +2154,22 @@ func (c *Client) DeleteVolume(ctx *c.Context, volID string) error {
 
 // ExtendVolume ...
 func (c *Client) ExtendVolume(ctx *c.Context, vol *model.VolumeSpec) (*model.VolumeSpec, error) {
+	var result *model.VolumeSpec
+	err := retryOnConflict(revisionOf(vol.BaseModel), func() (err error) {
+		result, err = c.extendVolume(ctx, vol)
+		return err
+	})
+	return result, err
+}
+
+func (c *Client) extendVolume(ctx *c.Context, vol *model.VolumeSpec) (*model.VolumeSpec, error) {
 	result, err := c.GetVolume(ctx, vol.Id)
 	if err != nil {
 		return nil, err
 	}
+	if err := checkRevision(vol.Id, revisionOf(vol.BaseModel), result.Revision); err != nil {
+		return nil, err
+	}
 
 	if vol.Size > 0 {
 		result.Size = vol.Size
@@ -1937,13 +2186,15 @@ func (c *Client) ExtendVolume(ctx *c.Context, vol *model.VolumeSpec) (*model.Vol
 	dbReq := &Request{
 		Url:        urls.GenerateVolumeURL(urls.Etcd, ctx.TenantId, vol.Id),
 		NewContent: string(body),
+		Revision:   result.Revision,
 	}
 
 	dbRes := c.Update(dbReq)
 	if dbRes.Status != "Success" {
 		log.Error("When extend volume in db:", dbRes.Error)
-		return nil, errors.New(dbRes.Error)
+		return nil, updateError(dbRes)
 	}
+	result.Revision = dbRes.Revision(0)
 	return result, nil
 }
 
@@ -2001,6 +2252,7 @@ func (c *Client) getVolumeAttachment(ctx *c.Context, attachmentId string) (*mode
 		log.Error("When parsing volume attachment in db:", dbRes.Error)
 		return nil, errors.New(dbRes.Error)
 	}
+	setRevision(atc.BaseModel, dbRes.Revision(0))
 	return atc, nil
 }
 
@@ -2019,12 +2271,13 @@ func (c *Client) ListVolumeAttachments(ctx *c.Context, volumeId string) ([]*mode
 	}
 
 	var atcs = []*model.VolumeAttachmentSpec{}
-	for _, msg := range dbRes.Message {
+	for i, msg := range dbRes.Message {
 		var atc = &model.VolumeAttachmentSpec{}
 		if err := json.Unmarshal([]byte(msg), atc); err != nil {
 			log.Error("When parsing volume attachment in db:", dbRes.Error)
 			return nil, errors.New(dbRes.Error)
 		}
+		setRevision(atc.BaseModel, dbRes.Revision(i))
 
 		if len(volumeId) == 0 || atc.VolumeId == volumeId {
 			atcs = append(atcs, atc)
@@ -2141,10 +2394,22 @@ func (c *Client) ListVolumeAttachmentsWithFilter(ctx *c.Context, m map[string][]
 
 // UpdateVolumeAttachment
 func (c *Client) UpdateVolumeAttachment(ctx *c.Context, attachmentId string, attachment *model.VolumeAttachmentSpec) (*model.VolumeAttachmentSpec, error) {
+	var result *model.VolumeAttachmentSpec
+	err := retryOnConflict(revisionOf(attachment.BaseModel), func() (err error) {
+		result, err = c.updateVolumeAttachment(ctx, attachmentId, attachment)
+		return err
+	})
+	return result, err
+}
+
+func (c *Client) updateVolumeAttachment(ctx *c.Context, attachmentId string, attachment *model.VolumeAttachmentSpec) (*model.VolumeAttachmentSpec, error) {
 	result, err := c.GetVolumeAttachment(ctx, attachmentId)
 	if err != nil {
 		return nil, err
 	}
+	if err := checkRevision(attachmentId, revisionOf(attachment.BaseModel), result.Revision); err != nil {
+		return nil, err
+	}
 	if len(attachment.Mountpoint) > 0 {
 		result.Mountpoint = attachment.Mountpoint
 	}
@@ -2206,13 +2471,15 @@ func (c *Client) UpdateVolumeAttachment(ctx *c.Context, attachmentId string, att
 	dbReq := &Request{
 		Url:        urls.GenerateAttachmentURL(urls.Etcd, result.TenantId, attachmentId),
 		NewContent: string(atcBody),
+		Revision:   result.Revision,
 	}
 
 	dbRes := c.Update(dbReq)
 	if dbRes.Status != "Success" {
 		log.Error("When update volume attachment in db:", dbRes.Error)
-		return nil, errors.New(dbRes.Error)
+		return nil, updateError(dbRes)
 	}
+	result.Revision = dbRes.Revision(0)
 	return result, nil
 }
 
@@ -2294,6 +2561,7 @@ func (c *Client) getVolumeSnapshot(ctx *c.Context, snpID string) (*model.VolumeS
 		log.Error("When parsing volume snapshot in db:", dbRes.Error)
 		return nil, errors.New(dbRes.Error)
 	}
+	setRevision(vs.BaseModel, dbRes.Revision(0))
 	return vs, nil
 }
 
@@ -2315,12 +2583,13 @@ func (c *Client) ListVolumeSnapshots(ctx *c.Context) ([]*model.VolumeSnapshotSpe
 	if len(dbRes.Message) == 0 {
 		return vss, nil
 	}
-	for _, msg := range dbRes.Message {
+	for i, msg := range dbRes.Message {
 		var vs = &model.VolumeSnapshotSpec{}
 		if err := json.Unmarshal([]byte(msg), vs); err != nil {
 			log.Error("When parsing volume snapshot in db:", dbRes.Error)
 			return nil, errors.New(dbRes.Error)
 		}
+		setRevision(vs.BaseModel, dbRes.Revision(i))
 		vss = append(vss, vs)
 	}
 	return vss, nil
@@ -2436,10 +2705,22 @@ func (c *Client) ListVolumeSnapshotsWithFilter(ctx *c.Context, m map[string][]st
 
 // UpdateVolumeSnapshot
 func (c *Client) UpdateVolumeSnapshot(ctx *c.Context, snpID string, snp *model.VolumeSnapshotSpec) (*model.VolumeSnapshotSpec, error) {
+	var result *model.VolumeSnapshotSpec
+	err := retryOnConflict(revisionOf(snp.BaseModel), func() (err error) {
+		result, err = c.updateVolumeSnapshot(ctx, snpID, snp)
+		return err
+	})
+	return result, err
+}
+
+func (c *Client) updateVolumeSnapshot(ctx *c.Context, snpID string, snp *model.VolumeSnapshotSpec) (*model.VolumeSnapshotSpec, error) {
 	result, err := c.GetVolumeSnapshot(ctx, snpID)
 	if err != nil {
 		return nil, err
 	}
+	if err := checkRevision(snpID, revisionOf(snp.BaseModel), result.Revision); err != nil {
+		return nil, err
+	}
 	if snp.Name != "" {
 		result.Name = snp.Name
 	}
@@ -2474,13 +2755,15 @@ func (c *Client) UpdateVolumeSnapshot(ctx *c.Context, snpID string, snp *model.V
 	dbReq := &Request{
 		Url:        urls.GenerateSnapshotURL(urls.Etcd, result.TenantId, snpID),
 		NewContent: string(atcBody),
+		Revision:   result.Revision,
 	}
 
 	dbRes := c.Update(dbReq)
 	if dbRes.Status != "Success" {
 		log.Error("When update volume snapshot in db:", dbRes.Error)
-		return nil, errors.New(dbRes.Error)
+		return nil, updateError(dbRes)
 	}
+	result.Revision = dbRes.Revision(0)
 	return result, nil
 }
 
@@ -2508,144 +2791,400 @@ func (c *Client) DeleteVolumeSnapshot(ctx *c.Context, snpID string) error {
 	return nil
 }
 
-func (c *Client) CreateReplication(ctx *c.Context, r *model.ReplicationSpec) (*model.ReplicationSpec, error) {
-	if r.Id == "" {
-		r.Id = uuid.NewV4().String()
+func (c *Client) CreateBackup(ctx *c.Context, backup *model.BackupSpec) (*model.BackupSpec, error) {
+	if backup.Id == "" {
+		backup.Id = uuid.NewV4().String()
 	}
 
-	r.TenantId = ctx.TenantId
-	r.CreatedAt = time.Now().Format(constants.TimeFormat)
-	rBody, err := json.Marshal(r)
+	backup.TenantId = ctx.TenantId
+	backup.UserId = ctx.UserId
+	backup.CreatedAt = time.Now().Format(constants.TimeFormat)
+	backupBody, err := json.Marshal(backup)
 	if err != nil {
 		return nil, err
 	}
 
-	req := &Request{
-		Url:     urls.GenerateReplicationURL(urls.Etcd, ctx.TenantId, r.Id),
-		Content: string(rBody),
+	dbReq := &Request{
+		Url:     urls.GenerateBackupURL(urls.Etcd, ctx.TenantId, backup.Id),
+		Content: string(backupBody),
 	}
-	resp := c.Create(req)
-	if resp.Status != "Success" {
-		log.Error("When create replication in db:", resp.Error)
-		return nil, errors.New(resp.Error)
+	dbRes := c.Create(dbReq)
+	if dbRes.Status != "Success" {
+		log.Error("When create volume backup in db:", dbRes.Error)
+		return nil, errors.New(dbRes.Error)
 	}
 
-	return r, nil
+	return backup, nil
 }
 
-func (c *Client) GetReplication(ctx *c.Context, replicationId string) (*model.ReplicationSpec, error) {
-	replication, err := c.getReplication(ctx, replicationId)
+func (c *Client) GetBackup(ctx *c.Context, backupId string) (*model.BackupSpec, error) {
+	backup, err := c.getBackup(ctx, backupId)
 	if !IsAdminContext(ctx) || err == nil {
-		return replication, err
-	}
-	replications, err := c.ListReplication(ctx)
-	if err != nil {
-		return nil, err
-	}
-	for _, r := range replications {
-		if r.Id == replicationId {
-			return r, nil
-		}
+		return backup, err
 	}
-	return nil, fmt.Errorf("specified replication(%s) can't find", replicationId)
-}
-
-func (c *Client) GetReplicationByVolumeId(ctx *c.Context, volumeId string) (*model.ReplicationSpec, error) {
-	replications, err := c.ListReplication(ctx)
+	backups, err := c.ListBackups(ctx)
 	if err != nil {
 		return nil, err
 	}
-
-	for _, r := range replications {
-		if volumeId == r.PrimaryVolumeId || volumeId == r.SecondaryVolumeId {
-			return r, nil
+	for _, b := range backups {
+		if b.Id == backupId {
+			return b, nil
 		}
 	}
-	return nil, model.NewNotFoundError(fmt.Sprintf("can't find specified replication by volume id %s", volumeId))
+	return nil, model.NewNotFoundError(fmt.Sprintf("specified volume backup(%s) can't find", backupId))
 }
 
-func (c *Client) getReplication(ctx *c.Context, replicationId string) (*model.ReplicationSpec, error) {
-	req := &Request{
-		Url: urls.GenerateReplicationURL(urls.Etcd, ctx.TenantId, replicationId),
+func (c *Client) getBackup(ctx *c.Context, backupId string) (*model.BackupSpec, error) {
+	dbReq := &Request{
+		Url: urls.GenerateBackupURL(urls.Etcd, ctx.TenantId, backupId),
 	}
-	resp := c.Get(req)
-	if resp.Status != "Success" {
-		log.Error("When get pool in db:", resp.Error)
-		return nil, errors.New(resp.Error)
+	dbRes := c.Get(dbReq)
+	if dbRes.Status != "Success" {
+		log.Error("When get volume backup in db:", dbRes.Error)
+		return nil, errors.New(dbRes.Error)
 	}
 
-	var r = &model.ReplicationSpec{}
-	if err := json.Unmarshal([]byte(resp.Message[0]), r); err != nil {
-		log.Error("When parsing replication in db:", resp.Error)
-		return nil, errors.New(resp.Error)
+	var backup = &model.BackupSpec{}
+	if err := json.Unmarshal([]byte(dbRes.Message[0]), backup); err != nil {
+		log.Error("When parsing volume backup in db:", dbRes.Error)
+		return nil, errors.New(dbRes.Error)
 	}
-	return r, nil
+	setRevision(backup.BaseModel, dbRes.Revision(0))
+	return backup, nil
 }
 
-func (c *Client) ListReplication(ctx *c.Context) ([]*model.ReplicationSpec, error) {
-	req := &Request{
-		Url: urls.GenerateReplicationURL(urls.Etcd, ctx.TenantId),
+func (c *Client) ListBackups(ctx *c.Context) ([]*model.BackupSpec, error) {
+	dbReq := &Request{
+		Url: urls.GenerateBackupURL(urls.Etcd, ctx.TenantId),
 	}
 	if IsAdminContext(ctx) {
-		req.Url = urls.GenerateReplicationURL(urls.Etcd, "")
+		dbReq.Url = urls.GenerateBackupURL(urls.Etcd, "")
 	}
-	resp := c.List(req)
-	if resp.Status != "Success" {
-		log.Error("When list replication in db:", resp.Error)
-		return nil, errors.New(resp.Error)
+	dbRes := c.List(dbReq)
+	if dbRes.Status != "Success" {
+		log.Error("When list volume backups in db:", dbRes.Error)
+		return nil, errors.New(dbRes.Error)
 	}
 
-	var replicas = []*model.ReplicationSpec{}
-	if len(resp.Message) == 0 {
-		return replicas, nil
+	var backups = []*model.BackupSpec{}
+	if len(dbRes.Message) == 0 {
+		return backups, nil
 	}
-	for _, msg := range resp.Message {
-		var r = &model.ReplicationSpec{}
-		if err := json.Unmarshal([]byte(msg), r); err != nil {
-			log.Error("When parsing replication in db:", resp.Error)
-			return nil, errors.New(resp.Error)
+	for i, msg := range dbRes.Message {
+		var backup = &model.BackupSpec{}
+		if err := json.Unmarshal([]byte(msg), backup); err != nil {
+			log.Error("When parsing volume backup in db:", dbRes.Error)
+			return nil, errors.New(dbRes.Error)
 		}
-		replicas = append(replicas, r)
+		setRevision(backup.BaseModel, dbRes.Revision(i))
+		backups = append(backups, backup)
 	}
-	return replicas, nil
-
+	return backups, nil
 }
 
-func (c *Client) filterByName(param map[string][]string, spec interface{}, filterList map[string]interface{}) bool {
-	v := reflect.ValueOf(spec)
-	if v.Kind() == reflect.Ptr {
-		v = v.Elem()
+func (c *Client) SelectBackups(param map[string][]string, backups []*model.BackupSpec) []*model.BackupSpec {
+	if !c.SelectOrNot(param) {
+		return backups
 	}
-	for key := range param {
-		_, ok := filterList[key]
-		if !ok {
-			continue
-		}
-		filed := v.FieldByName(key)
-		if !filed.IsValid() {
-			continue
-		}
-		paramVal := param[key][0]
-		var val string
-		switch filed.Kind() {
-		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-			val = strconv.FormatInt(filed.Int(), 10)
-		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-			val = strconv.FormatUint(filed.Uint(), 10)
-		case reflect.String:
-			val = filed.String()
-		default:
-			return false
-		}
-		if !strings.EqualFold(paramVal, val) {
-			return false
+
+	filterList := map[string]interface{}{
+		"Id":          nil,
+		"CreatedAt":   nil,
+		"UpdatedAt":   nil,
+		"Name":        nil,
+		"Description": nil,
+		"VolumeId":    nil,
+		"SnapshotId":  nil,
+		"PoolId":      nil,
+		"Size":        nil,
+		"Status":      nil,
+	}
+
+	var backupList = []*model.BackupSpec{}
+	for _, backup := range backups {
+		if c.filterByName(param, backup, filterList) {
+			backupList = append(backupList, backup)
 		}
 	}
-
-	return true
+	return backupList
 }
 
-func (c *Client) SelectReplication(param map[string][]string, replications []*model.ReplicationSpec) []*model.ReplicationSpec {
+type BackupsCompareFunc func(a *model.BackupSpec, b *model.BackupSpec) bool
+
+var backupsCompareFunc BackupsCompareFunc
+
+type BackupSlice []*model.BackupSpec
+
+func (backup BackupSlice) Len() int           { return len(backup) }
+func (backup BackupSlice) Swap(i, j int)      { backup[i], backup[j] = backup[j], backup[i] }
+func (backup BackupSlice) Less(i, j int) bool { return backupsCompareFunc(backup[i], backup[j]) }
+
+var backupSortKey2Func = map[string]BackupsCompareFunc{
+	"ID":         func(a *model.BackupSpec, b *model.BackupSpec) bool { return a.Id > b.Id },
+	"CREATEDAT":  func(a *model.BackupSpec, b *model.BackupSpec) bool { return a.CreatedAt > b.CreatedAt },
+	"NAME":       func(a *model.BackupSpec, b *model.BackupSpec) bool { return a.Name > b.Name },
+	"VOLUMEID":   func(a *model.BackupSpec, b *model.BackupSpec) bool { return a.VolumeId > b.VolumeId },
+	"SNAPSHOTID": func(a *model.BackupSpec, b *model.BackupSpec) bool { return a.SnapshotId > b.SnapshotId },
+	"SIZE":       func(a *model.BackupSpec, b *model.BackupSpec) bool { return a.Size > b.Size },
+	"STATUS":     func(a *model.BackupSpec, b *model.BackupSpec) bool { return a.Status > b.Status },
+	"TENANTID":   func(a *model.BackupSpec, b *model.BackupSpec) bool { return a.TenantId > b.TenantId },
+}
+
+func (c *Client) SortBackups(backups []*model.BackupSpec, p *Parameter) []*model.BackupSpec {
+	backupsCompareFunc = backupSortKey2Func[p.sortKey]
+
+	if strings.EqualFold(p.sortDir, "asc") {
+		sort.Sort(BackupSlice(backups))
+	} else {
+		sort.Sort(sort.Reverse(BackupSlice(backups)))
+	}
+	return backups
+}
+
+func (c *Client) ListBackupsWithFilter(ctx *c.Context, m map[string][]string) ([]*model.BackupSpec, error) {
+	backups, err := c.ListBackups(ctx)
+	if err != nil {
+		log.Error("List volume backups failed: ", err)
+		return nil, err
+	}
+
+	backupList := c.SelectBackups(m, backups)
+
+	var sortKeys []string
+	for k := range backupSortKey2Func {
+		sortKeys = append(sortKeys, k)
+	}
+	p := c.ParameterFilter(m, len(backupList), sortKeys)
+	return c.SortBackups(backupList, p)[p.beginIdx:p.endIdx], nil
+}
+
+func (c *Client) UpdateBackup(ctx *c.Context, backupId string, input *model.BackupSpec) (*model.BackupSpec, error) {
+	var result *model.BackupSpec
+	err := retryOnConflict(revisionOf(input.BaseModel), func() (err error) {
+		result, err = c.updateBackup(ctx, backupId, input)
+		return err
+	})
+	return result, err
+}
+
+func (c *Client) updateBackup(ctx *c.Context, backupId string, input *model.BackupSpec) (*model.BackupSpec, error) {
+	backup, err := c.GetBackup(ctx, backupId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkRevision(backupId, revisionOf(input.BaseModel), backup.Revision); err != nil {
+		return nil, err
+	}
+	if input.Name != "" {
+		backup.Name = input.Name
+	}
+	if input.Description != "" {
+		backup.Description = input.Description
+	}
+	if input.Size > 0 {
+		backup.Size = input.Size
+	}
+	if input.PoolId != "" {
+		backup.PoolId = input.PoolId
+	}
+	if input.BackupDriver != "" {
+		backup.BackupDriver = input.BackupDriver
+	}
+	if input.Status != "" {
+		backup.Status = input.Status
+	}
+	if input.Metadata != nil {
+		backup.Metadata = utils.MergeStringMaps(backup.Metadata, input.Metadata)
+	}
+
+	backup.UpdatedAt = time.Now().Format(constants.TimeFormat)
+
+	b, err := json.Marshal(backup)
+	if err != nil {
+		return nil, err
+	}
+
+	// If an admin want to access other tenant's resource just fake other's tenantId.
+	if !IsAdminContext(ctx) && !AuthorizeProjectContext(ctx, backup.TenantId) {
+		return nil, fmt.Errorf("opertaion is not permitted")
+	}
+
+	dbReq := &Request{
+		Url:        urls.GenerateBackupURL(urls.Etcd, backup.TenantId, backupId),
+		NewContent: string(b),
+		Revision:   backup.Revision,
+	}
+	dbRes := c.Update(dbReq)
+	if dbRes.Status != "Success" {
+		log.Error("When update volume backup in db:", dbRes.Error)
+		return nil, updateError(dbRes)
+	}
+	backup.Revision = dbRes.Revision(0)
+	return backup, nil
+}
+
+func (c *Client) DeleteBackup(ctx *c.Context, backupId string) error {
+	// If an admin want to access other tenant's resource just fake other's tenantId.
+	tenantId := ctx.TenantId
+	if IsAdminContext(ctx) {
+		backup, err := c.GetBackup(ctx, backupId)
+		if err != nil {
+			log.Error(err)
+			return err
+		}
+		tenantId = backup.TenantId
+	}
+	dbReq := &Request{
+		Url: urls.GenerateBackupURL(urls.Etcd, tenantId, backupId),
+	}
+	dbRes := c.Delete(dbReq)
+	if dbRes.Status != "Success" {
+		log.Error("When delete volume backup in db:", dbRes.Error)
+		return errors.New(dbRes.Error)
+	}
+	return nil
+}
+
+func (c *Client) CreateReplication(ctx *c.Context, r *model.ReplicationSpec) (*model.ReplicationSpec, error) {
+	if r.Id == "" {
+		r.Id = uuid.NewV4().String()
+	}
+
+	r.TenantId = ctx.TenantId
+	r.CreatedAt = time.Now().Format(constants.TimeFormat)
+	rBody, err := json.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &Request{
+		Url:     urls.GenerateReplicationURL(urls.Etcd, ctx.TenantId, r.Id),
+		Content: string(rBody),
+	}
+	resp := c.Create(req)
+	if resp.Status != "Success" {
+		log.Error("When create replication in db:", resp.Error)
+		return nil, errors.New(resp.Error)
+	}
+
+	return r, nil
+}
+
+func (c *Client) GetReplication(ctx *c.Context, replicationId string) (*model.ReplicationSpec, error) {
+	replication, err := c.getReplication(ctx, replicationId)
+	if !IsAdminContext(ctx) || err == nil {
+		return replication, err
+	}
+	replications, err := c.ListReplication(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range replications {
+		if r.Id == replicationId {
+			return r, nil
+		}
+	}
+	return nil, fmt.Errorf("specified replication(%s) can't find", replicationId)
+}
+
+func (c *Client) GetReplicationByVolumeId(ctx *c.Context, volumeId string) (*model.ReplicationSpec, error) {
+	replications, err := c.ListReplication(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range replications {
+		if volumeId == r.PrimaryVolumeId || volumeId == r.SecondaryVolumeId {
+			return r, nil
+		}
+	}
+	return nil, model.NewNotFoundError(fmt.Sprintf("can't find specified replication by volume id %s", volumeId))
+}
+
+func (c *Client) getReplication(ctx *c.Context, replicationId string) (*model.ReplicationSpec, error) {
+	req := &Request{
+		Url: urls.GenerateReplicationURL(urls.Etcd, ctx.TenantId, replicationId),
+	}
+	resp := c.Get(req)
+	if resp.Status != "Success" {
+		log.Error("When get pool in db:", resp.Error)
+		return nil, errors.New(resp.Error)
+	}
+
+	var r = &model.ReplicationSpec{}
+	if err := json.Unmarshal([]byte(resp.Message[0]), r); err != nil {
+		log.Error("When parsing replication in db:", resp.Error)
+		return nil, errors.New(resp.Error)
+	}
+	setRevision(r.BaseModel, resp.Revision(0))
+	return r, nil
+}
+
+func (c *Client) ListReplication(ctx *c.Context) ([]*model.ReplicationSpec, error) {
+	req := &Request{
+		Url: urls.GenerateReplicationURL(urls.Etcd, ctx.TenantId),
+	}
+	if IsAdminContext(ctx) {
+		req.Url = urls.GenerateReplicationURL(urls.Etcd, "")
+	}
+	resp := c.List(req)
+	if resp.Status != "Success" {
+		log.Error("When list replication in db:", resp.Error)
+		return nil, errors.New(resp.Error)
+	}
+
+	var replicas = []*model.ReplicationSpec{}
+	if len(resp.Message) == 0 {
+		return replicas, nil
+	}
+	for i, msg := range resp.Message {
+		var r = &model.ReplicationSpec{}
+		if err := json.Unmarshal([]byte(msg), r); err != nil {
+			log.Error("When parsing replication in db:", resp.Error)
+			return nil, errors.New(resp.Error)
+		}
+		setRevision(r.BaseModel, resp.Revision(i))
+		replicas = append(replicas, r)
+	}
+	return replicas, nil
+
+}
+
+func (c *Client) filterByName(param map[string][]string, spec interface{}, filterList map[string]interface{}) bool {
+	v := reflect.ValueOf(spec)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+	for key := range param {
+		_, ok := filterList[key]
+		if !ok {
+			continue
+		}
+		filed := v.FieldByName(key)
+		if !filed.IsValid() {
+			continue
+		}
+		paramVal := param[key][0]
+		var val string
+		switch filed.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			val = strconv.FormatInt(filed.Int(), 10)
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			val = strconv.FormatUint(filed.Uint(), 10)
+		case reflect.String:
+			val = filed.String()
+		default:
+			return false
+		}
+		if !strings.EqualFold(paramVal, val) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (c *Client) SelectReplication(param map[string][]string, replications []*model.ReplicationSpec) []*model.ReplicationSpec {
 	if !c.SelectOrNot(param) {
 		return replications
 	}
@@ -2744,10 +3283,22 @@ func (c *Client) DeleteReplication(ctx *c.Context, replicationId string) error {
 }
 
 func (c *Client) UpdateReplication(ctx *c.Context, replicationId string, input *model.ReplicationSpec) (*model.ReplicationSpec, error) {
+	var result *model.ReplicationSpec
+	err := retryOnConflict(revisionOf(input.BaseModel), func() (err error) {
+		result, err = c.updateReplication(ctx, replicationId, input)
+		return err
+	})
+	return result, err
+}
+
+func (c *Client) updateReplication(ctx *c.Context, replicationId string, input *model.ReplicationSpec) (*model.ReplicationSpec, error) {
 	r, err := c.GetReplication(ctx, replicationId)
 	if err != nil {
 		return nil, err
 	}
+	if err := checkRevision(replicationId, revisionOf(input.BaseModel), r.Revision); err != nil {
+		return nil, err
+	}
 	if input.ProfileId != "" {
 		r.ProfileId = input.ProfileId
 	}
@@ -2783,12 +3334,14 @@ func (c *Client) UpdateReplication(ctx *c.Context, replicationId string, input *
 	req := &Request{
 		Url:        urls.GenerateReplicationURL(urls.Etcd, tenantId, replicationId),
 		NewContent: string(b),
+		Revision:   r.Revision,
 	}
 	resp := c.Update(req)
 	if resp.Status != "Success" {
 		log.Error("When update replication in db:", resp.Error)
-		return nil, errors.New(resp.Error)
+		return nil, updateError(resp)
 	}
+	r.Revision = resp.Revision(0)
 	return r, nil
 }
 func (c *Client) CreateVolumeGroup(ctx *c.Context, vg *model.VolumeGroupSpec) (*model.VolumeGroupSpec, error) {
@@ -2826,14 +3379,27 @@ func (c *Client) GetVolumeGroup(ctx *c.Context, vgId string) (*model.VolumeGroup
 		log.Error("When parsing volume group in db:", dbRes.Error)
 		return nil, errors.New(dbRes.Error)
 	}
+	setRevision(vg.BaseModel, dbRes.Revision(0))
 	return vg, nil
 }
 
 func (c *Client) UpdateVolumeGroup(ctx *c.Context, vgUpdate *model.VolumeGroupSpec) (*model.VolumeGroupSpec, error) {
+	var result *model.VolumeGroupSpec
+	err := retryOnConflict(revisionOf(vgUpdate.BaseModel), func() (err error) {
+		result, err = c.updateVolumeGroup(ctx, vgUpdate)
+		return err
+	})
+	return result, err
+}
+
+func (c *Client) updateVolumeGroup(ctx *c.Context, vgUpdate *model.VolumeGroupSpec) (*model.VolumeGroupSpec, error) {
 	vg, err := c.GetVolumeGroup(ctx, vgUpdate.Id)
 	if err != nil {
 		return nil, err
 	}
+	if err := checkRevision(vgUpdate.Id, revisionOf(vgUpdate.BaseModel), vg.Revision); err != nil {
+		return nil, err
+	}
 	if vgUpdate.Name != "" && vgUpdate.Name != vg.Name {
 		vg.Name = vgUpdate.Name
 	}
@@ -2858,6 +3424,9 @@ func (c *Client) UpdateVolumeGroup(ctx *c.Context, vgUpdate *model.VolumeGroupSp
 	if vgUpdate.UpdatedAt != "" && vgUpdate.UpdatedAt != vg.UpdatedAt {
 		vg.UpdatedAt = vgUpdate.UpdatedAt
 	}
+	if vgUpdate.Metadata != nil {
+		vg.Metadata = utils.MergeStringMaps(vg.Metadata, vgUpdate.Metadata)
+	}
 
 	vgBody, err := json.Marshal(vg)
 	if err != nil {
@@ -2867,16 +3436,65 @@ func (c *Client) UpdateVolumeGroup(ctx *c.Context, vgUpdate *model.VolumeGroupSp
 	dbReq := &Request{
 		Url:        urls.GenerateVolumeGroupURL(urls.Etcd, ctx.TenantId, vgUpdate.Id),
 		NewContent: string(vgBody),
+		Revision:   vg.Revision,
 	}
 	dbRes := c.Update(dbReq)
 	if dbRes.Status != "Success" {
 		log.Error("When update volume group in db:", dbRes.Error)
-		return nil, errors.New(dbRes.Error)
+		return nil, updateError(dbRes)
 	}
+	vg.Revision = dbRes.Revision(0)
 	return vg, nil
 }
 
+// UpdateStatus sets the status of the object. If the object has been modified
+// by somebody else since it was read, the status is applied to the latest
+// version of the object instead.
 func (c *Client) UpdateStatus(ctx *c.Context, in interface{}, status string) error {
+	var err error
+	for i := 0; i < retryNum; i++ {
+		if err = c.updateStatus(ctx, in, status); !model.IsConflictError(err) {
+			return err
+		}
+		if in, err = c.latest(ctx, in); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// latest reads the latest version of the object from db.
+func (c *Client) latest(ctx *c.Context, in interface{}) (interface{}, error) {
+	switch in.(type) {
+	case *model.VolumeSnapshotSpec:
+		return c.GetVolumeSnapshot(ctx, in.(*model.VolumeSnapshotSpec).Id)
+	case *model.BackupSpec:
+		return c.GetBackup(ctx, in.(*model.BackupSpec).Id)
+	case *model.VolumeAttachmentSpec:
+		return c.GetVolumeAttachment(ctx, in.(*model.VolumeAttachmentSpec).Id)
+	case *model.VolumeSpec:
+		return c.GetVolume(ctx, in.(*model.VolumeSpec).Id)
+	case *model.ReplicationSpec:
+		return c.GetReplication(ctx, in.(*model.ReplicationSpec).Id)
+	case *model.VolumeGroupSpec:
+		return c.GetVolumeGroup(ctx, in.(*model.VolumeGroupSpec).Id)
+	case *model.VolumeGroupSnapshotSpec:
+		return c.GetVolumeGroupSnapshot(ctx, in.(*model.VolumeGroupSnapshotSpec).Id)
+	case *model.FileShareSpec:
+		return c.GetFileShare(ctx, in.(*model.FileShareSpec).Id)
+	case *model.FileShareSnapshotSpec:
+		return c.GetFileShareSnapshot(ctx, in.(*model.FileShareSnapshotSpec).Id)
+	case *model.FileShareAclSpec:
+		return c.GetFileShareAcl(ctx, in.(*model.FileShareAclSpec).Id)
+	case *model.DockSpec:
+		return c.GetDock(ctx, in.(*model.DockSpec).Id)
+	case *model.StoragePoolSpec:
+		return c.GetPool(ctx, in.(*model.StoragePoolSpec).Id)
+	}
+	return in, nil
+}
+
+func (c *Client) updateStatus(ctx *c.Context, in interface{}, status string) error {
 	switch in.(type) {
 	case *model.VolumeSnapshotSpec:
 		snap := in.(*model.VolumeSnapshotSpec)
@@ -2886,6 +3504,14 @@ func (c *Client) UpdateStatus(ctx *c.Context, in interface{}, status string) err
 			return errUpdate
 		}
 
+	case *model.BackupSpec:
+		backup := in.(*model.BackupSpec)
+		backup.Status = status
+		if _, errUpdate := c.UpdateBackup(ctx, backup.Id, backup); errUpdate != nil {
+			log.Error("When update volume backup status in db:", errUpdate.Error())
+			return errUpdate
+		}
+
 	case *model.VolumeAttachmentSpec:
 		attm := in.(*model.VolumeAttachmentSpec)
 		attm.Status = status
@@ -2902,6 +3528,14 @@ func (c *Client) UpdateStatus(ctx *c.Context, in interface{}, status string) err
 			return errUpdate
 		}
 
+	case *model.ReplicationSpec:
+		replica := in.(*model.ReplicationSpec)
+		replica.ReplicationStatus = status
+		if _, errUpdate := c.UpdateReplication(ctx, replica.Id, replica); errUpdate != nil {
+			log.Error("When update replication status in db:", errUpdate.Error())
+			return errUpdate
+		}
+
 	case *model.VolumeGroupSpec:
 		vg := in.(*model.VolumeGroupSpec)
 		vg.Status = status
@@ -2910,20 +3544,70 @@ func (c *Client) UpdateStatus(ctx *c.Context, in interface{}, status string) err
 			return errUpdate
 		}
 
-	case []*model.VolumeSpec:
-		vols := in.([]*model.VolumeSpec)
-		if _, errUpdate := c.VolumesToUpdate(ctx, vols); errUpdate != nil {
+	case *model.VolumeGroupSnapshotSpec:
+		gs := in.(*model.VolumeGroupSnapshotSpec)
+		gs.Status = status
+		if _, errUpdate := c.UpdateVolumeGroupSnapshot(ctx, gs.Id, gs); errUpdate != nil {
+			log.Error("When update volume group snapshot status in db:", errUpdate.Error())
 			return errUpdate
 		}
-	}
-	return nil
-}
-
-func (c *Client) ListVolumesByGroupId(ctx *c.Context, vgId string) ([]*model.VolumeSpec, error) {
-	volumes, err := c.ListVolumes(ctx)
-	if err != nil {
-		return nil, err
-	}
+
+	case *model.FileShareSpec:
+		fshare := in.(*model.FileShareSpec)
+		fshare.Status = status
+		if _, errUpdate := c.UpdateFileShare(ctx, fshare); errUpdate != nil {
+			log.Error("When update fileshare status in db:", errUpdate.Error())
+			return errUpdate
+		}
+
+	case *model.FileShareSnapshotSpec:
+		snap := in.(*model.FileShareSnapshotSpec)
+		snap.Status = status
+		if _, errUpdate := c.UpdateFileShareSnapshot(ctx, snap.Id, snap); errUpdate != nil {
+			log.Error("When update fileshare snapshot status in db:", errUpdate.Error())
+			return errUpdate
+		}
+
+	case *model.FileShareAclSpec:
+		acl := in.(*model.FileShareAclSpec)
+		acl.Status = status
+		if _, errUpdate := c.UpdateFileShareAcl(ctx, acl); errUpdate != nil {
+			log.Error("When update fileshare acl status in db:", errUpdate.Error())
+			return errUpdate
+		}
+
+	case *model.DockSpec:
+		dck := in.(*model.DockSpec)
+		dck.Status = status
+		dck.UpdatedAt = time.Now().Format(constants.TimeFormat)
+		if errUpdate := c.putDock(dck); errUpdate != nil {
+			log.Error("When update dock status in db:", errUpdate.Error())
+			return errUpdate
+		}
+
+	case *model.StoragePoolSpec:
+		pol := in.(*model.StoragePoolSpec)
+		pol.Status = status
+		pol.UpdatedAt = time.Now().Format(constants.TimeFormat)
+		if errUpdate := c.putPool(pol); errUpdate != nil {
+			log.Error("When update pool status in db:", errUpdate.Error())
+			return errUpdate
+		}
+
+	case []*model.VolumeSpec:
+		vols := in.([]*model.VolumeSpec)
+		if _, errUpdate := c.VolumesToUpdate(ctx, vols); errUpdate != nil {
+			return errUpdate
+		}
+	}
+	return nil
+}
+
+func (c *Client) ListVolumesByGroupId(ctx *c.Context, vgId string) ([]*model.VolumeSpec, error) {
+	volumes, err := c.ListVolumes(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	var volumesInSameGroup []*model.VolumeSpec
 	for _, v := range volumes {
@@ -2966,12 +3650,13 @@ func (c *Client) ListVolumeGroups(ctx *c.Context) ([]*model.VolumeGroupSpec, err
 	if len(dbRes.Message) == 0 {
 		return groups, nil
 	}
-	for _, msg := range dbRes.Message {
+	for i, msg := range dbRes.Message {
 		var group = &model.VolumeGroupSpec{}
 		if err := json.Unmarshal([]byte(msg), group); err != nil {
 			log.Error("When parsing volume group in db:", dbRes.Error)
 			return nil, errors.New(dbRes.Error)
 		}
+		setRevision(group.BaseModel, dbRes.Revision(i))
 		groups = append(groups, group)
 	}
 	return groups, nil
@@ -3098,3 +3783,925 @@ func (c *Client) SelectVolumeGroup(param map[string][]string, vgs []*model.Volum
 	}
 	return vglist
 }
+
+func (c *Client) CreateVolumeGroupSnapshot(ctx *c.Context, gs *model.VolumeGroupSnapshotSpec) (*model.VolumeGroupSnapshotSpec, error) {
+	if gs.Id == "" {
+		gs.Id = uuid.NewV4().String()
+	}
+
+	gs.TenantId = ctx.TenantId
+	gs.UserId = ctx.UserId
+	gs.CreatedAt = time.Now().Format(constants.TimeFormat)
+	gsBody, err := json.Marshal(gs)
+	if err != nil {
+		return nil, err
+	}
+
+	dbReq := &Request{
+		Url:     urls.GenerateVolumeGroupSnapshotURL(urls.Etcd, ctx.TenantId, gs.Id),
+		Content: string(gsBody),
+	}
+	dbRes := c.Create(dbReq)
+	if dbRes.Status != "Success" {
+		log.Error("When create volume group snapshot in db:", dbRes.Error)
+		return nil, errors.New(dbRes.Error)
+	}
+
+	return gs, nil
+}
+
+func (c *Client) GetVolumeGroupSnapshot(ctx *c.Context, gsId string) (*model.VolumeGroupSnapshotSpec, error) {
+	gs, err := c.getVolumeGroupSnapshot(ctx, gsId)
+	if !IsAdminContext(ctx) || err == nil {
+		return gs, err
+	}
+	gss, err := c.ListVolumeGroupSnapshots(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, g := range gss {
+		if g.Id == gsId {
+			return g, nil
+		}
+	}
+	return nil, model.NewNotFoundError(fmt.Sprintf("specified volume group snapshot(%s) can't find", gsId))
+}
+
+func (c *Client) getVolumeGroupSnapshot(ctx *c.Context, gsId string) (*model.VolumeGroupSnapshotSpec, error) {
+	dbReq := &Request{
+		Url: urls.GenerateVolumeGroupSnapshotURL(urls.Etcd, ctx.TenantId, gsId),
+	}
+	dbRes := c.Get(dbReq)
+	if dbRes.Status != "Success" {
+		log.Error("When get volume group snapshot in db:", dbRes.Error)
+		return nil, errors.New(dbRes.Error)
+	}
+
+	var gs = &model.VolumeGroupSnapshotSpec{}
+	if err := json.Unmarshal([]byte(dbRes.Message[0]), gs); err != nil {
+		log.Error("When parsing volume group snapshot in db:", dbRes.Error)
+		return nil, errors.New(dbRes.Error)
+	}
+	setRevision(gs.BaseModel, dbRes.Revision(0))
+	return gs, nil
+}
+
+func (c *Client) ListVolumeGroupSnapshots(ctx *c.Context) ([]*model.VolumeGroupSnapshotSpec, error) {
+	dbReq := &Request{
+		Url: urls.GenerateVolumeGroupSnapshotURL(urls.Etcd, ctx.TenantId),
+	}
+	if IsAdminContext(ctx) {
+		dbReq.Url = urls.GenerateVolumeGroupSnapshotURL(urls.Etcd, "")
+	}
+	dbRes := c.List(dbReq)
+	if dbRes.Status != "Success" {
+		log.Error("When list volume group snapshots in db:", dbRes.Error)
+		return nil, errors.New(dbRes.Error)
+	}
+
+	var gss = []*model.VolumeGroupSnapshotSpec{}
+	if len(dbRes.Message) == 0 {
+		return gss, nil
+	}
+	for i, msg := range dbRes.Message {
+		var gs = &model.VolumeGroupSnapshotSpec{}
+		if err := json.Unmarshal([]byte(msg), gs); err != nil {
+			log.Error("When parsing volume group snapshot in db:", dbRes.Error)
+			return nil, errors.New(dbRes.Error)
+		}
+		setRevision(gs.BaseModel, dbRes.Revision(i))
+		gss = append(gss, gs)
+	}
+	return gss, nil
+}
+
+func (c *Client) ListGroupSnapshotsByGroupId(ctx *c.Context, vgId string) ([]*model.VolumeGroupSnapshotSpec, error) {
+	gss, err := c.ListVolumeGroupSnapshots(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var gsList []*model.VolumeGroupSnapshotSpec
+	for _, gs := range gss {
+		if gs.GroupId == vgId {
+			gsList = append(gsList, gs)
+		}
+	}
+	return gsList, nil
+}
+
+func (c *Client) UpdateVolumeGroupSnapshot(ctx *c.Context, gsId string, input *model.VolumeGroupSnapshotSpec) (*model.VolumeGroupSnapshotSpec, error) {
+	var result *model.VolumeGroupSnapshotSpec
+	err := retryOnConflict(revisionOf(input.BaseModel), func() (err error) {
+		result, err = c.updateVolumeGroupSnapshot(ctx, gsId, input)
+		return err
+	})
+	return result, err
+}
+
+func (c *Client) updateVolumeGroupSnapshot(ctx *c.Context, gsId string, input *model.VolumeGroupSnapshotSpec) (*model.VolumeGroupSnapshotSpec, error) {
+	gs, err := c.GetVolumeGroupSnapshot(ctx, gsId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkRevision(gsId, revisionOf(input.BaseModel), gs.Revision); err != nil {
+		return nil, err
+	}
+	if input.Name != "" {
+		gs.Name = input.Name
+	}
+	if input.Description != "" {
+		gs.Description = input.Description
+	}
+	if input.Status != "" {
+		gs.Status = input.Status
+	}
+	if input.Snapshots != nil {
+		gs.Snapshots = input.Snapshots
+	}
+	if input.Metadata != nil {
+		gs.Metadata = utils.MergeStringMaps(gs.Metadata, input.Metadata)
+	}
+
+	gs.UpdatedAt = time.Now().Format(constants.TimeFormat)
+
+	b, err := json.Marshal(gs)
+	if err != nil {
+		return nil, err
+	}
+
+	// If an admin want to access other tenant's resource just fake other's tenantId.
+	if !IsAdminContext(ctx) && !AuthorizeProjectContext(ctx, gs.TenantId) {
+		return nil, fmt.Errorf("opertaion is not permitted")
+	}
+
+	dbReq := &Request{
+		Url:        urls.GenerateVolumeGroupSnapshotURL(urls.Etcd, gs.TenantId, gsId),
+		NewContent: string(b),
+		Revision:   gs.Revision,
+	}
+	dbRes := c.Update(dbReq)
+	if dbRes.Status != "Success" {
+		log.Error("When update volume group snapshot in db:", dbRes.Error)
+		return nil, updateError(dbRes)
+	}
+	gs.Revision = dbRes.Revision(0)
+	return gs, nil
+}
+
+func (c *Client) DeleteVolumeGroupSnapshot(ctx *c.Context, gsId string) error {
+	// If an admin want to access other tenant's resource just fake other's tenantId.
+	tenantId := ctx.TenantId
+	if IsAdminContext(ctx) {
+		gs, err := c.GetVolumeGroupSnapshot(ctx, gsId)
+		if err != nil {
+			log.Error(err)
+			return err
+		}
+		tenantId = gs.TenantId
+	}
+	dbReq := &Request{
+		Url: urls.GenerateVolumeGroupSnapshotURL(urls.Etcd, tenantId, gsId),
+	}
+	dbRes := c.Delete(dbReq)
+	if dbRes.Status != "Success" {
+		log.Error("When delete volume group snapshot in db:", dbRes.Error)
+		return errors.New(dbRes.Error)
+	}
+	return nil
+}
+
+func (c *Client) CreateOperation(ctx *c.Context, op *model.OperationSpec) (*model.OperationSpec, error) {
+	if op.Id == "" {
+		op.Id = uuid.NewV4().String()
+	}
+
+	op.TenantId = ctx.TenantId
+	op.UserId = ctx.UserId
+	op.CreatedAt = time.Now().Format(constants.TimeFormat)
+	opBody, err := json.Marshal(op)
+	if err != nil {
+		return nil, err
+	}
+
+	dbReq := &Request{
+		Url:     urls.GenerateOperationURL(urls.Etcd, ctx.TenantId, op.Id),
+		Content: string(opBody),
+	}
+	dbRes := c.Create(dbReq)
+	if dbRes.Status != "Success" {
+		log.Error("When create operation in db:", dbRes.Error)
+		return nil, errors.New(dbRes.Error)
+	}
+
+	return op, nil
+}
+
+func (c *Client) GetOperation(ctx *c.Context, opId string) (*model.OperationSpec, error) {
+	op, err := c.getOperation(ctx, opId)
+	if !IsAdminContext(ctx) || err == nil {
+		return op, err
+	}
+	ops, err := c.ListOperations(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range ops {
+		if o.Id == opId {
+			return o, nil
+		}
+	}
+	return nil, model.NewNotFoundError(fmt.Sprintf("specified operation(%s) can't find", opId))
+}
+
+func (c *Client) getOperation(ctx *c.Context, opId string) (*model.OperationSpec, error) {
+	dbReq := &Request{
+		Url: urls.GenerateOperationURL(urls.Etcd, ctx.TenantId, opId),
+	}
+	dbRes := c.Get(dbReq)
+	if dbRes.Status != "Success" {
+		log.Error("When get operation in db:", dbRes.Error)
+		return nil, errors.New(dbRes.Error)
+	}
+
+	var op = &model.OperationSpec{}
+	if err := json.Unmarshal([]byte(dbRes.Message[0]), op); err != nil {
+		log.Error("When parsing operation in db:", dbRes.Error)
+		return nil, errors.New(dbRes.Error)
+	}
+	setRevision(op.BaseModel, dbRes.Revision(0))
+	return op, nil
+}
+
+func (c *Client) ListOperations(ctx *c.Context) ([]*model.OperationSpec, error) {
+	dbReq := &Request{
+		Url: urls.GenerateOperationURL(urls.Etcd, ctx.TenantId),
+	}
+	if IsAdminContext(ctx) {
+		dbReq.Url = urls.GenerateOperationURL(urls.Etcd, "")
+	}
+	dbRes := c.List(dbReq)
+	if dbRes.Status != "Success" {
+		log.Error("When list operations in db:", dbRes.Error)
+		return nil, errors.New(dbRes.Error)
+	}
+
+	var ops = []*model.OperationSpec{}
+	if len(dbRes.Message) == 0 {
+		return ops, nil
+	}
+	for i, msg := range dbRes.Message {
+		var op = &model.OperationSpec{}
+		if err := json.Unmarshal([]byte(msg), op); err != nil {
+			log.Error("When parsing operation in db:", dbRes.Error)
+			return nil, errors.New(dbRes.Error)
+		}
+		setRevision(op.BaseModel, dbRes.Revision(i))
+		ops = append(ops, op)
+	}
+	return ops, nil
+}
+
+func (c *Client) SelectOperations(param map[string][]string, ops []*model.OperationSpec) []*model.OperationSpec {
+	if !c.SelectOrNot(param) {
+		return ops
+	}
+
+	filterList := map[string]interface{}{
+		"Id":           nil,
+		"CreatedAt":    nil,
+		"UpdatedAt":    nil,
+		"Action":       nil,
+		"ResourceType": nil,
+		"ResourceId":   nil,
+		"DockId":       nil,
+		"Status":       nil,
+	}
+
+	var oplist = []*model.OperationSpec{}
+	for _, op := range ops {
+		if c.filterByName(param, op, filterList) {
+			oplist = append(oplist, op)
+		}
+	}
+	return oplist
+}
+
+type OperationsCompareFunc func(a *model.OperationSpec, b *model.OperationSpec) bool
+
+var operationsCompareFunc OperationsCompareFunc
+
+type OperationSlice []*model.OperationSpec
+
+func (op OperationSlice) Len() int           { return len(op) }
+func (op OperationSlice) Swap(i, j int)      { op[i], op[j] = op[j], op[i] }
+func (op OperationSlice) Less(i, j int) bool { return operationsCompareFunc(op[i], op[j]) }
+
+var operationSortKey2Func = map[string]OperationsCompareFunc{
+	"ID":        func(a *model.OperationSpec, b *model.OperationSpec) bool { return a.Id > b.Id },
+	"CREATEDAT": func(a *model.OperationSpec, b *model.OperationSpec) bool { return a.CreatedAt > b.CreatedAt },
+	"ACTION":    func(a *model.OperationSpec, b *model.OperationSpec) bool { return a.Action > b.Action },
+	"RESOURCETYPE": func(a *model.OperationSpec, b *model.OperationSpec) bool {
+		return a.ResourceType > b.ResourceType
+	},
+	"RESOURCEID": func(a *model.OperationSpec, b *model.OperationSpec) bool { return a.ResourceId > b.ResourceId },
+	"STATUS":     func(a *model.OperationSpec, b *model.OperationSpec) bool { return a.Status > b.Status },
+	"TENANTID":   func(a *model.OperationSpec, b *model.OperationSpec) bool { return a.TenantId > b.TenantId },
+}
+
+func (c *Client) SortOperations(ops []*model.OperationSpec, p *Parameter) []*model.OperationSpec {
+	operationsCompareFunc = operationSortKey2Func[p.sortKey]
+
+	if strings.EqualFold(p.sortDir, "asc") {
+		sort.Sort(OperationSlice(ops))
+	} else {
+		sort.Sort(sort.Reverse(OperationSlice(ops)))
+	}
+	return ops
+}
+
+func (c *Client) ListOperationsWithFilter(ctx *c.Context, m map[string][]string) ([]*model.OperationSpec, error) {
+	ops, err := c.ListOperations(ctx)
+	if err != nil {
+		log.Error("List operations failed: ", err)
+		return nil, err
+	}
+
+	oplist := c.SelectOperations(m, ops)
+
+	var sortKeys []string
+	for k := range operationSortKey2Func {
+		sortKeys = append(sortKeys, k)
+	}
+	p := c.ParameterFilter(m, len(oplist), sortKeys)
+	return c.SortOperations(oplist, p)[p.beginIdx:p.endIdx], nil
+}
+
+func (c *Client) UpdateOperation(ctx *c.Context, opId string, input *model.OperationSpec) (*model.OperationSpec, error) {
+	var result *model.OperationSpec
+	err := retryOnConflict(revisionOf(input.BaseModel), func() (err error) {
+		result, err = c.updateOperation(ctx, opId, input)
+		return err
+	})
+	return result, err
+}
+
+func (c *Client) updateOperation(ctx *c.Context, opId string, input *model.OperationSpec) (*model.OperationSpec, error) {
+	op, err := c.GetOperation(ctx, opId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkRevision(opId, revisionOf(input.BaseModel), op.Revision); err != nil {
+		return nil, err
+	}
+	if input.Request != "" {
+		op.Request = input.Request
+	}
+	if input.DockId != "" {
+		op.DockId = input.DockId
+	}
+	if input.ResourceId != "" {
+		op.ResourceId = input.ResourceId
+	}
+	if input.Status != "" {
+		op.Status = input.Status
+	}
+	if input.ErrorMessage != "" {
+		op.ErrorMessage = input.ErrorMessage
+	}
+
+	op.UpdatedAt = time.Now().Format(constants.TimeFormat)
+
+	b, err := json.Marshal(op)
+	if err != nil {
+		return nil, err
+	}
+	tenantId := ctx.TenantId
+	if IsAdminContext(ctx) {
+		tenantId = op.TenantId
+	}
+	dbReq := &Request{
+		Url:        urls.GenerateOperationURL(urls.Etcd, tenantId, opId),
+		NewContent: string(b),
+		Revision:   op.Revision,
+	}
+	dbRes := c.Update(dbReq)
+	if dbRes.Status != "Success" {
+		log.Error("When update operation in db:", dbRes.Error)
+		return nil, updateError(dbRes)
+	}
+	op.Revision = dbRes.Revision(0)
+	return op, nil
+}
+
+func (c *Client) DeleteOperation(ctx *c.Context, opId string) error {
+	tenantId := ctx.TenantId
+	if IsAdminContext(ctx) {
+		op, err := c.GetOperation(ctx, opId)
+		if err != nil {
+			return err
+		}
+		tenantId = op.TenantId
+	}
+	dbReq := &Request{
+		Url: urls.GenerateOperationURL(urls.Etcd, tenantId, opId),
+	}
+	dbRes := c.Delete(dbReq)
+	if dbRes.Status != "Success" {
+		log.Error("When delete operation in db:", dbRes.Error)
+		return errors.New(dbRes.Error)
+	}
+	return nil
+}
+
+// GetQuota returns the quota set for the tenant explicitly, a NotFoundError
+// is returned if the tenant is limited by the default quota.
+func (c *Client) GetQuota(ctx *c.Context, tenantId string) (*model.QuotaSpec, error) {
+	dbReq := &Request{
+		Url: urls.GenerateQuotaURL(urls.Etcd, "", tenantId),
+	}
+	dbRes := c.Get(dbReq)
+	if dbRes.Status == "NotFound" {
+		return nil, model.NewNotFoundError(fmt.Sprintf("quota of tenant %s is not set", tenantId))
+	}
+	if dbRes.Status != "Success" {
+		log.Error("When get quota in db:", dbRes.Error)
+		return nil, errors.New(dbRes.Error)
+	}
+
+	var quota = &model.QuotaSpec{}
+	if err := json.Unmarshal([]byte(dbRes.Message[0]), quota); err != nil {
+		log.Error("When parsing quota in db:", dbRes.Error)
+		return nil, errors.New(dbRes.Error)
+	}
+	setRevision(quota.BaseModel, dbRes.Revision(0))
+	return quota, nil
+}
+
+// UpdateQuota stores the quota of the tenant, the quota is created if it
+// doesn't exist yet.
+func (c *Client) UpdateQuota(ctx *c.Context, quota *model.QuotaSpec) (*model.QuotaSpec, error) {
+	if quota.BaseModel == nil {
+		quota.BaseModel = &model.BaseModel{}
+	}
+	quota.Id = quota.TenantId
+	if quota.CreatedAt == "" {
+		quota.CreatedAt = time.Now().Format(constants.TimeFormat)
+	}
+	quota.UpdatedAt = time.Now().Format(constants.TimeFormat)
+	// The usage is never stored along with the limits.
+	quota.InUse, quota.Reserved = nil, nil
+
+	b, err := json.Marshal(quota)
+	if err != nil {
+		return nil, err
+	}
+	dbReq := &Request{
+		Url:        urls.GenerateQuotaURL(urls.Etcd, "", quota.TenantId),
+		NewContent: string(b),
+		Revision:   quota.Revision,
+	}
+	dbRes := c.Update(dbReq)
+	if dbRes.Status != "Success" {
+		log.Error("When update quota in db:", dbRes.Error)
+		return nil, updateError(dbRes)
+	}
+	quota.Revision = dbRes.Revision(0)
+	return quota, nil
+}
+
+// GetQuotaUsage returns the resources charged to the tenant, a NotFoundError
+// is returned if nothing has been charged yet.
+func (c *Client) GetQuotaUsage(ctx *c.Context, tenantId string) (*model.QuotaUsageSpec, error) {
+	dbReq := &Request{
+		Url: urls.GenerateQuotaUsageURL(urls.Etcd, "", tenantId),
+	}
+	dbRes := c.Get(dbReq)
+	if dbRes.Status == "NotFound" {
+		return nil, model.NewNotFoundError(fmt.Sprintf("quota usage of tenant %s can't find", tenantId))
+	}
+	if dbRes.Status != "Success" {
+		log.Error("When get quota usage in db:", dbRes.Error)
+		return nil, errors.New(dbRes.Error)
+	}
+
+	var usage = &model.QuotaUsageSpec{}
+	if err := json.Unmarshal([]byte(dbRes.Message[0]), usage); err != nil {
+		log.Error("When parsing quota usage in db:", dbRes.Error)
+		return nil, errors.New(dbRes.Error)
+	}
+	setRevision(usage.BaseModel, dbRes.Revision(0))
+	return usage, nil
+}
+
+// UpdateQuotaUsage stores the resources charged to the tenant, the usage is
+// created if it doesn't exist yet.
+func (c *Client) UpdateQuotaUsage(ctx *c.Context, usage *model.QuotaUsageSpec) (*model.QuotaUsageSpec, error) {
+	if usage.BaseModel == nil {
+		usage.BaseModel = &model.BaseModel{}
+	}
+	usage.Id = usage.TenantId
+	if usage.CreatedAt == "" {
+		usage.CreatedAt = time.Now().Format(constants.TimeFormat)
+	}
+	usage.UpdatedAt = time.Now().Format(constants.TimeFormat)
+
+	b, err := json.Marshal(usage)
+	if err != nil {
+		return nil, err
+	}
+	dbReq := &Request{
+		Url:        urls.GenerateQuotaUsageURL(urls.Etcd, "", usage.TenantId),
+		NewContent: string(b),
+		Revision:   usage.Revision,
+	}
+	dbRes := c.Update(dbReq)
+	if dbRes.Status != "Success" {
+		log.Error("When update quota usage in db:", dbRes.Error)
+		return nil, updateError(dbRes)
+	}
+	usage.Revision = dbRes.Revision(0)
+	return usage, nil
+}
+
+func (c *Client) CreateWebhook(ctx *c.Context, hook *model.WebhookSpec) (*model.WebhookSpec, error) {
+	if hook.BaseModel == nil {
+		hook.BaseModel = &model.BaseModel{}
+	}
+	if hook.Id == "" {
+		hook.Id = uuid.NewV4().String()
+	}
+	hook.TenantId = ctx.TenantId
+	hook.UserId = ctx.UserId
+	hook.CreatedAt = time.Now().Format(constants.TimeFormat)
+
+	b, err := json.Marshal(hook)
+	if err != nil {
+		return nil, err
+	}
+	dbReq := &Request{
+		Url:     urls.GenerateWebhookURL(urls.Etcd, ctx.TenantId, hook.Id),
+		Content: string(b),
+	}
+	dbRes := c.Create(dbReq)
+	if dbRes.Status != "Success" {
+		log.Error("When create webhook in db:", dbRes.Error)
+		return nil, errors.New(dbRes.Error)
+	}
+	return hook, nil
+}
+
+func (c *Client) GetWebhook(ctx *c.Context, hookId string) (*model.WebhookSpec, error) {
+	hook, err := c.getWebhook(ctx, hookId)
+	if !IsAdminContext(ctx) || err == nil {
+		return hook, err
+	}
+	hooks, err := c.ListWebhooks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, h := range hooks {
+		if h.Id == hookId {
+			return h, nil
+		}
+	}
+	return nil, model.NewNotFoundError(fmt.Sprintf("specified webhook(%s) can't find", hookId))
+}
+
+func (c *Client) getWebhook(ctx *c.Context, hookId string) (*model.WebhookSpec, error) {
+	dbReq := &Request{
+		Url: urls.GenerateWebhookURL(urls.Etcd, ctx.TenantId, hookId),
+	}
+	dbRes := c.Get(dbReq)
+	if dbRes.Status == "NotFound" {
+		return nil, model.NewNotFoundError(fmt.Sprintf("specified webhook(%s) can't find", hookId))
+	}
+	if dbRes.Status != "Success" {
+		log.Error("When get webhook in db:", dbRes.Error)
+		return nil, errors.New(dbRes.Error)
+	}
+
+	var hook = &model.WebhookSpec{}
+	if err := json.Unmarshal([]byte(dbRes.Message[0]), hook); err != nil {
+		log.Error("When parsing webhook in db:", dbRes.Error)
+		return nil, errors.New(dbRes.Error)
+	}
+	setRevision(hook.BaseModel, dbRes.Revision(0))
+	return hook, nil
+}
+
+func (c *Client) ListWebhooks(ctx *c.Context) ([]*model.WebhookSpec, error) {
+	dbReq := &Request{
+		Url: urls.GenerateWebhookURL(urls.Etcd, ctx.TenantId),
+	}
+	if IsAdminContext(ctx) {
+		dbReq.Url = urls.GenerateWebhookURL(urls.Etcd, "")
+	}
+	dbRes := c.List(dbReq)
+	if dbRes.Status != "Success" {
+		log.Error("When list webhooks in db:", dbRes.Error)
+		return nil, errors.New(dbRes.Error)
+	}
+
+	var hooks = []*model.WebhookSpec{}
+	for i, msg := range dbRes.Message {
+		var hook = &model.WebhookSpec{}
+		if err := json.Unmarshal([]byte(msg), hook); err != nil {
+			log.Error("When parsing webhook in db:", dbRes.Error)
+			return nil, errors.New(dbRes.Error)
+		}
+		setRevision(hook.BaseModel, dbRes.Revision(i))
+		hooks = append(hooks, hook)
+	}
+	return hooks, nil
+}
+
+// DeleteWebhook deletes the webhook along with its delivery log.
+func (c *Client) DeleteWebhook(ctx *c.Context, hookId string) error {
+	hook, err := c.GetWebhook(ctx, hookId)
+	if err != nil {
+		return err
+	}
+	dbReq := &Request{
+		Url: urls.GenerateWebhookURL(urls.Etcd, hook.TenantId, hookId),
+	}
+	dbRes := c.Delete(dbReq)
+	if dbRes.Status != "Success" {
+		log.Error("When delete webhook in db:", dbRes.Error)
+		return errors.New(dbRes.Error)
+	}
+
+	dlvs, err := c.ListWebhookDeliveries(ctx, hookId)
+	if err != nil {
+		return err
+	}
+	for _, dlv := range dlvs {
+		dbReq := &Request{
+			Url: urls.GenerateWebhookDeliveryURL(urls.Etcd, dlv.TenantId, dlv.Id),
+		}
+		if dbRes := c.Delete(dbReq); dbRes.Status != "Success" {
+			log.Errorf("When delete delivery %s of webhook %s in db: %s", dlv.Id, hookId, dbRes.Error)
+		}
+	}
+	return nil
+}
+
+// CreateWebhookDelivery stores the delivery under the tenant of the webhook,
+// which is not necessarily the tenant of the context.
+func (c *Client) CreateWebhookDelivery(ctx *c.Context, dlv *model.WebhookDeliverySpec) (*model.WebhookDeliverySpec, error) {
+	if dlv.BaseModel == nil {
+		dlv.BaseModel = &model.BaseModel{}
+	}
+	if dlv.Id == "" {
+		dlv.Id = uuid.NewV4().String()
+	}
+	dlv.CreatedAt = time.Now().Format(constants.TimeFormat)
+
+	b, err := json.Marshal(dlv)
+	if err != nil {
+		return nil, err
+	}
+	dbReq := &Request{
+		Url:     urls.GenerateWebhookDeliveryURL(urls.Etcd, dlv.TenantId, dlv.Id),
+		Content: string(b),
+	}
+	dbRes := c.Create(dbReq)
+	if dbRes.Status != "Success" {
+		log.Error("When create webhook delivery in db:", dbRes.Error)
+		return nil, errors.New(dbRes.Error)
+	}
+	return dlv, nil
+}
+
+func (c *Client) UpdateWebhookDelivery(ctx *c.Context, dlvId string, dlv *model.WebhookDeliverySpec) (*model.WebhookDeliverySpec, error) {
+	if dlv.BaseModel == nil {
+		dlv.BaseModel = &model.BaseModel{}
+	}
+	dlv.Id = dlvId
+	dlv.UpdatedAt = time.Now().Format(constants.TimeFormat)
+
+	b, err := json.Marshal(dlv)
+	if err != nil {
+		return nil, err
+	}
+	dbReq := &Request{
+		Url:        urls.GenerateWebhookDeliveryURL(urls.Etcd, dlv.TenantId, dlvId),
+		NewContent: string(b),
+		Revision:   dlv.Revision,
+	}
+	dbRes := c.Update(dbReq)
+	if dbRes.Status != "Success" {
+		log.Error("When update webhook delivery in db:", dbRes.Error)
+		return nil, updateError(dbRes)
+	}
+	dlv.Revision = dbRes.Revision(0)
+	return dlv, nil
+}
+
+// ListWebhookDeliveries returns the delivery log of the webhook.
+func (c *Client) ListWebhookDeliveries(ctx *c.Context, hookId string) ([]*model.WebhookDeliverySpec, error) {
+	dbReq := &Request{
+		Url: urls.GenerateWebhookDeliveryURL(urls.Etcd, ctx.TenantId),
+	}
+	if IsAdminContext(ctx) {
+		dbReq.Url = urls.GenerateWebhookDeliveryURL(urls.Etcd, "")
+	}
+	dbRes := c.List(dbReq)
+	if dbRes.Status != "Success" {
+		log.Error("When list webhook deliveries in db:", dbRes.Error)
+		return nil, errors.New(dbRes.Error)
+	}
+
+	var dlvs = []*model.WebhookDeliverySpec{}
+	for i, msg := range dbRes.Message {
+		var dlv = &model.WebhookDeliverySpec{}
+		if err := json.Unmarshal([]byte(msg), dlv); err != nil {
+			log.Error("When parsing webhook delivery in db:", dbRes.Error)
+			return nil, errors.New(dbRes.Error)
+		}
+		if dlv.WebhookId != hookId {
+			continue
+		}
+		setRevision(dlv.BaseModel, dbRes.Revision(i))
+		dlvs = append(dlvs, dlv)
+	}
+	return dlvs, nil
+}
+
+// CreateAuditRecord appends the record to the audit log, the records aren't
+// scoped by tenants since only admin can read them.
+func (c *Client) CreateAuditRecord(ctx *c.Context, rec *model.AuditRecordSpec) (*model.AuditRecordSpec, error) {
+	if rec.BaseModel == nil {
+		rec.BaseModel = &model.BaseModel{}
+	}
+	if rec.Id == "" {
+		rec.Id = uuid.NewV4().String()
+	}
+	if rec.CreatedAt == "" {
+		rec.CreatedAt = time.Now().Format(constants.TimeFormat)
+	}
+
+	b, err := json.Marshal(rec)
+	if err != nil {
+		return nil, err
+	}
+	dbReq := &Request{
+		Url:     urls.GenerateAuditURL(urls.Etcd, "", rec.Id),
+		Content: string(b),
+	}
+	dbRes := c.Create(dbReq)
+	if dbRes.Status != "Success" {
+		log.Error("When create audit record in db:", dbRes.Error)
+		return nil, errors.New(dbRes.Error)
+	}
+	return rec, nil
+}
+
+// ListAuditRecords returns the audit records newest first. The records can
+// be filtered by tenantId, userId, action, resourceId, requestId and outcome,
+// and by the time range [since, until) of their creation.
+func (c *Client) ListAuditRecords(ctx *c.Context, m map[string][]string) ([]*model.AuditRecordSpec, error) {
+	dbReq := &Request{
+		Url: urls.GenerateAuditURL(urls.Etcd, ""),
+	}
+	dbRes := c.List(dbReq)
+	if dbRes.Status != "Success" {
+		log.Error("When list audit records in db:", dbRes.Error)
+		return nil, errors.New(dbRes.Error)
+	}
+
+	var recs = []*model.AuditRecordSpec{}
+	for _, msg := range dbRes.Message {
+		var rec = &model.AuditRecordSpec{}
+		if err := json.Unmarshal([]byte(msg), rec); err != nil {
+			log.Error("When parsing audit record in db:", dbRes.Error)
+			return nil, errors.New(dbRes.Error)
+		}
+		if matchAuditRecord(rec, m) {
+			recs = append(recs, rec)
+		}
+	}
+	sort.SliceStable(recs, func(i, j int) bool {
+		if recs[i].CreatedAt != recs[j].CreatedAt {
+			return recs[i].CreatedAt > recs[j].CreatedAt
+		}
+		return recs[i].Id > recs[j].Id
+	})
+
+	p := c.ParameterFilter(m, len(recs), nil)
+	return recs[p.beginIdx:p.endIdx], nil
+}
+
+func matchAuditRecord(rec *model.AuditRecordSpec, m map[string][]string) bool {
+	fields := map[string]string{
+		"tenantId":   rec.TenantId,
+		"userId":     rec.UserId,
+		"action":     rec.Action,
+		"resourceId": rec.ResourceId,
+		"requestId":  rec.RequestId,
+		"outcome":    rec.Outcome,
+	}
+	for k, v := range m {
+		if len(v) == 0 || v[0] == "" {
+			continue
+		}
+		switch k {
+		case "since":
+			if rec.CreatedAt < v[0] {
+				return false
+			}
+		case "until":
+			if rec.CreatedAt >= v[0] {
+				return false
+			}
+		default:
+			if field, ok := fields[k]; ok && field != v[0] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// watchURLs holds the url generators of the resources which can be watched.
+var watchURLs = map[string]func(urlType int, tenantId string, in ...string) string{
+	model.WatchResourcePool:            urls.GeneratePoolURL,
+	model.OperationResourceVolume:      urls.GenerateVolumeURL,
+	model.OperationResourceSnapshot:    urls.GenerateSnapshotURL,
+	model.OperationResourceAttachment:  urls.GenerateAttachmentURL,
+	model.OperationResourceReplication: urls.GenerateReplicationURL,
+}
+
+// Watch streams the changes of the resources of the type after the revision,
+// the changes of the resources of other tenants are filtered out unless it's
+// an admin context.
+func (c *Client) Watch(ctx *c.Context, resourceType string, revision int64, stop <-chan struct{}) (<-chan *model.WatchEvent, error) {
+	generate, ok := watchURLs[resourceType]
+	if !ok {
+		return nil, fmt.Errorf("resource type %s can't be watched", resourceType)
+	}
+	// Pools aren't owned by any tenant.
+	tenantId := ctx.TenantId
+	if IsAdminContext(ctx) || resourceType == model.WatchResourcePool {
+		tenantId = ""
+	}
+	// The trailing slash keeps the prefix from matching the keys of other
+	// resources whose name begins with the same one.
+	dbReq := &Request{
+		Url:      generate(urls.Etcd, tenantId) + "/",
+		Revision: revision,
+	}
+
+	dbEvents := c.clientInterface.Watch(dbReq, stop)
+	events := make(chan *model.WatchEvent)
+	go func() {
+		defer close(events)
+		for e := range dbEvents {
+			evt := watchEvent(resourceType, e)
+			select {
+			case events <- evt:
+			case <-stop:
+				return
+			}
+		}
+	}()
+	return events, nil
+}
+
+func watchEvent(resourceType string, e *Event) *model.WatchEvent {
+	evt := &model.WatchEvent{
+		ResourceType:    resourceType,
+		ResourceVersion: e.Revision,
+	}
+	switch e.Type {
+	case EventError:
+		evt.Type = model.WatchError
+		evt.ErrorMessage = e.Error
+		return evt
+	case EventDelete:
+		evt.Type = model.WatchDeleted
+	default:
+		evt.Type = model.WatchModified
+		if e.Created {
+			evt.Type = model.WatchAdded
+		}
+	}
+	evt.Object = withRevision(e.Value, e.Revision)
+	return evt
+}
+
+// withRevision sets the revision of the object stored to the one of the
+// event, since the revision stored is the one before the last update.
+func withRevision(value string, rev int64) json.RawMessage {
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(value), &obj); err != nil {
+		log.Error("When parsing watched object in db:", err)
+		return json.RawMessage(value)
+	}
+	obj["revision"] = json.RawMessage(strconv.FormatInt(rev, 10))
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return json.RawMessage(value)
+	}
+	return b
+}
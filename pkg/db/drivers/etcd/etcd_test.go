@@ -21,6 +21,7 @@ defined in api module.
 package etcd
 
 import (
+	"encoding/json"
 	"reflect"
 	"strings"
 	"testing"
@@ -62,6 +63,21 @@ func (*fakeClientCaller) Get(req *Request) *Response {
 	if strings.Contains(req.Url, "replications") {
 		resp = append(resp, StringSliceReplications[0])
 	}
+	if strings.Contains(req.Url, "operations") {
+		resp = append(resp, StringSliceOperations[0])
+	}
+	if strings.Contains(req.Url, "backups") {
+		resp = append(resp, StringSliceBackups[0])
+	}
+	if strings.Contains(req.Url, "groupSnapshots") {
+		resp = append(resp, StringSliceGroupSnapshots[0])
+	}
+	if strings.Contains(req.Url, "quotas") {
+		resp = append(resp, StringSliceQuotas[0])
+	}
+	if strings.Contains(req.Url, "quotaUsages") {
+		return &Response{Status: "NotFound", Error: "key not found"}
+	}
 	return &Response{
 		Status:  "Success",
 		Message: resp,
@@ -92,6 +108,15 @@ func (*fakeClientCaller) List(req *Request) *Response {
 	if strings.Contains(req.Url, "replications") {
 		resp = StringSliceReplications
 	}
+	if strings.Contains(req.Url, "operations") {
+		resp = StringSliceOperations
+	}
+	if strings.Contains(req.Url, "backups") {
+		resp = StringSliceBackups
+	}
+	if strings.Contains(req.Url, "groupSnapshots") {
+		resp = StringSliceGroupSnapshots
+	}
 	return &Response{
 		Status:  "Success",
 		Message: resp,
@@ -110,6 +135,12 @@ func (*fakeClientCaller) Delete(req *Request) *Response {
 	}
 }
 
+func (*fakeClientCaller) Watch(req *Request, stop <-chan struct{}) <-chan *Event {
+	events := make(chan *Event)
+	close(events)
+	return events
+}
+
 var fc = &Client{
 	clientInterface: &fakeClientCaller{},
 }
@@ -156,6 +187,24 @@ func TestCreateReplication(t *testing.T) {
 	}
 }
 
+func TestCreateVolumeGroupSnapshot(t *testing.T) {
+	if _, err := fc.CreateVolumeGroupSnapshot(c.NewAdminContext(), &model.VolumeGroupSnapshotSpec{BaseModel: &model.BaseModel{}}); err != nil {
+		t.Error("Create volume group snapshot failed:", err)
+	}
+}
+
+func TestCreateBackup(t *testing.T) {
+	if _, err := fc.CreateBackup(c.NewAdminContext(), &model.BackupSpec{BaseModel: &model.BaseModel{}}); err != nil {
+		t.Error("Create volume backup failed:", err)
+	}
+}
+
+func TestCreateOperation(t *testing.T) {
+	if _, err := fc.CreateOperation(c.NewAdminContext(), &model.OperationSpec{BaseModel: &model.BaseModel{}}); err != nil {
+		t.Error("Create operation failed:", err)
+	}
+}
+
 func TestGetDock(t *testing.T) {
 	dck, err := fc.GetDock(c.NewAdminContext(), "")
 	if err != nil {
@@ -364,6 +413,60 @@ func TestUpdateVolume(t *testing.T) {
 	}
 }
 
+// revisionClientCaller stores a volume at revision 5 and counts the updates,
+// the updates always conflict if race is set.
+type revisionClientCaller struct {
+	fakeClientCaller
+	race    bool
+	updates int
+}
+
+func (rc *revisionClientCaller) Get(req *Request) *Response {
+	resp := rc.fakeClientCaller.Get(req)
+	resp.Revisions = []int64{5}
+	return resp
+}
+
+func (rc *revisionClientCaller) Update(req *Request) *Response {
+	rc.updates++
+	if rc.race || req.Revision != 5 {
+		return &Response{Status: "Conflict", Error: "conflict"}
+	}
+	return &Response{Status: "Success", Revisions: []int64{6}}
+}
+
+func TestUpdateVolumeRevision(t *testing.T) {
+	caller := &revisionClientCaller{}
+	cli := &Client{clientInterface: caller}
+	vol := &model.VolumeSpec{
+		BaseModel: &model.BaseModel{Id: "bd5b12a8-a101-11e7-941e-d77981b584d8", Revision: 5},
+		Name:      "Test Name",
+	}
+
+	result, err := cli.UpdateVolume(c.NewAdminContext(), vol)
+	if err != nil {
+		t.Fatal("Update volume failed:", err)
+	}
+	if result.Revision != 6 {
+		t.Errorf("Expected revision %d, got %d", 6, result.Revision)
+	}
+
+	vol.Revision = 4
+	if _, err = cli.UpdateVolume(c.NewAdminContext(), vol); !model.IsConflictError(err) {
+		t.Errorf("Expected a conflict error, got %v", err)
+	}
+
+	// An update without revision is retried when it loses the race.
+	caller.race, caller.updates = true, 0
+	vol.Revision = 0
+	if _, err = cli.UpdateVolume(c.NewAdminContext(), vol); !model.IsConflictError(err) {
+		t.Errorf("Expected a conflict error, got %v", err)
+	}
+	if caller.updates != retryNum {
+		t.Errorf("Expected %d updates, got %d", retryNum, caller.updates)
+	}
+}
+
 func TestListVolumeAttachments(t *testing.T) {
 	m := map[string][]string{
 		"VolumeId": {"bd5b12a8-a101-11e7-941e-d77981b584d8"},
@@ -548,6 +651,173 @@ func TestUpdateReplication(t *testing.T) {
 	}
 }
 
+func TestGetBackup(t *testing.T) {
+	backup, err := fc.GetBackup(c.NewAdminContext(), "5d8c2a5e-5e0f-11e9-8cf1-6f3c2b7e2c11")
+	if err != nil {
+		t.Error("Get volume backup failed:", err)
+	}
+
+	var expected = &SampleBackups[0]
+	if !reflect.DeepEqual(backup, expected) {
+		t.Errorf("Expected %+v, got %+v\n", expected, backup)
+	}
+}
+
+func TestListBackups(t *testing.T) {
+	m := map[string][]string{
+		"offset":  {"0"},
+		"limit":   {"2"},
+		"sortDir": {"desc"},
+		"sortKey": {"name"},
+	}
+	backups, err := fc.ListBackupsWithFilter(c.NewAdminContext(), m)
+	if err != nil {
+		t.Error("List volume backups failed:", err)
+	}
+
+	var expected []*model.BackupSpec
+	for i := range SampleBackups {
+		expected = append(expected, &SampleBackups[i])
+	}
+	if !reflect.DeepEqual(backups, expected) {
+		t.Errorf("Expected %+v, got %+v\n", expected, backups)
+	}
+
+	m = map[string][]string{
+		"SnapshotId": {"3769855c-a102-11e7-b772-17b880d2f537"},
+	}
+	backups, err = fc.ListBackupsWithFilter(c.NewAdminContext(), m)
+	if err != nil {
+		t.Error("List volume backups failed:", err)
+	}
+	expected = []*model.BackupSpec{&SampleBackups[1]}
+	if !reflect.DeepEqual(backups, expected) {
+		t.Errorf("Expected %+v, got %+v\n", expected, backups)
+	}
+}
+
+func TestUpdateBackup(t *testing.T) {
+	var backup = model.BackupSpec{
+		Name:   "Test Name",
+		Status: "error",
+	}
+
+	result, err := fc.UpdateBackup(c.NewAdminContext(), "5d8c2a5e-5e0f-11e9-8cf1-6f3c2b7e2c11", &backup)
+	if err != nil {
+		t.Error("Update volume backup failed:", err)
+	}
+
+	if result.Id != "5d8c2a5e-5e0f-11e9-8cf1-6f3c2b7e2c11" {
+		t.Errorf("Expected %+v, got %+v\n", "5d8c2a5e-5e0f-11e9-8cf1-6f3c2b7e2c11", result.Id)
+	}
+	if result.Name != backup.Name {
+		t.Errorf("Expected %+v, got %+v\n", backup.Name, result.Name)
+	}
+	if result.Status != backup.Status {
+		t.Errorf("Expected %+v, got %+v\n", backup.Status, result.Status)
+	}
+}
+
+func TestGetVolumeGroupSnapshot(t *testing.T) {
+	gs, err := fc.GetVolumeGroupSnapshot(c.NewAdminContext(), "9c8a3d2e-7f41-11e9-a6b2-3f5e8c1d4b90")
+	if err != nil {
+		t.Error("Get volume group snapshot failed:", err)
+	}
+
+	var expected = &SampleGroupSnapshots[0]
+	if !reflect.DeepEqual(gs, expected) {
+		t.Errorf("Expected %+v, got %+v\n", expected, gs)
+	}
+}
+
+func TestListGroupSnapshotsByGroupId(t *testing.T) {
+	gss, err := fc.ListGroupSnapshotsByGroupId(c.NewAdminContext(), "3769855c-a102-11e7-b772-17b880d2f555")
+	if err != nil {
+		t.Error("List volume group snapshots failed:", err)
+	}
+
+	var expected = []*model.VolumeGroupSnapshotSpec{&SampleGroupSnapshots[0]}
+	if !reflect.DeepEqual(gss, expected) {
+		t.Errorf("Expected %+v, got %+v\n", expected, gss)
+	}
+	if gss, _ = fc.ListGroupSnapshotsByGroupId(c.NewAdminContext(), "unknown"); len(gss) != 0 {
+		t.Errorf("Expected no group snapshot, got %+v\n", gss)
+	}
+}
+
+func TestUpdateVolumeGroupSnapshot(t *testing.T) {
+	var gs = model.VolumeGroupSnapshotSpec{
+		Status: "error",
+	}
+
+	result, err := fc.UpdateVolumeGroupSnapshot(c.NewAdminContext(), "9c8a3d2e-7f41-11e9-a6b2-3f5e8c1d4b90", &gs)
+	if err != nil {
+		t.Error("Update volume group snapshot failed:", err)
+	}
+	if result.Status != gs.Status || !reflect.DeepEqual(result.Snapshots, SampleGroupSnapshots[0].Snapshots) {
+		t.Errorf("Unexpected group snapshot after update: %+v\n", result)
+	}
+}
+
+func TestGetOperation(t *testing.T) {
+	op, err := fc.GetOperation(c.NewAdminContext(), "8b3ac17a-4c4f-11e9-9d5b-7b2ad6b2b1ea")
+	if err != nil {
+		t.Error("Get operation failed:", err)
+	}
+
+	var expected = &SampleOperations[0]
+	if !reflect.DeepEqual(op, expected) {
+		t.Errorf("Expected %+v, got %+v\n", expected, op)
+	}
+}
+
+func TestListOperations(t *testing.T) {
+	m := map[string][]string{
+		"offset":  {"0"},
+		"limit":   {"2"},
+		"sortDir": {"desc"},
+		"sortKey": {"action"},
+	}
+	ops, err := fc.ListOperationsWithFilter(c.NewAdminContext(), m)
+	if err != nil {
+		t.Error("List operations failed:", err)
+	}
+
+	var expected []*model.OperationSpec
+	for i := range SampleOperations {
+		expected = append(expected, &SampleOperations[i])
+	}
+	if !reflect.DeepEqual(ops, expected) {
+		t.Errorf("Expected %+v, got %+v\n", expected, ops)
+	}
+}
+
+func TestUpdateOperation(t *testing.T) {
+	var op = model.OperationSpec{
+		DockId:       "076454a8-65da-11e7-9a65-5f5d9b935b9f",
+		Status:       "failed",
+		ErrorMessage: "no available pool",
+	}
+
+	result, err := fc.UpdateOperation(c.NewAdminContext(), "8b3ac17a-4c4f-11e9-9d5b-7b2ad6b2b1ea", &op)
+	if err != nil {
+		t.Error("Update operation failed:", err)
+	}
+
+	if result.Id != "8b3ac17a-4c4f-11e9-9d5b-7b2ad6b2b1ea" {
+		t.Errorf("Expected %+v, got %+v\n", "8b3ac17a-4c4f-11e9-9d5b-7b2ad6b2b1ea", result.Id)
+	}
+	if result.DockId != op.DockId {
+		t.Errorf("Expected %+v, got %+v\n", op.DockId, result.DockId)
+	}
+	if result.Status != op.Status {
+		t.Errorf("Expected %+v, got %+v\n", op.Status, result.Status)
+	}
+	if result.ErrorMessage != op.ErrorMessage {
+		t.Errorf("Expected %+v, got %+v\n", op.ErrorMessage, result.ErrorMessage)
+	}
+}
+
 func TestDeleteDock(t *testing.T) {
 	if err := fc.DeleteDock(c.NewAdminContext(), ""); err != nil {
 		t.Error("Delete dock failed:", err)
@@ -590,6 +860,24 @@ func TestDeleteReplication(t *testing.T) {
 	}
 }
 
+func TestDeleteBackup(t *testing.T) {
+	if err := fc.DeleteBackup(c.NewAdminContext(), ""); err != nil {
+		t.Error("Delete volume backup failed:", err)
+	}
+}
+
+func TestDeleteVolumeGroupSnapshot(t *testing.T) {
+	if err := fc.DeleteVolumeGroupSnapshot(c.NewAdminContext(), ""); err != nil {
+		t.Error("Delete volume group snapshot failed:", err)
+	}
+}
+
+func TestDeleteOperation(t *testing.T) {
+	if err := fc.DeleteOperation(c.NewAdminContext(), ""); err != nil {
+		t.Error("Delete operation failed:", err)
+	}
+}
+
 func TestExtendVolume(t *testing.T) {
 	var vol = model.VolumeSpec{
 		BaseModel: &model.BaseModel{
@@ -613,3 +901,200 @@ func TestExtendVolume(t *testing.T) {
 		t.Errorf("Expected %+v, got %+v\n", 9, result.Size)
 	}
 }
+
+func TestGetQuota(t *testing.T) {
+	quota, err := fc.GetQuota(c.NewAdminContext(), "e93b4c0934da416eb9c8d120c5d04d96")
+	if err != nil {
+		t.Error("Get quota failed:", err)
+	}
+
+	var expected = &SampleQuotas[0]
+	if !reflect.DeepEqual(quota, expected) {
+		t.Errorf("Expected %+v, got %+v\n", expected, quota)
+	}
+}
+
+func TestUpdateQuota(t *testing.T) {
+	caller := &revisionClientCaller{}
+	cli := &Client{clientInterface: caller}
+	quota := &model.QuotaSpec{
+		BaseModel: &model.BaseModel{Revision: 5},
+		TenantId:  "e93b4c0934da416eb9c8d120c5d04d96",
+		QuotaSet:  model.QuotaSet{Volumes: 5},
+		InUse:     &model.QuotaSet{Volumes: 1},
+	}
+
+	result, err := cli.UpdateQuota(c.NewAdminContext(), quota)
+	if err != nil {
+		t.Fatal("Update quota failed:", err)
+	}
+	if result.Id != quota.TenantId || result.Revision != 6 {
+		t.Errorf("Expected quota %s at revision %d, got %s at %d", quota.TenantId, 6, result.Id, result.Revision)
+	}
+	if result.InUse != nil {
+		t.Error("Expected the usage not to be stored with the quota")
+	}
+}
+
+func TestGetQuotaUsage(t *testing.T) {
+	_, err := fc.GetQuotaUsage(c.NewAdminContext(), "e93b4c0934da416eb9c8d120c5d04d96")
+	if _, ok := err.(*model.NotFoundError); !ok {
+		t.Errorf("Expected a not found error, got %v", err)
+	}
+}
+
+// recordClientCaller records the contents written into db.
+type recordClientCaller struct {
+	fakeClientCaller
+	contents []string
+}
+
+func (rc *recordClientCaller) Update(req *Request) *Response {
+	rc.contents = append(rc.contents, req.NewContent)
+	return &Response{Status: "Success"}
+}
+
+func (rc *recordClientCaller) List(req *Request) *Response {
+	if !strings.Contains(req.Url, "webhookDeliveries") {
+		return rc.fakeClientCaller.List(req)
+	}
+	other := SampleWebhookDeliveries[0]
+	other.BaseModel = &model.BaseModel{Id: "other"}
+	other.WebhookId = "other"
+	var msgs []string
+	for _, dlv := range []model.WebhookDeliverySpec{SampleWebhookDeliveries[0], other} {
+		b, _ := json.Marshal(dlv)
+		msgs = append(msgs, string(b))
+	}
+	return &Response{Status: "Success", Message: msgs}
+}
+
+func TestUpdateReplicationStatus(t *testing.T) {
+	caller := &recordClientCaller{}
+	cli := &Client{clientInterface: caller}
+	replica := &model.ReplicationSpec{
+		BaseModel: &model.BaseModel{Id: "c299a978-4f3e-11e8-8a5c-977218a83359"},
+	}
+
+	if err := cli.UpdateStatus(c.NewAdminContext(), replica, model.ReplicationEnabled); err != nil {
+		t.Fatal("Update replication status failed:", err)
+	}
+	if len(caller.contents) != 1 {
+		t.Fatalf("Expected 1 update, got %d", len(caller.contents))
+	}
+	var result model.ReplicationSpec
+	json.Unmarshal([]byte(caller.contents[0]), &result)
+	if result.ReplicationStatus != model.ReplicationEnabled {
+		t.Errorf("Expected status %s, got %s", model.ReplicationEnabled, result.ReplicationStatus)
+	}
+}
+
+func TestListWebhookDeliveries(t *testing.T) {
+	cli := &Client{clientInterface: &recordClientCaller{}}
+	hookId := SampleWebhookDeliveries[0].WebhookId
+
+	dlvs, err := cli.ListWebhookDeliveries(c.NewAdminContext(), hookId)
+	if err != nil {
+		t.Fatal("List webhook deliveries failed:", err)
+	}
+	if len(dlvs) != 1 || dlvs[0].Id != SampleWebhookDeliveries[0].Id {
+		t.Errorf("Expected the deliveries of webhook %s only, got %+v", hookId, dlvs)
+	}
+}
+
+func TestMatchAuditRecord(t *testing.T) {
+	rec := &model.AuditRecordSpec{
+		BaseModel:  &model.BaseModel{CreatedAt: "2019-05-01T09:00:00"},
+		TenantId:   "tenant1",
+		Action:     "volume:delete",
+		ResourceId: "vol1",
+		Outcome:    model.AuditOutcomeDenied,
+	}
+	for _, tc := range []struct {
+		m        map[string][]string
+		expected bool
+	}{
+		{nil, true},
+		{map[string][]string{"resourceId": {"vol1"}, "limit": {"10"}}, true},
+		{map[string][]string{"resourceId": {"vol2"}}, false},
+		{map[string][]string{"since": {"2019-05-01T09:00:00"}, "until": {"2019-05-01T10:00:00"}}, true},
+		{map[string][]string{"until": {"2019-05-01T09:00:00"}}, false},
+		{map[string][]string{"outcome": {"success"}}, false},
+	} {
+		if got := matchAuditRecord(rec, tc.m); got != tc.expected {
+			t.Errorf("Expected %v with filter %v, got %v", tc.expected, tc.m, got)
+		}
+	}
+}
+
+// watchClientCaller replays the events to the watch and records its request.
+type watchClientCaller struct {
+	fakeClientCaller
+	req    *Request
+	events []*Event
+}
+
+func (wc *watchClientCaller) Watch(req *Request, stop <-chan struct{}) <-chan *Event {
+	wc.req = req
+	events := make(chan *Event, len(wc.events))
+	for _, e := range wc.events {
+		events <- e
+	}
+	close(events)
+	return events
+}
+
+func TestWatch(t *testing.T) {
+	vol := `{"id":"bd5b12a8-a101-11e7-941e-d77981b584d8","name":"sample-volume","revision":3}`
+	wc := &watchClientCaller{events: []*Event{
+		{Type: EventPut, Value: vol, Created: true, Revision: 5},
+		{Type: EventPut, Value: vol, Revision: 6},
+		{Type: EventDelete, Value: vol, Revision: 7},
+		{Type: EventError, Error: "required revision has been compacted"},
+	}}
+	cli := &Client{clientInterface: wc}
+
+	ctx := &c.Context{TenantId: "tenant1"}
+	events, err := cli.Watch(ctx, model.OperationResourceVolume, 4, make(chan struct{}))
+	if err != nil {
+		t.Fatal("Watch volumes failed:", err)
+	}
+	var got []*model.WatchEvent
+	for e := range events {
+		got = append(got, e)
+	}
+
+	if wc.req.Url != "v1beta/block/volumes/tenant1/" || wc.req.Revision != 4 {
+		t.Errorf("Unexpected watch request: %+v", wc.req)
+	}
+	expectedTypes := []string{model.WatchAdded, model.WatchModified, model.WatchDeleted, model.WatchError}
+	if len(got) != len(expectedTypes) {
+		t.Fatalf("Expected %d events, got %d", len(expectedTypes), len(got))
+	}
+	for i, e := range got {
+		if e.Type != expectedTypes[i] || e.ResourceType != model.OperationResourceVolume {
+			t.Errorf("Expected %s event of volume, got %+v", expectedTypes[i], e)
+		}
+	}
+	var obj model.VolumeSpec
+	if err := json.Unmarshal(got[1].Object, &obj); err != nil {
+		t.Fatal("Parsing watched volume failed:", err)
+	}
+	if obj.Name != "sample-volume" || obj.Revision != 6 {
+		t.Errorf("Expected the volume of revision 6, got %+v", obj)
+	}
+	if got[3].ErrorMessage == "" {
+		t.Error("Expected the error message of the watch")
+	}
+
+	// Pools aren't owned by tenants, and only the known resources are watched.
+	if _, err := cli.Watch(ctx, model.WatchResourcePool, 0, make(chan struct{})); err != nil {
+		t.Fatal("Watch pools failed:", err)
+	}
+	if wc.req.Url != "v1beta/pools/" {
+		t.Errorf("Unexpected watch request of pools: %+v", wc.req)
+	}
+	if _, err := cli.Watch(ctx, model.OperationResourceBackup, 0, make(chan struct{})); err == nil {
+		t.Error("Expected an error when watching backups")
+	}
+}
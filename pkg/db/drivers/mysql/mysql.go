@@ -14,7 +14,8 @@
 
 /*
 This module implements the mysql database operation of data structure
-defined in api module.
+defined in api module. Sqlite is supported by the same client so that it
+can be used without a database server.
 
 */
 
@@ -22,31 +23,1979 @@ package mysql
 
 import (
 	"database/sql"
-	"sync"
+	"errors"
+	"fmt"
+	"time"
+
+	_ "github.com/go-sql-driver/mysql"
+	log "github.com/golang/glog"
+	_ "github.com/mattn/go-sqlite3"
+	c "github.com/opensds/opensds/pkg/context"
+	"github.com/opensds/opensds/pkg/model"
+	"github.com/opensds/opensds/pkg/utils"
+	"github.com/opensds/opensds/pkg/utils/constants"
+	uuid "github.com/satori/go.uuid"
 )
 
-var c = &client{}
+const retryNum = 3
 
-// Init
-func Init(driver, credential string) *client {
-	// driver equals "mysql"
-	db, err := sql.Open(driver, credential)
+func IsAdminContext(ctx *c.Context) bool {
+	return ctx.IsAdmin
+}
+
+func AuthorizeProjectContext(ctx *c.Context, tenantId string) bool {
+	return ctx.TenantId == tenantId
+}
+
+// revisionOf returns the revision which an update of the object is based on.
+func revisionOf(m *model.BaseModel) int64 {
+	if m == nil {
+		return 0
+	}
+	return m.Revision
+}
+
+// checkRevision makes sure that the object in db still has the revision which
+// the update is based on, zero means the update is based on any revision.
+func checkRevision(id string, expected, actual int64) error {
+	if expected != 0 && expected != actual {
+		return model.NewConflictError(fmt.Sprintf("%s has been modified, current revision is %d", id, actual))
+	}
+	return nil
+}
+
+// retryOnConflict calls fn again when it lost a race with another writer. An
+// update based on a specific revision is never retried, the conflict is
+// returned to the caller which has to decide how to resolve it.
+func retryOnConflict(rev int64, fn func() error) error {
+	if rev != 0 {
+		return fn()
+	}
+	var err error
+	for i := 0; i < retryNum; i++ {
+		if err = fn(); !model.IsConflictError(err) {
+			return err
+		}
+		log.Warningf("Update conflicted with another writer, retry %d", i+1)
+	}
+	return err
+}
+
+// Init opens the database specified by credential and brings its schema up
+// to date, driver is either "mysql" or "sqlite3". For mysql the credential
+// is a dsn like "username:password@tcp(ip:port)/dbname", for sqlite3 it's
+// the path of the database file.
+func Init(driver, credential string) *Client {
+	var cli *Client
+	err := utils.Retry(retryNum, "Get "+driver+" client", false, func(retryIdx int, lastErr error) error {
+		var err error
+		cli, err = NewClient(driver, credential)
+		return err
+	})
 	if err != nil {
-		db.Close()
-		panic(err.Error()) // Just for example purpose. You should use proper error handling instead of panic
+		panic(err)
+	}
+	return cli
+}
+
+// NewClient
+func NewClient(driver, credential string) (*Client, error) {
+	d, ok := dialects[driver]
+	if !ok {
+		return nil, fmt.Errorf("sql driver %s is not supported", driver)
 	}
 
-	// Open doesn't open a connection. Validate DSN data:
+	db, err := sql.Open(driver, credential)
+	if err != nil {
+		return nil, err
+	}
+	// Sqlite allows only one writer at a time, share a single connection to
+	// avoid the "database is locked" errors.
+	if driver == "sqlite3" {
+		db.SetMaxOpenConns(1)
+	}
 	if err = db.Ping(); err != nil {
 		db.Close()
-		panic(err) // proper error handling instead of panic in your app
+		return nil, err
+	}
+	if err = migrate(db, d); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &Client{db: db, dialect: d}, nil
+}
+
+// Client
+type Client struct {
+	db      *sql.DB
+	dialect *dialect
+}
+
+// Close closes the database.
+func (c *Client) Close() error {
+	return c.db.Close()
+}
+
+func newId(id string) string {
+	if id == "" {
+		return uuid.NewV4().String()
+	}
+	return id
+}
+
+// *************   FileShare code block  *************
+
+func (c *Client) CreateFileShareAcl(ctx *c.Context, fshare *model.FileShareAclSpec) (*model.FileShareAclSpec, error) {
+	fshare.Id = newId(fshare.Id)
+	fshare.TenantId = ctx.TenantId
+	if err := c.put(fileShareAclTable, fshare); err != nil {
+		return nil, err
+	}
+	return fshare, nil
+}
+
+func (c *Client) CreateFileShare(ctx *c.Context, fshare *model.FileShareSpec) (*model.FileShareSpec, error) {
+	fshare.Id = newId(fshare.Id)
+	fshare.TenantId = ctx.TenantId
+	if err := c.put(fileShareTable, fshare); err != nil {
+		return nil, err
+	}
+	return fshare, nil
+}
+
+func (c *Client) listFileSharesAcl(ctx *c.Context, m map[string][]string, conds ...condition) ([]*model.FileShareAclSpec, error) {
+	records, err := c.list(ctx, fileShareAclTable, m, conds...)
+	if err != nil {
+		return nil, err
+	}
+	var acls = []*model.FileShareAclSpec{}
+	for _, rec := range records {
+		var acl = &model.FileShareAclSpec{}
+		if err := rec.decode(acl); err != nil {
+			log.Error("When parsing fileshare acl in db:", err)
+			return nil, err
+		}
+		acls = append(acls, acl)
+	}
+	return acls, nil
+}
+
+func (c *Client) ListFileSharesAclWithFilter(ctx *c.Context, m map[string][]string) ([]*model.FileShareAclSpec, error) {
+	return c.listFileSharesAcl(ctx, m)
+}
+
+func (c *Client) ListFileSharesAcl(ctx *c.Context) ([]*model.FileShareAclSpec, error) {
+	return c.listFileSharesAcl(ctx, nil)
+}
+
+func (c *Client) listFileShares(ctx *c.Context, m map[string][]string, conds ...condition) ([]*model.FileShareSpec, error) {
+	records, err := c.list(ctx, fileShareTable, m, conds...)
+	if err != nil {
+		return nil, err
+	}
+	var fshares = []*model.FileShareSpec{}
+	for _, rec := range records {
+		var fshare = &model.FileShareSpec{}
+		if err := rec.decode(fshare); err != nil {
+			log.Error("When parsing fileshare in db:", err)
+			return nil, err
+		}
+		fshares = append(fshares, fshare)
+	}
+	return fshares, nil
+}
+
+func (c *Client) ListFileSharesWithFilter(ctx *c.Context, m map[string][]string) ([]*model.FileShareSpec, error) {
+	return c.listFileShares(ctx, m)
+}
+
+func (c *Client) ListFileShares(ctx *c.Context) ([]*model.FileShareSpec, error) {
+	return c.listFileShares(ctx, nil)
+}
+
+func (c *Client) GetFileShareAcl(ctx *c.Context, aclID string) (*model.FileShareAclSpec, error) {
+	var acl = &model.FileShareAclSpec{}
+	if err := c.get(ctx, fileShareAclTable, aclID, acl); err != nil {
+		return nil, err
+	}
+	return acl, nil
+}
+
+func (c *Client) GetFileShare(ctx *c.Context, fshareID string) (*model.FileShareSpec, error) {
+	var fshare = &model.FileShareSpec{}
+	if err := c.get(ctx, fileShareTable, fshareID, fshare); err != nil {
+		return nil, err
+	}
+	return fshare, nil
+}
+
+func (c *Client) UpdateFileShare(ctx *c.Context, fshare *model.FileShareSpec) (*model.FileShareSpec, error) {
+	var result *model.FileShareSpec
+	err := retryOnConflict(revisionOf(fshare.BaseModel), func() (err error) {
+		result, err = c.updateFileShare(ctx, fshare)
+		return err
+	})
+	return result, err
+}
+
+func (c *Client) updateFileShare(ctx *c.Context, fshare *model.FileShareSpec) (*model.FileShareSpec, error) {
+	result, err := c.GetFileShare(ctx, fshare.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkRevision(fshare.Id, revisionOf(fshare.BaseModel), result.Revision); err != nil {
+		return nil, err
+	}
+	if fshare.Name != "" {
+		result.Name = fshare.Name
+	}
+	if fshare.Description != "" {
+		result.Description = fshare.Description
+	}
+	if fshare.Metadata != nil {
+		result.Metadata = utils.MergeStringMaps(result.Metadata, fshare.Metadata)
+	}
+	if fshare.ExportLocations != nil {
+		result.ExportLocations = fshare.ExportLocations
+	}
+	if fshare.PoolId != "" {
+		result.PoolId = fshare.PoolId
+	}
+	if fshare.ProfileId != "" {
+		result.ProfileId = fshare.ProfileId
+	}
+	if fshare.Status != "" {
+		result.Status = fshare.Status
+	}
+	result.UpdatedAt = time.Now().Format(constants.TimeFormat)
+
+	if err = c.update(fileShareTable, result.Id, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *Client) UpdateFileShareAcl(ctx *c.Context, acl *model.FileShareAclSpec) (*model.FileShareAclSpec, error) {
+	var result *model.FileShareAclSpec
+	err := retryOnConflict(revisionOf(acl.BaseModel), func() (err error) {
+		result, err = c.updateFileShareAcl(ctx, acl)
+		return err
+	})
+	return result, err
+}
+
+func (c *Client) updateFileShareAcl(ctx *c.Context, acl *model.FileShareAclSpec) (*model.FileShareAclSpec, error) {
+	result, err := c.GetFileShareAcl(ctx, acl.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkRevision(acl.Id, revisionOf(acl.BaseModel), result.Revision); err != nil {
+		return nil, err
+	}
+	if acl.Description != "" {
+		result.Description = acl.Description
+	}
+	if acl.Status != "" {
+		result.Status = acl.Status
+	}
+	result.UpdatedAt = time.Now().Format(constants.TimeFormat)
+
+	if err = c.update(fileShareAclTable, result.Id, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *Client) DeleteFileShareAcl(ctx *c.Context, aclID string) error {
+	return c.remove(ctx, fileShareAclTable, aclID)
+}
+
+func (c *Client) DeleteFileShare(ctx *c.Context, fileshareID string) error {
+	return c.remove(ctx, fileShareTable, fileshareID)
+}
+
+func (c *Client) CreateFileShareSnapshot(ctx *c.Context, snp *model.FileShareSnapshotSpec) (*model.FileShareSnapshotSpec, error) {
+	snp.Id = newId(snp.Id)
+	snp.TenantId = ctx.TenantId
+	if err := c.put(fileShareSnapshotTable, snp); err != nil {
+		return nil, err
+	}
+	return snp, nil
+}
+
+func (c *Client) GetFileShareSnapshot(ctx *c.Context, snpID string) (*model.FileShareSnapshotSpec, error) {
+	var snp = &model.FileShareSnapshotSpec{}
+	if err := c.get(ctx, fileShareSnapshotTable, snpID, snp); err != nil {
+		return nil, err
+	}
+	return snp, nil
+}
+
+func (c *Client) listFileShareSnapshots(ctx *c.Context, m map[string][]string, conds ...condition) ([]*model.FileShareSnapshotSpec, error) {
+	records, err := c.list(ctx, fileShareSnapshotTable, m, conds...)
+	if err != nil {
+		return nil, err
+	}
+	var snps = []*model.FileShareSnapshotSpec{}
+	for _, rec := range records {
+		var snp = &model.FileShareSnapshotSpec{}
+		if err := rec.decode(snp); err != nil {
+			log.Error("When parsing fileshare snapshot in db:", err)
+			return nil, err
+		}
+		snps = append(snps, snp)
+	}
+	return snps, nil
+}
+
+func (c *Client) ListFileShareSnapshots(ctx *c.Context) ([]*model.FileShareSnapshotSpec, error) {
+	return c.listFileShareSnapshots(ctx, nil)
+}
+
+func (c *Client) ListFileShareSnapshotsWithFilter(ctx *c.Context, m map[string][]string) ([]*model.FileShareSnapshotSpec, error) {
+	return c.listFileShareSnapshots(ctx, m)
+}
+
+func (c *Client) UpdateFileShareSnapshot(ctx *c.Context, snpID string, snp *model.FileShareSnapshotSpec) (*model.FileShareSnapshotSpec, error) {
+	var result *model.FileShareSnapshotSpec
+	err := retryOnConflict(revisionOf(snp.BaseModel), func() (err error) {
+		result, err = c.updateFileShareSnapshot(ctx, snpID, snp)
+		return err
+	})
+	return result, err
+}
+
+func (c *Client) updateFileShareSnapshot(ctx *c.Context, snpID string, snp *model.FileShareSnapshotSpec) (*model.FileShareSnapshotSpec, error) {
+	result, err := c.GetFileShareSnapshot(ctx, snpID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkRevision(snpID, revisionOf(snp.BaseModel), result.Revision); err != nil {
+		return nil, err
+	}
+	if snp.Name != "" {
+		result.Name = snp.Name
+	}
+	if snp.Description != "" {
+		result.Description = snp.Description
+	}
+	if snp.Status != "" {
+		result.Status = snp.Status
+	}
+	if snp.SnapshotSize != 0 {
+		result.SnapshotSize = snp.SnapshotSize
+	}
+	if snp.Metadata != nil {
+		result.Metadata = utils.MergeStringMaps(result.Metadata, snp.Metadata)
+	}
+	result.UpdatedAt = time.Now().Format(constants.TimeFormat)
+
+	if err = c.update(fileShareSnapshotTable, snpID, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *Client) DeleteFileShareSnapshot(ctx *c.Context, snpID string) error {
+	return c.remove(ctx, fileShareSnapshotTable, snpID)
+}
+
+// *************   Dock code block  *************
+
+func (c *Client) CreateDock(ctx *c.Context, dck *model.DockSpec) (*model.DockSpec, error) {
+	dck.Id = newId(dck.Id)
+	if dck.CreatedAt == "" {
+		dck.CreatedAt = time.Now().Format(constants.TimeFormat)
+	}
+	if err := c.put(dockTable, dck); err != nil {
+		return nil, err
+	}
+	return dck, nil
+}
+
+func (c *Client) GetDock(ctx *c.Context, dckID string) (*model.DockSpec, error) {
+	var dck = &model.DockSpec{}
+	if err := c.get(ctx, dockTable, dckID, dck); err != nil {
+		return nil, err
+	}
+	return dck, nil
+}
+
+func (c *Client) GetDockByPoolId(ctx *c.Context, poolId string) (*model.DockSpec, error) {
+	pool, err := c.GetPool(ctx, poolId)
+	if err != nil {
+		log.Error("Get pool failed in db: ", err)
+		return nil, err
+	}
+	dck, err := c.GetDock(ctx, pool.DockId)
+	if err != nil {
+		log.Error("Get dock failed in db: ", err)
+		return nil, errors.New("Get dock failed by pool id: " + poolId)
+	}
+	return dck, nil
+}
+
+func (c *Client) listDocks(ctx *c.Context, m map[string][]string) ([]*model.DockSpec, error) {
+	records, err := c.list(ctx, dockTable, m)
+	if err != nil {
+		return nil, err
+	}
+	var dcks = []*model.DockSpec{}
+	for _, rec := range records {
+		var dck = &model.DockSpec{}
+		if err := rec.decode(dck); err != nil {
+			log.Error("When parsing dock in db:", err)
+			return nil, err
+		}
+		dcks = append(dcks, dck)
+	}
+	return dcks, nil
+}
+
+func (c *Client) ListDocks(ctx *c.Context) ([]*model.DockSpec, error) {
+	return c.listDocks(ctx, nil)
+}
+
+func (c *Client) ListDocksWithFilter(ctx *c.Context, m map[string][]string) ([]*model.DockSpec, error) {
+	return c.listDocks(ctx, m)
+}
+
+func (c *Client) UpdateDock(ctx *c.Context, dckID, name, desp string) (*model.DockSpec, error) {
+	var result *model.DockSpec
+	err := retryOnConflict(0, func() (err error) {
+		result, err = c.updateDock(ctx, dckID, name, desp)
+		return err
+	})
+	return result, err
+}
+
+func (c *Client) updateDock(ctx *c.Context, dckID, name, desp string) (*model.DockSpec, error) {
+	dck, err := c.GetDock(ctx, dckID)
+	if err != nil {
+		return nil, err
+	}
+	if name != "" {
+		dck.Name = name
+	}
+	if desp != "" {
+		dck.Description = desp
+	}
+	dck.UpdatedAt = time.Now().Format(constants.TimeFormat)
+
+	if err = c.update(dockTable, dckID, dck); err != nil {
+		return nil, err
+	}
+	return dck, nil
+}
+
+func (c *Client) DeleteDock(ctx *c.Context, dckID string) error {
+	return c.remove(ctx, dockTable, dckID)
+}
+
+// *************   Pool code block  *************
+
+func (c *Client) CreatePool(ctx *c.Context, pol *model.StoragePoolSpec) (*model.StoragePoolSpec, error) {
+	pol.Id = newId(pol.Id)
+	if pol.CreatedAt == "" {
+		pol.CreatedAt = time.Now().Format(constants.TimeFormat)
+	}
+	if err := c.put(poolTable, pol); err != nil {
+		return nil, err
+	}
+	return pol, nil
+}
+
+func (c *Client) GetPool(ctx *c.Context, polID string) (*model.StoragePoolSpec, error) {
+	var pol = &model.StoragePoolSpec{}
+	if err := c.get(ctx, poolTable, polID, pol); err != nil {
+		return nil, err
+	}
+	return pol, nil
+}
+
+func (c *Client) ListAvailabilityZones(ctx *c.Context) ([]string, error) {
+	rows, err := c.db.Query("SELECT DISTINCT availability_zone FROM " + poolTable.name + " ORDER BY availability_zone")
+	if err != nil {
+		log.Error("Failed to get AZ for pools in db:", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var azs = []string{}
+	for rows.Next() {
+		var az sql.NullString
+		if err = rows.Scan(&az); err != nil {
+			log.Error("Failed to get AZ for pools in db:", err)
+			return nil, err
+		}
+		azs = append(azs, az.String)
+	}
+	// Null and empty zones are the same one.
+	return utils.RvRepElement(azs), rows.Err()
+}
+
+func (c *Client) listPools(ctx *c.Context, m map[string][]string) ([]*model.StoragePoolSpec, error) {
+	records, err := c.list(ctx, poolTable, m)
+	if err != nil {
+		return nil, err
+	}
+	var pols = []*model.StoragePoolSpec{}
+	for _, rec := range records {
+		var pol = &model.StoragePoolSpec{}
+		if err := rec.decode(pol); err != nil {
+			log.Error("When parsing pool in db:", err)
+			return nil, err
+		}
+		pols = append(pols, pol)
+	}
+	return pols, nil
+}
+
+func (c *Client) ListPools(ctx *c.Context) ([]*model.StoragePoolSpec, error) {
+	return c.listPools(ctx, nil)
+}
+
+func (c *Client) ListPoolsWithFilter(ctx *c.Context, m map[string][]string) ([]*model.StoragePoolSpec, error) {
+	return c.listPools(ctx, m)
+}
+
+func (c *Client) UpdatePool(ctx *c.Context, polID, name, desp string, usedCapacity int64, used bool) (*model.StoragePoolSpec, error) {
+	var result *model.StoragePoolSpec
+	err := retryOnConflict(0, func() (err error) {
+		result, err = c.updatePool(ctx, polID, name, desp, usedCapacity, used)
+		return err
+	})
+	return result, err
+}
+
+func (c *Client) updatePool(ctx *c.Context, polID, name, desp string, usedCapacity int64, used bool) (*model.StoragePoolSpec, error) {
+	pol, err := c.GetPool(ctx, polID)
+	if err != nil {
+		return nil, err
+	}
+	if name != "" {
+		pol.Name = name
+	}
+	if desp != "" {
+		pol.Description = desp
 	}
+	pol.UpdatedAt = time.Now().Format(constants.TimeFormat)
 
-	c.cli = db
-	return c
+	if err = c.update(poolTable, polID, pol); err != nil {
+		return nil, err
+	}
+	return pol, nil
+}
+
+func (c *Client) DeletePool(ctx *c.Context, polID string) error {
+	return c.remove(ctx, poolTable, polID)
+}
+
+// *************   Profile code block  *************
+
+func (c *Client) CreateProfile(ctx *c.Context, prf *model.ProfileSpec) (*model.ProfileSpec, error) {
+	prf.Id = newId(prf.Id)
+	if prf.CreatedAt == "" {
+		prf.CreatedAt = time.Now().Format(constants.TimeFormat)
+	}
+	if err := c.put(profileTable, prf); err != nil {
+		return nil, err
+	}
+	return prf, nil
+}
+
+func (c *Client) GetProfile(ctx *c.Context, prfID string) (*model.ProfileSpec, error) {
+	var prf = &model.ProfileSpec{}
+	if err := c.get(ctx, profileTable, prfID, prf); err != nil {
+		return nil, err
+	}
+	return prf, nil
+}
+
+func (c *Client) GetDefaultProfile(ctx *c.Context) (*model.ProfileSpec, error) {
+	profiles, err := c.listProfiles(ctx, nil, eq("name", "default"))
+	if err != nil {
+		log.Error("Get default profile failed in db: ", err)
+		return nil, err
+	}
+	// The name column is case-insensitive, but the name of default profile
+	// isn't.
+	for _, profile := range profiles {
+		if profile.Name == "default" {
+			return profile, nil
+		}
+	}
+	return nil, errors.New("No default profile in db.")
+}
+
+func (c *Client) listProfiles(ctx *c.Context, m map[string][]string, conds ...condition) ([]*model.ProfileSpec, error) {
+	records, err := c.list(ctx, profileTable, m, conds...)
+	if err != nil {
+		return nil, err
+	}
+	var prfs = []*model.ProfileSpec{}
+	for _, rec := range records {
+		var prf = &model.ProfileSpec{}
+		if err := rec.decode(prf); err != nil {
+			log.Error("When parsing profile in db:", err)
+			return nil, err
+		}
+		prfs = append(prfs, prf)
+	}
+	return prfs, nil
+}
+
+func (c *Client) ListProfiles(ctx *c.Context) ([]*model.ProfileSpec, error) {
+	return c.listProfiles(ctx, nil)
+}
+
+func (c *Client) ListProfilesWithFilter(ctx *c.Context, m map[string][]string) ([]*model.ProfileSpec, error) {
+	return c.listProfiles(ctx, m)
+}
+
+func (c *Client) UpdateProfile(ctx *c.Context, prfID string, input *model.ProfileSpec) (*model.ProfileSpec, error) {
+	var result *model.ProfileSpec
+	err := retryOnConflict(revisionOf(input.BaseModel), func() (err error) {
+		result, err = c.updateProfile(ctx, prfID, input)
+		return err
+	})
+	return result, err
+}
+
+func (c *Client) updateProfile(ctx *c.Context, prfID string, input *model.ProfileSpec) (*model.ProfileSpec, error) {
+	prf, err := c.GetProfile(ctx, prfID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkRevision(prfID, revisionOf(input.BaseModel), prf.Revision); err != nil {
+		return nil, err
+	}
+	if name := input.Name; name != "" {
+		prf.Name = name
+	}
+	if desp := input.Description; desp != "" {
+		prf.Description = desp
+	}
+	if props := input.CustomProperties; len(props) != 0 {
+		if prf.CustomProperties == nil {
+			prf.CustomProperties = make(map[string]interface{})
+		}
+		for k, v := range props {
+			prf.CustomProperties[k] = v
+		}
+	}
+	prf.UpdatedAt = time.Now().Format(constants.TimeFormat)
+
+	if err = c.update(profileTable, prfID, prf); err != nil {
+		return nil, err
+	}
+	return prf, nil
+}
+
+func (c *Client) DeleteProfile(ctx *c.Context, prfID string) error {
+	return c.remove(ctx, profileTable, prfID)
+}
+
+func (c *Client) AddCustomProperty(ctx *c.Context, prfID string, ext model.CustomPropertiesSpec) (*model.CustomPropertiesSpec, error) {
+	var prf *model.ProfileSpec
+	err := retryOnConflict(0, func() (err error) {
+		if prf, err = c.GetProfile(ctx, prfID); err != nil {
+			return err
+		}
+		if prf.CustomProperties == nil {
+			prf.CustomProperties = make(map[string]interface{})
+		}
+		for k, v := range ext {
+			prf.CustomProperties[k] = v
+		}
+		prf.UpdatedAt = time.Now().Format(constants.TimeFormat)
+		return c.update(profileTable, prfID, prf)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &prf.CustomProperties, nil
+}
+
+func (c *Client) ListCustomProperties(ctx *c.Context, prfID string) (*model.CustomPropertiesSpec, error) {
+	prf, err := c.GetProfile(ctx, prfID)
+	if err != nil {
+		return nil, err
+	}
+	return &prf.CustomProperties, nil
+}
+
+func (c *Client) RemoveCustomProperty(ctx *c.Context, prfID, customKey string) error {
+	return retryOnConflict(0, func() error {
+		prf, err := c.GetProfile(ctx, prfID)
+		if err != nil {
+			return err
+		}
+		delete(prf.CustomProperties, customKey)
+		return c.update(profileTable, prfID, prf)
+	})
+}
+
+// *************   Volume code block  *************
+
+func (c *Client) CreateVolume(ctx *c.Context, vol *model.VolumeSpec) (*model.VolumeSpec, error) {
+	var count int
+	if err := c.db.QueryRow("SELECT COUNT(*) FROM " + profileTable.name).Scan(&count); err != nil {
+		return nil, err
+	} else if count == 0 {
+		return nil, errors.New("No profile in db.")
+	}
+
+	vol.Id = newId(vol.Id)
+	vol.TenantId = ctx.TenantId
+	if err := c.put(volumeTable, vol); err != nil {
+		return nil, err
+	}
+	return vol, nil
+}
+
+func (c *Client) GetVolume(ctx *c.Context, volID string) (*model.VolumeSpec, error) {
+	var vol = &model.VolumeSpec{}
+	if err := c.get(ctx, volumeTable, volID, vol); err != nil {
+		return nil, err
+	}
+	return vol, nil
+}
+
+func (c *Client) listVolumes(ctx *c.Context, m map[string][]string, conds ...condition) ([]*model.VolumeSpec, error) {
+	records, err := c.list(ctx, volumeTable, m, conds...)
+	if err != nil {
+		return nil, err
+	}
+	var vols = []*model.VolumeSpec{}
+	for _, rec := range records {
+		var vol = &model.VolumeSpec{}
+		if err := rec.decode(vol); err != nil {
+			log.Error("When parsing volume in db:", err)
+			return nil, err
+		}
+		vols = append(vols, vol)
+	}
+	return vols, nil
+}
+
+func (c *Client) ListVolumes(ctx *c.Context) ([]*model.VolumeSpec, error) {
+	return c.listVolumes(ctx, nil)
+}
+
+func (c *Client) ListVolumesWithFilter(ctx *c.Context, m map[string][]string) ([]*model.VolumeSpec, error) {
+	return c.listVolumes(ctx, m)
+}
+
+func (c *Client) ListVolumesByGroupId(ctx *c.Context, vgId string) ([]*model.VolumeSpec, error) {
+	return c.listVolumes(ctx, nil, eq("group_id", vgId))
+}
+
+func (c *Client) UpdateVolume(ctx *c.Context, vol *model.VolumeSpec) (*model.VolumeSpec, error) {
+	var result *model.VolumeSpec
+	err := retryOnConflict(revisionOf(vol.BaseModel), func() (err error) {
+		result, err = c.updateVolume(ctx, vol)
+		return err
+	})
+	return result, err
+}
+
+func (c *Client) updateVolume(ctx *c.Context, vol *model.VolumeSpec) (*model.VolumeSpec, error) {
+	result, err := c.GetVolume(ctx, vol.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkRevision(vol.Id, revisionOf(vol.BaseModel), result.Revision); err != nil {
+		return nil, err
+	}
+	if vol.Name != "" {
+		result.Name = vol.Name
+	}
+	if vol.AvailabilityZone != "" {
+		result.AvailabilityZone = vol.AvailabilityZone
+	}
+	if vol.Description != "" {
+		result.Description = vol.Description
+	}
+	if vol.Metadata != nil {
+		result.Metadata = utils.MergeStringMaps(result.Metadata, vol.Metadata)
+	}
+	if vol.PoolId != "" {
+		result.PoolId = vol.PoolId
+	}
+	if vol.ProfileId != "" {
+		result.ProfileId = vol.ProfileId
+	}
+	if vol.Size != 0 {
+		result.Size = vol.Size
+	}
+	if vol.Status != "" {
+		result.Status = vol.Status
+	}
+	if vol.ReplicationDriverData != nil {
+		result.ReplicationDriverData = vol.ReplicationDriverData
+	}
+	if vol.MultiAttach {
+		result.MultiAttach = vol.MultiAttach
+	}
+	if vol.GroupId != "" {
+		result.GroupId = vol.GroupId
+	}
+	if vol.SnapshotSchedule != nil {
+		result.SnapshotSchedule = vol.SnapshotSchedule
+	}
+	result.UpdatedAt = time.Now().Format(constants.TimeFormat)
+
+	if err = c.update(volumeTable, vol.Id, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *Client) DeleteVolume(ctx *c.Context, volID string) error {
+	return c.remove(ctx, volumeTable, volID)
+}
+
+func (c *Client) ExtendVolume(ctx *c.Context, vol *model.VolumeSpec) (*model.VolumeSpec, error) {
+	var result *model.VolumeSpec
+	err := retryOnConflict(revisionOf(vol.BaseModel), func() (err error) {
+		result, err = c.extendVolume(ctx, vol)
+		return err
+	})
+	return result, err
+}
+
+func (c *Client) extendVolume(ctx *c.Context, vol *model.VolumeSpec) (*model.VolumeSpec, error) {
+	result, err := c.GetVolume(ctx, vol.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkRevision(vol.Id, revisionOf(vol.BaseModel), result.Revision); err != nil {
+		return nil, err
+	}
+	if vol.Size > 0 {
+		result.Size = vol.Size
+	}
+	result.Status = vol.Status
+	result.UpdatedAt = time.Now().Format(constants.TimeFormat)
+
+	if err = c.update(volumeTable, vol.Id, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *Client) VolumesToUpdate(ctx *c.Context, volumeList []*model.VolumeSpec) ([]*model.VolumeSpec, error) {
+	var volumeRefs []*model.VolumeSpec
+	for _, values := range volumeList {
+		v, err := c.UpdateVolume(ctx, values)
+		if err != nil {
+			return nil, err
+		}
+		volumeRefs = append(volumeRefs, v)
+	}
+	return volumeRefs, nil
+}
+
+// *************   Volume attachment code block  *************
+
+func (c *Client) CreateVolumeAttachment(ctx *c.Context, attachment *model.VolumeAttachmentSpec) (*model.VolumeAttachmentSpec, error) {
+	attachment.Id = newId(attachment.Id)
+	attachment.TenantId = ctx.TenantId
+	if err := c.put(attachmentTable, attachment); err != nil {
+		return nil, err
+	}
+	return attachment, nil
+}
+
+func (c *Client) GetVolumeAttachment(ctx *c.Context, attachmentId string) (*model.VolumeAttachmentSpec, error) {
+	var atc = &model.VolumeAttachmentSpec{}
+	if err := c.get(ctx, attachmentTable, attachmentId, atc); err != nil {
+		return nil, err
+	}
+	return atc, nil
+}
+
+func (c *Client) listVolumeAttachments(ctx *c.Context, m map[string][]string, conds ...condition) ([]*model.VolumeAttachmentSpec, error) {
+	records, err := c.list(ctx, attachmentTable, m, conds...)
+	if err != nil {
+		return nil, err
+	}
+	var atcs = []*model.VolumeAttachmentSpec{}
+	for _, rec := range records {
+		var atc = &model.VolumeAttachmentSpec{}
+		if err := rec.decode(atc); err != nil {
+			log.Error("When parsing volume attachment in db:", err)
+			return nil, err
+		}
+		atcs = append(atcs, atc)
+	}
+	return atcs, nil
+}
+
+func (c *Client) ListVolumeAttachments(ctx *c.Context, volumeId string) ([]*model.VolumeAttachmentSpec, error) {
+	if len(volumeId) == 0 {
+		return c.listVolumeAttachments(ctx, nil)
+	}
+	return c.listVolumeAttachments(ctx, nil, eq("volume_id", volumeId))
+}
+
+func (c *Client) ListVolumeAttachmentsWithFilter(ctx *c.Context, m map[string][]string) ([]*model.VolumeAttachmentSpec, error) {
+	return c.listVolumeAttachments(ctx, m)
+}
+
+func (c *Client) ListAttachmentsByVolumeId(ctx *c.Context, volumeId string) ([]*model.VolumeAttachmentSpec, error) {
+	return c.ListVolumeAttachments(ctx, volumeId)
+}
+
+func (c *Client) UpdateVolumeAttachment(ctx *c.Context, attachmentId string, attachment *model.VolumeAttachmentSpec) (*model.VolumeAttachmentSpec, error) {
+	var result *model.VolumeAttachmentSpec
+	err := retryOnConflict(revisionOf(attachment.BaseModel), func() (err error) {
+		result, err = c.updateVolumeAttachment(ctx, attachmentId, attachment)
+		return err
+	})
+	return result, err
+}
+
+func (c *Client) updateVolumeAttachment(ctx *c.Context, attachmentId string, attachment *model.VolumeAttachmentSpec) (*model.VolumeAttachmentSpec, error) {
+	result, err := c.GetVolumeAttachment(ctx, attachmentId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkRevision(attachmentId, revisionOf(attachment.BaseModel), result.Revision); err != nil {
+		return nil, err
+	}
+	if len(attachment.Mountpoint) > 0 {
+		result.Mountpoint = attachment.Mountpoint
+	}
+	if len(attachment.Status) > 0 {
+		result.Status = attachment.Status
+	}
+	if len(attachment.Platform) > 0 {
+		result.Platform = attachment.Platform
+	}
+	if len(attachment.OsType) > 0 {
+		result.OsType = attachment.OsType
+	}
+	if len(attachment.Ip) > 0 {
+		result.Ip = attachment.Ip
+	}
+	if len(attachment.Host) > 0 {
+		result.Host = attachment.Host
+	}
+	if len(attachment.Initiator) > 0 {
+		result.Initiator = attachment.Initiator
+	}
+	if len(attachment.DriverVolumeType) > 0 {
+		result.DriverVolumeType = attachment.DriverVolumeType
+	}
+	if len(attachment.AccessProtocol) > 0 {
+		result.AccessProtocol = attachment.AccessProtocol
+	}
+	if attachment.Metadata != nil {
+		result.Metadata = utils.MergeStringMaps(result.Metadata, attachment.Metadata)
+	}
+	if attachment.ConnectionData != nil {
+		if result.ConnectionData == nil {
+			result.ConnectionData = make(map[string]interface{})
+		}
+		for k, v := range attachment.ConnectionData {
+			result.ConnectionData[k] = v
+		}
+	}
+	result.UpdatedAt = time.Now().Format(constants.TimeFormat)
+
+	if err = c.update(attachmentTable, attachmentId, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *Client) DeleteVolumeAttachment(ctx *c.Context, attachmentId string) error {
+	return c.remove(ctx, attachmentTable, attachmentId)
+}
+
+// *************   Volume snapshot code block  *************
+
+func (c *Client) CreateVolumeSnapshot(ctx *c.Context, snp *model.VolumeSnapshotSpec) (*model.VolumeSnapshotSpec, error) {
+	snp.Id = newId(snp.Id)
+	snp.TenantId = ctx.TenantId
+	if err := c.put(snapshotTable, snp); err != nil {
+		return nil, err
+	}
+	return snp, nil
+}
+
+func (c *Client) GetVolumeSnapshot(ctx *c.Context, snpID string) (*model.VolumeSnapshotSpec, error) {
+	var snp = &model.VolumeSnapshotSpec{}
+	if err := c.get(ctx, snapshotTable, snpID, snp); err != nil {
+		return nil, err
+	}
+	return snp, nil
+}
+
+func (c *Client) listVolumeSnapshots(ctx *c.Context, m map[string][]string, conds ...condition) ([]*model.VolumeSnapshotSpec, error) {
+	records, err := c.list(ctx, snapshotTable, m, conds...)
+	if err != nil {
+		return nil, err
+	}
+	var snps = []*model.VolumeSnapshotSpec{}
+	for _, rec := range records {
+		var snp = &model.VolumeSnapshotSpec{}
+		if err := rec.decode(snp); err != nil {
+			log.Error("When parsing volume snapshot in db:", err)
+			return nil, err
+		}
+		snps = append(snps, snp)
+	}
+	return snps, nil
+}
+
+func (c *Client) ListVolumeSnapshots(ctx *c.Context) ([]*model.VolumeSnapshotSpec, error) {
+	return c.listVolumeSnapshots(ctx, nil)
+}
+
+func (c *Client) ListVolumeSnapshotsWithFilter(ctx *c.Context, m map[string][]string) ([]*model.VolumeSnapshotSpec, error) {
+	return c.listVolumeSnapshots(ctx, m)
+}
+
+func (c *Client) ListSnapshotsByVolumeId(ctx *c.Context, volumeId string) ([]*model.VolumeSnapshotSpec, error) {
+	return c.listVolumeSnapshots(ctx, nil, eq("volume_id", volumeId))
+}
+
+func (c *Client) UpdateVolumeSnapshot(ctx *c.Context, snpID string, snp *model.VolumeSnapshotSpec) (*model.VolumeSnapshotSpec, error) {
+	var result *model.VolumeSnapshotSpec
+	err := retryOnConflict(revisionOf(snp.BaseModel), func() (err error) {
+		result, err = c.updateVolumeSnapshot(ctx, snpID, snp)
+		return err
+	})
+	return result, err
+}
+
+func (c *Client) updateVolumeSnapshot(ctx *c.Context, snpID string, snp *model.VolumeSnapshotSpec) (*model.VolumeSnapshotSpec, error) {
+	result, err := c.GetVolumeSnapshot(ctx, snpID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkRevision(snpID, revisionOf(snp.BaseModel), result.Revision); err != nil {
+		return nil, err
+	}
+	if snp.Name != "" {
+		result.Name = snp.Name
+	}
+	if snp.Metadata != nil {
+		result.Metadata = utils.MergeStringMaps(result.Metadata, snp.Metadata)
+	}
+	if snp.Size > 0 {
+		result.Size = snp.Size
+	}
+	if snp.VolumeId != "" {
+		result.VolumeId = snp.VolumeId
+	}
+	if snp.Description != "" {
+		result.Description = snp.Description
+	}
+	if snp.Status != "" {
+		result.Status = snp.Status
+	}
+	result.UpdatedAt = time.Now().Format(constants.TimeFormat)
+
+	if err = c.update(snapshotTable, snpID, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *Client) DeleteVolumeSnapshot(ctx *c.Context, snpID string) error {
+	return c.remove(ctx, snapshotTable, snpID)
+}
+
+// *************   Volume backup code block  *************
+
+func (c *Client) CreateBackup(ctx *c.Context, backup *model.BackupSpec) (*model.BackupSpec, error) {
+	backup.Id = newId(backup.Id)
+	backup.TenantId = ctx.TenantId
+	backup.UserId = ctx.UserId
+	backup.CreatedAt = time.Now().Format(constants.TimeFormat)
+	if err := c.put(backupTable, backup); err != nil {
+		return nil, err
+	}
+	return backup, nil
+}
+
+func (c *Client) GetBackup(ctx *c.Context, backupId string) (*model.BackupSpec, error) {
+	var backup = &model.BackupSpec{}
+	if err := c.get(ctx, backupTable, backupId, backup); err != nil {
+		return nil, err
+	}
+	return backup, nil
+}
+
+func (c *Client) listBackups(ctx *c.Context, m map[string][]string) ([]*model.BackupSpec, error) {
+	records, err := c.list(ctx, backupTable, m)
+	if err != nil {
+		return nil, err
+	}
+	var backups = []*model.BackupSpec{}
+	for _, rec := range records {
+		var backup = &model.BackupSpec{}
+		if err := rec.decode(backup); err != nil {
+			log.Error("When parsing volume backup in db:", err)
+			return nil, err
+		}
+		backups = append(backups, backup)
+	}
+	return backups, nil
+}
+
+func (c *Client) ListBackups(ctx *c.Context) ([]*model.BackupSpec, error) {
+	return c.listBackups(ctx, nil)
+}
+
+func (c *Client) ListBackupsWithFilter(ctx *c.Context, m map[string][]string) ([]*model.BackupSpec, error) {
+	return c.listBackups(ctx, m)
+}
+
+func (c *Client) UpdateBackup(ctx *c.Context, backupId string, input *model.BackupSpec) (*model.BackupSpec, error) {
+	var result *model.BackupSpec
+	err := retryOnConflict(revisionOf(input.BaseModel), func() (err error) {
+		result, err = c.updateBackup(ctx, backupId, input)
+		return err
+	})
+	return result, err
+}
+
+func (c *Client) updateBackup(ctx *c.Context, backupId string, input *model.BackupSpec) (*model.BackupSpec, error) {
+	backup, err := c.GetBackup(ctx, backupId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkRevision(backupId, revisionOf(input.BaseModel), backup.Revision); err != nil {
+		return nil, err
+	}
+	if input.Name != "" {
+		backup.Name = input.Name
+	}
+	if input.Description != "" {
+		backup.Description = input.Description
+	}
+	if input.Size > 0 {
+		backup.Size = input.Size
+	}
+	if input.PoolId != "" {
+		backup.PoolId = input.PoolId
+	}
+	if input.BackupDriver != "" {
+		backup.BackupDriver = input.BackupDriver
+	}
+	if input.Status != "" {
+		backup.Status = input.Status
+	}
+	if input.Metadata != nil {
+		backup.Metadata = utils.MergeStringMaps(backup.Metadata, input.Metadata)
+	}
+	backup.UpdatedAt = time.Now().Format(constants.TimeFormat)
+
+	if err = c.update(backupTable, backupId, backup); err != nil {
+		return nil, err
+	}
+	return backup, nil
+}
+
+func (c *Client) DeleteBackup(ctx *c.Context, backupId string) error {
+	return c.remove(ctx, backupTable, backupId)
+}
+
+// *************   Replication code block  *************
+
+func (c *Client) CreateReplication(ctx *c.Context, r *model.ReplicationSpec) (*model.ReplicationSpec, error) {
+	r.Id = newId(r.Id)
+	r.TenantId = ctx.TenantId
+	r.CreatedAt = time.Now().Format(constants.TimeFormat)
+	if err := c.put(replicationTable, r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (c *Client) GetReplication(ctx *c.Context, replicationId string) (*model.ReplicationSpec, error) {
+	var r = &model.ReplicationSpec{}
+	if err := c.get(ctx, replicationTable, replicationId, r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (c *Client) GetReplicationByVolumeId(ctx *c.Context, volumeId string) (*model.ReplicationSpec, error) {
+	replicas, err := c.listReplication(ctx, nil, condition{
+		clause: "(primary_volume_id = ? OR secondary_volume_id = ?)",
+		args:   []interface{}{volumeId, volumeId},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range replicas {
+		if volumeId == r.PrimaryVolumeId || volumeId == r.SecondaryVolumeId {
+			return r, nil
+		}
+	}
+	return nil, model.NewNotFoundError(fmt.Sprintf("can't find specified replication by volume id %s", volumeId))
+}
+
+func (c *Client) listReplication(ctx *c.Context, m map[string][]string, conds ...condition) ([]*model.ReplicationSpec, error) {
+	records, err := c.list(ctx, replicationTable, m, conds...)
+	if err != nil {
+		return nil, err
+	}
+	var replicas = []*model.ReplicationSpec{}
+	for _, rec := range records {
+		var r = &model.ReplicationSpec{}
+		if err := rec.decode(r); err != nil {
+			log.Error("When parsing replication in db:", err)
+			return nil, err
+		}
+		replicas = append(replicas, r)
+	}
+	return replicas, nil
+}
+
+func (c *Client) ListReplication(ctx *c.Context) ([]*model.ReplicationSpec, error) {
+	return c.listReplication(ctx, nil)
+}
+
+func (c *Client) ListReplicationWithFilter(ctx *c.Context, m map[string][]string) ([]*model.ReplicationSpec, error) {
+	return c.listReplication(ctx, m)
+}
+
+func (c *Client) DeleteReplication(ctx *c.Context, replicationId string) error {
+	return c.remove(ctx, replicationTable, replicationId)
+}
+
+func (c *Client) UpdateReplication(ctx *c.Context, replicationId string, input *model.ReplicationSpec) (*model.ReplicationSpec, error) {
+	var result *model.ReplicationSpec
+	err := retryOnConflict(revisionOf(input.BaseModel), func() (err error) {
+		result, err = c.updateReplication(ctx, replicationId, input)
+		return err
+	})
+	return result, err
+}
+
+func (c *Client) updateReplication(ctx *c.Context, replicationId string, input *model.ReplicationSpec) (*model.ReplicationSpec, error) {
+	r, err := c.GetReplication(ctx, replicationId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkRevision(replicationId, revisionOf(input.BaseModel), r.Revision); err != nil {
+		return nil, err
+	}
+	if input.ProfileId != "" {
+		r.ProfileId = input.ProfileId
+	}
+	if input.Name != "" {
+		r.Name = input.Name
+	}
+	if input.Description != "" {
+		r.Description = input.Description
+	}
+	if input.PrimaryReplicationDriverData != nil {
+		r.PrimaryReplicationDriverData = input.PrimaryReplicationDriverData
+	}
+	if input.SecondaryReplicationDriverData != nil {
+		r.SecondaryReplicationDriverData = input.SecondaryReplicationDriverData
+	}
+	if input.Metadata != nil {
+		r.Metadata = utils.MergeStringMaps(r.Metadata, input.Metadata)
+	}
+	if input.ReplicationStatus != "" {
+		r.ReplicationStatus = input.ReplicationStatus
+	}
+	r.UpdatedAt = time.Now().Format(constants.TimeFormat)
+
+	if err = c.update(replicationTable, replicationId, r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// *************   Volume group code block  *************
+
+func (c *Client) CreateVolumeGroup(ctx *c.Context, vg *model.VolumeGroupSpec) (*model.VolumeGroupSpec, error) {
+	vg.Id = newId(vg.Id)
+	vg.TenantId = ctx.TenantId
+	if err := c.put(volumeGroupTable, vg); err != nil {
+		return nil, err
+	}
+	return vg, nil
+}
+
+func (c *Client) GetVolumeGroup(ctx *c.Context, vgId string) (*model.VolumeGroupSpec, error) {
+	var vg = &model.VolumeGroupSpec{}
+	if err := c.get(ctx, volumeGroupTable, vgId, vg); err != nil {
+		return nil, err
+	}
+	return vg, nil
+}
+
+func (c *Client) UpdateVolumeGroup(ctx *c.Context, vgUpdate *model.VolumeGroupSpec) (*model.VolumeGroupSpec, error) {
+	var result *model.VolumeGroupSpec
+	err := retryOnConflict(revisionOf(vgUpdate.BaseModel), func() (err error) {
+		result, err = c.updateVolumeGroup(ctx, vgUpdate)
+		return err
+	})
+	return result, err
+}
+
+func (c *Client) updateVolumeGroup(ctx *c.Context, vgUpdate *model.VolumeGroupSpec) (*model.VolumeGroupSpec, error) {
+	vg, err := c.GetVolumeGroup(ctx, vgUpdate.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkRevision(vgUpdate.Id, revisionOf(vgUpdate.BaseModel), vg.Revision); err != nil {
+		return nil, err
+	}
+	if vgUpdate.Name != "" {
+		vg.Name = vgUpdate.Name
+	}
+	if vgUpdate.AvailabilityZone != "" {
+		vg.AvailabilityZone = vgUpdate.AvailabilityZone
+	}
+	if vgUpdate.Description != "" {
+		vg.Description = vgUpdate.Description
+	}
+	if vgUpdate.PoolId != "" {
+		vg.PoolId = vgUpdate.PoolId
+	}
+	if vgUpdate.Status != "" {
+		vg.Status = vgUpdate.Status
+	}
+	if vgUpdate.CreatedAt != "" {
+		vg.CreatedAt = vgUpdate.CreatedAt
+	}
+	if vgUpdate.UpdatedAt != "" {
+		vg.UpdatedAt = vgUpdate.UpdatedAt
+	}
+	if vgUpdate.Metadata != nil {
+		vg.Metadata = utils.MergeStringMaps(vg.Metadata, vgUpdate.Metadata)
+	}
+
+	if err = c.update(volumeGroupTable, vg.Id, vg); err != nil {
+		return nil, err
+	}
+	return vg, nil
+}
+
+func (c *Client) listVolumeGroups(ctx *c.Context, m map[string][]string) ([]*model.VolumeGroupSpec, error) {
+	records, err := c.list(ctx, volumeGroupTable, m)
+	if err != nil {
+		return nil, err
+	}
+	var vgs = []*model.VolumeGroupSpec{}
+	for _, rec := range records {
+		var vg = &model.VolumeGroupSpec{}
+		if err := rec.decode(vg); err != nil {
+			log.Error("When parsing volume group in db:", err)
+			return nil, err
+		}
+		vgs = append(vgs, vg)
+	}
+	return vgs, nil
+}
+
+func (c *Client) ListVolumeGroups(ctx *c.Context) ([]*model.VolumeGroupSpec, error) {
+	return c.listVolumeGroups(ctx, nil)
+}
+
+func (c *Client) ListVolumeGroupsWithFilter(ctx *c.Context, m map[string][]string) ([]*model.VolumeGroupSpec, error) {
+	return c.listVolumeGroups(ctx, m)
+}
+
+func (c *Client) DeleteVolumeGroup(ctx *c.Context, volumeGroupId string) error {
+	return c.remove(ctx, volumeGroupTable, volumeGroupId)
+}
+
+// *************   Volume group snapshot code block  *************
+
+func (c *Client) CreateVolumeGroupSnapshot(ctx *c.Context, gs *model.VolumeGroupSnapshotSpec) (*model.VolumeGroupSnapshotSpec, error) {
+	gs.Id = newId(gs.Id)
+	gs.TenantId = ctx.TenantId
+	gs.UserId = ctx.UserId
+	gs.CreatedAt = time.Now().Format(constants.TimeFormat)
+	if err := c.put(groupSnapshotTable, gs); err != nil {
+		return nil, err
+	}
+	return gs, nil
+}
+
+func (c *Client) GetVolumeGroupSnapshot(ctx *c.Context, gsId string) (*model.VolumeGroupSnapshotSpec, error) {
+	var gs = &model.VolumeGroupSnapshotSpec{}
+	if err := c.get(ctx, groupSnapshotTable, gsId, gs); err != nil {
+		return nil, err
+	}
+	return gs, nil
+}
+
+func (c *Client) listVolumeGroupSnapshots(ctx *c.Context, conds ...condition) ([]*model.VolumeGroupSnapshotSpec, error) {
+	records, err := c.list(ctx, groupSnapshotTable, nil, conds...)
+	if err != nil {
+		return nil, err
+	}
+	var gss = []*model.VolumeGroupSnapshotSpec{}
+	for _, rec := range records {
+		var gs = &model.VolumeGroupSnapshotSpec{}
+		if err := rec.decode(gs); err != nil {
+			log.Error("When parsing volume group snapshot in db:", err)
+			return nil, err
+		}
+		gss = append(gss, gs)
+	}
+	return gss, nil
+}
+
+func (c *Client) ListVolumeGroupSnapshots(ctx *c.Context) ([]*model.VolumeGroupSnapshotSpec, error) {
+	return c.listVolumeGroupSnapshots(ctx)
+}
+
+func (c *Client) ListGroupSnapshotsByGroupId(ctx *c.Context, vgId string) ([]*model.VolumeGroupSnapshotSpec, error) {
+	return c.listVolumeGroupSnapshots(ctx, eq("group_id", vgId))
+}
+
+func (c *Client) UpdateVolumeGroupSnapshot(ctx *c.Context, gsId string, input *model.VolumeGroupSnapshotSpec) (*model.VolumeGroupSnapshotSpec, error) {
+	var result *model.VolumeGroupSnapshotSpec
+	err := retryOnConflict(revisionOf(input.BaseModel), func() (err error) {
+		result, err = c.updateVolumeGroupSnapshot(ctx, gsId, input)
+		return err
+	})
+	return result, err
+}
+
+func (c *Client) updateVolumeGroupSnapshot(ctx *c.Context, gsId string, input *model.VolumeGroupSnapshotSpec) (*model.VolumeGroupSnapshotSpec, error) {
+	gs, err := c.GetVolumeGroupSnapshot(ctx, gsId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkRevision(gsId, revisionOf(input.BaseModel), gs.Revision); err != nil {
+		return nil, err
+	}
+	if input.Name != "" {
+		gs.Name = input.Name
+	}
+	if input.Description != "" {
+		gs.Description = input.Description
+	}
+	if input.Status != "" {
+		gs.Status = input.Status
+	}
+	if input.Snapshots != nil {
+		gs.Snapshots = input.Snapshots
+	}
+	if input.Metadata != nil {
+		gs.Metadata = utils.MergeStringMaps(gs.Metadata, input.Metadata)
+	}
+	gs.UpdatedAt = time.Now().Format(constants.TimeFormat)
+
+	if err = c.update(groupSnapshotTable, gsId, gs); err != nil {
+		return nil, err
+	}
+	return gs, nil
+}
+
+func (c *Client) DeleteVolumeGroupSnapshot(ctx *c.Context, gsId string) error {
+	return c.remove(ctx, groupSnapshotTable, gsId)
+}
+
+// UpdateStatus sets the status of the object. If the object has been modified
+// by somebody else since it was read, the status is applied to the latest
+// version of the object instead.
+func (c *Client) UpdateStatus(ctx *c.Context, in interface{}, status string) error {
+	var err error
+	for i := 0; i < retryNum; i++ {
+		if err = c.updateStatus(ctx, in, status); !model.IsConflictError(err) {
+			return err
+		}
+		if in, err = c.latest(ctx, in); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// latest reads the latest version of the object from db.
+func (c *Client) latest(ctx *c.Context, in interface{}) (interface{}, error) {
+	switch in.(type) {
+	case *model.VolumeSnapshotSpec:
+		return c.GetVolumeSnapshot(ctx, in.(*model.VolumeSnapshotSpec).Id)
+	case *model.BackupSpec:
+		return c.GetBackup(ctx, in.(*model.BackupSpec).Id)
+	case *model.VolumeAttachmentSpec:
+		return c.GetVolumeAttachment(ctx, in.(*model.VolumeAttachmentSpec).Id)
+	case *model.VolumeSpec:
+		return c.GetVolume(ctx, in.(*model.VolumeSpec).Id)
+	case *model.ReplicationSpec:
+		return c.GetReplication(ctx, in.(*model.ReplicationSpec).Id)
+	case *model.VolumeGroupSpec:
+		return c.GetVolumeGroup(ctx, in.(*model.VolumeGroupSpec).Id)
+	case *model.VolumeGroupSnapshotSpec:
+		return c.GetVolumeGroupSnapshot(ctx, in.(*model.VolumeGroupSnapshotSpec).Id)
+	case *model.FileShareSpec:
+		return c.GetFileShare(ctx, in.(*model.FileShareSpec).Id)
+	case *model.FileShareSnapshotSpec:
+		return c.GetFileShareSnapshot(ctx, in.(*model.FileShareSnapshotSpec).Id)
+	case *model.FileShareAclSpec:
+		return c.GetFileShareAcl(ctx, in.(*model.FileShareAclSpec).Id)
+	case *model.DockSpec:
+		return c.GetDock(ctx, in.(*model.DockSpec).Id)
+	case *model.StoragePoolSpec:
+		return c.GetPool(ctx, in.(*model.StoragePoolSpec).Id)
+	}
+	return in, nil
+}
+
+func (c *Client) updateStatus(ctx *c.Context, in interface{}, status string) error {
+	switch in.(type) {
+	case *model.VolumeSnapshotSpec:
+		snap := in.(*model.VolumeSnapshotSpec)
+		snap.Status = status
+		if _, errUpdate := c.UpdateVolumeSnapshot(ctx, snap.Id, snap); errUpdate != nil {
+			log.Error("Error occurs when update volume snapshot status in db:", errUpdate.Error())
+			return errUpdate
+		}
+
+	case *model.BackupSpec:
+		backup := in.(*model.BackupSpec)
+		backup.Status = status
+		if _, errUpdate := c.UpdateBackup(ctx, backup.Id, backup); errUpdate != nil {
+			log.Error("When update volume backup status in db:", errUpdate.Error())
+			return errUpdate
+		}
+
+	case *model.VolumeAttachmentSpec:
+		attm := in.(*model.VolumeAttachmentSpec)
+		attm.Status = status
+		if _, errUpdate := c.UpdateVolumeAttachment(ctx, attm.Id, attm); errUpdate != nil {
+			log.Error("Error occurred in dock module when update volume attachment status in db:", errUpdate)
+			return errUpdate
+		}
+
+	case *model.VolumeSpec:
+		volume := in.(*model.VolumeSpec)
+		volume.Status = status
+		if _, errUpdate := c.UpdateVolume(ctx, volume); errUpdate != nil {
+			log.Error("When update volume status in db:", errUpdate.Error())
+			return errUpdate
+		}
+
+	case *model.ReplicationSpec:
+		replica := in.(*model.ReplicationSpec)
+		replica.ReplicationStatus = status
+		if _, errUpdate := c.UpdateReplication(ctx, replica.Id, replica); errUpdate != nil {
+			log.Error("When update replication status in db:", errUpdate.Error())
+			return errUpdate
+		}
+
+	case *model.VolumeGroupSpec:
+		vg := in.(*model.VolumeGroupSpec)
+		vg.Status = status
+		if _, errUpdate := c.UpdateVolumeGroup(ctx, vg); errUpdate != nil {
+			log.Error("When update volume status in db:", errUpdate.Error())
+			return errUpdate
+		}
+
+	case *model.VolumeGroupSnapshotSpec:
+		gs := in.(*model.VolumeGroupSnapshotSpec)
+		gs.Status = status
+		if _, errUpdate := c.UpdateVolumeGroupSnapshot(ctx, gs.Id, gs); errUpdate != nil {
+			log.Error("When update volume group snapshot status in db:", errUpdate.Error())
+			return errUpdate
+		}
+
+	case *model.FileShareSpec:
+		fshare := in.(*model.FileShareSpec)
+		fshare.Status = status
+		if _, errUpdate := c.UpdateFileShare(ctx, fshare); errUpdate != nil {
+			log.Error("When update fileshare status in db:", errUpdate.Error())
+			return errUpdate
+		}
+
+	case *model.FileShareSnapshotSpec:
+		snap := in.(*model.FileShareSnapshotSpec)
+		snap.Status = status
+		if _, errUpdate := c.UpdateFileShareSnapshot(ctx, snap.Id, snap); errUpdate != nil {
+			log.Error("When update fileshare snapshot status in db:", errUpdate.Error())
+			return errUpdate
+		}
+
+	case *model.FileShareAclSpec:
+		acl := in.(*model.FileShareAclSpec)
+		acl.Status = status
+		if _, errUpdate := c.UpdateFileShareAcl(ctx, acl); errUpdate != nil {
+			log.Error("When update fileshare acl status in db:", errUpdate.Error())
+			return errUpdate
+		}
+
+	case *model.DockSpec:
+		dck := in.(*model.DockSpec)
+		dck.Status = status
+		dck.UpdatedAt = time.Now().Format(constants.TimeFormat)
+		if errUpdate := c.update(dockTable, dck.Id, dck); errUpdate != nil {
+			log.Error("When update dock status in db:", errUpdate.Error())
+			return errUpdate
+		}
+
+	case *model.StoragePoolSpec:
+		pol := in.(*model.StoragePoolSpec)
+		pol.Status = status
+		pol.UpdatedAt = time.Now().Format(constants.TimeFormat)
+		if errUpdate := c.update(poolTable, pol.Id, pol); errUpdate != nil {
+			log.Error("When update pool status in db:", errUpdate.Error())
+			return errUpdate
+		}
+
+	case []*model.VolumeSpec:
+		vols := in.([]*model.VolumeSpec)
+		if _, errUpdate := c.VolumesToUpdate(ctx, vols); errUpdate != nil {
+			return errUpdate
+		}
+	}
+	return nil
+}
+
+// *************   Operation code block  *************
+
+func (c *Client) CreateOperation(ctx *c.Context, op *model.OperationSpec) (*model.OperationSpec, error) {
+	op.Id = newId(op.Id)
+	op.TenantId = ctx.TenantId
+	op.UserId = ctx.UserId
+	op.CreatedAt = time.Now().Format(constants.TimeFormat)
+	if err := c.put(operationTable, op); err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+func (c *Client) GetOperation(ctx *c.Context, opId string) (*model.OperationSpec, error) {
+	var op = &model.OperationSpec{}
+	if err := c.get(ctx, operationTable, opId, op); err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+func (c *Client) listOperations(ctx *c.Context, m map[string][]string) ([]*model.OperationSpec, error) {
+	records, err := c.list(ctx, operationTable, m)
+	if err != nil {
+		return nil, err
+	}
+	var ops = []*model.OperationSpec{}
+	for _, rec := range records {
+		var op = &model.OperationSpec{}
+		if err := rec.decode(op); err != nil {
+			log.Error("When parsing operation in db:", err)
+			return nil, err
+		}
+		ops = append(ops, op)
+	}
+	return ops, nil
+}
+
+func (c *Client) ListOperations(ctx *c.Context) ([]*model.OperationSpec, error) {
+	return c.listOperations(ctx, nil)
+}
+
+func (c *Client) ListOperationsWithFilter(ctx *c.Context, m map[string][]string) ([]*model.OperationSpec, error) {
+	return c.listOperations(ctx, m)
+}
+
+func (c *Client) UpdateOperation(ctx *c.Context, opId string, input *model.OperationSpec) (*model.OperationSpec, error) {
+	var result *model.OperationSpec
+	err := retryOnConflict(revisionOf(input.BaseModel), func() (err error) {
+		result, err = c.updateOperation(ctx, opId, input)
+		return err
+	})
+	return result, err
+}
+
+func (c *Client) updateOperation(ctx *c.Context, opId string, input *model.OperationSpec) (*model.OperationSpec, error) {
+	op, err := c.GetOperation(ctx, opId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkRevision(opId, revisionOf(input.BaseModel), op.Revision); err != nil {
+		return nil, err
+	}
+	if input.Request != "" {
+		op.Request = input.Request
+	}
+	if input.DockId != "" {
+		op.DockId = input.DockId
+	}
+	if input.ResourceId != "" {
+		op.ResourceId = input.ResourceId
+	}
+	if input.Status != "" {
+		op.Status = input.Status
+	}
+	if input.ErrorMessage != "" {
+		op.ErrorMessage = input.ErrorMessage
+	}
+	op.UpdatedAt = time.Now().Format(constants.TimeFormat)
+
+	if err = c.update(operationTable, opId, op); err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+func (c *Client) DeleteOperation(ctx *c.Context, opId string) error {
+	return c.remove(ctx, operationTable, opId)
+}
+
+// *************   Quota code block  *************
+
+// GetQuota returns the quota set for the tenant explicitly, a NotFoundError
+// is returned if the tenant is limited by the default quota.
+func (c *Client) GetQuota(ctx *c.Context, tenantId string) (*model.QuotaSpec, error) {
+	var quota = &model.QuotaSpec{}
+	if err := c.get(ctx, quotaTable, tenantId, quota); err != nil {
+		return nil, err
+	}
+	return quota, nil
+}
+
+// UpdateQuota stores the quota of the tenant, the quota is created if it
+// doesn't exist yet.
+func (c *Client) UpdateQuota(ctx *c.Context, quota *model.QuotaSpec) (*model.QuotaSpec, error) {
+	if quota.BaseModel == nil {
+		quota.BaseModel = &model.BaseModel{}
+	}
+	quota.Id = quota.TenantId
+	quota.UpdatedAt = time.Now().Format(constants.TimeFormat)
+	// The usage is never stored along with the limits.
+	quota.InUse, quota.Reserved = nil, nil
+
+	rev := quota.Revision
+	err := retryOnConflict(rev, func() error {
+		old, err := c.GetQuota(ctx, quota.TenantId)
+		if _, ok := err.(*model.NotFoundError); ok && rev == 0 {
+			quota.CreatedAt = quota.UpdatedAt
+			return c.put(quotaTable, quota)
+		}
+		if err != nil {
+			return err
+		}
+		if err = checkRevision(quota.Id, rev, old.Revision); err != nil {
+			return err
+		}
+		quota.CreatedAt, quota.Revision = old.CreatedAt, old.Revision
+		return c.update(quotaTable, quota.Id, quota)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return quota, nil
+}
+
+// GetQuotaUsage returns the resources charged to the tenant, a NotFoundError
+// is returned if nothing has been charged yet.
+func (c *Client) GetQuotaUsage(ctx *c.Context, tenantId string) (*model.QuotaUsageSpec, error) {
+	var usage = &model.QuotaUsageSpec{}
+	if err := c.get(ctx, quotaUsageTable, tenantId, usage); err != nil {
+		return nil, err
+	}
+	return usage, nil
+}
+
+// UpdateQuotaUsage stores the resources charged to the tenant, the usage is
+// created if it doesn't exist yet.
+func (c *Client) UpdateQuotaUsage(ctx *c.Context, usage *model.QuotaUsageSpec) (*model.QuotaUsageSpec, error) {
+	if usage.BaseModel == nil {
+		usage.BaseModel = &model.BaseModel{}
+	}
+	usage.Id = usage.TenantId
+	usage.UpdatedAt = time.Now().Format(constants.TimeFormat)
+
+	rev := usage.Revision
+	err := retryOnConflict(rev, func() error {
+		old, err := c.GetQuotaUsage(ctx, usage.TenantId)
+		if _, ok := err.(*model.NotFoundError); ok && rev == 0 {
+			usage.CreatedAt = usage.UpdatedAt
+			return c.put(quotaUsageTable, usage)
+		}
+		if err != nil {
+			return err
+		}
+		if err = checkRevision(usage.Id, rev, old.Revision); err != nil {
+			return err
+		}
+		usage.CreatedAt, usage.Revision = old.CreatedAt, old.Revision
+		return c.update(quotaUsageTable, usage.Id, usage)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return usage, nil
+}
+
+// *************   Webhook code block  *************
+
+func (c *Client) CreateWebhook(ctx *c.Context, hook *model.WebhookSpec) (*model.WebhookSpec, error) {
+	if hook.BaseModel == nil {
+		hook.BaseModel = &model.BaseModel{}
+	}
+	hook.Id = newId(hook.Id)
+	hook.TenantId = ctx.TenantId
+	hook.UserId = ctx.UserId
+	hook.CreatedAt = time.Now().Format(constants.TimeFormat)
+	if err := c.put(webhookTable, hook); err != nil {
+		return nil, err
+	}
+	return hook, nil
+}
+
+func (c *Client) GetWebhook(ctx *c.Context, hookId string) (*model.WebhookSpec, error) {
+	var hook = &model.WebhookSpec{}
+	if err := c.get(ctx, webhookTable, hookId, hook); err != nil {
+		return nil, err
+	}
+	return hook, nil
+}
+
+func (c *Client) ListWebhooks(ctx *c.Context) ([]*model.WebhookSpec, error) {
+	records, err := c.list(ctx, webhookTable, nil)
+	if err != nil {
+		return nil, err
+	}
+	var hooks = []*model.WebhookSpec{}
+	for _, rec := range records {
+		var hook = &model.WebhookSpec{}
+		if err := rec.decode(hook); err != nil {
+			log.Error("When parsing webhook in db:", err)
+			return nil, err
+		}
+		hooks = append(hooks, hook)
+	}
+	return hooks, nil
+}
+
+// DeleteWebhook deletes the webhook along with its delivery log.
+func (c *Client) DeleteWebhook(ctx *c.Context, hookId string) error {
+	if _, err := c.GetWebhook(ctx, hookId); err != nil {
+		return err
+	}
+	if err := c.remove(ctx, webhookTable, hookId); err != nil {
+		return err
+	}
+	if _, err := c.db.Exec("DELETE FROM "+webhookDeliveryTable.name+" WHERE webhook_id = ?", hookId); err != nil {
+		log.Errorf("When delete deliveries of webhook %s in db: %v", hookId, err)
+		return err
+	}
+	return nil
+}
+
+// CreateWebhookDelivery stores the delivery under the tenant of the webhook,
+// which is not necessarily the tenant of the context.
+func (c *Client) CreateWebhookDelivery(ctx *c.Context, dlv *model.WebhookDeliverySpec) (*model.WebhookDeliverySpec, error) {
+	if dlv.BaseModel == nil {
+		dlv.BaseModel = &model.BaseModel{}
+	}
+	dlv.Id = newId(dlv.Id)
+	dlv.CreatedAt = time.Now().Format(constants.TimeFormat)
+	if err := c.put(webhookDeliveryTable, dlv); err != nil {
+		return nil, err
+	}
+	return dlv, nil
+}
+
+// UpdateWebhookDelivery rewrites the delivery, it's only updated by the
+// notifier delivering it so the latest revision is used if none is given.
+func (c *Client) UpdateWebhookDelivery(ctx *c.Context, dlvId string, dlv *model.WebhookDeliverySpec) (*model.WebhookDeliverySpec, error) {
+	if dlv.BaseModel == nil {
+		dlv.BaseModel = &model.BaseModel{}
+	}
+	var old = &model.WebhookDeliverySpec{}
+	if err := c.get(ctx, webhookDeliveryTable, dlvId, old); err != nil {
+		return nil, err
+	}
+	if err := checkRevision(dlvId, dlv.Revision, old.Revision); err != nil {
+		return nil, err
+	}
+	dlv.Id, dlv.CreatedAt, dlv.Revision = dlvId, old.CreatedAt, old.Revision
+	dlv.UpdatedAt = time.Now().Format(constants.TimeFormat)
+	if err := c.update(webhookDeliveryTable, dlvId, dlv); err != nil {
+		return nil, err
+	}
+	return dlv, nil
+}
+
+// ListWebhookDeliveries returns the delivery log of the webhook.
+func (c *Client) ListWebhookDeliveries(ctx *c.Context, hookId string) ([]*model.WebhookDeliverySpec, error) {
+	records, err := c.list(ctx, webhookDeliveryTable, nil, eq("webhook_id", hookId))
+	if err != nil {
+		return nil, err
+	}
+	var dlvs = []*model.WebhookDeliverySpec{}
+	for _, rec := range records {
+		var dlv = &model.WebhookDeliverySpec{}
+		if err := rec.decode(dlv); err != nil {
+			log.Error("When parsing webhook delivery in db:", err)
+			return nil, err
+		}
+		dlvs = append(dlvs, dlv)
+	}
+	return dlvs, nil
+}
+
+// *************   Audit code block  *************
+
+// CreateAuditRecord appends the record to the audit log.
+func (c *Client) CreateAuditRecord(ctx *c.Context, rec *model.AuditRecordSpec) (*model.AuditRecordSpec, error) {
+	if rec.BaseModel == nil {
+		rec.BaseModel = &model.BaseModel{}
+	}
+	rec.Id = newId(rec.Id)
+	if rec.CreatedAt == "" {
+		rec.CreatedAt = time.Now().Format(constants.TimeFormat)
+	}
+	if err := c.put(auditTable, rec); err != nil {
+		return nil, err
+	}
+	return rec, nil
+}
+
+// ListAuditRecords returns the audit records newest first. Besides the
+// columns, the records can be filtered by the time range [since, until) of
+// their creation.
+func (c *Client) ListAuditRecords(ctx *c.Context, m map[string][]string) ([]*model.AuditRecordSpec, error) {
+	var conds []condition
+	if v, ok := m["since"]; ok && len(v) != 0 && v[0] != "" {
+		conds = append(conds, condition{clause: "created_at >= ?", args: []interface{}{v[0]}})
+	}
+	if v, ok := m["until"]; ok && len(v) != 0 && v[0] != "" {
+		conds = append(conds, condition{clause: "created_at < ?", args: []interface{}{v[0]}})
+	}
+	params := map[string][]string{"sortKey": {"createdAt"}}
+	for k, v := range m {
+		if k != "since" && k != "until" {
+			params[k] = v
+		}
+	}
+
+	records, err := c.list(ctx, auditTable, params, conds...)
+	if err != nil {
+		return nil, err
+	}
+	var recs = []*model.AuditRecordSpec{}
+	for _, rec := range records {
+		var r = &model.AuditRecordSpec{}
+		if err := rec.decode(r); err != nil {
+			log.Error("When parsing audit record in db:", err)
+			return nil, err
+		}
+		recs = append(recs, r)
+	}
+	return recs, nil
 }
 
-type client struct {
-	cli  *sql.DB
-	lock sync.Mutex
+// Watch isn't supported since the tables have no change feed to follow.
+func (c *Client) Watch(ctx *c.Context, resourceType string, revision int64, stop <-chan struct{}) (<-chan *model.WatchEvent, error) {
+	return nil, &model.NotImplementError{S: "watch is not supported by the mysql driver"}
 }
@@ -0,0 +1,642 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	c "github.com/opensds/opensds/pkg/context"
+	"github.com/opensds/opensds/pkg/model"
+	. "github.com/opensds/opensds/testutils/collection"
+)
+
+// newTestClient returns a client of a fresh sqlite database and the function
+// to remove it.
+func newTestClient(t *testing.T) (*Client, func()) {
+	dir, err := ioutil.TempDir("", "opensds-db")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cli, err := NewClient("sqlite3", filepath.Join(dir, "opensds.db"))
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+	return cli, func() {
+		cli.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+// The sample objects share their base models, copy them before the client
+// modifies the ids and timestamps.
+func newBaseModel(id string) *model.BaseModel {
+	return &model.BaseModel{Id: id}
+}
+
+func sampleVolume(i int, id, tenantId string) *model.VolumeSpec {
+	vol := SampleVolumes[i]
+	vol.BaseModel = newBaseModel(id)
+	vol.TenantId = tenantId
+	return &vol
+}
+
+func createProfiles(t *testing.T, cli *Client) {
+	for i := range SampleProfiles {
+		prf := SampleProfiles[i]
+		prf.BaseModel = newBaseModel(prf.Id)
+		if _, err := cli.CreateProfile(c.NewAdminContext(), &prf); err != nil {
+			t.Fatal("Create profile failed:", err)
+		}
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "opensds-db")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "opensds.db")
+	for i := 0; i < 2; i++ {
+		cli, err := NewClient("sqlite3", path)
+		if err != nil {
+			t.Fatalf("Open database %d time(s) failed: %v", i+1, err)
+		}
+		var version int
+		if err = cli.db.QueryRow("SELECT MAX(version) FROM " + migrationTable).Scan(&version); err != nil {
+			t.Fatal(err)
+		}
+		if version != migrations[len(migrations)-1].version {
+			t.Errorf("Expected schema version %d, got %d", migrations[len(migrations)-1].version, version)
+		}
+		cli.Close()
+	}
+
+	if _, err := NewClient("oracle", path); err == nil {
+		t.Error("Expected an error with unsupported driver")
+	}
+}
+
+func TestVolume(t *testing.T) {
+	cli, teardown := newTestClient(t)
+	defer teardown()
+	ctx := c.NewAdminContext()
+
+	if _, err := cli.CreateVolume(ctx, sampleVolume(0, "v1", "")); err == nil {
+		t.Error("Expected an error when there is no profile")
+	}
+	createProfiles(t, cli)
+
+	expected := sampleVolume(0, "v1", "")
+	if _, err := cli.CreateVolume(ctx, expected); err != nil {
+		t.Fatal("Create volume failed:", err)
+	}
+	vol, err := cli.GetVolume(ctx, "v1")
+	if err != nil {
+		t.Fatal("Get volume failed:", err)
+	}
+	if !reflect.DeepEqual(vol, expected) {
+		t.Errorf("Expected %+v, got %+v\n", expected, vol)
+	}
+
+	vol, err = cli.UpdateVolume(ctx, &model.VolumeSpec{
+		BaseModel: newBaseModel("v1"),
+		Name:      "updated-volume",
+		Metadata:  map[string]string{"key": "value"},
+	})
+	if err != nil {
+		t.Fatal("Update volume failed:", err)
+	}
+	vol, _ = cli.GetVolume(ctx, "v1")
+	if vol.Name != "updated-volume" || vol.Status != expected.Status || vol.Metadata["key"] != "value" || vol.UpdatedAt == "" {
+		t.Errorf("Unexpected volume after update: %+v\n", vol)
+	}
+
+	if err = cli.DeleteVolume(ctx, "v1"); err != nil {
+		t.Fatal("Delete volume failed:", err)
+	}
+	if _, err = cli.GetVolume(ctx, "v1"); err == nil {
+		t.Error("Expected an error when get a deleted volume")
+	} else if _, ok := err.(*model.NotFoundError); !ok {
+		t.Errorf("Expected NotFoundError, got %v", err)
+	}
+	// Deleting a volume which doesn't exist is not an error.
+	if err = cli.DeleteVolume(ctx, "v1"); err != nil {
+		t.Error("Delete volume twice failed:", err)
+	}
+}
+
+func TestVolumeRevision(t *testing.T) {
+	cli, teardown := newTestClient(t)
+	defer teardown()
+	ctx := c.NewAdminContext()
+	createProfiles(t, cli)
+
+	vol, err := cli.CreateVolume(ctx, sampleVolume(0, "v1", ""))
+	if err != nil {
+		t.Fatal("Create volume failed:", err)
+	}
+	if vol.Revision != 1 {
+		t.Errorf("Expected revision 1 of a new volume, got %d", vol.Revision)
+	}
+
+	update := &model.VolumeSpec{BaseModel: &model.BaseModel{Id: "v1", Revision: 1}, Name: "v1-1"}
+	if vol, err = cli.UpdateVolume(ctx, update); err != nil {
+		t.Fatal("Update volume failed:", err)
+	}
+	if vol.Revision != 2 {
+		t.Errorf("Expected revision 2 after update, got %d", vol.Revision)
+	}
+	// The same update is based on an out of date revision now.
+	update.Name = "v1-2"
+	if _, err = cli.UpdateVolume(ctx, update); !model.IsConflictError(err) {
+		t.Errorf("Expected a conflict error, got %v", err)
+	}
+	// An update without revision is applied to the latest version.
+	update.Revision = 0
+	if vol, err = cli.UpdateVolume(ctx, update); err != nil {
+		t.Fatal("Update volume failed:", err)
+	}
+	if vol.Name != "v1-2" || vol.Revision != 3 {
+		t.Errorf("Unexpected volume after update: %+v, %+v\n", vol, vol.BaseModel)
+	}
+
+	// Lose the race against another writer.
+	stale, _ := cli.GetVolume(ctx, "v1")
+	if _, err = cli.UpdateVolume(ctx, &model.VolumeSpec{BaseModel: newBaseModel("v1"), Size: 2}); err != nil {
+		t.Fatal("Update volume failed:", err)
+	}
+	stale.Name = "stale"
+	if err = cli.update(volumeTable, "v1", stale); !model.IsConflictError(err) {
+		t.Errorf("Expected a conflict error, got %v", err)
+	}
+	// Status is still applied to the latest version of the volume.
+	if err = cli.UpdateStatus(ctx, stale, model.VolumeDeleting); err != nil {
+		t.Fatal("Update volume status failed:", err)
+	}
+	vol, _ = cli.GetVolume(ctx, "v1")
+	if vol.Status != model.VolumeDeleting || vol.Name != "v1-2" || vol.Size != 2 {
+		t.Errorf("Unexpected volume after update status: %+v\n", vol)
+	}
+}
+
+func TestTenantScope(t *testing.T) {
+	cli, teardown := newTestClient(t)
+	defer teardown()
+	createProfiles(t, cli)
+
+	tenant1 := &c.Context{TenantId: "tenant1"}
+	tenant2 := &c.Context{TenantId: "tenant2"}
+	if _, err := cli.CreateVolume(tenant1, sampleVolume(0, "v1", "")); err != nil {
+		t.Fatal("Create volume failed:", err)
+	}
+	if _, err := cli.CreateVolume(tenant2, sampleVolume(0, "v2", "")); err != nil {
+		t.Fatal("Create volume failed:", err)
+	}
+
+	if _, err := cli.GetVolume(tenant1, "v2"); err == nil {
+		t.Error("Expected an error when get the volume of another tenant")
+	}
+	if err := cli.DeleteVolume(tenant1, "v2"); err != nil {
+		t.Fatal("Delete volume failed:", err)
+	}
+	if _, err := cli.GetVolume(tenant2, "v2"); err != nil {
+		t.Error("Volume of another tenant shouldn't be deleted:", err)
+	}
+
+	vols, _ := cli.ListVolumes(tenant1)
+	if len(vols) != 1 || vols[0].Id != "v1" {
+		t.Errorf("Expected only volume v1 of tenant1, got %+v\n", vols)
+	}
+	vols, _ = cli.ListVolumes(c.NewAdminContext())
+	if len(vols) != 2 {
+		t.Errorf("Expected admin lists 2 volumes, got %d\n", len(vols))
+	}
+	vol, err := cli.GetVolume(c.NewAdminContext(), "v2")
+	if err != nil || vol.TenantId != "tenant2" {
+		t.Errorf("Expected admin gets volume v2 of tenant2, got %+v, %v\n", vol, err)
+	}
+}
+
+func TestListVolumesWithFilter(t *testing.T) {
+	cli, teardown := newTestClient(t)
+	defer teardown()
+	createProfiles(t, cli)
+	ctx := c.NewAdminContext()
+
+	for _, v := range []struct {
+		id, name, status string
+		size             int64
+	}{
+		{"v1", "vol-c", "available", 1},
+		{"v2", "vol-a", "available", 3},
+		{"v3", "vol-b", "error", 2},
+		{"v4", "vol-d", "Available", 2},
+	} {
+		vol := sampleVolume(0, v.id, "")
+		vol.Name, vol.Status, vol.Size = v.name, v.status, v.size
+		if _, err := cli.CreateVolume(ctx, vol); err != nil {
+			t.Fatal("Create volume failed:", err)
+		}
+	}
+
+	ids := func(vols []*model.VolumeSpec) []string {
+		var s = []string{}
+		for _, v := range vols {
+			s = append(s, v.Id)
+		}
+		return s
+	}
+
+	for _, tc := range []struct {
+		m        map[string][]string
+		expected []string
+	}{
+		// Default is sorting by id in descending order.
+		{map[string][]string{}, []string{"v4", "v3", "v2", "v1"}},
+		// Filters are case-insensitive.
+		{map[string][]string{"status": {"available"}, "sortKey": {"name"}, "sortDir": {"asc"}},
+			[]string{"v2", "v1", "v4"}},
+		{map[string][]string{"Size": {"2"}, "sortDir": {"asc"}}, []string{"v3", "v4"}},
+		{map[string][]string{"size": {"two"}}, []string{}},
+		// Unknown filters are ignored.
+		{map[string][]string{"unknown": {"x"}, "sortKey": {"SIZE"}, "sortDir": {"asc"}},
+			[]string{"v1", "v3", "v4", "v2"}},
+		{map[string][]string{"limit": {"2"}, "offset": {"1"}, "sortDir": {"asc"}}, []string{"v2", "v3"}},
+		// Limit 50 returns all the volumes.
+		{map[string][]string{"limit": {"50"}, "offset": {"3"}}, []string{"v1"}},
+		// Offset out of bounds starts from the first volume.
+		{map[string][]string{"limit": {"1"}, "offset": {"10"}}, []string{"v4"}},
+		{map[string][]string{"offset": {"4"}}, []string{}},
+		// Invalid sort parameters use the default ones.
+		{map[string][]string{"sortKey": {"body"}, "sortDir": {"up"}}, []string{"v4", "v3", "v2", "v1"}},
+	} {
+		vols, err := cli.ListVolumesWithFilter(ctx, tc.m)
+		if err != nil {
+			t.Fatal("List volumes failed:", err)
+		}
+		if got := ids(vols); !reflect.DeepEqual(got, tc.expected) {
+			t.Errorf("With filter %v expected %v, got %v\n", tc.m, tc.expected, got)
+		}
+	}
+}
+
+func TestProfile(t *testing.T) {
+	cli, teardown := newTestClient(t)
+	defer teardown()
+	ctx := c.NewAdminContext()
+
+	if _, err := cli.GetDefaultProfile(ctx); err == nil {
+		t.Error("Expected an error when there is no default profile")
+	}
+	createProfiles(t, cli)
+
+	prf, err := cli.GetDefaultProfile(ctx)
+	if err != nil || prf.Id != SampleProfiles[0].Id {
+		t.Fatalf("Expected default profile %s, got %+v, %v", SampleProfiles[0].Id, prf, err)
+	}
+
+	props, err := cli.AddCustomProperty(ctx, prf.Id, SampleCustomProperties)
+	if err != nil {
+		t.Fatal("Add custom property failed:", err)
+	}
+	if !reflect.DeepEqual(*props, SampleCustomProperties) {
+		t.Errorf("Expected %+v, got %+v\n", SampleCustomProperties, *props)
+	}
+	if err = cli.RemoveCustomProperty(ctx, prf.Id, "dataStorage"); err != nil {
+		t.Fatal("Remove custom property failed:", err)
+	}
+	props, _ = cli.ListCustomProperties(ctx, prf.Id)
+	if _, ok := (*props)["dataStorage"]; ok || len(*props) != 1 {
+		t.Errorf("Unexpected custom properties after remove: %+v\n", *props)
+	}
+
+	prfs, _ := cli.ListProfilesWithFilter(ctx, map[string][]string{"name": {"SILVER"}})
+	if len(prfs) != 1 || prfs[0].Id != SampleProfiles[1].Id {
+		t.Errorf("Expected profile silver, got %+v\n", prfs)
+	}
+}
+
+func TestDockAndPool(t *testing.T) {
+	cli, teardown := newTestClient(t)
+	defer teardown()
+	ctx := c.NewAdminContext()
+
+	dck := SampleDocks[0]
+	dck.BaseModel = newBaseModel(dck.Id)
+	if _, err := cli.CreateDock(ctx, &dck); err != nil {
+		t.Fatal("Create dock failed:", err)
+	}
+	// Docks are registered again on restart.
+	if _, err := cli.CreateDock(ctx, &dck); err != nil {
+		t.Fatal("Create dock again failed:", err)
+	}
+	for i := range SamplePools {
+		pol := SamplePools[i]
+		pol.BaseModel = newBaseModel(pol.Id)
+		if _, err := cli.CreatePool(ctx, &pol); err != nil {
+			t.Fatal("Create pool failed:", err)
+		}
+	}
+
+	got, err := cli.GetDockByPoolId(ctx, SamplePools[1].Id)
+	if err != nil || !reflect.DeepEqual(got, &dck) {
+		t.Errorf("Expected %+v, got %+v, %v\n", &dck, got, err)
+	}
+	azs, err := cli.ListAvailabilityZones(ctx)
+	if err != nil || !reflect.DeepEqual(azs, SampleAvailabilityZones) {
+		t.Errorf("Expected %v, got %v, %v\n", SampleAvailabilityZones, azs, err)
+	}
+	pols, _ := cli.ListPoolsWithFilter(ctx, map[string][]string{"freeCapacity": {"170"}})
+	if len(pols) != 1 || pols[0].Id != SamplePools[1].Id {
+		t.Errorf("Expected pool %s, got %+v\n", SamplePools[1].Id, pols)
+	}
+}
+
+func TestListByVolumeId(t *testing.T) {
+	cli, teardown := newTestClient(t)
+	defer teardown()
+	ctx := c.NewAdminContext()
+
+	atc := SampleAttachments[0]
+	atc.BaseModel = newBaseModel(atc.Id)
+	if _, err := cli.CreateVolumeAttachment(ctx, &atc); err != nil {
+		t.Fatal("Create volume attachment failed:", err)
+	}
+	for i := range SampleSnapshots {
+		snp := SampleSnapshots[i]
+		snp.BaseModel = newBaseModel(snp.Id)
+		if _, err := cli.CreateVolumeSnapshot(ctx, &snp); err != nil {
+			t.Fatal("Create volume snapshot failed:", err)
+		}
+	}
+	rep := SampleReplications[0]
+	rep.BaseModel = newBaseModel(rep.Id)
+	rep.SecondaryVolumeId = "secondary"
+	if _, err := cli.CreateReplication(ctx, &rep); err != nil {
+		t.Fatal("Create replication failed:", err)
+	}
+
+	volId := SampleVolumes[0].Id
+	atcs, _ := cli.ListAttachmentsByVolumeId(ctx, volId)
+	if len(atcs) != 1 || !reflect.DeepEqual(atcs[0], &atc) {
+		t.Errorf("Expected %+v, got %+v\n", &atc, atcs)
+	}
+	if atcs, _ = cli.ListAttachmentsByVolumeId(ctx, "unknown"); len(atcs) != 0 {
+		t.Errorf("Expected no attachment, got %+v\n", atcs)
+	}
+	if snps, _ := cli.ListSnapshotsByVolumeId(ctx, volId); len(snps) != 2 {
+		t.Errorf("Expected 2 snapshots, got %+v\n", snps)
+	}
+	for _, id := range []string{volId, "secondary"} {
+		if r, err := cli.GetReplicationByVolumeId(ctx, id); err != nil || r.Id != rep.Id {
+			t.Errorf("Expected replication %s by volume %s, got %+v, %v\n", rep.Id, id, r, err)
+		}
+	}
+	if _, err := cli.GetReplicationByVolumeId(ctx, "unknown"); err == nil {
+		t.Error("Expected an error when get replication by unknown volume")
+	}
+}
+
+func TestOperation(t *testing.T) {
+	cli, teardown := newTestClient(t)
+	defer teardown()
+	ctx := &c.Context{TenantId: "tenant1", UserId: "user1"}
+
+	for i := range SampleOperations {
+		op := SampleOperations[i]
+		op.BaseModel = newBaseModel(op.Id)
+		if _, err := cli.CreateOperation(ctx, &op); err != nil {
+			t.Fatal("Create operation failed:", err)
+		}
+	}
+
+	op, err := cli.UpdateOperation(ctx, SampleOperations[0].Id, &model.OperationSpec{
+		Status:       model.OperationFailed,
+		ErrorMessage: "failed",
+	})
+	if err != nil {
+		t.Fatal("Update operation failed:", err)
+	}
+	op, _ = cli.GetOperation(ctx, op.Id)
+	if op.Status != model.OperationFailed || op.ErrorMessage != "failed" || op.Action != SampleOperations[0].Action ||
+		op.TenantId != "tenant1" || op.UserId != "user1" {
+		t.Errorf("Unexpected operation after update: %+v\n", op)
+	}
+
+	ops, _ := cli.ListOperationsWithFilter(ctx, map[string][]string{"status": {"failed"}})
+	if len(ops) != 2 {
+		t.Errorf("Expected 2 failed operations, got %+v\n", ops)
+	}
+	ops, _ = cli.ListOperationsWithFilter(ctx, map[string][]string{"action": {"deletevolume"}})
+	if len(ops) != 1 || ops[0].Id != SampleOperations[1].Id {
+		t.Errorf("Expected operation %s, got %+v\n", SampleOperations[1].Id, ops)
+	}
+}
+
+func TestQuota(t *testing.T) {
+	cli, teardown := newTestClient(t)
+	defer teardown()
+	ctx := c.NewAdminContext()
+	tenantId := SampleQuotas[0].TenantId
+
+	if _, err := cli.GetQuota(ctx, tenantId); err == nil {
+		t.Error("Expected an error when the quota is not set")
+	} else if _, ok := err.(*model.NotFoundError); !ok {
+		t.Errorf("Expected NotFoundError, got %v", err)
+	}
+
+	quota := &model.QuotaSpec{TenantId: tenantId, QuotaSet: SampleQuotas[0].QuotaSet}
+	result, err := cli.UpdateQuota(ctx, quota)
+	if err != nil {
+		t.Fatal("Create quota failed:", err)
+	}
+	if result.Revision != 1 {
+		t.Errorf("Expected revision 1 of a new quota, got %d", result.Revision)
+	}
+
+	update := &model.QuotaSpec{
+		BaseModel: &model.BaseModel{Revision: 1},
+		TenantId:  tenantId,
+		QuotaSet:  model.QuotaSet{Volumes: 1, Snapshots: -1, Capacity: -1, FileShares: -1},
+	}
+	if _, err = cli.UpdateQuota(ctx, update); err != nil {
+		t.Fatal("Update quota failed:", err)
+	}
+	// The same update is based on an out of date revision now.
+	update.Revision = 1
+	if _, err = cli.UpdateQuota(ctx, update); !model.IsConflictError(err) {
+		t.Errorf("Expected a conflict error, got %v", err)
+	}
+	result, _ = cli.GetQuota(ctx, tenantId)
+	if result.QuotaSet != update.QuotaSet || result.Revision != 2 || result.CreatedAt == "" {
+		t.Errorf("Unexpected quota after update: %+v, %+v\n", result, result.BaseModel)
+	}
+
+	usage := &model.QuotaUsageSpec{
+		TenantId: tenantId,
+		Charges:  SampleQuotaUsages[0].Charges,
+	}
+	if _, err = cli.UpdateQuotaUsage(ctx, usage); err != nil {
+		t.Fatal("Create quota usage failed:", err)
+	}
+	got, err := cli.GetQuotaUsage(ctx, tenantId)
+	if err != nil {
+		t.Fatal("Get quota usage failed:", err)
+	}
+	if !reflect.DeepEqual(got.Charges, usage.Charges) {
+		t.Errorf("Expected %+v, got %+v\n", usage.Charges, got.Charges)
+	}
+}
+
+func TestWebhook(t *testing.T) {
+	cli, teardown := newTestClient(t)
+	defer teardown()
+	ctx := &c.Context{TenantId: "tenant1", UserId: "user1"}
+
+	hook := SampleWebhooks[0]
+	hook.BaseModel = newBaseModel(hook.Id)
+	if _, err := cli.CreateWebhook(ctx, &hook); err != nil {
+		t.Fatal("Create webhook failed:", err)
+	}
+	got, err := cli.GetWebhook(ctx, hook.Id)
+	if err != nil {
+		t.Fatal("Get webhook failed:", err)
+	}
+	if got.TenantId != "tenant1" || got.Secret != hook.Secret || !reflect.DeepEqual(got.Events, hook.Events) {
+		t.Errorf("Unexpected webhook: %+v\n", got)
+	}
+	if _, err = cli.GetWebhook(&c.Context{TenantId: "tenant2"}, hook.Id); err == nil {
+		t.Error("Expected the webhook to be invisible to other tenants")
+	}
+
+	// The deliveries are written by the notifier with admin context.
+	admin := c.NewAdminContext()
+	dlv := SampleWebhookDeliveries[0]
+	dlv.BaseModel, dlv.TenantId, dlv.Status = &model.BaseModel{}, "tenant1", model.WebhookDeliveryPending
+	if _, err = cli.CreateWebhookDelivery(admin, &dlv); err != nil {
+		t.Fatal("Create webhook delivery failed:", err)
+	}
+	dlv.Status, dlv.Attempts = model.WebhookDeliveryDelivered, 2
+	if _, err = cli.UpdateWebhookDelivery(admin, dlv.Id, &dlv); err != nil {
+		t.Fatal("Update webhook delivery failed:", err)
+	}
+	dlvs, err := cli.ListWebhookDeliveries(ctx, hook.Id)
+	if err != nil {
+		t.Fatal("List webhook deliveries failed:", err)
+	}
+	if len(dlvs) != 1 || dlvs[0].Status != model.WebhookDeliveryDelivered || dlvs[0].Attempts != 2 ||
+		dlvs[0].Event != dlv.Event {
+		t.Errorf("Unexpected deliveries: %+v\n", dlvs)
+	}
+
+	if err = cli.DeleteWebhook(ctx, hook.Id); err != nil {
+		t.Fatal("Delete webhook failed:", err)
+	}
+	if hooks, _ := cli.ListWebhooks(admin); len(hooks) != 0 {
+		t.Errorf("Expected no webhook, got %+v\n", hooks)
+	}
+	if dlvs, _ = cli.ListWebhookDeliveries(admin, hook.Id); len(dlvs) != 0 {
+		t.Errorf("Expected the deliveries to be deleted, got %+v\n", dlvs)
+	}
+}
+
+func TestVolumeGroupSnapshot(t *testing.T) {
+	cli, teardown := newTestClient(t)
+	defer teardown()
+	ctx := &c.Context{TenantId: "tenant1", UserId: "user1"}
+
+	gs := SampleGroupSnapshots[0]
+	gs.BaseModel = newBaseModel(gs.Id)
+	if _, err := cli.CreateVolumeGroupSnapshot(ctx, &gs); err != nil {
+		t.Fatal("Create volume group snapshot failed:", err)
+	}
+	if _, err := cli.GetVolumeGroupSnapshot(&c.Context{TenantId: "tenant2"}, gs.Id); err == nil {
+		t.Error("Expected the group snapshot to be invisible to other tenants")
+	}
+
+	if err := cli.UpdateStatus(ctx, &gs, model.VolumeGroupSnapError); err != nil {
+		t.Fatal("Update volume group snapshot status failed:", err)
+	}
+	gss, err := cli.ListGroupSnapshotsByGroupId(ctx, gs.GroupId)
+	if err != nil {
+		t.Fatal("List volume group snapshots failed:", err)
+	}
+	if len(gss) != 1 || gss[0].Status != model.VolumeGroupSnapError || gss[0].UserId != "user1" ||
+		!reflect.DeepEqual(gss[0].Snapshots, gs.Snapshots) {
+		t.Errorf("Unexpected group snapshots: %+v\n", gss)
+	}
+	if gss, _ = cli.ListGroupSnapshotsByGroupId(ctx, "unknown"); len(gss) != 0 {
+		t.Errorf("Expected no group snapshot, got %+v\n", gss)
+	}
+
+	if err = cli.DeleteVolumeGroupSnapshot(ctx, gs.Id); err != nil {
+		t.Fatal("Delete volume group snapshot failed:", err)
+	}
+	if gss, _ = cli.ListVolumeGroupSnapshots(c.NewAdminContext()); len(gss) != 0 {
+		t.Errorf("Expected no group snapshot, got %+v\n", gss)
+	}
+}
+
+func TestAuditRecord(t *testing.T) {
+	cli, teardown := newTestClient(t)
+	defer teardown()
+	ctx := c.NewAdminContext()
+
+	for _, rec := range []*model.AuditRecordSpec{
+		{BaseModel: &model.BaseModel{CreatedAt: "2019-05-01T08:00:00"}, TenantId: "tenant1", Action: "volume:create",
+			ResourceId: "vol1", Outcome: model.AuditOutcomeSuccess},
+		{BaseModel: &model.BaseModel{CreatedAt: "2019-05-01T09:00:00"}, TenantId: "tenant1", Action: "volume:delete",
+			ResourceId: "vol1", Outcome: model.AuditOutcomeDenied},
+		{BaseModel: &model.BaseModel{CreatedAt: "2019-05-01T10:00:00"}, TenantId: "tenant2", Action: "volume:create",
+			ResourceId: "vol2", Outcome: model.AuditOutcomeSuccess},
+	} {
+		if _, err := cli.CreateAuditRecord(ctx, rec); err != nil {
+			t.Fatal("Create audit record failed:", err)
+		}
+	}
+
+	actions := func(recs []*model.AuditRecordSpec) []string {
+		var result []string
+		for _, rec := range recs {
+			result = append(result, rec.TenantId+" "+rec.Action)
+		}
+		return result
+	}
+	for _, tc := range []struct {
+		m        map[string][]string
+		expected []string
+	}{
+		{nil, []string{"tenant2 volume:create", "tenant1 volume:delete", "tenant1 volume:create"}},
+		{map[string][]string{"resourceId": {"vol1"}}, []string{"tenant1 volume:delete", "tenant1 volume:create"}},
+		{map[string][]string{"since": {"2019-05-01T09:00:00"}, "until": {"2019-05-01T10:00:00"}},
+			[]string{"tenant1 volume:delete"}},
+		{map[string][]string{"outcome": {"denied"}, "tenantId": {"tenant1"}}, []string{"tenant1 volume:delete"}},
+	} {
+		recs, err := cli.ListAuditRecords(ctx, tc.m)
+		if err != nil {
+			t.Fatal("List audit records failed:", err)
+		}
+		if got := actions(recs); !reflect.DeepEqual(got, tc.expected) {
+			t.Errorf("Expected %v with filter %v, got %v", tc.expected, tc.m, got)
+		}
+	}
+}
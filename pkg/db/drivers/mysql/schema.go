@@ -0,0 +1,496 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	log "github.com/golang/glog"
+	"github.com/opensds/opensds/pkg/utils/constants"
+)
+
+const (
+	migrationTable   = "schema_migrations"
+	migrationLock    = "opensds_schema_migrations"
+	migrationTimeout = 60
+)
+
+// dialect describes the differences between the sql databases supported
+// by this driver.
+type dialect struct {
+	name string
+	// stringType is the column type of indexed string fields, it's expected to
+	// be compared case-insensitively.
+	stringType string
+	// textType is the column type of long string fields which may be filtered
+	// or sorted but never indexed.
+	textType string
+	// bodyType is the column type used to store the json body of the object.
+	bodyType string
+	// tableOptions is appended to every CREATE TABLE statement.
+	tableOptions string
+}
+
+var dialects = map[string]*dialect{
+	"mysql": {
+		name:         "mysql",
+		stringType:   "VARCHAR(255)",
+		textType:     "TEXT",
+		bodyType:     "LONGTEXT",
+		tableOptions: " ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci",
+	},
+	"sqlite3": {
+		name:         "sqlite3",
+		stringType:   "VARCHAR(255) COLLATE NOCASE",
+		textType:     "TEXT COLLATE NOCASE",
+		bodyType:     "TEXT",
+		tableOptions: "",
+	},
+}
+
+// expand replaces the type placeholders in a schema statement with the
+// column types of the dialect.
+func (d *dialect) expand(stmt string) string {
+	return strings.NewReplacer(
+		"$STRING", d.stringType,
+		"$TEXT", d.textType,
+		"$BODY", d.bodyType,
+		"$OPTIONS", d.tableOptions,
+	).Replace(stmt)
+}
+
+// lock serializes the schema migrations of all the processes sharing the
+// database. Sqlite locks the whole database file inside a transaction, so
+// only mysql needs an explicit named lock.
+func (d *dialect) lock(ctx context.Context, conn *sql.Conn) (func(), error) {
+	if d.name != "mysql" {
+		return func() {}, nil
+	}
+	var got sql.NullInt64
+	if err := conn.QueryRowContext(ctx, "SELECT GET_LOCK(?, ?)", migrationLock, migrationTimeout).Scan(&got); err != nil {
+		return nil, err
+	}
+	if !got.Valid || got.Int64 != 1 {
+		return nil, fmt.Errorf("timeout when waiting for lock %s", migrationLock)
+	}
+	return func() {
+		conn.ExecContext(ctx, "SELECT RELEASE_LOCK(?)", migrationLock)
+	}, nil
+}
+
+// migration is a versioned change of the database schema. Migrations are
+// applied in order and never modified once released, any further change of
+// the schema must be appended as a new migration.
+type migration struct {
+	version     int
+	description string
+	statements  []string
+}
+
+var migrations = []migration{
+	{
+		version:     1,
+		description: "create initial tables",
+		statements: []string{
+			`CREATE TABLE docks (
+				id VARCHAR(64) NOT NULL PRIMARY KEY,
+				created_at VARCHAR(32),
+				updated_at VARCHAR(32),
+				name $STRING,
+				description $TEXT,
+				status $STRING,
+				storage_type $STRING,
+				endpoint $STRING,
+				node_id $STRING,
+				driver_name $STRING,
+				body $BODY NOT NULL
+			)$OPTIONS`,
+			`CREATE TABLE pools (
+				id VARCHAR(64) NOT NULL PRIMARY KEY,
+				created_at VARCHAR(32),
+				updated_at VARCHAR(32),
+				name $STRING,
+				description $TEXT,
+				status $STRING,
+				dock_id $STRING,
+				availability_zone $STRING,
+				storage_type $STRING,
+				total_capacity BIGINT,
+				free_capacity BIGINT,
+				body $BODY NOT NULL
+			)$OPTIONS`,
+			`CREATE INDEX idx_pools_dock_id ON pools (dock_id)`,
+			`CREATE TABLE profiles (
+				id VARCHAR(64) NOT NULL PRIMARY KEY,
+				created_at VARCHAR(32),
+				updated_at VARCHAR(32),
+				name $STRING,
+				description $TEXT,
+				storage_type $STRING,
+				body $BODY NOT NULL
+			)$OPTIONS`,
+			`CREATE INDEX idx_profiles_name ON profiles (name)`,
+			`CREATE TABLE volumes (
+				id VARCHAR(64) NOT NULL PRIMARY KEY,
+				created_at VARCHAR(32),
+				updated_at VARCHAR(32),
+				tenant_id $STRING,
+				user_id $STRING,
+				name $STRING,
+				description $TEXT,
+				status $STRING,
+				size BIGINT,
+				availability_zone $STRING,
+				pool_id $STRING,
+				profile_id $STRING,
+				group_id $STRING,
+				snapshot_id $STRING,
+				body $BODY NOT NULL
+			)$OPTIONS`,
+			`CREATE INDEX idx_volumes_tenant_id ON volumes (tenant_id)`,
+			`CREATE INDEX idx_volumes_status ON volumes (status)`,
+			`CREATE INDEX idx_volumes_pool_id ON volumes (pool_id)`,
+			`CREATE INDEX idx_volumes_group_id ON volumes (group_id)`,
+			`CREATE TABLE volume_attachments (
+				id VARCHAR(64) NOT NULL PRIMARY KEY,
+				created_at VARCHAR(32),
+				updated_at VARCHAR(32),
+				tenant_id $STRING,
+				user_id $STRING,
+				volume_id $STRING,
+				mountpoint $STRING,
+				status $STRING,
+				body $BODY NOT NULL
+			)$OPTIONS`,
+			`CREATE INDEX idx_volume_attachments_tenant_id ON volume_attachments (tenant_id)`,
+			`CREATE INDEX idx_volume_attachments_volume_id ON volume_attachments (volume_id)`,
+			`CREATE TABLE volume_snapshots (
+				id VARCHAR(64) NOT NULL PRIMARY KEY,
+				created_at VARCHAR(32),
+				updated_at VARCHAR(32),
+				tenant_id $STRING,
+				user_id $STRING,
+				name $STRING,
+				description $TEXT,
+				status $STRING,
+				size BIGINT,
+				volume_id $STRING,
+				profile_id $STRING,
+				body $BODY NOT NULL
+			)$OPTIONS`,
+			`CREATE INDEX idx_volume_snapshots_tenant_id ON volume_snapshots (tenant_id)`,
+			`CREATE INDEX idx_volume_snapshots_volume_id ON volume_snapshots (volume_id)`,
+			`CREATE TABLE backups (
+				id VARCHAR(64) NOT NULL PRIMARY KEY,
+				created_at VARCHAR(32),
+				updated_at VARCHAR(32),
+				tenant_id $STRING,
+				user_id $STRING,
+				name $STRING,
+				description $TEXT,
+				status $STRING,
+				size BIGINT,
+				volume_id $STRING,
+				snapshot_id $STRING,
+				pool_id $STRING,
+				body $BODY NOT NULL
+			)$OPTIONS`,
+			`CREATE INDEX idx_backups_tenant_id ON backups (tenant_id)`,
+			`CREATE INDEX idx_backups_volume_id ON backups (volume_id)`,
+			`CREATE TABLE replications (
+				id VARCHAR(64) NOT NULL PRIMARY KEY,
+				created_at VARCHAR(32),
+				updated_at VARCHAR(32),
+				tenant_id $STRING,
+				user_id $STRING,
+				name $STRING,
+				description $TEXT,
+				replication_status $STRING,
+				availability_zone $STRING,
+				primary_volume_id $STRING,
+				secondary_volume_id $STRING,
+				profile_id $STRING,
+				pool_id $STRING,
+				body $BODY NOT NULL
+			)$OPTIONS`,
+			`CREATE INDEX idx_replications_tenant_id ON replications (tenant_id)`,
+			`CREATE INDEX idx_replications_primary_volume_id ON replications (primary_volume_id)`,
+			`CREATE INDEX idx_replications_secondary_volume_id ON replications (secondary_volume_id)`,
+			`CREATE TABLE volume_groups (
+				id VARCHAR(64) NOT NULL PRIMARY KEY,
+				created_at VARCHAR(32),
+				updated_at VARCHAR(32),
+				tenant_id $STRING,
+				user_id $STRING,
+				name $STRING,
+				description $TEXT,
+				status $STRING,
+				availability_zone $STRING,
+				pool_id $STRING,
+				body $BODY NOT NULL
+			)$OPTIONS`,
+			`CREATE INDEX idx_volume_groups_tenant_id ON volume_groups (tenant_id)`,
+			`CREATE TABLE operations (
+				id VARCHAR(64) NOT NULL PRIMARY KEY,
+				created_at VARCHAR(32),
+				updated_at VARCHAR(32),
+				tenant_id $STRING,
+				user_id $STRING,
+				action $STRING,
+				resource_type $STRING,
+				resource_id $STRING,
+				dock_id $STRING,
+				status $STRING,
+				body $BODY NOT NULL
+			)$OPTIONS`,
+			`CREATE INDEX idx_operations_tenant_id ON operations (tenant_id)`,
+			`CREATE INDEX idx_operations_resource_id ON operations (resource_id)`,
+			`CREATE INDEX idx_operations_status ON operations (status)`,
+			`CREATE TABLE fileshares (
+				id VARCHAR(64) NOT NULL PRIMARY KEY,
+				created_at VARCHAR(32),
+				updated_at VARCHAR(32),
+				tenant_id $STRING,
+				user_id $STRING,
+				name $STRING,
+				description $TEXT,
+				status $STRING,
+				size BIGINT,
+				availability_zone $STRING,
+				pool_id $STRING,
+				profile_id $STRING,
+				snapshot_id $STRING,
+				body $BODY NOT NULL
+			)$OPTIONS`,
+			`CREATE INDEX idx_fileshares_tenant_id ON fileshares (tenant_id)`,
+			`CREATE TABLE fileshare_acls (
+				id VARCHAR(64) NOT NULL PRIMARY KEY,
+				created_at VARCHAR(32),
+				updated_at VARCHAR(32),
+				tenant_id $STRING,
+				fileshare_id $STRING,
+				type $STRING,
+				description $TEXT,
+				body $BODY NOT NULL
+			)$OPTIONS`,
+			`CREATE INDEX idx_fileshare_acls_tenant_id ON fileshare_acls (tenant_id)`,
+			`CREATE INDEX idx_fileshare_acls_fileshare_id ON fileshare_acls (fileshare_id)`,
+			`CREATE TABLE fileshare_snapshots (
+				id VARCHAR(64) NOT NULL PRIMARY KEY,
+				created_at VARCHAR(32),
+				updated_at VARCHAR(32),
+				tenant_id $STRING,
+				user_id $STRING,
+				name $STRING,
+				description $TEXT,
+				status $STRING,
+				snapshot_size BIGINT,
+				fileshare_id $STRING,
+				body $BODY NOT NULL
+			)$OPTIONS`,
+			`CREATE INDEX idx_fileshare_snapshots_tenant_id ON fileshare_snapshots (tenant_id)`,
+			`CREATE INDEX idx_fileshare_snapshots_fileshare_id ON fileshare_snapshots (fileshare_id)`,
+		},
+	},
+	{
+		// Revision zero means any revision in an update, so the existing
+		// objects start from one.
+		version:     2,
+		description: "add object revisions",
+		statements: []string{
+			`ALTER TABLE docks ADD COLUMN revision BIGINT NOT NULL DEFAULT 1`,
+			`ALTER TABLE pools ADD COLUMN revision BIGINT NOT NULL DEFAULT 1`,
+			`ALTER TABLE profiles ADD COLUMN revision BIGINT NOT NULL DEFAULT 1`,
+			`ALTER TABLE volumes ADD COLUMN revision BIGINT NOT NULL DEFAULT 1`,
+			`ALTER TABLE volume_attachments ADD COLUMN revision BIGINT NOT NULL DEFAULT 1`,
+			`ALTER TABLE volume_snapshots ADD COLUMN revision BIGINT NOT NULL DEFAULT 1`,
+			`ALTER TABLE backups ADD COLUMN revision BIGINT NOT NULL DEFAULT 1`,
+			`ALTER TABLE replications ADD COLUMN revision BIGINT NOT NULL DEFAULT 1`,
+			`ALTER TABLE volume_groups ADD COLUMN revision BIGINT NOT NULL DEFAULT 1`,
+			`ALTER TABLE operations ADD COLUMN revision BIGINT NOT NULL DEFAULT 1`,
+			`ALTER TABLE fileshares ADD COLUMN revision BIGINT NOT NULL DEFAULT 1`,
+			`ALTER TABLE fileshare_acls ADD COLUMN revision BIGINT NOT NULL DEFAULT 1`,
+			`ALTER TABLE fileshare_snapshots ADD COLUMN revision BIGINT NOT NULL DEFAULT 1`,
+		},
+	},
+	{
+		version:     3,
+		description: "create quota tables",
+		statements: []string{
+			`CREATE TABLE quotas (
+				id VARCHAR(64) NOT NULL PRIMARY KEY,
+				created_at VARCHAR(32),
+				updated_at VARCHAR(32),
+				tenant_id $STRING,
+				body $BODY NOT NULL,
+				revision BIGINT NOT NULL DEFAULT 1
+			)$OPTIONS`,
+			`CREATE TABLE quota_usages (
+				id VARCHAR(64) NOT NULL PRIMARY KEY,
+				created_at VARCHAR(32),
+				updated_at VARCHAR(32),
+				tenant_id $STRING,
+				body $BODY NOT NULL,
+				revision BIGINT NOT NULL DEFAULT 1
+			)$OPTIONS`,
+		},
+	},
+	{
+		version:     4,
+		description: "add fileshare acl status",
+		statements: []string{
+			`ALTER TABLE fileshare_acls ADD COLUMN status $STRING`,
+		},
+	},
+	{
+		version:     5,
+		description: "create webhook tables",
+		statements: []string{
+			`CREATE TABLE webhooks (
+				id VARCHAR(64) NOT NULL PRIMARY KEY,
+				created_at VARCHAR(32),
+				updated_at VARCHAR(32),
+				tenant_id $STRING,
+				user_id $STRING,
+				name $STRING,
+				url $TEXT,
+				body $BODY NOT NULL,
+				revision BIGINT NOT NULL DEFAULT 1
+			)$OPTIONS`,
+			`CREATE INDEX idx_webhooks_tenant_id ON webhooks (tenant_id)`,
+			`CREATE TABLE webhook_deliveries (
+				id VARCHAR(64) NOT NULL PRIMARY KEY,
+				created_at VARCHAR(32),
+				updated_at VARCHAR(32),
+				tenant_id $STRING,
+				webhook_id $STRING,
+				status $STRING,
+				body $BODY NOT NULL,
+				revision BIGINT NOT NULL DEFAULT 1
+			)$OPTIONS`,
+			`CREATE INDEX idx_webhook_deliveries_webhook_id ON webhook_deliveries (webhook_id)`,
+		},
+	},
+	{
+		version:     6,
+		description: "create audit table",
+		statements: []string{
+			`CREATE TABLE audit_records (
+				id VARCHAR(64) NOT NULL PRIMARY KEY,
+				created_at VARCHAR(32),
+				updated_at VARCHAR(32),
+				tenant_id $STRING,
+				user_id $STRING,
+				action $STRING,
+				resource_id $STRING,
+				request_id $STRING,
+				outcome $STRING,
+				body $BODY NOT NULL,
+				revision BIGINT NOT NULL DEFAULT 1
+			)$OPTIONS`,
+			`CREATE INDEX idx_audit_records_created_at ON audit_records (created_at)`,
+			`CREATE INDEX idx_audit_records_resource_id ON audit_records (resource_id)`,
+		},
+	},
+	{
+		version:     7,
+		description: "create volume group snapshot table",
+		statements: []string{
+			`CREATE TABLE volume_group_snapshots (
+				id VARCHAR(64) NOT NULL PRIMARY KEY,
+				created_at VARCHAR(32),
+				updated_at VARCHAR(32),
+				tenant_id $STRING,
+				user_id $STRING,
+				name $STRING,
+				description $TEXT,
+				status $STRING,
+				group_id $STRING,
+				body $BODY NOT NULL,
+				revision BIGINT NOT NULL DEFAULT 1
+			)$OPTIONS`,
+			`CREATE INDEX idx_volume_group_snapshots_tenant_id ON volume_group_snapshots (tenant_id)`,
+			`CREATE INDEX idx_volume_group_snapshots_group_id ON volume_group_snapshots (group_id)`,
+		},
+	},
+}
+
+// migrate brings the database schema up to the latest version, the applied
+// versions are recorded in the schema_migrations table.
+func migrate(db *sql.DB, d *dialect) error {
+	ctx := context.Background()
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	unlock, err := d.lock(ctx, conn)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	stmt := fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+		version INTEGER NOT NULL PRIMARY KEY,
+		description VARCHAR(255),
+		applied_at VARCHAR(32)
+	)%s`, migrationTable, d.tableOptions)
+	if _, err = conn.ExecContext(ctx, stmt); err != nil {
+		return err
+	}
+
+	var current sql.NullInt64
+	if err = conn.QueryRowContext(ctx, fmt.Sprintf("SELECT MAX(version) FROM %s", migrationTable)).Scan(&current); err != nil {
+		return err
+	}
+
+	for _, m := range migrations {
+		if int64(m.version) <= current.Int64 {
+			continue
+		}
+		log.Infof("Applying schema migration %d: %s", m.version, m.description)
+		if err = applyMigration(ctx, conn, d, m); err != nil {
+			return fmt.Errorf("apply schema migration %d failed: %v", m.version, err)
+		}
+	}
+	return nil
+}
+
+// applyMigration runs all the statements of a migration in one transaction.
+// Mysql commits DDL statements implicitly, so a failed migration there has to
+// be cleaned up manually before the service can start again.
+func applyMigration(ctx context.Context, conn *sql.Conn, d *dialect, m migration) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	for _, stmt := range m.statements {
+		if _, err = tx.ExecContext(ctx, d.expand(stmt)); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	insert := fmt.Sprintf("INSERT INTO %s (version, description, applied_at) VALUES (?, ?, ?)", migrationTable)
+	if _, err = tx.ExecContext(ctx, insert, m.version, m.description, time.Now().Format(constants.TimeFormat)); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
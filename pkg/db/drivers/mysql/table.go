@@ -0,0 +1,507 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	log "github.com/golang/glog"
+	c "github.com/opensds/opensds/pkg/context"
+	"github.com/opensds/opensds/pkg/model"
+)
+
+const (
+	defaultLimit   = 50
+	defaultOffset  = 0
+	defaultSortDir = "desc"
+	defaultSortKey = "id"
+)
+
+var validKey = []string{"limit", "offset", "sortDir", "sortKey"}
+
+// column maps a field of the model spec to a column of the table, all the
+// columns can be used to filter and sort the objects.
+type column struct {
+	name    string
+	field   string
+	numeric bool
+}
+
+func str(name, field string) column { return column{name: name, field: field} }
+
+func num(name, field string) column { return column{name: name, field: field, numeric: true} }
+
+var baseColumns = []column{
+	str("id", "Id"),
+	str("created_at", "CreatedAt"),
+	str("updated_at", "UpdatedAt"),
+}
+
+// table describes how one kind of object is stored. Besides the columns
+// every table has a body column holding the whole object in json format.
+type table struct {
+	name string
+	kind string
+	// scoped tables store objects owned by tenants, only admin can access
+	// the objects of other tenants.
+	scoped  bool
+	columns []column
+}
+
+func newTable(name, kind string, scoped bool, columns ...column) *table {
+	return &table{
+		name:    name,
+		kind:    kind,
+		scoped:  scoped,
+		columns: append(append([]column{}, baseColumns...), columns...),
+	}
+}
+
+// column finds the column by either its name or the field name of the
+// model spec, the key is case-insensitive.
+func (t *table) column(key string) (column, bool) {
+	for _, col := range t.columns {
+		if strings.EqualFold(key, col.field) || strings.EqualFold(key, col.name) {
+			return col, true
+		}
+	}
+	return column{}, false
+}
+
+var (
+	dockTable = newTable("docks", "dock", false,
+		str("name", "Name"), str("description", "Description"), str("status", "Status"),
+		str("storage_type", "StorageType"), str("endpoint", "Endpoint"),
+		str("node_id", "NodeId"), str("driver_name", "DriverName"))
+
+	poolTable = newTable("pools", "pool", false,
+		str("name", "Name"), str("description", "Description"), str("status", "Status"),
+		str("dock_id", "DockId"), str("availability_zone", "AvailabilityZone"),
+		str("storage_type", "StorageType"), num("total_capacity", "TotalCapacity"),
+		num("free_capacity", "FreeCapacity"))
+
+	profileTable = newTable("profiles", "profile", false,
+		str("name", "Name"), str("description", "Description"), str("storage_type", "StorageType"))
+
+	volumeTable = newTable("volumes", "volume", true,
+		str("tenant_id", "TenantId"), str("user_id", "UserId"), str("name", "Name"),
+		str("description", "Description"), str("status", "Status"), num("size", "Size"),
+		str("availability_zone", "AvailabilityZone"), str("pool_id", "PoolId"),
+		str("profile_id", "ProfileId"), str("group_id", "GroupId"), str("snapshot_id", "SnapshotId"))
+
+	attachmentTable = newTable("volume_attachments", "volume attachment", true,
+		str("tenant_id", "TenantId"), str("user_id", "UserId"), str("volume_id", "VolumeId"),
+		str("mountpoint", "Mountpoint"), str("status", "Status"))
+
+	snapshotTable = newTable("volume_snapshots", "volume snapshot", true,
+		str("tenant_id", "TenantId"), str("user_id", "UserId"), str("name", "Name"),
+		str("description", "Description"), str("status", "Status"), num("size", "Size"),
+		str("volume_id", "VolumeId"), str("profile_id", "ProfileId"))
+
+	backupTable = newTable("backups", "volume backup", true,
+		str("tenant_id", "TenantId"), str("user_id", "UserId"), str("name", "Name"),
+		str("description", "Description"), str("status", "Status"), num("size", "Size"),
+		str("volume_id", "VolumeId"), str("snapshot_id", "SnapshotId"), str("pool_id", "PoolId"))
+
+	replicationTable = newTable("replications", "replication", true,
+		str("tenant_id", "TenantId"), str("user_id", "UserId"), str("name", "Name"),
+		str("description", "Description"), str("replication_status", "ReplicationStatus"),
+		str("availability_zone", "AvailabilityZone"), str("primary_volume_id", "PrimaryVolumeId"),
+		str("secondary_volume_id", "SecondaryVolumeId"), str("profile_id", "ProfileId"),
+		str("pool_id", "PoolId"))
+
+	volumeGroupTable = newTable("volume_groups", "volume group", true,
+		str("tenant_id", "TenantId"), str("user_id", "UserId"), str("name", "Name"),
+		str("description", "Description"), str("status", "Status"),
+		str("availability_zone", "AvailabilityZone"), str("pool_id", "PoolId"))
+
+	groupSnapshotTable = newTable("volume_group_snapshots", "volume group snapshot", true,
+		str("tenant_id", "TenantId"), str("user_id", "UserId"), str("name", "Name"),
+		str("description", "Description"), str("status", "Status"), str("group_id", "GroupId"))
+
+	operationTable = newTable("operations", "operation", true,
+		str("tenant_id", "TenantId"), str("user_id", "UserId"), str("action", "Action"),
+		str("resource_type", "ResourceType"), str("resource_id", "ResourceId"),
+		str("dock_id", "DockId"), str("status", "Status"))
+
+	fileShareTable = newTable("fileshares", "fileshare", true,
+		str("tenant_id", "TenantId"), str("user_id", "UserId"), str("name", "Name"),
+		str("description", "Description"), str("status", "Status"), num("size", "Size"),
+		str("availability_zone", "AvailabilityZone"), str("pool_id", "PoolId"),
+		str("profile_id", "ProfileId"), str("snapshot_id", "SnapshotId"))
+
+	fileShareAclTable = newTable("fileshare_acls", "fileshare acl", true,
+		str("tenant_id", "TenantId"), str("fileshare_id", "FileShareId"), str("type", "Type"),
+		str("description", "Description"), str("status", "Status"))
+
+	fileShareSnapshotTable = newTable("fileshare_snapshots", "fileshare snapshot", true,
+		str("tenant_id", "TenantId"), str("user_id", "UserId"), str("name", "Name"),
+		str("description", "Description"), str("status", "Status"),
+		num("snapshot_size", "SnapshotSize"), str("fileshare_id", "FileShareId"))
+
+	// The quotas and usages are identified by the tenants, the callers are
+	// in charge of checking the access to them.
+	quotaTable = newTable("quotas", "quota", false, str("tenant_id", "TenantId"))
+
+	quotaUsageTable = newTable("quota_usages", "quota usage", false, str("tenant_id", "TenantId"))
+
+	webhookTable = newTable("webhooks", "webhook", true,
+		str("tenant_id", "TenantId"), str("user_id", "UserId"), str("name", "Name"), str("url", "Url"))
+
+	webhookDeliveryTable = newTable("webhook_deliveries", "webhook delivery", true,
+		str("tenant_id", "TenantId"), str("webhook_id", "WebhookId"), str("status", "Status"))
+
+	// The audit records are read by admin only.
+	auditTable = newTable("audit_records", "audit record", false,
+		str("tenant_id", "TenantId"), str("user_id", "UserId"), str("action", "Action"),
+		str("resource_id", "ResourceId"), str("request_id", "RequestId"), str("outcome", "Outcome"))
+)
+
+// condition is an extra restriction of the WHERE clause.
+type condition struct {
+	clause string
+	args   []interface{}
+}
+
+func eq(col string, value interface{}) condition {
+	return condition{clause: col + " = ?", args: []interface{}{value}}
+}
+
+// parameter holds the sorting and paging parameters of a list request.
+type parameter struct {
+	limit, offset    int
+	sortKey, sortDir string
+}
+
+// fieldValue returns the value of the named field of spec, the fields of a
+// nil embedded struct are treated as zero values.
+func fieldValue(spec interface{}, name string) interface{} {
+	v := reflect.Indirect(reflect.ValueOf(spec))
+	f, ok := v.Type().FieldByName(name)
+	if !ok {
+		return nil
+	}
+	for _, i := range f.Index {
+		if v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return reflect.Zero(f.Type).Interface()
+			}
+			v = v.Elem()
+		}
+		v = v.Field(i)
+	}
+	return v.Interface()
+}
+
+// baseModel returns the embedded base model of spec, or nil if there is none.
+func baseModel(spec interface{}) *model.BaseModel {
+	v := reflect.Indirect(reflect.ValueOf(spec))
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+	f := v.FieldByName("BaseModel")
+	if !f.IsValid() || f.IsNil() {
+		return nil
+	}
+	b, _ := f.Interface().(*model.BaseModel)
+	return b
+}
+
+// record is an object read from db.
+type record struct {
+	body     string
+	revision int64
+}
+
+// decode parses the json body of the record into out and sets the revision.
+func (r *record) decode(out interface{}) error {
+	if err := json.Unmarshal([]byte(r.body), out); err != nil {
+		return err
+	}
+	if b := baseModel(out); b != nil {
+		b.Revision = r.revision
+	}
+	return nil
+}
+
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
+
+// scope returns the condition limiting the query to the objects of the
+// tenant in the context, admin can access the objects of all tenants.
+func scope(ctx *c.Context, t *table) []condition {
+	if !t.scoped || IsAdminContext(ctx) {
+		return nil
+	}
+	return []condition{eq("tenant_id", ctx.TenantId)}
+}
+
+func where(conds []condition) (string, []interface{}) {
+	if len(conds) == 0 {
+		return "", nil
+	}
+	var clauses []string
+	var args []interface{}
+	for _, cond := range conds {
+		clauses = append(clauses, cond.clause)
+		args = append(args, cond.args...)
+	}
+	return " WHERE " + strings.Join(clauses, " AND "), args
+}
+
+// put writes the object into the table, an existing object with the same id
+// will be replaced.
+func (c *Client) put(t *table, spec interface{}) error {
+	body, err := json.Marshal(spec)
+	if err != nil {
+		return err
+	}
+
+	var cols []string
+	var args []interface{}
+	for _, col := range t.columns {
+		cols = append(cols, col.name)
+		args = append(args, fieldValue(spec, col.field))
+	}
+	cols = append(cols, "body")
+	args = append(args, string(body))
+
+	stmt := fmt.Sprintf("REPLACE INTO %s (%s) VALUES (%s)", t.name, strings.Join(cols, ", "), placeholders(len(cols)))
+	if _, err = c.db.Exec(stmt, args...); err != nil {
+		log.Errorf("When put %s in db: %v", t.kind, err)
+		return err
+	}
+	// A new object always starts from the default revision of the column.
+	if b := baseModel(spec); b != nil {
+		b.Revision = 1
+	}
+	return nil
+}
+
+// update rewrites all the columns of an existing object.
+func (c *Client) update(t *table, id string, spec interface{}) error {
+	b := baseModel(spec)
+	if b == nil {
+		return fmt.Errorf("%s(%s) has no revision", t.kind, id)
+	}
+	body, err := json.Marshal(spec)
+	if err != nil {
+		return err
+	}
+
+	var sets []string
+	var args []interface{}
+	for _, col := range t.columns[1:] {
+		sets = append(sets, col.name+" = ?")
+		args = append(args, fieldValue(spec, col.field))
+	}
+	sets = append(sets, "body = ?", "revision = revision + 1")
+	args = append(args, string(body), id, b.Revision)
+
+	// Only update the object if nobody else has modified it since the
+	// revision which spec was read from.
+	stmt := fmt.Sprintf("UPDATE %s SET %s WHERE id = ? AND revision = ?", t.name, strings.Join(sets, ", "))
+	res, err := c.db.Exec(stmt, args...)
+	if err != nil {
+		log.Errorf("When update %s in db: %v", t.kind, err)
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return model.NewConflictError(fmt.Sprintf("%s(%s) has been modified since revision %d", t.kind, id, b.Revision))
+	}
+	b.Revision++
+	return nil
+}
+
+// get reads the object specified by id into out, a NotFoundError is returned
+// if the object doesn't exist or is owned by another tenant.
+func (c *Client) get(ctx *c.Context, t *table, id string, out interface{}) error {
+	clause, args := where(append([]condition{eq("id", id)}, scope(ctx, t)...))
+
+	var rec record
+	err := c.db.QueryRow("SELECT body, revision FROM "+t.name+clause, args...).Scan(&rec.body, &rec.revision)
+	if err == sql.ErrNoRows {
+		return model.NewNotFoundError(fmt.Sprintf("specified %s(%s) can't find", t.kind, id))
+	}
+	if err != nil {
+		log.Errorf("When get %s in db: %v", t.kind, err)
+		return err
+	}
+	if err = rec.decode(out); err != nil {
+		log.Errorf("When parsing %s in db: %v", t.kind, err)
+		return err
+	}
+	return nil
+}
+
+// remove deletes the object specified by id, it does nothing if the object
+// doesn't exist.
+func (c *Client) remove(ctx *c.Context, t *table, id string) error {
+	clause, args := where(append([]condition{eq("id", id)}, scope(ctx, t)...))
+
+	if _, err := c.db.Exec("DELETE FROM "+t.name+clause, args...); err != nil {
+		log.Errorf("When delete %s in db: %v", t.kind, err)
+		return err
+	}
+	return nil
+}
+
+// list returns the records of the objects matching the conditions and
+// the filters in m. The keys of m are the field names of the model spec and
+// compared case-insensitively, keys which are not columns of the table are
+// ignored. Sorting and paging follow the same rules as the etcd driver:
+// limit 50 or an invalid limit returns all the objects, and an offset out of
+// bounds starts from the first object. A nil m lists all the objects in the
+// order of their ids.
+func (c *Client) list(ctx *c.Context, t *table, m map[string][]string, conds ...condition) ([]record, error) {
+	conds = append(scope(ctx, t), conds...)
+
+	var keys []string
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if isInArray(k, validKey) || len(m[k]) == 0 {
+			continue
+		}
+		col, ok := t.column(k)
+		if !ok {
+			continue
+		}
+		var value interface{} = m[k][0]
+		if col.numeric {
+			n, err := strconv.ParseInt(m[k][0], 10, 64)
+			if err != nil {
+				return []record{}, nil
+			}
+			value = n
+		}
+		conds = append(conds, eq(col.name, value))
+	}
+	clause, args := where(conds)
+
+	p := c.parameterFilter(t, m)
+	records, err := c.query(t, clause, args, p)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) != 0 || p.offset == 0 {
+		return records, nil
+	}
+
+	// Start from the first object if the offset is out of bounds.
+	var size int
+	if err = c.db.QueryRow("SELECT COUNT(*) FROM "+t.name+clause, args...).Scan(&size); err != nil {
+		log.Errorf("When count %s in db: %v", t.kind, err)
+		return nil, err
+	}
+	if p.offset <= size {
+		return records, nil
+	}
+	log.Warning("Input offset is out of bounds:", p.offset, ",use default value instead:0")
+	p.offset = defaultOffset
+	return c.query(t, clause, args, p)
+}
+
+func (c *Client) query(t *table, clause string, args []interface{}, p *parameter) ([]record, error) {
+	limit := int64(p.limit)
+	if p.limit < 0 {
+		limit = math.MaxInt64
+	}
+	stmt := fmt.Sprintf("SELECT body, revision FROM %s%s ORDER BY %s %s, id %s LIMIT ? OFFSET ?",
+		t.name, clause, p.sortKey, p.sortDir, p.sortDir)
+
+	rows, err := c.db.Query(stmt, append(args, limit, p.offset)...)
+	if err != nil {
+		log.Errorf("When list %s in db: %v", t.kind, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var records = []record{}
+	for rows.Next() {
+		var rec record
+		if err = rows.Scan(&rec.body, &rec.revision); err != nil {
+			log.Errorf("When list %s in db: %v", t.kind, err)
+			return nil, err
+		}
+		records = append(records, rec)
+	}
+	return records, rows.Err()
+}
+
+// parameterFilter parses the sorting and paging parameters of m, the
+// invalid parameters are replaced by default values.
+func (c *Client) parameterFilter(t *table, m map[string][]string) *parameter {
+	if m == nil {
+		return &parameter{limit: -1, offset: defaultOffset, sortKey: defaultSortKey, sortDir: "ASC"}
+	}
+
+	p := &parameter{limit: -1, offset: defaultOffset, sortKey: defaultSortKey, sortDir: "DESC"}
+	if v, ok := m["limit"]; ok {
+		limit, err := strconv.Atoi(v[0])
+		if err != nil || limit < 0 {
+			log.Warning("Invalid input limit:", v[0], ",use default value instead:50")
+		} else if limit != defaultLimit {
+			// If use not specified the limit return all the items.
+			p.limit = limit
+		}
+	}
+	if v, ok := m["offset"]; ok {
+		offset, err := strconv.Atoi(v[0])
+		if err != nil || offset < 0 {
+			log.Warning("Invalid input offset:", v[0], ",use default value instead:0")
+		} else {
+			p.offset = offset
+		}
+	}
+	if v, ok := m["sortDir"]; ok {
+		if strings.EqualFold(v[0], "asc") {
+			p.sortDir = "ASC"
+		} else if !strings.EqualFold(v[0], defaultSortDir) {
+			log.Warning("Invalid input sortDir:", v[0], ",use default value instead:desc")
+		}
+	}
+	if v, ok := m["sortKey"]; ok {
+		if col, ok := t.column(v[0]); ok {
+			p.sortKey = col.name
+		} else {
+			log.Warning("Invalid input sortKey:", v[0], ",use default value instead:ID")
+		}
+	}
+	return p
+}
+
+func isInArray(e string, s []string) bool {
+	for _, v := range s {
+		if strings.EqualFold(e, v) {
+			return true
+		}
+	}
+	return false
+}
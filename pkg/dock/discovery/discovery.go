@@ -20,18 +20,22 @@ This module implements the entry into operations of storageDock module.
 package discovery
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"runtime"
 	"strings"
+	"sync"
 	"time"
 
 	log "github.com/golang/glog"
 	"github.com/opensds/opensds/contrib/connector"
 	"github.com/opensds/opensds/contrib/drivers"
 	c "github.com/opensds/opensds/pkg/context"
+	"github.com/opensds/opensds/pkg/controller/client"
 	"github.com/opensds/opensds/pkg/db"
 	"github.com/opensds/opensds/pkg/model"
+	pb "github.com/opensds/opensds/pkg/model/proto"
 	. "github.com/opensds/opensds/pkg/utils/config"
 	uuid "github.com/satori/go.uuid"
 )
@@ -61,12 +65,34 @@ func DiscoveryAndReport(dd DockDiscoverer, ctx *Context) {
 	}
 }
 
+// Heartbeat tells osdslet periodically that the docks discovered by dd are
+// still alive, or osdslet will mark them and their pools unavailable. Unlike
+// the report, a failed heartbeat doesn't stop the loop because osdslet may
+// just be restarting.
+func Heartbeat(dd DockDiscoverer, interval time.Duration, stopChan <-chan bool) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := dd.Heartbeat(); err != nil {
+			log.Warning("when sending dock heartbeat:", err)
+		}
+
+		select {
+		case <-stopChan:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
 type DockDiscoverer interface {
 	Init() error
 
 	Discover() error
 
 	Report() error
+
+	Heartbeat() error
 }
 
 // NewDockDiscoverer method creates a new DockDiscoverer.
@@ -118,6 +144,7 @@ func (pdd *provisionDockDiscoverer) Init() error {
 			Endpoint:    CONF.OsdsDock.ApiEndpoint,
 			NodeId:      host,
 			Type:        model.DockTypeProvioner,
+			Status:      model.DockAvailable,
 			Metadata:    map[string]string{"HostReplicationDriver": CONF.OsdsDock.HostBasedReplicationDriver},
 		}
 		pdd.dcks = append(pdd.dcks, dck)
@@ -131,7 +158,13 @@ func (pdd *provisionDockDiscoverer) Discover() error {
 	pdd.pols = pdd.pols[:0]
 	for _, dck := range pdd.dcks {
 		// Call function of StorageDrivers configured by storage drivers.
-		pols, err := drivers.Init(dck.DriverName).ListPools()
+		d, err := drivers.Init(dck.DriverName)
+		if err != nil {
+			log.Errorf("Init driver of dock %s failed: %v", dck.Id, err)
+			continue
+		}
+		pols, err := d.ListPools()
+		drivers.Clean(d)
 		if err != nil {
 			log.Error("Call driver to list pools failed:", err)
 			continue
@@ -152,6 +185,7 @@ func (pdd *provisionDockDiscoverer) Discover() error {
 			pol.DockId = dck.Id
 			pol.ReplicationType = replicationType
 			pol.ReplicationDriverName = replicationDriverName
+			pol.Status = model.PoolAvailable
 		}
 		pdd.pols = append(pdd.pols, pols...)
 	}
@@ -236,6 +270,7 @@ func (add *attachDockDiscoverer) Discover() error {
 		Endpoint: CONF.OsdsDock.ApiEndpoint,
 		NodeId:   host,
 		Type:     model.DockTypeAttacher,
+		Status:   model.DockAvailable,
 		Metadata: map[string]string{
 			"Platform":  runtime.GOARCH,
 			"OsType":    runtime.GOOS,
@@ -252,11 +287,16 @@ func (add *attachDockDiscoverer) Report() error {
 }
 
 func NewDockRegister() *DockRegister {
-	return &DockRegister{c: db.C}
+	return &DockRegister{c: db.C, ctr: client.NewClient()}
 }
 
 type DockRegister struct {
 	c db.Client
+	// ctr is used to send heartbeat of the registered docks to osdslet.
+	ctr client.Client
+
+	mu      sync.Mutex
+	dockIds []string
 }
 
 func (dr *DockRegister) Register(in interface{}) error {
@@ -265,14 +305,28 @@ func (dr *DockRegister) Register(in interface{}) error {
 	switch in.(type) {
 	case *model.DockSpec:
 		dck := in.(*model.DockSpec)
+		// The status of the registered dock is owned by the heartbeat monitor
+		// of osdslet, so it's kept when the dock is reported again.
+		if old, err := dr.c.GetDock(ctx, dck.Id); err == nil {
+			dck.Status = old.Status
+		}
 		// Call db module to create dock resource.
 		if _, err := dr.c.CreateDock(ctx, dck); err != nil {
 			log.Errorf("When create dock %s in db: %v\n", dck.Id, err)
 			return err
 		}
+		dr.addDock(dck.Id)
 		break
 	case *model.StoragePoolSpec:
 		pol := in.(*model.StoragePoolSpec)
+		// Same as the dock, the status of the registered pool is kept, and
+		// the new pool starts with the status of its dock.
+		if old, err := dr.c.GetPool(ctx, pol.Id); err == nil {
+			pol.Status = old.Status
+		} else if dck, err := dr.c.GetDock(ctx, pol.DockId); err == nil &&
+			dck.Status == model.DockUnavailable {
+			pol.Status = model.PoolUnavailable
+		}
 		// Call db module to create pool resource.
 		if _, err := dr.c.CreatePool(ctx, pol); err != nil {
 			log.Errorf("When create pool %s in db: %v\n", pol.Id, err)
@@ -297,6 +351,7 @@ func (dr *DockRegister) Unregister(in interface{}) error {
 			log.Errorf("When delete dock %s in db: %v\n", dck.Id, err)
 			return err
 		}
+		dr.removeDock(dck.Id)
 		break
 	case *model.StoragePoolSpec:
 		pol := in.(*model.StoragePoolSpec)
@@ -312,3 +367,56 @@ func (dr *DockRegister) Unregister(in interface{}) error {
 
 	return nil
 }
+
+// Heartbeat tells osdslet that the docks registered so far are still alive.
+func (dr *DockRegister) Heartbeat() error {
+	dr.mu.Lock()
+	ids := append([]string{}, dr.dockIds...)
+	dr.mu.Unlock()
+	// Nothing to report until the docks are registered.
+	if len(ids) == 0 {
+		return nil
+	}
+
+	if err := dr.ctr.Connect(CONF.OsdsLet.ApiEndpoint); err != nil {
+		log.Error("When connecting controller client:", err)
+		return err
+	}
+	defer dr.ctr.Close()
+
+	opt := &pb.HeartbeatOpts{
+		DockIds: ids,
+		Context: c.NewAdminContext().ToJson(),
+	}
+	response, err := dr.ctr.Heartbeat(context.Background(), opt)
+	if err != nil {
+		return err
+	}
+	if errorMsg := response.GetError(); errorMsg != nil {
+		return fmt.Errorf("failed to send heartbeat, code: %v, message: %v",
+			errorMsg.GetCode(), errorMsg.GetDescription())
+	}
+	return nil
+}
+
+func (dr *DockRegister) addDock(id string) {
+	dr.mu.Lock()
+	defer dr.mu.Unlock()
+	for _, v := range dr.dockIds {
+		if v == id {
+			return
+		}
+	}
+	dr.dockIds = append(dr.dockIds, id)
+}
+
+func (dr *DockRegister) removeDock(id string) {
+	dr.mu.Lock()
+	defer dr.mu.Unlock()
+	for i, v := range dr.dockIds {
+		if v == id {
+			dr.dockIds = append(dr.dockIds[:i], dr.dockIds[i+1:]...)
+			return
+		}
+	}
+}
@@ -15,14 +15,19 @@
 package discovery
 
 import (
+	"errors"
 	"reflect"
 	"testing"
+	"time"
 
 	c "github.com/opensds/opensds/pkg/context"
 	"github.com/opensds/opensds/pkg/model"
+	pb "github.com/opensds/opensds/pkg/model/proto"
 	. "github.com/opensds/opensds/pkg/utils/config"
 	. "github.com/opensds/opensds/testutils/collection"
+	ctrtest "github.com/opensds/opensds/testutils/controller/testing"
 	dbtest "github.com/opensds/opensds/testutils/db/testing"
+	"github.com/stretchr/testify/mock"
 )
 
 const (
@@ -62,6 +67,10 @@ func TestInit(t *testing.T) {
 		t.Errorf("Failed to init discoverer struct: %v\n", err)
 	}
 	for i := range fdd.dcks {
+		if fdd.dcks[i].Status != model.DockAvailable {
+			t.Errorf("Expected dock status %s, got %s\n", model.DockAvailable, fdd.dcks[i].Status)
+		}
+		fdd.dcks[i].Status = ""
 		fdd.dcks[i].Id = ""
 		fdd.dcks[i].NodeId = ""
 		fdd.dcks[i].Metadata = nil
@@ -86,6 +95,10 @@ func TestDiscover(t *testing.T) {
 		t.Errorf("Failed to discoverer pools: %v\n", err)
 	}
 	for _, pol := range fdd.pols {
+		if pol.Status != model.PoolAvailable {
+			t.Errorf("Expected pool status %s, got %s\n", model.PoolAvailable, pol.Status)
+		}
+		pol.Status = ""
 		pol.Id = ""
 	}
 	if !reflect.DeepEqual(fdd.pols, expected) {
@@ -104,6 +117,9 @@ func TestReport(t *testing.T) {
 	}
 
 	mockClient := new(dbtest.Client)
+	mockClient.On("GetDock", c.NewAdminContext(), fdd.dcks[0].Id).Return(nil, errors.New("not found"))
+	mockClient.On("GetPool", c.NewAdminContext(), fdd.pols[0].Id).Return(nil, errors.New("not found"))
+	mockClient.On("GetPool", c.NewAdminContext(), fdd.pols[1].Id).Return(nil, errors.New("not found"))
 	mockClient.On("CreateDock", c.NewAdminContext(), fdd.dcks[0]).Return(nil, nil)
 	mockClient.On("CreatePool", c.NewAdminContext(), fdd.pols[0]).Return(nil, nil)
 	mockClient.On("CreatePool", c.NewAdminContext(), fdd.pols[1]).Return(nil, nil)
@@ -113,3 +129,99 @@ func TestReport(t *testing.T) {
 		t.Errorf("Failed to store docks and pools into database: %v\n", err)
 	}
 }
+
+func TestReportUnavailableDock(t *testing.T) {
+	var fdd = NewFakeDockDiscoverer()
+	var dck, pol = SampleDocks[0], SamplePools[0]
+	dck.Status, pol.Status = model.DockAvailable, model.PoolAvailable
+	fdd.dcks = append(fdd.dcks, &dck)
+	fdd.pols = append(fdd.pols, &pol)
+
+	// The dock and its pool have been marked unavailable by osdslet.
+	var oldDck, oldPol = SampleDocks[0], SamplePools[0]
+	oldDck.Status, oldPol.Status = model.DockUnavailable, model.PoolUnavailable
+	mockClient := new(dbtest.Client)
+	mockClient.On("GetDock", c.NewAdminContext(), dck.Id).Return(&oldDck, nil)
+	mockClient.On("GetPool", c.NewAdminContext(), pol.Id).Return(&oldPol, nil)
+	mockClient.On("CreateDock", c.NewAdminContext(), &dck).Return(nil, nil)
+	mockClient.On("CreatePool", c.NewAdminContext(), &pol).Return(nil, nil)
+	fdd.c = mockClient
+
+	if err := fdd.Report(); err != nil {
+		t.Errorf("Failed to store docks and pools into database: %v\n", err)
+	}
+	if dck.Status != model.DockUnavailable || pol.Status != model.PoolUnavailable {
+		t.Errorf("Expected the status to be kept, got dock %s and pool %s\n", dck.Status, pol.Status)
+	}
+	mockClient.AssertExpectations(t)
+}
+
+func TestReportNewPoolOfUnavailableDock(t *testing.T) {
+	var fdd = NewFakeDockDiscoverer()
+	var dck, pol = SampleDocks[0], SamplePools[0]
+	dck.Status, pol.Status = model.DockAvailable, model.PoolAvailable
+	fdd.dcks = append(fdd.dcks, &dck)
+	fdd.pols = append(fdd.pols, &pol)
+
+	// The dock has been marked unavailable, and the pool is discovered for
+	// the first time.
+	var oldDck = SampleDocks[0]
+	oldDck.Status = model.DockUnavailable
+	mockClient := new(dbtest.Client)
+	mockClient.On("GetDock", c.NewAdminContext(), dck.Id).Return(&oldDck, nil)
+	mockClient.On("GetPool", c.NewAdminContext(), pol.Id).Return(nil, errors.New("not found"))
+	mockClient.On("CreateDock", c.NewAdminContext(), &dck).Return(nil, nil)
+	mockClient.On("CreatePool", c.NewAdminContext(), &pol).Return(nil, nil)
+	fdd.c = mockClient
+
+	if err := fdd.Report(); err != nil {
+		t.Errorf("Failed to store docks and pools into database: %v\n", err)
+	}
+	if pol.Status != model.PoolUnavailable {
+		t.Errorf("Expected pool status %s, got %s\n", model.PoolUnavailable, pol.Status)
+	}
+	mockClient.AssertExpectations(t)
+}
+
+func TestHeartbeat(t *testing.T) {
+	var fdd = NewFakeDockDiscoverer()
+	for i := range SampleDocks {
+		fdd.dcks = append(fdd.dcks, &SampleDocks[i])
+	}
+
+	// Nothing is sent before the docks are registered.
+	fdd.ctr = new(ctrtest.Client)
+	if err := fdd.Heartbeat(); err != nil {
+		t.Errorf("Failed to skip heartbeat of unregistered docks: %v\n", err)
+	}
+
+	mockClient := new(dbtest.Client)
+	mockClient.On("GetDock", c.NewAdminContext(), fdd.dcks[0].Id).Return(nil, errors.New("not found"))
+	mockClient.On("CreateDock", c.NewAdminContext(), fdd.dcks[0]).Return(nil, nil)
+	fdd.c = mockClient
+	if err := fdd.Register(fdd.dcks[0]); err != nil {
+		t.Errorf("Failed to register dock: %v\n", err)
+	}
+
+	opt := &pb.HeartbeatOpts{
+		DockIds: []string{fdd.dcks[0].Id},
+		Context: c.NewAdminContext().ToJson(),
+	}
+	mockCtr := new(ctrtest.Client)
+	mockCtr.On("Connect", mock.Anything).Return(nil)
+	mockCtr.On("Close").Return()
+	mockCtr.On("Heartbeat", mock.Anything, opt).Return(&pb.GenericResponse{}, nil)
+	fdd.ctr = mockCtr
+
+	stopChan := make(chan bool)
+	done := make(chan struct{})
+	go func() {
+		Heartbeat(fdd, time.Millisecond, stopChan)
+		close(done)
+	}()
+	time.Sleep(10 * time.Millisecond)
+	stopChan <- true
+	<-done
+
+	mockCtr.AssertCalled(t, "Heartbeat", mock.Anything, opt)
+}
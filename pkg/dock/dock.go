@@ -24,8 +24,11 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"os"
+	"runtime"
 
 	log "github.com/golang/glog"
+	"github.com/opensds/opensds/contrib/backup"
 	"github.com/opensds/opensds/contrib/connector"
 	"github.com/opensds/opensds/contrib/drivers"
 	"github.com/opensds/opensds/contrib/drivers/filesharedrivers"
@@ -34,11 +37,25 @@ import (
 	"github.com/opensds/opensds/pkg/dock/discovery"
 	"github.com/opensds/opensds/pkg/model"
 	pb "github.com/opensds/opensds/pkg/model/proto"
+	"github.com/opensds/opensds/pkg/quota"
+	"github.com/opensds/opensds/pkg/utils"
+	. "github.com/opensds/opensds/pkg/utils/config"
+	"github.com/opensds/opensds/pkg/utils/exec"
+	uuid "github.com/satori/go.uuid"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	_ "github.com/opensds/opensds/contrib/connector/fc"
 	_ "github.com/opensds/opensds/contrib/connector/iscsi"
 	_ "github.com/opensds/opensds/contrib/connector/rbd"
+	_ "github.com/opensds/opensds/contrib/drivers/ceph"
+	_ "github.com/opensds/opensds/contrib/drivers/drbd"
+	_ "github.com/opensds/opensds/contrib/drivers/filesharedrivers/nfs"
+	_ "github.com/opensds/opensds/contrib/drivers/huawei/dorado"
+	_ "github.com/opensds/opensds/contrib/drivers/huawei/fusionstorage"
+	_ "github.com/opensds/opensds/contrib/drivers/lvm"
+	_ "github.com/opensds/opensds/contrib/drivers/openstack/cinder"
 )
 
 // dockServer is used to implement pb.DockServer
@@ -86,6 +103,9 @@ func (ds *dockServer) Run() error {
 			MetaChan: make(chan string),
 		}
 		go discovery.DiscoveryAndReport(ds.Discoverer, ctx)
+		// The heartbeat lives as long as the dock server, even if the
+		// report loop is stopped.
+		go discovery.Heartbeat(ds.Discoverer, CONF.OsdsDock.HeartbeatInterval, nil)
 		go func(ctx *discovery.Context) {
 			if err = <-ctx.ErrChan; err != nil {
 				log.Error("when calling capabilty report method:", err)
@@ -114,12 +134,26 @@ func (ds *dockServer) Run() error {
 // CreateVolume implements pb.DockServer.CreateVolume
 func (ds *dockServer) CreateVolume(ctx context.Context, opt *pb.CreateVolumeOpts) (*pb.GenericResponse, error) {
 	// Get the storage drivers and do some initializations.
-	ds.Driver = drivers.Init(opt.GetDriverName())
+	driver, err := drivers.Init(opt.GetDriverName())
+	if err != nil {
+		log.Error("when init volume driver in dock module:", err)
+		return pb.GenericResponseError(err), err
+	}
+	ds.Driver = driver
 	defer drivers.Clean(ds.Driver)
 
 	log.Info("Dock server receive create volume request, vr =", opt)
 
-	vol, err := ds.Driver.CreateVolume(opt)
+	var vol *model.VolumeSpec
+	if opt.GetSourceVolumeId() != "" {
+		vol, err = ds.Driver.CloneVolume(opt)
+		if _, ok := err.(*model.NotImplementError); ok {
+			log.Info("Driver doesn't support clone natively, clone volume through a temporary snapshot.")
+			vol, err = ds.cloneVolumeGeneric(opt)
+		}
+	} else {
+		vol, err = ds.Driver.CreateVolume(opt)
+	}
 	if err != nil {
 		log.Error("when create volume in dock module:", err)
 		return pb.GenericResponseError(err), err
@@ -128,10 +162,66 @@ func (ds *dockServer) CreateVolume(ctx context.Context, opt *pb.CreateVolumeOpts
 	return pb.GenericResponseResult(vol), nil
 }
 
+// cloneVolumeGeneric clones the source volume by taking a temporary snapshot
+// of it and creating the volume from that snapshot, the temporary snapshot
+// will be removed whether the creation succeeds or not.
+func (ds *dockServer) cloneVolumeGeneric(opt *pb.CreateVolumeOpts) (*model.VolumeSpec, error) {
+	var snapId = uuid.NewV4().String()
+	snap, err := ds.Driver.CreateSnapshot(&pb.CreateVolumeSnapshotOpts{
+		Id:          snapId,
+		Name:        "clone-" + opt.GetId(),
+		Description: "Temporary snapshot for cloning volume " + opt.GetId(),
+		Size:        opt.GetSourceVolumeSize(),
+		VolumeId:    opt.GetSourceVolumeId(),
+		Metadata:    opt.GetMetadata(),
+		DriverName:  opt.GetDriverName(),
+		Context:     opt.GetContext(),
+	})
+	if err != nil {
+		log.Error("when create temporary snapshot for cloning volume:", err)
+		return nil, err
+	}
+
+	var snapMetadata = utils.MergeStringMaps(opt.GetMetadata(), snap.Metadata)
+	defer func() {
+		if err := ds.Driver.DeleteSnapshot(&pb.DeleteVolumeSnapshotOpts{
+			Id:         snapId,
+			VolumeId:   opt.GetSourceVolumeId(),
+			Metadata:   snapMetadata,
+			DriverName: opt.GetDriverName(),
+			Context:    opt.GetContext(),
+		}); err != nil {
+			log.Errorf("when delete temporary snapshot %s: %v", snapId, err)
+		}
+	}()
+
+	return ds.Driver.CreateVolume(&pb.CreateVolumeOpts{
+		Id:               opt.GetId(),
+		Name:             opt.GetName(),
+		Size:             opt.GetSize(),
+		Description:      opt.GetDescription(),
+		SnapshotId:       snapId,
+		SnapshotSize:     opt.GetSourceVolumeSize(),
+		AvailabilityZone: opt.GetAvailabilityZone(),
+		ProfileId:        opt.GetProfileId(),
+		Profile:          opt.GetProfile(),
+		PoolId:           opt.GetPoolId(),
+		PoolName:         opt.GetPoolName(),
+		Metadata:         snapMetadata,
+		DriverName:       opt.GetDriverName(),
+		Context:          opt.GetContext(),
+	})
+}
+
 // DeleteVolume implements pb.DockServer.DeleteVolume
 func (ds *dockServer) DeleteVolume(ctx context.Context, opt *pb.DeleteVolumeOpts) (*pb.GenericResponse, error) {
 	// Get the storage drivers and do some initializations.
-	ds.Driver = drivers.Init(opt.GetDriverName())
+	driver, err := drivers.Init(opt.GetDriverName())
+	if err != nil {
+		log.Error("when init volume driver in dock module:", err)
+		return pb.GenericResponseError(err), err
+	}
+	ds.Driver = driver
 	defer drivers.Clean(ds.Driver)
 
 	log.Info("Dock server receive delete volume request, vr =", opt)
@@ -147,7 +237,12 @@ func (ds *dockServer) DeleteVolume(ctx context.Context, opt *pb.DeleteVolumeOpts
 // ExtendVolume implements pb.DockServer.ExtendVolume
 func (ds *dockServer) ExtendVolume(ctx context.Context, opt *pb.ExtendVolumeOpts) (*pb.GenericResponse, error) {
 	// Get the storage drivers and do some initializations.
-	ds.Driver = drivers.Init(opt.GetDriverName())
+	driver, err := drivers.Init(opt.GetDriverName())
+	if err != nil {
+		log.Error("when init volume driver in dock module:", err)
+		return pb.GenericResponseError(err), err
+	}
+	ds.Driver = driver
 	defer drivers.Clean(ds.Driver)
 
 	log.Info("Dock server receive extend volume request, vr =", opt)
@@ -161,10 +256,105 @@ func (ds *dockServer) ExtendVolume(ctx context.Context, opt *pb.ExtendVolumeOpts
 	return pb.GenericResponseResult(vol), nil
 }
 
+// MigrateVolume implements pb.DockServer.MigrateVolume
+func (ds *dockServer) MigrateVolume(ctx context.Context, opt *pb.MigrateVolumeOpts) (*pb.GenericResponse, error) {
+	// Get the storage drivers and do some initializations.
+	driver, err := drivers.Init(opt.GetDriverName())
+	if err != nil {
+		log.Error("when init volume driver in dock module:", err)
+		return pb.GenericResponseError(err), err
+	}
+	ds.Driver = driver
+	defer drivers.Clean(ds.Driver)
+
+	log.Info("Dock server receive migrate volume request, vr =", opt)
+
+	vol, err := ds.Driver.MigrateVolume(opt)
+	if err != nil {
+		log.Error("when migrate volume in dock module:", err)
+		// Tell the controller to fall back to migrating the volume through
+		// an attacher dock.
+		if _, ok := err.(*model.NotImplementError); ok {
+			return pb.GenericResponseError(err), status.Error(codes.Unimplemented, err.Error())
+		}
+		return pb.GenericResponseError(err), err
+	}
+	return pb.GenericResponseResult(vol), nil
+}
+
+// RevertVolumeToSnapshot implements pb.DockServer.RevertVolumeToSnapshot
+func (ds *dockServer) RevertVolumeToSnapshot(ctx context.Context, opt *pb.RevertVolumeToSnapshotOpts) (*pb.GenericResponse, error) {
+	// Get the storage drivers and do some initializations.
+	driver, err := drivers.Init(opt.GetDriverName())
+	if err != nil {
+		log.Error("when init volume driver in dock module:", err)
+		return pb.GenericResponseError(err), err
+	}
+	ds.Driver = driver
+	defer drivers.Clean(ds.Driver)
+
+	log.Info("Dock server receive revert volume to snapshot request, vr =", opt)
+
+	// The controller falls back to copying the snapshot back to the volume
+	// if the driver can't revert it natively.
+	if err = ds.Driver.RevertVolumeToSnapshot(opt); err != nil {
+		return errorResponse(err)
+	}
+
+	log.Infof("Revert volume (%s) to snapshot (%s) successfully.\n", opt.GetId(), opt.GetSnapshotId())
+	return pb.GenericResponseResult(nil), nil
+}
+
+// ManageVolume implements pb.DockServer.ManageVolume
+func (ds *dockServer) ManageVolume(ctx context.Context, opt *pb.ManageVolumeOpts) (*pb.GenericResponse, error) {
+	// Get the storage drivers and do some initializations.
+	driver, err := drivers.Init(opt.GetDriverName())
+	if err != nil {
+		log.Error("when init volume driver in dock module:", err)
+		return pb.GenericResponseError(err), err
+	}
+	ds.Driver = driver
+	defer drivers.Clean(ds.Driver)
+
+	log.Info("Dock server receive manage volume request, vr =", opt)
+
+	vol, err := ds.Driver.ManageVolume(opt)
+	if err != nil {
+		log.Error("error occurred in dock module when manage volume:", err)
+		return pb.GenericResponseError(err), err
+	}
+	return pb.GenericResponseResult(vol), nil
+}
+
+// UnmanageVolume implements pb.DockServer.UnmanageVolume
+func (ds *dockServer) UnmanageVolume(ctx context.Context, opt *pb.UnmanageVolumeOpts) (*pb.GenericResponse, error) {
+	// Get the storage drivers and do some initializations.
+	driver, err := drivers.Init(opt.GetDriverName())
+	if err != nil {
+		log.Error("when init volume driver in dock module:", err)
+		return pb.GenericResponseError(err), err
+	}
+	ds.Driver = driver
+	defer drivers.Clean(ds.Driver)
+
+	log.Info("Dock server receive unmanage volume request, vr =", opt)
+
+	if err := ds.Driver.UnmanageVolume(opt); err != nil {
+		log.Error("error occurred in dock module when unmanage volume:", err)
+		return pb.GenericResponseError(err), err
+	}
+	return pb.GenericResponseResult(nil), nil
+}
+
 // CreateVolumeAttachment implements pb.DockServer.CreateVolumeAttachment
 func (ds *dockServer) CreateVolumeAttachment(ctx context.Context, opt *pb.CreateVolumeAttachmentOpts) (*pb.GenericResponse, error) {
 	// Get the storage drivers and do some initializations.
-	ds.Driver = drivers.Init(opt.GetDriverName())
+	driver, err := drivers.Init(opt.GetDriverName())
+	if err != nil {
+		log.Error("when init volume driver in dock module:", err)
+		return pb.GenericResponseError(err), err
+	}
+	ds.Driver = driver
 	defer drivers.Clean(ds.Driver)
 
 	log.Info("Dock server receive create volume attachment request, vr =", opt)
@@ -197,7 +387,12 @@ func (ds *dockServer) CreateVolumeAttachment(ctx context.Context, opt *pb.Create
 // DeleteVolumeAttachment implements pb.DockServer.DeleteVolumeAttachment
 func (ds *dockServer) DeleteVolumeAttachment(ctx context.Context, opt *pb.DeleteVolumeAttachmentOpts) (*pb.GenericResponse, error) {
 	// Get the storage drivers and do some initializations.
-	ds.Driver = drivers.Init(opt.GetDriverName())
+	driver, err := drivers.Init(opt.GetDriverName())
+	if err != nil {
+		log.Error("when init volume driver in dock module:", err)
+		return pb.GenericResponseError(err), err
+	}
+	ds.Driver = driver
 	defer drivers.Clean(ds.Driver)
 
 	log.Info("Dock server receive delete volume attachment request, vr =", opt)
@@ -213,7 +408,12 @@ func (ds *dockServer) DeleteVolumeAttachment(ctx context.Context, opt *pb.Delete
 // CreateVolumeSnapshot implements pb.DockServer.CreateVolumeSnapshot
 func (ds *dockServer) CreateVolumeSnapshot(ctx context.Context, opt *pb.CreateVolumeSnapshotOpts) (*pb.GenericResponse, error) {
 	// Get the storage drivers and do some initializations.
-	ds.Driver = drivers.Init(opt.GetDriverName())
+	driver, err := drivers.Init(opt.GetDriverName())
+	if err != nil {
+		log.Error("when init volume driver in dock module:", err)
+		return pb.GenericResponseError(err), err
+	}
+	ds.Driver = driver
 	defer drivers.Clean(ds.Driver)
 
 	log.Info("Dock server receive create volume snapshot request, vr =", opt)
@@ -230,7 +430,12 @@ func (ds *dockServer) CreateVolumeSnapshot(ctx context.Context, opt *pb.CreateVo
 // DeleteVolumeSnapshot implements pb.DockServer.DeleteVolumeSnapshot
 func (ds *dockServer) DeleteVolumeSnapshot(ctx context.Context, opt *pb.DeleteVolumeSnapshotOpts) (*pb.GenericResponse, error) {
 	// Get the storage drivers and do some initializations.
-	ds.Driver = drivers.Init(opt.GetDriverName())
+	driver, err := drivers.Init(opt.GetDriverName())
+	if err != nil {
+		log.Error("when init volume driver in dock module:", err)
+		return pb.GenericResponseError(err), err
+	}
+	ds.Driver = driver
 	defer drivers.Clean(ds.Driver)
 
 	log.Info("Dock server receive delete volume snapshot request, vr =", opt)
@@ -243,6 +448,295 @@ func (ds *dockServer) DeleteVolumeSnapshot(ctx context.Context, opt *pb.DeleteVo
 	return pb.GenericResponseResult(nil), nil
 }
 
+// ManageVolumeSnapshot implements pb.DockServer.ManageVolumeSnapshot
+func (ds *dockServer) ManageVolumeSnapshot(ctx context.Context, opt *pb.ManageVolumeSnapshotOpts) (*pb.GenericResponse, error) {
+	// Get the storage drivers and do some initializations.
+	driver, err := drivers.Init(opt.GetDriverName())
+	if err != nil {
+		log.Error("when init volume driver in dock module:", err)
+		return pb.GenericResponseError(err), err
+	}
+	ds.Driver = driver
+	defer drivers.Clean(ds.Driver)
+
+	log.Info("Dock server receive manage volume snapshot request, vr =", opt)
+
+	snp, err := ds.Driver.ManageSnapshot(opt)
+	if err != nil {
+		log.Error("error occurred in dock module when manage snapshot:", err)
+		return pb.GenericResponseError(err), err
+	}
+	return pb.GenericResponseResult(snp), nil
+}
+
+// UnmanageVolumeSnapshot implements pb.DockServer.UnmanageVolumeSnapshot
+func (ds *dockServer) UnmanageVolumeSnapshot(ctx context.Context, opt *pb.UnmanageVolumeSnapshotOpts) (*pb.GenericResponse, error) {
+	// Get the storage drivers and do some initializations.
+	driver, err := drivers.Init(opt.GetDriverName())
+	if err != nil {
+		log.Error("when init volume driver in dock module:", err)
+		return pb.GenericResponseError(err), err
+	}
+	ds.Driver = driver
+	defer drivers.Clean(ds.Driver)
+
+	log.Info("Dock server receive unmanage volume snapshot request, vr =", opt)
+
+	if err := ds.Driver.UnmanageSnapshot(opt); err != nil {
+		log.Error("error occurred in dock module when unmanage snapshot:", err)
+		return pb.GenericResponseError(err), err
+	}
+	return pb.GenericResponseResult(nil), nil
+}
+
+// CreateVolumeBackup implements pb.DockServer.CreateVolumeBackup
+func (ds *dockServer) CreateVolumeBackup(ctx context.Context, opt *pb.CreateVolumeBackupOpts) (*pb.GenericResponse, error) {
+	// Get the storage drivers and do some initializations.
+	driver, err := drivers.Init(opt.GetDriverName())
+	if err != nil {
+		log.Error("when init volume driver in dock module:", err)
+		return pb.GenericResponseError(err), err
+	}
+	ds.Driver = driver
+	defer drivers.Clean(ds.Driver)
+
+	log.Info("Dock server receive create volume backup request, vr =", opt)
+
+	var bkpDriverName = opt.GetBackupDriver()
+	if bkpDriverName == "" {
+		bkpDriverName = CONF.OsdsDock.BackupDriver
+	}
+	bkpDriver, err := setUpBackupDriver(bkpDriverName)
+	if err != nil {
+		log.Error("error occurred in dock module when set up backup driver:", err)
+		return pb.GenericResponseError(err), err
+	}
+	defer bkpDriver.CleanUp()
+
+	var devPath string
+	var detach func()
+	if opt.GetSnapshotId() != "" {
+		devPath, detach, err = ds.attachSnapshotLocally(opt.GetSnapshotId(), opt.GetAccessProtocol(),
+			opt.GetSourceMetadata(), opt.GetDriverName(), opt.GetContext())
+	} else {
+		devPath, detach, err = ds.attachVolumeLocally(opt.GetVolumeId(), opt.GetAccessProtocol(),
+			opt.GetSourceMetadata(), opt.GetDriverName(), opt.GetContext())
+	}
+	if err != nil {
+		log.Error("error occurred in dock module when attach backup source:", err)
+		return pb.GenericResponseError(err), err
+	}
+	defer detach()
+
+	file, err := os.Open(devPath)
+	if err != nil {
+		log.Error("error occurred in dock module when open backup source:", err)
+		return pb.GenericResponseError(err), err
+	}
+	defer file.Close()
+
+	var bkp = &backup.BackupSpec{
+		Id:       opt.GetId(),
+		Name:     opt.GetName(),
+		Metadata: utils.MergeStringMaps(map[string]string{}, opt.GetMetadata()),
+	}
+	if err = bkpDriver.Backup(bkp, file); err != nil {
+		log.Error("error occurred in dock module when create volume backup:", err)
+		return pb.GenericResponseError(err), err
+	}
+
+	var result = &model.BackupSpec{
+		BaseModel: &model.BaseModel{
+			Id: opt.GetId(),
+		},
+		Name:         opt.GetName(),
+		Description:  opt.GetDescription(),
+		VolumeId:     opt.GetVolumeId(),
+		SnapshotId:   opt.GetSnapshotId(),
+		Size:         opt.GetSize(),
+		BackupDriver: bkpDriverName,
+		Metadata:     bkp.Metadata,
+	}
+	return pb.GenericResponseResult(result), nil
+}
+
+// DeleteVolumeBackup implements pb.DockServer.DeleteVolumeBackup
+func (ds *dockServer) DeleteVolumeBackup(ctx context.Context, opt *pb.DeleteVolumeBackupOpts) (*pb.GenericResponse, error) {
+	log.Info("Dock server receive delete volume backup request, vr =", opt)
+
+	bkpDriver, err := setUpBackupDriver(opt.GetBackupDriver())
+	if err != nil {
+		log.Error("error occurred in dock module when set up backup driver:", err)
+		return pb.GenericResponseError(err), err
+	}
+	defer bkpDriver.CleanUp()
+
+	var bkp = &backup.BackupSpec{
+		Id:       opt.GetId(),
+		Metadata: opt.GetMetadata(),
+	}
+	if err = bkpDriver.Delete(bkp); err != nil {
+		log.Error("error occurred in dock module when delete volume backup:", err)
+		return pb.GenericResponseError(err), err
+	}
+	return pb.GenericResponseResult(nil), nil
+}
+
+// RestoreVolumeBackup implements pb.DockServer.RestoreVolumeBackup
+func (ds *dockServer) RestoreVolumeBackup(ctx context.Context, opt *pb.RestoreVolumeBackupOpts) (*pb.GenericResponse, error) {
+	// Get the storage drivers and do some initializations.
+	driver, err := drivers.Init(opt.GetDriverName())
+	if err != nil {
+		log.Error("when init volume driver in dock module:", err)
+		return pb.GenericResponseError(err), err
+	}
+	ds.Driver = driver
+	defer drivers.Clean(ds.Driver)
+
+	log.Info("Dock server receive restore volume backup request, vr =", opt)
+
+	bkpDriver, err := setUpBackupDriver(opt.GetBackupDriver())
+	if err != nil {
+		log.Error("error occurred in dock module when set up backup driver:", err)
+		return pb.GenericResponseError(err), err
+	}
+	defer bkpDriver.CleanUp()
+
+	devPath, detach, err := ds.attachVolumeLocally(opt.GetVolumeId(), opt.GetAccessProtocol(),
+		opt.GetVolumeMetadata(), opt.GetDriverName(), opt.GetContext())
+	if err != nil {
+		log.Error("error occurred in dock module when attach volume:", err)
+		return pb.GenericResponseError(err), err
+	}
+	defer detach()
+
+	file, err := os.OpenFile(devPath, os.O_RDWR, 0666)
+	if err != nil {
+		log.Error("error occurred in dock module when open volume:", err)
+		return pb.GenericResponseError(err), err
+	}
+	defer file.Close()
+
+	var bkp = &backup.BackupSpec{
+		Id:       opt.GetId(),
+		Metadata: opt.GetMetadata(),
+	}
+	if err = bkpDriver.Restore(bkp, opt.GetId(), file); err != nil {
+		log.Error("error occurred in dock module when restore volume backup:", err)
+		return pb.GenericResponseError(err), err
+	}
+	return pb.GenericResponseResult(nil), nil
+}
+
+// setUpBackupDriver returns the specified backup driver which has been set up.
+func setUpBackupDriver(name string) (backup.BackupDriver, error) {
+	bkpDriver, err := backup.NewBackup(name)
+	if err != nil {
+		return nil, fmt.Errorf("get backup driver %s failed: %v", name, err)
+	}
+	if err := bkpDriver.SetUp(); err != nil {
+		return nil, err
+	}
+	return bkpDriver, nil
+}
+
+// localHostInfo returns the information of the host which the dock runs on,
+// so that volumes and snapshots can be exported to it.
+func localHostInfo(protocol string) *pb.HostInfo {
+	host, _ := os.Hostname()
+	var info = &pb.HostInfo{
+		Platform: runtime.GOARCH,
+		OsType:   runtime.GOOS,
+		Host:     host,
+		Ip:       CONF.OsdsDock.BindIp,
+	}
+	if con := connector.NewConnector(protocol); con != nil {
+		info.Initiator, _ = con.GetInitiatorInfo()
+	}
+	return info
+}
+
+// attachLocally attaches the exported volume or snapshot to the local host,
+// the returned function detaches it and calls terminate to remove the export.
+func attachLocally(connInfo *model.ConnectionInfo, terminate func()) (string, func(), error) {
+	con := connector.NewConnector(connInfo.DriverVolumeType)
+	if con == nil {
+		terminate()
+		return "", nil, fmt.Errorf("can not find connector (%s)!", connInfo.DriverVolumeType)
+	}
+	devPath, err := con.Attach(connInfo.ConnectionData)
+	if err != nil {
+		terminate()
+		return "", nil, err
+	}
+	return devPath, func() {
+		if err := con.Detach(connInfo.ConnectionData); err != nil {
+			log.Error("error occurred in dock module when detach:", err)
+		}
+		terminate()
+	}, nil
+}
+
+func (ds *dockServer) attachVolumeLocally(volId, protocol string, metadata map[string]string, driverName, context string) (string, func(), error) {
+	var hostInfo = localHostInfo(protocol)
+	connInfo, err := ds.Driver.InitializeConnection(&pb.CreateVolumeAttachmentOpts{
+		Id:             uuid.NewV4().String(),
+		VolumeId:       volId,
+		DoLocalAttach:  true,
+		HostInfo:       hostInfo,
+		Metadata:       metadata,
+		DriverName:     driverName,
+		Context:        context,
+		AccessProtocol: protocol,
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	return attachLocally(connInfo, func() {
+		if err := ds.Driver.TerminateConnection(&pb.DeleteVolumeAttachmentOpts{
+			VolumeId:       volId,
+			HostInfo:       hostInfo,
+			Metadata:       metadata,
+			DriverName:     driverName,
+			Context:        context,
+			AccessProtocol: protocol,
+		}); err != nil {
+			log.Errorf("when terminate connection of volume %s: %v", volId, err)
+		}
+	})
+}
+
+func (ds *dockServer) attachSnapshotLocally(snapId, protocol string, metadata map[string]string, driverName, context string) (string, func(), error) {
+	var hostInfo = localHostInfo(protocol)
+	connInfo, err := ds.Driver.InitializeSnapshotConnection(&pb.CreateSnapshotAttachmentOpts{
+		Id:             uuid.NewV4().String(),
+		SnapshotId:     snapId,
+		DoLocalAttach:  true,
+		HostInfo:       hostInfo,
+		Metadata:       metadata,
+		DriverName:     driverName,
+		Context:        context,
+		AccessProtocol: protocol,
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	return attachLocally(connInfo, func() {
+		if err := ds.Driver.TerminateSnapshotConnection(&pb.DeleteSnapshotAttachmentOpts{
+			SnapshotId:     snapId,
+			HostInfo:       hostInfo,
+			Metadata:       metadata,
+			DriverName:     driverName,
+			Context:        context,
+			AccessProtocol: protocol,
+		}); err != nil {
+			log.Errorf("when terminate connection of snapshot %s: %v", snapId, err)
+		}
+	})
+}
+
 // AttachVolume implements pb.DockServer.AttachVolume
 func (ds *dockServer) AttachVolume(ctx context.Context, opt *pb.AttachVolumeOpts) (*pb.GenericResponse, error) {
 	var connData = make(map[string]interface{})
@@ -290,10 +784,66 @@ func (ds *dockServer) DetachVolume(ctx context.Context, opt *pb.DetachVolumeOpts
 	return pb.GenericResponseResult(nil), nil
 }
 
+// CopyVolume implements pb.DockServer.CopyVolume
+func (ds *dockServer) CopyVolume(ctx context.Context, opt *pb.CopyVolumeOpts) (*pb.GenericResponse, error) {
+	log.Info("Dock server receive copy volume request, vr =", opt)
+
+	if opt.GetSrcPath() == "" || opt.GetDstPath() == "" {
+		err := errors.New("both source and destination device path are required")
+		return pb.GenericResponseError(err), err
+	}
+	if _, err := exec.Run("dd",
+		"if="+opt.GetSrcPath(),
+		"of="+opt.GetDstPath(),
+		"bs=1M",
+		"count="+fmt.Sprint(opt.GetSize()<<10),
+		"oflag=direct",
+		"conv=fsync",
+	); err != nil {
+		log.Error("error occurred in dock module when copy volume:", err)
+		return pb.GenericResponseError(err), err
+	}
+	return pb.GenericResponseResult(nil), nil
+}
+
+// FreezeVolume implements pb.AttachDockServer.FreezeVolume
+func (ds *dockServer) FreezeVolume(ctx context.Context, opt *pb.FreezeVolumeOpts) (*pb.GenericResponse, error) {
+	log.Info("Dock server receive freeze volume request, vr =", opt)
+
+	if opt.GetMountpoint() == "" {
+		err := errors.New("mountpoint of the volume is required")
+		return pb.GenericResponseError(err), err
+	}
+	if _, err := exec.Run("fsfreeze", "-f", opt.GetMountpoint()); err != nil {
+		log.Error("error occurred in dock module when freeze volume:", err)
+		return pb.GenericResponseError(err), err
+	}
+	return pb.GenericResponseResult(nil), nil
+}
+
+// ThawVolume implements pb.AttachDockServer.ThawVolume
+func (ds *dockServer) ThawVolume(ctx context.Context, opt *pb.FreezeVolumeOpts) (*pb.GenericResponse, error) {
+	log.Info("Dock server receive thaw volume request, vr =", opt)
+
+	if opt.GetMountpoint() == "" {
+		err := errors.New("mountpoint of the volume is required")
+		return pb.GenericResponseError(err), err
+	}
+	if _, err := exec.Run("fsfreeze", "-u", opt.GetMountpoint()); err != nil {
+		log.Error("error occurred in dock module when thaw volume:", err)
+		return pb.GenericResponseError(err), err
+	}
+	return pb.GenericResponseResult(nil), nil
+}
+
 // CreateReplication implements opensds.DockServer
 func (ds *dockServer) CreateReplication(ctx context.Context, opt *pb.CreateReplicationOpts) (*pb.GenericResponse, error) {
 	//Get the storage replication drivers and do some initializations.
-	driver, _ := drivers.InitReplicationDriver(opt.GetDriverName())
+	driver, err := drivers.InitReplicationDriver(opt.GetDriverName())
+	if err != nil {
+		log.Error("when init replication driver in dock module:", err)
+		return pb.GenericResponseError(err), err
+	}
 	defer drivers.CleanReplicationDriver(driver)
 
 	log.Info("Dock server receive create replication request, vr =", opt)
@@ -312,7 +862,11 @@ func (ds *dockServer) CreateReplication(ctx context.Context, opt *pb.CreateRepli
 
 func (ds *dockServer) DeleteReplication(ctx context.Context, opt *pb.DeleteReplicationOpts) (*pb.GenericResponse, error) {
 	// Get the storage replication drivers and do some initializations.
-	driver, _ := drivers.InitReplicationDriver(opt.GetDriverName())
+	driver, err := drivers.InitReplicationDriver(opt.GetDriverName())
+	if err != nil {
+		log.Error("when init replication driver in dock module:", err)
+		return pb.GenericResponseError(err), err
+	}
 	defer drivers.CleanReplicationDriver(driver)
 
 	log.Info("Dock server receive delete replication request, vr =", opt)
@@ -327,7 +881,11 @@ func (ds *dockServer) DeleteReplication(ctx context.Context, opt *pb.DeleteRepli
 
 func (ds *dockServer) EnableReplication(ctx context.Context, opt *pb.EnableReplicationOpts) (*pb.GenericResponse, error) {
 	// Get the storage replication drivers and do some initializations.
-	driver, _ := drivers.InitReplicationDriver(opt.GetDriverName())
+	driver, err := drivers.InitReplicationDriver(opt.GetDriverName())
+	if err != nil {
+		log.Error("when init replication driver in dock module:", err)
+		return pb.GenericResponseError(err), err
+	}
 	defer drivers.CleanReplicationDriver(driver)
 
 	log.Info("Dock server receive enable replication request, vr =", opt)
@@ -342,7 +900,11 @@ func (ds *dockServer) EnableReplication(ctx context.Context, opt *pb.EnableRepli
 
 func (ds *dockServer) DisableReplication(ctx context.Context, opt *pb.DisableReplicationOpts) (*pb.GenericResponse, error) {
 	// Get the storage replication drivers and do some initializations.
-	driver, _ := drivers.InitReplicationDriver(opt.GetDriverName())
+	driver, err := drivers.InitReplicationDriver(opt.GetDriverName())
+	if err != nil {
+		log.Error("when init replication driver in dock module:", err)
+		return pb.GenericResponseError(err), err
+	}
 	defer drivers.CleanReplicationDriver(driver)
 
 	log.Info("Dock server receive disable replication request, vr =", opt)
@@ -357,7 +919,11 @@ func (ds *dockServer) DisableReplication(ctx context.Context, opt *pb.DisableRep
 
 func (ds *dockServer) FailoverReplication(ctx context.Context, opt *pb.FailoverReplicationOpts) (*pb.GenericResponse, error) {
 	// Get the storage replication drivers and do some initializations.
-	driver, _ := drivers.InitReplicationDriver(opt.GetDriverName())
+	driver, err := drivers.InitReplicationDriver(opt.GetDriverName())
+	if err != nil {
+		log.Error("when init replication driver in dock module:", err)
+		return pb.GenericResponseError(err), err
+	}
 	defer drivers.CleanReplicationDriver(driver)
 
 	log.Info("Dock server receive failover replication request, vr =", opt)
@@ -373,74 +939,142 @@ func (ds *dockServer) FailoverReplication(ctx context.Context, opt *pb.FailoverR
 // CreateVolumeGroup implements pb.DockServer.CreateVolumeGroup
 func (ds *dockServer) CreateVolumeGroup(ctx context.Context, opt *pb.CreateVolumeGroupOpts) (*pb.GenericResponse, error) {
 	// Get the storage drivers and do some initializations.
-	ds.Driver = drivers.Init(opt.GetDriverName())
+	driver, err := drivers.Init(opt.GetDriverName())
+	if err != nil {
+		log.Error("when init volume driver in dock module:", err)
+		return pb.GenericResponseError(err), err
+	}
+	ds.Driver = driver
 	defer drivers.Clean(ds.Driver)
 
 	log.Info("Dock server receive create volume group request, vr =", opt)
 
+	osdsCtx := c.NewContextFromJson(opt.GetContext())
+	if opt.AddVolumesRef, err = volumesRef(osdsCtx, opt.GetAddVolumes()); err != nil {
+		return pb.GenericResponseError(err), err
+	}
+
 	vg, err := ds.Driver.CreateVolumeGroup(opt)
 	if err != nil {
 		if _, ok := err.(*model.NotImplementError); !ok {
 			log.Error("when calling volume driver to create volume group:", err)
 			return pb.GenericResponseError(err), err
 		}
+		// The driver doesn't manage volume groups, so the group is only kept
+		// in database and the one there is returned.
+		if vg, err = db.C.GetVolumeGroup(osdsCtx, opt.GetId()); err != nil {
+			return pb.GenericResponseError(err), err
+		}
 	}
 
 	log.Infof("Create volume group (%s) successfully.\n", opt.GetId())
-	// TODO Currently no storage driver has implemented with volume group operations,
-	// So we will fetch the volume group resource from database as the fake one.
-	vg, err = db.C.GetVolumeGroup(c.NewContextFromJson(opt.GetContext()), opt.GetId())
-	if err != nil {
-		return pb.GenericResponseError(err), err
-	}
 	return pb.GenericResponseResult(vg), nil
 }
 
 func (ds *dockServer) UpdateVolumeGroup(ctx context.Context, opt *pb.UpdateVolumeGroupOpts) (*pb.GenericResponse, error) {
 	// Get the storage drivers and do some initializations.
-	ds.Driver = drivers.Init(opt.GetDriverName())
+	driver, err := drivers.Init(opt.GetDriverName())
+	if err != nil {
+		log.Error("when init volume driver in dock module:", err)
+		return pb.GenericResponseError(err), err
+	}
+	ds.Driver = driver
 	defer drivers.Clean(ds.Driver)
 
 	log.Info("Dock server receive update volume group request, vr =", opt)
 
-	vg, err := ds.Driver.UpdateVolumeGroup(opt)
+	osdsCtx := c.NewContextFromJson(opt.GetContext())
+	vg, err := db.C.GetVolumeGroup(osdsCtx, opt.GetId())
+	if err != nil {
+		return pb.GenericResponseError(err), err
+	}
+	opt.Metadata = vg.Metadata
+	if opt.AddVolumesRef, err = volumesRef(osdsCtx, opt.GetAddVolumes()); err != nil {
+		return pb.GenericResponseError(err), err
+	}
+	if opt.RemoveVolumesRef, err = volumesRef(osdsCtx, opt.GetRemoveVolumes()); err != nil {
+		return pb.GenericResponseError(err), err
+	}
+
+	result, err := ds.Driver.UpdateVolumeGroup(opt)
 	if err != nil {
 		if _, ok := err.(*model.NotImplementError); !ok {
 			err = errors.New("error occurred when updating group" + opt.GetId() + "," + err.Error())
 			return pb.GenericResponseError(err), err
 		}
+		// The driver doesn't manage volume groups, so the group is only kept
+		// in database and the one there is returned.
+		result = vg
 	}
 
 	log.Infof("Update volume group (%s) successfully.\n", opt.GetId())
-	// TODO Currently no storage driver has implemented with volume group operations,
-	// So we will fetch the volume group resource from database as the fake one.
-	vg, err = db.C.GetVolumeGroup(c.NewContextFromJson(opt.GetContext()), opt.GetId())
-	if err != nil {
-		return pb.GenericResponseError(err), err
-	}
-	return pb.GenericResponseResult(vg), nil
+	return pb.GenericResponseResult(result), nil
 }
 
 func (ds *dockServer) DeleteVolumeGroup(ctx context.Context, opt *pb.DeleteVolumeGroupOpts) (*pb.GenericResponse, error) {
 	// Get the storage drivers and do some initializations.
-	ds.Driver = drivers.Init(opt.GetDriverName())
+	driver, err := drivers.Init(opt.GetDriverName())
+	if err != nil {
+		log.Error("when init volume driver in dock module:", err)
+		return pb.GenericResponseError(err), err
+	}
+	ds.Driver = driver
 	defer drivers.Clean(ds.Driver)
 
 	log.Info("Dock server receive delete volume group request, vr =", opt)
 
-	if err := ds.Driver.DeleteVolumeGroup(opt); err != nil {
+	osdsCtx := c.NewContextFromJson(opt.GetContext())
+	vg, err := db.C.GetVolumeGroup(osdsCtx, opt.GetId())
+	if err != nil {
+		return pb.GenericResponseError(err), err
+	}
+	volumes, err := db.C.ListVolumesByGroupId(osdsCtx, opt.GetId())
+	if err != nil {
+		return pb.GenericResponseError(err), err
+	}
+	opt.Metadata = vg.Metadata
+	for _, vol := range volumes {
+		opt.VolumesRef = append(opt.VolumesRef, volumeRef(vol))
+	}
+
+	// The driver only removes the group from the backend, the volumes in it
+	// are deleted one by one afterwards.
+	if err = ds.Driver.DeleteVolumeGroup(opt); err != nil {
 		if _, ok := err.(*model.NotImplementError); !ok {
 			return pb.GenericResponseError(err), err
 		}
-		if err = ds.deleteGroupGeneric(opt); err != nil {
-			return pb.GenericResponseError(err), err
-		}
+	}
+	if err = ds.deleteGroupGeneric(opt); err != nil {
+		return pb.GenericResponseError(err), err
 	}
 
 	log.Infof("Delete volume group (%s) successfully.\n", opt.GetId())
 	return pb.GenericResponseResult(nil), nil
 }
 
+// volumeRef returns the properties of the volume which the driver needs to
+// put it into or take it out of a volume group.
+func volumeRef(vol *model.VolumeSpec) *pb.VolumeRef {
+	return &pb.VolumeRef{
+		Id:       vol.Id,
+		Name:     vol.Name,
+		Status:   vol.Status,
+		Metadata: vol.Metadata,
+	}
+}
+
+func volumesRef(ctx *c.Context, volIds []string) ([]*pb.VolumeRef, error) {
+	var refs []*pb.VolumeRef
+	for _, volId := range volIds {
+		vol, err := db.C.GetVolume(ctx, volId)
+		if err != nil {
+			return nil, err
+		}
+		refs = append(refs, volumeRef(vol))
+	}
+	return refs, nil
+}
+
 func (ds *dockServer) deleteGroupGeneric(opt *pb.DeleteVolumeGroupOpts) error {
 	ctx := c.NewContextFromJson(opt.GetContext())
 
@@ -459,16 +1093,161 @@ func (ds *dockServer) deleteGroupGeneric(opt *pb.DeleteVolumeGroupOpts) error {
 			// Delete the volume entry in DB after successfully deleting the
 			// volume on the storage.
 			db.C.DeleteVolume(ctx, volRef.Id)
+			quota.Release(ctx, volRef.TenantId, volRef.Id)
 		}
 	}
 
 	return nil
 }
 
+// CreateGroupSnapshot implements pb.DockServer.CreateGroupSnapshot
+func (ds *dockServer) CreateGroupSnapshot(ctx context.Context, opt *pb.CreateGroupSnapshotOpts) (*pb.GenericResponse, error) {
+	// Get the storage drivers and do some initializations.
+	driver, err := drivers.Init(opt.GetDriverName())
+	if err != nil {
+		log.Error("when init volume driver in dock module:", err)
+		return pb.GenericResponseError(err), err
+	}
+	ds.Driver = driver
+	defer drivers.Clean(ds.Driver)
+
+	log.Info("Dock server receive create group snapshot request, vr =", opt)
+
+	snps, err := ds.Driver.CreateGroupSnapshot(opt)
+	if err != nil {
+		return errorResponse(err)
+	}
+
+	log.Infof("Create group snapshot (%s) successfully.\n", opt.GetId())
+	return pb.GenericResponseResult(snps), nil
+}
+
+// DeleteGroupSnapshot implements pb.DockServer.DeleteGroupSnapshot
+func (ds *dockServer) DeleteGroupSnapshot(ctx context.Context, opt *pb.DeleteGroupSnapshotOpts) (*pb.GenericResponse, error) {
+	// Get the storage drivers and do some initializations.
+	driver, err := drivers.Init(opt.GetDriverName())
+	if err != nil {
+		log.Error("when init volume driver in dock module:", err)
+		return pb.GenericResponseError(err), err
+	}
+	ds.Driver = driver
+	defer drivers.Clean(ds.Driver)
+
+	log.Info("Dock server receive delete group snapshot request, vr =", opt)
+
+	if err = ds.Driver.DeleteGroupSnapshot(opt); err != nil {
+		return errorResponse(err)
+	}
+
+	log.Infof("Delete group snapshot (%s) successfully.\n", opt.GetId())
+	return pb.GenericResponseResult(nil), nil
+}
+
+// PullVolume implements pb.DockServer.PullVolume
+func (ds *dockServer) PullVolume(ctx context.Context, opt *pb.PullVolumeOpts) (*pb.GenericResponse, error) {
+	// Get the storage drivers and do some initializations.
+	driver, err := drivers.Init(opt.GetDriverName())
+	if err != nil {
+		log.Error("when init volume driver in dock module:", err)
+		return pb.GenericResponseError(err), err
+	}
+	ds.Driver = driver
+	defer drivers.Clean(ds.Driver)
+
+	log.V(5).Info("Dock server receive pull volume request, vr =", opt)
+
+	vol, err := ds.Driver.PullVolume(opt)
+	if err != nil {
+		return errorResponse(err)
+	}
+	return pb.GenericResponseResult(vol), nil
+}
+
+// PullVolumeSnapshot implements pb.DockServer.PullVolumeSnapshot
+func (ds *dockServer) PullVolumeSnapshot(ctx context.Context, opt *pb.PullVolumeSnapshotOpts) (*pb.GenericResponse, error) {
+	// Get the storage drivers and do some initializations.
+	driver, err := drivers.Init(opt.GetDriverName())
+	if err != nil {
+		log.Error("when init volume driver in dock module:", err)
+		return pb.GenericResponseError(err), err
+	}
+	ds.Driver = driver
+	defer drivers.Clean(ds.Driver)
+
+	log.V(5).Info("Dock server receive pull volume snapshot request, vr =", opt)
+
+	snp, err := ds.Driver.PullSnapshot(opt)
+	if err != nil {
+		return errorResponse(err)
+	}
+	return pb.GenericResponseResult(snp), nil
+}
+
+// ListVolumes implements pb.DockServer.ListVolumes
+func (ds *dockServer) ListVolumes(ctx context.Context, opt *pb.ListVolumesOpts) (*pb.GenericResponse, error) {
+	// Get the storage drivers and do some initializations.
+	driver, err := drivers.Init(opt.GetDriverName())
+	if err != nil {
+		log.Error("when init volume driver in dock module:", err)
+		return pb.GenericResponseError(err), err
+	}
+	ds.Driver = driver
+	defer drivers.Clean(ds.Driver)
+
+	log.V(5).Info("Dock server receive list volumes request, vr =", opt)
+
+	vols, err := ds.Driver.ListVolumes(opt)
+	if err != nil {
+		return errorResponse(err)
+	}
+	return pb.GenericResponseResult(vols), nil
+}
+
+// ListVolumeSnapshots implements pb.DockServer.ListVolumeSnapshots
+func (ds *dockServer) ListVolumeSnapshots(ctx context.Context, opt *pb.ListVolumesOpts) (*pb.GenericResponse, error) {
+	// Get the storage drivers and do some initializations.
+	driver, err := drivers.Init(opt.GetDriverName())
+	if err != nil {
+		log.Error("when init volume driver in dock module:", err)
+		return pb.GenericResponseError(err), err
+	}
+	ds.Driver = driver
+	defer drivers.Clean(ds.Driver)
+
+	log.V(5).Info("Dock server receive list volume snapshots request, vr =", opt)
+
+	snps, err := ds.Driver.ListSnapshots(opt)
+	if err != nil {
+		return errorResponse(err)
+	}
+	return pb.GenericResponseResult(snps), nil
+}
+
+// errorResponse tells the controller whether the resource doesn't exist or
+// the driver doesn't support the operation, so that the controller is able to
+// tell them apart from the other failures.
+func errorResponse(err error) (*pb.GenericResponse, error) {
+	switch err.(type) {
+	case *model.NotFoundError:
+		return pb.GenericResponseError(err), status.Error(codes.NotFound, err.Error())
+	case *model.NotImplementError:
+		return pb.GenericResponseError(err), status.Error(codes.Unimplemented, err.Error())
+	case *model.PreconditionError:
+		return pb.GenericResponseError(err), status.Error(codes.FailedPrecondition, err.Error())
+	}
+	log.Error("error occurred in dock module:", err)
+	return pb.GenericResponseError(err), err
+}
+
 // CreateFileShare implements pb.DockServer.CreateFileShare
 func (ds *dockServer) CreateFileShare(ctx context.Context, opt *pb.CreateFileShareOpts) (*pb.GenericResponse, error) {
 	// Get the storage drivers and do some initializations.
-	ds.FileShareDriver = filesharedrivers.Init(opt.GetDriverName())
+	driver, err := filesharedrivers.Init(opt.GetDriverName())
+	if err != nil {
+		log.Error("when init fileshare driver in dock module:", err)
+		return pb.GenericResponseError(err), err
+	}
+	ds.FileShareDriver = driver
 	defer filesharedrivers.Clean(ds.FileShareDriver)
 
 	log.Info("Dock server receive create file share request, vr =", opt)
@@ -486,7 +1265,12 @@ func (ds *dockServer) CreateFileShare(ctx context.Context, opt *pb.CreateFileSha
 func (ds *dockServer) DeleteFileShare(ctx context.Context, opt *pb.DeleteFileShareOpts) (*pb.GenericResponse, error) {
 
 	// Get the storage drivers and do some initializations.
-	ds.FileShareDriver = filesharedrivers.Init(opt.GetDriverName())
+	driver, err := filesharedrivers.Init(opt.GetDriverName())
+	if err != nil {
+		log.Error("when init fileshare driver in dock module:", err)
+		return pb.GenericResponseError(err), err
+	}
+	ds.FileShareDriver = driver
 	defer filesharedrivers.Clean(ds.FileShareDriver)
 
 	log.Info("Dock server receive delete file share request, vr =", opt)
@@ -499,3 +1283,85 @@ func (ds *dockServer) DeleteFileShare(ctx context.Context, opt *pb.DeleteFileSha
 	// TODO: maybe need to update status in DB.
 	return pb.GenericResponseResult(nil), nil
 }
+
+// CreateFileShareSnapshot implements pb.FileShareDockServer.CreateFileShareSnapshot
+func (ds *dockServer) CreateFileShareSnapshot(ctx context.Context, opt *pb.CreateFileShareSnapshotOpts) (*pb.GenericResponse, error) {
+	// Get the storage drivers and do some initializations.
+	driver, err := filesharedrivers.Init(opt.GetDriverName())
+	if err != nil {
+		log.Error("when init fileshare driver in dock module:", err)
+		return pb.GenericResponseError(err), err
+	}
+	ds.FileShareDriver = driver
+	defer filesharedrivers.Clean(ds.FileShareDriver)
+
+	log.Info("Dock server receive create file share snapshot request, vr =", opt)
+
+	snp, err := ds.FileShareDriver.CreateFileShareSnapshot(opt)
+	if err != nil {
+		log.Error("error occurred in dock module when create file share snapshot:", err)
+		return pb.GenericResponseError(err), err
+	}
+	return pb.GenericResponseResult(snp), nil
+}
+
+// DeleteFileShareSnapshot implements pb.FileShareDockServer.DeleteFileShareSnapshot
+func (ds *dockServer) DeleteFileShareSnapshot(ctx context.Context, opt *pb.DeleteFileShareSnapshotOpts) (*pb.GenericResponse, error) {
+	// Get the storage drivers and do some initializations.
+	driver, err := filesharedrivers.Init(opt.GetDriverName())
+	if err != nil {
+		log.Error("when init fileshare driver in dock module:", err)
+		return pb.GenericResponseError(err), err
+	}
+	ds.FileShareDriver = driver
+	defer filesharedrivers.Clean(ds.FileShareDriver)
+
+	log.Info("Dock server receive delete file share snapshot request, vr =", opt)
+
+	if err := ds.FileShareDriver.DeleteFileShareSnapshot(opt); err != nil {
+		log.Error("error occurred in dock module when delete file share snapshot:", err)
+		return pb.GenericResponseError(err), err
+	}
+	return pb.GenericResponseResult(nil), nil
+}
+
+// CreateFileShareAcl implements pb.FileShareDockServer.CreateFileShareAcl
+func (ds *dockServer) CreateFileShareAcl(ctx context.Context, opt *pb.CreateFileShareAclOpts) (*pb.GenericResponse, error) {
+	// Get the storage drivers and do some initializations.
+	driver, err := filesharedrivers.Init(opt.GetDriverName())
+	if err != nil {
+		log.Error("when init fileshare driver in dock module:", err)
+		return pb.GenericResponseError(err), err
+	}
+	ds.FileShareDriver = driver
+	defer filesharedrivers.Clean(ds.FileShareDriver)
+
+	log.Info("Dock server receive create file share acl request, vr =", opt)
+
+	acl, err := ds.FileShareDriver.CreateFileShareAcl(opt)
+	if err != nil {
+		log.Error("error occurred in dock module when create file share acl:", err)
+		return pb.GenericResponseError(err), err
+	}
+	return pb.GenericResponseResult(acl), nil
+}
+
+// DeleteFileShareAcl implements pb.FileShareDockServer.DeleteFileShareAcl
+func (ds *dockServer) DeleteFileShareAcl(ctx context.Context, opt *pb.DeleteFileShareAclOpts) (*pb.GenericResponse, error) {
+	// Get the storage drivers and do some initializations.
+	driver, err := filesharedrivers.Init(opt.GetDriverName())
+	if err != nil {
+		log.Error("when init fileshare driver in dock module:", err)
+		return pb.GenericResponseError(err), err
+	}
+	ds.FileShareDriver = driver
+	defer filesharedrivers.Clean(ds.FileShareDriver)
+
+	log.Info("Dock server receive delete file share acl request, vr =", opt)
+
+	if err := ds.FileShareDriver.DeleteFileShareAcl(opt); err != nil {
+		log.Error("error occurred in dock module when delete file share acl:", err)
+		return pb.GenericResponseError(err), err
+	}
+	return pb.GenericResponseResult(nil), nil
+}
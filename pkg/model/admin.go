@@ -0,0 +1,28 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+This module implements the common data structure of the actions used by
+admin to repair the resources stuck in a transient status.
+
+*/
+
+package model
+
+// ResetStatusSpec is the request body of resetting the status of a resource
+// without touching the backend, it's used when the resource is stuck, for
+// example when the api server died after accepting a request.
+type ResetStatusSpec struct {
+	Status string `json:"status"`
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+This module implements the audit record data structure, which records a
+mutating request handled by the api server.
+
+*/
+
+package model
+
+// AuditRecordSpec records who did what to which resource through the api
+// server and how it ended up. The records are append-only, they're never
+// updated or deleted through the api.
+type AuditRecordSpec struct {
+	*BaseModel
+
+	// The uuid of the tenant that the caller belongs to.
+	TenantId string `json:"tenantId,omitempty"`
+
+	// The uuid of the caller.
+	UserId string `json:"userId,omitempty"`
+
+	// The roles of the caller.
+	// +optional
+	Roles []string `json:"roles,omitempty"`
+
+	// The policy rule authorized for the request, such as "volume:create".
+	Action string `json:"action"`
+
+	// The http method and the path of the request.
+	Method string `json:"method"`
+	Path   string `json:"path"`
+
+	// The uuid of the resource targeted by the request, it's empty if the
+	// resource can't be told from the request or the response.
+	// +optional
+	ResourceId string `json:"resourceId,omitempty"`
+
+	// The id of the request, which is returned in the X-Request-Id header.
+	RequestId string `json:"requestId,omitempty"`
+
+	// The outcome of the request, one of "success", "failure" and "denied".
+	Outcome string `json:"outcome"`
+
+	// The http status code of the response.
+	StatusCode int `json:"statusCode"`
+}
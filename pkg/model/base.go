@@ -37,6 +37,12 @@ type BaseModel struct {
 	// Now, it's represented as a time string in RFC8601 format.
 	// +readOnly
 	UpdatedAt string `json:"updatedAt"`
+
+	// Revision is the version of the object in the database, it changes on
+	// every update of the object. An update which carries a revision is only
+	// applied if the object has not been modified since that revision.
+	// +readOnly
+	Revision int64 `json:"revision,omitempty"`
 }
 
 // DataStorageLoS can be used to describe a service option covering storage
@@ -29,6 +29,9 @@ const (
 	ErrorUnauthorized   = http.StatusUnauthorized
 	ErrorForbidden      = http.StatusForbidden
 	ErrorNotFound       = http.StatusNotFound
+	ErrorConflict       = http.StatusConflict
+	ErrorPrecondition   = http.StatusPreconditionFailed
+	ErrorOverQuota      = http.StatusRequestEntityTooLarge
 	ErrorInternalServer = http.StatusInternalServerError
 	ErrorNotImplemented = http.StatusNotImplemented
 )
@@ -60,6 +63,21 @@ func ErrorNotFoundStatus(message string) []byte {
 	return errorStatus(ErrorNotFound, message)
 }
 
+// ErrorConflictStatus
+func ErrorConflictStatus(message string) []byte {
+	return errorStatus(ErrorConflict, message)
+}
+
+// ErrorPreconditionStatus
+func ErrorPreconditionStatus(message string) []byte {
+	return errorStatus(ErrorPrecondition, message)
+}
+
+// ErrorOverQuotaStatus
+func ErrorOverQuotaStatus(message string) []byte {
+	return errorStatus(ErrorOverQuota, message)
+}
+
 // ErrorInternalServerStatus
 func ErrorInternalServerStatus(message string) []byte {
 	return errorStatus(ErrorInternalServer, message)
@@ -104,3 +122,56 @@ func NewNotFoundError(msg string) error {
 func (e *NotFoundError) Error() string {
 	return e.S
 }
+
+// PreconditionError means the request is refused before anything is changed
+// in the backend, e.g. the volume to be reverted is still opened.
+type PreconditionError struct {
+	S string
+}
+
+func NewPreconditionError(msg string) error {
+	return &PreconditionError{S: msg}
+}
+
+func (e *PreconditionError) Error() string {
+	return e.S
+}
+
+// ErrConflict means the object has been modified since the revision which
+// the update is based on.
+type ErrConflict struct {
+	S string
+}
+
+func NewConflictError(msg string) error {
+	return &ErrConflict{S: msg}
+}
+
+func (e *ErrConflict) Error() string {
+	return e.S
+}
+
+// IsConflictError
+func IsConflictError(err error) bool {
+	_, ok := err.(*ErrConflict)
+	return ok
+}
+
+// ErrOverQuota means the request would exceed the quota of the tenant.
+type ErrOverQuota struct {
+	S string
+}
+
+func NewOverQuotaError(msg string) error {
+	return &ErrOverQuota{S: msg}
+}
+
+func (e *ErrOverQuota) Error() string {
+	return e.S
+}
+
+// IsOverQuotaError
+func IsOverQuotaError(err error) bool {
+	_, ok := err.(*ErrOverQuota)
+	return ok
+}
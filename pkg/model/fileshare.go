@@ -31,13 +31,17 @@ type FileShareAclSpec struct {
 	Type string `json:"type,omitempty"`
 
 	// The accessCapability for fileshare.
-	AccessCapability []string `json:accessCapability",omitempty"`
+	AccessCapability []string `json:"accessCapability,omitempty"`
 
 	// accessTo of the fileshare.
-	AccessTo []string `json:accessTo",omitempty"`
+	AccessTo []string `json:"accessTo,omitempty"`
 
 	// The description of the fileshare acl.
 	Description string `json:"description,omitempty"`
+
+	// The status of the fileshare acl.
+	// One of: "creating", "available", "deleting", "error", "errorDeleting".
+	Status string `json:"status,omitempty"`
 }
 
 // FileShareSpec is a schema for fileshare API. Fileshare will be created on some backend
@@ -126,4 +130,9 @@ type FileShareSnapshotSpec struct {
 	// The status of the fileshare snapshot.
 	// One of: "available", "error", etc.
 	Status string `json:"status,omitempty"`
+
+	// Metadata should be kept until the scemantics between opensds fileshare
+	// snapshot and backend storage resouce description are clear.
+	// +optional
+	Metadata map[string]string `json:"metadata,omitempty"`
 }
@@ -0,0 +1,81 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+This module implements the operation data structure which is used to track
+the asynchronous requests accepted by the api server.
+*/
+
+package model
+
+// Operation resource types
+const (
+	OperationResourceVolume      = "volume"
+	OperationResourceSnapshot    = "snapshot"
+	OperationResourceBackup      = "backup"
+	OperationResourceAttachment  = "attachment"
+	OperationResourceReplication = "replication"
+	OperationResourceVolumeGroup = "volumeGroup"
+	OperationResourceFileShare   = "fileshare"
+
+	OperationResourceGroupSnapshot = "groupSnapshot"
+
+	OperationResourceFileShareSnapshot = "fileshareSnapshot"
+	OperationResourceFileShareAcl      = "fileshareAcl"
+)
+
+// OperationSpec is a data structure which records an asynchronous request
+// accepted by the api server, so that the caller can follow its progress
+// and find out why it failed instead of polling the resource status.
+type OperationSpec struct {
+	*BaseModel
+
+	// The uuid of the tenant that the operation belongs to.
+	TenantId string `json:"tenantId,omitempty"`
+
+	// The uuid of the user that the operation belongs to.
+	// +optional
+	UserId string `json:"userId,omitempty"`
+
+	// The action of the operation, such as "CreateVolume".
+	Action string `json:"action"`
+
+	// The type of the resource which the operation is applied to.
+	ResourceType string `json:"resourceType"`
+
+	// The uuid of the resource which the operation is applied to.
+	ResourceId string `json:"resourceId,omitempty"`
+
+	// The json string of the request which has been sent to the controller.
+	// +optional
+	Request string `json:"request,omitempty"`
+
+	// The uuid of the dock which is chosen to serve the operation.
+	// +optional
+	DockId string `json:"dockId,omitempty"`
+
+	// The status of the operation, one of "accepted", "running",
+	// "succeeded" and "failed".
+	Status string `json:"status"`
+
+	// The error message of the operation if it failed.
+	// +optional
+	ErrorMessage string `json:"errorMessage,omitempty"`
+}
+
+// IsFinished returns true if the operation has come to an end, no matter
+// whether it succeeded or not.
+func (op *OperationSpec) IsFinished() bool {
+	return op.Status == OperationSucceeded || op.Status == OperationFailed
+}
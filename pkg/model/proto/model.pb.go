@@ -61,7 +61,13 @@ type CreateVolumeOpts struct {
 	// Down load snapshot from cloud
 	SnapshotFromCloud bool `protobuf:"varint,16,opt,name=snapshotFromCloud,proto3" json:"snapshotFromCloud,omitempty"`
 	// The Serialized profile
-	Profile              string   `protobuf:"bytes,17,opt,name=profile,proto3" json:"profile,omitempty"`
+	Profile string `protobuf:"bytes,17,opt,name=profile,proto3" json:"profile,omitempty"`
+	// The uuid of the operation which tracks this request.
+	OperationId string `protobuf:"bytes,18,opt,name=operationId,proto3" json:"operationId,omitempty"`
+	// When clone volume from an existing volume, this field is required.
+	SourceVolumeId string `protobuf:"bytes,19,opt,name=sourceVolumeId,proto3" json:"sourceVolumeId,omitempty"`
+	// The size of the source volume
+	SourceVolumeSize     int64    `protobuf:"varint,20,opt,name=sourceVolumeSize,proto3" json:"sourceVolumeSize,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -211,6 +217,27 @@ func (m *CreateVolumeOpts) GetProfile() string {
 	return ""
 }
 
+func (m *CreateVolumeOpts) GetOperationId() string {
+	if m != nil {
+		return m.OperationId
+	}
+	return ""
+}
+
+func (m *CreateVolumeOpts) GetSourceVolumeId() string {
+	if m != nil {
+		return m.SourceVolumeId
+	}
+	return ""
+}
+
+func (m *CreateVolumeOpts) GetSourceVolumeSize() int64 {
+	if m != nil {
+		return m.SourceVolumeSize
+	}
+	return 0
+}
+
 // DeleteVolumeOpts is a structure which indicates all required properties
 // for deleting a volume.
 type DeleteVolumeOpts struct {
@@ -229,7 +256,9 @@ type DeleteVolumeOpts struct {
 	// The Context
 	Context string `protobuf:"bytes,6,opt,name=context,proto3" json:"context,omitempty"`
 	// The Serialized profile
-	Profile              string   `protobuf:"bytes,7,opt,name=profile,proto3" json:"profile,omitempty"`
+	Profile string `protobuf:"bytes,7,opt,name=profile,proto3" json:"profile,omitempty"`
+	// The uuid of the operation which tracks this request.
+	OperationId          string   `protobuf:"bytes,8,opt,name=operationId,proto3" json:"operationId,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -309,6 +338,13 @@ func (m *DeleteVolumeOpts) GetProfile() string {
 	return ""
 }
 
+func (m *DeleteVolumeOpts) GetOperationId() string {
+	if m != nil {
+		return m.OperationId
+	}
+	return ""
+}
+
 // ExtendVolumeOpts is a structure which indicates all required properties
 // for Extending a volume.
 type ExtendVolumeOpts struct {
@@ -338,7 +374,9 @@ type ExtendVolumeOpts struct {
 	// The Context
 	Context string `protobuf:"bytes,12,opt,name=context,proto3" json:"context,omitempty"`
 	// The Serialized profile
-	Profile              string   `protobuf:"bytes,13,opt,name=profile,proto3" json:"profile,omitempty"`
+	Profile string `protobuf:"bytes,13,opt,name=profile,proto3" json:"profile,omitempty"`
+	// The uuid of the operation which tracks this request.
+	OperationId          string   `protobuf:"bytes,14,opt,name=operationId,proto3" json:"operationId,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -460,2699 +498,5577 @@ func (m *ExtendVolumeOpts) GetProfile() string {
 	return ""
 }
 
-// CreateVolumeSnapshotOpts is a structure which indicates all required
-// properties for creating a volume snapshot.
-type CreateVolumeSnapshotOpts struct {
-	// The uuid of the volume snapshot, optional.
+func (m *ExtendVolumeOpts) GetOperationId() string {
+	if m != nil {
+		return m.OperationId
+	}
+	return ""
+}
+
+// RetypeVolumeOpts is a structure which indicates all required properties
+// for changing the profile of a volume.
+type RetypeVolumeOpts struct {
+	// The uuid of the volume, required.
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// The name of the volume snapshot, required.
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// The size of the volume that snapshot belongs to, required.
-	Size int64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
-	// The description of the volume snapshot, optional.
-	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	// The uuid of the volume that snapshot belongs to, required.
-	VolumeId string `protobuf:"bytes,5,opt,name=volumeId,proto3" json:"volumeId,omitempty"`
-	// The service level that volume belongs to, required.
-	// TODO: This item will be replace by profile, don't use it.
-	ProfileId string `protobuf:"bytes,6,opt,name=profileId,proto3" json:"profileId,omitempty"`
-	// The metadata of the volume snapshot, optional.
-	Metadata map[string]string `protobuf:"bytes,7,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// The storage driver type.
-	DriverName string `protobuf:"bytes,8,opt,name=driverName,proto3" json:"driverName,omitempty"`
+	// The uuid of the new profile, required.
+	ProfileId string `protobuf:"bytes,2,opt,name=profileId,proto3" json:"profileId,omitempty"`
+	// The uuid of the pool which the volume will be migrated to if the
+	// current pool doesn't satisfy the new profile, optional.
+	PoolId string `protobuf:"bytes,3,opt,name=poolId,proto3" json:"poolId,omitempty"`
+	// Whether the volume can be migrated, "never" or "onDemand", optional.
+	MigrationPolicy string `protobuf:"bytes,4,opt,name=migrationPolicy,proto3" json:"migrationPolicy,omitempty"`
 	// The Context
-	Context string `protobuf:"bytes,9,opt,name=context,proto3" json:"context,omitempty"`
-	// The Serialized profile
-	Profile              string   `protobuf:"bytes,10,opt,name=profile,proto3" json:"profile,omitempty"`
+	Context string `protobuf:"bytes,5,opt,name=context,proto3" json:"context,omitempty"`
+	// The uuid of the operation which tracks this request.
+	OperationId          string   `protobuf:"bytes,6,opt,name=operationId,proto3" json:"operationId,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateVolumeSnapshotOpts) Reset()         { *m = CreateVolumeSnapshotOpts{} }
-func (m *CreateVolumeSnapshotOpts) String() string { return proto.CompactTextString(m) }
-func (*CreateVolumeSnapshotOpts) ProtoMessage()    {}
-func (*CreateVolumeSnapshotOpts) Descriptor() ([]byte, []int) {
+func (m *RetypeVolumeOpts) Reset()         { *m = RetypeVolumeOpts{} }
+func (m *RetypeVolumeOpts) String() string { return proto.CompactTextString(m) }
+func (*RetypeVolumeOpts) ProtoMessage()    {}
+func (*RetypeVolumeOpts) Descriptor() ([]byte, []int) {
 	return fileDescriptor_4c16552f9fdb66d8, []int{3}
 }
 
-func (m *CreateVolumeSnapshotOpts) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateVolumeSnapshotOpts.Unmarshal(m, b)
+func (m *RetypeVolumeOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetypeVolumeOpts.Unmarshal(m, b)
 }
-func (m *CreateVolumeSnapshotOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateVolumeSnapshotOpts.Marshal(b, m, deterministic)
+func (m *RetypeVolumeOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetypeVolumeOpts.Marshal(b, m, deterministic)
 }
-func (m *CreateVolumeSnapshotOpts) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateVolumeSnapshotOpts.Merge(m, src)
+func (m *RetypeVolumeOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetypeVolumeOpts.Merge(m, src)
 }
-func (m *CreateVolumeSnapshotOpts) XXX_Size() int {
-	return xxx_messageInfo_CreateVolumeSnapshotOpts.Size(m)
+func (m *RetypeVolumeOpts) XXX_Size() int {
+	return xxx_messageInfo_RetypeVolumeOpts.Size(m)
 }
-func (m *CreateVolumeSnapshotOpts) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateVolumeSnapshotOpts.DiscardUnknown(m)
+func (m *RetypeVolumeOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetypeVolumeOpts.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateVolumeSnapshotOpts proto.InternalMessageInfo
+var xxx_messageInfo_RetypeVolumeOpts proto.InternalMessageInfo
 
-func (m *CreateVolumeSnapshotOpts) GetId() string {
+func (m *RetypeVolumeOpts) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-func (m *CreateVolumeSnapshotOpts) GetName() string {
+func (m *RetypeVolumeOpts) GetProfileId() string {
 	if m != nil {
-		return m.Name
+		return m.ProfileId
 	}
 	return ""
 }
 
-func (m *CreateVolumeSnapshotOpts) GetSize() int64 {
+func (m *RetypeVolumeOpts) GetPoolId() string {
 	if m != nil {
-		return m.Size
+		return m.PoolId
 	}
-	return 0
+	return ""
 }
 
-func (m *CreateVolumeSnapshotOpts) GetDescription() string {
+func (m *RetypeVolumeOpts) GetMigrationPolicy() string {
 	if m != nil {
-		return m.Description
+		return m.MigrationPolicy
 	}
 	return ""
 }
 
-func (m *CreateVolumeSnapshotOpts) GetVolumeId() string {
+func (m *RetypeVolumeOpts) GetContext() string {
 	if m != nil {
-		return m.VolumeId
+		return m.Context
 	}
 	return ""
 }
 
-func (m *CreateVolumeSnapshotOpts) GetProfileId() string {
+func (m *RetypeVolumeOpts) GetOperationId() string {
 	if m != nil {
-		return m.ProfileId
+		return m.OperationId
 	}
 	return ""
 }
 
-func (m *CreateVolumeSnapshotOpts) GetMetadata() map[string]string {
+// MigrateVolumeOpts is a structure which indicates all required properties
+// for migrating a volume to another pool.
+type MigrateVolumeOpts struct {
+	// The uuid of the volume, required.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The name of the volume.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The capacity of the volume.
+	Size int64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// The uuid of the target pool, the selector will choose one if it's
+	// empty.
+	PoolId string `protobuf:"bytes,4,opt,name=poolId,proto3" json:"poolId,omitempty"`
+	// The name of the target pool.
+	PoolName string `protobuf:"bytes,5,opt,name=poolName,proto3" json:"poolName,omitempty"`
+	// The uuid of the profile which the target pool satisfies.
+	ProfileId string `protobuf:"bytes,6,opt,name=profileId,proto3" json:"profileId,omitempty"`
+	// The Serialized profile
+	Profile string `protobuf:"bytes,7,opt,name=profile,proto3" json:"profile,omitempty"`
+	// The uuid of the pool which the volume is placed in.
+	SourcePoolId string `protobuf:"bytes,8,opt,name=sourcePoolId,proto3" json:"sourcePoolId,omitempty"`
+	// The name of the pool which the volume is placed in.
+	SourcePoolName string `protobuf:"bytes,9,opt,name=sourcePoolName,proto3" json:"sourcePoolName,omitempty"`
+	// The metadata of the volume, optional.
+	Metadata map[string]string `protobuf:"bytes,10,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The storage driver type.
+	DriverName string `protobuf:"bytes,11,opt,name=driverName,proto3" json:"driverName,omitempty"`
+	// The Context
+	Context string `protobuf:"bytes,12,opt,name=context,proto3" json:"context,omitempty"`
+	// The uuid of the operation which tracks this request.
+	OperationId          string   `protobuf:"bytes,13,opt,name=operationId,proto3" json:"operationId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MigrateVolumeOpts) Reset()         { *m = MigrateVolumeOpts{} }
+func (m *MigrateVolumeOpts) String() string { return proto.CompactTextString(m) }
+func (*MigrateVolumeOpts) ProtoMessage()    {}
+func (*MigrateVolumeOpts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{4}
+}
+
+func (m *MigrateVolumeOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MigrateVolumeOpts.Unmarshal(m, b)
+}
+func (m *MigrateVolumeOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MigrateVolumeOpts.Marshal(b, m, deterministic)
+}
+func (m *MigrateVolumeOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigrateVolumeOpts.Merge(m, src)
+}
+func (m *MigrateVolumeOpts) XXX_Size() int {
+	return xxx_messageInfo_MigrateVolumeOpts.Size(m)
+}
+func (m *MigrateVolumeOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigrateVolumeOpts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MigrateVolumeOpts proto.InternalMessageInfo
+
+func (m *MigrateVolumeOpts) GetId() string {
 	if m != nil {
-		return m.Metadata
+		return m.Id
 	}
-	return nil
+	return ""
 }
 
-func (m *CreateVolumeSnapshotOpts) GetDriverName() string {
+func (m *MigrateVolumeOpts) GetName() string {
 	if m != nil {
-		return m.DriverName
+		return m.Name
 	}
 	return ""
 }
 
-func (m *CreateVolumeSnapshotOpts) GetContext() string {
+func (m *MigrateVolumeOpts) GetSize() int64 {
 	if m != nil {
-		return m.Context
+		return m.Size
 	}
-	return ""
+	return 0
 }
 
-func (m *CreateVolumeSnapshotOpts) GetProfile() string {
+func (m *MigrateVolumeOpts) GetPoolId() string {
 	if m != nil {
-		return m.Profile
+		return m.PoolId
 	}
 	return ""
 }
 
-// DeleteVolumeSnapshotOpts is a structure which indicates all required
-// properties for deleting a volume snapshot.
-type DeleteVolumeSnapshotOpts struct {
-	// The uuid of the volume snapshot, required.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// The uuid of the volume that snapshot belongs to, required.
-	VolumeId string `protobuf:"bytes,2,opt,name=volumeId,proto3" json:"volumeId,omitempty"`
-	// The metadata of the volume snapshot, optional.
-	Metadata map[string]string `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// The storage driver type.
-	DriverName string `protobuf:"bytes,4,opt,name=driverName,proto3" json:"driverName,omitempty"`
-	// The Context
-	Context string `protobuf:"bytes,5,opt,name=context,proto3" json:"context,omitempty"`
-	// The Serialized profile
-	Profile              string   `protobuf:"bytes,6,opt,name=profile,proto3" json:"profile,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *MigrateVolumeOpts) GetPoolName() string {
+	if m != nil {
+		return m.PoolName
+	}
+	return ""
 }
 
-func (m *DeleteVolumeSnapshotOpts) Reset()         { *m = DeleteVolumeSnapshotOpts{} }
-func (m *DeleteVolumeSnapshotOpts) String() string { return proto.CompactTextString(m) }
-func (*DeleteVolumeSnapshotOpts) ProtoMessage()    {}
-func (*DeleteVolumeSnapshotOpts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{4}
+func (m *MigrateVolumeOpts) GetProfileId() string {
+	if m != nil {
+		return m.ProfileId
+	}
+	return ""
 }
 
-func (m *DeleteVolumeSnapshotOpts) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteVolumeSnapshotOpts.Unmarshal(m, b)
-}
-func (m *DeleteVolumeSnapshotOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteVolumeSnapshotOpts.Marshal(b, m, deterministic)
-}
-func (m *DeleteVolumeSnapshotOpts) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteVolumeSnapshotOpts.Merge(m, src)
-}
-func (m *DeleteVolumeSnapshotOpts) XXX_Size() int {
-	return xxx_messageInfo_DeleteVolumeSnapshotOpts.Size(m)
-}
-func (m *DeleteVolumeSnapshotOpts) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteVolumeSnapshotOpts.DiscardUnknown(m)
+func (m *MigrateVolumeOpts) GetProfile() string {
+	if m != nil {
+		return m.Profile
+	}
+	return ""
 }
 
-var xxx_messageInfo_DeleteVolumeSnapshotOpts proto.InternalMessageInfo
-
-func (m *DeleteVolumeSnapshotOpts) GetId() string {
+func (m *MigrateVolumeOpts) GetSourcePoolId() string {
 	if m != nil {
-		return m.Id
+		return m.SourcePoolId
 	}
 	return ""
 }
 
-func (m *DeleteVolumeSnapshotOpts) GetVolumeId() string {
+func (m *MigrateVolumeOpts) GetSourcePoolName() string {
 	if m != nil {
-		return m.VolumeId
+		return m.SourcePoolName
 	}
 	return ""
 }
 
-func (m *DeleteVolumeSnapshotOpts) GetMetadata() map[string]string {
+func (m *MigrateVolumeOpts) GetMetadata() map[string]string {
 	if m != nil {
 		return m.Metadata
 	}
 	return nil
 }
 
-func (m *DeleteVolumeSnapshotOpts) GetDriverName() string {
+func (m *MigrateVolumeOpts) GetDriverName() string {
 	if m != nil {
 		return m.DriverName
 	}
 	return ""
 }
 
-func (m *DeleteVolumeSnapshotOpts) GetContext() string {
+func (m *MigrateVolumeOpts) GetContext() string {
 	if m != nil {
 		return m.Context
 	}
 	return ""
 }
 
-func (m *DeleteVolumeSnapshotOpts) GetProfile() string {
+func (m *MigrateVolumeOpts) GetOperationId() string {
 	if m != nil {
-		return m.Profile
+		return m.OperationId
 	}
 	return ""
 }
 
-// CreateVolumeAttachmentOpts is a structure which indicates all required
-// properties for creating a volume attachment.
-type CreateVolumeAttachmentOpts struct {
-	// The uuid of the volume attachment, optional.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+// RevertVolumeToSnapshotOpts is a structure which indicates all required
+// properties for reverting a volume to one of its snapshots.
+type RevertVolumeToSnapshotOpts struct {
 	// The uuid of the volume, required.
-	VolumeId string `protobuf:"bytes,2,opt,name=volumeId,proto3" json:"volumeId,omitempty"`
-	// This field indicates if the volume is attached locally, optional.
-	DoLocalAttach bool `protobuf:"varint,3,opt,name=doLocalAttach,proto3" json:"doLocalAttach,omitempty"`
-	// This field indicates if the volume is attached multiple times, optional.
-	MultiPath bool `protobuf:"varint,4,opt,name=multiPath,proto3" json:"multiPath,omitempty"`
-	// The infomation of the host node on which the volume will be attached.
-	HostInfo *HostInfo `protobuf:"bytes,5,opt,name=hostInfo,proto3" json:"hostInfo,omitempty"`
-	// The metadata of the volume attachment, optional.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The uuid of the snapshot which the volume is reverted to, required.
+	SnapshotId string `protobuf:"bytes,2,opt,name=snapshotId,proto3" json:"snapshotId,omitempty"`
+	// The capacity of the volume.
+	Size int64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// The uuid of the pool which the volume is placed in.
+	PoolId string `protobuf:"bytes,4,opt,name=poolId,proto3" json:"poolId,omitempty"`
+	// The name of the pool which the volume is placed in.
+	PoolName string `protobuf:"bytes,5,opt,name=poolName,proto3" json:"poolName,omitempty"`
+	// The metadata of the volume, optional.
 	Metadata map[string]string `protobuf:"bytes,6,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The capacity of the snapshot.
+	SnapshotSize int64 `protobuf:"varint,7,opt,name=snapshotSize,proto3" json:"snapshotSize,omitempty"`
+	// The metadata of the snapshot, optional.
+	SnapshotMetadata map[string]string `protobuf:"bytes,8,rep,name=snapshotMetadata,proto3" json:"snapshotMetadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Whether the volume is reverted even if it's attached or the snapshot
+	// isn't the latest one.
+	Force bool `protobuf:"varint,9,opt,name=force,proto3" json:"force,omitempty"`
 	// The storage driver type.
-	DriverName string `protobuf:"bytes,7,opt,name=driverName,proto3" json:"driverName,omitempty"`
+	DriverName string `protobuf:"bytes,10,opt,name=driverName,proto3" json:"driverName,omitempty"`
 	// The Context
-	Context string `protobuf:"bytes,8,opt,name=context,proto3" json:"context,omitempty"`
-	// The protocol
-	AccessProtocol       string   `protobuf:"bytes,9,opt,name=AccessProtocol,proto3" json:"AccessProtocol,omitempty"`
+	Context string `protobuf:"bytes,11,opt,name=context,proto3" json:"context,omitempty"`
+	// The uuid of the operation which tracks this request.
+	OperationId          string   `protobuf:"bytes,12,opt,name=operationId,proto3" json:"operationId,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateVolumeAttachmentOpts) Reset()         { *m = CreateVolumeAttachmentOpts{} }
-func (m *CreateVolumeAttachmentOpts) String() string { return proto.CompactTextString(m) }
-func (*CreateVolumeAttachmentOpts) ProtoMessage()    {}
-func (*CreateVolumeAttachmentOpts) Descriptor() ([]byte, []int) {
+func (m *RevertVolumeToSnapshotOpts) Reset()         { *m = RevertVolumeToSnapshotOpts{} }
+func (m *RevertVolumeToSnapshotOpts) String() string { return proto.CompactTextString(m) }
+func (*RevertVolumeToSnapshotOpts) ProtoMessage()    {}
+func (*RevertVolumeToSnapshotOpts) Descriptor() ([]byte, []int) {
 	return fileDescriptor_4c16552f9fdb66d8, []int{5}
 }
 
-func (m *CreateVolumeAttachmentOpts) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateVolumeAttachmentOpts.Unmarshal(m, b)
+func (m *RevertVolumeToSnapshotOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevertVolumeToSnapshotOpts.Unmarshal(m, b)
 }
-func (m *CreateVolumeAttachmentOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateVolumeAttachmentOpts.Marshal(b, m, deterministic)
+func (m *RevertVolumeToSnapshotOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevertVolumeToSnapshotOpts.Marshal(b, m, deterministic)
 }
-func (m *CreateVolumeAttachmentOpts) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateVolumeAttachmentOpts.Merge(m, src)
+func (m *RevertVolumeToSnapshotOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevertVolumeToSnapshotOpts.Merge(m, src)
 }
-func (m *CreateVolumeAttachmentOpts) XXX_Size() int {
-	return xxx_messageInfo_CreateVolumeAttachmentOpts.Size(m)
+func (m *RevertVolumeToSnapshotOpts) XXX_Size() int {
+	return xxx_messageInfo_RevertVolumeToSnapshotOpts.Size(m)
 }
-func (m *CreateVolumeAttachmentOpts) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateVolumeAttachmentOpts.DiscardUnknown(m)
+func (m *RevertVolumeToSnapshotOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevertVolumeToSnapshotOpts.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateVolumeAttachmentOpts proto.InternalMessageInfo
+var xxx_messageInfo_RevertVolumeToSnapshotOpts proto.InternalMessageInfo
 
-func (m *CreateVolumeAttachmentOpts) GetId() string {
+func (m *RevertVolumeToSnapshotOpts) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-func (m *CreateVolumeAttachmentOpts) GetVolumeId() string {
+func (m *RevertVolumeToSnapshotOpts) GetSnapshotId() string {
 	if m != nil {
-		return m.VolumeId
+		return m.SnapshotId
 	}
 	return ""
 }
 
-func (m *CreateVolumeAttachmentOpts) GetDoLocalAttach() bool {
+func (m *RevertVolumeToSnapshotOpts) GetSize() int64 {
 	if m != nil {
-		return m.DoLocalAttach
+		return m.Size
 	}
-	return false
+	return 0
 }
 
-func (m *CreateVolumeAttachmentOpts) GetMultiPath() bool {
+func (m *RevertVolumeToSnapshotOpts) GetPoolId() string {
 	if m != nil {
-		return m.MultiPath
+		return m.PoolId
 	}
-	return false
+	return ""
 }
 
-func (m *CreateVolumeAttachmentOpts) GetHostInfo() *HostInfo {
+func (m *RevertVolumeToSnapshotOpts) GetPoolName() string {
 	if m != nil {
-		return m.HostInfo
+		return m.PoolName
 	}
-	return nil
+	return ""
 }
 
-func (m *CreateVolumeAttachmentOpts) GetMetadata() map[string]string {
+func (m *RevertVolumeToSnapshotOpts) GetMetadata() map[string]string {
 	if m != nil {
 		return m.Metadata
 	}
 	return nil
 }
 
-func (m *CreateVolumeAttachmentOpts) GetDriverName() string {
+func (m *RevertVolumeToSnapshotOpts) GetSnapshotSize() int64 {
 	if m != nil {
-		return m.DriverName
+		return m.SnapshotSize
 	}
-	return ""
+	return 0
 }
 
-func (m *CreateVolumeAttachmentOpts) GetContext() string {
+func (m *RevertVolumeToSnapshotOpts) GetSnapshotMetadata() map[string]string {
 	if m != nil {
-		return m.Context
+		return m.SnapshotMetadata
 	}
-	return ""
+	return nil
 }
 
-func (m *CreateVolumeAttachmentOpts) GetAccessProtocol() string {
+func (m *RevertVolumeToSnapshotOpts) GetForce() bool {
 	if m != nil {
-		return m.AccessProtocol
+		return m.Force
+	}
+	return false
+}
+
+func (m *RevertVolumeToSnapshotOpts) GetDriverName() string {
+	if m != nil {
+		return m.DriverName
 	}
 	return ""
 }
 
-// DeleteVolumeAttachmentOpts is a structure which indicates all required
-// properties for deleting a volume attachment.
-type DeleteVolumeAttachmentOpts struct {
-	// The uuid of the volume attachment, optional.
+func (m *RevertVolumeToSnapshotOpts) GetContext() string {
+	if m != nil {
+		return m.Context
+	}
+	return ""
+}
+
+func (m *RevertVolumeToSnapshotOpts) GetOperationId() string {
+	if m != nil {
+		return m.OperationId
+	}
+	return ""
+}
+
+// ManageVolumeOpts is a structure which indicates all required properties
+// for taking over a volume existing in the backend.
+type ManageVolumeOpts struct {
+	// The uuid assigned to the volume, required.
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// The uuid of the volume, required.
-	VolumeId string `protobuf:"bytes,2,opt,name=volumeId,proto3" json:"volumeId,omitempty"`
-	// The infomation of the host node on which the volume will be attached.
-	HostInfo *HostInfo `protobuf:"bytes,3,opt,name=hostInfo,proto3" json:"hostInfo,omitempty"`
-	// The metadata of the volume attachment, optional.
-	Metadata map[string]string `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The name of the volume, optional.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The description of the volume, optional.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// The name or id of the volume in the backend, required.
+	Identifier string `protobuf:"bytes,4,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// The uuid of the pool which the volume is placed in, required.
+	PoolId string `protobuf:"bytes,5,opt,name=poolId,proto3" json:"poolId,omitempty"`
+	// The name of the pool which the volume is placed in.
+	PoolName string `protobuf:"bytes,6,opt,name=poolName,proto3" json:"poolName,omitempty"`
+	// The uuid of the profile, required.
+	ProfileId string `protobuf:"bytes,7,opt,name=profileId,proto3" json:"profileId,omitempty"`
+	// The Serialized profile
+	Profile string `protobuf:"bytes,8,opt,name=profile,proto3" json:"profile,omitempty"`
+	// The metadata of the volume, optional.
+	Metadata map[string]string `protobuf:"bytes,9,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// The storage driver type.
-	DriverName string `protobuf:"bytes,5,opt,name=driverName,proto3" json:"driverName,omitempty"`
+	DriverName string `protobuf:"bytes,10,opt,name=driverName,proto3" json:"driverName,omitempty"`
 	// The Context
-	Context string `protobuf:"bytes,6,opt,name=context,proto3" json:"context,omitempty"`
-	// The protocol
-	AccessProtocol       string   `protobuf:"bytes,7,opt,name=AccessProtocol,proto3" json:"AccessProtocol,omitempty"`
+	Context string `protobuf:"bytes,11,opt,name=context,proto3" json:"context,omitempty"`
+	// The uuid of the operation which tracks this request.
+	OperationId          string   `protobuf:"bytes,12,opt,name=operationId,proto3" json:"operationId,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteVolumeAttachmentOpts) Reset()         { *m = DeleteVolumeAttachmentOpts{} }
-func (m *DeleteVolumeAttachmentOpts) String() string { return proto.CompactTextString(m) }
-func (*DeleteVolumeAttachmentOpts) ProtoMessage()    {}
-func (*DeleteVolumeAttachmentOpts) Descriptor() ([]byte, []int) {
+func (m *ManageVolumeOpts) Reset()         { *m = ManageVolumeOpts{} }
+func (m *ManageVolumeOpts) String() string { return proto.CompactTextString(m) }
+func (*ManageVolumeOpts) ProtoMessage()    {}
+func (*ManageVolumeOpts) Descriptor() ([]byte, []int) {
 	return fileDescriptor_4c16552f9fdb66d8, []int{6}
 }
 
-func (m *DeleteVolumeAttachmentOpts) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteVolumeAttachmentOpts.Unmarshal(m, b)
+func (m *ManageVolumeOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ManageVolumeOpts.Unmarshal(m, b)
 }
-func (m *DeleteVolumeAttachmentOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteVolumeAttachmentOpts.Marshal(b, m, deterministic)
+func (m *ManageVolumeOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ManageVolumeOpts.Marshal(b, m, deterministic)
 }
-func (m *DeleteVolumeAttachmentOpts) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteVolumeAttachmentOpts.Merge(m, src)
+func (m *ManageVolumeOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManageVolumeOpts.Merge(m, src)
 }
-func (m *DeleteVolumeAttachmentOpts) XXX_Size() int {
-	return xxx_messageInfo_DeleteVolumeAttachmentOpts.Size(m)
+func (m *ManageVolumeOpts) XXX_Size() int {
+	return xxx_messageInfo_ManageVolumeOpts.Size(m)
 }
-func (m *DeleteVolumeAttachmentOpts) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteVolumeAttachmentOpts.DiscardUnknown(m)
+func (m *ManageVolumeOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManageVolumeOpts.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteVolumeAttachmentOpts proto.InternalMessageInfo
+var xxx_messageInfo_ManageVolumeOpts proto.InternalMessageInfo
 
-func (m *DeleteVolumeAttachmentOpts) GetId() string {
+func (m *ManageVolumeOpts) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-func (m *DeleteVolumeAttachmentOpts) GetVolumeId() string {
+func (m *ManageVolumeOpts) GetName() string {
 	if m != nil {
-		return m.VolumeId
+		return m.Name
 	}
 	return ""
 }
 
-func (m *DeleteVolumeAttachmentOpts) GetHostInfo() *HostInfo {
+func (m *ManageVolumeOpts) GetDescription() string {
 	if m != nil {
-		return m.HostInfo
+		return m.Description
 	}
-	return nil
+	return ""
 }
 
-func (m *DeleteVolumeAttachmentOpts) GetMetadata() map[string]string {
+func (m *ManageVolumeOpts) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *ManageVolumeOpts) GetPoolId() string {
+	if m != nil {
+		return m.PoolId
+	}
+	return ""
+}
+
+func (m *ManageVolumeOpts) GetPoolName() string {
+	if m != nil {
+		return m.PoolName
+	}
+	return ""
+}
+
+func (m *ManageVolumeOpts) GetProfileId() string {
+	if m != nil {
+		return m.ProfileId
+	}
+	return ""
+}
+
+func (m *ManageVolumeOpts) GetProfile() string {
+	if m != nil {
+		return m.Profile
+	}
+	return ""
+}
+
+func (m *ManageVolumeOpts) GetMetadata() map[string]string {
 	if m != nil {
 		return m.Metadata
 	}
 	return nil
 }
 
-func (m *DeleteVolumeAttachmentOpts) GetDriverName() string {
+func (m *ManageVolumeOpts) GetDriverName() string {
 	if m != nil {
 		return m.DriverName
 	}
 	return ""
 }
 
-func (m *DeleteVolumeAttachmentOpts) GetContext() string {
+func (m *ManageVolumeOpts) GetContext() string {
 	if m != nil {
 		return m.Context
 	}
 	return ""
 }
 
-func (m *DeleteVolumeAttachmentOpts) GetAccessProtocol() string {
+func (m *ManageVolumeOpts) GetOperationId() string {
 	if m != nil {
-		return m.AccessProtocol
+		return m.OperationId
 	}
 	return ""
 }
 
-// CreateSnapshotAttachmentOpts is a structure which indicates all required
-// properties for creating a snapshot attachment.
-type CreateSnapshotAttachmentOpts struct {
-	// The uuid of the snapshot attachment, optional.
+// UnmanageVolumeOpts is a structure which indicates all required properties
+// for giving up the management of a volume.
+type UnmanageVolumeOpts struct {
+	// The uuid of the volume, required.
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// The uuid of the snapshot, required.
-	SnapshotId string `protobuf:"bytes,2,opt,name=snapshotId,proto3" json:"snapshotId,omitempty"`
-	// This field indicates if the snapshot is attached locally, optional.
-	DoLocalAttach bool `protobuf:"varint,3,opt,name=doLocalAttach,proto3" json:"doLocalAttach,omitempty"`
-	// This field indicates if the snapshot is attached multiple times, optional.
-	MultiPath bool `protobuf:"varint,4,opt,name=multiPath,proto3" json:"multiPath,omitempty"`
-	// The infomation of the host node on which the snapshot will be attached.
-	HostInfo *HostInfo `protobuf:"bytes,5,opt,name=hostInfo,proto3" json:"hostInfo,omitempty"`
-	// The metadata of the snapshot attachment, optional.
-	Metadata map[string]string `protobuf:"bytes,6,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The uuid of the pool which the volume is placed in, required.
+	PoolId string `protobuf:"bytes,2,opt,name=poolId,proto3" json:"poolId,omitempty"`
+	// The metadata of the volume, optional.
+	Metadata map[string]string `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// The storage driver type.
-	DriverName string `protobuf:"bytes,7,opt,name=driverName,proto3" json:"driverName,omitempty"`
+	DriverName string `protobuf:"bytes,4,opt,name=driverName,proto3" json:"driverName,omitempty"`
 	// The Context
-	Context string `protobuf:"bytes,8,opt,name=context,proto3" json:"context,omitempty"`
-	// The protocol
-	AccessProtocol       string   `protobuf:"bytes,9,opt,name=AccessProtocol,proto3" json:"AccessProtocol,omitempty"`
+	Context string `protobuf:"bytes,5,opt,name=context,proto3" json:"context,omitempty"`
+	// The uuid of the operation which tracks this request.
+	OperationId          string   `protobuf:"bytes,6,opt,name=operationId,proto3" json:"operationId,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateSnapshotAttachmentOpts) Reset()         { *m = CreateSnapshotAttachmentOpts{} }
-func (m *CreateSnapshotAttachmentOpts) String() string { return proto.CompactTextString(m) }
-func (*CreateSnapshotAttachmentOpts) ProtoMessage()    {}
-func (*CreateSnapshotAttachmentOpts) Descriptor() ([]byte, []int) {
+func (m *UnmanageVolumeOpts) Reset()         { *m = UnmanageVolumeOpts{} }
+func (m *UnmanageVolumeOpts) String() string { return proto.CompactTextString(m) }
+func (*UnmanageVolumeOpts) ProtoMessage()    {}
+func (*UnmanageVolumeOpts) Descriptor() ([]byte, []int) {
 	return fileDescriptor_4c16552f9fdb66d8, []int{7}
 }
 
-func (m *CreateSnapshotAttachmentOpts) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateSnapshotAttachmentOpts.Unmarshal(m, b)
+func (m *UnmanageVolumeOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnmanageVolumeOpts.Unmarshal(m, b)
 }
-func (m *CreateSnapshotAttachmentOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateSnapshotAttachmentOpts.Marshal(b, m, deterministic)
+func (m *UnmanageVolumeOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnmanageVolumeOpts.Marshal(b, m, deterministic)
 }
-func (m *CreateSnapshotAttachmentOpts) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateSnapshotAttachmentOpts.Merge(m, src)
+func (m *UnmanageVolumeOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnmanageVolumeOpts.Merge(m, src)
 }
-func (m *CreateSnapshotAttachmentOpts) XXX_Size() int {
-	return xxx_messageInfo_CreateSnapshotAttachmentOpts.Size(m)
+func (m *UnmanageVolumeOpts) XXX_Size() int {
+	return xxx_messageInfo_UnmanageVolumeOpts.Size(m)
 }
-func (m *CreateSnapshotAttachmentOpts) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateSnapshotAttachmentOpts.DiscardUnknown(m)
+func (m *UnmanageVolumeOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnmanageVolumeOpts.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateSnapshotAttachmentOpts proto.InternalMessageInfo
+var xxx_messageInfo_UnmanageVolumeOpts proto.InternalMessageInfo
 
-func (m *CreateSnapshotAttachmentOpts) GetId() string {
+func (m *UnmanageVolumeOpts) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-func (m *CreateSnapshotAttachmentOpts) GetSnapshotId() string {
+func (m *UnmanageVolumeOpts) GetPoolId() string {
 	if m != nil {
-		return m.SnapshotId
+		return m.PoolId
 	}
 	return ""
 }
 
-func (m *CreateSnapshotAttachmentOpts) GetDoLocalAttach() bool {
-	if m != nil {
-		return m.DoLocalAttach
-	}
-	return false
-}
-
-func (m *CreateSnapshotAttachmentOpts) GetMultiPath() bool {
-	if m != nil {
-		return m.MultiPath
-	}
-	return false
-}
-
-func (m *CreateSnapshotAttachmentOpts) GetHostInfo() *HostInfo {
-	if m != nil {
-		return m.HostInfo
-	}
-	return nil
-}
-
-func (m *CreateSnapshotAttachmentOpts) GetMetadata() map[string]string {
+func (m *UnmanageVolumeOpts) GetMetadata() map[string]string {
 	if m != nil {
 		return m.Metadata
 	}
 	return nil
 }
 
-func (m *CreateSnapshotAttachmentOpts) GetDriverName() string {
+func (m *UnmanageVolumeOpts) GetDriverName() string {
 	if m != nil {
 		return m.DriverName
 	}
 	return ""
 }
 
-func (m *CreateSnapshotAttachmentOpts) GetContext() string {
+func (m *UnmanageVolumeOpts) GetContext() string {
 	if m != nil {
 		return m.Context
 	}
 	return ""
 }
 
-func (m *CreateSnapshotAttachmentOpts) GetAccessProtocol() string {
+func (m *UnmanageVolumeOpts) GetOperationId() string {
 	if m != nil {
-		return m.AccessProtocol
+		return m.OperationId
 	}
 	return ""
 }
 
-// DeleteSnapshotAttachmentOpts is a structure which indicates all required
-// properties for deleting a snapshot attachment.
-type DeleteSnapshotAttachmentOpts struct {
-	// The uuid of the snapshot attachment, optional.
+// CreateVolumeSnapshotOpts is a structure which indicates all required
+// properties for creating a volume snapshot.
+type CreateVolumeSnapshotOpts struct {
+	// The uuid of the volume snapshot, optional.
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// The uuid of the snapshot, required.
-	SnapshotId string `protobuf:"bytes,2,opt,name=snapshotId,proto3" json:"snapshotId,omitempty"`
-	// The infomation of the host node on which the volume will be attached.
-	HostInfo *HostInfo `protobuf:"bytes,3,opt,name=hostInfo,proto3" json:"hostInfo,omitempty"`
-	// The metadata of the snapshot attachment, optional.
-	Metadata map[string]string `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The name of the volume snapshot, required.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The size of the volume that snapshot belongs to, required.
+	Size int64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// The description of the volume snapshot, optional.
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// The uuid of the volume that snapshot belongs to, required.
+	VolumeId string `protobuf:"bytes,5,opt,name=volumeId,proto3" json:"volumeId,omitempty"`
+	// The service level that volume belongs to, required.
+	// TODO: This item will be replace by profile, don't use it.
+	ProfileId string `protobuf:"bytes,6,opt,name=profileId,proto3" json:"profileId,omitempty"`
+	// The metadata of the volume snapshot, optional.
+	Metadata map[string]string `protobuf:"bytes,7,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// The storage driver type.
-	DriverName string `protobuf:"bytes,5,opt,name=driverName,proto3" json:"driverName,omitempty"`
+	DriverName string `protobuf:"bytes,8,opt,name=driverName,proto3" json:"driverName,omitempty"`
 	// The Context
-	Context string `protobuf:"bytes,6,opt,name=context,proto3" json:"context,omitempty"`
-	// The protocol
-	AccessProtocol       string   `protobuf:"bytes,7,opt,name=AccessProtocol,proto3" json:"AccessProtocol,omitempty"`
+	Context string `protobuf:"bytes,9,opt,name=context,proto3" json:"context,omitempty"`
+	// The Serialized profile
+	Profile string `protobuf:"bytes,10,opt,name=profile,proto3" json:"profile,omitempty"`
+	// The uuid of the operation which tracks this request.
+	OperationId          string   `protobuf:"bytes,11,opt,name=operationId,proto3" json:"operationId,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteSnapshotAttachmentOpts) Reset()         { *m = DeleteSnapshotAttachmentOpts{} }
-func (m *DeleteSnapshotAttachmentOpts) String() string { return proto.CompactTextString(m) }
-func (*DeleteSnapshotAttachmentOpts) ProtoMessage()    {}
-func (*DeleteSnapshotAttachmentOpts) Descriptor() ([]byte, []int) {
+func (m *CreateVolumeSnapshotOpts) Reset()         { *m = CreateVolumeSnapshotOpts{} }
+func (m *CreateVolumeSnapshotOpts) String() string { return proto.CompactTextString(m) }
+func (*CreateVolumeSnapshotOpts) ProtoMessage()    {}
+func (*CreateVolumeSnapshotOpts) Descriptor() ([]byte, []int) {
 	return fileDescriptor_4c16552f9fdb66d8, []int{8}
 }
 
-func (m *DeleteSnapshotAttachmentOpts) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteSnapshotAttachmentOpts.Unmarshal(m, b)
+func (m *CreateVolumeSnapshotOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateVolumeSnapshotOpts.Unmarshal(m, b)
 }
-func (m *DeleteSnapshotAttachmentOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteSnapshotAttachmentOpts.Marshal(b, m, deterministic)
+func (m *CreateVolumeSnapshotOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateVolumeSnapshotOpts.Marshal(b, m, deterministic)
 }
-func (m *DeleteSnapshotAttachmentOpts) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteSnapshotAttachmentOpts.Merge(m, src)
+func (m *CreateVolumeSnapshotOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateVolumeSnapshotOpts.Merge(m, src)
 }
-func (m *DeleteSnapshotAttachmentOpts) XXX_Size() int {
-	return xxx_messageInfo_DeleteSnapshotAttachmentOpts.Size(m)
+func (m *CreateVolumeSnapshotOpts) XXX_Size() int {
+	return xxx_messageInfo_CreateVolumeSnapshotOpts.Size(m)
 }
-func (m *DeleteSnapshotAttachmentOpts) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteSnapshotAttachmentOpts.DiscardUnknown(m)
+func (m *CreateVolumeSnapshotOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateVolumeSnapshotOpts.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteSnapshotAttachmentOpts proto.InternalMessageInfo
+var xxx_messageInfo_CreateVolumeSnapshotOpts proto.InternalMessageInfo
 
-func (m *DeleteSnapshotAttachmentOpts) GetId() string {
+func (m *CreateVolumeSnapshotOpts) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-func (m *DeleteSnapshotAttachmentOpts) GetSnapshotId() string {
+func (m *CreateVolumeSnapshotOpts) GetName() string {
 	if m != nil {
-		return m.SnapshotId
+		return m.Name
 	}
 	return ""
 }
 
-func (m *DeleteSnapshotAttachmentOpts) GetHostInfo() *HostInfo {
+func (m *CreateVolumeSnapshotOpts) GetSize() int64 {
 	if m != nil {
-		return m.HostInfo
+		return m.Size
 	}
-	return nil
+	return 0
 }
 
-func (m *DeleteSnapshotAttachmentOpts) GetMetadata() map[string]string {
+func (m *CreateVolumeSnapshotOpts) GetDescription() string {
 	if m != nil {
-		return m.Metadata
+		return m.Description
 	}
-	return nil
+	return ""
 }
 
-func (m *DeleteSnapshotAttachmentOpts) GetDriverName() string {
+func (m *CreateVolumeSnapshotOpts) GetVolumeId() string {
 	if m != nil {
-		return m.DriverName
+		return m.VolumeId
 	}
 	return ""
 }
 
-func (m *DeleteSnapshotAttachmentOpts) GetContext() string {
+func (m *CreateVolumeSnapshotOpts) GetProfileId() string {
 	if m != nil {
-		return m.Context
+		return m.ProfileId
 	}
 	return ""
 }
 
-func (m *DeleteSnapshotAttachmentOpts) GetAccessProtocol() string {
+func (m *CreateVolumeSnapshotOpts) GetMetadata() map[string]string {
 	if m != nil {
-		return m.AccessProtocol
+		return m.Metadata
 	}
-	return ""
+	return nil
 }
 
-type HostInfo struct {
-	// The platform of the host, such as "x86_64"
-	Platform string `protobuf:"bytes,1,opt,name=platform,proto3" json:"platform,omitempty"`
-	// The type of OS, such as "linux","windows", etc.
-	OsType string `protobuf:"bytes,2,opt,name=osType,proto3" json:"osType,omitempty"`
-	// The name of the host
-	Host string `protobuf:"bytes,3,opt,name=host,proto3" json:"host,omitempty"`
-	// The ip address of the host
-	Ip string `protobuf:"bytes,4,opt,name=ip,proto3" json:"ip,omitempty"`
-	// The initiator infomation, such as: "iqn.2017.com.redhat:e08039b48d5c"
-	Initiator            string   `protobuf:"bytes,5,opt,name=initiator,proto3" json:"initiator,omitempty"`
+func (m *CreateVolumeSnapshotOpts) GetDriverName() string {
+	if m != nil {
+		return m.DriverName
+	}
+	return ""
+}
+
+func (m *CreateVolumeSnapshotOpts) GetContext() string {
+	if m != nil {
+		return m.Context
+	}
+	return ""
+}
+
+func (m *CreateVolumeSnapshotOpts) GetProfile() string {
+	if m != nil {
+		return m.Profile
+	}
+	return ""
+}
+
+func (m *CreateVolumeSnapshotOpts) GetOperationId() string {
+	if m != nil {
+		return m.OperationId
+	}
+	return ""
+}
+
+// DeleteVolumeSnapshotOpts is a structure which indicates all required
+// properties for deleting a volume snapshot.
+type DeleteVolumeSnapshotOpts struct {
+	// The uuid of the volume snapshot, required.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The uuid of the volume that snapshot belongs to, required.
+	VolumeId string `protobuf:"bytes,2,opt,name=volumeId,proto3" json:"volumeId,omitempty"`
+	// The metadata of the volume snapshot, optional.
+	Metadata map[string]string `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The storage driver type.
+	DriverName string `protobuf:"bytes,4,opt,name=driverName,proto3" json:"driverName,omitempty"`
+	// The Context
+	Context string `protobuf:"bytes,5,opt,name=context,proto3" json:"context,omitempty"`
+	// The Serialized profile
+	Profile string `protobuf:"bytes,6,opt,name=profile,proto3" json:"profile,omitempty"`
+	// The uuid of the operation which tracks this request.
+	OperationId          string   `protobuf:"bytes,7,opt,name=operationId,proto3" json:"operationId,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *HostInfo) Reset()         { *m = HostInfo{} }
-func (m *HostInfo) String() string { return proto.CompactTextString(m) }
-func (*HostInfo) ProtoMessage()    {}
-func (*HostInfo) Descriptor() ([]byte, []int) {
+func (m *DeleteVolumeSnapshotOpts) Reset()         { *m = DeleteVolumeSnapshotOpts{} }
+func (m *DeleteVolumeSnapshotOpts) String() string { return proto.CompactTextString(m) }
+func (*DeleteVolumeSnapshotOpts) ProtoMessage()    {}
+func (*DeleteVolumeSnapshotOpts) Descriptor() ([]byte, []int) {
 	return fileDescriptor_4c16552f9fdb66d8, []int{9}
 }
 
-func (m *HostInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_HostInfo.Unmarshal(m, b)
+func (m *DeleteVolumeSnapshotOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteVolumeSnapshotOpts.Unmarshal(m, b)
 }
-func (m *HostInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_HostInfo.Marshal(b, m, deterministic)
+func (m *DeleteVolumeSnapshotOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteVolumeSnapshotOpts.Marshal(b, m, deterministic)
 }
-func (m *HostInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_HostInfo.Merge(m, src)
+func (m *DeleteVolumeSnapshotOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteVolumeSnapshotOpts.Merge(m, src)
 }
-func (m *HostInfo) XXX_Size() int {
-	return xxx_messageInfo_HostInfo.Size(m)
+func (m *DeleteVolumeSnapshotOpts) XXX_Size() int {
+	return xxx_messageInfo_DeleteVolumeSnapshotOpts.Size(m)
 }
-func (m *HostInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_HostInfo.DiscardUnknown(m)
+func (m *DeleteVolumeSnapshotOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteVolumeSnapshotOpts.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_HostInfo proto.InternalMessageInfo
+var xxx_messageInfo_DeleteVolumeSnapshotOpts proto.InternalMessageInfo
 
-func (m *HostInfo) GetPlatform() string {
+func (m *DeleteVolumeSnapshotOpts) GetId() string {
 	if m != nil {
-		return m.Platform
+		return m.Id
 	}
 	return ""
 }
 
-func (m *HostInfo) GetOsType() string {
+func (m *DeleteVolumeSnapshotOpts) GetVolumeId() string {
 	if m != nil {
-		return m.OsType
+		return m.VolumeId
 	}
 	return ""
 }
 
-func (m *HostInfo) GetHost() string {
+func (m *DeleteVolumeSnapshotOpts) GetMetadata() map[string]string {
 	if m != nil {
-		return m.Host
+		return m.Metadata
 	}
-	return ""
+	return nil
 }
 
-func (m *HostInfo) GetIp() string {
+func (m *DeleteVolumeSnapshotOpts) GetDriverName() string {
 	if m != nil {
-		return m.Ip
+		return m.DriverName
 	}
 	return ""
 }
 
-func (m *HostInfo) GetInitiator() string {
+func (m *DeleteVolumeSnapshotOpts) GetContext() string {
 	if m != nil {
-		return m.Initiator
+		return m.Context
 	}
 	return ""
 }
 
-type VolumeData struct {
-	Data                 map[string]string `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
-}
-
-func (m *VolumeData) Reset()         { *m = VolumeData{} }
-func (m *VolumeData) String() string { return proto.CompactTextString(m) }
-func (*VolumeData) ProtoMessage()    {}
-func (*VolumeData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{10}
-}
-
-func (m *VolumeData) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_VolumeData.Unmarshal(m, b)
-}
-func (m *VolumeData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_VolumeData.Marshal(b, m, deterministic)
-}
-func (m *VolumeData) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VolumeData.Merge(m, src)
-}
-func (m *VolumeData) XXX_Size() int {
-	return xxx_messageInfo_VolumeData.Size(m)
-}
-func (m *VolumeData) XXX_DiscardUnknown() {
-	xxx_messageInfo_VolumeData.DiscardUnknown(m)
+func (m *DeleteVolumeSnapshotOpts) GetProfile() string {
+	if m != nil {
+		return m.Profile
+	}
+	return ""
 }
 
-var xxx_messageInfo_VolumeData proto.InternalMessageInfo
-
-func (m *VolumeData) GetData() map[string]string {
+func (m *DeleteVolumeSnapshotOpts) GetOperationId() string {
 	if m != nil {
-		return m.Data
+		return m.OperationId
 	}
-	return nil
+	return ""
 }
 
-// CreateReplicationOpts is a structure which indicates all required properties
-// for creating a replication.
-// NOTE: Need to figure out how to handle more than 2 sites.
-type CreateReplicationOpts struct {
-	// The uuid of the replication, optional when creating.
+// ManageVolumeSnapshotOpts is a structure which indicates all required
+// properties for taking over a volume snapshot existing in the backend.
+type ManageVolumeSnapshotOpts struct {
+	// The uuid assigned to the volume snapshot, required.
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// The name of the replication, optional.
+	// The name of the volume snapshot, optional.
 	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// The description of the replication, optional.
+	// The description of the volume snapshot, optional.
 	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	// The uuid of the primary volume. This field is required.
-	PrimaryVolumeId string `protobuf:"bytes,4,opt,name=primaryVolumeId,proto3" json:"primaryVolumeId,omitempty"`
-	// The uuid of the secondary volume. This field is required.
-	SecondaryVolumeId string `protobuf:"bytes,5,opt,name=secondaryVolumeId,proto3" json:"secondaryVolumeId,omitempty"`
-	// The dock infomation on which the request will be executed
-	AvailabilityZone string `protobuf:"bytes,6,opt,name=availabilityZone,proto3" json:"availabilityZone,omitempty"`
-	// The service level that volume belongs to, required.
-	// TODO: This item will be replace by profile, don't use it.
-	ProfileId string `protobuf:"bytes,7,opt,name=profileId,proto3" json:"profileId,omitempty"`
-	// The uuid of the pool on which volume will be created, required.
-	PoolId string `protobuf:"bytes,8,opt,name=poolId,proto3" json:"poolId,omitempty"`
-	// The name of the pool on which volume will be created, required.
-	PoolName string `protobuf:"bytes,9,opt,name=poolName,proto3" json:"poolName,omitempty"`
-	// The metadata of the primary replication, optional.
-	PrimaryReplicationDriverData map[string]string `protobuf:"bytes,11,rep,name=primaryReplicationDriverData,proto3" json:"primaryReplicationDriverData,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// The metadata of the seondary replication, optional.
-	SecondaryReplicationDriverData map[string]string `protobuf:"bytes,12,rep,name=secondaryReplicationDriverData,proto3" json:"secondaryReplicationDriverData,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// The dock id.
-	DockId string `protobuf:"bytes,13,opt,name=dockId,proto3" json:"dockId,omitempty"`
-	// The replication driver type.
-	DriverName string `protobuf:"bytes,14,opt,name=driverName,proto3" json:"driverName,omitempty"`
+	// The name or id of the volume snapshot in the backend, required.
+	Identifier string `protobuf:"bytes,4,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// The uuid of the volume that snapshot belongs to, required.
+	VolumeId string `protobuf:"bytes,5,opt,name=volumeId,proto3" json:"volumeId,omitempty"`
+	// The size of the volume that snapshot belongs to.
+	Size int64 `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`
+	// The metadata of the volume that snapshot belongs to, optional.
+	Metadata map[string]string `protobuf:"bytes,7,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The storage driver type.
+	DriverName string `protobuf:"bytes,8,opt,name=driverName,proto3" json:"driverName,omitempty"`
 	// The Context
-	Context string `protobuf:"bytes,15,opt,name=context,proto3" json:"context,omitempty"`
-	// Whether is primary replication
-	IsPrimary bool `protobuf:"varint,16,opt,name=isPrimary,proto3" json:"isPrimary,omitempty"`
-	// The replication metadata
-	Metadata map[string]string `protobuf:"bytes,17,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// The volume data list
-	VolumeDataList []*VolumeData `protobuf:"bytes,18,rep,name=volumeDataList,proto3" json:"volumeDataList,omitempty"`
-	//the replication mode sync/async
-	ReplicationMode string `protobuf:"bytes,19,opt,name=replicationMode,proto3" json:"replicationMode,omitempty"`
-	// 0 means sync replication.
-	ReplicationPeriod int64 `protobuf:"varint,20,opt,name=ReplicationPeriod,proto3" json:"ReplicationPeriod,omitempty"`
-	// replication bandwidth
-	ReplicationBandwidth int64 `protobuf:"varint,21,opt,name=ReplicationBandwidth,proto3" json:"ReplicationBandwidth,omitempty"`
-	// The Serialized profile
-	Profile              string   `protobuf:"bytes,22,opt,name=profile,proto3" json:"profile,omitempty"`
+	Context string `protobuf:"bytes,9,opt,name=context,proto3" json:"context,omitempty"`
+	// The uuid of the operation which tracks this request.
+	OperationId          string   `protobuf:"bytes,10,opt,name=operationId,proto3" json:"operationId,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateReplicationOpts) Reset()         { *m = CreateReplicationOpts{} }
-func (m *CreateReplicationOpts) String() string { return proto.CompactTextString(m) }
-func (*CreateReplicationOpts) ProtoMessage()    {}
-func (*CreateReplicationOpts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{11}
+func (m *ManageVolumeSnapshotOpts) Reset()         { *m = ManageVolumeSnapshotOpts{} }
+func (m *ManageVolumeSnapshotOpts) String() string { return proto.CompactTextString(m) }
+func (*ManageVolumeSnapshotOpts) ProtoMessage()    {}
+func (*ManageVolumeSnapshotOpts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{10}
 }
 
-func (m *CreateReplicationOpts) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateReplicationOpts.Unmarshal(m, b)
+func (m *ManageVolumeSnapshotOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ManageVolumeSnapshotOpts.Unmarshal(m, b)
 }
-func (m *CreateReplicationOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateReplicationOpts.Marshal(b, m, deterministic)
+func (m *ManageVolumeSnapshotOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ManageVolumeSnapshotOpts.Marshal(b, m, deterministic)
 }
-func (m *CreateReplicationOpts) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateReplicationOpts.Merge(m, src)
+func (m *ManageVolumeSnapshotOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManageVolumeSnapshotOpts.Merge(m, src)
 }
-func (m *CreateReplicationOpts) XXX_Size() int {
-	return xxx_messageInfo_CreateReplicationOpts.Size(m)
+func (m *ManageVolumeSnapshotOpts) XXX_Size() int {
+	return xxx_messageInfo_ManageVolumeSnapshotOpts.Size(m)
 }
-func (m *CreateReplicationOpts) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateReplicationOpts.DiscardUnknown(m)
+func (m *ManageVolumeSnapshotOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManageVolumeSnapshotOpts.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateReplicationOpts proto.InternalMessageInfo
+var xxx_messageInfo_ManageVolumeSnapshotOpts proto.InternalMessageInfo
 
-func (m *CreateReplicationOpts) GetId() string {
+func (m *ManageVolumeSnapshotOpts) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-func (m *CreateReplicationOpts) GetName() string {
+func (m *ManageVolumeSnapshotOpts) GetName() string {
 	if m != nil {
 		return m.Name
 	}
 	return ""
 }
 
-func (m *CreateReplicationOpts) GetDescription() string {
+func (m *ManageVolumeSnapshotOpts) GetDescription() string {
 	if m != nil {
 		return m.Description
 	}
 	return ""
 }
 
-func (m *CreateReplicationOpts) GetPrimaryVolumeId() string {
+func (m *ManageVolumeSnapshotOpts) GetIdentifier() string {
 	if m != nil {
-		return m.PrimaryVolumeId
+		return m.Identifier
 	}
 	return ""
 }
 
-func (m *CreateReplicationOpts) GetSecondaryVolumeId() string {
+func (m *ManageVolumeSnapshotOpts) GetVolumeId() string {
 	if m != nil {
-		return m.SecondaryVolumeId
+		return m.VolumeId
 	}
 	return ""
 }
 
-func (m *CreateReplicationOpts) GetAvailabilityZone() string {
+func (m *ManageVolumeSnapshotOpts) GetSize() int64 {
 	if m != nil {
-		return m.AvailabilityZone
+		return m.Size
 	}
-	return ""
+	return 0
 }
 
-func (m *CreateReplicationOpts) GetProfileId() string {
+func (m *ManageVolumeSnapshotOpts) GetMetadata() map[string]string {
 	if m != nil {
-		return m.ProfileId
+		return m.Metadata
 	}
-	return ""
+	return nil
 }
 
-func (m *CreateReplicationOpts) GetPoolId() string {
+func (m *ManageVolumeSnapshotOpts) GetDriverName() string {
 	if m != nil {
-		return m.PoolId
+		return m.DriverName
 	}
 	return ""
 }
 
-func (m *CreateReplicationOpts) GetPoolName() string {
+func (m *ManageVolumeSnapshotOpts) GetContext() string {
 	if m != nil {
-		return m.PoolName
+		return m.Context
 	}
 	return ""
 }
 
-func (m *CreateReplicationOpts) GetPrimaryReplicationDriverData() map[string]string {
+func (m *ManageVolumeSnapshotOpts) GetOperationId() string {
 	if m != nil {
-		return m.PrimaryReplicationDriverData
+		return m.OperationId
 	}
-	return nil
+	return ""
 }
 
-func (m *CreateReplicationOpts) GetSecondaryReplicationDriverData() map[string]string {
-	if m != nil {
-		return m.SecondaryReplicationDriverData
-	}
-	return nil
+// UnmanageVolumeSnapshotOpts is a structure which indicates all required
+// properties for giving up the management of a volume snapshot.
+type UnmanageVolumeSnapshotOpts struct {
+	// The uuid of the volume snapshot, required.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The uuid of the volume that snapshot belongs to, required.
+	VolumeId string `protobuf:"bytes,2,opt,name=volumeId,proto3" json:"volumeId,omitempty"`
+	// The metadata of the volume snapshot, optional.
+	Metadata map[string]string `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The storage driver type.
+	DriverName string `protobuf:"bytes,4,opt,name=driverName,proto3" json:"driverName,omitempty"`
+	// The Context
+	Context string `protobuf:"bytes,5,opt,name=context,proto3" json:"context,omitempty"`
+	// The uuid of the operation which tracks this request.
+	OperationId          string   `protobuf:"bytes,6,opt,name=operationId,proto3" json:"operationId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateReplicationOpts) GetDockId() string {
-	if m != nil {
-		return m.DockId
-	}
-	return ""
+func (m *UnmanageVolumeSnapshotOpts) Reset()         { *m = UnmanageVolumeSnapshotOpts{} }
+func (m *UnmanageVolumeSnapshotOpts) String() string { return proto.CompactTextString(m) }
+func (*UnmanageVolumeSnapshotOpts) ProtoMessage()    {}
+func (*UnmanageVolumeSnapshotOpts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{11}
 }
 
-func (m *CreateReplicationOpts) GetDriverName() string {
-	if m != nil {
-		return m.DriverName
-	}
-	return ""
+func (m *UnmanageVolumeSnapshotOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnmanageVolumeSnapshotOpts.Unmarshal(m, b)
+}
+func (m *UnmanageVolumeSnapshotOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnmanageVolumeSnapshotOpts.Marshal(b, m, deterministic)
+}
+func (m *UnmanageVolumeSnapshotOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnmanageVolumeSnapshotOpts.Merge(m, src)
+}
+func (m *UnmanageVolumeSnapshotOpts) XXX_Size() int {
+	return xxx_messageInfo_UnmanageVolumeSnapshotOpts.Size(m)
+}
+func (m *UnmanageVolumeSnapshotOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnmanageVolumeSnapshotOpts.DiscardUnknown(m)
 }
 
-func (m *CreateReplicationOpts) GetContext() string {
+var xxx_messageInfo_UnmanageVolumeSnapshotOpts proto.InternalMessageInfo
+
+func (m *UnmanageVolumeSnapshotOpts) GetId() string {
 	if m != nil {
-		return m.Context
+		return m.Id
 	}
 	return ""
 }
 
-func (m *CreateReplicationOpts) GetIsPrimary() bool {
+func (m *UnmanageVolumeSnapshotOpts) GetVolumeId() string {
 	if m != nil {
-		return m.IsPrimary
+		return m.VolumeId
 	}
-	return false
+	return ""
 }
 
-func (m *CreateReplicationOpts) GetMetadata() map[string]string {
+func (m *UnmanageVolumeSnapshotOpts) GetMetadata() map[string]string {
 	if m != nil {
 		return m.Metadata
 	}
 	return nil
 }
 
-func (m *CreateReplicationOpts) GetVolumeDataList() []*VolumeData {
-	if m != nil {
-		return m.VolumeDataList
-	}
-	return nil
-}
-
-func (m *CreateReplicationOpts) GetReplicationMode() string {
+func (m *UnmanageVolumeSnapshotOpts) GetDriverName() string {
 	if m != nil {
-		return m.ReplicationMode
+		return m.DriverName
 	}
 	return ""
 }
 
-func (m *CreateReplicationOpts) GetReplicationPeriod() int64 {
-	if m != nil {
-		return m.ReplicationPeriod
-	}
-	return 0
-}
-
-func (m *CreateReplicationOpts) GetReplicationBandwidth() int64 {
+func (m *UnmanageVolumeSnapshotOpts) GetContext() string {
 	if m != nil {
-		return m.ReplicationBandwidth
+		return m.Context
 	}
-	return 0
+	return ""
 }
 
-func (m *CreateReplicationOpts) GetProfile() string {
+func (m *UnmanageVolumeSnapshotOpts) GetOperationId() string {
 	if m != nil {
-		return m.Profile
+		return m.OperationId
 	}
 	return ""
 }
 
-// Delete ReplicationOpts is a structure which indicates all required properties
-// for deleting a replication.
-// NOTE: Need to figure out how to handle more than 2 sites.
-type DeleteReplicationOpts struct {
-	// The uuid of the replication, optional when creating.
+// PullVolumeOpts is a structure which indicates all required properties
+// for pulling a volume from the backend.
+type PullVolumeOpts struct {
+	// The uuid of the volume, required.
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// The name of the replication, optional.
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// The description of the replication, optional.
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	// The uuid of the primary volume. This field is required.
-	PrimaryVolumeId string `protobuf:"bytes,4,opt,name=primaryVolumeId,proto3" json:"primaryVolumeId,omitempty"`
-	// The uuid of the secondary volume. This field is required.
-	SecondaryVolumeId string `protobuf:"bytes,5,opt,name=secondaryVolumeId,proto3" json:"secondaryVolumeId,omitempty"`
-	// The dock infomation on which the request will be executed
-	AvailabilityZone string `protobuf:"bytes,6,opt,name=availabilityZone,proto3" json:"availabilityZone,omitempty"`
-	// The service level that volume belongs to, required.
-	// TODO: This item will be replace by profile, don't use it.
-	ProfileId string `protobuf:"bytes,7,opt,name=profileId,proto3" json:"profileId,omitempty"`
-	// The uuid of the pool on which volume will be created, required.
-	PoolId string `protobuf:"bytes,8,opt,name=poolId,proto3" json:"poolId,omitempty"`
-	// The name of the pool on which volume will be created, required.
-	PoolName string `protobuf:"bytes,9,opt,name=poolName,proto3" json:"poolName,omitempty"`
-	// The metadata of the primary replication, optional.
-	PrimaryReplicationDriverData map[string]string `protobuf:"bytes,11,rep,name=primaryReplicationDriverData,proto3" json:"primaryReplicationDriverData,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// The metadata of the seondary replication, optional.
-	SecondaryReplicationDriverData map[string]string `protobuf:"bytes,12,rep,name=secondaryReplicationDriverData,proto3" json:"secondaryReplicationDriverData,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// The dock id.
-	DockId string `protobuf:"bytes,13,opt,name=dockId,proto3" json:"dockId,omitempty"`
-	// The replication driver type.
-	DriverName string `protobuf:"bytes,14,opt,name=driverName,proto3" json:"driverName,omitempty"`
+	// The uuid of the pool which the volume is placed in, required.
+	PoolId string `protobuf:"bytes,2,opt,name=poolId,proto3" json:"poolId,omitempty"`
+	// The name of the pool which the volume is placed in.
+	PoolName string `protobuf:"bytes,3,opt,name=poolName,proto3" json:"poolName,omitempty"`
+	// The metadata of the volume, optional.
+	Metadata map[string]string `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The storage driver type.
+	DriverName string `protobuf:"bytes,5,opt,name=driverName,proto3" json:"driverName,omitempty"`
 	// The Context
-	Context string `protobuf:"bytes,15,opt,name=context,proto3" json:"context,omitempty"`
-	// The replication metadata
-	Metadata map[string]string `protobuf:"bytes,16,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// Whether is primary replication
-	IsPrimary bool `protobuf:"varint,17,opt,name=isPrimary,proto3" json:"isPrimary,omitempty"`
-	// The Serialized profile
-	Profile              string   `protobuf:"bytes,18,opt,name=profile,proto3" json:"profile,omitempty"`
+	Context              string   `protobuf:"bytes,6,opt,name=context,proto3" json:"context,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteReplicationOpts) Reset()         { *m = DeleteReplicationOpts{} }
-func (m *DeleteReplicationOpts) String() string { return proto.CompactTextString(m) }
-func (*DeleteReplicationOpts) ProtoMessage()    {}
-func (*DeleteReplicationOpts) Descriptor() ([]byte, []int) {
+func (m *PullVolumeOpts) Reset()         { *m = PullVolumeOpts{} }
+func (m *PullVolumeOpts) String() string { return proto.CompactTextString(m) }
+func (*PullVolumeOpts) ProtoMessage()    {}
+func (*PullVolumeOpts) Descriptor() ([]byte, []int) {
 	return fileDescriptor_4c16552f9fdb66d8, []int{12}
 }
 
-func (m *DeleteReplicationOpts) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteReplicationOpts.Unmarshal(m, b)
+func (m *PullVolumeOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PullVolumeOpts.Unmarshal(m, b)
 }
-func (m *DeleteReplicationOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteReplicationOpts.Marshal(b, m, deterministic)
+func (m *PullVolumeOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PullVolumeOpts.Marshal(b, m, deterministic)
 }
-func (m *DeleteReplicationOpts) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteReplicationOpts.Merge(m, src)
+func (m *PullVolumeOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PullVolumeOpts.Merge(m, src)
 }
-func (m *DeleteReplicationOpts) XXX_Size() int {
-	return xxx_messageInfo_DeleteReplicationOpts.Size(m)
+func (m *PullVolumeOpts) XXX_Size() int {
+	return xxx_messageInfo_PullVolumeOpts.Size(m)
 }
-func (m *DeleteReplicationOpts) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteReplicationOpts.DiscardUnknown(m)
+func (m *PullVolumeOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_PullVolumeOpts.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteReplicationOpts proto.InternalMessageInfo
+var xxx_messageInfo_PullVolumeOpts proto.InternalMessageInfo
 
-func (m *DeleteReplicationOpts) GetId() string {
+func (m *PullVolumeOpts) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-func (m *DeleteReplicationOpts) GetName() string {
+func (m *PullVolumeOpts) GetPoolId() string {
 	if m != nil {
-		return m.Name
+		return m.PoolId
 	}
 	return ""
 }
 
-func (m *DeleteReplicationOpts) GetDescription() string {
+func (m *PullVolumeOpts) GetPoolName() string {
 	if m != nil {
-		return m.Description
+		return m.PoolName
 	}
 	return ""
 }
 
-func (m *DeleteReplicationOpts) GetPrimaryVolumeId() string {
+func (m *PullVolumeOpts) GetMetadata() map[string]string {
 	if m != nil {
-		return m.PrimaryVolumeId
+		return m.Metadata
 	}
-	return ""
+	return nil
 }
 
-func (m *DeleteReplicationOpts) GetSecondaryVolumeId() string {
+func (m *PullVolumeOpts) GetDriverName() string {
 	if m != nil {
-		return m.SecondaryVolumeId
+		return m.DriverName
 	}
 	return ""
 }
 
-func (m *DeleteReplicationOpts) GetAvailabilityZone() string {
+func (m *PullVolumeOpts) GetContext() string {
 	if m != nil {
-		return m.AvailabilityZone
+		return m.Context
 	}
 	return ""
 }
 
-func (m *DeleteReplicationOpts) GetProfileId() string {
-	if m != nil {
-		return m.ProfileId
-	}
-	return ""
+// PullVolumeSnapshotOpts is a structure which indicates all required
+// properties for pulling a volume snapshot from the backend.
+type PullVolumeSnapshotOpts struct {
+	// The uuid of the volume snapshot, required.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The uuid of the volume that snapshot belongs to, required.
+	VolumeId string `protobuf:"bytes,2,opt,name=volumeId,proto3" json:"volumeId,omitempty"`
+	// The name of the pool which the volume is placed in.
+	PoolName string `protobuf:"bytes,3,opt,name=poolName,proto3" json:"poolName,omitempty"`
+	// The metadata of the volume snapshot, optional.
+	Metadata map[string]string `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The storage driver type.
+	DriverName string `protobuf:"bytes,5,opt,name=driverName,proto3" json:"driverName,omitempty"`
+	// The Context
+	Context              string   `protobuf:"bytes,6,opt,name=context,proto3" json:"context,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteReplicationOpts) GetPoolId() string {
-	if m != nil {
-		return m.PoolId
-	}
-	return ""
+func (m *PullVolumeSnapshotOpts) Reset()         { *m = PullVolumeSnapshotOpts{} }
+func (m *PullVolumeSnapshotOpts) String() string { return proto.CompactTextString(m) }
+func (*PullVolumeSnapshotOpts) ProtoMessage()    {}
+func (*PullVolumeSnapshotOpts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{13}
 }
 
-func (m *DeleteReplicationOpts) GetPoolName() string {
-	if m != nil {
-		return m.PoolName
-	}
-	return ""
+func (m *PullVolumeSnapshotOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PullVolumeSnapshotOpts.Unmarshal(m, b)
 }
-
-func (m *DeleteReplicationOpts) GetPrimaryReplicationDriverData() map[string]string {
-	if m != nil {
-		return m.PrimaryReplicationDriverData
-	}
-	return nil
+func (m *PullVolumeSnapshotOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PullVolumeSnapshotOpts.Marshal(b, m, deterministic)
 }
-
-func (m *DeleteReplicationOpts) GetSecondaryReplicationDriverData() map[string]string {
-	if m != nil {
-		return m.SecondaryReplicationDriverData
-	}
-	return nil
+func (m *PullVolumeSnapshotOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PullVolumeSnapshotOpts.Merge(m, src)
+}
+func (m *PullVolumeSnapshotOpts) XXX_Size() int {
+	return xxx_messageInfo_PullVolumeSnapshotOpts.Size(m)
+}
+func (m *PullVolumeSnapshotOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_PullVolumeSnapshotOpts.DiscardUnknown(m)
 }
 
-func (m *DeleteReplicationOpts) GetDockId() string {
+var xxx_messageInfo_PullVolumeSnapshotOpts proto.InternalMessageInfo
+
+func (m *PullVolumeSnapshotOpts) GetId() string {
 	if m != nil {
-		return m.DockId
+		return m.Id
 	}
 	return ""
 }
 
-func (m *DeleteReplicationOpts) GetDriverName() string {
+func (m *PullVolumeSnapshotOpts) GetVolumeId() string {
 	if m != nil {
-		return m.DriverName
+		return m.VolumeId
 	}
 	return ""
 }
 
-func (m *DeleteReplicationOpts) GetContext() string {
+func (m *PullVolumeSnapshotOpts) GetPoolName() string {
 	if m != nil {
-		return m.Context
+		return m.PoolName
 	}
 	return ""
 }
 
-func (m *DeleteReplicationOpts) GetMetadata() map[string]string {
+func (m *PullVolumeSnapshotOpts) GetMetadata() map[string]string {
 	if m != nil {
 		return m.Metadata
 	}
 	return nil
 }
 
-func (m *DeleteReplicationOpts) GetIsPrimary() bool {
+func (m *PullVolumeSnapshotOpts) GetDriverName() string {
 	if m != nil {
-		return m.IsPrimary
+		return m.DriverName
 	}
-	return false
+	return ""
 }
 
-func (m *DeleteReplicationOpts) GetProfile() string {
+func (m *PullVolumeSnapshotOpts) GetContext() string {
 	if m != nil {
-		return m.Profile
+		return m.Context
 	}
 	return ""
 }
 
-// Delete ReplicationOpts is a structure which indicates all required properties
-type EnableReplicationOpts struct {
-	// The uuid of the replication, optional when creating.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// The name of the replication, optional.
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// The description of the replication, optional.
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	// The uuid of the primary volume. This field is required.
-	PrimaryVolumeId string `protobuf:"bytes,4,opt,name=primaryVolumeId,proto3" json:"primaryVolumeId,omitempty"`
-	// The uuid of the secondary volume. This field is required.
-	SecondaryVolumeId string `protobuf:"bytes,5,opt,name=secondaryVolumeId,proto3" json:"secondaryVolumeId,omitempty"`
-	// The dock infomation on which the request will be executed
-	AvailabilityZone string `protobuf:"bytes,6,opt,name=availabilityZone,proto3" json:"availabilityZone,omitempty"`
-	// The service level that volume belongs to, required.
-	// TODO: This item will be replace by profile, don't use it.
-	ProfileId string `protobuf:"bytes,7,opt,name=profileId,proto3" json:"profileId,omitempty"`
-	// The uuid of the pool on which volume will be created, required.
-	PoolId string `protobuf:"bytes,8,opt,name=poolId,proto3" json:"poolId,omitempty"`
-	// The name of the pool on which volume will be created, required.
-	PoolName string `protobuf:"bytes,9,opt,name=poolName,proto3" json:"poolName,omitempty"`
-	// The metadata of the primary replication, optional.
-	PrimaryReplicationDriverData map[string]string `protobuf:"bytes,11,rep,name=primaryReplicationDriverData,proto3" json:"primaryReplicationDriverData,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// The metadata of the seondary replication, optional.
-	SecondaryReplicationDriverData map[string]string `protobuf:"bytes,12,rep,name=secondaryReplicationDriverData,proto3" json:"secondaryReplicationDriverData,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// The dock id.
-	DockId string `protobuf:"bytes,13,opt,name=dockId,proto3" json:"dockId,omitempty"`
-	// The replication driver type.
-	DriverName string `protobuf:"bytes,14,opt,name=driverName,proto3" json:"driverName,omitempty"`
+// ListVolumesOpts is a structure which indicates the pool whose volumes or
+// volume snapshots are listed.
+type ListVolumesOpts struct {
+	// The uuid of the pool, required.
+	PoolId string `protobuf:"bytes,1,opt,name=poolId,proto3" json:"poolId,omitempty"`
+	// The name of the pool, required.
+	PoolName string `protobuf:"bytes,2,opt,name=poolName,proto3" json:"poolName,omitempty"`
+	// The storage driver type.
+	DriverName string `protobuf:"bytes,3,opt,name=driverName,proto3" json:"driverName,omitempty"`
 	// The Context
-	Context string `protobuf:"bytes,15,opt,name=context,proto3" json:"context,omitempty"`
-	// The replication metadata
-	Metadata map[string]string `protobuf:"bytes,16,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// Whether is primary replication
-	IsPrimary bool `protobuf:"varint,17,opt,name=isPrimary,proto3" json:"isPrimary,omitempty"`
-	// The Serialized profile
-	Profile              string   `protobuf:"bytes,18,opt,name=profile,proto3" json:"profile,omitempty"`
+	Context              string   `protobuf:"bytes,4,opt,name=context,proto3" json:"context,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *EnableReplicationOpts) Reset()         { *m = EnableReplicationOpts{} }
-func (m *EnableReplicationOpts) String() string { return proto.CompactTextString(m) }
-func (*EnableReplicationOpts) ProtoMessage()    {}
-func (*EnableReplicationOpts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{13}
+func (m *ListVolumesOpts) Reset()         { *m = ListVolumesOpts{} }
+func (m *ListVolumesOpts) String() string { return proto.CompactTextString(m) }
+func (*ListVolumesOpts) ProtoMessage()    {}
+func (*ListVolumesOpts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{14}
 }
 
-func (m *EnableReplicationOpts) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_EnableReplicationOpts.Unmarshal(m, b)
+func (m *ListVolumesOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListVolumesOpts.Unmarshal(m, b)
 }
-func (m *EnableReplicationOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_EnableReplicationOpts.Marshal(b, m, deterministic)
+func (m *ListVolumesOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListVolumesOpts.Marshal(b, m, deterministic)
 }
-func (m *EnableReplicationOpts) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EnableReplicationOpts.Merge(m, src)
+func (m *ListVolumesOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListVolumesOpts.Merge(m, src)
 }
-func (m *EnableReplicationOpts) XXX_Size() int {
-	return xxx_messageInfo_EnableReplicationOpts.Size(m)
+func (m *ListVolumesOpts) XXX_Size() int {
+	return xxx_messageInfo_ListVolumesOpts.Size(m)
 }
-func (m *EnableReplicationOpts) XXX_DiscardUnknown() {
-	xxx_messageInfo_EnableReplicationOpts.DiscardUnknown(m)
+func (m *ListVolumesOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListVolumesOpts.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EnableReplicationOpts proto.InternalMessageInfo
+var xxx_messageInfo_ListVolumesOpts proto.InternalMessageInfo
 
-func (m *EnableReplicationOpts) GetId() string {
+func (m *ListVolumesOpts) GetPoolId() string {
 	if m != nil {
-		return m.Id
+		return m.PoolId
 	}
 	return ""
 }
 
-func (m *EnableReplicationOpts) GetName() string {
+func (m *ListVolumesOpts) GetPoolName() string {
 	if m != nil {
-		return m.Name
+		return m.PoolName
 	}
 	return ""
 }
 
-func (m *EnableReplicationOpts) GetDescription() string {
+func (m *ListVolumesOpts) GetDriverName() string {
 	if m != nil {
-		return m.Description
+		return m.DriverName
 	}
 	return ""
 }
 
-func (m *EnableReplicationOpts) GetPrimaryVolumeId() string {
+func (m *ListVolumesOpts) GetContext() string {
 	if m != nil {
-		return m.PrimaryVolumeId
+		return m.Context
 	}
 	return ""
 }
 
-func (m *EnableReplicationOpts) GetSecondaryVolumeId() string {
-	if m != nil {
-		return m.SecondaryVolumeId
-	}
-	return ""
+// CreateVolumeBackupOpts is a structure which indicates all required
+// properties for creating a volume backup.
+type CreateVolumeBackupOpts struct {
+	// The uuid of the volume backup, required.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The name of the volume backup, optional.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The description of the volume backup, optional.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// The uuid of the volume that backup belongs to, required.
+	VolumeId string `protobuf:"bytes,4,opt,name=volumeId,proto3" json:"volumeId,omitempty"`
+	// The uuid of the snapshot that backup is taken from, optional.
+	SnapshotId string `protobuf:"bytes,5,opt,name=snapshotId,proto3" json:"snapshotId,omitempty"`
+	// The size of the volume that backup belongs to, required.
+	Size int64 `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`
+	// The metadata of the volume backup, optional.
+	Metadata map[string]string `protobuf:"bytes,7,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The metadata of the volume or snapshot to be backed up, required.
+	SourceMetadata map[string]string `protobuf:"bytes,8,rep,name=sourceMetadata,proto3" json:"sourceMetadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The protocol used to attach the volume or snapshot.
+	AccessProtocol string `protobuf:"bytes,9,opt,name=accessProtocol,proto3" json:"accessProtocol,omitempty"`
+	// The backup driver type, the one configured in dock is used if empty.
+	BackupDriver string `protobuf:"bytes,10,opt,name=backupDriver,proto3" json:"backupDriver,omitempty"`
+	// The storage driver type.
+	DriverName string `protobuf:"bytes,11,opt,name=driverName,proto3" json:"driverName,omitempty"`
+	// The Context
+	Context string `protobuf:"bytes,12,opt,name=context,proto3" json:"context,omitempty"`
+	// The uuid of the operation which tracks this request.
+	OperationId          string   `protobuf:"bytes,13,opt,name=operationId,proto3" json:"operationId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *EnableReplicationOpts) GetAvailabilityZone() string {
+func (m *CreateVolumeBackupOpts) Reset()         { *m = CreateVolumeBackupOpts{} }
+func (m *CreateVolumeBackupOpts) String() string { return proto.CompactTextString(m) }
+func (*CreateVolumeBackupOpts) ProtoMessage()    {}
+func (*CreateVolumeBackupOpts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{15}
+}
+
+func (m *CreateVolumeBackupOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateVolumeBackupOpts.Unmarshal(m, b)
+}
+func (m *CreateVolumeBackupOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateVolumeBackupOpts.Marshal(b, m, deterministic)
+}
+func (m *CreateVolumeBackupOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateVolumeBackupOpts.Merge(m, src)
+}
+func (m *CreateVolumeBackupOpts) XXX_Size() int {
+	return xxx_messageInfo_CreateVolumeBackupOpts.Size(m)
+}
+func (m *CreateVolumeBackupOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateVolumeBackupOpts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateVolumeBackupOpts proto.InternalMessageInfo
+
+func (m *CreateVolumeBackupOpts) GetId() string {
 	if m != nil {
-		return m.AvailabilityZone
+		return m.Id
 	}
 	return ""
 }
 
-func (m *EnableReplicationOpts) GetProfileId() string {
+func (m *CreateVolumeBackupOpts) GetName() string {
 	if m != nil {
-		return m.ProfileId
+		return m.Name
 	}
 	return ""
 }
 
-func (m *EnableReplicationOpts) GetPoolId() string {
+func (m *CreateVolumeBackupOpts) GetDescription() string {
 	if m != nil {
-		return m.PoolId
+		return m.Description
 	}
 	return ""
 }
 
-func (m *EnableReplicationOpts) GetPoolName() string {
+func (m *CreateVolumeBackupOpts) GetVolumeId() string {
 	if m != nil {
-		return m.PoolName
+		return m.VolumeId
 	}
 	return ""
 }
 
-func (m *EnableReplicationOpts) GetPrimaryReplicationDriverData() map[string]string {
+func (m *CreateVolumeBackupOpts) GetSnapshotId() string {
 	if m != nil {
-		return m.PrimaryReplicationDriverData
+		return m.SnapshotId
 	}
-	return nil
+	return ""
 }
 
-func (m *EnableReplicationOpts) GetSecondaryReplicationDriverData() map[string]string {
+func (m *CreateVolumeBackupOpts) GetSize() int64 {
 	if m != nil {
-		return m.SecondaryReplicationDriverData
+		return m.Size
+	}
+	return 0
+}
+
+func (m *CreateVolumeBackupOpts) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
 	}
 	return nil
 }
 
-func (m *EnableReplicationOpts) GetDockId() string {
+func (m *CreateVolumeBackupOpts) GetSourceMetadata() map[string]string {
 	if m != nil {
-		return m.DockId
+		return m.SourceMetadata
 	}
-	return ""
+	return nil
 }
 
-func (m *EnableReplicationOpts) GetDriverName() string {
+func (m *CreateVolumeBackupOpts) GetAccessProtocol() string {
 	if m != nil {
-		return m.DriverName
+		return m.AccessProtocol
 	}
 	return ""
 }
 
-func (m *EnableReplicationOpts) GetContext() string {
+func (m *CreateVolumeBackupOpts) GetBackupDriver() string {
 	if m != nil {
-		return m.Context
+		return m.BackupDriver
 	}
 	return ""
 }
 
-func (m *EnableReplicationOpts) GetMetadata() map[string]string {
+func (m *CreateVolumeBackupOpts) GetDriverName() string {
 	if m != nil {
-		return m.Metadata
+		return m.DriverName
 	}
-	return nil
+	return ""
 }
 
-func (m *EnableReplicationOpts) GetIsPrimary() bool {
+func (m *CreateVolumeBackupOpts) GetContext() string {
 	if m != nil {
-		return m.IsPrimary
+		return m.Context
 	}
-	return false
+	return ""
 }
 
-func (m *EnableReplicationOpts) GetProfile() string {
+func (m *CreateVolumeBackupOpts) GetOperationId() string {
 	if m != nil {
-		return m.Profile
+		return m.OperationId
 	}
 	return ""
 }
 
-// Delete ReplicationOpts is a structure which indicates all required properties
-type DisableReplicationOpts struct {
-	// The uuid of the replication, optional when creating.
+// DeleteVolumeBackupOpts is a structure which indicates all required
+// properties for deleting a volume backup.
+type DeleteVolumeBackupOpts struct {
+	// The uuid of the volume backup, required.
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// The name of the replication, optional.
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// The description of the replication, optional.
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	// The uuid of the primary volume. This field is required.
-	PrimaryVolumeId string `protobuf:"bytes,4,opt,name=primaryVolumeId,proto3" json:"primaryVolumeId,omitempty"`
-	// The uuid of the secondary volume. This field is required.
-	SecondaryVolumeId string `protobuf:"bytes,5,opt,name=secondaryVolumeId,proto3" json:"secondaryVolumeId,omitempty"`
-	// The dock infomation on which the request will be executed
-	AvailabilityZone string `protobuf:"bytes,6,opt,name=availabilityZone,proto3" json:"availabilityZone,omitempty"`
-	// The service level that volume belongs to, required.
-	// TODO: This item will be replace by profile, don't use it.
-	ProfileId string `protobuf:"bytes,7,opt,name=profileId,proto3" json:"profileId,omitempty"`
-	// The uuid of the pool on which volume will be created, required.
-	PoolId string `protobuf:"bytes,8,opt,name=poolId,proto3" json:"poolId,omitempty"`
-	// The name of the pool on which volume will be created, required.
-	PoolName string `protobuf:"bytes,9,opt,name=poolName,proto3" json:"poolName,omitempty"`
-	// The metadata of the primary replication, optional.
-	PrimaryReplicationDriverData map[string]string `protobuf:"bytes,11,rep,name=primaryReplicationDriverData,proto3" json:"primaryReplicationDriverData,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// The metadata of the seondary replication, optional.
-	SecondaryReplicationDriverData map[string]string `protobuf:"bytes,12,rep,name=secondaryReplicationDriverData,proto3" json:"secondaryReplicationDriverData,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// The dock id.
-	DockId string `protobuf:"bytes,13,opt,name=dockId,proto3" json:"dockId,omitempty"`
-	// The replication driver type.
-	DriverName string `protobuf:"bytes,14,opt,name=driverName,proto3" json:"driverName,omitempty"`
+	// The metadata of the volume backup, optional.
+	Metadata map[string]string `protobuf:"bytes,2,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The backup driver type which stores the backup.
+	BackupDriver string `protobuf:"bytes,3,opt,name=backupDriver,proto3" json:"backupDriver,omitempty"`
+	// The storage driver type.
+	DriverName string `protobuf:"bytes,4,opt,name=driverName,proto3" json:"driverName,omitempty"`
 	// The Context
-	Context string `protobuf:"bytes,15,opt,name=context,proto3" json:"context,omitempty"`
-	// The replication metadata
-	Metadata map[string]string `protobuf:"bytes,16,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// Whether is primary replication
-	IsPrimary bool `protobuf:"varint,17,opt,name=isPrimary,proto3" json:"isPrimary,omitempty"`
-	// The Serialized profile
-	Profile              string   `protobuf:"bytes,18,opt,name=profile,proto3" json:"profile,omitempty"`
+	Context string `protobuf:"bytes,5,opt,name=context,proto3" json:"context,omitempty"`
+	// The uuid of the operation which tracks this request.
+	OperationId          string   `protobuf:"bytes,6,opt,name=operationId,proto3" json:"operationId,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DisableReplicationOpts) Reset()         { *m = DisableReplicationOpts{} }
-func (m *DisableReplicationOpts) String() string { return proto.CompactTextString(m) }
-func (*DisableReplicationOpts) ProtoMessage()    {}
-func (*DisableReplicationOpts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{14}
+func (m *DeleteVolumeBackupOpts) Reset()         { *m = DeleteVolumeBackupOpts{} }
+func (m *DeleteVolumeBackupOpts) String() string { return proto.CompactTextString(m) }
+func (*DeleteVolumeBackupOpts) ProtoMessage()    {}
+func (*DeleteVolumeBackupOpts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{16}
 }
 
-func (m *DisableReplicationOpts) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DisableReplicationOpts.Unmarshal(m, b)
+func (m *DeleteVolumeBackupOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteVolumeBackupOpts.Unmarshal(m, b)
 }
-func (m *DisableReplicationOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DisableReplicationOpts.Marshal(b, m, deterministic)
+func (m *DeleteVolumeBackupOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteVolumeBackupOpts.Marshal(b, m, deterministic)
 }
-func (m *DisableReplicationOpts) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DisableReplicationOpts.Merge(m, src)
+func (m *DeleteVolumeBackupOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteVolumeBackupOpts.Merge(m, src)
 }
-func (m *DisableReplicationOpts) XXX_Size() int {
-	return xxx_messageInfo_DisableReplicationOpts.Size(m)
+func (m *DeleteVolumeBackupOpts) XXX_Size() int {
+	return xxx_messageInfo_DeleteVolumeBackupOpts.Size(m)
 }
-func (m *DisableReplicationOpts) XXX_DiscardUnknown() {
-	xxx_messageInfo_DisableReplicationOpts.DiscardUnknown(m)
+func (m *DeleteVolumeBackupOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteVolumeBackupOpts.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DisableReplicationOpts proto.InternalMessageInfo
+var xxx_messageInfo_DeleteVolumeBackupOpts proto.InternalMessageInfo
 
-func (m *DisableReplicationOpts) GetId() string {
+func (m *DeleteVolumeBackupOpts) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-func (m *DisableReplicationOpts) GetName() string {
+func (m *DeleteVolumeBackupOpts) GetMetadata() map[string]string {
 	if m != nil {
-		return m.Name
+		return m.Metadata
 	}
-	return ""
+	return nil
 }
 
-func (m *DisableReplicationOpts) GetDescription() string {
+func (m *DeleteVolumeBackupOpts) GetBackupDriver() string {
 	if m != nil {
-		return m.Description
+		return m.BackupDriver
 	}
 	return ""
 }
 
-func (m *DisableReplicationOpts) GetPrimaryVolumeId() string {
+func (m *DeleteVolumeBackupOpts) GetDriverName() string {
 	if m != nil {
-		return m.PrimaryVolumeId
+		return m.DriverName
 	}
 	return ""
 }
 
-func (m *DisableReplicationOpts) GetSecondaryVolumeId() string {
+func (m *DeleteVolumeBackupOpts) GetContext() string {
 	if m != nil {
-		return m.SecondaryVolumeId
+		return m.Context
 	}
 	return ""
 }
 
-func (m *DisableReplicationOpts) GetAvailabilityZone() string {
+func (m *DeleteVolumeBackupOpts) GetOperationId() string {
 	if m != nil {
-		return m.AvailabilityZone
+		return m.OperationId
 	}
 	return ""
 }
 
-func (m *DisableReplicationOpts) GetProfileId() string {
+// RestoreVolumeBackupOpts is a structure which indicates all required
+// properties for restoring a volume backup.
+type RestoreVolumeBackupOpts struct {
+	// The uuid of the volume backup, required.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The uuid of the volume which the backup is restored into, required.
+	VolumeId string `protobuf:"bytes,2,opt,name=volumeId,proto3" json:"volumeId,omitempty"`
+	// The size of the volume backup.
+	Size int64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// The metadata of the volume backup, optional.
+	Metadata map[string]string `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The metadata of the volume which the backup is restored into.
+	VolumeMetadata map[string]string `protobuf:"bytes,5,rep,name=volumeMetadata,proto3" json:"volumeMetadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The protocol used to attach the volume.
+	AccessProtocol string `protobuf:"bytes,6,opt,name=accessProtocol,proto3" json:"accessProtocol,omitempty"`
+	// The backup driver type which stores the backup.
+	BackupDriver string `protobuf:"bytes,7,opt,name=backupDriver,proto3" json:"backupDriver,omitempty"`
+	// The storage driver type.
+	DriverName string `protobuf:"bytes,8,opt,name=driverName,proto3" json:"driverName,omitempty"`
+	// The Context
+	Context string `protobuf:"bytes,9,opt,name=context,proto3" json:"context,omitempty"`
+	// The uuid of the operation which tracks this request.
+	OperationId string `protobuf:"bytes,10,opt,name=operationId,proto3" json:"operationId,omitempty"`
+	// The options of the new volume which the backup is restored into, if
+	// it is specified, the volume will be created before restoring.
+	NewVolume            *CreateVolumeOpts `protobuf:"bytes,11,opt,name=newVolume,proto3" json:"newVolume,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *RestoreVolumeBackupOpts) Reset()         { *m = RestoreVolumeBackupOpts{} }
+func (m *RestoreVolumeBackupOpts) String() string { return proto.CompactTextString(m) }
+func (*RestoreVolumeBackupOpts) ProtoMessage()    {}
+func (*RestoreVolumeBackupOpts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{17}
+}
+
+func (m *RestoreVolumeBackupOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreVolumeBackupOpts.Unmarshal(m, b)
+}
+func (m *RestoreVolumeBackupOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreVolumeBackupOpts.Marshal(b, m, deterministic)
+}
+func (m *RestoreVolumeBackupOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreVolumeBackupOpts.Merge(m, src)
+}
+func (m *RestoreVolumeBackupOpts) XXX_Size() int {
+	return xxx_messageInfo_RestoreVolumeBackupOpts.Size(m)
+}
+func (m *RestoreVolumeBackupOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreVolumeBackupOpts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreVolumeBackupOpts proto.InternalMessageInfo
+
+func (m *RestoreVolumeBackupOpts) GetId() string {
 	if m != nil {
-		return m.ProfileId
+		return m.Id
 	}
 	return ""
 }
 
-func (m *DisableReplicationOpts) GetPoolId() string {
+func (m *RestoreVolumeBackupOpts) GetVolumeId() string {
 	if m != nil {
-		return m.PoolId
+		return m.VolumeId
 	}
 	return ""
 }
 
-func (m *DisableReplicationOpts) GetPoolName() string {
+func (m *RestoreVolumeBackupOpts) GetSize() int64 {
 	if m != nil {
-		return m.PoolName
+		return m.Size
 	}
-	return ""
+	return 0
 }
 
-func (m *DisableReplicationOpts) GetPrimaryReplicationDriverData() map[string]string {
+func (m *RestoreVolumeBackupOpts) GetMetadata() map[string]string {
 	if m != nil {
-		return m.PrimaryReplicationDriverData
+		return m.Metadata
 	}
 	return nil
 }
 
-func (m *DisableReplicationOpts) GetSecondaryReplicationDriverData() map[string]string {
+func (m *RestoreVolumeBackupOpts) GetVolumeMetadata() map[string]string {
 	if m != nil {
-		return m.SecondaryReplicationDriverData
+		return m.VolumeMetadata
 	}
 	return nil
 }
 
-func (m *DisableReplicationOpts) GetDockId() string {
+func (m *RestoreVolumeBackupOpts) GetAccessProtocol() string {
 	if m != nil {
-		return m.DockId
+		return m.AccessProtocol
 	}
 	return ""
 }
 
-func (m *DisableReplicationOpts) GetDriverName() string {
+func (m *RestoreVolumeBackupOpts) GetBackupDriver() string {
 	if m != nil {
-		return m.DriverName
+		return m.BackupDriver
 	}
 	return ""
 }
 
-func (m *DisableReplicationOpts) GetContext() string {
+func (m *RestoreVolumeBackupOpts) GetDriverName() string {
 	if m != nil {
-		return m.Context
+		return m.DriverName
 	}
 	return ""
 }
 
-func (m *DisableReplicationOpts) GetMetadata() map[string]string {
+func (m *RestoreVolumeBackupOpts) GetContext() string {
 	if m != nil {
-		return m.Metadata
+		return m.Context
 	}
-	return nil
+	return ""
 }
 
-func (m *DisableReplicationOpts) GetIsPrimary() bool {
+func (m *RestoreVolumeBackupOpts) GetOperationId() string {
 	if m != nil {
-		return m.IsPrimary
+		return m.OperationId
 	}
-	return false
+	return ""
 }
 
-func (m *DisableReplicationOpts) GetProfile() string {
+func (m *RestoreVolumeBackupOpts) GetNewVolume() *CreateVolumeOpts {
 	if m != nil {
-		return m.Profile
+		return m.NewVolume
 	}
-	return ""
+	return nil
 }
 
-// Delete ReplicationOpts is a structure which indicates all required properties
-type FailoverReplicationOpts struct {
-	// The uuid of the replication, optional when creating.
+// CreateVolumeAttachmentOpts is a structure which indicates all required
+// properties for creating a volume attachment.
+type CreateVolumeAttachmentOpts struct {
+	// The uuid of the volume attachment, optional.
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// The name of the replication, optional.
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// The description of the replication, optional.
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	// The uuid of the primary volume. This field is required.
-	PrimaryVolumeId string `protobuf:"bytes,4,opt,name=primaryVolumeId,proto3" json:"primaryVolumeId,omitempty"`
-	// The uuid of the secondary volume. This field is required.
-	SecondaryVolumeId string `protobuf:"bytes,5,opt,name=secondaryVolumeId,proto3" json:"secondaryVolumeId,omitempty"`
-	// The dock infomation on which the request will be executed
-	AvailabilityZone string `protobuf:"bytes,6,opt,name=availabilityZone,proto3" json:"availabilityZone,omitempty"`
-	// The service level that volume belongs to, required.
-	// TODO: This item will be replace by profile, don't use it.
-	ProfileId string `protobuf:"bytes,7,opt,name=profileId,proto3" json:"profileId,omitempty"`
-	// The uuid of the pool on which volume will be created, required.
-	PoolId string `protobuf:"bytes,8,opt,name=poolId,proto3" json:"poolId,omitempty"`
-	// The name of the pool on which volume will be created, required.
-	PoolName string `protobuf:"bytes,9,opt,name=poolName,proto3" json:"poolName,omitempty"`
-	// The metadata of the primary replication, optional.
-	PrimaryReplicationDriverData map[string]string `protobuf:"bytes,11,rep,name=primaryReplicationDriverData,proto3" json:"primaryReplicationDriverData,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// The metadata of the seondary replication, optional.
-	SecondaryReplicationDriverData map[string]string `protobuf:"bytes,12,rep,name=secondaryReplicationDriverData,proto3" json:"secondaryReplicationDriverData,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// The dock id.
-	DockId string `protobuf:"bytes,13,opt,name=dockId,proto3" json:"dockId,omitempty"`
-	// The replication driver type.
-	DriverName string `protobuf:"bytes,14,opt,name=driverName,proto3" json:"driverName,omitempty"`
+	// The uuid of the volume, required.
+	VolumeId string `protobuf:"bytes,2,opt,name=volumeId,proto3" json:"volumeId,omitempty"`
+	// This field indicates if the volume is attached locally, optional.
+	DoLocalAttach bool `protobuf:"varint,3,opt,name=doLocalAttach,proto3" json:"doLocalAttach,omitempty"`
+	// This field indicates if the volume is attached multiple times, optional.
+	MultiPath bool `protobuf:"varint,4,opt,name=multiPath,proto3" json:"multiPath,omitempty"`
+	// The infomation of the host node on which the volume will be attached.
+	HostInfo *HostInfo `protobuf:"bytes,5,opt,name=hostInfo,proto3" json:"hostInfo,omitempty"`
+	// The metadata of the volume attachment, optional.
+	Metadata map[string]string `protobuf:"bytes,6,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The storage driver type.
+	DriverName string `protobuf:"bytes,7,opt,name=driverName,proto3" json:"driverName,omitempty"`
 	// The Context
-	Context string `protobuf:"bytes,15,opt,name=context,proto3" json:"context,omitempty"`
-	// Allow attached volume
-	AllowAttachedVolume bool `protobuf:"varint,16,opt,name=allowAttachedVolume,proto3" json:"allowAttachedVolume,omitempty"`
-	// The secondary backend id.
-	SecondaryBackendId string `protobuf:"bytes,17,opt,name=secondaryBackendId,proto3" json:"secondaryBackendId,omitempty"`
-	// The replication metadata
-	Metadata map[string]string `protobuf:"bytes,18,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// Whether is primary replication
-	IsPrimary bool `protobuf:"varint,19,opt,name=isPrimary,proto3" json:"isPrimary,omitempty"`
-	// The Serialized profile
-	Profile              string   `protobuf:"bytes,20,opt,name=profile,proto3" json:"profile,omitempty"`
+	Context string `protobuf:"bytes,8,opt,name=context,proto3" json:"context,omitempty"`
+	// The protocol
+	AccessProtocol string `protobuf:"bytes,9,opt,name=AccessProtocol,proto3" json:"AccessProtocol,omitempty"`
+	// The uuid of the operation which tracks this request.
+	OperationId          string   `protobuf:"bytes,10,opt,name=operationId,proto3" json:"operationId,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *FailoverReplicationOpts) Reset()         { *m = FailoverReplicationOpts{} }
-func (m *FailoverReplicationOpts) String() string { return proto.CompactTextString(m) }
-func (*FailoverReplicationOpts) ProtoMessage()    {}
-func (*FailoverReplicationOpts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{15}
+func (m *CreateVolumeAttachmentOpts) Reset()         { *m = CreateVolumeAttachmentOpts{} }
+func (m *CreateVolumeAttachmentOpts) String() string { return proto.CompactTextString(m) }
+func (*CreateVolumeAttachmentOpts) ProtoMessage()    {}
+func (*CreateVolumeAttachmentOpts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{18}
 }
 
-func (m *FailoverReplicationOpts) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FailoverReplicationOpts.Unmarshal(m, b)
+func (m *CreateVolumeAttachmentOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateVolumeAttachmentOpts.Unmarshal(m, b)
 }
-func (m *FailoverReplicationOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FailoverReplicationOpts.Marshal(b, m, deterministic)
+func (m *CreateVolumeAttachmentOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateVolumeAttachmentOpts.Marshal(b, m, deterministic)
 }
-func (m *FailoverReplicationOpts) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FailoverReplicationOpts.Merge(m, src)
+func (m *CreateVolumeAttachmentOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateVolumeAttachmentOpts.Merge(m, src)
 }
-func (m *FailoverReplicationOpts) XXX_Size() int {
-	return xxx_messageInfo_FailoverReplicationOpts.Size(m)
+func (m *CreateVolumeAttachmentOpts) XXX_Size() int {
+	return xxx_messageInfo_CreateVolumeAttachmentOpts.Size(m)
 }
-func (m *FailoverReplicationOpts) XXX_DiscardUnknown() {
-	xxx_messageInfo_FailoverReplicationOpts.DiscardUnknown(m)
+func (m *CreateVolumeAttachmentOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateVolumeAttachmentOpts.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FailoverReplicationOpts proto.InternalMessageInfo
+var xxx_messageInfo_CreateVolumeAttachmentOpts proto.InternalMessageInfo
 
-func (m *FailoverReplicationOpts) GetId() string {
+func (m *CreateVolumeAttachmentOpts) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-func (m *FailoverReplicationOpts) GetName() string {
+func (m *CreateVolumeAttachmentOpts) GetVolumeId() string {
 	if m != nil {
-		return m.Name
+		return m.VolumeId
 	}
 	return ""
 }
 
-func (m *FailoverReplicationOpts) GetDescription() string {
+func (m *CreateVolumeAttachmentOpts) GetDoLocalAttach() bool {
 	if m != nil {
-		return m.Description
+		return m.DoLocalAttach
 	}
-	return ""
+	return false
 }
 
-func (m *FailoverReplicationOpts) GetPrimaryVolumeId() string {
+func (m *CreateVolumeAttachmentOpts) GetMultiPath() bool {
 	if m != nil {
-		return m.PrimaryVolumeId
+		return m.MultiPath
 	}
-	return ""
+	return false
 }
 
-func (m *FailoverReplicationOpts) GetSecondaryVolumeId() string {
+func (m *CreateVolumeAttachmentOpts) GetHostInfo() *HostInfo {
 	if m != nil {
-		return m.SecondaryVolumeId
+		return m.HostInfo
 	}
-	return ""
+	return nil
 }
 
-func (m *FailoverReplicationOpts) GetAvailabilityZone() string {
+func (m *CreateVolumeAttachmentOpts) GetMetadata() map[string]string {
 	if m != nil {
-		return m.AvailabilityZone
+		return m.Metadata
 	}
-	return ""
+	return nil
 }
 
-func (m *FailoverReplicationOpts) GetProfileId() string {
+func (m *CreateVolumeAttachmentOpts) GetDriverName() string {
 	if m != nil {
-		return m.ProfileId
+		return m.DriverName
 	}
 	return ""
 }
 
-func (m *FailoverReplicationOpts) GetPoolId() string {
+func (m *CreateVolumeAttachmentOpts) GetContext() string {
 	if m != nil {
-		return m.PoolId
+		return m.Context
 	}
 	return ""
 }
 
-func (m *FailoverReplicationOpts) GetPoolName() string {
+func (m *CreateVolumeAttachmentOpts) GetAccessProtocol() string {
 	if m != nil {
-		return m.PoolName
+		return m.AccessProtocol
 	}
 	return ""
 }
 
-func (m *FailoverReplicationOpts) GetPrimaryReplicationDriverData() map[string]string {
+func (m *CreateVolumeAttachmentOpts) GetOperationId() string {
 	if m != nil {
-		return m.PrimaryReplicationDriverData
+		return m.OperationId
 	}
-	return nil
+	return ""
 }
 
-func (m *FailoverReplicationOpts) GetSecondaryReplicationDriverData() map[string]string {
-	if m != nil {
-		return m.SecondaryReplicationDriverData
-	}
-	return nil
+// DeleteVolumeAttachmentOpts is a structure which indicates all required
+// properties for deleting a volume attachment.
+type DeleteVolumeAttachmentOpts struct {
+	// The uuid of the volume attachment, optional.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The uuid of the volume, required.
+	VolumeId string `protobuf:"bytes,2,opt,name=volumeId,proto3" json:"volumeId,omitempty"`
+	// The infomation of the host node on which the volume will be attached.
+	HostInfo *HostInfo `protobuf:"bytes,3,opt,name=hostInfo,proto3" json:"hostInfo,omitempty"`
+	// The metadata of the volume attachment, optional.
+	Metadata map[string]string `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The storage driver type.
+	DriverName string `protobuf:"bytes,5,opt,name=driverName,proto3" json:"driverName,omitempty"`
+	// The Context
+	Context string `protobuf:"bytes,6,opt,name=context,proto3" json:"context,omitempty"`
+	// The protocol
+	AccessProtocol string `protobuf:"bytes,7,opt,name=AccessProtocol,proto3" json:"AccessProtocol,omitempty"`
+	// The uuid of the operation which tracks this request.
+	OperationId          string   `protobuf:"bytes,8,opt,name=operationId,proto3" json:"operationId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *FailoverReplicationOpts) GetDockId() string {
+func (m *DeleteVolumeAttachmentOpts) Reset()         { *m = DeleteVolumeAttachmentOpts{} }
+func (m *DeleteVolumeAttachmentOpts) String() string { return proto.CompactTextString(m) }
+func (*DeleteVolumeAttachmentOpts) ProtoMessage()    {}
+func (*DeleteVolumeAttachmentOpts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{19}
+}
+
+func (m *DeleteVolumeAttachmentOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteVolumeAttachmentOpts.Unmarshal(m, b)
+}
+func (m *DeleteVolumeAttachmentOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteVolumeAttachmentOpts.Marshal(b, m, deterministic)
+}
+func (m *DeleteVolumeAttachmentOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteVolumeAttachmentOpts.Merge(m, src)
+}
+func (m *DeleteVolumeAttachmentOpts) XXX_Size() int {
+	return xxx_messageInfo_DeleteVolumeAttachmentOpts.Size(m)
+}
+func (m *DeleteVolumeAttachmentOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteVolumeAttachmentOpts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteVolumeAttachmentOpts proto.InternalMessageInfo
+
+func (m *DeleteVolumeAttachmentOpts) GetId() string {
 	if m != nil {
-		return m.DockId
+		return m.Id
 	}
 	return ""
 }
 
-func (m *FailoverReplicationOpts) GetDriverName() string {
+func (m *DeleteVolumeAttachmentOpts) GetVolumeId() string {
 	if m != nil {
-		return m.DriverName
+		return m.VolumeId
 	}
 	return ""
 }
 
-func (m *FailoverReplicationOpts) GetContext() string {
+func (m *DeleteVolumeAttachmentOpts) GetHostInfo() *HostInfo {
 	if m != nil {
-		return m.Context
+		return m.HostInfo
 	}
-	return ""
+	return nil
 }
 
-func (m *FailoverReplicationOpts) GetAllowAttachedVolume() bool {
+func (m *DeleteVolumeAttachmentOpts) GetMetadata() map[string]string {
 	if m != nil {
-		return m.AllowAttachedVolume
+		return m.Metadata
 	}
-	return false
+	return nil
 }
 
-func (m *FailoverReplicationOpts) GetSecondaryBackendId() string {
+func (m *DeleteVolumeAttachmentOpts) GetDriverName() string {
 	if m != nil {
-		return m.SecondaryBackendId
+		return m.DriverName
 	}
 	return ""
 }
 
-func (m *FailoverReplicationOpts) GetMetadata() map[string]string {
+func (m *DeleteVolumeAttachmentOpts) GetContext() string {
 	if m != nil {
-		return m.Metadata
+		return m.Context
 	}
-	return nil
+	return ""
 }
 
-func (m *FailoverReplicationOpts) GetIsPrimary() bool {
+func (m *DeleteVolumeAttachmentOpts) GetAccessProtocol() string {
 	if m != nil {
-		return m.IsPrimary
+		return m.AccessProtocol
 	}
-	return false
+	return ""
 }
 
-func (m *FailoverReplicationOpts) GetProfile() string {
+func (m *DeleteVolumeAttachmentOpts) GetOperationId() string {
 	if m != nil {
-		return m.Profile
+		return m.OperationId
 	}
 	return ""
 }
 
-type FailoverReplicationOpts_FailoverRequest struct {
-	AllowAttachedVolume  bool     `protobuf:"varint,1,opt,name=allowAttachedVolume,proto3" json:"allowAttachedVolume,omitempty"`
-	SecondaryBackendId   string   `protobuf:"bytes,2,opt,name=secondaryBackendId,proto3" json:"secondaryBackendId,omitempty"`
+// CreateSnapshotAttachmentOpts is a structure which indicates all required
+// properties for creating a snapshot attachment.
+type CreateSnapshotAttachmentOpts struct {
+	// The uuid of the snapshot attachment, optional.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The uuid of the snapshot, required.
+	SnapshotId string `protobuf:"bytes,2,opt,name=snapshotId,proto3" json:"snapshotId,omitempty"`
+	// This field indicates if the snapshot is attached locally, optional.
+	DoLocalAttach bool `protobuf:"varint,3,opt,name=doLocalAttach,proto3" json:"doLocalAttach,omitempty"`
+	// This field indicates if the snapshot is attached multiple times, optional.
+	MultiPath bool `protobuf:"varint,4,opt,name=multiPath,proto3" json:"multiPath,omitempty"`
+	// The infomation of the host node on which the snapshot will be attached.
+	HostInfo *HostInfo `protobuf:"bytes,5,opt,name=hostInfo,proto3" json:"hostInfo,omitempty"`
+	// The metadata of the snapshot attachment, optional.
+	Metadata map[string]string `protobuf:"bytes,6,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The storage driver type.
+	DriverName string `protobuf:"bytes,7,opt,name=driverName,proto3" json:"driverName,omitempty"`
+	// The Context
+	Context string `protobuf:"bytes,8,opt,name=context,proto3" json:"context,omitempty"`
+	// The protocol
+	AccessProtocol       string   `protobuf:"bytes,9,opt,name=AccessProtocol,proto3" json:"AccessProtocol,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *FailoverReplicationOpts_FailoverRequest) Reset() {
-	*m = FailoverReplicationOpts_FailoverRequest{}
-}
-func (m *FailoverReplicationOpts_FailoverRequest) String() string { return proto.CompactTextString(m) }
-func (*FailoverReplicationOpts_FailoverRequest) ProtoMessage()    {}
-func (*FailoverReplicationOpts_FailoverRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{15, 3}
+func (m *CreateSnapshotAttachmentOpts) Reset()         { *m = CreateSnapshotAttachmentOpts{} }
+func (m *CreateSnapshotAttachmentOpts) String() string { return proto.CompactTextString(m) }
+func (*CreateSnapshotAttachmentOpts) ProtoMessage()    {}
+func (*CreateSnapshotAttachmentOpts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{20}
 }
 
-func (m *FailoverReplicationOpts_FailoverRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FailoverReplicationOpts_FailoverRequest.Unmarshal(m, b)
+func (m *CreateSnapshotAttachmentOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateSnapshotAttachmentOpts.Unmarshal(m, b)
 }
-func (m *FailoverReplicationOpts_FailoverRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FailoverReplicationOpts_FailoverRequest.Marshal(b, m, deterministic)
+func (m *CreateSnapshotAttachmentOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateSnapshotAttachmentOpts.Marshal(b, m, deterministic)
 }
-func (m *FailoverReplicationOpts_FailoverRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FailoverReplicationOpts_FailoverRequest.Merge(m, src)
+func (m *CreateSnapshotAttachmentOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateSnapshotAttachmentOpts.Merge(m, src)
 }
-func (m *FailoverReplicationOpts_FailoverRequest) XXX_Size() int {
-	return xxx_messageInfo_FailoverReplicationOpts_FailoverRequest.Size(m)
+func (m *CreateSnapshotAttachmentOpts) XXX_Size() int {
+	return xxx_messageInfo_CreateSnapshotAttachmentOpts.Size(m)
 }
-func (m *FailoverReplicationOpts_FailoverRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_FailoverReplicationOpts_FailoverRequest.DiscardUnknown(m)
+func (m *CreateSnapshotAttachmentOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateSnapshotAttachmentOpts.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FailoverReplicationOpts_FailoverRequest proto.InternalMessageInfo
+var xxx_messageInfo_CreateSnapshotAttachmentOpts proto.InternalMessageInfo
 
-func (m *FailoverReplicationOpts_FailoverRequest) GetAllowAttachedVolume() bool {
+func (m *CreateSnapshotAttachmentOpts) GetId() string {
 	if m != nil {
-		return m.AllowAttachedVolume
+		return m.Id
 	}
-	return false
+	return ""
 }
 
-func (m *FailoverReplicationOpts_FailoverRequest) GetSecondaryBackendId() string {
+func (m *CreateSnapshotAttachmentOpts) GetSnapshotId() string {
 	if m != nil {
-		return m.SecondaryBackendId
+		return m.SnapshotId
 	}
 	return ""
 }
 
-// CreateVolumeGroupOpts is a structure which indicates all required
-// properties for creating a volume group.
-type CreateVolumeGroupOpts struct {
-	// The uuid of the volume group, optional when creating.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// The name of the volume, required.
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// The driver of the volume group.
-	DriverName string `protobuf:"bytes,3,opt,name=driverName,proto3" json:"driverName,omitempty"`
-	// The description of the volume, optional.
-	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	// When create volume from snapshot, this field is required.
-	AvailabilityZone string `protobuf:"bytes,5,opt,name=availabilityZone,proto3" json:"availabilityZone,omitempty"`
-	// The addVolumes contain UUIDs of volumes to be added to the group.
-	AddVolumes []string `protobuf:"bytes,6,rep,name=addVolumes,proto3" json:"addVolumes,omitempty"`
-	// The removeVolumes contains the volumes to be removed from the group.
-	RemoveVolumes []string `protobuf:"bytes,7,rep,name=removeVolumes,proto3" json:"removeVolumes,omitempty"`
-	// The pool belongs to the group.
-	PoolId string `protobuf:"bytes,8,opt,name=poolId,proto3" json:"poolId,omitempty"`
-	// The Context
-	Context              string   `protobuf:"bytes,9,opt,name=context,proto3" json:"context,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *CreateVolumeGroupOpts) Reset()         { *m = CreateVolumeGroupOpts{} }
-func (m *CreateVolumeGroupOpts) String() string { return proto.CompactTextString(m) }
-func (*CreateVolumeGroupOpts) ProtoMessage()    {}
-func (*CreateVolumeGroupOpts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{16}
-}
-
-func (m *CreateVolumeGroupOpts) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateVolumeGroupOpts.Unmarshal(m, b)
-}
-func (m *CreateVolumeGroupOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateVolumeGroupOpts.Marshal(b, m, deterministic)
-}
-func (m *CreateVolumeGroupOpts) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateVolumeGroupOpts.Merge(m, src)
-}
-func (m *CreateVolumeGroupOpts) XXX_Size() int {
-	return xxx_messageInfo_CreateVolumeGroupOpts.Size(m)
-}
-func (m *CreateVolumeGroupOpts) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateVolumeGroupOpts.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_CreateVolumeGroupOpts proto.InternalMessageInfo
-
-func (m *CreateVolumeGroupOpts) GetId() string {
-	if m != nil {
-		return m.Id
-	}
-	return ""
-}
-
-func (m *CreateVolumeGroupOpts) GetName() string {
-	if m != nil {
-		return m.Name
-	}
-	return ""
-}
-
-func (m *CreateVolumeGroupOpts) GetDriverName() string {
+func (m *CreateSnapshotAttachmentOpts) GetDoLocalAttach() bool {
 	if m != nil {
-		return m.DriverName
+		return m.DoLocalAttach
 	}
-	return ""
+	return false
 }
 
-func (m *CreateVolumeGroupOpts) GetDescription() string {
+func (m *CreateSnapshotAttachmentOpts) GetMultiPath() bool {
 	if m != nil {
-		return m.Description
+		return m.MultiPath
 	}
-	return ""
+	return false
 }
 
-func (m *CreateVolumeGroupOpts) GetAvailabilityZone() string {
+func (m *CreateSnapshotAttachmentOpts) GetHostInfo() *HostInfo {
 	if m != nil {
-		return m.AvailabilityZone
+		return m.HostInfo
 	}
-	return ""
+	return nil
 }
 
-func (m *CreateVolumeGroupOpts) GetAddVolumes() []string {
+func (m *CreateSnapshotAttachmentOpts) GetMetadata() map[string]string {
 	if m != nil {
-		return m.AddVolumes
+		return m.Metadata
 	}
 	return nil
 }
 
-func (m *CreateVolumeGroupOpts) GetRemoveVolumes() []string {
+func (m *CreateSnapshotAttachmentOpts) GetDriverName() string {
 	if m != nil {
-		return m.RemoveVolumes
+		return m.DriverName
 	}
-	return nil
+	return ""
 }
 
-func (m *CreateVolumeGroupOpts) GetPoolId() string {
+func (m *CreateSnapshotAttachmentOpts) GetContext() string {
 	if m != nil {
-		return m.PoolId
+		return m.Context
 	}
 	return ""
 }
 
-func (m *CreateVolumeGroupOpts) GetContext() string {
+func (m *CreateSnapshotAttachmentOpts) GetAccessProtocol() string {
 	if m != nil {
-		return m.Context
+		return m.AccessProtocol
 	}
 	return ""
 }
 
-type UpdateVolumeGroupOpts struct {
-	// The uuid of the volume group, optional when updating.
+// DeleteSnapshotAttachmentOpts is a structure which indicates all required
+// properties for deleting a snapshot attachment.
+type DeleteSnapshotAttachmentOpts struct {
+	// The uuid of the snapshot attachment, optional.
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// The driver of the volume group.
-	DriverName string `protobuf:"bytes,2,opt,name=driverName,proto3" json:"driverName,omitempty"`
-	// The addVolumes contain UUIDs of volumes to be added to the group.
-	AddVolumes []string `protobuf:"bytes,3,rep,name=addVolumes,proto3" json:"addVolumes,omitempty"`
-	// The removeVolumes contains the volumes to be removed from the group.
-	RemoveVolumes []string `protobuf:"bytes,4,rep,name=removeVolumes,proto3" json:"removeVolumes,omitempty"`
-	// The pool belongs to the group.
-	PoolId string `protobuf:"bytes,5,opt,name=poolId,proto3" json:"poolId,omitempty"`
+	// The uuid of the snapshot, required.
+	SnapshotId string `protobuf:"bytes,2,opt,name=snapshotId,proto3" json:"snapshotId,omitempty"`
+	// The infomation of the host node on which the volume will be attached.
+	HostInfo *HostInfo `protobuf:"bytes,3,opt,name=hostInfo,proto3" json:"hostInfo,omitempty"`
+	// The metadata of the snapshot attachment, optional.
+	Metadata map[string]string `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The storage driver type.
+	DriverName string `protobuf:"bytes,5,opt,name=driverName,proto3" json:"driverName,omitempty"`
 	// The Context
-	Context              string   `protobuf:"bytes,6,opt,name=context,proto3" json:"context,omitempty"`
+	Context string `protobuf:"bytes,6,opt,name=context,proto3" json:"context,omitempty"`
+	// The protocol
+	AccessProtocol       string   `protobuf:"bytes,7,opt,name=AccessProtocol,proto3" json:"AccessProtocol,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpdateVolumeGroupOpts) Reset()         { *m = UpdateVolumeGroupOpts{} }
-func (m *UpdateVolumeGroupOpts) String() string { return proto.CompactTextString(m) }
-func (*UpdateVolumeGroupOpts) ProtoMessage()    {}
-func (*UpdateVolumeGroupOpts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{17}
+func (m *DeleteSnapshotAttachmentOpts) Reset()         { *m = DeleteSnapshotAttachmentOpts{} }
+func (m *DeleteSnapshotAttachmentOpts) String() string { return proto.CompactTextString(m) }
+func (*DeleteSnapshotAttachmentOpts) ProtoMessage()    {}
+func (*DeleteSnapshotAttachmentOpts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{21}
 }
 
-func (m *UpdateVolumeGroupOpts) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdateVolumeGroupOpts.Unmarshal(m, b)
+func (m *DeleteSnapshotAttachmentOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteSnapshotAttachmentOpts.Unmarshal(m, b)
 }
-func (m *UpdateVolumeGroupOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdateVolumeGroupOpts.Marshal(b, m, deterministic)
+func (m *DeleteSnapshotAttachmentOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteSnapshotAttachmentOpts.Marshal(b, m, deterministic)
 }
-func (m *UpdateVolumeGroupOpts) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateVolumeGroupOpts.Merge(m, src)
+func (m *DeleteSnapshotAttachmentOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteSnapshotAttachmentOpts.Merge(m, src)
 }
-func (m *UpdateVolumeGroupOpts) XXX_Size() int {
-	return xxx_messageInfo_UpdateVolumeGroupOpts.Size(m)
+func (m *DeleteSnapshotAttachmentOpts) XXX_Size() int {
+	return xxx_messageInfo_DeleteSnapshotAttachmentOpts.Size(m)
 }
-func (m *UpdateVolumeGroupOpts) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateVolumeGroupOpts.DiscardUnknown(m)
+func (m *DeleteSnapshotAttachmentOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteSnapshotAttachmentOpts.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateVolumeGroupOpts proto.InternalMessageInfo
+var xxx_messageInfo_DeleteSnapshotAttachmentOpts proto.InternalMessageInfo
 
-func (m *UpdateVolumeGroupOpts) GetId() string {
+func (m *DeleteSnapshotAttachmentOpts) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-func (m *UpdateVolumeGroupOpts) GetDriverName() string {
+func (m *DeleteSnapshotAttachmentOpts) GetSnapshotId() string {
 	if m != nil {
-		return m.DriverName
+		return m.SnapshotId
 	}
 	return ""
 }
 
-func (m *UpdateVolumeGroupOpts) GetAddVolumes() []string {
+func (m *DeleteSnapshotAttachmentOpts) GetHostInfo() *HostInfo {
 	if m != nil {
-		return m.AddVolumes
+		return m.HostInfo
 	}
 	return nil
 }
 
-func (m *UpdateVolumeGroupOpts) GetRemoveVolumes() []string {
+func (m *DeleteSnapshotAttachmentOpts) GetMetadata() map[string]string {
 	if m != nil {
-		return m.RemoveVolumes
+		return m.Metadata
 	}
 	return nil
 }
 
-func (m *UpdateVolumeGroupOpts) GetPoolId() string {
+func (m *DeleteSnapshotAttachmentOpts) GetDriverName() string {
 	if m != nil {
-		return m.PoolId
+		return m.DriverName
 	}
 	return ""
 }
 
-func (m *UpdateVolumeGroupOpts) GetContext() string {
+func (m *DeleteSnapshotAttachmentOpts) GetContext() string {
 	if m != nil {
 		return m.Context
 	}
 	return ""
 }
 
-type DeleteVolumeGroupOpts struct {
-	// The uuid of the volume group, optional when deleting.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// The pool belongs to the group.
-	PoolId string `protobuf:"bytes,2,opt,name=poolId,proto3" json:"poolId,omitempty"`
-	// The driver of the volume group.
-	DriverName string `protobuf:"bytes,3,opt,name=driverName,proto3" json:"driverName,omitempty"`
-	// The Context
-	Context              string   `protobuf:"bytes,4,opt,name=context,proto3" json:"context,omitempty"`
+func (m *DeleteSnapshotAttachmentOpts) GetAccessProtocol() string {
+	if m != nil {
+		return m.AccessProtocol
+	}
+	return ""
+}
+
+type HostInfo struct {
+	// The platform of the host, such as "x86_64"
+	Platform string `protobuf:"bytes,1,opt,name=platform,proto3" json:"platform,omitempty"`
+	// The type of OS, such as "linux","windows", etc.
+	OsType string `protobuf:"bytes,2,opt,name=osType,proto3" json:"osType,omitempty"`
+	// The name of the host
+	Host string `protobuf:"bytes,3,opt,name=host,proto3" json:"host,omitempty"`
+	// The ip address of the host
+	Ip string `protobuf:"bytes,4,opt,name=ip,proto3" json:"ip,omitempty"`
+	// The initiator infomation, such as: "iqn.2017.com.redhat:e08039b48d5c"
+	Initiator            string   `protobuf:"bytes,5,opt,name=initiator,proto3" json:"initiator,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteVolumeGroupOpts) Reset()         { *m = DeleteVolumeGroupOpts{} }
-func (m *DeleteVolumeGroupOpts) String() string { return proto.CompactTextString(m) }
-func (*DeleteVolumeGroupOpts) ProtoMessage()    {}
-func (*DeleteVolumeGroupOpts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{18}
+func (m *HostInfo) Reset()         { *m = HostInfo{} }
+func (m *HostInfo) String() string { return proto.CompactTextString(m) }
+func (*HostInfo) ProtoMessage()    {}
+func (*HostInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{22}
 }
 
-func (m *DeleteVolumeGroupOpts) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteVolumeGroupOpts.Unmarshal(m, b)
+func (m *HostInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HostInfo.Unmarshal(m, b)
 }
-func (m *DeleteVolumeGroupOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteVolumeGroupOpts.Marshal(b, m, deterministic)
+func (m *HostInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HostInfo.Marshal(b, m, deterministic)
 }
-func (m *DeleteVolumeGroupOpts) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteVolumeGroupOpts.Merge(m, src)
+func (m *HostInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HostInfo.Merge(m, src)
 }
-func (m *DeleteVolumeGroupOpts) XXX_Size() int {
-	return xxx_messageInfo_DeleteVolumeGroupOpts.Size(m)
+func (m *HostInfo) XXX_Size() int {
+	return xxx_messageInfo_HostInfo.Size(m)
 }
-func (m *DeleteVolumeGroupOpts) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteVolumeGroupOpts.DiscardUnknown(m)
+func (m *HostInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_HostInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteVolumeGroupOpts proto.InternalMessageInfo
+var xxx_messageInfo_HostInfo proto.InternalMessageInfo
 
-func (m *DeleteVolumeGroupOpts) GetId() string {
+func (m *HostInfo) GetPlatform() string {
 	if m != nil {
-		return m.Id
+		return m.Platform
 	}
 	return ""
 }
 
-func (m *DeleteVolumeGroupOpts) GetPoolId() string {
+func (m *HostInfo) GetOsType() string {
 	if m != nil {
-		return m.PoolId
+		return m.OsType
 	}
 	return ""
 }
 
-func (m *DeleteVolumeGroupOpts) GetDriverName() string {
+func (m *HostInfo) GetHost() string {
 	if m != nil {
-		return m.DriverName
+		return m.Host
 	}
 	return ""
 }
 
-func (m *DeleteVolumeGroupOpts) GetContext() string {
+func (m *HostInfo) GetIp() string {
 	if m != nil {
-		return m.Context
+		return m.Ip
 	}
 	return ""
 }
 
-// AttachVolumeOpts is a structure which indicates all required
-// properties for attaching a volume.
-type AttachVolumeOpts struct {
-	// The access protocol for attaching a volume.
-	AccessProtocol string `protobuf:"bytes,1,opt,name=accessProtocol,proto3" json:"accessProtocol,omitempty"`
-	// The connectionData for attaching a volume.
-	ConnectionData string `protobuf:"bytes,2,opt,name=connectionData,proto3" json:"connectionData,omitempty"`
-	// The metadata for attaching a volume, optional.
-	Metadata map[string]string `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// The Context
-	Context              string   `protobuf:"bytes,4,opt,name=context,proto3" json:"context,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *AttachVolumeOpts) Reset()         { *m = AttachVolumeOpts{} }
-func (m *AttachVolumeOpts) String() string { return proto.CompactTextString(m) }
-func (*AttachVolumeOpts) ProtoMessage()    {}
-func (*AttachVolumeOpts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{19}
+func (m *HostInfo) GetInitiator() string {
+	if m != nil {
+		return m.Initiator
+	}
+	return ""
 }
 
-func (m *AttachVolumeOpts) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AttachVolumeOpts.Unmarshal(m, b)
-}
-func (m *AttachVolumeOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AttachVolumeOpts.Marshal(b, m, deterministic)
-}
-func (m *AttachVolumeOpts) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AttachVolumeOpts.Merge(m, src)
-}
-func (m *AttachVolumeOpts) XXX_Size() int {
-	return xxx_messageInfo_AttachVolumeOpts.Size(m)
-}
-func (m *AttachVolumeOpts) XXX_DiscardUnknown() {
-	xxx_messageInfo_AttachVolumeOpts.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_AttachVolumeOpts proto.InternalMessageInfo
-
-func (m *AttachVolumeOpts) GetAccessProtocol() string {
-	if m != nil {
-		return m.AccessProtocol
-	}
-	return ""
-}
-
-func (m *AttachVolumeOpts) GetConnectionData() string {
-	if m != nil {
-		return m.ConnectionData
-	}
-	return ""
-}
-
-func (m *AttachVolumeOpts) GetMetadata() map[string]string {
-	if m != nil {
-		return m.Metadata
-	}
-	return nil
-}
-
-func (m *AttachVolumeOpts) GetContext() string {
-	if m != nil {
-		return m.Context
-	}
-	return ""
-}
-
-// DetachVolumeOpts is a structure which indicates all required
-// properties for detaching a volume.
-type DetachVolumeOpts struct {
-	// The access protocol for detaching a volume.
-	AccessProtocol string `protobuf:"bytes,1,opt,name=accessProtocol,proto3" json:"accessProtocol,omitempty"`
-	// The connectionData for detaching a volume.
-	ConnectionData string `protobuf:"bytes,2,opt,name=connectionData,proto3" json:"connectionData,omitempty"`
-	// The metadata for detaching a volume, optional.
-	Metadata map[string]string `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// The Context
-	Context              string   `protobuf:"bytes,4,opt,name=context,proto3" json:"context,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type VolumeData struct {
+	Data                 map[string]string `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *DetachVolumeOpts) Reset()         { *m = DetachVolumeOpts{} }
-func (m *DetachVolumeOpts) String() string { return proto.CompactTextString(m) }
-func (*DetachVolumeOpts) ProtoMessage()    {}
-func (*DetachVolumeOpts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{20}
+func (m *VolumeData) Reset()         { *m = VolumeData{} }
+func (m *VolumeData) String() string { return proto.CompactTextString(m) }
+func (*VolumeData) ProtoMessage()    {}
+func (*VolumeData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{23}
 }
 
-func (m *DetachVolumeOpts) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DetachVolumeOpts.Unmarshal(m, b)
-}
-func (m *DetachVolumeOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DetachVolumeOpts.Marshal(b, m, deterministic)
+func (m *VolumeData) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VolumeData.Unmarshal(m, b)
 }
-func (m *DetachVolumeOpts) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DetachVolumeOpts.Merge(m, src)
+func (m *VolumeData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VolumeData.Marshal(b, m, deterministic)
 }
-func (m *DetachVolumeOpts) XXX_Size() int {
-	return xxx_messageInfo_DetachVolumeOpts.Size(m)
+func (m *VolumeData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VolumeData.Merge(m, src)
 }
-func (m *DetachVolumeOpts) XXX_DiscardUnknown() {
-	xxx_messageInfo_DetachVolumeOpts.DiscardUnknown(m)
+func (m *VolumeData) XXX_Size() int {
+	return xxx_messageInfo_VolumeData.Size(m)
 }
-
-var xxx_messageInfo_DetachVolumeOpts proto.InternalMessageInfo
-
-func (m *DetachVolumeOpts) GetAccessProtocol() string {
-	if m != nil {
-		return m.AccessProtocol
-	}
-	return ""
+func (m *VolumeData) XXX_DiscardUnknown() {
+	xxx_messageInfo_VolumeData.DiscardUnknown(m)
 }
 
-func (m *DetachVolumeOpts) GetConnectionData() string {
-	if m != nil {
-		return m.ConnectionData
-	}
-	return ""
-}
+var xxx_messageInfo_VolumeData proto.InternalMessageInfo
 
-func (m *DetachVolumeOpts) GetMetadata() map[string]string {
+func (m *VolumeData) GetData() map[string]string {
 	if m != nil {
-		return m.Metadata
+		return m.Data
 	}
 	return nil
 }
 
-func (m *DetachVolumeOpts) GetContext() string {
-	if m != nil {
-		return m.Context
-	}
-	return ""
-}
-
-// CreateFileShareOpts is a structure which indicates all required properties for creating a file share.
-type CreateFileShareOpts struct {
-	// The uuid of the file share, optional when creating.
+// CreateReplicationOpts is a structure which indicates all required properties
+// for creating a replication.
+// NOTE: Need to figure out how to handle more than 2 sites.
+type CreateReplicationOpts struct {
+	// The uuid of the replication, optional when creating.
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// The name of the file share, required.
+	// The name of the replication, optional.
 	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// The requested capacity of the file share, required.
-	Size int64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
-	// The description of the file share, optional.
-	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	// The locality that file share belongs to, required.
+	// The description of the replication, optional.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// The uuid of the primary volume. This field is required.
+	PrimaryVolumeId string `protobuf:"bytes,4,opt,name=primaryVolumeId,proto3" json:"primaryVolumeId,omitempty"`
+	// The uuid of the secondary volume. This field is required.
+	SecondaryVolumeId string `protobuf:"bytes,5,opt,name=secondaryVolumeId,proto3" json:"secondaryVolumeId,omitempty"`
+	// The dock infomation on which the request will be executed
 	AvailabilityZone string `protobuf:"bytes,6,opt,name=availabilityZone,proto3" json:"availabilityZone,omitempty"`
-	// The service level that file share belongs to, required.
+	// The service level that volume belongs to, required.
+	// TODO: This item will be replace by profile, don't use it.
 	ProfileId string `protobuf:"bytes,7,opt,name=profileId,proto3" json:"profileId,omitempty"`
-	// The uuid of the pool on which file share will be created, required.
+	// The uuid of the pool on which volume will be created, required.
 	PoolId string `protobuf:"bytes,8,opt,name=poolId,proto3" json:"poolId,omitempty"`
-	// The name of the pool on which file share will be created, required.
+	// The name of the pool on which volume will be created, required.
 	PoolName string `protobuf:"bytes,9,opt,name=poolName,proto3" json:"poolName,omitempty"`
-	// The metadata of the file share, optional.
-	Metadata map[string]string `protobuf:"bytes,10,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// The storage driver type.
-	DriverName string `protobuf:"bytes,11,opt,name=driverName,proto3" json:"driverName,omitempty"`
+	// The metadata of the primary replication, optional.
+	PrimaryReplicationDriverData map[string]string `protobuf:"bytes,11,rep,name=primaryReplicationDriverData,proto3" json:"primaryReplicationDriverData,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The metadata of the seondary replication, optional.
+	SecondaryReplicationDriverData map[string]string `protobuf:"bytes,12,rep,name=secondaryReplicationDriverData,proto3" json:"secondaryReplicationDriverData,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The dock id.
+	DockId string `protobuf:"bytes,13,opt,name=dockId,proto3" json:"dockId,omitempty"`
+	// The replication driver type.
+	DriverName string `protobuf:"bytes,14,opt,name=driverName,proto3" json:"driverName,omitempty"`
 	// The Context
-	Context string `protobuf:"bytes,12,opt,name=context,proto3" json:"context,omitempty"`
+	Context string `protobuf:"bytes,15,opt,name=context,proto3" json:"context,omitempty"`
+	// Whether is primary replication
+	IsPrimary bool `protobuf:"varint,16,opt,name=isPrimary,proto3" json:"isPrimary,omitempty"`
+	// The replication metadata
+	Metadata map[string]string `protobuf:"bytes,17,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The volume data list
+	VolumeDataList []*VolumeData `protobuf:"bytes,18,rep,name=volumeDataList,proto3" json:"volumeDataList,omitempty"`
+	//the replication mode sync/async
+	ReplicationMode string `protobuf:"bytes,19,opt,name=replicationMode,proto3" json:"replicationMode,omitempty"`
+	// 0 means sync replication.
+	ReplicationPeriod int64 `protobuf:"varint,20,opt,name=ReplicationPeriod,proto3" json:"ReplicationPeriod,omitempty"`
+	// replication bandwidth
+	ReplicationBandwidth int64 `protobuf:"varint,21,opt,name=ReplicationBandwidth,proto3" json:"ReplicationBandwidth,omitempty"`
 	// The Serialized profile
-	Profile              string   `protobuf:"bytes,13,opt,name=profile,proto3" json:"profile,omitempty"`
+	Profile string `protobuf:"bytes,22,opt,name=profile,proto3" json:"profile,omitempty"`
+	// The uuid of the operation which tracks this request.
+	OperationId          string   `protobuf:"bytes,23,opt,name=operationId,proto3" json:"operationId,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateFileShareOpts) Reset()         { *m = CreateFileShareOpts{} }
-func (m *CreateFileShareOpts) String() string { return proto.CompactTextString(m) }
-func (*CreateFileShareOpts) ProtoMessage()    {}
-func (*CreateFileShareOpts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{21}
+func (m *CreateReplicationOpts) Reset()         { *m = CreateReplicationOpts{} }
+func (m *CreateReplicationOpts) String() string { return proto.CompactTextString(m) }
+func (*CreateReplicationOpts) ProtoMessage()    {}
+func (*CreateReplicationOpts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{24}
 }
 
-func (m *CreateFileShareOpts) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateFileShareOpts.Unmarshal(m, b)
+func (m *CreateReplicationOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateReplicationOpts.Unmarshal(m, b)
 }
-func (m *CreateFileShareOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateFileShareOpts.Marshal(b, m, deterministic)
+func (m *CreateReplicationOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateReplicationOpts.Marshal(b, m, deterministic)
 }
-func (m *CreateFileShareOpts) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateFileShareOpts.Merge(m, src)
+func (m *CreateReplicationOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateReplicationOpts.Merge(m, src)
 }
-func (m *CreateFileShareOpts) XXX_Size() int {
-	return xxx_messageInfo_CreateFileShareOpts.Size(m)
+func (m *CreateReplicationOpts) XXX_Size() int {
+	return xxx_messageInfo_CreateReplicationOpts.Size(m)
 }
-func (m *CreateFileShareOpts) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateFileShareOpts.DiscardUnknown(m)
+func (m *CreateReplicationOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateReplicationOpts.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateFileShareOpts proto.InternalMessageInfo
+var xxx_messageInfo_CreateReplicationOpts proto.InternalMessageInfo
 
-func (m *CreateFileShareOpts) GetId() string {
+func (m *CreateReplicationOpts) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-func (m *CreateFileShareOpts) GetName() string {
+func (m *CreateReplicationOpts) GetName() string {
 	if m != nil {
 		return m.Name
 	}
 	return ""
 }
 
-func (m *CreateFileShareOpts) GetSize() int64 {
+func (m *CreateReplicationOpts) GetDescription() string {
 	if m != nil {
-		return m.Size
+		return m.Description
 	}
-	return 0
+	return ""
 }
 
-func (m *CreateFileShareOpts) GetDescription() string {
+func (m *CreateReplicationOpts) GetPrimaryVolumeId() string {
 	if m != nil {
-		return m.Description
+		return m.PrimaryVolumeId
 	}
 	return ""
 }
 
-func (m *CreateFileShareOpts) GetAvailabilityZone() string {
+func (m *CreateReplicationOpts) GetSecondaryVolumeId() string {
+	if m != nil {
+		return m.SecondaryVolumeId
+	}
+	return ""
+}
+
+func (m *CreateReplicationOpts) GetAvailabilityZone() string {
 	if m != nil {
 		return m.AvailabilityZone
 	}
 	return ""
 }
 
-func (m *CreateFileShareOpts) GetProfileId() string {
+func (m *CreateReplicationOpts) GetProfileId() string {
 	if m != nil {
 		return m.ProfileId
 	}
 	return ""
 }
 
-func (m *CreateFileShareOpts) GetPoolId() string {
+func (m *CreateReplicationOpts) GetPoolId() string {
 	if m != nil {
 		return m.PoolId
 	}
 	return ""
 }
 
-func (m *CreateFileShareOpts) GetPoolName() string {
+func (m *CreateReplicationOpts) GetPoolName() string {
 	if m != nil {
 		return m.PoolName
 	}
 	return ""
 }
 
-func (m *CreateFileShareOpts) GetMetadata() map[string]string {
+func (m *CreateReplicationOpts) GetPrimaryReplicationDriverData() map[string]string {
 	if m != nil {
-		return m.Metadata
+		return m.PrimaryReplicationDriverData
 	}
 	return nil
 }
 
-func (m *CreateFileShareOpts) GetDriverName() string {
+func (m *CreateReplicationOpts) GetSecondaryReplicationDriverData() map[string]string {
 	if m != nil {
-		return m.DriverName
+		return m.SecondaryReplicationDriverData
 	}
-	return ""
+	return nil
 }
 
-func (m *CreateFileShareOpts) GetContext() string {
+func (m *CreateReplicationOpts) GetDockId() string {
 	if m != nil {
-		return m.Context
+		return m.DockId
 	}
 	return ""
 }
 
-func (m *CreateFileShareOpts) GetProfile() string {
+func (m *CreateReplicationOpts) GetDriverName() string {
 	if m != nil {
-		return m.Profile
+		return m.DriverName
 	}
 	return ""
 }
 
-// DeleteFileShareOpts is a structure which indicates all required properties
-// for deleting a file share.
-type DeleteFileShareOpts struct {
-	// The uuid of the fileshare, required.
+func (m *CreateReplicationOpts) GetContext() string {
+	if m != nil {
+		return m.Context
+	}
+	return ""
+}
+
+func (m *CreateReplicationOpts) GetIsPrimary() bool {
+	if m != nil {
+		return m.IsPrimary
+	}
+	return false
+}
+
+func (m *CreateReplicationOpts) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *CreateReplicationOpts) GetVolumeDataList() []*VolumeData {
+	if m != nil {
+		return m.VolumeDataList
+	}
+	return nil
+}
+
+func (m *CreateReplicationOpts) GetReplicationMode() string {
+	if m != nil {
+		return m.ReplicationMode
+	}
+	return ""
+}
+
+func (m *CreateReplicationOpts) GetReplicationPeriod() int64 {
+	if m != nil {
+		return m.ReplicationPeriod
+	}
+	return 0
+}
+
+func (m *CreateReplicationOpts) GetReplicationBandwidth() int64 {
+	if m != nil {
+		return m.ReplicationBandwidth
+	}
+	return 0
+}
+
+func (m *CreateReplicationOpts) GetProfile() string {
+	if m != nil {
+		return m.Profile
+	}
+	return ""
+}
+
+func (m *CreateReplicationOpts) GetOperationId() string {
+	if m != nil {
+		return m.OperationId
+	}
+	return ""
+}
+
+// Delete ReplicationOpts is a structure which indicates all required properties
+// for deleting a replication.
+// NOTE: Need to figure out how to handle more than 2 sites.
+type DeleteReplicationOpts struct {
+	// The uuid of the replication, optional when creating.
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// The service level that fileshare belongs to, required.
-	// This item will be replace by profile
-	ProfileId string `protobuf:"bytes,2,opt,name=profileId,proto3" json:"profileId,omitempty"`
-	// The uuid of the pool on which fileshare will be created, required.
-	PoolId string `protobuf:"bytes,3,opt,name=poolId,proto3" json:"poolId,omitempty"`
-	// The metadata of the fileshare, optional.
-	Metadata map[string]string `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// The storage driver type.
-	DriverName string `protobuf:"bytes,5,opt,name=driverName,proto3" json:"driverName,omitempty"`
+	// The name of the replication, optional.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The description of the replication, optional.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// The uuid of the primary volume. This field is required.
+	PrimaryVolumeId string `protobuf:"bytes,4,opt,name=primaryVolumeId,proto3" json:"primaryVolumeId,omitempty"`
+	// The uuid of the secondary volume. This field is required.
+	SecondaryVolumeId string `protobuf:"bytes,5,opt,name=secondaryVolumeId,proto3" json:"secondaryVolumeId,omitempty"`
+	// The dock infomation on which the request will be executed
+	AvailabilityZone string `protobuf:"bytes,6,opt,name=availabilityZone,proto3" json:"availabilityZone,omitempty"`
+	// The service level that volume belongs to, required.
+	// TODO: This item will be replace by profile, don't use it.
+	ProfileId string `protobuf:"bytes,7,opt,name=profileId,proto3" json:"profileId,omitempty"`
+	// The uuid of the pool on which volume will be created, required.
+	PoolId string `protobuf:"bytes,8,opt,name=poolId,proto3" json:"poolId,omitempty"`
+	// The name of the pool on which volume will be created, required.
+	PoolName string `protobuf:"bytes,9,opt,name=poolName,proto3" json:"poolName,omitempty"`
+	// The metadata of the primary replication, optional.
+	PrimaryReplicationDriverData map[string]string `protobuf:"bytes,11,rep,name=primaryReplicationDriverData,proto3" json:"primaryReplicationDriverData,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The metadata of the seondary replication, optional.
+	SecondaryReplicationDriverData map[string]string `protobuf:"bytes,12,rep,name=secondaryReplicationDriverData,proto3" json:"secondaryReplicationDriverData,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The dock id.
+	DockId string `protobuf:"bytes,13,opt,name=dockId,proto3" json:"dockId,omitempty"`
+	// The replication driver type.
+	DriverName string `protobuf:"bytes,14,opt,name=driverName,proto3" json:"driverName,omitempty"`
 	// The Context
-	Context string `protobuf:"bytes,6,opt,name=context,proto3" json:"context,omitempty"`
+	Context string `protobuf:"bytes,15,opt,name=context,proto3" json:"context,omitempty"`
+	// The replication metadata
+	Metadata map[string]string `protobuf:"bytes,16,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Whether is primary replication
+	IsPrimary bool `protobuf:"varint,17,opt,name=isPrimary,proto3" json:"isPrimary,omitempty"`
 	// The Serialized profile
-	Profile              string   `protobuf:"bytes,7,opt,name=profile,proto3" json:"profile,omitempty"`
+	Profile string `protobuf:"bytes,18,opt,name=profile,proto3" json:"profile,omitempty"`
+	// The uuid of the operation which tracks this request.
+	OperationId          string   `protobuf:"bytes,19,opt,name=operationId,proto3" json:"operationId,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteFileShareOpts) Reset()         { *m = DeleteFileShareOpts{} }
-func (m *DeleteFileShareOpts) String() string { return proto.CompactTextString(m) }
-func (*DeleteFileShareOpts) ProtoMessage()    {}
-func (*DeleteFileShareOpts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{22}
+func (m *DeleteReplicationOpts) Reset()         { *m = DeleteReplicationOpts{} }
+func (m *DeleteReplicationOpts) String() string { return proto.CompactTextString(m) }
+func (*DeleteReplicationOpts) ProtoMessage()    {}
+func (*DeleteReplicationOpts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{25}
 }
 
-func (m *DeleteFileShareOpts) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteFileShareOpts.Unmarshal(m, b)
+func (m *DeleteReplicationOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteReplicationOpts.Unmarshal(m, b)
 }
-func (m *DeleteFileShareOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteFileShareOpts.Marshal(b, m, deterministic)
+func (m *DeleteReplicationOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteReplicationOpts.Marshal(b, m, deterministic)
 }
-func (m *DeleteFileShareOpts) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteFileShareOpts.Merge(m, src)
+func (m *DeleteReplicationOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteReplicationOpts.Merge(m, src)
 }
-func (m *DeleteFileShareOpts) XXX_Size() int {
-	return xxx_messageInfo_DeleteFileShareOpts.Size(m)
+func (m *DeleteReplicationOpts) XXX_Size() int {
+	return xxx_messageInfo_DeleteReplicationOpts.Size(m)
 }
-func (m *DeleteFileShareOpts) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteFileShareOpts.DiscardUnknown(m)
+func (m *DeleteReplicationOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteReplicationOpts.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteFileShareOpts proto.InternalMessageInfo
+var xxx_messageInfo_DeleteReplicationOpts proto.InternalMessageInfo
 
-func (m *DeleteFileShareOpts) GetId() string {
+func (m *DeleteReplicationOpts) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-func (m *DeleteFileShareOpts) GetProfileId() string {
+func (m *DeleteReplicationOpts) GetName() string {
 	if m != nil {
-		return m.ProfileId
+		return m.Name
 	}
 	return ""
 }
 
-func (m *DeleteFileShareOpts) GetPoolId() string {
+func (m *DeleteReplicationOpts) GetDescription() string {
 	if m != nil {
-		return m.PoolId
+		return m.Description
 	}
 	return ""
 }
 
-func (m *DeleteFileShareOpts) GetMetadata() map[string]string {
+func (m *DeleteReplicationOpts) GetPrimaryVolumeId() string {
 	if m != nil {
-		return m.Metadata
+		return m.PrimaryVolumeId
 	}
-	return nil
+	return ""
 }
 
-func (m *DeleteFileShareOpts) GetDriverName() string {
+func (m *DeleteReplicationOpts) GetSecondaryVolumeId() string {
 	if m != nil {
-		return m.DriverName
+		return m.SecondaryVolumeId
 	}
 	return ""
 }
 
-func (m *DeleteFileShareOpts) GetContext() string {
+func (m *DeleteReplicationOpts) GetAvailabilityZone() string {
 	if m != nil {
-		return m.Context
+		return m.AvailabilityZone
 	}
 	return ""
 }
 
-func (m *DeleteFileShareOpts) GetProfile() string {
+func (m *DeleteReplicationOpts) GetProfileId() string {
 	if m != nil {
-		return m.Profile
+		return m.ProfileId
 	}
 	return ""
 }
 
-// Generic response, it return:
-// 1. Return result with message when create/update resource successfully.
-// 2. Return result without message when delete resource successfully.
-// 3. Return Error with error code and message when operate unsuccessfully.
-type GenericResponse struct {
-	// Types that are valid to be assigned to Reply:
-	//	*GenericResponse_Result_
-	//	*GenericResponse_Error_
-	Reply                isGenericResponse_Reply `protobuf_oneof:"reply"`
-	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
-	XXX_unrecognized     []byte                  `json:"-"`
-	XXX_sizecache        int32                   `json:"-"`
+func (m *DeleteReplicationOpts) GetPoolId() string {
+	if m != nil {
+		return m.PoolId
+	}
+	return ""
 }
 
-func (m *GenericResponse) Reset()         { *m = GenericResponse{} }
-func (m *GenericResponse) String() string { return proto.CompactTextString(m) }
-func (*GenericResponse) ProtoMessage()    {}
-func (*GenericResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{23}
+func (m *DeleteReplicationOpts) GetPoolName() string {
+	if m != nil {
+		return m.PoolName
+	}
+	return ""
 }
 
-func (m *GenericResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GenericResponse.Unmarshal(m, b)
-}
-func (m *GenericResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GenericResponse.Marshal(b, m, deterministic)
-}
-func (m *GenericResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GenericResponse.Merge(m, src)
-}
-func (m *GenericResponse) XXX_Size() int {
-	return xxx_messageInfo_GenericResponse.Size(m)
-}
-func (m *GenericResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GenericResponse.DiscardUnknown(m)
+func (m *DeleteReplicationOpts) GetPrimaryReplicationDriverData() map[string]string {
+	if m != nil {
+		return m.PrimaryReplicationDriverData
+	}
+	return nil
 }
 
-var xxx_messageInfo_GenericResponse proto.InternalMessageInfo
-
-type isGenericResponse_Reply interface {
-	isGenericResponse_Reply()
+func (m *DeleteReplicationOpts) GetSecondaryReplicationDriverData() map[string]string {
+	if m != nil {
+		return m.SecondaryReplicationDriverData
+	}
+	return nil
 }
 
-type GenericResponse_Result_ struct {
-	Result *GenericResponse_Result `protobuf:"bytes,1,opt,name=result,proto3,oneof"`
+func (m *DeleteReplicationOpts) GetDockId() string {
+	if m != nil {
+		return m.DockId
+	}
+	return ""
 }
 
-type GenericResponse_Error_ struct {
-	Error *GenericResponse_Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+func (m *DeleteReplicationOpts) GetDriverName() string {
+	if m != nil {
+		return m.DriverName
+	}
+	return ""
 }
 
-func (*GenericResponse_Result_) isGenericResponse_Reply() {}
-
-func (*GenericResponse_Error_) isGenericResponse_Reply() {}
-
-func (m *GenericResponse) GetReply() isGenericResponse_Reply {
+func (m *DeleteReplicationOpts) GetContext() string {
 	if m != nil {
-		return m.Reply
+		return m.Context
 	}
-	return nil
+	return ""
 }
 
-func (m *GenericResponse) GetResult() *GenericResponse_Result {
-	if x, ok := m.GetReply().(*GenericResponse_Result_); ok {
-		return x.Result
+func (m *DeleteReplicationOpts) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
 	}
 	return nil
 }
 
-func (m *GenericResponse) GetError() *GenericResponse_Error {
-	if x, ok := m.GetReply().(*GenericResponse_Error_); ok {
-		return x.Error
-	}
-	return nil
+func (m *DeleteReplicationOpts) GetIsPrimary() bool {
+	if m != nil {
+		return m.IsPrimary
+	}
+	return false
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*GenericResponse) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*GenericResponse_Result_)(nil),
-		(*GenericResponse_Error_)(nil),
+func (m *DeleteReplicationOpts) GetProfile() string {
+	if m != nil {
+		return m.Profile
 	}
+	return ""
 }
 
-type GenericResponse_Result struct {
-	Message              string   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+func (m *DeleteReplicationOpts) GetOperationId() string {
+	if m != nil {
+		return m.OperationId
+	}
+	return ""
+}
+
+// Delete ReplicationOpts is a structure which indicates all required properties
+type EnableReplicationOpts struct {
+	// The uuid of the replication, optional when creating.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The name of the replication, optional.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The description of the replication, optional.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// The uuid of the primary volume. This field is required.
+	PrimaryVolumeId string `protobuf:"bytes,4,opt,name=primaryVolumeId,proto3" json:"primaryVolumeId,omitempty"`
+	// The uuid of the secondary volume. This field is required.
+	SecondaryVolumeId string `protobuf:"bytes,5,opt,name=secondaryVolumeId,proto3" json:"secondaryVolumeId,omitempty"`
+	// The dock infomation on which the request will be executed
+	AvailabilityZone string `protobuf:"bytes,6,opt,name=availabilityZone,proto3" json:"availabilityZone,omitempty"`
+	// The service level that volume belongs to, required.
+	// TODO: This item will be replace by profile, don't use it.
+	ProfileId string `protobuf:"bytes,7,opt,name=profileId,proto3" json:"profileId,omitempty"`
+	// The uuid of the pool on which volume will be created, required.
+	PoolId string `protobuf:"bytes,8,opt,name=poolId,proto3" json:"poolId,omitempty"`
+	// The name of the pool on which volume will be created, required.
+	PoolName string `protobuf:"bytes,9,opt,name=poolName,proto3" json:"poolName,omitempty"`
+	// The metadata of the primary replication, optional.
+	PrimaryReplicationDriverData map[string]string `protobuf:"bytes,11,rep,name=primaryReplicationDriverData,proto3" json:"primaryReplicationDriverData,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The metadata of the seondary replication, optional.
+	SecondaryReplicationDriverData map[string]string `protobuf:"bytes,12,rep,name=secondaryReplicationDriverData,proto3" json:"secondaryReplicationDriverData,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The dock id.
+	DockId string `protobuf:"bytes,13,opt,name=dockId,proto3" json:"dockId,omitempty"`
+	// The replication driver type.
+	DriverName string `protobuf:"bytes,14,opt,name=driverName,proto3" json:"driverName,omitempty"`
+	// The Context
+	Context string `protobuf:"bytes,15,opt,name=context,proto3" json:"context,omitempty"`
+	// The replication metadata
+	Metadata map[string]string `protobuf:"bytes,16,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Whether is primary replication
+	IsPrimary bool `protobuf:"varint,17,opt,name=isPrimary,proto3" json:"isPrimary,omitempty"`
+	// The Serialized profile
+	Profile string `protobuf:"bytes,18,opt,name=profile,proto3" json:"profile,omitempty"`
+	// The uuid of the operation which tracks this request.
+	OperationId          string   `protobuf:"bytes,19,opt,name=operationId,proto3" json:"operationId,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GenericResponse_Result) Reset()         { *m = GenericResponse_Result{} }
-func (m *GenericResponse_Result) String() string { return proto.CompactTextString(m) }
-func (*GenericResponse_Result) ProtoMessage()    {}
-func (*GenericResponse_Result) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{23, 0}
+func (m *EnableReplicationOpts) Reset()         { *m = EnableReplicationOpts{} }
+func (m *EnableReplicationOpts) String() string { return proto.CompactTextString(m) }
+func (*EnableReplicationOpts) ProtoMessage()    {}
+func (*EnableReplicationOpts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{26}
 }
 
-func (m *GenericResponse_Result) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GenericResponse_Result.Unmarshal(m, b)
+func (m *EnableReplicationOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EnableReplicationOpts.Unmarshal(m, b)
 }
-func (m *GenericResponse_Result) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GenericResponse_Result.Marshal(b, m, deterministic)
+func (m *EnableReplicationOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EnableReplicationOpts.Marshal(b, m, deterministic)
 }
-func (m *GenericResponse_Result) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GenericResponse_Result.Merge(m, src)
+func (m *EnableReplicationOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnableReplicationOpts.Merge(m, src)
 }
-func (m *GenericResponse_Result) XXX_Size() int {
-	return xxx_messageInfo_GenericResponse_Result.Size(m)
+func (m *EnableReplicationOpts) XXX_Size() int {
+	return xxx_messageInfo_EnableReplicationOpts.Size(m)
 }
-func (m *GenericResponse_Result) XXX_DiscardUnknown() {
-	xxx_messageInfo_GenericResponse_Result.DiscardUnknown(m)
+func (m *EnableReplicationOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnableReplicationOpts.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GenericResponse_Result proto.InternalMessageInfo
+var xxx_messageInfo_EnableReplicationOpts proto.InternalMessageInfo
 
-func (m *GenericResponse_Result) GetMessage() string {
+func (m *EnableReplicationOpts) GetId() string {
 	if m != nil {
-		return m.Message
+		return m.Id
 	}
 	return ""
 }
 
-type GenericResponse_Error struct {
-	Code                 string   `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
-	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *EnableReplicationOpts) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *GenericResponse_Error) Reset()         { *m = GenericResponse_Error{} }
-func (m *GenericResponse_Error) String() string { return proto.CompactTextString(m) }
-func (*GenericResponse_Error) ProtoMessage()    {}
-func (*GenericResponse_Error) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{23, 1}
+func (m *EnableReplicationOpts) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
 }
 
-func (m *GenericResponse_Error) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GenericResponse_Error.Unmarshal(m, b)
-}
-func (m *GenericResponse_Error) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GenericResponse_Error.Marshal(b, m, deterministic)
-}
-func (m *GenericResponse_Error) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GenericResponse_Error.Merge(m, src)
-}
-func (m *GenericResponse_Error) XXX_Size() int {
-	return xxx_messageInfo_GenericResponse_Error.Size(m)
-}
-func (m *GenericResponse_Error) XXX_DiscardUnknown() {
-	xxx_messageInfo_GenericResponse_Error.DiscardUnknown(m)
+func (m *EnableReplicationOpts) GetPrimaryVolumeId() string {
+	if m != nil {
+		return m.PrimaryVolumeId
+	}
+	return ""
 }
 
-var xxx_messageInfo_GenericResponse_Error proto.InternalMessageInfo
-
-func (m *GenericResponse_Error) GetCode() string {
+func (m *EnableReplicationOpts) GetSecondaryVolumeId() string {
 	if m != nil {
-		return m.Code
+		return m.SecondaryVolumeId
 	}
 	return ""
 }
 
-func (m *GenericResponse_Error) GetDescription() string {
+func (m *EnableReplicationOpts) GetAvailabilityZone() string {
 	if m != nil {
-		return m.Description
+		return m.AvailabilityZone
 	}
 	return ""
 }
 
-type GetMetricsOpts struct {
-	InstanceId           string   `protobuf:"bytes,1,opt,name=instanceId,proto3" json:"instanceId,omitempty"`
-	MetricName           string   `protobuf:"bytes,2,opt,name=metricName,proto3" json:"metricName,omitempty"`
-	StartTime            string   `protobuf:"bytes,3,opt,name=startTime,proto3" json:"startTime,omitempty"`
-	EndTime              string   `protobuf:"bytes,4,opt,name=endTime,proto3" json:"endTime,omitempty"`
-	Context              string   `protobuf:"bytes,5,opt,name=context,proto3" json:"context,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *EnableReplicationOpts) GetProfileId() string {
+	if m != nil {
+		return m.ProfileId
+	}
+	return ""
 }
 
-func (m *GetMetricsOpts) Reset()         { *m = GetMetricsOpts{} }
-func (m *GetMetricsOpts) String() string { return proto.CompactTextString(m) }
-func (*GetMetricsOpts) ProtoMessage()    {}
-func (*GetMetricsOpts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{24}
+func (m *EnableReplicationOpts) GetPoolId() string {
+	if m != nil {
+		return m.PoolId
+	}
+	return ""
 }
 
-func (m *GetMetricsOpts) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetMetricsOpts.Unmarshal(m, b)
-}
-func (m *GetMetricsOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetMetricsOpts.Marshal(b, m, deterministic)
-}
-func (m *GetMetricsOpts) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetMetricsOpts.Merge(m, src)
-}
-func (m *GetMetricsOpts) XXX_Size() int {
-	return xxx_messageInfo_GetMetricsOpts.Size(m)
+func (m *EnableReplicationOpts) GetPoolName() string {
+	if m != nil {
+		return m.PoolName
+	}
+	return ""
 }
-func (m *GetMetricsOpts) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetMetricsOpts.DiscardUnknown(m)
+
+func (m *EnableReplicationOpts) GetPrimaryReplicationDriverData() map[string]string {
+	if m != nil {
+		return m.PrimaryReplicationDriverData
+	}
+	return nil
 }
 
-var xxx_messageInfo_GetMetricsOpts proto.InternalMessageInfo
+func (m *EnableReplicationOpts) GetSecondaryReplicationDriverData() map[string]string {
+	if m != nil {
+		return m.SecondaryReplicationDriverData
+	}
+	return nil
+}
 
-func (m *GetMetricsOpts) GetInstanceId() string {
+func (m *EnableReplicationOpts) GetDockId() string {
 	if m != nil {
-		return m.InstanceId
+		return m.DockId
 	}
 	return ""
 }
 
-func (m *GetMetricsOpts) GetMetricName() string {
+func (m *EnableReplicationOpts) GetDriverName() string {
 	if m != nil {
-		return m.MetricName
+		return m.DriverName
 	}
 	return ""
 }
 
-func (m *GetMetricsOpts) GetStartTime() string {
+func (m *EnableReplicationOpts) GetContext() string {
 	if m != nil {
-		return m.StartTime
+		return m.Context
 	}
 	return ""
 }
 
-func (m *GetMetricsOpts) GetEndTime() string {
+func (m *EnableReplicationOpts) GetMetadata() map[string]string {
 	if m != nil {
-		return m.EndTime
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *EnableReplicationOpts) GetIsPrimary() bool {
+	if m != nil {
+		return m.IsPrimary
+	}
+	return false
+}
+
+func (m *EnableReplicationOpts) GetProfile() string {
+	if m != nil {
+		return m.Profile
 	}
 	return ""
 }
 
-func (m *GetMetricsOpts) GetContext() string {
+func (m *EnableReplicationOpts) GetOperationId() string {
 	if m != nil {
-		return m.Context
+		return m.OperationId
 	}
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*CreateVolumeOpts)(nil), "proto.CreateVolumeOpts")
-	proto.RegisterMapType((map[string]string)(nil), "proto.CreateVolumeOpts.MetadataEntry")
-	proto.RegisterMapType((map[string]string)(nil), "proto.CreateVolumeOpts.ReplicationDriverDataEntry")
-	proto.RegisterType((*DeleteVolumeOpts)(nil), "proto.DeleteVolumeOpts")
-	proto.RegisterMapType((map[string]string)(nil), "proto.DeleteVolumeOpts.MetadataEntry")
-	proto.RegisterType((*ExtendVolumeOpts)(nil), "proto.ExtendVolumeOpts")
-	proto.RegisterMapType((map[string]string)(nil), "proto.ExtendVolumeOpts.MetadataEntry")
-	proto.RegisterType((*CreateVolumeSnapshotOpts)(nil), "proto.CreateVolumeSnapshotOpts")
-	proto.RegisterMapType((map[string]string)(nil), "proto.CreateVolumeSnapshotOpts.MetadataEntry")
-	proto.RegisterType((*DeleteVolumeSnapshotOpts)(nil), "proto.DeleteVolumeSnapshotOpts")
-	proto.RegisterMapType((map[string]string)(nil), "proto.DeleteVolumeSnapshotOpts.MetadataEntry")
-	proto.RegisterType((*CreateVolumeAttachmentOpts)(nil), "proto.CreateVolumeAttachmentOpts")
-	proto.RegisterMapType((map[string]string)(nil), "proto.CreateVolumeAttachmentOpts.MetadataEntry")
-	proto.RegisterType((*DeleteVolumeAttachmentOpts)(nil), "proto.DeleteVolumeAttachmentOpts")
-	proto.RegisterMapType((map[string]string)(nil), "proto.DeleteVolumeAttachmentOpts.MetadataEntry")
-	proto.RegisterType((*CreateSnapshotAttachmentOpts)(nil), "proto.CreateSnapshotAttachmentOpts")
-	proto.RegisterMapType((map[string]string)(nil), "proto.CreateSnapshotAttachmentOpts.MetadataEntry")
-	proto.RegisterType((*DeleteSnapshotAttachmentOpts)(nil), "proto.DeleteSnapshotAttachmentOpts")
-	proto.RegisterMapType((map[string]string)(nil), "proto.DeleteSnapshotAttachmentOpts.MetadataEntry")
-	proto.RegisterType((*HostInfo)(nil), "proto.HostInfo")
-	proto.RegisterType((*VolumeData)(nil), "proto.VolumeData")
-	proto.RegisterMapType((map[string]string)(nil), "proto.VolumeData.DataEntry")
-	proto.RegisterType((*CreateReplicationOpts)(nil), "proto.CreateReplicationOpts")
-	proto.RegisterMapType((map[string]string)(nil), "proto.CreateReplicationOpts.MetadataEntry")
-	proto.RegisterMapType((map[string]string)(nil), "proto.CreateReplicationOpts.PrimaryReplicationDriverDataEntry")
-	proto.RegisterMapType((map[string]string)(nil), "proto.CreateReplicationOpts.SecondaryReplicationDriverDataEntry")
-	proto.RegisterType((*DeleteReplicationOpts)(nil), "proto.DeleteReplicationOpts")
+// Delete ReplicationOpts is a structure which indicates all required properties
+type DisableReplicationOpts struct {
+	// The uuid of the replication, optional when creating.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The name of the replication, optional.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The description of the replication, optional.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// The uuid of the primary volume. This field is required.
+	PrimaryVolumeId string `protobuf:"bytes,4,opt,name=primaryVolumeId,proto3" json:"primaryVolumeId,omitempty"`
+	// The uuid of the secondary volume. This field is required.
+	SecondaryVolumeId string `protobuf:"bytes,5,opt,name=secondaryVolumeId,proto3" json:"secondaryVolumeId,omitempty"`
+	// The dock infomation on which the request will be executed
+	AvailabilityZone string `protobuf:"bytes,6,opt,name=availabilityZone,proto3" json:"availabilityZone,omitempty"`
+	// The service level that volume belongs to, required.
+	// TODO: This item will be replace by profile, don't use it.
+	ProfileId string `protobuf:"bytes,7,opt,name=profileId,proto3" json:"profileId,omitempty"`
+	// The uuid of the pool on which volume will be created, required.
+	PoolId string `protobuf:"bytes,8,opt,name=poolId,proto3" json:"poolId,omitempty"`
+	// The name of the pool on which volume will be created, required.
+	PoolName string `protobuf:"bytes,9,opt,name=poolName,proto3" json:"poolName,omitempty"`
+	// The metadata of the primary replication, optional.
+	PrimaryReplicationDriverData map[string]string `protobuf:"bytes,11,rep,name=primaryReplicationDriverData,proto3" json:"primaryReplicationDriverData,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The metadata of the seondary replication, optional.
+	SecondaryReplicationDriverData map[string]string `protobuf:"bytes,12,rep,name=secondaryReplicationDriverData,proto3" json:"secondaryReplicationDriverData,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The dock id.
+	DockId string `protobuf:"bytes,13,opt,name=dockId,proto3" json:"dockId,omitempty"`
+	// The replication driver type.
+	DriverName string `protobuf:"bytes,14,opt,name=driverName,proto3" json:"driverName,omitempty"`
+	// The Context
+	Context string `protobuf:"bytes,15,opt,name=context,proto3" json:"context,omitempty"`
+	// The replication metadata
+	Metadata map[string]string `protobuf:"bytes,16,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Whether is primary replication
+	IsPrimary bool `protobuf:"varint,17,opt,name=isPrimary,proto3" json:"isPrimary,omitempty"`
+	// The Serialized profile
+	Profile string `protobuf:"bytes,18,opt,name=profile,proto3" json:"profile,omitempty"`
+	// The uuid of the operation which tracks this request.
+	OperationId          string   `protobuf:"bytes,19,opt,name=operationId,proto3" json:"operationId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DisableReplicationOpts) Reset()         { *m = DisableReplicationOpts{} }
+func (m *DisableReplicationOpts) String() string { return proto.CompactTextString(m) }
+func (*DisableReplicationOpts) ProtoMessage()    {}
+func (*DisableReplicationOpts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{27}
+}
+
+func (m *DisableReplicationOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DisableReplicationOpts.Unmarshal(m, b)
+}
+func (m *DisableReplicationOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DisableReplicationOpts.Marshal(b, m, deterministic)
+}
+func (m *DisableReplicationOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisableReplicationOpts.Merge(m, src)
+}
+func (m *DisableReplicationOpts) XXX_Size() int {
+	return xxx_messageInfo_DisableReplicationOpts.Size(m)
+}
+func (m *DisableReplicationOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisableReplicationOpts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisableReplicationOpts proto.InternalMessageInfo
+
+func (m *DisableReplicationOpts) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *DisableReplicationOpts) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DisableReplicationOpts) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *DisableReplicationOpts) GetPrimaryVolumeId() string {
+	if m != nil {
+		return m.PrimaryVolumeId
+	}
+	return ""
+}
+
+func (m *DisableReplicationOpts) GetSecondaryVolumeId() string {
+	if m != nil {
+		return m.SecondaryVolumeId
+	}
+	return ""
+}
+
+func (m *DisableReplicationOpts) GetAvailabilityZone() string {
+	if m != nil {
+		return m.AvailabilityZone
+	}
+	return ""
+}
+
+func (m *DisableReplicationOpts) GetProfileId() string {
+	if m != nil {
+		return m.ProfileId
+	}
+	return ""
+}
+
+func (m *DisableReplicationOpts) GetPoolId() string {
+	if m != nil {
+		return m.PoolId
+	}
+	return ""
+}
+
+func (m *DisableReplicationOpts) GetPoolName() string {
+	if m != nil {
+		return m.PoolName
+	}
+	return ""
+}
+
+func (m *DisableReplicationOpts) GetPrimaryReplicationDriverData() map[string]string {
+	if m != nil {
+		return m.PrimaryReplicationDriverData
+	}
+	return nil
+}
+
+func (m *DisableReplicationOpts) GetSecondaryReplicationDriverData() map[string]string {
+	if m != nil {
+		return m.SecondaryReplicationDriverData
+	}
+	return nil
+}
+
+func (m *DisableReplicationOpts) GetDockId() string {
+	if m != nil {
+		return m.DockId
+	}
+	return ""
+}
+
+func (m *DisableReplicationOpts) GetDriverName() string {
+	if m != nil {
+		return m.DriverName
+	}
+	return ""
+}
+
+func (m *DisableReplicationOpts) GetContext() string {
+	if m != nil {
+		return m.Context
+	}
+	return ""
+}
+
+func (m *DisableReplicationOpts) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *DisableReplicationOpts) GetIsPrimary() bool {
+	if m != nil {
+		return m.IsPrimary
+	}
+	return false
+}
+
+func (m *DisableReplicationOpts) GetProfile() string {
+	if m != nil {
+		return m.Profile
+	}
+	return ""
+}
+
+func (m *DisableReplicationOpts) GetOperationId() string {
+	if m != nil {
+		return m.OperationId
+	}
+	return ""
+}
+
+// Delete ReplicationOpts is a structure which indicates all required properties
+type FailoverReplicationOpts struct {
+	// The uuid of the replication, optional when creating.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The name of the replication, optional.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The description of the replication, optional.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// The uuid of the primary volume. This field is required.
+	PrimaryVolumeId string `protobuf:"bytes,4,opt,name=primaryVolumeId,proto3" json:"primaryVolumeId,omitempty"`
+	// The uuid of the secondary volume. This field is required.
+	SecondaryVolumeId string `protobuf:"bytes,5,opt,name=secondaryVolumeId,proto3" json:"secondaryVolumeId,omitempty"`
+	// The dock infomation on which the request will be executed
+	AvailabilityZone string `protobuf:"bytes,6,opt,name=availabilityZone,proto3" json:"availabilityZone,omitempty"`
+	// The service level that volume belongs to, required.
+	// TODO: This item will be replace by profile, don't use it.
+	ProfileId string `protobuf:"bytes,7,opt,name=profileId,proto3" json:"profileId,omitempty"`
+	// The uuid of the pool on which volume will be created, required.
+	PoolId string `protobuf:"bytes,8,opt,name=poolId,proto3" json:"poolId,omitempty"`
+	// The name of the pool on which volume will be created, required.
+	PoolName string `protobuf:"bytes,9,opt,name=poolName,proto3" json:"poolName,omitempty"`
+	// The metadata of the primary replication, optional.
+	PrimaryReplicationDriverData map[string]string `protobuf:"bytes,11,rep,name=primaryReplicationDriverData,proto3" json:"primaryReplicationDriverData,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The metadata of the seondary replication, optional.
+	SecondaryReplicationDriverData map[string]string `protobuf:"bytes,12,rep,name=secondaryReplicationDriverData,proto3" json:"secondaryReplicationDriverData,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The dock id.
+	DockId string `protobuf:"bytes,13,opt,name=dockId,proto3" json:"dockId,omitempty"`
+	// The replication driver type.
+	DriverName string `protobuf:"bytes,14,opt,name=driverName,proto3" json:"driverName,omitempty"`
+	// The Context
+	Context string `protobuf:"bytes,15,opt,name=context,proto3" json:"context,omitempty"`
+	// Allow attached volume
+	AllowAttachedVolume bool `protobuf:"varint,16,opt,name=allowAttachedVolume,proto3" json:"allowAttachedVolume,omitempty"`
+	// The secondary backend id.
+	SecondaryBackendId string `protobuf:"bytes,17,opt,name=secondaryBackendId,proto3" json:"secondaryBackendId,omitempty"`
+	// The replication metadata
+	Metadata map[string]string `protobuf:"bytes,18,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Whether is primary replication
+	IsPrimary bool `protobuf:"varint,19,opt,name=isPrimary,proto3" json:"isPrimary,omitempty"`
+	// The Serialized profile
+	Profile string `protobuf:"bytes,20,opt,name=profile,proto3" json:"profile,omitempty"`
+	// The uuid of the operation which tracks this request.
+	OperationId          string   `protobuf:"bytes,21,opt,name=operationId,proto3" json:"operationId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FailoverReplicationOpts) Reset()         { *m = FailoverReplicationOpts{} }
+func (m *FailoverReplicationOpts) String() string { return proto.CompactTextString(m) }
+func (*FailoverReplicationOpts) ProtoMessage()    {}
+func (*FailoverReplicationOpts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{28}
+}
+
+func (m *FailoverReplicationOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FailoverReplicationOpts.Unmarshal(m, b)
+}
+func (m *FailoverReplicationOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FailoverReplicationOpts.Marshal(b, m, deterministic)
+}
+func (m *FailoverReplicationOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FailoverReplicationOpts.Merge(m, src)
+}
+func (m *FailoverReplicationOpts) XXX_Size() int {
+	return xxx_messageInfo_FailoverReplicationOpts.Size(m)
+}
+func (m *FailoverReplicationOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_FailoverReplicationOpts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FailoverReplicationOpts proto.InternalMessageInfo
+
+func (m *FailoverReplicationOpts) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *FailoverReplicationOpts) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *FailoverReplicationOpts) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *FailoverReplicationOpts) GetPrimaryVolumeId() string {
+	if m != nil {
+		return m.PrimaryVolumeId
+	}
+	return ""
+}
+
+func (m *FailoverReplicationOpts) GetSecondaryVolumeId() string {
+	if m != nil {
+		return m.SecondaryVolumeId
+	}
+	return ""
+}
+
+func (m *FailoverReplicationOpts) GetAvailabilityZone() string {
+	if m != nil {
+		return m.AvailabilityZone
+	}
+	return ""
+}
+
+func (m *FailoverReplicationOpts) GetProfileId() string {
+	if m != nil {
+		return m.ProfileId
+	}
+	return ""
+}
+
+func (m *FailoverReplicationOpts) GetPoolId() string {
+	if m != nil {
+		return m.PoolId
+	}
+	return ""
+}
+
+func (m *FailoverReplicationOpts) GetPoolName() string {
+	if m != nil {
+		return m.PoolName
+	}
+	return ""
+}
+
+func (m *FailoverReplicationOpts) GetPrimaryReplicationDriverData() map[string]string {
+	if m != nil {
+		return m.PrimaryReplicationDriverData
+	}
+	return nil
+}
+
+func (m *FailoverReplicationOpts) GetSecondaryReplicationDriverData() map[string]string {
+	if m != nil {
+		return m.SecondaryReplicationDriverData
+	}
+	return nil
+}
+
+func (m *FailoverReplicationOpts) GetDockId() string {
+	if m != nil {
+		return m.DockId
+	}
+	return ""
+}
+
+func (m *FailoverReplicationOpts) GetDriverName() string {
+	if m != nil {
+		return m.DriverName
+	}
+	return ""
+}
+
+func (m *FailoverReplicationOpts) GetContext() string {
+	if m != nil {
+		return m.Context
+	}
+	return ""
+}
+
+func (m *FailoverReplicationOpts) GetAllowAttachedVolume() bool {
+	if m != nil {
+		return m.AllowAttachedVolume
+	}
+	return false
+}
+
+func (m *FailoverReplicationOpts) GetSecondaryBackendId() string {
+	if m != nil {
+		return m.SecondaryBackendId
+	}
+	return ""
+}
+
+func (m *FailoverReplicationOpts) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *FailoverReplicationOpts) GetIsPrimary() bool {
+	if m != nil {
+		return m.IsPrimary
+	}
+	return false
+}
+
+func (m *FailoverReplicationOpts) GetProfile() string {
+	if m != nil {
+		return m.Profile
+	}
+	return ""
+}
+
+func (m *FailoverReplicationOpts) GetOperationId() string {
+	if m != nil {
+		return m.OperationId
+	}
+	return ""
+}
+
+type FailoverReplicationOpts_FailoverRequest struct {
+	AllowAttachedVolume  bool     `protobuf:"varint,1,opt,name=allowAttachedVolume,proto3" json:"allowAttachedVolume,omitempty"`
+	SecondaryBackendId   string   `protobuf:"bytes,2,opt,name=secondaryBackendId,proto3" json:"secondaryBackendId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FailoverReplicationOpts_FailoverRequest) Reset() {
+	*m = FailoverReplicationOpts_FailoverRequest{}
+}
+func (m *FailoverReplicationOpts_FailoverRequest) String() string { return proto.CompactTextString(m) }
+func (*FailoverReplicationOpts_FailoverRequest) ProtoMessage()    {}
+func (*FailoverReplicationOpts_FailoverRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{28, 3}
+}
+
+func (m *FailoverReplicationOpts_FailoverRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FailoverReplicationOpts_FailoverRequest.Unmarshal(m, b)
+}
+func (m *FailoverReplicationOpts_FailoverRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FailoverReplicationOpts_FailoverRequest.Marshal(b, m, deterministic)
+}
+func (m *FailoverReplicationOpts_FailoverRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FailoverReplicationOpts_FailoverRequest.Merge(m, src)
+}
+func (m *FailoverReplicationOpts_FailoverRequest) XXX_Size() int {
+	return xxx_messageInfo_FailoverReplicationOpts_FailoverRequest.Size(m)
+}
+func (m *FailoverReplicationOpts_FailoverRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FailoverReplicationOpts_FailoverRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FailoverReplicationOpts_FailoverRequest proto.InternalMessageInfo
+
+func (m *FailoverReplicationOpts_FailoverRequest) GetAllowAttachedVolume() bool {
+	if m != nil {
+		return m.AllowAttachedVolume
+	}
+	return false
+}
+
+func (m *FailoverReplicationOpts_FailoverRequest) GetSecondaryBackendId() string {
+	if m != nil {
+		return m.SecondaryBackendId
+	}
+	return ""
+}
+
+// CreateVolumeGroupOpts is a structure which indicates all required
+// properties for creating a volume group.
+type CreateVolumeGroupOpts struct {
+	// The uuid of the volume group, optional when creating.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The name of the volume, required.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The driver of the volume group.
+	DriverName string `protobuf:"bytes,3,opt,name=driverName,proto3" json:"driverName,omitempty"`
+	// The description of the volume, optional.
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// When create volume from snapshot, this field is required.
+	AvailabilityZone string `protobuf:"bytes,5,opt,name=availabilityZone,proto3" json:"availabilityZone,omitempty"`
+	// The addVolumes contain UUIDs of volumes to be added to the group.
+	AddVolumes []string `protobuf:"bytes,6,rep,name=addVolumes,proto3" json:"addVolumes,omitempty"`
+	// The removeVolumes contains the volumes to be removed from the group.
+	RemoveVolumes []string `protobuf:"bytes,7,rep,name=removeVolumes,proto3" json:"removeVolumes,omitempty"`
+	// The pool belongs to the group.
+	PoolId string `protobuf:"bytes,8,opt,name=poolId,proto3" json:"poolId,omitempty"`
+	// The Context
+	Context string `protobuf:"bytes,9,opt,name=context,proto3" json:"context,omitempty"`
+	// The uuid of the operation which tracks this request.
+	OperationId string `protobuf:"bytes,10,opt,name=operationId,proto3" json:"operationId,omitempty"`
+	// The uuid of the group snapshot which the group is created from,
+	// optional.
+	GroupSnapshotId string `protobuf:"bytes,11,opt,name=groupSnapshotId,proto3" json:"groupSnapshotId,omitempty"`
+	// The volumes to be added to the group, filled in by the dock.
+	AddVolumesRef        []*VolumeRef `protobuf:"bytes,12,rep,name=addVolumesRef,proto3" json:"addVolumesRef,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *CreateVolumeGroupOpts) Reset()         { *m = CreateVolumeGroupOpts{} }
+func (m *CreateVolumeGroupOpts) String() string { return proto.CompactTextString(m) }
+func (*CreateVolumeGroupOpts) ProtoMessage()    {}
+func (*CreateVolumeGroupOpts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{29}
+}
+
+func (m *CreateVolumeGroupOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateVolumeGroupOpts.Unmarshal(m, b)
+}
+func (m *CreateVolumeGroupOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateVolumeGroupOpts.Marshal(b, m, deterministic)
+}
+func (m *CreateVolumeGroupOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateVolumeGroupOpts.Merge(m, src)
+}
+func (m *CreateVolumeGroupOpts) XXX_Size() int {
+	return xxx_messageInfo_CreateVolumeGroupOpts.Size(m)
+}
+func (m *CreateVolumeGroupOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateVolumeGroupOpts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateVolumeGroupOpts proto.InternalMessageInfo
+
+func (m *CreateVolumeGroupOpts) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CreateVolumeGroupOpts) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateVolumeGroupOpts) GetDriverName() string {
+	if m != nil {
+		return m.DriverName
+	}
+	return ""
+}
+
+func (m *CreateVolumeGroupOpts) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *CreateVolumeGroupOpts) GetAvailabilityZone() string {
+	if m != nil {
+		return m.AvailabilityZone
+	}
+	return ""
+}
+
+func (m *CreateVolumeGroupOpts) GetAddVolumes() []string {
+	if m != nil {
+		return m.AddVolumes
+	}
+	return nil
+}
+
+func (m *CreateVolumeGroupOpts) GetRemoveVolumes() []string {
+	if m != nil {
+		return m.RemoveVolumes
+	}
+	return nil
+}
+
+func (m *CreateVolumeGroupOpts) GetPoolId() string {
+	if m != nil {
+		return m.PoolId
+	}
+	return ""
+}
+
+func (m *CreateVolumeGroupOpts) GetContext() string {
+	if m != nil {
+		return m.Context
+	}
+	return ""
+}
+
+func (m *CreateVolumeGroupOpts) GetOperationId() string {
+	if m != nil {
+		return m.OperationId
+	}
+	return ""
+}
+
+func (m *CreateVolumeGroupOpts) GetGroupSnapshotId() string {
+	if m != nil {
+		return m.GroupSnapshotId
+	}
+	return ""
+}
+
+func (m *CreateVolumeGroupOpts) GetAddVolumesRef() []*VolumeRef {
+	if m != nil {
+		return m.AddVolumesRef
+	}
+	return nil
+}
+
+type UpdateVolumeGroupOpts struct {
+	// The uuid of the volume group, optional when updating.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The driver of the volume group.
+	DriverName string `protobuf:"bytes,2,opt,name=driverName,proto3" json:"driverName,omitempty"`
+	// The addVolumes contain UUIDs of volumes to be added to the group.
+	AddVolumes []string `protobuf:"bytes,3,rep,name=addVolumes,proto3" json:"addVolumes,omitempty"`
+	// The removeVolumes contains the volumes to be removed from the group.
+	RemoveVolumes []string `protobuf:"bytes,4,rep,name=removeVolumes,proto3" json:"removeVolumes,omitempty"`
+	// The pool belongs to the group.
+	PoolId string `protobuf:"bytes,5,opt,name=poolId,proto3" json:"poolId,omitempty"`
+	// The Context
+	Context string `protobuf:"bytes,6,opt,name=context,proto3" json:"context,omitempty"`
+	// The uuid of the operation which tracks this request.
+	OperationId string `protobuf:"bytes,7,opt,name=operationId,proto3" json:"operationId,omitempty"`
+	// The volumes to be added to the group, filled in by the dock.
+	AddVolumesRef []*VolumeRef `protobuf:"bytes,8,rep,name=addVolumesRef,proto3" json:"addVolumesRef,omitempty"`
+	// The volumes to be removed from the group, filled in by the dock.
+	RemoveVolumesRef []*VolumeRef `protobuf:"bytes,9,rep,name=removeVolumesRef,proto3" json:"removeVolumesRef,omitempty"`
+	// The metadata of the volume group.
+	Metadata             map[string]string `protobuf:"bytes,10,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *UpdateVolumeGroupOpts) Reset()         { *m = UpdateVolumeGroupOpts{} }
+func (m *UpdateVolumeGroupOpts) String() string { return proto.CompactTextString(m) }
+func (*UpdateVolumeGroupOpts) ProtoMessage()    {}
+func (*UpdateVolumeGroupOpts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{30}
+}
+
+func (m *UpdateVolumeGroupOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateVolumeGroupOpts.Unmarshal(m, b)
+}
+func (m *UpdateVolumeGroupOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateVolumeGroupOpts.Marshal(b, m, deterministic)
+}
+func (m *UpdateVolumeGroupOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateVolumeGroupOpts.Merge(m, src)
+}
+func (m *UpdateVolumeGroupOpts) XXX_Size() int {
+	return xxx_messageInfo_UpdateVolumeGroupOpts.Size(m)
+}
+func (m *UpdateVolumeGroupOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateVolumeGroupOpts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateVolumeGroupOpts proto.InternalMessageInfo
+
+func (m *UpdateVolumeGroupOpts) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *UpdateVolumeGroupOpts) GetDriverName() string {
+	if m != nil {
+		return m.DriverName
+	}
+	return ""
+}
+
+func (m *UpdateVolumeGroupOpts) GetAddVolumes() []string {
+	if m != nil {
+		return m.AddVolumes
+	}
+	return nil
+}
+
+func (m *UpdateVolumeGroupOpts) GetRemoveVolumes() []string {
+	if m != nil {
+		return m.RemoveVolumes
+	}
+	return nil
+}
+
+func (m *UpdateVolumeGroupOpts) GetPoolId() string {
+	if m != nil {
+		return m.PoolId
+	}
+	return ""
+}
+
+func (m *UpdateVolumeGroupOpts) GetContext() string {
+	if m != nil {
+		return m.Context
+	}
+	return ""
+}
+
+func (m *UpdateVolumeGroupOpts) GetOperationId() string {
+	if m != nil {
+		return m.OperationId
+	}
+	return ""
+}
+
+func (m *UpdateVolumeGroupOpts) GetAddVolumesRef() []*VolumeRef {
+	if m != nil {
+		return m.AddVolumesRef
+	}
+	return nil
+}
+
+func (m *UpdateVolumeGroupOpts) GetRemoveVolumesRef() []*VolumeRef {
+	if m != nil {
+		return m.RemoveVolumesRef
+	}
+	return nil
+}
+
+func (m *UpdateVolumeGroupOpts) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+type DeleteVolumeGroupOpts struct {
+	// The uuid of the volume group, optional when deleting.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The pool belongs to the group.
+	PoolId string `protobuf:"bytes,2,opt,name=poolId,proto3" json:"poolId,omitempty"`
+	// The driver of the volume group.
+	DriverName string `protobuf:"bytes,3,opt,name=driverName,proto3" json:"driverName,omitempty"`
+	// The Context
+	Context string `protobuf:"bytes,4,opt,name=context,proto3" json:"context,omitempty"`
+	// The uuid of the operation which tracks this request.
+	OperationId string `protobuf:"bytes,5,opt,name=operationId,proto3" json:"operationId,omitempty"`
+	// The volumes in the group, filled in by the dock.
+	VolumesRef []*VolumeRef `protobuf:"bytes,6,rep,name=volumesRef,proto3" json:"volumesRef,omitempty"`
+	// The metadata of the volume group.
+	Metadata             map[string]string `protobuf:"bytes,7,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DeleteVolumeGroupOpts) Reset()         { *m = DeleteVolumeGroupOpts{} }
+func (m *DeleteVolumeGroupOpts) String() string { return proto.CompactTextString(m) }
+func (*DeleteVolumeGroupOpts) ProtoMessage()    {}
+func (*DeleteVolumeGroupOpts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{31}
+}
+
+func (m *DeleteVolumeGroupOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteVolumeGroupOpts.Unmarshal(m, b)
+}
+func (m *DeleteVolumeGroupOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteVolumeGroupOpts.Marshal(b, m, deterministic)
+}
+func (m *DeleteVolumeGroupOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteVolumeGroupOpts.Merge(m, src)
+}
+func (m *DeleteVolumeGroupOpts) XXX_Size() int {
+	return xxx_messageInfo_DeleteVolumeGroupOpts.Size(m)
+}
+func (m *DeleteVolumeGroupOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteVolumeGroupOpts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteVolumeGroupOpts proto.InternalMessageInfo
+
+func (m *DeleteVolumeGroupOpts) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *DeleteVolumeGroupOpts) GetPoolId() string {
+	if m != nil {
+		return m.PoolId
+	}
+	return ""
+}
+
+func (m *DeleteVolumeGroupOpts) GetDriverName() string {
+	if m != nil {
+		return m.DriverName
+	}
+	return ""
+}
+
+func (m *DeleteVolumeGroupOpts) GetContext() string {
+	if m != nil {
+		return m.Context
+	}
+	return ""
+}
+
+func (m *DeleteVolumeGroupOpts) GetOperationId() string {
+	if m != nil {
+		return m.OperationId
+	}
+	return ""
+}
+
+func (m *DeleteVolumeGroupOpts) GetVolumesRef() []*VolumeRef {
+	if m != nil {
+		return m.VolumesRef
+	}
+	return nil
+}
+
+func (m *DeleteVolumeGroupOpts) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+// VolumeRef is a structure which indicates the properties of a volume which
+// the driver needs to put it into or take it out of a volume group.
+type VolumeRef struct {
+	// The uuid of the volume.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The name of the volume.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The status of the volume.
+	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	// The metadata of the volume.
+	Metadata             map[string]string `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *VolumeRef) Reset()         { *m = VolumeRef{} }
+func (m *VolumeRef) String() string { return proto.CompactTextString(m) }
+func (*VolumeRef) ProtoMessage()    {}
+func (*VolumeRef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{32}
+}
+
+func (m *VolumeRef) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VolumeRef.Unmarshal(m, b)
+}
+func (m *VolumeRef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VolumeRef.Marshal(b, m, deterministic)
+}
+func (m *VolumeRef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VolumeRef.Merge(m, src)
+}
+func (m *VolumeRef) XXX_Size() int {
+	return xxx_messageInfo_VolumeRef.Size(m)
+}
+func (m *VolumeRef) XXX_DiscardUnknown() {
+	xxx_messageInfo_VolumeRef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VolumeRef proto.InternalMessageInfo
+
+func (m *VolumeRef) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *VolumeRef) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *VolumeRef) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *VolumeRef) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+// CreateGroupSnapshotOpts is a structure which indicates all required
+// properties for creating a snapshot of a volume group.
+type CreateGroupSnapshotOpts struct {
+	// The uuid of the group snapshot, required.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The name of the group snapshot, optional.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The description of the group snapshot, optional.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// The uuid of the volume group, required.
+	GroupId string `protobuf:"bytes,4,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	// The pool belongs to the group.
+	PoolId string `protobuf:"bytes,5,opt,name=poolId,proto3" json:"poolId,omitempty"`
+	// The member snapshots, one for each volume of the group, required.
+	Snapshots []*CreateVolumeSnapshotOpts `protobuf:"bytes,6,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	// The metadata of the group snapshot, optional.
+	Metadata map[string]string `protobuf:"bytes,7,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The storage driver type.
+	DriverName string `protobuf:"bytes,8,opt,name=driverName,proto3" json:"driverName,omitempty"`
+	// The Context
+	Context string `protobuf:"bytes,9,opt,name=context,proto3" json:"context,omitempty"`
+	// The uuid of the operation which tracks this request.
+	OperationId          string   `protobuf:"bytes,10,opt,name=operationId,proto3" json:"operationId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateGroupSnapshotOpts) Reset()         { *m = CreateGroupSnapshotOpts{} }
+func (m *CreateGroupSnapshotOpts) String() string { return proto.CompactTextString(m) }
+func (*CreateGroupSnapshotOpts) ProtoMessage()    {}
+func (*CreateGroupSnapshotOpts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{33}
+}
+
+func (m *CreateGroupSnapshotOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateGroupSnapshotOpts.Unmarshal(m, b)
+}
+func (m *CreateGroupSnapshotOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateGroupSnapshotOpts.Marshal(b, m, deterministic)
+}
+func (m *CreateGroupSnapshotOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateGroupSnapshotOpts.Merge(m, src)
+}
+func (m *CreateGroupSnapshotOpts) XXX_Size() int {
+	return xxx_messageInfo_CreateGroupSnapshotOpts.Size(m)
+}
+func (m *CreateGroupSnapshotOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateGroupSnapshotOpts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateGroupSnapshotOpts proto.InternalMessageInfo
+
+func (m *CreateGroupSnapshotOpts) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CreateGroupSnapshotOpts) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateGroupSnapshotOpts) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *CreateGroupSnapshotOpts) GetGroupId() string {
+	if m != nil {
+		return m.GroupId
+	}
+	return ""
+}
+
+func (m *CreateGroupSnapshotOpts) GetPoolId() string {
+	if m != nil {
+		return m.PoolId
+	}
+	return ""
+}
+
+func (m *CreateGroupSnapshotOpts) GetSnapshots() []*CreateVolumeSnapshotOpts {
+	if m != nil {
+		return m.Snapshots
+	}
+	return nil
+}
+
+func (m *CreateGroupSnapshotOpts) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *CreateGroupSnapshotOpts) GetDriverName() string {
+	if m != nil {
+		return m.DriverName
+	}
+	return ""
+}
+
+func (m *CreateGroupSnapshotOpts) GetContext() string {
+	if m != nil {
+		return m.Context
+	}
+	return ""
+}
+
+func (m *CreateGroupSnapshotOpts) GetOperationId() string {
+	if m != nil {
+		return m.OperationId
+	}
+	return ""
+}
+
+// DeleteGroupSnapshotOpts is a structure which indicates all required
+// properties for deleting a volume group snapshot.
+type DeleteGroupSnapshotOpts struct {
+	// The uuid of the group snapshot, required.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The uuid of the volume group, required.
+	GroupId string `protobuf:"bytes,2,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	// The pool belongs to the group.
+	PoolId string `protobuf:"bytes,3,opt,name=poolId,proto3" json:"poolId,omitempty"`
+	// The member snapshots to be deleted, required.
+	Snapshots []*DeleteVolumeSnapshotOpts `protobuf:"bytes,4,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	// The metadata of the group snapshot, optional.
+	Metadata map[string]string `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The storage driver type.
+	DriverName string `protobuf:"bytes,6,opt,name=driverName,proto3" json:"driverName,omitempty"`
+	// The Context
+	Context string `protobuf:"bytes,7,opt,name=context,proto3" json:"context,omitempty"`
+	// The uuid of the operation which tracks this request.
+	OperationId          string   `protobuf:"bytes,8,opt,name=operationId,proto3" json:"operationId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteGroupSnapshotOpts) Reset()         { *m = DeleteGroupSnapshotOpts{} }
+func (m *DeleteGroupSnapshotOpts) String() string { return proto.CompactTextString(m) }
+func (*DeleteGroupSnapshotOpts) ProtoMessage()    {}
+func (*DeleteGroupSnapshotOpts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{34}
+}
+
+func (m *DeleteGroupSnapshotOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteGroupSnapshotOpts.Unmarshal(m, b)
+}
+func (m *DeleteGroupSnapshotOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteGroupSnapshotOpts.Marshal(b, m, deterministic)
+}
+func (m *DeleteGroupSnapshotOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteGroupSnapshotOpts.Merge(m, src)
+}
+func (m *DeleteGroupSnapshotOpts) XXX_Size() int {
+	return xxx_messageInfo_DeleteGroupSnapshotOpts.Size(m)
+}
+func (m *DeleteGroupSnapshotOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteGroupSnapshotOpts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteGroupSnapshotOpts proto.InternalMessageInfo
+
+func (m *DeleteGroupSnapshotOpts) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *DeleteGroupSnapshotOpts) GetGroupId() string {
+	if m != nil {
+		return m.GroupId
+	}
+	return ""
+}
+
+func (m *DeleteGroupSnapshotOpts) GetPoolId() string {
+	if m != nil {
+		return m.PoolId
+	}
+	return ""
+}
+
+func (m *DeleteGroupSnapshotOpts) GetSnapshots() []*DeleteVolumeSnapshotOpts {
+	if m != nil {
+		return m.Snapshots
+	}
+	return nil
+}
+
+func (m *DeleteGroupSnapshotOpts) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *DeleteGroupSnapshotOpts) GetDriverName() string {
+	if m != nil {
+		return m.DriverName
+	}
+	return ""
+}
+
+func (m *DeleteGroupSnapshotOpts) GetContext() string {
+	if m != nil {
+		return m.Context
+	}
+	return ""
+}
+
+func (m *DeleteGroupSnapshotOpts) GetOperationId() string {
+	if m != nil {
+		return m.OperationId
+	}
+	return ""
+}
+
+// AttachVolumeOpts is a structure which indicates all required
+// properties for attaching a volume.
+type AttachVolumeOpts struct {
+	// The access protocol for attaching a volume.
+	AccessProtocol string `protobuf:"bytes,1,opt,name=accessProtocol,proto3" json:"accessProtocol,omitempty"`
+	// The connectionData for attaching a volume.
+	ConnectionData string `protobuf:"bytes,2,opt,name=connectionData,proto3" json:"connectionData,omitempty"`
+	// The metadata for attaching a volume, optional.
+	Metadata map[string]string `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The Context
+	Context              string   `protobuf:"bytes,4,opt,name=context,proto3" json:"context,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AttachVolumeOpts) Reset()         { *m = AttachVolumeOpts{} }
+func (m *AttachVolumeOpts) String() string { return proto.CompactTextString(m) }
+func (*AttachVolumeOpts) ProtoMessage()    {}
+func (*AttachVolumeOpts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{35}
+}
+
+func (m *AttachVolumeOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AttachVolumeOpts.Unmarshal(m, b)
+}
+func (m *AttachVolumeOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AttachVolumeOpts.Marshal(b, m, deterministic)
+}
+func (m *AttachVolumeOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttachVolumeOpts.Merge(m, src)
+}
+func (m *AttachVolumeOpts) XXX_Size() int {
+	return xxx_messageInfo_AttachVolumeOpts.Size(m)
+}
+func (m *AttachVolumeOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttachVolumeOpts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttachVolumeOpts proto.InternalMessageInfo
+
+func (m *AttachVolumeOpts) GetAccessProtocol() string {
+	if m != nil {
+		return m.AccessProtocol
+	}
+	return ""
+}
+
+func (m *AttachVolumeOpts) GetConnectionData() string {
+	if m != nil {
+		return m.ConnectionData
+	}
+	return ""
+}
+
+func (m *AttachVolumeOpts) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *AttachVolumeOpts) GetContext() string {
+	if m != nil {
+		return m.Context
+	}
+	return ""
+}
+
+// DetachVolumeOpts is a structure which indicates all required
+// properties for detaching a volume.
+type DetachVolumeOpts struct {
+	// The access protocol for detaching a volume.
+	AccessProtocol string `protobuf:"bytes,1,opt,name=accessProtocol,proto3" json:"accessProtocol,omitempty"`
+	// The connectionData for detaching a volume.
+	ConnectionData string `protobuf:"bytes,2,opt,name=connectionData,proto3" json:"connectionData,omitempty"`
+	// The metadata for detaching a volume, optional.
+	Metadata map[string]string `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The Context
+	Context              string   `protobuf:"bytes,4,opt,name=context,proto3" json:"context,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DetachVolumeOpts) Reset()         { *m = DetachVolumeOpts{} }
+func (m *DetachVolumeOpts) String() string { return proto.CompactTextString(m) }
+func (*DetachVolumeOpts) ProtoMessage()    {}
+func (*DetachVolumeOpts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{36}
+}
+
+func (m *DetachVolumeOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DetachVolumeOpts.Unmarshal(m, b)
+}
+func (m *DetachVolumeOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DetachVolumeOpts.Marshal(b, m, deterministic)
+}
+func (m *DetachVolumeOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DetachVolumeOpts.Merge(m, src)
+}
+func (m *DetachVolumeOpts) XXX_Size() int {
+	return xxx_messageInfo_DetachVolumeOpts.Size(m)
+}
+func (m *DetachVolumeOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_DetachVolumeOpts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DetachVolumeOpts proto.InternalMessageInfo
+
+func (m *DetachVolumeOpts) GetAccessProtocol() string {
+	if m != nil {
+		return m.AccessProtocol
+	}
+	return ""
+}
+
+func (m *DetachVolumeOpts) GetConnectionData() string {
+	if m != nil {
+		return m.ConnectionData
+	}
+	return ""
+}
+
+func (m *DetachVolumeOpts) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *DetachVolumeOpts) GetContext() string {
+	if m != nil {
+		return m.Context
+	}
+	return ""
+}
+
+// CopyVolumeOpts is a structure which indicates all required
+// properties for copying data between two attached volumes.
+type CopyVolumeOpts struct {
+	// The device path of the source volume.
+	SrcPath string `protobuf:"bytes,1,opt,name=srcPath,proto3" json:"srcPath,omitempty"`
+	// The device path of the destination volume.
+	DstPath string `protobuf:"bytes,2,opt,name=dstPath,proto3" json:"dstPath,omitempty"`
+	// The capacity of the data to be copied in GB.
+	Size int64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// The Context
+	Context              string   `protobuf:"bytes,4,opt,name=context,proto3" json:"context,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CopyVolumeOpts) Reset()         { *m = CopyVolumeOpts{} }
+func (m *CopyVolumeOpts) String() string { return proto.CompactTextString(m) }
+func (*CopyVolumeOpts) ProtoMessage()    {}
+func (*CopyVolumeOpts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{37}
+}
+
+func (m *CopyVolumeOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CopyVolumeOpts.Unmarshal(m, b)
+}
+func (m *CopyVolumeOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CopyVolumeOpts.Marshal(b, m, deterministic)
+}
+func (m *CopyVolumeOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CopyVolumeOpts.Merge(m, src)
+}
+func (m *CopyVolumeOpts) XXX_Size() int {
+	return xxx_messageInfo_CopyVolumeOpts.Size(m)
+}
+func (m *CopyVolumeOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_CopyVolumeOpts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CopyVolumeOpts proto.InternalMessageInfo
+
+func (m *CopyVolumeOpts) GetSrcPath() string {
+	if m != nil {
+		return m.SrcPath
+	}
+	return ""
+}
+
+func (m *CopyVolumeOpts) GetDstPath() string {
+	if m != nil {
+		return m.DstPath
+	}
+	return ""
+}
+
+func (m *CopyVolumeOpts) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *CopyVolumeOpts) GetContext() string {
+	if m != nil {
+		return m.Context
+	}
+	return ""
+}
+
+// FreezeVolumeOpts is a structure which indicates all required
+// properties for freezing or thawing the file system on a volume.
+type FreezeVolumeOpts struct {
+	// The path where the file system of the volume is mounted.
+	Mountpoint string `protobuf:"bytes,1,opt,name=mountpoint,proto3" json:"mountpoint,omitempty"`
+	// The Context
+	Context              string   `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FreezeVolumeOpts) Reset()         { *m = FreezeVolumeOpts{} }
+func (m *FreezeVolumeOpts) String() string { return proto.CompactTextString(m) }
+func (*FreezeVolumeOpts) ProtoMessage()    {}
+func (*FreezeVolumeOpts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{38}
+}
+
+func (m *FreezeVolumeOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FreezeVolumeOpts.Unmarshal(m, b)
+}
+func (m *FreezeVolumeOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FreezeVolumeOpts.Marshal(b, m, deterministic)
+}
+func (m *FreezeVolumeOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FreezeVolumeOpts.Merge(m, src)
+}
+func (m *FreezeVolumeOpts) XXX_Size() int {
+	return xxx_messageInfo_FreezeVolumeOpts.Size(m)
+}
+func (m *FreezeVolumeOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_FreezeVolumeOpts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FreezeVolumeOpts proto.InternalMessageInfo
+
+func (m *FreezeVolumeOpts) GetMountpoint() string {
+	if m != nil {
+		return m.Mountpoint
+	}
+	return ""
+}
+
+func (m *FreezeVolumeOpts) GetContext() string {
+	if m != nil {
+		return m.Context
+	}
+	return ""
+}
+
+// CreateFileShareOpts is a structure which indicates all required properties for creating a file share.
+type CreateFileShareOpts struct {
+	// The uuid of the file share, optional when creating.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The name of the file share, required.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The requested capacity of the file share, required.
+	Size int64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// The description of the file share, optional.
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// The locality that file share belongs to, required.
+	AvailabilityZone string `protobuf:"bytes,6,opt,name=availabilityZone,proto3" json:"availabilityZone,omitempty"`
+	// The service level that file share belongs to, required.
+	ProfileId string `protobuf:"bytes,7,opt,name=profileId,proto3" json:"profileId,omitempty"`
+	// The uuid of the pool on which file share will be created, required.
+	PoolId string `protobuf:"bytes,8,opt,name=poolId,proto3" json:"poolId,omitempty"`
+	// The name of the pool on which file share will be created, required.
+	PoolName string `protobuf:"bytes,9,opt,name=poolName,proto3" json:"poolName,omitempty"`
+	// The metadata of the file share, optional.
+	Metadata map[string]string `protobuf:"bytes,10,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The storage driver type.
+	DriverName string `protobuf:"bytes,11,opt,name=driverName,proto3" json:"driverName,omitempty"`
+	// The Context
+	Context string `protobuf:"bytes,12,opt,name=context,proto3" json:"context,omitempty"`
+	// The Serialized profile
+	Profile string `protobuf:"bytes,13,opt,name=profile,proto3" json:"profile,omitempty"`
+	// The uuid of the operation which tracks this request.
+	OperationId          string   `protobuf:"bytes,14,opt,name=operationId,proto3" json:"operationId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateFileShareOpts) Reset()         { *m = CreateFileShareOpts{} }
+func (m *CreateFileShareOpts) String() string { return proto.CompactTextString(m) }
+func (*CreateFileShareOpts) ProtoMessage()    {}
+func (*CreateFileShareOpts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{39}
+}
+
+func (m *CreateFileShareOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateFileShareOpts.Unmarshal(m, b)
+}
+func (m *CreateFileShareOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateFileShareOpts.Marshal(b, m, deterministic)
+}
+func (m *CreateFileShareOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateFileShareOpts.Merge(m, src)
+}
+func (m *CreateFileShareOpts) XXX_Size() int {
+	return xxx_messageInfo_CreateFileShareOpts.Size(m)
+}
+func (m *CreateFileShareOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateFileShareOpts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateFileShareOpts proto.InternalMessageInfo
+
+func (m *CreateFileShareOpts) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CreateFileShareOpts) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateFileShareOpts) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *CreateFileShareOpts) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *CreateFileShareOpts) GetAvailabilityZone() string {
+	if m != nil {
+		return m.AvailabilityZone
+	}
+	return ""
+}
+
+func (m *CreateFileShareOpts) GetProfileId() string {
+	if m != nil {
+		return m.ProfileId
+	}
+	return ""
+}
+
+func (m *CreateFileShareOpts) GetPoolId() string {
+	if m != nil {
+		return m.PoolId
+	}
+	return ""
+}
+
+func (m *CreateFileShareOpts) GetPoolName() string {
+	if m != nil {
+		return m.PoolName
+	}
+	return ""
+}
+
+func (m *CreateFileShareOpts) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *CreateFileShareOpts) GetDriverName() string {
+	if m != nil {
+		return m.DriverName
+	}
+	return ""
+}
+
+func (m *CreateFileShareOpts) GetContext() string {
+	if m != nil {
+		return m.Context
+	}
+	return ""
+}
+
+func (m *CreateFileShareOpts) GetProfile() string {
+	if m != nil {
+		return m.Profile
+	}
+	return ""
+}
+
+func (m *CreateFileShareOpts) GetOperationId() string {
+	if m != nil {
+		return m.OperationId
+	}
+	return ""
+}
+
+// DeleteFileShareOpts is a structure which indicates all required properties
+// for deleting a file share.
+type DeleteFileShareOpts struct {
+	// The uuid of the fileshare, required.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The service level that fileshare belongs to, required.
+	// This item will be replace by profile
+	ProfileId string `protobuf:"bytes,2,opt,name=profileId,proto3" json:"profileId,omitempty"`
+	// The uuid of the pool on which fileshare will be created, required.
+	PoolId string `protobuf:"bytes,3,opt,name=poolId,proto3" json:"poolId,omitempty"`
+	// The metadata of the fileshare, optional.
+	Metadata map[string]string `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The storage driver type.
+	DriverName string `protobuf:"bytes,5,opt,name=driverName,proto3" json:"driverName,omitempty"`
+	// The Context
+	Context string `protobuf:"bytes,6,opt,name=context,proto3" json:"context,omitempty"`
+	// The Serialized profile
+	Profile string `protobuf:"bytes,7,opt,name=profile,proto3" json:"profile,omitempty"`
+	// The uuid of the operation which tracks this request.
+	OperationId          string   `protobuf:"bytes,8,opt,name=operationId,proto3" json:"operationId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteFileShareOpts) Reset()         { *m = DeleteFileShareOpts{} }
+func (m *DeleteFileShareOpts) String() string { return proto.CompactTextString(m) }
+func (*DeleteFileShareOpts) ProtoMessage()    {}
+func (*DeleteFileShareOpts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{40}
+}
+
+func (m *DeleteFileShareOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteFileShareOpts.Unmarshal(m, b)
+}
+func (m *DeleteFileShareOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteFileShareOpts.Marshal(b, m, deterministic)
+}
+func (m *DeleteFileShareOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteFileShareOpts.Merge(m, src)
+}
+func (m *DeleteFileShareOpts) XXX_Size() int {
+	return xxx_messageInfo_DeleteFileShareOpts.Size(m)
+}
+func (m *DeleteFileShareOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteFileShareOpts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteFileShareOpts proto.InternalMessageInfo
+
+func (m *DeleteFileShareOpts) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *DeleteFileShareOpts) GetProfileId() string {
+	if m != nil {
+		return m.ProfileId
+	}
+	return ""
+}
+
+func (m *DeleteFileShareOpts) GetPoolId() string {
+	if m != nil {
+		return m.PoolId
+	}
+	return ""
+}
+
+func (m *DeleteFileShareOpts) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *DeleteFileShareOpts) GetDriverName() string {
+	if m != nil {
+		return m.DriverName
+	}
+	return ""
+}
+
+func (m *DeleteFileShareOpts) GetContext() string {
+	if m != nil {
+		return m.Context
+	}
+	return ""
+}
+
+func (m *DeleteFileShareOpts) GetProfile() string {
+	if m != nil {
+		return m.Profile
+	}
+	return ""
+}
+
+func (m *DeleteFileShareOpts) GetOperationId() string {
+	if m != nil {
+		return m.OperationId
+	}
+	return ""
+}
+
+// CreateFileShareSnapshotOpts is a structure which indicates all required
+// properties for creating a file share snapshot.
+type CreateFileShareSnapshotOpts struct {
+	// The uuid of the file share snapshot, required.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The name of the file share snapshot, required.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The description of the file share snapshot, optional.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// The uuid of the file share that snapshot belongs to, required.
+	FileshareId string `protobuf:"bytes,4,opt,name=fileshareId,proto3" json:"fileshareId,omitempty"`
+	// The size of the file share that snapshot belongs to, required.
+	Size int64 `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	// The metadata of the file share that snapshot belongs to, optional.
+	Metadata map[string]string `protobuf:"bytes,6,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The storage driver type.
+	DriverName string `protobuf:"bytes,7,opt,name=driverName,proto3" json:"driverName,omitempty"`
+	// The Context
+	Context string `protobuf:"bytes,8,opt,name=context,proto3" json:"context,omitempty"`
+	// The uuid of the operation which tracks this request.
+	OperationId          string   `protobuf:"bytes,9,opt,name=operationId,proto3" json:"operationId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateFileShareSnapshotOpts) Reset()         { *m = CreateFileShareSnapshotOpts{} }
+func (m *CreateFileShareSnapshotOpts) String() string { return proto.CompactTextString(m) }
+func (*CreateFileShareSnapshotOpts) ProtoMessage()    {}
+func (*CreateFileShareSnapshotOpts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{41}
+}
+
+func (m *CreateFileShareSnapshotOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateFileShareSnapshotOpts.Unmarshal(m, b)
+}
+func (m *CreateFileShareSnapshotOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateFileShareSnapshotOpts.Marshal(b, m, deterministic)
+}
+func (m *CreateFileShareSnapshotOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateFileShareSnapshotOpts.Merge(m, src)
+}
+func (m *CreateFileShareSnapshotOpts) XXX_Size() int {
+	return xxx_messageInfo_CreateFileShareSnapshotOpts.Size(m)
+}
+func (m *CreateFileShareSnapshotOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateFileShareSnapshotOpts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateFileShareSnapshotOpts proto.InternalMessageInfo
+
+func (m *CreateFileShareSnapshotOpts) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CreateFileShareSnapshotOpts) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateFileShareSnapshotOpts) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *CreateFileShareSnapshotOpts) GetFileshareId() string {
+	if m != nil {
+		return m.FileshareId
+	}
+	return ""
+}
+
+func (m *CreateFileShareSnapshotOpts) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *CreateFileShareSnapshotOpts) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *CreateFileShareSnapshotOpts) GetDriverName() string {
+	if m != nil {
+		return m.DriverName
+	}
+	return ""
+}
+
+func (m *CreateFileShareSnapshotOpts) GetContext() string {
+	if m != nil {
+		return m.Context
+	}
+	return ""
+}
+
+func (m *CreateFileShareSnapshotOpts) GetOperationId() string {
+	if m != nil {
+		return m.OperationId
+	}
+	return ""
+}
+
+// DeleteFileShareSnapshotOpts is a structure which indicates all required
+// properties for deleting a file share snapshot.
+type DeleteFileShareSnapshotOpts struct {
+	// The uuid of the file share snapshot, required.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The uuid of the file share that snapshot belongs to, required.
+	FileshareId string `protobuf:"bytes,2,opt,name=fileshareId,proto3" json:"fileshareId,omitempty"`
+	// The metadata of the file share snapshot, optional.
+	Metadata map[string]string `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The storage driver type.
+	DriverName string `protobuf:"bytes,4,opt,name=driverName,proto3" json:"driverName,omitempty"`
+	// The Context
+	Context string `protobuf:"bytes,5,opt,name=context,proto3" json:"context,omitempty"`
+	// The uuid of the operation which tracks this request.
+	OperationId          string   `protobuf:"bytes,6,opt,name=operationId,proto3" json:"operationId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteFileShareSnapshotOpts) Reset()         { *m = DeleteFileShareSnapshotOpts{} }
+func (m *DeleteFileShareSnapshotOpts) String() string { return proto.CompactTextString(m) }
+func (*DeleteFileShareSnapshotOpts) ProtoMessage()    {}
+func (*DeleteFileShareSnapshotOpts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{42}
+}
+
+func (m *DeleteFileShareSnapshotOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteFileShareSnapshotOpts.Unmarshal(m, b)
+}
+func (m *DeleteFileShareSnapshotOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteFileShareSnapshotOpts.Marshal(b, m, deterministic)
+}
+func (m *DeleteFileShareSnapshotOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteFileShareSnapshotOpts.Merge(m, src)
+}
+func (m *DeleteFileShareSnapshotOpts) XXX_Size() int {
+	return xxx_messageInfo_DeleteFileShareSnapshotOpts.Size(m)
+}
+func (m *DeleteFileShareSnapshotOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteFileShareSnapshotOpts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteFileShareSnapshotOpts proto.InternalMessageInfo
+
+func (m *DeleteFileShareSnapshotOpts) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *DeleteFileShareSnapshotOpts) GetFileshareId() string {
+	if m != nil {
+		return m.FileshareId
+	}
+	return ""
+}
+
+func (m *DeleteFileShareSnapshotOpts) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *DeleteFileShareSnapshotOpts) GetDriverName() string {
+	if m != nil {
+		return m.DriverName
+	}
+	return ""
+}
+
+func (m *DeleteFileShareSnapshotOpts) GetContext() string {
+	if m != nil {
+		return m.Context
+	}
+	return ""
+}
+
+func (m *DeleteFileShareSnapshotOpts) GetOperationId() string {
+	if m != nil {
+		return m.OperationId
+	}
+	return ""
+}
+
+// CreateFileShareAclOpts is a structure which indicates all required
+// properties for granting the access to a file share.
+type CreateFileShareAclOpts struct {
+	// The uuid of the file share acl, required.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The uuid of the file share that acl belongs to, required.
+	FileshareId string `protobuf:"bytes,2,opt,name=fileshareId,proto3" json:"fileshareId,omitempty"`
+	// The type of the access, required. Ex: ip.
+	Type string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	// The clients which are granted the access, required.
+	AccessTo []string `protobuf:"bytes,4,rep,name=accessTo,proto3" json:"accessTo,omitempty"`
+	// The capabilities of the access, required. Ex: Read, Write.
+	AccessCapability []string `protobuf:"bytes,5,rep,name=accessCapability,proto3" json:"accessCapability,omitempty"`
+	// The description of the file share acl, optional.
+	Description string `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	// The metadata of the file share that acl belongs to, optional.
+	Metadata map[string]string `protobuf:"bytes,7,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The storage driver type.
+	DriverName string `protobuf:"bytes,8,opt,name=driverName,proto3" json:"driverName,omitempty"`
+	// The Context
+	Context string `protobuf:"bytes,9,opt,name=context,proto3" json:"context,omitempty"`
+	// The uuid of the operation which tracks this request.
+	OperationId          string   `protobuf:"bytes,10,opt,name=operationId,proto3" json:"operationId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateFileShareAclOpts) Reset()         { *m = CreateFileShareAclOpts{} }
+func (m *CreateFileShareAclOpts) String() string { return proto.CompactTextString(m) }
+func (*CreateFileShareAclOpts) ProtoMessage()    {}
+func (*CreateFileShareAclOpts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{43}
+}
+
+func (m *CreateFileShareAclOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateFileShareAclOpts.Unmarshal(m, b)
+}
+func (m *CreateFileShareAclOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateFileShareAclOpts.Marshal(b, m, deterministic)
+}
+func (m *CreateFileShareAclOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateFileShareAclOpts.Merge(m, src)
+}
+func (m *CreateFileShareAclOpts) XXX_Size() int {
+	return xxx_messageInfo_CreateFileShareAclOpts.Size(m)
+}
+func (m *CreateFileShareAclOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateFileShareAclOpts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateFileShareAclOpts proto.InternalMessageInfo
+
+func (m *CreateFileShareAclOpts) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CreateFileShareAclOpts) GetFileshareId() string {
+	if m != nil {
+		return m.FileshareId
+	}
+	return ""
+}
+
+func (m *CreateFileShareAclOpts) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *CreateFileShareAclOpts) GetAccessTo() []string {
+	if m != nil {
+		return m.AccessTo
+	}
+	return nil
+}
+
+func (m *CreateFileShareAclOpts) GetAccessCapability() []string {
+	if m != nil {
+		return m.AccessCapability
+	}
+	return nil
+}
+
+func (m *CreateFileShareAclOpts) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *CreateFileShareAclOpts) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *CreateFileShareAclOpts) GetDriverName() string {
+	if m != nil {
+		return m.DriverName
+	}
+	return ""
+}
+
+func (m *CreateFileShareAclOpts) GetContext() string {
+	if m != nil {
+		return m.Context
+	}
+	return ""
+}
+
+func (m *CreateFileShareAclOpts) GetOperationId() string {
+	if m != nil {
+		return m.OperationId
+	}
+	return ""
+}
+
+// DeleteFileShareAclOpts is a structure which indicates all required
+// properties for revoking the access to a file share.
+type DeleteFileShareAclOpts struct {
+	// The uuid of the file share acl, required.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The uuid of the file share that acl belongs to, required.
+	FileshareId string `protobuf:"bytes,2,opt,name=fileshareId,proto3" json:"fileshareId,omitempty"`
+	// The metadata of the file share that acl belongs to, optional.
+	Metadata map[string]string `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The storage driver type.
+	DriverName string `protobuf:"bytes,4,opt,name=driverName,proto3" json:"driverName,omitempty"`
+	// The Context
+	Context string `protobuf:"bytes,5,opt,name=context,proto3" json:"context,omitempty"`
+	// The uuid of the operation which tracks this request.
+	OperationId          string   `protobuf:"bytes,6,opt,name=operationId,proto3" json:"operationId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteFileShareAclOpts) Reset()         { *m = DeleteFileShareAclOpts{} }
+func (m *DeleteFileShareAclOpts) String() string { return proto.CompactTextString(m) }
+func (*DeleteFileShareAclOpts) ProtoMessage()    {}
+func (*DeleteFileShareAclOpts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{44}
+}
+
+func (m *DeleteFileShareAclOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteFileShareAclOpts.Unmarshal(m, b)
+}
+func (m *DeleteFileShareAclOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteFileShareAclOpts.Marshal(b, m, deterministic)
+}
+func (m *DeleteFileShareAclOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteFileShareAclOpts.Merge(m, src)
+}
+func (m *DeleteFileShareAclOpts) XXX_Size() int {
+	return xxx_messageInfo_DeleteFileShareAclOpts.Size(m)
+}
+func (m *DeleteFileShareAclOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteFileShareAclOpts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteFileShareAclOpts proto.InternalMessageInfo
+
+func (m *DeleteFileShareAclOpts) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *DeleteFileShareAclOpts) GetFileshareId() string {
+	if m != nil {
+		return m.FileshareId
+	}
+	return ""
+}
+
+func (m *DeleteFileShareAclOpts) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *DeleteFileShareAclOpts) GetDriverName() string {
+	if m != nil {
+		return m.DriverName
+	}
+	return ""
+}
+
+func (m *DeleteFileShareAclOpts) GetContext() string {
+	if m != nil {
+		return m.Context
+	}
+	return ""
+}
+
+func (m *DeleteFileShareAclOpts) GetOperationId() string {
+	if m != nil {
+		return m.OperationId
+	}
+	return ""
+}
+
+// Generic response, it return:
+// 1. Return result with message when create/update resource successfully.
+// 2. Return result without message when delete resource successfully.
+// 3. Return Error with error code and message when operate unsuccessfully.
+type GenericResponse struct {
+	// Types that are valid to be assigned to Reply:
+	//	*GenericResponse_Result_
+	//	*GenericResponse_Error_
+	Reply                isGenericResponse_Reply `protobuf_oneof:"reply"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *GenericResponse) Reset()         { *m = GenericResponse{} }
+func (m *GenericResponse) String() string { return proto.CompactTextString(m) }
+func (*GenericResponse) ProtoMessage()    {}
+func (*GenericResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{45}
+}
+
+func (m *GenericResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GenericResponse.Unmarshal(m, b)
+}
+func (m *GenericResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GenericResponse.Marshal(b, m, deterministic)
+}
+func (m *GenericResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenericResponse.Merge(m, src)
+}
+func (m *GenericResponse) XXX_Size() int {
+	return xxx_messageInfo_GenericResponse.Size(m)
+}
+func (m *GenericResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenericResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenericResponse proto.InternalMessageInfo
+
+type isGenericResponse_Reply interface {
+	isGenericResponse_Reply()
+}
+
+type GenericResponse_Result_ struct {
+	Result *GenericResponse_Result `protobuf:"bytes,1,opt,name=result,proto3,oneof"`
+}
+
+type GenericResponse_Error_ struct {
+	Error *GenericResponse_Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*GenericResponse_Result_) isGenericResponse_Reply() {}
+
+func (*GenericResponse_Error_) isGenericResponse_Reply() {}
+
+func (m *GenericResponse) GetReply() isGenericResponse_Reply {
+	if m != nil {
+		return m.Reply
+	}
+	return nil
+}
+
+func (m *GenericResponse) GetResult() *GenericResponse_Result {
+	if x, ok := m.GetReply().(*GenericResponse_Result_); ok {
+		return x.Result
+	}
+	return nil
+}
+
+func (m *GenericResponse) GetError() *GenericResponse_Error {
+	if x, ok := m.GetReply().(*GenericResponse_Error_); ok {
+		return x.Error
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*GenericResponse) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*GenericResponse_Result_)(nil),
+		(*GenericResponse_Error_)(nil),
+	}
+}
+
+type GenericResponse_Result struct {
+	Message              string   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GenericResponse_Result) Reset()         { *m = GenericResponse_Result{} }
+func (m *GenericResponse_Result) String() string { return proto.CompactTextString(m) }
+func (*GenericResponse_Result) ProtoMessage()    {}
+func (*GenericResponse_Result) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{45, 0}
+}
+
+func (m *GenericResponse_Result) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GenericResponse_Result.Unmarshal(m, b)
+}
+func (m *GenericResponse_Result) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GenericResponse_Result.Marshal(b, m, deterministic)
+}
+func (m *GenericResponse_Result) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenericResponse_Result.Merge(m, src)
+}
+func (m *GenericResponse_Result) XXX_Size() int {
+	return xxx_messageInfo_GenericResponse_Result.Size(m)
+}
+func (m *GenericResponse_Result) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenericResponse_Result.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenericResponse_Result proto.InternalMessageInfo
+
+func (m *GenericResponse_Result) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type GenericResponse_Error struct {
+	Code                 string   `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GenericResponse_Error) Reset()         { *m = GenericResponse_Error{} }
+func (m *GenericResponse_Error) String() string { return proto.CompactTextString(m) }
+func (*GenericResponse_Error) ProtoMessage()    {}
+func (*GenericResponse_Error) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{45, 1}
+}
+
+func (m *GenericResponse_Error) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GenericResponse_Error.Unmarshal(m, b)
+}
+func (m *GenericResponse_Error) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GenericResponse_Error.Marshal(b, m, deterministic)
+}
+func (m *GenericResponse_Error) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenericResponse_Error.Merge(m, src)
+}
+func (m *GenericResponse_Error) XXX_Size() int {
+	return xxx_messageInfo_GenericResponse_Error.Size(m)
+}
+func (m *GenericResponse_Error) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenericResponse_Error.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenericResponse_Error proto.InternalMessageInfo
+
+func (m *GenericResponse_Error) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *GenericResponse_Error) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+type GetMetricsOpts struct {
+	InstanceId           string   `protobuf:"bytes,1,opt,name=instanceId,proto3" json:"instanceId,omitempty"`
+	MetricName           string   `protobuf:"bytes,2,opt,name=metricName,proto3" json:"metricName,omitempty"`
+	StartTime            string   `protobuf:"bytes,3,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime              string   `protobuf:"bytes,4,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	Context              string   `protobuf:"bytes,5,opt,name=context,proto3" json:"context,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetMetricsOpts) Reset()         { *m = GetMetricsOpts{} }
+func (m *GetMetricsOpts) String() string { return proto.CompactTextString(m) }
+func (*GetMetricsOpts) ProtoMessage()    {}
+func (*GetMetricsOpts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{46}
+}
+
+func (m *GetMetricsOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMetricsOpts.Unmarshal(m, b)
+}
+func (m *GetMetricsOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMetricsOpts.Marshal(b, m, deterministic)
+}
+func (m *GetMetricsOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMetricsOpts.Merge(m, src)
+}
+func (m *GetMetricsOpts) XXX_Size() int {
+	return xxx_messageInfo_GetMetricsOpts.Size(m)
+}
+func (m *GetMetricsOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMetricsOpts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMetricsOpts proto.InternalMessageInfo
+
+func (m *GetMetricsOpts) GetInstanceId() string {
+	if m != nil {
+		return m.InstanceId
+	}
+	return ""
+}
+
+func (m *GetMetricsOpts) GetMetricName() string {
+	if m != nil {
+		return m.MetricName
+	}
+	return ""
+}
+
+func (m *GetMetricsOpts) GetStartTime() string {
+	if m != nil {
+		return m.StartTime
+	}
+	return ""
+}
+
+func (m *GetMetricsOpts) GetEndTime() string {
+	if m != nil {
+		return m.EndTime
+	}
+	return ""
+}
+
+func (m *GetMetricsOpts) GetContext() string {
+	if m != nil {
+		return m.Context
+	}
+	return ""
+}
+
+// HeartbeatOpts is a structure which is sent periodically by the dock to
+// show that it is still alive.
+type HeartbeatOpts struct {
+	// The uuids of the docks reported by the dock process, required.
+	DockIds []string `protobuf:"bytes,1,rep,name=dockIds,proto3" json:"dockIds,omitempty"`
+	// The Context
+	Context              string   `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HeartbeatOpts) Reset()         { *m = HeartbeatOpts{} }
+func (m *HeartbeatOpts) String() string { return proto.CompactTextString(m) }
+func (*HeartbeatOpts) ProtoMessage()    {}
+func (*HeartbeatOpts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{47}
+}
+
+func (m *HeartbeatOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HeartbeatOpts.Unmarshal(m, b)
+}
+func (m *HeartbeatOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HeartbeatOpts.Marshal(b, m, deterministic)
+}
+func (m *HeartbeatOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeartbeatOpts.Merge(m, src)
+}
+func (m *HeartbeatOpts) XXX_Size() int {
+	return xxx_messageInfo_HeartbeatOpts.Size(m)
+}
+func (m *HeartbeatOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeartbeatOpts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeartbeatOpts proto.InternalMessageInfo
+
+func (m *HeartbeatOpts) GetDockIds() []string {
+	if m != nil {
+		return m.DockIds
+	}
+	return nil
+}
+
+func (m *HeartbeatOpts) GetContext() string {
+	if m != nil {
+		return m.Context
+	}
+	return ""
+}
+
+// ReconcileOpts is a structure which indicates how the database is
+// reconciled with the backends.
+type ReconcileOpts struct {
+	// Fix the drifts which are safe to be fixed automatically.
+	AutoFix bool `protobuf:"varint,1,opt,name=autoFix,proto3" json:"autoFix,omitempty"`
+	// The Context
+	Context              string   `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReconcileOpts) Reset()         { *m = ReconcileOpts{} }
+func (m *ReconcileOpts) String() string { return proto.CompactTextString(m) }
+func (*ReconcileOpts) ProtoMessage()    {}
+func (*ReconcileOpts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{48}
+}
+
+func (m *ReconcileOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReconcileOpts.Unmarshal(m, b)
+}
+func (m *ReconcileOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReconcileOpts.Marshal(b, m, deterministic)
+}
+func (m *ReconcileOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReconcileOpts.Merge(m, src)
+}
+func (m *ReconcileOpts) XXX_Size() int {
+	return xxx_messageInfo_ReconcileOpts.Size(m)
+}
+func (m *ReconcileOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReconcileOpts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReconcileOpts proto.InternalMessageInfo
+
+func (m *ReconcileOpts) GetAutoFix() bool {
+	if m != nil {
+		return m.AutoFix
+	}
+	return false
+}
+
+func (m *ReconcileOpts) GetContext() string {
+	if m != nil {
+		return m.Context
+	}
+	return ""
+}
+
+// HandshakeOpts is sent by the dock before using a driver plugin.
+type HandshakeOpts struct {
+	// The protocol version spoken by the dock, required.
+	Version              int32    `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HandshakeOpts) Reset()         { *m = HandshakeOpts{} }
+func (m *HandshakeOpts) String() string { return proto.CompactTextString(m) }
+func (*HandshakeOpts) ProtoMessage()    {}
+func (*HandshakeOpts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{49}
+}
+
+func (m *HandshakeOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HandshakeOpts.Unmarshal(m, b)
+}
+func (m *HandshakeOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HandshakeOpts.Marshal(b, m, deterministic)
+}
+func (m *HandshakeOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HandshakeOpts.Merge(m, src)
+}
+func (m *HandshakeOpts) XXX_Size() int {
+	return xxx_messageInfo_HandshakeOpts.Size(m)
+}
+func (m *HandshakeOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_HandshakeOpts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HandshakeOpts proto.InternalMessageInfo
+
+func (m *HandshakeOpts) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+// HandshakeReply tells the dock who the plugin is and what it supports.
+type HandshakeReply struct {
+	// The protocol version spoken by the plugin.
+	Version int32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// The name of the plugin.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The optional features supported by the plugin.
+	Capability           *DriverCapability `protobuf:"bytes,3,opt,name=capability,proto3" json:"capability,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *HandshakeReply) Reset()         { *m = HandshakeReply{} }
+func (m *HandshakeReply) String() string { return proto.CompactTextString(m) }
+func (*HandshakeReply) ProtoMessage()    {}
+func (*HandshakeReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{50}
+}
+
+func (m *HandshakeReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HandshakeReply.Unmarshal(m, b)
+}
+func (m *HandshakeReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HandshakeReply.Marshal(b, m, deterministic)
+}
+func (m *HandshakeReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HandshakeReply.Merge(m, src)
+}
+func (m *HandshakeReply) XXX_Size() int {
+	return xxx_messageInfo_HandshakeReply.Size(m)
+}
+func (m *HandshakeReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_HandshakeReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HandshakeReply proto.InternalMessageInfo
+
+func (m *HandshakeReply) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *HandshakeReply) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *HandshakeReply) GetCapability() *DriverCapability {
+	if m != nil {
+		return m.Capability
+	}
+	return nil
+}
+
+// DriverCapability describes the optional features of a storage driver.
+type DriverCapability struct {
+	VolumeGroup          bool     `protobuf:"varint,1,opt,name=volumeGroup,proto3" json:"volumeGroup,omitempty"`
+	Replication          bool     `protobuf:"varint,2,opt,name=replication,proto3" json:"replication,omitempty"`
+	SnapshotAttach       bool     `protobuf:"varint,3,opt,name=snapshotAttach,proto3" json:"snapshotAttach,omitempty"`
+	Metrics              bool     `protobuf:"varint,4,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DriverCapability) Reset()         { *m = DriverCapability{} }
+func (m *DriverCapability) String() string { return proto.CompactTextString(m) }
+func (*DriverCapability) ProtoMessage()    {}
+func (*DriverCapability) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{51}
+}
+
+func (m *DriverCapability) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DriverCapability.Unmarshal(m, b)
+}
+func (m *DriverCapability) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DriverCapability.Marshal(b, m, deterministic)
+}
+func (m *DriverCapability) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DriverCapability.Merge(m, src)
+}
+func (m *DriverCapability) XXX_Size() int {
+	return xxx_messageInfo_DriverCapability.Size(m)
+}
+func (m *DriverCapability) XXX_DiscardUnknown() {
+	xxx_messageInfo_DriverCapability.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DriverCapability proto.InternalMessageInfo
+
+func (m *DriverCapability) GetVolumeGroup() bool {
+	if m != nil {
+		return m.VolumeGroup
+	}
+	return false
+}
+
+func (m *DriverCapability) GetReplication() bool {
+	if m != nil {
+		return m.Replication
+	}
+	return false
+}
+
+func (m *DriverCapability) GetSnapshotAttach() bool {
+	if m != nil {
+		return m.SnapshotAttach
+	}
+	return false
+}
+
+func (m *DriverCapability) GetMetrics() bool {
+	if m != nil {
+		return m.Metrics
+	}
+	return false
+}
+
+// PluginOpts is sent to the driver plugin when no argument is required.
+type PluginOpts struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PluginOpts) Reset()         { *m = PluginOpts{} }
+func (m *PluginOpts) String() string { return proto.CompactTextString(m) }
+func (*PluginOpts) ProtoMessage()    {}
+func (*PluginOpts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{52}
+}
+
+func (m *PluginOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PluginOpts.Unmarshal(m, b)
+}
+func (m *PluginOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PluginOpts.Marshal(b, m, deterministic)
+}
+func (m *PluginOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PluginOpts.Merge(m, src)
+}
+func (m *PluginOpts) XXX_Size() int {
+	return xxx_messageInfo_PluginOpts.Size(m)
+}
+func (m *PluginOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_PluginOpts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PluginOpts proto.InternalMessageInfo
+
+// CollectMetricsOpts indicates the metrics collected from the driver plugin.
+type CollectMetricsOpts struct {
+	Metrics              []string `protobuf:"bytes,1,rep,name=metrics,proto3" json:"metrics,omitempty"`
+	InstanceId           string   `protobuf:"bytes,2,opt,name=instanceId,proto3" json:"instanceId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CollectMetricsOpts) Reset()         { *m = CollectMetricsOpts{} }
+func (m *CollectMetricsOpts) String() string { return proto.CompactTextString(m) }
+func (*CollectMetricsOpts) ProtoMessage()    {}
+func (*CollectMetricsOpts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{53}
+}
+
+func (m *CollectMetricsOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CollectMetricsOpts.Unmarshal(m, b)
+}
+func (m *CollectMetricsOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CollectMetricsOpts.Marshal(b, m, deterministic)
+}
+func (m *CollectMetricsOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollectMetricsOpts.Merge(m, src)
+}
+func (m *CollectMetricsOpts) XXX_Size() int {
+	return xxx_messageInfo_CollectMetricsOpts.Size(m)
+}
+func (m *CollectMetricsOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollectMetricsOpts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollectMetricsOpts proto.InternalMessageInfo
+
+func (m *CollectMetricsOpts) GetMetrics() []string {
+	if m != nil {
+		return m.Metrics
+	}
+	return nil
+}
+
+func (m *CollectMetricsOpts) GetInstanceId() string {
+	if m != nil {
+		return m.InstanceId
+	}
+	return ""
+}
+
+// ValidateMetricsOpts indicates the metrics checked by the driver plugin.
+type ValidateMetricsOpts struct {
+	Metrics              []string `protobuf:"bytes,1,rep,name=metrics,proto3" json:"metrics,omitempty"`
+	ResourceType         string   `protobuf:"bytes,2,opt,name=resourceType,proto3" json:"resourceType,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidateMetricsOpts) Reset()         { *m = ValidateMetricsOpts{} }
+func (m *ValidateMetricsOpts) String() string { return proto.CompactTextString(m) }
+func (*ValidateMetricsOpts) ProtoMessage()    {}
+func (*ValidateMetricsOpts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{54}
+}
+
+func (m *ValidateMetricsOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateMetricsOpts.Unmarshal(m, b)
+}
+func (m *ValidateMetricsOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateMetricsOpts.Marshal(b, m, deterministic)
+}
+func (m *ValidateMetricsOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateMetricsOpts.Merge(m, src)
+}
+func (m *ValidateMetricsOpts) XXX_Size() int {
+	return xxx_messageInfo_ValidateMetricsOpts.Size(m)
+}
+func (m *ValidateMetricsOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateMetricsOpts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateMetricsOpts proto.InternalMessageInfo
+
+func (m *ValidateMetricsOpts) GetMetrics() []string {
+	if m != nil {
+		return m.Metrics
+	}
+	return nil
+}
+
+func (m *ValidateMetricsOpts) GetResourceType() string {
+	if m != nil {
+		return m.ResourceType
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*CreateVolumeOpts)(nil), "proto.CreateVolumeOpts")
+	proto.RegisterMapType((map[string]string)(nil), "proto.CreateVolumeOpts.MetadataEntry")
+	proto.RegisterMapType((map[string]string)(nil), "proto.CreateVolumeOpts.ReplicationDriverDataEntry")
+	proto.RegisterType((*DeleteVolumeOpts)(nil), "proto.DeleteVolumeOpts")
+	proto.RegisterMapType((map[string]string)(nil), "proto.DeleteVolumeOpts.MetadataEntry")
+	proto.RegisterType((*ExtendVolumeOpts)(nil), "proto.ExtendVolumeOpts")
+	proto.RegisterMapType((map[string]string)(nil), "proto.ExtendVolumeOpts.MetadataEntry")
+	proto.RegisterType((*RetypeVolumeOpts)(nil), "proto.RetypeVolumeOpts")
+	proto.RegisterType((*MigrateVolumeOpts)(nil), "proto.MigrateVolumeOpts")
+	proto.RegisterMapType((map[string]string)(nil), "proto.MigrateVolumeOpts.MetadataEntry")
+	proto.RegisterType((*RevertVolumeToSnapshotOpts)(nil), "proto.RevertVolumeToSnapshotOpts")
+	proto.RegisterMapType((map[string]string)(nil), "proto.RevertVolumeToSnapshotOpts.MetadataEntry")
+	proto.RegisterMapType((map[string]string)(nil), "proto.RevertVolumeToSnapshotOpts.SnapshotMetadataEntry")
+	proto.RegisterType((*ManageVolumeOpts)(nil), "proto.ManageVolumeOpts")
+	proto.RegisterMapType((map[string]string)(nil), "proto.ManageVolumeOpts.MetadataEntry")
+	proto.RegisterType((*UnmanageVolumeOpts)(nil), "proto.UnmanageVolumeOpts")
+	proto.RegisterMapType((map[string]string)(nil), "proto.UnmanageVolumeOpts.MetadataEntry")
+	proto.RegisterType((*CreateVolumeSnapshotOpts)(nil), "proto.CreateVolumeSnapshotOpts")
+	proto.RegisterMapType((map[string]string)(nil), "proto.CreateVolumeSnapshotOpts.MetadataEntry")
+	proto.RegisterType((*DeleteVolumeSnapshotOpts)(nil), "proto.DeleteVolumeSnapshotOpts")
+	proto.RegisterMapType((map[string]string)(nil), "proto.DeleteVolumeSnapshotOpts.MetadataEntry")
+	proto.RegisterType((*ManageVolumeSnapshotOpts)(nil), "proto.ManageVolumeSnapshotOpts")
+	proto.RegisterMapType((map[string]string)(nil), "proto.ManageVolumeSnapshotOpts.MetadataEntry")
+	proto.RegisterType((*UnmanageVolumeSnapshotOpts)(nil), "proto.UnmanageVolumeSnapshotOpts")
+	proto.RegisterMapType((map[string]string)(nil), "proto.UnmanageVolumeSnapshotOpts.MetadataEntry")
+	proto.RegisterType((*PullVolumeOpts)(nil), "proto.PullVolumeOpts")
+	proto.RegisterMapType((map[string]string)(nil), "proto.PullVolumeOpts.MetadataEntry")
+	proto.RegisterType((*PullVolumeSnapshotOpts)(nil), "proto.PullVolumeSnapshotOpts")
+	proto.RegisterMapType((map[string]string)(nil), "proto.PullVolumeSnapshotOpts.MetadataEntry")
+	proto.RegisterType((*ListVolumesOpts)(nil), "proto.ListVolumesOpts")
+	proto.RegisterType((*CreateVolumeBackupOpts)(nil), "proto.CreateVolumeBackupOpts")
+	proto.RegisterMapType((map[string]string)(nil), "proto.CreateVolumeBackupOpts.MetadataEntry")
+	proto.RegisterMapType((map[string]string)(nil), "proto.CreateVolumeBackupOpts.SourceMetadataEntry")
+	proto.RegisterType((*DeleteVolumeBackupOpts)(nil), "proto.DeleteVolumeBackupOpts")
+	proto.RegisterMapType((map[string]string)(nil), "proto.DeleteVolumeBackupOpts.MetadataEntry")
+	proto.RegisterType((*RestoreVolumeBackupOpts)(nil), "proto.RestoreVolumeBackupOpts")
+	proto.RegisterMapType((map[string]string)(nil), "proto.RestoreVolumeBackupOpts.MetadataEntry")
+	proto.RegisterMapType((map[string]string)(nil), "proto.RestoreVolumeBackupOpts.VolumeMetadataEntry")
+	proto.RegisterType((*CreateVolumeAttachmentOpts)(nil), "proto.CreateVolumeAttachmentOpts")
+	proto.RegisterMapType((map[string]string)(nil), "proto.CreateVolumeAttachmentOpts.MetadataEntry")
+	proto.RegisterType((*DeleteVolumeAttachmentOpts)(nil), "proto.DeleteVolumeAttachmentOpts")
+	proto.RegisterMapType((map[string]string)(nil), "proto.DeleteVolumeAttachmentOpts.MetadataEntry")
+	proto.RegisterType((*CreateSnapshotAttachmentOpts)(nil), "proto.CreateSnapshotAttachmentOpts")
+	proto.RegisterMapType((map[string]string)(nil), "proto.CreateSnapshotAttachmentOpts.MetadataEntry")
+	proto.RegisterType((*DeleteSnapshotAttachmentOpts)(nil), "proto.DeleteSnapshotAttachmentOpts")
+	proto.RegisterMapType((map[string]string)(nil), "proto.DeleteSnapshotAttachmentOpts.MetadataEntry")
+	proto.RegisterType((*HostInfo)(nil), "proto.HostInfo")
+	proto.RegisterType((*VolumeData)(nil), "proto.VolumeData")
+	proto.RegisterMapType((map[string]string)(nil), "proto.VolumeData.DataEntry")
+	proto.RegisterType((*CreateReplicationOpts)(nil), "proto.CreateReplicationOpts")
+	proto.RegisterMapType((map[string]string)(nil), "proto.CreateReplicationOpts.MetadataEntry")
+	proto.RegisterMapType((map[string]string)(nil), "proto.CreateReplicationOpts.PrimaryReplicationDriverDataEntry")
+	proto.RegisterMapType((map[string]string)(nil), "proto.CreateReplicationOpts.SecondaryReplicationDriverDataEntry")
+	proto.RegisterType((*DeleteReplicationOpts)(nil), "proto.DeleteReplicationOpts")
 	proto.RegisterMapType((map[string]string)(nil), "proto.DeleteReplicationOpts.MetadataEntry")
 	proto.RegisterMapType((map[string]string)(nil), "proto.DeleteReplicationOpts.PrimaryReplicationDriverDataEntry")
 	proto.RegisterMapType((map[string]string)(nil), "proto.DeleteReplicationOpts.SecondaryReplicationDriverDataEntry")
@@ -3171,1629 +6087,4769 @@ func init() {
 	proto.RegisterType((*FailoverReplicationOpts_FailoverRequest)(nil), "proto.FailoverReplicationOpts.FailoverRequest")
 	proto.RegisterType((*CreateVolumeGroupOpts)(nil), "proto.CreateVolumeGroupOpts")
 	proto.RegisterType((*UpdateVolumeGroupOpts)(nil), "proto.UpdateVolumeGroupOpts")
+	proto.RegisterMapType((map[string]string)(nil), "proto.UpdateVolumeGroupOpts.MetadataEntry")
 	proto.RegisterType((*DeleteVolumeGroupOpts)(nil), "proto.DeleteVolumeGroupOpts")
+	proto.RegisterMapType((map[string]string)(nil), "proto.DeleteVolumeGroupOpts.MetadataEntry")
+	proto.RegisterType((*VolumeRef)(nil), "proto.VolumeRef")
+	proto.RegisterMapType((map[string]string)(nil), "proto.VolumeRef.MetadataEntry")
+	proto.RegisterType((*CreateGroupSnapshotOpts)(nil), "proto.CreateGroupSnapshotOpts")
+	proto.RegisterMapType((map[string]string)(nil), "proto.CreateGroupSnapshotOpts.MetadataEntry")
+	proto.RegisterType((*DeleteGroupSnapshotOpts)(nil), "proto.DeleteGroupSnapshotOpts")
+	proto.RegisterMapType((map[string]string)(nil), "proto.DeleteGroupSnapshotOpts.MetadataEntry")
 	proto.RegisterType((*AttachVolumeOpts)(nil), "proto.AttachVolumeOpts")
 	proto.RegisterMapType((map[string]string)(nil), "proto.AttachVolumeOpts.MetadataEntry")
 	proto.RegisterType((*DetachVolumeOpts)(nil), "proto.DetachVolumeOpts")
 	proto.RegisterMapType((map[string]string)(nil), "proto.DetachVolumeOpts.MetadataEntry")
+	proto.RegisterType((*CopyVolumeOpts)(nil), "proto.CopyVolumeOpts")
+	proto.RegisterType((*FreezeVolumeOpts)(nil), "proto.FreezeVolumeOpts")
 	proto.RegisterType((*CreateFileShareOpts)(nil), "proto.CreateFileShareOpts")
 	proto.RegisterMapType((map[string]string)(nil), "proto.CreateFileShareOpts.MetadataEntry")
 	proto.RegisterType((*DeleteFileShareOpts)(nil), "proto.DeleteFileShareOpts")
 	proto.RegisterMapType((map[string]string)(nil), "proto.DeleteFileShareOpts.MetadataEntry")
+	proto.RegisterType((*CreateFileShareSnapshotOpts)(nil), "proto.CreateFileShareSnapshotOpts")
+	proto.RegisterMapType((map[string]string)(nil), "proto.CreateFileShareSnapshotOpts.MetadataEntry")
+	proto.RegisterType((*DeleteFileShareSnapshotOpts)(nil), "proto.DeleteFileShareSnapshotOpts")
+	proto.RegisterMapType((map[string]string)(nil), "proto.DeleteFileShareSnapshotOpts.MetadataEntry")
+	proto.RegisterType((*CreateFileShareAclOpts)(nil), "proto.CreateFileShareAclOpts")
+	proto.RegisterMapType((map[string]string)(nil), "proto.CreateFileShareAclOpts.MetadataEntry")
+	proto.RegisterType((*DeleteFileShareAclOpts)(nil), "proto.DeleteFileShareAclOpts")
+	proto.RegisterMapType((map[string]string)(nil), "proto.DeleteFileShareAclOpts.MetadataEntry")
 	proto.RegisterType((*GenericResponse)(nil), "proto.GenericResponse")
 	proto.RegisterType((*GenericResponse_Result)(nil), "proto.GenericResponse.Result")
 	proto.RegisterType((*GenericResponse_Error)(nil), "proto.GenericResponse.Error")
 	proto.RegisterType((*GetMetricsOpts)(nil), "proto.GetMetricsOpts")
+	proto.RegisterType((*HeartbeatOpts)(nil), "proto.HeartbeatOpts")
+	proto.RegisterType((*ReconcileOpts)(nil), "proto.ReconcileOpts")
+	proto.RegisterType((*HandshakeOpts)(nil), "proto.HandshakeOpts")
+	proto.RegisterType((*HandshakeReply)(nil), "proto.HandshakeReply")
+	proto.RegisterType((*DriverCapability)(nil), "proto.DriverCapability")
+	proto.RegisterType((*PluginOpts)(nil), "proto.PluginOpts")
+	proto.RegisterType((*CollectMetricsOpts)(nil), "proto.CollectMetricsOpts")
+	proto.RegisterType((*ValidateMetricsOpts)(nil), "proto.ValidateMetricsOpts")
+}
+
+func init() { proto.RegisterFile("model.proto", fileDescriptor_4c16552f9fdb66d8) }
+
+var fileDescriptor_4c16552f9fdb66d8 = []byte{
+	// 3933 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5d, 0x4f, 0x8c, 0x1c, 0x47,
+	0xb9, 0xf7, 0xf4, 0xfc, 0xff, 0x76, 0x77, 0x76, 0x5c, 0xeb, 0x5d, 0xcf, 0x1b, 0x3b, 0x7e, 0xce,
+	0x24, 0xcf, 0x6f, 0x5f, 0x9c, 0x38, 0xce, 0xbe, 0x80, 0x43, 0x82, 0x49, 0xd6, 0xbb, 0xf6, 0xee,
+	0xca, 0xde, 0x78, 0xd3, 0xbb, 0x36, 0x22, 0x82, 0x43, 0x7b, 0xba, 0xec, 0x6d, 0xb9, 0xa7, 0x7b,
+	0xe8, 0xee, 0x59, 0x67, 0x73, 0x21, 0x22, 0x1c, 0x00, 0xe5, 0x06, 0x87, 0x08, 0x21, 0x84, 0x90,
+	0xb8, 0xa0, 0xc0, 0x05, 0x89, 0x03, 0x07, 0x94, 0x03, 0x12, 0x37, 0x0e, 0x28, 0xdc, 0x50, 0xe0,
+	0x84, 0x84, 0xc4, 0x85, 0x53, 0x24, 0x04, 0x12, 0xea, 0xea, 0x7f, 0x55, 0xd5, 0xd5, 0x35, 0x33,
+	0x3b, 0xb3, 0xf6, 0x3a, 0x99, 0xd3, 0x4e, 0x55, 0x57, 0x7f, 0x5d, 0xf5, 0xfb, 0xbe, 0xfa, 0xd5,
+	0x57, 0x55, 0x5f, 0xd5, 0xc2, 0x54, 0xc7, 0xd6, 0xb1, 0x79, 0xa1, 0xeb, 0xd8, 0x9e, 0x8d, 0x8a,
+	0xe4, 0x4f, 0xeb, 0x9d, 0x32, 0xd4, 0x57, 0x1c, 0xac, 0x79, 0xf8, 0xb6, 0x6d, 0xf6, 0x3a, 0xf8,
+	0x66, 0xd7, 0x73, 0x51, 0x0d, 0x14, 0x43, 0x6f, 0xe4, 0xce, 0xe6, 0x16, 0xab, 0xaa, 0x62, 0xe8,
+	0x08, 0x41, 0xc1, 0xd2, 0x3a, 0xb8, 0xa1, 0x90, 0x1c, 0xf2, 0xdb, 0xcf, 0x73, 0x8d, 0xb7, 0x71,
+	0x23, 0x7f, 0x36, 0xb7, 0x98, 0x57, 0xc9, 0x6f, 0x74, 0x16, 0xa6, 0x74, 0xec, 0xb6, 0x1d, 0xa3,
+	0xeb, 0x19, 0xb6, 0xd5, 0x28, 0x90, 0xe2, 0x74, 0x16, 0x3a, 0x03, 0xe0, 0x5a, 0x5a, 0xd7, 0xdd,
+	0xb5, 0xbd, 0x0d, 0xbd, 0x51, 0x24, 0x05, 0xa8, 0x1c, 0xf4, 0x0c, 0xd4, 0xb5, 0x3d, 0xcd, 0x30,
+	0xb5, 0x3b, 0x86, 0x69, 0x78, 0xfb, 0x6f, 0xda, 0x16, 0x6e, 0x94, 0x48, 0xa9, 0x54, 0x3e, 0x3a,
+	0x0d, 0xd5, 0xae, 0x63, 0xdf, 0x35, 0x4c, 0xbc, 0xa1, 0x37, 0xca, 0xa4, 0x50, 0x92, 0x81, 0x16,
+	0xa0, 0xd4, 0xb5, 0x6d, 0x73, 0x43, 0x6f, 0x54, 0xc8, 0xa3, 0x30, 0x85, 0x9a, 0x50, 0xf1, 0x7f,
+	0xbd, 0xee, 0xb7, 0xa7, 0x4a, 0x9e, 0xc4, 0x69, 0xb4, 0x0c, 0x95, 0x0e, 0xf6, 0x34, 0x5d, 0xf3,
+	0xb4, 0x06, 0x9c, 0xcd, 0x2f, 0x4e, 0x2d, 0xfd, 0x4f, 0x80, 0xd6, 0x05, 0x1e, 0xa2, 0x0b, 0x9b,
+	0x61, 0xb9, 0xab, 0x96, 0xe7, 0xec, 0xab, 0xf1, 0x6b, 0x7e, 0x03, 0x75, 0xc7, 0xd8, 0xc3, 0x0e,
+	0xf9, 0xc0, 0x54, 0xd0, 0xc0, 0x24, 0x07, 0x35, 0xa0, 0xdc, 0xb6, 0x2d, 0x0f, 0xbf, 0xe5, 0x35,
+	0xa6, 0xc9, 0xc3, 0x28, 0x89, 0x76, 0x61, 0xde, 0xc1, 0x5d, 0xd3, 0x68, 0x6b, 0x3e, 0x52, 0xab,
+	0xe4, 0x95, 0x55, 0xbf, 0x26, 0x33, 0xa4, 0x26, 0x4b, 0x59, 0x35, 0x51, 0x45, 0x2f, 0x05, 0xd5,
+	0x12, 0x0b, 0x44, 0x4f, 0xc3, 0x0c, 0xf5, 0x60, 0x43, 0x6f, 0xd4, 0x48, 0x4d, 0xd8, 0x4c, 0xd4,
+	0x82, 0xe9, 0x48, 0x31, 0xdb, 0xbe, 0xa2, 0x67, 0x89, 0xa2, 0x99, 0x3c, 0xf4, 0x2c, 0x1c, 0x8f,
+	0xd2, 0xd7, 0x1c, 0xbb, 0xb3, 0x62, 0xda, 0x3d, 0xbd, 0x51, 0x3f, 0x9b, 0x5b, 0xac, 0xa8, 0xe9,
+	0x07, 0x7e, 0xdb, 0x43, 0xfd, 0x34, 0x8e, 0x07, 0x6d, 0x0f, 0x93, 0xbe, 0xe1, 0xd8, 0x5d, 0xec,
+	0x44, 0xf5, 0x41, 0x81, 0xe1, 0x50, 0x59, 0xe8, 0x1c, 0xd4, 0x5c, 0xbb, 0xe7, 0xb4, 0xc3, 0x96,
+	0x6f, 0xe8, 0x8d, 0x39, 0x52, 0x88, 0xcb, 0xf5, 0x0d, 0x88, 0xce, 0x21, 0x35, 0x3f, 0x41, 0x6a,
+	0x9e, 0xca, 0x6f, 0xbe, 0x02, 0x33, 0x8c, 0x1a, 0x51, 0x1d, 0xf2, 0xf7, 0xf1, 0x7e, 0x68, 0xf8,
+	0xfe, 0x4f, 0x74, 0x02, 0x8a, 0x7b, 0x9a, 0xd9, 0x8b, 0x4c, 0x3f, 0x48, 0xbc, 0xac, 0xbc, 0x94,
+	0x6b, 0xae, 0x43, 0x33, 0x1b, 0xf9, 0x61, 0x24, 0xb5, 0x7e, 0xaf, 0x40, 0x7d, 0x15, 0x9b, 0x58,
+	0xda, 0x05, 0x19, 0x63, 0x57, 0xb2, 0x8d, 0x3d, 0xcf, 0x18, 0x3b, 0x6d, 0xd0, 0x05, 0xc6, 0xa0,
+	0xf9, 0x0f, 0x0e, 0x68, 0xd0, 0x45, 0x99, 0x41, 0x97, 0x58, 0x83, 0xa6, 0xd4, 0x5d, 0x96, 0xaa,
+	0xbb, 0x92, 0x52, 0xf7, 0x48, 0xaa, 0x69, 0xbd, 0x53, 0x80, 0xfa, 0xd5, 0xb7, 0x3c, 0x6c, 0xe9,
+	0x13, 0x4e, 0x93, 0x70, 0x1a, 0x0f, 0xd1, 0x21, 0x70, 0x1a, 0x65, 0x02, 0x33, 0x52, 0x13, 0xa8,
+	0x8d, 0xd9, 0x04, 0x7e, 0x93, 0x83, 0xba, 0x8a, 0xbd, 0xfd, 0xee, 0xf8, 0xfb, 0xd4, 0x22, 0xcc,
+	0x76, 0x8c, 0x7b, 0x41, 0x35, 0xb7, 0x6c, 0xd3, 0x68, 0xef, 0x87, 0x46, 0xc1, 0x67, 0xd3, 0xb8,
+	0x14, 0x59, 0x5c, 0xb8, 0xd6, 0x97, 0x52, 0xad, 0x6f, 0xfd, 0x25, 0x0f, 0xc7, 0x37, 0x89, 0xbc,
+	0x71, 0x0c, 0xcc, 0x49, 0x5b, 0x0a, 0x99, 0x86, 0x53, 0xe4, 0x0c, 0x87, 0x41, 0xa7, 0xc4, 0xa3,
+	0x93, 0xdd, 0xb9, 0xfd, 0x71, 0x83, 0x30, 0xed, 0x16, 0x6d, 0xaa, 0x4c, 0x5e, 0xc2, 0xe6, 0x5b,
+	0xac, 0xd9, 0x72, 0xb9, 0xe8, 0x4a, 0xca, 0x78, 0xcf, 0x85, 0xc6, 0x9b, 0xc2, 0xe6, 0x10, 0xac,
+	0x97, 0xd3, 0xd2, 0xcc, 0x98, 0x6d, 0xf4, 0xe3, 0x82, 0x3f, 0x84, 0xec, 0x61, 0xc7, 0x0b, 0x5a,
+	0xb1, 0x63, 0x6f, 0x87, 0xa4, 0x21, 0xd4, 0x35, 0x4b, 0x33, 0x4a, 0x8a, 0x66, 0xc6, 0xa5, 0xf7,
+	0xeb, 0x14, 0xe6, 0x25, 0x82, 0xf9, 0xf3, 0x21, 0xe6, 0xd9, 0x95, 0xcd, 0x04, 0x9f, 0x77, 0x22,
+	0xca, 0x02, 0x27, 0xa2, 0x0d, 0xf5, 0x28, 0x1d, 0x89, 0x69, 0x54, 0xc8, 0x87, 0x2f, 0xf5, 0xff,
+	0xf0, 0x36, 0xf7, 0x66, 0x50, 0x81, 0x94, 0x40, 0x5f, 0x0d, 0x77, 0x6d, 0xa7, 0x1d, 0x18, 0x5a,
+	0x45, 0x0d, 0x12, 0x9c, 0x6d, 0x80, 0xcc, 0x36, 0xa6, 0xa4, 0xb6, 0x31, 0x3d, 0x5e, 0xdb, 0x68,
+	0xae, 0xc0, 0xbc, 0xb0, 0x65, 0x43, 0x19, 0xd8, 0x87, 0x79, 0xa8, 0x6f, 0x6a, 0x96, 0x76, 0x6f,
+	0x58, 0x0a, 0xe1, 0xc6, 0xbc, 0xbc, 0x70, 0xcc, 0x33, 0x74, 0x6c, 0x79, 0xc6, 0x5d, 0x03, 0x3b,
+	0xa1, 0x71, 0x51, 0x39, 0x94, 0xe1, 0x15, 0x33, 0x0d, 0xaf, 0x24, 0x23, 0x9c, 0xb2, 0x84, 0x70,
+	0x2a, 0x2c, 0xe1, 0xd0, 0x23, 0x5c, 0x95, 0x19, 0xe1, 0xf8, 0xc6, 0x0f, 0xc8, 0x11, 0x47, 0xc7,
+	0x0e, 0x5a, 0x3f, 0x50, 0x00, 0xdd, 0xb2, 0x3a, 0xfd, 0x94, 0x98, 0xc0, 0xad, 0x30, 0x70, 0xaf,
+	0x50, 0xd0, 0xe4, 0x09, 0x34, 0xff, 0x1b, 0x42, 0x93, 0x16, 0x3a, 0x20, 0x38, 0x05, 0x19, 0x38,
+	0xc3, 0x0e, 0x73, 0xa3, 0x81, 0xf3, 0x41, 0x1e, 0x1a, 0xf4, 0x74, 0x48, 0x4a, 0x9f, 0xe3, 0xf3,
+	0xf7, 0x9a, 0x50, 0xd9, 0x8b, 0x26, 0x21, 0x21, 0x79, 0x46, 0xe9, 0x3e, 0x83, 0xe6, 0x06, 0xa5,
+	0x8e, 0x32, 0x51, 0xc7, 0x73, 0x82, 0x59, 0xdd, 0x40, 0xc4, 0xca, 0x2a, 0xa5, 0x22, 0x53, 0x4a,
+	0x35, 0xd3, 0x27, 0x03, 0xa9, 0x4f, 0x36, 0x35, 0x66, 0x75, 0xfd, 0x56, 0x81, 0x06, 0x3d, 0xed,
+	0x90, 0xaa, 0x8b, 0x06, 0x59, 0xe1, 0x40, 0xde, 0x48, 0x59, 0xf5, 0x73, 0x82, 0x59, 0xcd, 0x01,
+	0x60, 0x1c, 0xc6, 0xb6, 0x29, 0x18, 0x4b, 0x52, 0x18, 0xcb, 0x63, 0x86, 0xf1, 0xfb, 0x79, 0x68,
+	0xd0, 0xc4, 0x36, 0xb4, 0xd5, 0x8f, 0xce, 0xee, 0xb2, 0x1e, 0x10, 0xf5, 0xa9, 0x12, 0xd5, 0xa7,
+	0xb2, 0xed, 0x3e, 0xab, 0x21, 0x87, 0x60, 0xf7, 0x9c, 0x5a, 0x60, 0xcc, 0x6a, 0xf9, 0x85, 0x02,
+	0x4d, 0x96, 0x54, 0x0f, 0x6c, 0xdf, 0xd7, 0x53, 0xf6, 0xfd, 0xbc, 0x90, 0xb5, 0x0f, 0xd9, 0xc2,
+	0x0f, 0x99, 0xbd, 0xdf, 0x53, 0xa0, 0xb6, 0xd5, 0x33, 0xcd, 0x03, 0x0c, 0x6b, 0xb4, 0x17, 0x91,
+	0xe7, 0xbc, 0x88, 0x57, 0x53, 0x4b, 0x1e, 0x4f, 0x85, 0xe0, 0xb1, 0x1f, 0x1b, 0xff, 0x82, 0xc7,
+	0x68, 0x70, 0xfc, 0x58, 0x81, 0x85, 0xa4, 0x86, 0x07, 0xb6, 0x1d, 0x19, 0x34, 0x6b, 0x29, 0x68,
+	0xce, 0xa7, 0xa0, 0x39, 0x80, 0x4d, 0x3d, 0x34, 0x88, 0xbe, 0x01, 0xb3, 0x37, 0x0c, 0x37, 0x9c,
+	0x07, 0xb8, 0x04, 0x9a, 0xc4, 0x42, 0x72, 0x99, 0x16, 0xa2, 0x70, 0x30, 0xb0, 0xb5, 0xcf, 0xcb,
+	0x6a, 0x5f, 0x60, 0x6a, 0xdf, 0xfa, 0x67, 0x01, 0x16, 0xe8, 0x91, 0xfa, 0x8a, 0xd6, 0xbe, 0xdf,
+	0xeb, 0x8e, 0x91, 0x78, 0x69, 0xcd, 0x16, 0x38, 0xcd, 0xf6, 0x5b, 0x66, 0x12, 0x11, 0xef, 0x5a,
+	0x8a, 0x78, 0xcf, 0x0b, 0x1c, 0x8e, 0xa4, 0x19, 0x99, 0x1a, 0xff, 0x4a, 0x34, 0x61, 0xe7, 0x66,
+	0x68, 0x2f, 0xc8, 0xc5, 0x6d, 0x33, 0xef, 0x04, 0x42, 0x39, 0x41, 0xfe, 0x5a, 0x80, 0xd6, 0x6e,
+	0x63, 0xd7, 0xdd, 0xf2, 0x25, 0xb5, 0x6d, 0x33, 0x5a, 0x0b, 0x60, 0x73, 0xfd, 0xa9, 0xe4, 0x1d,
+	0x22, 0x39, 0x58, 0x6b, 0x0d, 0x09, 0x9c, 0xc9, 0x3b, 0xb2, 0x73, 0xfd, 0xe6, 0x32, 0xcc, 0x09,
+	0xb0, 0x18, 0x76, 0x80, 0x59, 0xa0, 0xfd, 0x1b, 0x89, 0xf1, 0xd1, 0x6a, 0x57, 0x18, 0xb5, 0x8b,
+	0x05, 0xc8, 0xa6, 0xef, 0x0c, 0xe6, 0xf9, 0xbe, 0x98, 0x1f, 0xa1, 0x01, 0xe6, 0xe3, 0x02, 0x9c,
+	0x54, 0xb1, 0xeb, 0xd9, 0x4e, 0x7f, 0xc4, 0x64, 0x94, 0x2a, 0x9a, 0x25, 0xac, 0xa7, 0xa8, 0xf4,
+	0xd9, 0x78, 0xad, 0x42, 0xf8, 0xc5, 0x4c, 0x88, 0xdf, 0x84, 0x5a, 0xf0, 0xa5, 0xb8, 0x67, 0x15,
+	0x99, 0xfd, 0x9e, 0x2c, 0x79, 0xb7, 0x99, 0x97, 0xc2, 0xae, 0xc5, 0x4a, 0x12, 0x74, 0xad, 0xd2,
+	0x40, 0x5d, 0xab, 0xdc, 0x57, 0xcd, 0x63, 0x75, 0xbc, 0xd0, 0xe7, 0xa0, 0x6a, 0xe1, 0x07, 0x41,
+	0x8b, 0x48, 0xaf, 0x9d, 0x5a, 0x3a, 0x99, 0xb1, 0xdd, 0xa5, 0x26, 0x25, 0x47, 0xee, 0x91, 0x02,
+	0x08, 0x87, 0x32, 0xb0, 0xdf, 0xe5, 0xa1, 0x49, 0xd7, 0x6f, 0xd9, 0xf3, 0xb4, 0xf6, 0x6e, 0x07,
+	0x5b, 0xc3, 0x0f, 0xdb, 0x4f, 0xc3, 0x8c, 0x6e, 0xdf, 0xb0, 0xdb, 0x9a, 0x19, 0x08, 0x21, 0xc6,
+	0x56, 0x51, 0xd9, 0x4c, 0x7f, 0x76, 0xd9, 0xe9, 0x99, 0x9e, 0xb1, 0xa5, 0x79, 0xbb, 0xa4, 0xa7,
+	0x55, 0xd4, 0x24, 0x03, 0x9d, 0x87, 0xca, 0xae, 0xed, 0x7a, 0x1b, 0xd6, 0x5d, 0x9b, 0xf4, 0xb4,
+	0xa9, 0xa5, 0xd9, 0x10, 0xc4, 0xf5, 0x30, 0x5b, 0x8d, 0x0b, 0x48, 0x56, 0xf9, 0xb2, 0x5b, 0x34,
+	0xa0, 0x3f, 0x50, 0x96, 0xd9, 0x46, 0x85, 0xb5, 0x8d, 0x73, 0x50, 0x5b, 0x16, 0x92, 0x3f, 0x9b,
+	0x7b, 0xd8, 0xce, 0xfb, 0xbb, 0x79, 0x68, 0xd2, 0xd4, 0x38, 0x82, 0x26, 0x69, 0x2d, 0xe4, 0x87,
+	0xd1, 0x42, 0x81, 0xd1, 0x42, 0x76, 0x6d, 0x0e, 0x61, 0xa7, 0x2e, 0xad, 0x85, 0xf2, 0x20, 0x5a,
+	0x18, 0xf7, 0xbe, 0xdd, 0xcf, 0xf3, 0x70, 0x3a, 0xb0, 0xbe, 0xc8, 0x0b, 0xed, 0xa3, 0x87, 0x7e,
+	0x4b, 0xe2, 0x0f, 0xbd, 0x57, 0x6d, 0xa6, 0x7a, 0x15, 0xeb, 0x20, 0x89, 0xdb, 0xf5, 0xe8, 0xfa,
+	0xd5, 0x68, 0xfa, 0xfa, 0x9b, 0x02, 0xa7, 0x03, 0x3b, 0x1d, 0x93, 0xbe, 0x86, 0xea, 0x3b, 0x9b,
+	0xa9, 0xbe, 0xf3, 0x02, 0xd3, 0x77, 0x46, 0xc2, 0xfa, 0x10, 0x7a, 0xcf, 0x88, 0x7b, 0xda, 0x39,
+	0xa8, 0x44, 0x20, 0x90, 0xd9, 0x8d, 0xa9, 0x79, 0x77, 0x6d, 0xa7, 0x13, 0xbe, 0x1d, 0xa7, 0xfd,
+	0x19, 0x91, 0xed, 0xee, 0xec, 0x77, 0x23, 0x19, 0x61, 0xca, 0xf7, 0x62, 0x7c, 0xe8, 0x42, 0x17,
+	0x8e, 0xfc, 0x26, 0xfa, 0xe9, 0x86, 0x2e, 0x9b, 0x62, 0x74, 0xfd, 0x9e, 0x60, 0x58, 0x86, 0x67,
+	0x68, 0x9e, 0xed, 0x84, 0x10, 0x24, 0x19, 0xad, 0x3d, 0x80, 0x80, 0x8f, 0x48, 0x10, 0xc9, 0xf3,
+	0x50, 0x20, 0xd0, 0xe7, 0x08, 0xf4, 0xa7, 0x42, 0xe8, 0x93, 0x02, 0x17, 0x92, 0x30, 0x14, 0x52,
+	0xb0, 0x79, 0x09, 0xaa, 0x07, 0x8b, 0x8f, 0xf8, 0x73, 0x15, 0xe6, 0x83, 0xee, 0x43, 0x05, 0x5c,
+	0x8c, 0x71, 0xd2, 0xb5, 0x08, 0xb3, 0x5d, 0xc7, 0xe8, 0x68, 0xce, 0xfe, 0x6d, 0x76, 0xee, 0xc5,
+	0x67, 0x93, 0x70, 0x17, 0xdc, 0xb6, 0x2d, 0x9d, 0x2e, 0x1b, 0xe0, 0x94, 0x7e, 0xf0, 0x88, 0xf7,
+	0xfd, 0xbf, 0x99, 0x83, 0xd3, 0x61, 0xfd, 0x85, 0x71, 0x2a, 0x8d, 0x29, 0xa2, 0xb8, 0x2f, 0x31,
+	0xfc, 0xc4, 0x01, 0x7c, 0x61, 0x4b, 0x22, 0x20, 0xd0, 0xad, 0xf4, 0x1b, 0xe8, 0xdb, 0x39, 0x38,
+	0x13, 0x03, 0x23, 0xae, 0xc6, 0x34, 0xa9, 0xc6, 0x6b, 0xd2, 0x6a, 0x6c, 0x4b, 0x45, 0x04, 0x15,
+	0xe9, 0xf3, 0x1d, 0x1f, 0x43, 0xdd, 0x6e, 0xdf, 0x8f, 0xe7, 0x76, 0x61, 0x8a, 0xeb, 0xf7, 0x35,
+	0x59, 0xbf, 0x9f, 0x65, 0xfb, 0xbd, 0xdf, 0x5b, 0xdc, 0x10, 0xa1, 0x30, 0xe8, 0x29, 0xc9, 0x40,
+	0xd7, 0x28, 0x7a, 0x3a, 0x4e, 0xda, 0xf8, 0x8c, 0xb4, 0x8d, 0x59, 0xbc, 0xf4, 0x85, 0x68, 0x7e,
+	0xe0, 0xb7, 0xc2, 0x5f, 0xfe, 0x68, 0x20, 0x22, 0xed, 0x78, 0xaa, 0xc7, 0xa9, 0x5c, 0x41, 0xdf,
+	0xb0, 0xa9, 0x90, 0xae, 0x4d, 0x5b, 0xc7, 0x61, 0xd0, 0x14, 0x9f, 0xed, 0x1b, 0x36, 0x55, 0x9f,
+	0x2d, 0xec, 0x18, 0xb6, 0x1e, 0x86, 0x4d, 0xa5, 0x1f, 0xa0, 0x25, 0x38, 0x41, 0x65, 0x5e, 0xd1,
+	0x2c, 0xfd, 0x81, 0xa1, 0x7b, 0xbb, 0x8d, 0x79, 0xf2, 0x82, 0xf0, 0x19, 0xbd, 0x5c, 0xbe, 0x20,
+	0x5d, 0x2e, 0x3f, 0x99, 0x76, 0x2a, 0x6e, 0xc2, 0x93, 0x7d, 0x0d, 0x71, 0x28, 0xdf, 0xff, 0x0d,
+	0x78, 0x6a, 0x00, 0x93, 0x1a, 0x4a, 0xe4, 0x48, 0xe4, 0xfe, 0x7e, 0x05, 0xe6, 0x83, 0x41, 0x6b,
+	0xc2, 0x70, 0x87, 0xc6, 0x70, 0x42, 0x80, 0x1f, 0x3e, 0xc3, 0x89, 0xab, 0x71, 0x34, 0x19, 0x8e,
+	0xe6, 0xb0, 0x3a, 0xc3, 0x61, 0xe2, 0x56, 0x64, 0x71, 0x18, 0xc3, 0x94, 0xc7, 0x79, 0xa6, 0xa4,
+	0xa8, 0x01, 0x49, 0xa9, 0x61, 0xee, 0x33, 0x4a, 0x0d, 0x57, 0x2d, 0xed, 0x8e, 0x39, 0xa1, 0x86,
+	0xc3, 0xa3, 0x06, 0x21, 0xc0, 0x0f, 0x9f, 0x1a, 0xc4, 0xd5, 0x78, 0xdc, 0xa8, 0x41, 0xdc, 0x8a,
+	0x09, 0x35, 0x8c, 0x9d, 0x1a, 0x7e, 0x58, 0x81, 0x85, 0x55, 0xc3, 0x9d, 0x70, 0xc3, 0x70, 0xdc,
+	0xf0, 0xee, 0x60, 0xdc, 0xf0, 0x6a, 0x34, 0xd2, 0x19, 0xee, 0x61, 0x90, 0xc3, 0x77, 0x06, 0x25,
+	0x87, 0x65, 0x79, 0x3d, 0x8e, 0x26, 0x3b, 0xac, 0xa5, 0xd8, 0xe1, 0xbc, 0xbc, 0x19, 0x13, 0x7a,
+	0x18, 0x3b, 0x3d, 0x7c, 0x52, 0x85, 0x93, 0xd7, 0x34, 0xc3, 0xb4, 0xf7, 0xb0, 0x33, 0xe1, 0x87,
+	0xc1, 0xf9, 0xe1, 0x5b, 0x83, 0xf1, 0x43, 0x34, 0x68, 0x67, 0x40, 0x3c, 0x32, 0x41, 0x7c, 0x77,
+	0x50, 0x82, 0xb8, 0xd2, 0xa7, 0x22, 0x47, 0x93, 0x21, 0x2e, 0xc2, 0x9c, 0x66, 0x9a, 0xf6, 0x83,
+	0x60, 0x75, 0x16, 0x87, 0xc7, 0x50, 0xc2, 0x65, 0x14, 0xd1, 0x23, 0x74, 0x01, 0x50, 0x5c, 0x4b,
+	0x7f, 0x1f, 0x14, 0x5b, 0xfa, 0x86, 0x1e, 0x1e, 0x24, 0x13, 0x3c, 0x61, 0xb6, 0x68, 0x11, 0xb3,
+	0x45, 0x9b, 0x85, 0xd4, 0x40, 0x24, 0x34, 0x27, 0x21, 0xa1, 0x13, 0x52, 0x12, 0x9a, 0xff, 0xec,
+	0x91, 0x50, 0xd3, 0x85, 0xd9, 0x04, 0xed, 0xaf, 0xf7, 0xb0, 0x9b, 0xa9, 0xf9, 0xdc, 0xb0, 0x9a,
+	0x57, 0xb2, 0x34, 0xdf, 0xfa, 0x69, 0x3e, 0x5a, 0x30, 0x0e, 0x04, 0xac, 0x39, 0xf6, 0x10, 0x51,
+	0x3a, 0xfd, 0xc2, 0x83, 0xfa, 0x07, 0x08, 0x8b, 0xf8, 0xab, 0x98, 0xc1, 0x5f, 0x67, 0x00, 0x34,
+	0x3d, 0x6c, 0xa8, 0x4b, 0xf6, 0x8c, 0xaa, 0x2a, 0x95, 0x13, 0x9c, 0xd5, 0xec, 0xd8, 0x7b, 0x38,
+	0x2a, 0x52, 0x26, 0x45, 0xd8, 0xcc, 0x4c, 0x9e, 0x1b, 0x65, 0x53, 0x7e, 0x11, 0x66, 0xef, 0xf9,
+	0xc0, 0x6d, 0x27, 0x3b, 0x36, 0x41, 0x40, 0x0d, 0x9f, 0x8d, 0x3e, 0x0f, 0x33, 0x49, 0x8d, 0x55,
+	0x7c, 0x37, 0x24, 0xa6, 0x3a, 0xb3, 0x42, 0xa9, 0xe2, 0xbb, 0x2a, 0x5b, 0xac, 0xf5, 0xa7, 0x3c,
+	0xcc, 0xdf, 0xea, 0xea, 0x03, 0xe8, 0x89, 0xd5, 0x89, 0x92, 0xd2, 0x09, 0x8b, 0x62, 0xbe, 0x3f,
+	0x8a, 0x05, 0x39, 0x8a, 0xc5, 0x2c, 0x14, 0x4b, 0x52, 0x14, 0xd3, 0xa1, 0xbe, 0x69, 0x6c, 0x2a,
+	0x03, 0x61, 0x83, 0xbe, 0x08, 0x75, 0xa6, 0x72, 0xfe, 0xab, 0xd5, 0x8c, 0x57, 0x53, 0x25, 0xd1,
+	0xb5, 0xd4, 0xb9, 0xa9, 0x68, 0x76, 0x26, 0xc4, 0x3b, 0x8b, 0xf9, 0x46, 0x73, 0x40, 0xfe, 0xa0,
+	0x44, 0xab, 0x9a, 0xfd, 0xd4, 0x9b, 0x15, 0xe7, 0x79, 0xe0, 0x48, 0x3d, 0x5e, 0x31, 0xc5, 0xb4,
+	0x62, 0x2e, 0x02, 0xec, 0x25, 0xd0, 0x96, 0x32, 0xa0, 0x85, 0x3d, 0x31, 0xa8, 0x65, 0xc1, 0x6a,
+	0xd8, 0x43, 0x01, 0xf5, 0xc3, 0x1c, 0x54, 0xe3, 0xea, 0x0d, 0xc4, 0x67, 0x0b, 0x50, 0x72, 0x3d,
+	0xcd, 0xeb, 0xb9, 0xd1, 0x39, 0xc6, 0x20, 0x85, 0x5e, 0x4e, 0xed, 0x9f, 0x9e, 0xe1, 0x9b, 0x7f,
+	0x38, 0x4d, 0xf8, 0x65, 0x1e, 0x4e, 0x06, 0xf4, 0xbc, 0x46, 0x13, 0xc9, 0x18, 0x1d, 0xd3, 0x06,
+	0x94, 0x09, 0x47, 0xc5, 0x0e, 0x69, 0x94, 0xcc, 0xec, 0xe2, 0x97, 0xa1, 0x1a, 0xed, 0x43, 0xbb,
+	0xa1, 0x31, 0xfc, 0x77, 0x9f, 0xa3, 0x19, 0x6a, 0xf2, 0x06, 0x5a, 0x4f, 0x99, 0xc6, 0xb3, 0xcc,
+	0xdb, 0xa9, 0x86, 0x3e, 0x7e, 0xf1, 0xed, 0xff, 0x56, 0xe0, 0x64, 0x60, 0xe7, 0xfd, 0xb5, 0x46,
+	0xe1, 0xaf, 0x64, 0xe1, 0x9f, 0xcf, 0xc6, 0xbf, 0xc0, 0xe0, 0x9f, 0x75, 0xa6, 0x23, 0x0b, 0xff,
+	0x22, 0x83, 0x7f, 0x46, 0x95, 0x07, 0xc4, 0xbf, 0x24, 0xc3, 0xbf, 0x2c, 0xc5, 0x7f, 0xdc, 0xc1,
+	0x31, 0xff, 0xca, 0x41, 0x3d, 0xf0, 0x8b, 0xa8, 0x80, 0xf9, 0x74, 0x80, 0x5f, 0x4e, 0x18, 0xe0,
+	0x77, 0x0e, 0x6a, 0x6d, 0xdb, 0xb2, 0x70, 0x9b, 0x38, 0x83, 0x41, 0x58, 0x28, 0x29, 0xc7, 0xe6,
+	0x32, 0x47, 0xe9, 0xf2, 0xcc, 0x51, 0x3a, 0xfe, 0xd3, 0x99, 0x00, 0x66, 0x72, 0xf2, 0xe8, 0xcd,
+	0x5f, 0xc5, 0x8f, 0xac, 0xf9, 0xab, 0xf8, 0xd1, 0x36, 0xdf, 0x81, 0xda, 0x8a, 0xdd, 0xdd, 0xa7,
+	0xda, 0xde, 0x80, 0xb2, 0xeb, 0xb4, 0x49, 0xcc, 0x52, 0x20, 0x21, 0x4a, 0xfa, 0x4f, 0x74, 0xd7,
+	0x23, 0x4f, 0xc2, 0xde, 0x17, 0x26, 0x85, 0x91, 0xac, 0xd9, 0xd1, 0xee, 0x37, 0xa0, 0x7e, 0xcd,
+	0xc1, 0xf8, 0x6d, 0xfa, 0xe0, 0xe1, 0x19, 0x80, 0x8e, 0xdd, 0xb3, 0xbc, 0xae, 0x6d, 0x58, 0x5e,
+	0xf8, 0x61, 0x2a, 0x87, 0x96, 0xa6, 0xb0, 0xd2, 0xfe, 0x9a, 0x87, 0xb9, 0x80, 0x0c, 0xaf, 0x19,
+	0x26, 0xde, 0xde, 0xd5, 0x9c, 0xc3, 0xbe, 0x97, 0xe1, 0xd1, 0x2e, 0x23, 0xac, 0xa6, 0x5c, 0xb0,
+	0x45, 0x66, 0x48, 0x60, 0x50, 0xf8, 0x34, 0x5d, 0xbd, 0xf0, 0x91, 0x02, 0x73, 0x01, 0xeb, 0xca,
+	0x15, 0x7d, 0xb0, 0xdb, 0x17, 0x56, 0x53, 0x5e, 0xcb, 0x22, 0xc3, 0xf4, 0x07, 0x81, 0xf5, 0xf1,
+	0xb8, 0xd4, 0x24, 0x0f, 0xa7, 0x38, 0xcb, 0x39, 0x04, 0xcf, 0xe9, 0x2c, 0x4c, 0xf9, 0x8d, 0x71,
+	0x7d, 0xf1, 0xb1, 0xf7, 0x44, 0x67, 0xc5, 0x7d, 0xb1, 0x48, 0xf5, 0xc5, 0x1b, 0xa9, 0xb0, 0xc7,
+	0x8b, 0x62, 0x5b, 0x3f, 0xc0, 0x10, 0x3c, 0x4c, 0xd4, 0x23, 0xa7, 0x82, 0xea, 0x98, 0x55, 0xf0,
+	0x2b, 0x05, 0x4e, 0x71, 0x56, 0x26, 0x55, 0x01, 0x07, 0xa6, 0x92, 0x06, 0xf3, 0x46, 0x6a, 0xc0,
+	0xb9, 0x28, 0xb6, 0xe6, 0xc7, 0xfb, 0xa8, 0xdf, 0xcf, 0xf2, 0xd1, 0xb9, 0xa9, 0xb8, 0x41, 0xcb,
+	0x6d, 0xf3, 0x80, 0x98, 0x21, 0x28, 0x78, 0x7e, 0x78, 0x63, 0x18, 0xc8, 0xe8, 0xff, 0xf6, 0x89,
+	0x38, 0x18, 0xf2, 0x77, 0xec, 0x70, 0x6a, 0x1f, 0xa7, 0xc9, 0x30, 0x40, 0x7e, 0xaf, 0x68, 0xdd,
+	0x90, 0xf2, 0x89, 0x8f, 0x58, 0x55, 0x53, 0xf9, 0x7c, 0x07, 0x29, 0xa5, 0x3b, 0x48, 0xbf, 0x13,
+	0x55, 0x7c, 0x03, 0x1f, 0x3f, 0x47, 0xff, 0x83, 0xf8, 0x9c, 0xd1, 0x18, 0x94, 0xb5, 0x96, 0x32,
+	0xf0, 0xf3, 0x62, 0x03, 0x1f, 0x0e, 0xae, 0x23, 0x64, 0xdb, 0x7f, 0xcf, 0xc1, 0xec, 0x1a, 0xb6,
+	0xb0, 0x63, 0xb4, 0x55, 0xec, 0x76, 0x6d, 0xcb, 0xc5, 0xe8, 0x12, 0x94, 0x1c, 0xec, 0xf6, 0xcc,
+	0xc0, 0x43, 0x9a, 0x5a, 0x7a, 0x22, 0x6c, 0x33, 0x57, 0xee, 0x82, 0x4a, 0x0a, 0xad, 0x1f, 0x53,
+	0xc3, 0xe2, 0xe8, 0x45, 0x28, 0x62, 0xc7, 0xb1, 0x1d, 0xf2, 0x99, 0xa9, 0xa5, 0xd3, 0x19, 0xef,
+	0x5d, 0xf5, 0xcb, 0xac, 0x1f, 0x53, 0x83, 0xc2, 0xcd, 0x16, 0x94, 0x02, 0x49, 0x3e, 0x0a, 0x1d,
+	0xec, 0xba, 0xda, 0x3d, 0x1c, 0x39, 0x85, 0x61, 0xb2, 0x79, 0x19, 0x8a, 0xe4, 0x2d, 0xbf, 0xfb,
+	0xb4, 0x6d, 0x3d, 0x7a, 0x4e, 0x7e, 0xf3, 0x66, 0xaf, 0xa4, 0xcc, 0xfe, 0x4a, 0x19, 0x8a, 0x0e,
+	0xee, 0x9a, 0xfb, 0xad, 0x9f, 0xe4, 0xa0, 0xb6, 0x86, 0xbd, 0x4d, 0xec, 0x39, 0x46, 0xdb, 0x8d,
+	0x7c, 0x42, 0xc3, 0x72, 0x3d, 0xcd, 0x6a, 0xe3, 0xf8, 0x1c, 0x26, 0x95, 0xe3, 0x3f, 0xef, 0x90,
+	0xe2, 0xf4, 0xe2, 0x5d, 0x92, 0xe3, 0x3b, 0x02, 0xae, 0xa7, 0x39, 0xde, 0x8e, 0x11, 0x2f, 0xf2,
+	0x24, 0x19, 0x7e, 0x93, 0xb0, 0xa5, 0xef, 0x18, 0xb1, 0xd6, 0xa3, 0x64, 0xb6, 0xca, 0x5b, 0x2b,
+	0x30, 0xb3, 0x8e, 0x35, 0xc7, 0xbb, 0x83, 0x35, 0x2f, 0x72, 0x96, 0x83, 0x1d, 0x0b, 0x97, 0xc4,
+	0x2b, 0x57, 0xd5, 0x28, 0x29, 0x71, 0x58, 0x57, 0x60, 0x46, 0xf5, 0xd7, 0x96, 0xdb, 0x86, 0x19,
+	0x7b, 0xdc, 0x5a, 0xcf, 0xb3, 0xaf, 0x19, 0x6f, 0x85, 0x8b, 0xd5, 0x51, 0x52, 0x22, 0xe4, 0xff,
+	0x60, 0x66, 0x5d, 0xb3, 0x74, 0x77, 0x57, 0xbb, 0x1f, 0x0b, 0xd9, 0xc3, 0x8e, 0xeb, 0xc3, 0xec,
+	0x0b, 0x29, 0xaa, 0x51, 0xb2, 0xf5, 0x00, 0x6a, 0x71, 0x51, 0x7f, 0xe9, 0x7e, 0x3f, 0xbb, 0xac,
+	0x70, 0x70, 0xbf, 0x04, 0xd0, 0x4e, 0x18, 0x2e, 0xcf, 0x9c, 0xa2, 0x0a, 0xd6, 0xff, 0x13, 0xa2,
+	0x53, 0xa9, 0xa2, 0xad, 0xf7, 0xfd, 0xa9, 0x15, 0x57, 0xc0, 0x37, 0x89, 0xbd, 0x64, 0x3d, 0x2b,
+	0x6c, 0x30, 0x9d, 0xe5, 0x97, 0xa0, 0xc2, 0x48, 0x49, 0x55, 0x2a, 0x2a, 0x9d, 0x45, 0x6e, 0x79,
+	0x62, 0x82, 0xf0, 0xc3, 0xf3, 0x17, 0x5c, 0x6e, 0x60, 0xb5, 0xc4, 0x9e, 0xc2, 0xe3, 0x17, 0x51,
+	0xb2, 0x35, 0x0d, 0xb0, 0x65, 0xf6, 0xee, 0x19, 0x64, 0x7f, 0xa6, 0xf5, 0x3a, 0xa0, 0x15, 0xdb,
+	0x34, 0x71, 0x9b, 0x31, 0x3f, 0xea, 0xed, 0x50, 0xb7, 0x61, 0x92, 0x33, 0x4c, 0x85, 0x37, 0xcc,
+	0xd6, 0x36, 0xcc, 0xdd, 0xd6, 0x4c, 0x43, 0xd7, 0x3c, 0x3c, 0x98, 0xc0, 0x16, 0x4c, 0x3b, 0x38,
+	0x38, 0xbe, 0x4a, 0x45, 0xd8, 0x33, 0x79, 0x4b, 0xbf, 0x46, 0x00, 0x2b, 0xb6, 0xe5, 0x39, 0x7e,
+	0x4d, 0x1d, 0xb4, 0x0c, 0xd3, 0xf4, 0x02, 0x12, 0xca, 0x3a, 0xd7, 0xd6, 0x5c, 0x10, 0xf7, 0xf5,
+	0xd6, 0x31, 0x5f, 0x04, 0xbd, 0x06, 0x12, 0x8b, 0xe0, 0xaf, 0xf0, 0x93, 0x8b, 0xa0, 0x6f, 0x7b,
+	0x8b, 0x45, 0xf0, 0x57, 0xc0, 0xc9, 0x45, 0xd0, 0x17, 0xaa, 0xc5, 0x22, 0xf8, 0x5b, 0xd6, 0x24,
+	0x22, 0x56, 0x60, 0x86, 0xb9, 0xb6, 0x0b, 0x35, 0xb2, 0x2e, 0xf3, 0x92, 0x08, 0xb9, 0x05, 0x0b,
+	0xe2, 0xeb, 0xa0, 0xd0, 0x93, 0x7d, 0x6f, 0x8b, 0x92, 0x37, 0x8f, 0xbe, 0x8b, 0x22, 0x6e, 0x1e,
+	0x7f, 0x85, 0x90, 0x44, 0xc4, 0x55, 0xa8, 0xb1, 0xf7, 0x33, 0xa0, 0xff, 0xca, 0xbc, 0x6c, 0x47,
+	0x22, 0xe6, 0x0d, 0x38, 0x21, 0x5a, 0x72, 0x44, 0xfd, 0xd6, 0x23, 0xe5, 0x22, 0x45, 0xab, 0x68,
+	0xa8, 0xdf, 0x12, 0x9b, 0x5c, 0xa4, 0xe8, 0xee, 0x8e, 0x58, 0x64, 0xd6, 0xc5, 0x1e, 0x72, 0xcd,
+	0x8a, 0xef, 0xb7, 0x88, 0x35, 0x9b, 0x7d, 0xfd, 0x85, 0x44, 0xec, 0x26, 0xa0, 0xf4, 0xe9, 0x74,
+	0xf4, 0x84, 0xf4, 0xe0, 0xba, 0x5c, 0x5c, 0xfa, 0x10, 0x75, 0x2c, 0x4e, 0x7c, 0xbe, 0x5a, 0x22,
+	0xee, 0x26, 0xcc, 0x09, 0x4e, 0xf8, 0xa2, 0x33, 0xf2, 0xd3, 0xbf, 0x72, 0x14, 0xc5, 0x27, 0x38,
+	0x63, 0x14, 0xb3, 0x0f, 0x78, 0xca, 0xc5, 0x8a, 0x8f, 0x24, 0xc6, 0x62, 0xb3, 0x4f, 0x2c, 0x4a,
+	0xc4, 0x5e, 0x87, 0xe3, 0xa9, 0xe3, 0x10, 0xe8, 0xb4, 0xec, 0xa0, 0x84, 0x5c, 0x58, 0x2a, 0x2e,
+	0x39, 0x16, 0x26, 0x8c, 0x58, 0x96, 0x0b, 0x4b, 0x45, 0x32, 0xc6, 0xc2, 0x84, 0x31, 0x8e, 0x7d,
+	0x8c, 0x26, 0x15, 0xf8, 0x94, 0x18, 0x8d, 0xe1, 0x0e, 0x27, 0xee, 0x26, 0xcc, 0x09, 0x62, 0x18,
+	0x62, 0xa3, 0xc9, 0x88, 0x6f, 0x18, 0x44, 0x0d, 0xd4, 0x1e, 0x16, 0xa7, 0x06, 0x6e, 0x77, 0x4b,
+	0x2e, 0x2c, 0xb5, 0xcb, 0x18, 0x0b, 0x13, 0xee, 0x3f, 0x0e, 0xa2, 0x53, 0x91, 0x30, 0xe1, 0xbe,
+	0x9b, 0x1c, 0x37, 0xc1, 0x7e, 0x4c, 0x8c, 0x5b, 0xc6, 0x5e, 0x8d, 0x5c, 0xa0, 0x60, 0x83, 0x21,
+	0x16, 0x98, 0xb1, 0xf9, 0x20, 0x11, 0x78, 0x19, 0x20, 0xf1, 0xae, 0xd1, 0x7c, 0x5c, 0x8e, 0xf6,
+	0x78, 0x24, 0xaf, 0xbf, 0x02, 0xd5, 0xd8, 0xf1, 0x45, 0x27, 0xa2, 0xb3, 0x93, 0xb4, 0x2b, 0x2c,
+	0x79, 0x79, 0x15, 0xd0, 0x1a, 0xf6, 0x62, 0x9f, 0x57, 0xc5, 0x5d, 0xdb, 0x49, 0xa4, 0x30, 0xbe,
+	0xb0, 0xbc, 0x0a, 0x71, 0xd1, 0x61, 0x5f, 0x5e, 0xfa, 0x1e, 0x82, 0x99, 0x2d, 0xc7, 0xde, 0x33,
+	0x7c, 0x2f, 0x77, 0xd5, 0x6e, 0xdf, 0xff, 0xf4, 0xf8, 0x4f, 0x13, 0xe7, 0x67, 0xe2, 0xfc, 0x4c,
+	0x9c, 0x9f, 0x89, 0xf3, 0x33, 0x71, 0x7e, 0x26, 0xce, 0xcf, 0xc4, 0xf9, 0xc9, 0x72, 0x7e, 0x92,
+	0x8b, 0xe8, 0x62, 0xe7, 0x87, 0xbd, 0xb6, 0x4f, 0x6e, 0x67, 0xe9, 0x7b, 0xec, 0x62, 0x3b, 0x13,
+	0x5f, 0x71, 0x27, 0x11, 0xf7, 0x2a, 0x4c, 0x51, 0xb7, 0xcd, 0xa1, 0xa8, 0x20, 0x77, 0x03, 0x9d,
+	0x44, 0xc0, 0x1a, 0xcc, 0x25, 0x85, 0xb7, 0xe3, 0x58, 0x95, 0xa1, 0x05, 0x2d, 0x7d, 0x92, 0x87,
+	0xb9, 0x78, 0xa1, 0x9c, 0x5a, 0x5b, 0x5a, 0x83, 0x59, 0x6e, 0xd3, 0x01, 0x35, 0xb3, 0xf7, 0x98,
+	0xa5, 0x35, 0x9d, 0xe5, 0x96, 0xe3, 0x63, 0x41, 0x82, 0x5d, 0x55, 0x89, 0xa0, 0x2f, 0x47, 0x91,
+	0x5d, 0xa9, 0x8d, 0x2b, 0xd4, 0xea, 0xbf, 0x23, 0x28, 0x17, 0x9c, 0xb1, 0x23, 0x16, 0x0b, 0x96,
+	0xec, 0x98, 0x0d, 0x32, 0x40, 0xd2, 0x3b, 0x11, 0xdc, 0x00, 0xc9, 0x6f, 0x52, 0x0c, 0x32, 0x40,
+	0x0a, 0xc5, 0x89, 0xf7, 0x3c, 0x24, 0x9a, 0xff, 0x47, 0x1e, 0x66, 0xe2, 0xe2, 0xc4, 0x1f, 0x9e,
+	0xe8, 0xfc, 0xd3, 0xae, 0xf3, 0x8f, 0xea, 0x30, 0x1d, 0x2c, 0xc7, 0x07, 0x4b, 0xdf, 0xe8, 0x65,
+	0xa8, 0xc6, 0x1b, 0x03, 0xc9, 0xa4, 0x8e, 0xde, 0x55, 0x68, 0xce, 0xf3, 0xb9, 0x64, 0x03, 0xa1,
+	0x75, 0xcc, 0xdf, 0x50, 0xda, 0xc6, 0x5e, 0xaf, 0x8b, 0xa2, 0xeb, 0x22, 0x92, 0xe5, 0x74, 0x49,
+	0x8b, 0x5e, 0x84, 0xe2, 0x2d, 0xcb, 0xc5, 0xde, 0x70, 0x6f, 0x8d, 0x61, 0xaa, 0xf6, 0x1a, 0x4c,
+	0xad, 0x98, 0xb6, 0x35, 0x82, 0x84, 0x11, 0xc7, 0x90, 0xc9, 0x5c, 0xf1, 0xf1, 0x99, 0x2b, 0x6e,
+	0xc3, 0x89, 0x0d, 0x72, 0x39, 0x91, 0x69, 0xbc, 0x8d, 0x57, 0xe2, 0x48, 0xbe, 0xd1, 0x5c, 0x7d,
+	0x15, 0xe6, 0x76, 0xb0, 0xd3, 0x31, 0x2c, 0xcd, 0x13, 0xc9, 0x3c, 0xa0, 0x9f, 0x5f, 0x63, 0xaf,
+	0xff, 0x1a, 0x65, 0x3a, 0xbb, 0x06, 0xd3, 0xbe, 0x35, 0x8f, 0xee, 0xdf, 0x5c, 0x87, 0x5a, 0xa0,
+	0xb3, 0x71, 0x4c, 0x5f, 0x6f, 0x42, 0x3d, 0xd2, 0xdd, 0x78, 0x26, 0xae, 0xd7, 0xa1, 0xc6, 0x5e,
+	0xe3, 0x35, 0xca, 0x7c, 0xfd, 0x6b, 0x70, 0x3a, 0xb1, 0x94, 0xe8, 0x1d, 0x4a, 0xbb, 0x4f, 0x0d,
+	0x70, 0x49, 0x9b, 0x44, 0xfc, 0x57, 0xe1, 0x54, 0x6c, 0x33, 0x12, 0xe9, 0xb2, 0x6b, 0xc9, 0x26,
+	0xd3, 0x93, 0xa3, 0x3f, 0x3d, 0x79, 0x09, 0xaa, 0xbe, 0xcf, 0xee, 0xff, 0x73, 0x22, 0x77, 0xb8,
+	0x91, 0x71, 0xe4, 0xa9, 0xc4, 0x32, 0xcc, 0xf8, 0x85, 0x47, 0x99, 0x44, 0xbc, 0x47, 0x6e, 0xc3,
+	0xe5, 0x4e, 0x04, 0x86, 0x1e, 0xc6, 0xc3, 0xf4, 0x12, 0x26, 0x8b, 0x1e, 0x47, 0x61, 0xd1, 0x63,
+	0xe9, 0x47, 0x0a, 0xa0, 0x60, 0x4f, 0x61, 0x0c, 0x96, 0x70, 0x09, 0x2a, 0x3b, 0x58, 0x73, 0x74,
+	0xfb, 0x81, 0x35, 0xdc, 0x8b, 0x57, 0xa1, 0xc6, 0x46, 0x74, 0xc4, 0x9e, 0x40, 0x3a, 0xd0, 0x43,
+	0x3a, 0x68, 0x37, 0xb9, 0x40, 0x8e, 0xed, 0x5e, 0xb7, 0x6b, 0x3b, 0x9e, 0xdf, 0x3d, 0xe2, 0x69,
+	0x8d, 0x20, 0xd6, 0x43, 0x02, 0xd0, 0x1f, 0x15, 0x80, 0x80, 0xa3, 0xa3, 0x7d, 0x08, 0xfa, 0x08,
+	0x44, 0xec, 0xf6, 0xf0, 0xe7, 0x22, 0xfa, 0xf9, 0x96, 0x02, 0x11, 0xab, 0x78, 0x60, 0x11, 0x97,
+	0x01, 0x92, 0x63, 0x00, 0xb1, 0x77, 0xcb, 0x9e, 0x0c, 0x90, 0xd7, 0x80, 0x8e, 0xe8, 0x8f, 0x6b,
+	0xc0, 0x87, 0xf9, 0x4b, 0xa9, 0x0c, 0x76, 0x76, 0xb5, 0x07, 0x07, 0x16, 0x70, 0xa7, 0x44, 0x1e,
+	0xfc, 0xff, 0x7f, 0x06, 0x00, 0x57, 0xd5, 0x51, 0x1f, 0x8d, 0x76, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ControllerClient is the client API for Controller service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ControllerClient interface {
+	// Create a volume
+	CreateVolume(ctx context.Context, in *CreateVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Delete a volume
+	DeleteVolume(ctx context.Context, in *DeleteVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Extend a volume
+	ExtendVolume(ctx context.Context, in *ExtendVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Change the profile of a volume
+	RetypeVolume(ctx context.Context, in *RetypeVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Migrate a volume to another pool
+	MigrateVolume(ctx context.Context, in *MigrateVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Revert a volume to one of its snapshots
+	RevertVolumeToSnapshot(ctx context.Context, in *RevertVolumeToSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Take over a volume existing in the backend
+	ManageVolume(ctx context.Context, in *ManageVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Give up the management of a volume and leave it in the backend
+	UnmanageVolume(ctx context.Context, in *UnmanageVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Create a volume snapshot
+	CreateVolumeSnapshot(ctx context.Context, in *CreateVolumeSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Delete a volume snapshot
+	DeleteVolumeSnapshot(ctx context.Context, in *DeleteVolumeSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Take over a volume snapshot existing in the backend
+	ManageVolumeSnapshot(ctx context.Context, in *ManageVolumeSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Give up the management of a volume snapshot and leave it in the backend
+	UnmanageVolumeSnapshot(ctx context.Context, in *UnmanageVolumeSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Create a volume backup
+	CreateVolumeBackup(ctx context.Context, in *CreateVolumeBackupOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Delete a volume backup
+	DeleteVolumeBackup(ctx context.Context, in *DeleteVolumeBackupOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Restore a volume backup
+	RestoreVolumeBackup(ctx context.Context, in *RestoreVolumeBackupOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Create a volume attachment
+	CreateVolumeAttachment(ctx context.Context, in *CreateVolumeAttachmentOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Delete a volume attachment
+	DeleteVolumeAttachment(ctx context.Context, in *DeleteVolumeAttachmentOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Create a replication
+	CreateReplication(ctx context.Context, in *CreateReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Delete a replication
+	DeleteReplication(ctx context.Context, in *DeleteReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Enable a replication
+	EnableReplication(ctx context.Context, in *EnableReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Disable a replication
+	DisableReplication(ctx context.Context, in *DisableReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Failover a replication
+	FailoverReplication(ctx context.Context, in *FailoverReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Create a volume group
+	CreateVolumeGroup(ctx context.Context, in *CreateVolumeGroupOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Update volume group
+	UpdateVolumeGroup(ctx context.Context, in *UpdateVolumeGroupOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Delete volume group
+	DeleteVolumeGroup(ctx context.Context, in *DeleteVolumeGroupOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Create a snapshot of all the volumes in a volume group
+	CreateGroupSnapshot(ctx context.Context, in *CreateGroupSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Delete a volume group snapshot
+	DeleteGroupSnapshot(ctx context.Context, in *DeleteGroupSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	GetMetrics(ctx context.Context, in *GetMetricsOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Tell the controller that the docks are still alive
+	Heartbeat(ctx context.Context, in *HeartbeatOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Get the report of the last reconciliation between the database and
+	// the backends
+	GetReconcileReport(ctx context.Context, in *ReconcileOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Start a reconciliation between the database and the backends
+	Reconcile(ctx context.Context, in *ReconcileOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+}
+
+type controllerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewControllerClient(cc *grpc.ClientConn) ControllerClient {
+	return &controllerClient{cc}
+}
+
+func (c *controllerClient) CreateVolume(ctx context.Context, in *CreateVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.Controller/CreateVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerClient) DeleteVolume(ctx context.Context, in *DeleteVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.Controller/DeleteVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerClient) ExtendVolume(ctx context.Context, in *ExtendVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.Controller/ExtendVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerClient) RetypeVolume(ctx context.Context, in *RetypeVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.Controller/RetypeVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerClient) MigrateVolume(ctx context.Context, in *MigrateVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.Controller/MigrateVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerClient) RevertVolumeToSnapshot(ctx context.Context, in *RevertVolumeToSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.Controller/RevertVolumeToSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerClient) ManageVolume(ctx context.Context, in *ManageVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.Controller/ManageVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerClient) UnmanageVolume(ctx context.Context, in *UnmanageVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.Controller/UnmanageVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerClient) CreateVolumeSnapshot(ctx context.Context, in *CreateVolumeSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.Controller/CreateVolumeSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerClient) DeleteVolumeSnapshot(ctx context.Context, in *DeleteVolumeSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.Controller/DeleteVolumeSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerClient) ManageVolumeSnapshot(ctx context.Context, in *ManageVolumeSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.Controller/ManageVolumeSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerClient) UnmanageVolumeSnapshot(ctx context.Context, in *UnmanageVolumeSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.Controller/UnmanageVolumeSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerClient) CreateVolumeBackup(ctx context.Context, in *CreateVolumeBackupOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.Controller/CreateVolumeBackup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerClient) DeleteVolumeBackup(ctx context.Context, in *DeleteVolumeBackupOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.Controller/DeleteVolumeBackup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerClient) RestoreVolumeBackup(ctx context.Context, in *RestoreVolumeBackupOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.Controller/RestoreVolumeBackup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerClient) CreateVolumeAttachment(ctx context.Context, in *CreateVolumeAttachmentOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.Controller/CreateVolumeAttachment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerClient) DeleteVolumeAttachment(ctx context.Context, in *DeleteVolumeAttachmentOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.Controller/DeleteVolumeAttachment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerClient) CreateReplication(ctx context.Context, in *CreateReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.Controller/CreateReplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerClient) DeleteReplication(ctx context.Context, in *DeleteReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.Controller/DeleteReplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerClient) EnableReplication(ctx context.Context, in *EnableReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.Controller/EnableReplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerClient) DisableReplication(ctx context.Context, in *DisableReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.Controller/DisableReplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerClient) FailoverReplication(ctx context.Context, in *FailoverReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.Controller/FailoverReplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerClient) CreateVolumeGroup(ctx context.Context, in *CreateVolumeGroupOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.Controller/CreateVolumeGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerClient) UpdateVolumeGroup(ctx context.Context, in *UpdateVolumeGroupOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.Controller/UpdateVolumeGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerClient) DeleteVolumeGroup(ctx context.Context, in *DeleteVolumeGroupOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.Controller/DeleteVolumeGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerClient) CreateGroupSnapshot(ctx context.Context, in *CreateGroupSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.Controller/CreateGroupSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerClient) DeleteGroupSnapshot(ctx context.Context, in *DeleteGroupSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.Controller/DeleteGroupSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerClient) GetMetrics(ctx context.Context, in *GetMetricsOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.Controller/GetMetrics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerClient) Heartbeat(ctx context.Context, in *HeartbeatOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.Controller/Heartbeat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerClient) GetReconcileReport(ctx context.Context, in *ReconcileOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.Controller/GetReconcileReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerClient) Reconcile(ctx context.Context, in *ReconcileOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.Controller/Reconcile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ControllerServer is the server API for Controller service.
+type ControllerServer interface {
+	// Create a volume
+	CreateVolume(context.Context, *CreateVolumeOpts) (*GenericResponse, error)
+	// Delete a volume
+	DeleteVolume(context.Context, *DeleteVolumeOpts) (*GenericResponse, error)
+	// Extend a volume
+	ExtendVolume(context.Context, *ExtendVolumeOpts) (*GenericResponse, error)
+	// Change the profile of a volume
+	RetypeVolume(context.Context, *RetypeVolumeOpts) (*GenericResponse, error)
+	// Migrate a volume to another pool
+	MigrateVolume(context.Context, *MigrateVolumeOpts) (*GenericResponse, error)
+	// Revert a volume to one of its snapshots
+	RevertVolumeToSnapshot(context.Context, *RevertVolumeToSnapshotOpts) (*GenericResponse, error)
+	// Take over a volume existing in the backend
+	ManageVolume(context.Context, *ManageVolumeOpts) (*GenericResponse, error)
+	// Give up the management of a volume and leave it in the backend
+	UnmanageVolume(context.Context, *UnmanageVolumeOpts) (*GenericResponse, error)
+	// Create a volume snapshot
+	CreateVolumeSnapshot(context.Context, *CreateVolumeSnapshotOpts) (*GenericResponse, error)
+	// Delete a volume snapshot
+	DeleteVolumeSnapshot(context.Context, *DeleteVolumeSnapshotOpts) (*GenericResponse, error)
+	// Take over a volume snapshot existing in the backend
+	ManageVolumeSnapshot(context.Context, *ManageVolumeSnapshotOpts) (*GenericResponse, error)
+	// Give up the management of a volume snapshot and leave it in the backend
+	UnmanageVolumeSnapshot(context.Context, *UnmanageVolumeSnapshotOpts) (*GenericResponse, error)
+	// Create a volume backup
+	CreateVolumeBackup(context.Context, *CreateVolumeBackupOpts) (*GenericResponse, error)
+	// Delete a volume backup
+	DeleteVolumeBackup(context.Context, *DeleteVolumeBackupOpts) (*GenericResponse, error)
+	// Restore a volume backup
+	RestoreVolumeBackup(context.Context, *RestoreVolumeBackupOpts) (*GenericResponse, error)
+	// Create a volume attachment
+	CreateVolumeAttachment(context.Context, *CreateVolumeAttachmentOpts) (*GenericResponse, error)
+	// Delete a volume attachment
+	DeleteVolumeAttachment(context.Context, *DeleteVolumeAttachmentOpts) (*GenericResponse, error)
+	// Create a replication
+	CreateReplication(context.Context, *CreateReplicationOpts) (*GenericResponse, error)
+	// Delete a replication
+	DeleteReplication(context.Context, *DeleteReplicationOpts) (*GenericResponse, error)
+	// Enable a replication
+	EnableReplication(context.Context, *EnableReplicationOpts) (*GenericResponse, error)
+	// Disable a replication
+	DisableReplication(context.Context, *DisableReplicationOpts) (*GenericResponse, error)
+	// Failover a replication
+	FailoverReplication(context.Context, *FailoverReplicationOpts) (*GenericResponse, error)
+	// Create a volume group
+	CreateVolumeGroup(context.Context, *CreateVolumeGroupOpts) (*GenericResponse, error)
+	// Update volume group
+	UpdateVolumeGroup(context.Context, *UpdateVolumeGroupOpts) (*GenericResponse, error)
+	// Delete volume group
+	DeleteVolumeGroup(context.Context, *DeleteVolumeGroupOpts) (*GenericResponse, error)
+	// Create a snapshot of all the volumes in a volume group
+	CreateGroupSnapshot(context.Context, *CreateGroupSnapshotOpts) (*GenericResponse, error)
+	// Delete a volume group snapshot
+	DeleteGroupSnapshot(context.Context, *DeleteGroupSnapshotOpts) (*GenericResponse, error)
+	GetMetrics(context.Context, *GetMetricsOpts) (*GenericResponse, error)
+	// Tell the controller that the docks are still alive
+	Heartbeat(context.Context, *HeartbeatOpts) (*GenericResponse, error)
+	// Get the report of the last reconciliation between the database and
+	// the backends
+	GetReconcileReport(context.Context, *ReconcileOpts) (*GenericResponse, error)
+	// Start a reconciliation between the database and the backends
+	Reconcile(context.Context, *ReconcileOpts) (*GenericResponse, error)
+}
+
+// UnimplementedControllerServer can be embedded to have forward compatible implementations.
+type UnimplementedControllerServer struct {
+}
+
+func (*UnimplementedControllerServer) CreateVolume(ctx context.Context, req *CreateVolumeOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVolume not implemented")
+}
+func (*UnimplementedControllerServer) DeleteVolume(ctx context.Context, req *DeleteVolumeOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVolume not implemented")
+}
+func (*UnimplementedControllerServer) ExtendVolume(ctx context.Context, req *ExtendVolumeOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendVolume not implemented")
+}
+func (*UnimplementedControllerServer) RetypeVolume(ctx context.Context, req *RetypeVolumeOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetypeVolume not implemented")
+}
+func (*UnimplementedControllerServer) MigrateVolume(ctx context.Context, req *MigrateVolumeOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateVolume not implemented")
+}
+func (*UnimplementedControllerServer) RevertVolumeToSnapshot(ctx context.Context, req *RevertVolumeToSnapshotOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertVolumeToSnapshot not implemented")
+}
+func (*UnimplementedControllerServer) ManageVolume(ctx context.Context, req *ManageVolumeOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ManageVolume not implemented")
+}
+func (*UnimplementedControllerServer) UnmanageVolume(ctx context.Context, req *UnmanageVolumeOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnmanageVolume not implemented")
+}
+func (*UnimplementedControllerServer) CreateVolumeSnapshot(ctx context.Context, req *CreateVolumeSnapshotOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVolumeSnapshot not implemented")
+}
+func (*UnimplementedControllerServer) DeleteVolumeSnapshot(ctx context.Context, req *DeleteVolumeSnapshotOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVolumeSnapshot not implemented")
+}
+func (*UnimplementedControllerServer) ManageVolumeSnapshot(ctx context.Context, req *ManageVolumeSnapshotOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ManageVolumeSnapshot not implemented")
+}
+func (*UnimplementedControllerServer) UnmanageVolumeSnapshot(ctx context.Context, req *UnmanageVolumeSnapshotOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnmanageVolumeSnapshot not implemented")
+}
+func (*UnimplementedControllerServer) CreateVolumeBackup(ctx context.Context, req *CreateVolumeBackupOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVolumeBackup not implemented")
+}
+func (*UnimplementedControllerServer) DeleteVolumeBackup(ctx context.Context, req *DeleteVolumeBackupOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVolumeBackup not implemented")
+}
+func (*UnimplementedControllerServer) RestoreVolumeBackup(ctx context.Context, req *RestoreVolumeBackupOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreVolumeBackup not implemented")
+}
+func (*UnimplementedControllerServer) CreateVolumeAttachment(ctx context.Context, req *CreateVolumeAttachmentOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVolumeAttachment not implemented")
+}
+func (*UnimplementedControllerServer) DeleteVolumeAttachment(ctx context.Context, req *DeleteVolumeAttachmentOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVolumeAttachment not implemented")
+}
+func (*UnimplementedControllerServer) CreateReplication(ctx context.Context, req *CreateReplicationOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReplication not implemented")
+}
+func (*UnimplementedControllerServer) DeleteReplication(ctx context.Context, req *DeleteReplicationOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReplication not implemented")
+}
+func (*UnimplementedControllerServer) EnableReplication(ctx context.Context, req *EnableReplicationOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableReplication not implemented")
+}
+func (*UnimplementedControllerServer) DisableReplication(ctx context.Context, req *DisableReplicationOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableReplication not implemented")
+}
+func (*UnimplementedControllerServer) FailoverReplication(ctx context.Context, req *FailoverReplicationOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FailoverReplication not implemented")
+}
+func (*UnimplementedControllerServer) CreateVolumeGroup(ctx context.Context, req *CreateVolumeGroupOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVolumeGroup not implemented")
+}
+func (*UnimplementedControllerServer) UpdateVolumeGroup(ctx context.Context, req *UpdateVolumeGroupOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVolumeGroup not implemented")
+}
+func (*UnimplementedControllerServer) DeleteVolumeGroup(ctx context.Context, req *DeleteVolumeGroupOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVolumeGroup not implemented")
+}
+func (*UnimplementedControllerServer) CreateGroupSnapshot(ctx context.Context, req *CreateGroupSnapshotOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroupSnapshot not implemented")
+}
+func (*UnimplementedControllerServer) DeleteGroupSnapshot(ctx context.Context, req *DeleteGroupSnapshotOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGroupSnapshot not implemented")
+}
+func (*UnimplementedControllerServer) GetMetrics(ctx context.Context, req *GetMetricsOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMetrics not implemented")
+}
+func (*UnimplementedControllerServer) Heartbeat(ctx context.Context, req *HeartbeatOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
+}
+func (*UnimplementedControllerServer) GetReconcileReport(ctx context.Context, req *ReconcileOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReconcileReport not implemented")
+}
+func (*UnimplementedControllerServer) Reconcile(ctx context.Context, req *ReconcileOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reconcile not implemented")
+}
+
+func RegisterControllerServer(s *grpc.Server, srv ControllerServer) {
+	s.RegisterService(&_Controller_serviceDesc, srv)
+}
+
+func _Controller_CreateVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVolumeOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServer).CreateVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Controller/CreateVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServer).CreateVolume(ctx, req.(*CreateVolumeOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Controller_DeleteVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVolumeOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServer).DeleteVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Controller/DeleteVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServer).DeleteVolume(ctx, req.(*DeleteVolumeOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Controller_ExtendVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtendVolumeOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServer).ExtendVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Controller/ExtendVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServer).ExtendVolume(ctx, req.(*ExtendVolumeOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Controller_RetypeVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetypeVolumeOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServer).RetypeVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Controller/RetypeVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServer).RetypeVolume(ctx, req.(*RetypeVolumeOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Controller_MigrateVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MigrateVolumeOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServer).MigrateVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Controller/MigrateVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServer).MigrateVolume(ctx, req.(*MigrateVolumeOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Controller_RevertVolumeToSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertVolumeToSnapshotOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServer).RevertVolumeToSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Controller/RevertVolumeToSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServer).RevertVolumeToSnapshot(ctx, req.(*RevertVolumeToSnapshotOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Controller_ManageVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ManageVolumeOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServer).ManageVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Controller/ManageVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServer).ManageVolume(ctx, req.(*ManageVolumeOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Controller_UnmanageVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnmanageVolumeOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServer).UnmanageVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Controller/UnmanageVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServer).UnmanageVolume(ctx, req.(*UnmanageVolumeOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Controller_CreateVolumeSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVolumeSnapshotOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServer).CreateVolumeSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Controller/CreateVolumeSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServer).CreateVolumeSnapshot(ctx, req.(*CreateVolumeSnapshotOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Controller_DeleteVolumeSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVolumeSnapshotOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServer).DeleteVolumeSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Controller/DeleteVolumeSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServer).DeleteVolumeSnapshot(ctx, req.(*DeleteVolumeSnapshotOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Controller_ManageVolumeSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ManageVolumeSnapshotOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServer).ManageVolumeSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Controller/ManageVolumeSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServer).ManageVolumeSnapshot(ctx, req.(*ManageVolumeSnapshotOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Controller_UnmanageVolumeSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnmanageVolumeSnapshotOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServer).UnmanageVolumeSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Controller/UnmanageVolumeSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServer).UnmanageVolumeSnapshot(ctx, req.(*UnmanageVolumeSnapshotOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Controller_CreateVolumeBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVolumeBackupOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServer).CreateVolumeBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Controller/CreateVolumeBackup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServer).CreateVolumeBackup(ctx, req.(*CreateVolumeBackupOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Controller_DeleteVolumeBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVolumeBackupOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServer).DeleteVolumeBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Controller/DeleteVolumeBackup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServer).DeleteVolumeBackup(ctx, req.(*DeleteVolumeBackupOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Controller_RestoreVolumeBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreVolumeBackupOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServer).RestoreVolumeBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Controller/RestoreVolumeBackup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServer).RestoreVolumeBackup(ctx, req.(*RestoreVolumeBackupOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Controller_CreateVolumeAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVolumeAttachmentOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServer).CreateVolumeAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Controller/CreateVolumeAttachment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServer).CreateVolumeAttachment(ctx, req.(*CreateVolumeAttachmentOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Controller_DeleteVolumeAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVolumeAttachmentOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServer).DeleteVolumeAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Controller/DeleteVolumeAttachment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServer).DeleteVolumeAttachment(ctx, req.(*DeleteVolumeAttachmentOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Controller_CreateReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReplicationOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServer).CreateReplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Controller/CreateReplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServer).CreateReplication(ctx, req.(*CreateReplicationOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Controller_DeleteReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReplicationOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServer).DeleteReplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Controller/DeleteReplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServer).DeleteReplication(ctx, req.(*DeleteReplicationOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Controller_EnableReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableReplicationOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServer).EnableReplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Controller/EnableReplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServer).EnableReplication(ctx, req.(*EnableReplicationOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Controller_DisableReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableReplicationOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServer).DisableReplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Controller/DisableReplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServer).DisableReplication(ctx, req.(*DisableReplicationOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Controller_FailoverReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FailoverReplicationOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServer).FailoverReplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Controller/FailoverReplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServer).FailoverReplication(ctx, req.(*FailoverReplicationOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Controller_CreateVolumeGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVolumeGroupOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServer).CreateVolumeGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Controller/CreateVolumeGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServer).CreateVolumeGroup(ctx, req.(*CreateVolumeGroupOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Controller_UpdateVolumeGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVolumeGroupOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServer).UpdateVolumeGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Controller/UpdateVolumeGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServer).UpdateVolumeGroup(ctx, req.(*UpdateVolumeGroupOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Controller_DeleteVolumeGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVolumeGroupOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServer).DeleteVolumeGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Controller/DeleteVolumeGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServer).DeleteVolumeGroup(ctx, req.(*DeleteVolumeGroupOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Controller_CreateGroupSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupSnapshotOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServer).CreateGroupSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Controller/CreateGroupSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServer).CreateGroupSnapshot(ctx, req.(*CreateGroupSnapshotOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Controller_DeleteGroupSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGroupSnapshotOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServer).DeleteGroupSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Controller/DeleteGroupSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServer).DeleteGroupSnapshot(ctx, req.(*DeleteGroupSnapshotOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Controller_GetMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMetricsOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServer).GetMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Controller/GetMetrics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServer).GetMetrics(ctx, req.(*GetMetricsOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Controller_Heartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeartbeatOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServer).Heartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Controller/Heartbeat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServer).Heartbeat(ctx, req.(*HeartbeatOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Controller_GetReconcileReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServer).GetReconcileReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Controller/GetReconcileReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServer).GetReconcileReport(ctx, req.(*ReconcileOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Controller_Reconcile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServer).Reconcile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Controller/Reconcile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServer).Reconcile(ctx, req.(*ReconcileOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Controller_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.Controller",
+	HandlerType: (*ControllerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateVolume",
+			Handler:    _Controller_CreateVolume_Handler,
+		},
+		{
+			MethodName: "DeleteVolume",
+			Handler:    _Controller_DeleteVolume_Handler,
+		},
+		{
+			MethodName: "ExtendVolume",
+			Handler:    _Controller_ExtendVolume_Handler,
+		},
+		{
+			MethodName: "RetypeVolume",
+			Handler:    _Controller_RetypeVolume_Handler,
+		},
+		{
+			MethodName: "MigrateVolume",
+			Handler:    _Controller_MigrateVolume_Handler,
+		},
+		{
+			MethodName: "RevertVolumeToSnapshot",
+			Handler:    _Controller_RevertVolumeToSnapshot_Handler,
+		},
+		{
+			MethodName: "ManageVolume",
+			Handler:    _Controller_ManageVolume_Handler,
+		},
+		{
+			MethodName: "UnmanageVolume",
+			Handler:    _Controller_UnmanageVolume_Handler,
+		},
+		{
+			MethodName: "CreateVolumeSnapshot",
+			Handler:    _Controller_CreateVolumeSnapshot_Handler,
+		},
+		{
+			MethodName: "DeleteVolumeSnapshot",
+			Handler:    _Controller_DeleteVolumeSnapshot_Handler,
+		},
+		{
+			MethodName: "ManageVolumeSnapshot",
+			Handler:    _Controller_ManageVolumeSnapshot_Handler,
+		},
+		{
+			MethodName: "UnmanageVolumeSnapshot",
+			Handler:    _Controller_UnmanageVolumeSnapshot_Handler,
+		},
+		{
+			MethodName: "CreateVolumeBackup",
+			Handler:    _Controller_CreateVolumeBackup_Handler,
+		},
+		{
+			MethodName: "DeleteVolumeBackup",
+			Handler:    _Controller_DeleteVolumeBackup_Handler,
+		},
+		{
+			MethodName: "RestoreVolumeBackup",
+			Handler:    _Controller_RestoreVolumeBackup_Handler,
+		},
+		{
+			MethodName: "CreateVolumeAttachment",
+			Handler:    _Controller_CreateVolumeAttachment_Handler,
+		},
+		{
+			MethodName: "DeleteVolumeAttachment",
+			Handler:    _Controller_DeleteVolumeAttachment_Handler,
+		},
+		{
+			MethodName: "CreateReplication",
+			Handler:    _Controller_CreateReplication_Handler,
+		},
+		{
+			MethodName: "DeleteReplication",
+			Handler:    _Controller_DeleteReplication_Handler,
+		},
+		{
+			MethodName: "EnableReplication",
+			Handler:    _Controller_EnableReplication_Handler,
+		},
+		{
+			MethodName: "DisableReplication",
+			Handler:    _Controller_DisableReplication_Handler,
+		},
+		{
+			MethodName: "FailoverReplication",
+			Handler:    _Controller_FailoverReplication_Handler,
+		},
+		{
+			MethodName: "CreateVolumeGroup",
+			Handler:    _Controller_CreateVolumeGroup_Handler,
+		},
+		{
+			MethodName: "UpdateVolumeGroup",
+			Handler:    _Controller_UpdateVolumeGroup_Handler,
+		},
+		{
+			MethodName: "DeleteVolumeGroup",
+			Handler:    _Controller_DeleteVolumeGroup_Handler,
+		},
+		{
+			MethodName: "CreateGroupSnapshot",
+			Handler:    _Controller_CreateGroupSnapshot_Handler,
+		},
+		{
+			MethodName: "DeleteGroupSnapshot",
+			Handler:    _Controller_DeleteGroupSnapshot_Handler,
+		},
+		{
+			MethodName: "GetMetrics",
+			Handler:    _Controller_GetMetrics_Handler,
+		},
+		{
+			MethodName: "Heartbeat",
+			Handler:    _Controller_Heartbeat_Handler,
+		},
+		{
+			MethodName: "GetReconcileReport",
+			Handler:    _Controller_GetReconcileReport_Handler,
+		},
+		{
+			MethodName: "Reconcile",
+			Handler:    _Controller_Reconcile_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "model.proto",
+}
+
+// ProvisionDockClient is the client API for ProvisionDock service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ProvisionDockClient interface {
+	// Create a volume
+	CreateVolume(ctx context.Context, in *CreateVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Delete a volume
+	DeleteVolume(ctx context.Context, in *DeleteVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Extend a volume
+	ExtendVolume(ctx context.Context, in *ExtendVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Migrate a volume to another pool of the same dock
+	MigrateVolume(ctx context.Context, in *MigrateVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Revert a volume to one of its snapshots
+	RevertVolumeToSnapshot(ctx context.Context, in *RevertVolumeToSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Take over a volume existing in the backend
+	ManageVolume(ctx context.Context, in *ManageVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Give up the management of a volume and leave it in the backend
+	UnmanageVolume(ctx context.Context, in *UnmanageVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Create a volume snapshot
+	CreateVolumeSnapshot(ctx context.Context, in *CreateVolumeSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Delete a volume snapshot
+	DeleteVolumeSnapshot(ctx context.Context, in *DeleteVolumeSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Take over a volume snapshot existing in the backend
+	ManageVolumeSnapshot(ctx context.Context, in *ManageVolumeSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Give up the management of a volume snapshot and leave it in the backend
+	UnmanageVolumeSnapshot(ctx context.Context, in *UnmanageVolumeSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Create a volume backup
+	CreateVolumeBackup(ctx context.Context, in *CreateVolumeBackupOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Delete a volume backup
+	DeleteVolumeBackup(ctx context.Context, in *DeleteVolumeBackupOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Restore a volume backup
+	RestoreVolumeBackup(ctx context.Context, in *RestoreVolumeBackupOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Create a volume attachment
+	CreateVolumeAttachment(ctx context.Context, in *CreateVolumeAttachmentOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Delete a volume attachment
+	DeleteVolumeAttachment(ctx context.Context, in *DeleteVolumeAttachmentOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Create a replication
+	CreateReplication(ctx context.Context, in *CreateReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Delete a replication
+	DeleteReplication(ctx context.Context, in *DeleteReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Enable a replication
+	EnableReplication(ctx context.Context, in *EnableReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Disable a replication
+	DisableReplication(ctx context.Context, in *DisableReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Failover a replication
+	FailoverReplication(ctx context.Context, in *FailoverReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Create a volume group
+	CreateVolumeGroup(ctx context.Context, in *CreateVolumeGroupOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Update volume group
+	UpdateVolumeGroup(ctx context.Context, in *UpdateVolumeGroupOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Delete volume group
+	DeleteVolumeGroup(ctx context.Context, in *DeleteVolumeGroupOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Create a snapshot of all the volumes in a volume group
+	CreateGroupSnapshot(ctx context.Context, in *CreateGroupSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Delete a volume group snapshot
+	DeleteGroupSnapshot(ctx context.Context, in *DeleteGroupSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Pull a volume from the backend
+	PullVolume(ctx context.Context, in *PullVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Pull a volume snapshot from the backend
+	PullVolumeSnapshot(ctx context.Context, in *PullVolumeSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// List the volumes created by opensds in a pool
+	ListVolumes(ctx context.Context, in *ListVolumesOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// List the volume snapshots created by opensds in a pool
+	ListVolumeSnapshots(ctx context.Context, in *ListVolumesOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+}
+
+type provisionDockClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewProvisionDockClient(cc *grpc.ClientConn) ProvisionDockClient {
+	return &provisionDockClient{cc}
+}
+
+func (c *provisionDockClient) CreateVolume(ctx context.Context, in *CreateVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/CreateVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *provisionDockClient) DeleteVolume(ctx context.Context, in *DeleteVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/DeleteVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *provisionDockClient) ExtendVolume(ctx context.Context, in *ExtendVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/ExtendVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *provisionDockClient) MigrateVolume(ctx context.Context, in *MigrateVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/MigrateVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *provisionDockClient) RevertVolumeToSnapshot(ctx context.Context, in *RevertVolumeToSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/RevertVolumeToSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *provisionDockClient) ManageVolume(ctx context.Context, in *ManageVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/ManageVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *provisionDockClient) UnmanageVolume(ctx context.Context, in *UnmanageVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/UnmanageVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *provisionDockClient) CreateVolumeSnapshot(ctx context.Context, in *CreateVolumeSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/CreateVolumeSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *provisionDockClient) DeleteVolumeSnapshot(ctx context.Context, in *DeleteVolumeSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/DeleteVolumeSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *provisionDockClient) ManageVolumeSnapshot(ctx context.Context, in *ManageVolumeSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/ManageVolumeSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *provisionDockClient) UnmanageVolumeSnapshot(ctx context.Context, in *UnmanageVolumeSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/UnmanageVolumeSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *provisionDockClient) CreateVolumeBackup(ctx context.Context, in *CreateVolumeBackupOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/CreateVolumeBackup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *provisionDockClient) DeleteVolumeBackup(ctx context.Context, in *DeleteVolumeBackupOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/DeleteVolumeBackup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *provisionDockClient) RestoreVolumeBackup(ctx context.Context, in *RestoreVolumeBackupOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/RestoreVolumeBackup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *provisionDockClient) CreateVolumeAttachment(ctx context.Context, in *CreateVolumeAttachmentOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/CreateVolumeAttachment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *provisionDockClient) DeleteVolumeAttachment(ctx context.Context, in *DeleteVolumeAttachmentOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/DeleteVolumeAttachment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *provisionDockClient) CreateReplication(ctx context.Context, in *CreateReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/CreateReplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *provisionDockClient) DeleteReplication(ctx context.Context, in *DeleteReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/DeleteReplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *provisionDockClient) EnableReplication(ctx context.Context, in *EnableReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/EnableReplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *provisionDockClient) DisableReplication(ctx context.Context, in *DisableReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/DisableReplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *provisionDockClient) FailoverReplication(ctx context.Context, in *FailoverReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/FailoverReplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *provisionDockClient) CreateVolumeGroup(ctx context.Context, in *CreateVolumeGroupOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/CreateVolumeGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *provisionDockClient) UpdateVolumeGroup(ctx context.Context, in *UpdateVolumeGroupOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/UpdateVolumeGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *provisionDockClient) DeleteVolumeGroup(ctx context.Context, in *DeleteVolumeGroupOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/DeleteVolumeGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *provisionDockClient) CreateGroupSnapshot(ctx context.Context, in *CreateGroupSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/CreateGroupSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *provisionDockClient) DeleteGroupSnapshot(ctx context.Context, in *DeleteGroupSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/DeleteGroupSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *provisionDockClient) PullVolume(ctx context.Context, in *PullVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/PullVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *provisionDockClient) PullVolumeSnapshot(ctx context.Context, in *PullVolumeSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/PullVolumeSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *provisionDockClient) ListVolumes(ctx context.Context, in *ListVolumesOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/ListVolumes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *provisionDockClient) ListVolumeSnapshots(ctx context.Context, in *ListVolumesOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/ListVolumeSnapshots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProvisionDockServer is the server API for ProvisionDock service.
+type ProvisionDockServer interface {
+	// Create a volume
+	CreateVolume(context.Context, *CreateVolumeOpts) (*GenericResponse, error)
+	// Delete a volume
+	DeleteVolume(context.Context, *DeleteVolumeOpts) (*GenericResponse, error)
+	// Extend a volume
+	ExtendVolume(context.Context, *ExtendVolumeOpts) (*GenericResponse, error)
+	// Migrate a volume to another pool of the same dock
+	MigrateVolume(context.Context, *MigrateVolumeOpts) (*GenericResponse, error)
+	// Revert a volume to one of its snapshots
+	RevertVolumeToSnapshot(context.Context, *RevertVolumeToSnapshotOpts) (*GenericResponse, error)
+	// Take over a volume existing in the backend
+	ManageVolume(context.Context, *ManageVolumeOpts) (*GenericResponse, error)
+	// Give up the management of a volume and leave it in the backend
+	UnmanageVolume(context.Context, *UnmanageVolumeOpts) (*GenericResponse, error)
+	// Create a volume snapshot
+	CreateVolumeSnapshot(context.Context, *CreateVolumeSnapshotOpts) (*GenericResponse, error)
+	// Delete a volume snapshot
+	DeleteVolumeSnapshot(context.Context, *DeleteVolumeSnapshotOpts) (*GenericResponse, error)
+	// Take over a volume snapshot existing in the backend
+	ManageVolumeSnapshot(context.Context, *ManageVolumeSnapshotOpts) (*GenericResponse, error)
+	// Give up the management of a volume snapshot and leave it in the backend
+	UnmanageVolumeSnapshot(context.Context, *UnmanageVolumeSnapshotOpts) (*GenericResponse, error)
+	// Create a volume backup
+	CreateVolumeBackup(context.Context, *CreateVolumeBackupOpts) (*GenericResponse, error)
+	// Delete a volume backup
+	DeleteVolumeBackup(context.Context, *DeleteVolumeBackupOpts) (*GenericResponse, error)
+	// Restore a volume backup
+	RestoreVolumeBackup(context.Context, *RestoreVolumeBackupOpts) (*GenericResponse, error)
+	// Create a volume attachment
+	CreateVolumeAttachment(context.Context, *CreateVolumeAttachmentOpts) (*GenericResponse, error)
+	// Delete a volume attachment
+	DeleteVolumeAttachment(context.Context, *DeleteVolumeAttachmentOpts) (*GenericResponse, error)
+	// Create a replication
+	CreateReplication(context.Context, *CreateReplicationOpts) (*GenericResponse, error)
+	// Delete a replication
+	DeleteReplication(context.Context, *DeleteReplicationOpts) (*GenericResponse, error)
+	// Enable a replication
+	EnableReplication(context.Context, *EnableReplicationOpts) (*GenericResponse, error)
+	// Disable a replication
+	DisableReplication(context.Context, *DisableReplicationOpts) (*GenericResponse, error)
+	// Failover a replication
+	FailoverReplication(context.Context, *FailoverReplicationOpts) (*GenericResponse, error)
+	// Create a volume group
+	CreateVolumeGroup(context.Context, *CreateVolumeGroupOpts) (*GenericResponse, error)
+	// Update volume group
+	UpdateVolumeGroup(context.Context, *UpdateVolumeGroupOpts) (*GenericResponse, error)
+	// Delete volume group
+	DeleteVolumeGroup(context.Context, *DeleteVolumeGroupOpts) (*GenericResponse, error)
+	// Create a snapshot of all the volumes in a volume group
+	CreateGroupSnapshot(context.Context, *CreateGroupSnapshotOpts) (*GenericResponse, error)
+	// Delete a volume group snapshot
+	DeleteGroupSnapshot(context.Context, *DeleteGroupSnapshotOpts) (*GenericResponse, error)
+	// Pull a volume from the backend
+	PullVolume(context.Context, *PullVolumeOpts) (*GenericResponse, error)
+	// Pull a volume snapshot from the backend
+	PullVolumeSnapshot(context.Context, *PullVolumeSnapshotOpts) (*GenericResponse, error)
+	// List the volumes created by opensds in a pool
+	ListVolumes(context.Context, *ListVolumesOpts) (*GenericResponse, error)
+	// List the volume snapshots created by opensds in a pool
+	ListVolumeSnapshots(context.Context, *ListVolumesOpts) (*GenericResponse, error)
+}
+
+// UnimplementedProvisionDockServer can be embedded to have forward compatible implementations.
+type UnimplementedProvisionDockServer struct {
+}
+
+func (*UnimplementedProvisionDockServer) CreateVolume(ctx context.Context, req *CreateVolumeOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVolume not implemented")
+}
+func (*UnimplementedProvisionDockServer) DeleteVolume(ctx context.Context, req *DeleteVolumeOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVolume not implemented")
+}
+func (*UnimplementedProvisionDockServer) ExtendVolume(ctx context.Context, req *ExtendVolumeOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendVolume not implemented")
+}
+func (*UnimplementedProvisionDockServer) MigrateVolume(ctx context.Context, req *MigrateVolumeOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateVolume not implemented")
+}
+func (*UnimplementedProvisionDockServer) RevertVolumeToSnapshot(ctx context.Context, req *RevertVolumeToSnapshotOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertVolumeToSnapshot not implemented")
+}
+func (*UnimplementedProvisionDockServer) ManageVolume(ctx context.Context, req *ManageVolumeOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ManageVolume not implemented")
+}
+func (*UnimplementedProvisionDockServer) UnmanageVolume(ctx context.Context, req *UnmanageVolumeOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnmanageVolume not implemented")
+}
+func (*UnimplementedProvisionDockServer) CreateVolumeSnapshot(ctx context.Context, req *CreateVolumeSnapshotOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVolumeSnapshot not implemented")
+}
+func (*UnimplementedProvisionDockServer) DeleteVolumeSnapshot(ctx context.Context, req *DeleteVolumeSnapshotOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVolumeSnapshot not implemented")
+}
+func (*UnimplementedProvisionDockServer) ManageVolumeSnapshot(ctx context.Context, req *ManageVolumeSnapshotOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ManageVolumeSnapshot not implemented")
+}
+func (*UnimplementedProvisionDockServer) UnmanageVolumeSnapshot(ctx context.Context, req *UnmanageVolumeSnapshotOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnmanageVolumeSnapshot not implemented")
+}
+func (*UnimplementedProvisionDockServer) CreateVolumeBackup(ctx context.Context, req *CreateVolumeBackupOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVolumeBackup not implemented")
+}
+func (*UnimplementedProvisionDockServer) DeleteVolumeBackup(ctx context.Context, req *DeleteVolumeBackupOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVolumeBackup not implemented")
+}
+func (*UnimplementedProvisionDockServer) RestoreVolumeBackup(ctx context.Context, req *RestoreVolumeBackupOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreVolumeBackup not implemented")
+}
+func (*UnimplementedProvisionDockServer) CreateVolumeAttachment(ctx context.Context, req *CreateVolumeAttachmentOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVolumeAttachment not implemented")
+}
+func (*UnimplementedProvisionDockServer) DeleteVolumeAttachment(ctx context.Context, req *DeleteVolumeAttachmentOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVolumeAttachment not implemented")
+}
+func (*UnimplementedProvisionDockServer) CreateReplication(ctx context.Context, req *CreateReplicationOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReplication not implemented")
+}
+func (*UnimplementedProvisionDockServer) DeleteReplication(ctx context.Context, req *DeleteReplicationOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReplication not implemented")
+}
+func (*UnimplementedProvisionDockServer) EnableReplication(ctx context.Context, req *EnableReplicationOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableReplication not implemented")
+}
+func (*UnimplementedProvisionDockServer) DisableReplication(ctx context.Context, req *DisableReplicationOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableReplication not implemented")
+}
+func (*UnimplementedProvisionDockServer) FailoverReplication(ctx context.Context, req *FailoverReplicationOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FailoverReplication not implemented")
+}
+func (*UnimplementedProvisionDockServer) CreateVolumeGroup(ctx context.Context, req *CreateVolumeGroupOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVolumeGroup not implemented")
+}
+func (*UnimplementedProvisionDockServer) UpdateVolumeGroup(ctx context.Context, req *UpdateVolumeGroupOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVolumeGroup not implemented")
+}
+func (*UnimplementedProvisionDockServer) DeleteVolumeGroup(ctx context.Context, req *DeleteVolumeGroupOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVolumeGroup not implemented")
+}
+func (*UnimplementedProvisionDockServer) CreateGroupSnapshot(ctx context.Context, req *CreateGroupSnapshotOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroupSnapshot not implemented")
+}
+func (*UnimplementedProvisionDockServer) DeleteGroupSnapshot(ctx context.Context, req *DeleteGroupSnapshotOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGroupSnapshot not implemented")
+}
+func (*UnimplementedProvisionDockServer) PullVolume(ctx context.Context, req *PullVolumeOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PullVolume not implemented")
+}
+func (*UnimplementedProvisionDockServer) PullVolumeSnapshot(ctx context.Context, req *PullVolumeSnapshotOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PullVolumeSnapshot not implemented")
+}
+func (*UnimplementedProvisionDockServer) ListVolumes(ctx context.Context, req *ListVolumesOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVolumes not implemented")
+}
+func (*UnimplementedProvisionDockServer) ListVolumeSnapshots(ctx context.Context, req *ListVolumesOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVolumeSnapshots not implemented")
+}
+
+func RegisterProvisionDockServer(s *grpc.Server, srv ProvisionDockServer) {
+	s.RegisterService(&_ProvisionDock_serviceDesc, srv)
+}
+
+func _ProvisionDock_CreateVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVolumeOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvisionDockServer).CreateVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProvisionDock/CreateVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvisionDockServer).CreateVolume(ctx, req.(*CreateVolumeOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvisionDock_DeleteVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVolumeOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvisionDockServer).DeleteVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProvisionDock/DeleteVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvisionDockServer).DeleteVolume(ctx, req.(*DeleteVolumeOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvisionDock_ExtendVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtendVolumeOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvisionDockServer).ExtendVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProvisionDock/ExtendVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvisionDockServer).ExtendVolume(ctx, req.(*ExtendVolumeOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvisionDock_MigrateVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MigrateVolumeOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvisionDockServer).MigrateVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProvisionDock/MigrateVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvisionDockServer).MigrateVolume(ctx, req.(*MigrateVolumeOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvisionDock_RevertVolumeToSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertVolumeToSnapshotOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvisionDockServer).RevertVolumeToSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProvisionDock/RevertVolumeToSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvisionDockServer).RevertVolumeToSnapshot(ctx, req.(*RevertVolumeToSnapshotOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvisionDock_ManageVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ManageVolumeOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvisionDockServer).ManageVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProvisionDock/ManageVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvisionDockServer).ManageVolume(ctx, req.(*ManageVolumeOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvisionDock_UnmanageVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnmanageVolumeOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvisionDockServer).UnmanageVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProvisionDock/UnmanageVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvisionDockServer).UnmanageVolume(ctx, req.(*UnmanageVolumeOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvisionDock_CreateVolumeSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVolumeSnapshotOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvisionDockServer).CreateVolumeSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProvisionDock/CreateVolumeSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvisionDockServer).CreateVolumeSnapshot(ctx, req.(*CreateVolumeSnapshotOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvisionDock_DeleteVolumeSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVolumeSnapshotOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvisionDockServer).DeleteVolumeSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProvisionDock/DeleteVolumeSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvisionDockServer).DeleteVolumeSnapshot(ctx, req.(*DeleteVolumeSnapshotOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvisionDock_ManageVolumeSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ManageVolumeSnapshotOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvisionDockServer).ManageVolumeSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProvisionDock/ManageVolumeSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvisionDockServer).ManageVolumeSnapshot(ctx, req.(*ManageVolumeSnapshotOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvisionDock_UnmanageVolumeSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnmanageVolumeSnapshotOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvisionDockServer).UnmanageVolumeSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProvisionDock/UnmanageVolumeSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvisionDockServer).UnmanageVolumeSnapshot(ctx, req.(*UnmanageVolumeSnapshotOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvisionDock_CreateVolumeBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVolumeBackupOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvisionDockServer).CreateVolumeBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProvisionDock/CreateVolumeBackup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvisionDockServer).CreateVolumeBackup(ctx, req.(*CreateVolumeBackupOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvisionDock_DeleteVolumeBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVolumeBackupOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvisionDockServer).DeleteVolumeBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProvisionDock/DeleteVolumeBackup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvisionDockServer).DeleteVolumeBackup(ctx, req.(*DeleteVolumeBackupOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvisionDock_RestoreVolumeBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreVolumeBackupOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvisionDockServer).RestoreVolumeBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProvisionDock/RestoreVolumeBackup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvisionDockServer).RestoreVolumeBackup(ctx, req.(*RestoreVolumeBackupOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvisionDock_CreateVolumeAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVolumeAttachmentOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvisionDockServer).CreateVolumeAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProvisionDock/CreateVolumeAttachment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvisionDockServer).CreateVolumeAttachment(ctx, req.(*CreateVolumeAttachmentOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvisionDock_DeleteVolumeAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVolumeAttachmentOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvisionDockServer).DeleteVolumeAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProvisionDock/DeleteVolumeAttachment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvisionDockServer).DeleteVolumeAttachment(ctx, req.(*DeleteVolumeAttachmentOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvisionDock_CreateReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReplicationOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvisionDockServer).CreateReplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProvisionDock/CreateReplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvisionDockServer).CreateReplication(ctx, req.(*CreateReplicationOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvisionDock_DeleteReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReplicationOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvisionDockServer).DeleteReplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProvisionDock/DeleteReplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvisionDockServer).DeleteReplication(ctx, req.(*DeleteReplicationOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvisionDock_EnableReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableReplicationOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvisionDockServer).EnableReplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProvisionDock/EnableReplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvisionDockServer).EnableReplication(ctx, req.(*EnableReplicationOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvisionDock_DisableReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableReplicationOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvisionDockServer).DisableReplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProvisionDock/DisableReplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvisionDockServer).DisableReplication(ctx, req.(*DisableReplicationOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvisionDock_FailoverReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FailoverReplicationOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvisionDockServer).FailoverReplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProvisionDock/FailoverReplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvisionDockServer).FailoverReplication(ctx, req.(*FailoverReplicationOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvisionDock_CreateVolumeGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVolumeGroupOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvisionDockServer).CreateVolumeGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProvisionDock/CreateVolumeGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvisionDockServer).CreateVolumeGroup(ctx, req.(*CreateVolumeGroupOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvisionDock_UpdateVolumeGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVolumeGroupOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvisionDockServer).UpdateVolumeGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProvisionDock/UpdateVolumeGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvisionDockServer).UpdateVolumeGroup(ctx, req.(*UpdateVolumeGroupOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvisionDock_DeleteVolumeGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVolumeGroupOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvisionDockServer).DeleteVolumeGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProvisionDock/DeleteVolumeGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvisionDockServer).DeleteVolumeGroup(ctx, req.(*DeleteVolumeGroupOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvisionDock_CreateGroupSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupSnapshotOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvisionDockServer).CreateGroupSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProvisionDock/CreateGroupSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvisionDockServer).CreateGroupSnapshot(ctx, req.(*CreateGroupSnapshotOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvisionDock_DeleteGroupSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGroupSnapshotOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvisionDockServer).DeleteGroupSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProvisionDock/DeleteGroupSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvisionDockServer).DeleteGroupSnapshot(ctx, req.(*DeleteGroupSnapshotOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvisionDock_PullVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PullVolumeOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvisionDockServer).PullVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProvisionDock/PullVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvisionDockServer).PullVolume(ctx, req.(*PullVolumeOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvisionDock_PullVolumeSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PullVolumeSnapshotOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvisionDockServer).PullVolumeSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProvisionDock/PullVolumeSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvisionDockServer).PullVolumeSnapshot(ctx, req.(*PullVolumeSnapshotOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvisionDock_ListVolumes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVolumesOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvisionDockServer).ListVolumes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProvisionDock/ListVolumes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvisionDockServer).ListVolumes(ctx, req.(*ListVolumesOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvisionDock_ListVolumeSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVolumesOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvisionDockServer).ListVolumeSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProvisionDock/ListVolumeSnapshots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvisionDockServer).ListVolumeSnapshots(ctx, req.(*ListVolumesOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ProvisionDock_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.ProvisionDock",
+	HandlerType: (*ProvisionDockServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateVolume",
+			Handler:    _ProvisionDock_CreateVolume_Handler,
+		},
+		{
+			MethodName: "DeleteVolume",
+			Handler:    _ProvisionDock_DeleteVolume_Handler,
+		},
+		{
+			MethodName: "ExtendVolume",
+			Handler:    _ProvisionDock_ExtendVolume_Handler,
+		},
+		{
+			MethodName: "MigrateVolume",
+			Handler:    _ProvisionDock_MigrateVolume_Handler,
+		},
+		{
+			MethodName: "RevertVolumeToSnapshot",
+			Handler:    _ProvisionDock_RevertVolumeToSnapshot_Handler,
+		},
+		{
+			MethodName: "ManageVolume",
+			Handler:    _ProvisionDock_ManageVolume_Handler,
+		},
+		{
+			MethodName: "UnmanageVolume",
+			Handler:    _ProvisionDock_UnmanageVolume_Handler,
+		},
+		{
+			MethodName: "CreateVolumeSnapshot",
+			Handler:    _ProvisionDock_CreateVolumeSnapshot_Handler,
+		},
+		{
+			MethodName: "DeleteVolumeSnapshot",
+			Handler:    _ProvisionDock_DeleteVolumeSnapshot_Handler,
+		},
+		{
+			MethodName: "ManageVolumeSnapshot",
+			Handler:    _ProvisionDock_ManageVolumeSnapshot_Handler,
+		},
+		{
+			MethodName: "UnmanageVolumeSnapshot",
+			Handler:    _ProvisionDock_UnmanageVolumeSnapshot_Handler,
+		},
+		{
+			MethodName: "CreateVolumeBackup",
+			Handler:    _ProvisionDock_CreateVolumeBackup_Handler,
+		},
+		{
+			MethodName: "DeleteVolumeBackup",
+			Handler:    _ProvisionDock_DeleteVolumeBackup_Handler,
+		},
+		{
+			MethodName: "RestoreVolumeBackup",
+			Handler:    _ProvisionDock_RestoreVolumeBackup_Handler,
+		},
+		{
+			MethodName: "CreateVolumeAttachment",
+			Handler:    _ProvisionDock_CreateVolumeAttachment_Handler,
+		},
+		{
+			MethodName: "DeleteVolumeAttachment",
+			Handler:    _ProvisionDock_DeleteVolumeAttachment_Handler,
+		},
+		{
+			MethodName: "CreateReplication",
+			Handler:    _ProvisionDock_CreateReplication_Handler,
+		},
+		{
+			MethodName: "DeleteReplication",
+			Handler:    _ProvisionDock_DeleteReplication_Handler,
+		},
+		{
+			MethodName: "EnableReplication",
+			Handler:    _ProvisionDock_EnableReplication_Handler,
+		},
+		{
+			MethodName: "DisableReplication",
+			Handler:    _ProvisionDock_DisableReplication_Handler,
+		},
+		{
+			MethodName: "FailoverReplication",
+			Handler:    _ProvisionDock_FailoverReplication_Handler,
+		},
+		{
+			MethodName: "CreateVolumeGroup",
+			Handler:    _ProvisionDock_CreateVolumeGroup_Handler,
+		},
+		{
+			MethodName: "UpdateVolumeGroup",
+			Handler:    _ProvisionDock_UpdateVolumeGroup_Handler,
+		},
+		{
+			MethodName: "DeleteVolumeGroup",
+			Handler:    _ProvisionDock_DeleteVolumeGroup_Handler,
+		},
+		{
+			MethodName: "CreateGroupSnapshot",
+			Handler:    _ProvisionDock_CreateGroupSnapshot_Handler,
+		},
+		{
+			MethodName: "DeleteGroupSnapshot",
+			Handler:    _ProvisionDock_DeleteGroupSnapshot_Handler,
+		},
+		{
+			MethodName: "PullVolume",
+			Handler:    _ProvisionDock_PullVolume_Handler,
+		},
+		{
+			MethodName: "PullVolumeSnapshot",
+			Handler:    _ProvisionDock_PullVolumeSnapshot_Handler,
+		},
+		{
+			MethodName: "ListVolumes",
+			Handler:    _ProvisionDock_ListVolumes_Handler,
+		},
+		{
+			MethodName: "ListVolumeSnapshots",
+			Handler:    _ProvisionDock_ListVolumeSnapshots_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "model.proto",
+}
+
+// FileShareControllerClient is the client API for FileShareController service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type FileShareControllerClient interface {
+	// Create a file share
+	CreateFileShare(ctx context.Context, in *CreateFileShareOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Delete a file share
+	DeleteFileShare(ctx context.Context, in *DeleteFileShareOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Create a file share snapshot
+	CreateFileShareSnapshot(ctx context.Context, in *CreateFileShareSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Delete a file share snapshot
+	DeleteFileShareSnapshot(ctx context.Context, in *DeleteFileShareSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Create a file share acl
+	CreateFileShareAcl(ctx context.Context, in *CreateFileShareAclOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Delete a file share acl
+	DeleteFileShareAcl(ctx context.Context, in *DeleteFileShareAclOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+}
+
+type fileShareControllerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewFileShareControllerClient(cc *grpc.ClientConn) FileShareControllerClient {
+	return &fileShareControllerClient{cc}
+}
+
+func (c *fileShareControllerClient) CreateFileShare(ctx context.Context, in *CreateFileShareOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.FileShareController/CreateFileShare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileShareControllerClient) DeleteFileShare(ctx context.Context, in *DeleteFileShareOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.FileShareController/DeleteFileShare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileShareControllerClient) CreateFileShareSnapshot(ctx context.Context, in *CreateFileShareSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.FileShareController/CreateFileShareSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileShareControllerClient) DeleteFileShareSnapshot(ctx context.Context, in *DeleteFileShareSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.FileShareController/DeleteFileShareSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileShareControllerClient) CreateFileShareAcl(ctx context.Context, in *CreateFileShareAclOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.FileShareController/CreateFileShareAcl", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileShareControllerClient) DeleteFileShareAcl(ctx context.Context, in *DeleteFileShareAclOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.FileShareController/DeleteFileShareAcl", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FileShareControllerServer is the server API for FileShareController service.
+type FileShareControllerServer interface {
+	// Create a file share
+	CreateFileShare(context.Context, *CreateFileShareOpts) (*GenericResponse, error)
+	// Delete a file share
+	DeleteFileShare(context.Context, *DeleteFileShareOpts) (*GenericResponse, error)
+	// Create a file share snapshot
+	CreateFileShareSnapshot(context.Context, *CreateFileShareSnapshotOpts) (*GenericResponse, error)
+	// Delete a file share snapshot
+	DeleteFileShareSnapshot(context.Context, *DeleteFileShareSnapshotOpts) (*GenericResponse, error)
+	// Create a file share acl
+	CreateFileShareAcl(context.Context, *CreateFileShareAclOpts) (*GenericResponse, error)
+	// Delete a file share acl
+	DeleteFileShareAcl(context.Context, *DeleteFileShareAclOpts) (*GenericResponse, error)
+}
+
+// UnimplementedFileShareControllerServer can be embedded to have forward compatible implementations.
+type UnimplementedFileShareControllerServer struct {
+}
+
+func (*UnimplementedFileShareControllerServer) CreateFileShare(ctx context.Context, req *CreateFileShareOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFileShare not implemented")
+}
+func (*UnimplementedFileShareControllerServer) DeleteFileShare(ctx context.Context, req *DeleteFileShareOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFileShare not implemented")
+}
+func (*UnimplementedFileShareControllerServer) CreateFileShareSnapshot(ctx context.Context, req *CreateFileShareSnapshotOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFileShareSnapshot not implemented")
+}
+func (*UnimplementedFileShareControllerServer) DeleteFileShareSnapshot(ctx context.Context, req *DeleteFileShareSnapshotOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFileShareSnapshot not implemented")
+}
+func (*UnimplementedFileShareControllerServer) CreateFileShareAcl(ctx context.Context, req *CreateFileShareAclOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFileShareAcl not implemented")
+}
+func (*UnimplementedFileShareControllerServer) DeleteFileShareAcl(ctx context.Context, req *DeleteFileShareAclOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFileShareAcl not implemented")
+}
+
+func RegisterFileShareControllerServer(s *grpc.Server, srv FileShareControllerServer) {
+	s.RegisterService(&_FileShareController_serviceDesc, srv)
+}
+
+func _FileShareController_CreateFileShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFileShareOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileShareControllerServer).CreateFileShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.FileShareController/CreateFileShare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileShareControllerServer).CreateFileShare(ctx, req.(*CreateFileShareOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileShareController_DeleteFileShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFileShareOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileShareControllerServer).DeleteFileShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.FileShareController/DeleteFileShare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileShareControllerServer).DeleteFileShare(ctx, req.(*DeleteFileShareOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileShareController_CreateFileShareSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFileShareSnapshotOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileShareControllerServer).CreateFileShareSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.FileShareController/CreateFileShareSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileShareControllerServer).CreateFileShareSnapshot(ctx, req.(*CreateFileShareSnapshotOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileShareController_DeleteFileShareSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFileShareSnapshotOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileShareControllerServer).DeleteFileShareSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.FileShareController/DeleteFileShareSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileShareControllerServer).DeleteFileShareSnapshot(ctx, req.(*DeleteFileShareSnapshotOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileShareController_CreateFileShareAcl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFileShareAclOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileShareControllerServer).CreateFileShareAcl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.FileShareController/CreateFileShareAcl",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileShareControllerServer).CreateFileShareAcl(ctx, req.(*CreateFileShareAclOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileShareController_DeleteFileShareAcl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFileShareAclOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileShareControllerServer).DeleteFileShareAcl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.FileShareController/DeleteFileShareAcl",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileShareControllerServer).DeleteFileShareAcl(ctx, req.(*DeleteFileShareAclOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _FileShareController_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.FileShareController",
+	HandlerType: (*FileShareControllerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateFileShare",
+			Handler:    _FileShareController_CreateFileShare_Handler,
+		},
+		{
+			MethodName: "DeleteFileShare",
+			Handler:    _FileShareController_DeleteFileShare_Handler,
+		},
+		{
+			MethodName: "CreateFileShareSnapshot",
+			Handler:    _FileShareController_CreateFileShareSnapshot_Handler,
+		},
+		{
+			MethodName: "DeleteFileShareSnapshot",
+			Handler:    _FileShareController_DeleteFileShareSnapshot_Handler,
+		},
+		{
+			MethodName: "CreateFileShareAcl",
+			Handler:    _FileShareController_CreateFileShareAcl_Handler,
+		},
+		{
+			MethodName: "DeleteFileShareAcl",
+			Handler:    _FileShareController_DeleteFileShareAcl_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "model.proto",
+}
+
+// FileShareDockClient is the client API for FileShareDock service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type FileShareDockClient interface {
+	// Create a file share
+	CreateFileShare(ctx context.Context, in *CreateFileShareOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Delete a file share
+	DeleteFileShare(ctx context.Context, in *DeleteFileShareOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Create a file share snapshot
+	CreateFileShareSnapshot(ctx context.Context, in *CreateFileShareSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Delete a file share snapshot
+	DeleteFileShareSnapshot(ctx context.Context, in *DeleteFileShareSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Create a file share acl
+	CreateFileShareAcl(ctx context.Context, in *CreateFileShareAclOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Delete a file share acl
+	DeleteFileShareAcl(ctx context.Context, in *DeleteFileShareAclOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+}
+
+type fileShareDockClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewFileShareDockClient(cc *grpc.ClientConn) FileShareDockClient {
+	return &fileShareDockClient{cc}
+}
+
+func (c *fileShareDockClient) CreateFileShare(ctx context.Context, in *CreateFileShareOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.FileShareDock/CreateFileShare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileShareDockClient) DeleteFileShare(ctx context.Context, in *DeleteFileShareOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.FileShareDock/DeleteFileShare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileShareDockClient) CreateFileShareSnapshot(ctx context.Context, in *CreateFileShareSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.FileShareDock/CreateFileShareSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileShareDockClient) DeleteFileShareSnapshot(ctx context.Context, in *DeleteFileShareSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.FileShareDock/DeleteFileShareSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileShareDockClient) CreateFileShareAcl(ctx context.Context, in *CreateFileShareAclOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.FileShareDock/CreateFileShareAcl", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileShareDockClient) DeleteFileShareAcl(ctx context.Context, in *DeleteFileShareAclOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.FileShareDock/DeleteFileShareAcl", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FileShareDockServer is the server API for FileShareDock service.
+type FileShareDockServer interface {
+	// Create a file share
+	CreateFileShare(context.Context, *CreateFileShareOpts) (*GenericResponse, error)
+	// Delete a file share
+	DeleteFileShare(context.Context, *DeleteFileShareOpts) (*GenericResponse, error)
+	// Create a file share snapshot
+	CreateFileShareSnapshot(context.Context, *CreateFileShareSnapshotOpts) (*GenericResponse, error)
+	// Delete a file share snapshot
+	DeleteFileShareSnapshot(context.Context, *DeleteFileShareSnapshotOpts) (*GenericResponse, error)
+	// Create a file share acl
+	CreateFileShareAcl(context.Context, *CreateFileShareAclOpts) (*GenericResponse, error)
+	// Delete a file share acl
+	DeleteFileShareAcl(context.Context, *DeleteFileShareAclOpts) (*GenericResponse, error)
+}
+
+// UnimplementedFileShareDockServer can be embedded to have forward compatible implementations.
+type UnimplementedFileShareDockServer struct {
+}
+
+func (*UnimplementedFileShareDockServer) CreateFileShare(ctx context.Context, req *CreateFileShareOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFileShare not implemented")
+}
+func (*UnimplementedFileShareDockServer) DeleteFileShare(ctx context.Context, req *DeleteFileShareOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFileShare not implemented")
+}
+func (*UnimplementedFileShareDockServer) CreateFileShareSnapshot(ctx context.Context, req *CreateFileShareSnapshotOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFileShareSnapshot not implemented")
+}
+func (*UnimplementedFileShareDockServer) DeleteFileShareSnapshot(ctx context.Context, req *DeleteFileShareSnapshotOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFileShareSnapshot not implemented")
+}
+func (*UnimplementedFileShareDockServer) CreateFileShareAcl(ctx context.Context, req *CreateFileShareAclOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFileShareAcl not implemented")
+}
+func (*UnimplementedFileShareDockServer) DeleteFileShareAcl(ctx context.Context, req *DeleteFileShareAclOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFileShareAcl not implemented")
+}
+
+func RegisterFileShareDockServer(s *grpc.Server, srv FileShareDockServer) {
+	s.RegisterService(&_FileShareDock_serviceDesc, srv)
+}
+
+func _FileShareDock_CreateFileShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFileShareOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileShareDockServer).CreateFileShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.FileShareDock/CreateFileShare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileShareDockServer).CreateFileShare(ctx, req.(*CreateFileShareOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileShareDock_DeleteFileShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFileShareOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileShareDockServer).DeleteFileShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.FileShareDock/DeleteFileShare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileShareDockServer).DeleteFileShare(ctx, req.(*DeleteFileShareOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileShareDock_CreateFileShareSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFileShareSnapshotOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileShareDockServer).CreateFileShareSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.FileShareDock/CreateFileShareSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileShareDockServer).CreateFileShareSnapshot(ctx, req.(*CreateFileShareSnapshotOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileShareDock_DeleteFileShareSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFileShareSnapshotOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileShareDockServer).DeleteFileShareSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.FileShareDock/DeleteFileShareSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileShareDockServer).DeleteFileShareSnapshot(ctx, req.(*DeleteFileShareSnapshotOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileShareDock_CreateFileShareAcl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFileShareAclOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileShareDockServer).CreateFileShareAcl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.FileShareDock/CreateFileShareAcl",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileShareDockServer).CreateFileShareAcl(ctx, req.(*CreateFileShareAclOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileShareDock_DeleteFileShareAcl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFileShareAclOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileShareDockServer).DeleteFileShareAcl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.FileShareDock/DeleteFileShareAcl",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileShareDockServer).DeleteFileShareAcl(ctx, req.(*DeleteFileShareAclOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _FileShareDock_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.FileShareDock",
+	HandlerType: (*FileShareDockServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateFileShare",
+			Handler:    _FileShareDock_CreateFileShare_Handler,
+		},
+		{
+			MethodName: "DeleteFileShare",
+			Handler:    _FileShareDock_DeleteFileShare_Handler,
+		},
+		{
+			MethodName: "CreateFileShareSnapshot",
+			Handler:    _FileShareDock_CreateFileShareSnapshot_Handler,
+		},
+		{
+			MethodName: "DeleteFileShareSnapshot",
+			Handler:    _FileShareDock_DeleteFileShareSnapshot_Handler,
+		},
+		{
+			MethodName: "CreateFileShareAcl",
+			Handler:    _FileShareDock_CreateFileShareAcl_Handler,
+		},
+		{
+			MethodName: "DeleteFileShareAcl",
+			Handler:    _FileShareDock_DeleteFileShareAcl_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "model.proto",
+}
+
+// DriverPluginClient is the client API for DriverPlugin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DriverPluginClient interface {
+	// Check the protocol version and negotiate the capability of the plugin
+	Handshake(ctx context.Context, in *HandshakeOpts, opts ...grpc.CallOption) (*HandshakeReply, error)
+	Setup(ctx context.Context, in *PluginOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	Unset(ctx context.Context, in *PluginOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	CreateVolume(ctx context.Context, in *CreateVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	CloneVolume(ctx context.Context, in *CreateVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	PullVolume(ctx context.Context, in *PullVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	DeleteVolume(ctx context.Context, in *DeleteVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	ExtendVolume(ctx context.Context, in *ExtendVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	MigrateVolume(ctx context.Context, in *MigrateVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	RevertVolumeToSnapshot(ctx context.Context, in *RevertVolumeToSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	ManageVolume(ctx context.Context, in *ManageVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	UnmanageVolume(ctx context.Context, in *UnmanageVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	InitializeConnection(ctx context.Context, in *CreateVolumeAttachmentOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	TerminateConnection(ctx context.Context, in *DeleteVolumeAttachmentOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	CreateSnapshot(ctx context.Context, in *CreateVolumeSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	PullSnapshot(ctx context.Context, in *PullVolumeSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	ManageSnapshot(ctx context.Context, in *ManageVolumeSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	UnmanageSnapshot(ctx context.Context, in *UnmanageVolumeSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	DeleteSnapshot(ctx context.Context, in *DeleteVolumeSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	InitializeSnapshotConnection(ctx context.Context, in *CreateSnapshotAttachmentOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	TerminateSnapshotConnection(ctx context.Context, in *DeleteSnapshotAttachmentOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	CreateVolumeGroup(ctx context.Context, in *CreateVolumeGroupOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	UpdateVolumeGroup(ctx context.Context, in *UpdateVolumeGroupOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	DeleteVolumeGroup(ctx context.Context, in *DeleteVolumeGroupOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	CreateGroupSnapshot(ctx context.Context, in *CreateGroupSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	DeleteGroupSnapshot(ctx context.Context, in *DeleteGroupSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	ListPools(ctx context.Context, in *PluginOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	ListVolumes(ctx context.Context, in *ListVolumesOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	ListSnapshots(ctx context.Context, in *ListVolumesOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+}
+
+type driverPluginClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDriverPluginClient(cc *grpc.ClientConn) DriverPluginClient {
+	return &driverPluginClient{cc}
+}
+
+func (c *driverPluginClient) Handshake(ctx context.Context, in *HandshakeOpts, opts ...grpc.CallOption) (*HandshakeReply, error) {
+	out := new(HandshakeReply)
+	err := c.cc.Invoke(ctx, "/proto.DriverPlugin/Handshake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverPluginClient) Setup(ctx context.Context, in *PluginOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.DriverPlugin/Setup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverPluginClient) Unset(ctx context.Context, in *PluginOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.DriverPlugin/Unset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverPluginClient) CreateVolume(ctx context.Context, in *CreateVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.DriverPlugin/CreateVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverPluginClient) CloneVolume(ctx context.Context, in *CreateVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.DriverPlugin/CloneVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverPluginClient) PullVolume(ctx context.Context, in *PullVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.DriverPlugin/PullVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func init() { proto.RegisterFile("model.proto", fileDescriptor_4c16552f9fdb66d8) }
+func (c *driverPluginClient) DeleteVolume(ctx context.Context, in *DeleteVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.DriverPlugin/DeleteVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-var fileDescriptor_4c16552f9fdb66d8 = []byte{
-	// 2038 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xcb, 0x6f, 0xe4, 0x48,
-	0x19, 0x1f, 0xdb, 0xfd, 0xfc, 0x3a, 0x49, 0x77, 0x2a, 0x8f, 0xb5, 0x9a, 0x6c, 0xc8, 0x36, 0xcb,
-	0x2a, 0xda, 0x5d, 0xb2, 0x6c, 0x83, 0xb4, 0x3c, 0xb4, 0x40, 0x32, 0x79, 0xb5, 0x76, 0xc3, 0x64,
-	0x3b, 0x33, 0x73, 0xe0, 0xe6, 0xb1, 0x6b, 0x88, 0x15, 0xb7, 0xab, 0xb1, 0x9d, 0x9e, 0x09, 0x27,
-	0x04, 0x1c, 0x60, 0x8e, 0x1c, 0xb9, 0xcd, 0x48, 0x88, 0x0b, 0x5c, 0xb8, 0x23, 0x2e, 0xfc, 0x0b,
-	0x70, 0x44, 0xe2, 0x88, 0xc4, 0x99, 0x03, 0xe2, 0x80, 0x5c, 0x7e, 0x74, 0x95, 0x1f, 0xd5, 0xee,
-	0xc9, 0x63, 0x32, 0x9a, 0x3e, 0x75, 0xd7, 0x57, 0xe5, 0xcf, 0xf5, 0xfd, 0xbe, 0xef, 0xf7, 0xb9,
-	0x1e, 0x1f, 0x34, 0x06, 0xc4, 0xc0, 0xd6, 0xd6, 0xd0, 0x21, 0x1e, 0x41, 0x65, 0xfa, 0xd3, 0x79,
-	0x56, 0x81, 0xd6, 0x5d, 0x07, 0x6b, 0x1e, 0x7e, 0x48, 0xac, 0xf3, 0x01, 0xbe, 0x37, 0xf4, 0x5c,
-	0xb4, 0x00, 0xb2, 0x69, 0xa8, 0xd2, 0x86, 0xb4, 0x59, 0xef, 0xcb, 0xa6, 0x81, 0x10, 0x94, 0x6c,
-	0x6d, 0x80, 0x55, 0x99, 0x4a, 0xe8, 0x7f, 0x5f, 0xe6, 0x9a, 0x3f, 0xc5, 0xaa, 0xb2, 0x21, 0x6d,
-	0x2a, 0x7d, 0xfa, 0x1f, 0x6d, 0x40, 0xc3, 0xc0, 0xae, 0xee, 0x98, 0x43, 0xcf, 0x24, 0xb6, 0x5a,
-	0xa2, 0xc3, 0x59, 0x11, 0x5a, 0x07, 0x70, 0x6d, 0x6d, 0xe8, 0x9e, 0x12, 0xaf, 0x67, 0xa8, 0x65,
-	0x3a, 0x80, 0x91, 0xa0, 0xf7, 0xa1, 0xa5, 0x8d, 0x34, 0xd3, 0xd2, 0x1e, 0x99, 0x96, 0xe9, 0x5d,
-	0xfc, 0x88, 0xd8, 0x58, 0xad, 0xd0, 0x51, 0x29, 0x39, 0x5a, 0x83, 0xfa, 0xd0, 0x21, 0x8f, 0x4d,
-	0x0b, 0xf7, 0x0c, 0xb5, 0x4a, 0x07, 0x8d, 0x05, 0x68, 0x15, 0x2a, 0x43, 0x42, 0xac, 0x9e, 0xa1,
-	0xd6, 0x68, 0x57, 0xd8, 0x42, 0x6d, 0xa8, 0xf9, 0xff, 0x7e, 0xe8, 0xdb, 0x53, 0xa7, 0x3d, 0x71,
-	0x1b, 0x6d, 0x43, 0x6d, 0x80, 0x3d, 0xcd, 0xd0, 0x3c, 0x4d, 0x85, 0x0d, 0x65, 0xb3, 0xd1, 0xfd,
-	0x6a, 0x80, 0xd6, 0x56, 0x12, 0xa2, 0xad, 0xa3, 0x70, 0xdc, 0x9e, 0xed, 0x39, 0x17, 0xfd, 0xf8,
-	0x31, 0xdf, 0x40, 0xc3, 0x31, 0x47, 0xd8, 0xa1, 0x2f, 0x68, 0x04, 0x06, 0x8e, 0x25, 0x48, 0x85,
-	0xaa, 0x4e, 0x6c, 0x0f, 0x3f, 0xf5, 0xd4, 0x39, 0xda, 0x19, 0x35, 0xd1, 0x29, 0xac, 0x38, 0x78,
-	0x68, 0x99, 0xba, 0xe6, 0x23, 0xb5, 0x4b, 0x1f, 0xd9, 0xf5, 0x67, 0x32, 0x4f, 0x67, 0xd2, 0xcd,
-	0x9b, 0x49, 0x3f, 0xeb, 0xa1, 0x60, 0x5a, 0xd9, 0x0a, 0xd1, 0xbb, 0x30, 0xcf, 0x74, 0xf4, 0x0c,
-	0x75, 0x81, 0xce, 0x84, 0x17, 0xa2, 0x0e, 0xcc, 0x45, 0x8e, 0x39, 0xf1, 0x1d, 0xdd, 0xa4, 0x8e,
-	0xe6, 0x64, 0xe8, 0x43, 0x58, 0x8c, 0xda, 0xfb, 0x0e, 0x19, 0xdc, 0xb5, 0xc8, 0xb9, 0xa1, 0xb6,
-	0x36, 0xa4, 0xcd, 0x5a, 0x3f, 0xdd, 0xe1, 0xdb, 0x1e, 0xfa, 0x47, 0x5d, 0x0c, 0x6c, 0x0f, 0x9b,
-	0xed, 0xef, 0xc2, 0x3c, 0x07, 0x28, 0x6a, 0x81, 0x72, 0x86, 0x2f, 0xc2, 0x10, 0xf4, 0xff, 0xa2,
-	0x65, 0x28, 0x8f, 0x34, 0xeb, 0x3c, 0x0a, 0xc2, 0xa0, 0xf1, 0x1d, 0xf9, 0x5b, 0x52, 0xfb, 0x10,
-	0xda, 0xf9, 0x18, 0x4c, 0xa3, 0xa9, 0xf3, 0x07, 0x19, 0x5a, 0xbb, 0xd8, 0xc2, 0x42, 0x32, 0x70,
-	0x61, 0x27, 0xe7, 0x87, 0x9d, 0xc2, 0x85, 0x1d, 0x1b, 0x5a, 0x25, 0x2e, 0xb4, 0x92, 0x2f, 0x2c,
-	0x18, 0x5a, 0x65, 0x51, 0x68, 0x55, 0xf8, 0xd0, 0x62, 0x80, 0xaf, 0x5e, 0x1d, 0xf0, 0x9d, 0x7f,
-	0x28, 0xd0, 0xda, 0x7b, 0xea, 0x61, 0xdb, 0x98, 0xe5, 0x0e, 0x41, 0xee, 0x48, 0x42, 0x74, 0x0d,
-	0xb9, 0x83, 0x71, 0xf0, 0xfc, 0x15, 0x3a, 0xf8, 0x99, 0x02, 0x2a, 0x9b, 0x6f, 0x4e, 0x42, 0xb0,
-	0xaf, 0xd9, 0xd1, 0x6d, 0xa8, 0x8d, 0xe8, 0xfb, 0x62, 0x37, 0xc7, 0x6d, 0xde, 0x71, 0x95, 0xa4,
-	0xe3, 0x7a, 0x8c, 0x13, 0xaa, 0xd4, 0x09, 0x5f, 0xcb, 0x48, 0x9b, 0xac, 0x19, 0x05, 0x9d, 0x51,
-	0x13, 0x39, 0xa3, 0x9e, 0xeb, 0x0c, 0xb8, 0x42, 0x67, 0xbc, 0x90, 0x41, 0x65, 0x73, 0x85, 0xd0,
-	0x19, 0x2c, 0x84, 0x72, 0x02, 0x42, 0x16, 0x24, 0x85, 0x03, 0x29, 0x4f, 0x7d, 0x41, 0x90, 0x4a,
-	0x22, 0x90, 0xca, 0xb9, 0x20, 0x55, 0xae, 0x10, 0xa4, 0xdf, 0x29, 0xd0, 0x66, 0x5d, 0xbd, 0xed,
-	0x79, 0x9a, 0x7e, 0x3a, 0xc0, 0xf6, 0xf4, 0x30, 0xbd, 0x0b, 0xf3, 0x06, 0xf9, 0x9c, 0xe8, 0x9a,
-	0x15, 0x28, 0xa1, 0x41, 0x5c, 0xeb, 0xf3, 0x42, 0x3f, 0x1e, 0x07, 0xe7, 0x96, 0x67, 0x1e, 0x6b,
-	0xde, 0x29, 0x05, 0xa0, 0xd6, 0x1f, 0x0b, 0xd0, 0x07, 0x50, 0x3b, 0x25, 0xae, 0xd7, 0xb3, 0x1f,
-	0x13, 0x0a, 0x40, 0xa3, 0xdb, 0x0c, 0xa1, 0x3e, 0x0c, 0xc5, 0xfd, 0x78, 0x00, 0xfa, 0x8c, 0xf1,
-	0x4b, 0x85, 0xfa, 0xe5, 0xa3, 0x8c, 0xe0, 0xe5, 0x2d, 0x2a, 0xe8, 0x99, 0xaa, 0xc8, 0x33, 0x35,
-	0xde, 0x33, 0xef, 0xc1, 0xc2, 0xb6, 0xae, 0x63, 0xd7, 0x3d, 0xf6, 0xdf, 0xad, 0x13, 0x2b, 0x8c,
-	0xef, 0x84, 0xf4, 0x72, 0x7e, 0xfa, 0xa7, 0x0c, 0x6d, 0x36, 0xda, 0x2e, 0xe1, 0x27, 0x16, 0x63,
-	0x65, 0x1a, 0x8c, 0x4b, 0x1c, 0xc6, 0xf9, 0xb3, 0xb9, 0x86, 0x0f, 0x72, 0x1a, 0xe3, 0xea, 0xd5,
-	0x63, 0xfc, 0x47, 0x05, 0xd6, 0x82, 0xc8, 0x89, 0xb8, 0x3c, 0x01, 0x65, 0xfe, 0x03, 0x2b, 0xa7,
-	0x3e, 0xb0, 0x37, 0xce, 0x88, 0xa3, 0x14, 0x23, 0x3e, 0xe6, 0x18, 0x91, 0x6d, 0xd7, 0xeb, 0xca,
-	0x89, 0x7f, 0xc9, 0xb0, 0x16, 0x44, 0xe1, 0x15, 0xf9, 0x6b, 0x2a, 0x66, 0x1c, 0xa5, 0x98, 0xf1,
-	0x31, 0xc7, 0x8c, 0x4b, 0x61, 0x7d, 0xeb, 0xb8, 0xf1, 0x33, 0x09, 0x6a, 0x11, 0x08, 0x74, 0x59,
-	0x67, 0x69, 0xde, 0x63, 0xe2, 0x0c, 0xc2, 0xa7, 0xe3, 0xb6, 0xbf, 0x14, 0x24, 0xee, 0xfd, 0x8b,
-	0x61, 0xa4, 0x23, 0x6c, 0xf9, 0x2b, 0x1b, 0x1f, 0xba, 0x70, 0x95, 0x4f, 0xff, 0x53, 0xff, 0x0c,
-	0xc3, 0xaf, 0xa0, 0x6c, 0x0e, 0x7d, 0x26, 0x98, 0xb6, 0xe9, 0x99, 0x9a, 0x47, 0x9c, 0x10, 0x82,
-	0xb1, 0xa0, 0x33, 0x02, 0x08, 0xb2, 0x0d, 0xdd, 0x93, 0x7d, 0x04, 0x25, 0x0a, 0xbd, 0x44, 0xa1,
-	0xff, 0x52, 0x08, 0xfd, 0x78, 0xc0, 0xd6, 0x78, 0x57, 0x47, 0x07, 0xb6, 0x3f, 0x81, 0xfa, 0xcb,
-	0x6d, 0x72, 0xfe, 0x5c, 0x87, 0x95, 0x80, 0x3e, 0xcc, 0xae, 0xa9, 0xf0, 0x8a, 0x2e, 0xb1, 0x7a,
-	0x53, 0xd2, 0xab, 0xb7, 0x4d, 0x68, 0x0e, 0x1d, 0x73, 0xa0, 0x39, 0x17, 0x0f, 0xa3, 0x94, 0x1d,
-	0x40, 0x92, 0x14, 0xd3, 0xdd, 0x23, 0xd6, 0x89, 0x6d, 0xb0, 0x63, 0x03, 0x9c, 0xd2, 0x1d, 0xaf,
-	0x78, 0x79, 0xff, 0x73, 0x09, 0xd6, 0xc2, 0xf9, 0x67, 0x6e, 0x36, 0xd5, 0x06, 0x75, 0xdc, 0xf7,
-	0xb8, 0xfc, 0x94, 0x00, 0x78, 0xeb, 0x58, 0xa0, 0x20, 0xf0, 0xad, 0xf0, 0x1d, 0xe8, 0x57, 0x12,
-	0xac, 0xc7, 0xc0, 0x64, 0x4f, 0x63, 0x8e, 0x4e, 0xe3, 0x07, 0xc2, 0x69, 0x9c, 0x08, 0x55, 0x04,
-	0x13, 0x99, 0xf0, 0x1e, 0x1f, 0x43, 0x83, 0xe8, 0x67, 0x3d, 0x23, 0xdc, 0x70, 0x84, 0xad, 0x04,
-	0xef, 0x17, 0x44, 0xbc, 0x6f, 0xf2, 0xbc, 0xf7, 0xd9, 0xe2, 0x86, 0x08, 0x85, 0x67, 0x08, 0x63,
-	0x01, 0xda, 0x67, 0xd2, 0xd3, 0x22, 0xb5, 0xf1, 0x7d, 0xa1, 0x8d, 0x79, 0x79, 0xe9, 0xdb, 0xb0,
-	0x30, 0x8a, 0x49, 0xf5, 0xb9, 0xe9, 0x7a, 0x2a, 0xa2, 0xda, 0x16, 0x53, 0x8c, 0xeb, 0x27, 0x06,
-	0xfa, 0x81, 0xcd, 0x9c, 0x90, 0x1c, 0x11, 0x03, 0xab, 0x4b, 0x41, 0x60, 0x27, 0xc4, 0x7e, 0x60,
-	0x33, 0xf3, 0x39, 0xc6, 0x8e, 0x49, 0x0c, 0x75, 0x99, 0xee, 0x81, 0xd2, 0x1d, 0xa8, 0x0b, 0xcb,
-	0x8c, 0x70, 0x47, 0xb3, 0x8d, 0x27, 0xa6, 0xe1, 0x9d, 0xaa, 0x2b, 0xf4, 0x81, 0xcc, 0x3e, 0x76,
-	0xf9, 0xbc, 0xca, 0x2f, 0x9f, 0xef, 0xc1, 0x3b, 0x13, 0xc3, 0x6c, 0xaa, 0xe3, 0x95, 0x2f, 0xe0,
-	0x2b, 0x05, 0x02, 0x66, 0x2a, 0x95, 0x97, 0x4a, 0xdd, 0xff, 0xa9, 0xc2, 0x4a, 0xf0, 0x49, 0x9a,
-	0xe5, 0xaf, 0x6b, 0xcb, 0x5f, 0x99, 0x00, 0xdf, 0x7c, 0xfe, 0xca, 0x9e, 0xc6, 0xed, 0xcc, 0x5f,
-	0x6c, 0x86, 0x6a, 0x71, 0x19, 0x2a, 0xdb, 0x8a, 0xbc, 0x0c, 0xc5, 0xe5, 0xc1, 0xc5, 0x64, 0x1e,
-	0x64, 0x88, 0x8f, 0xde, 0x40, 0xe2, 0xef, 0xd9, 0xda, 0x23, 0x6b, 0x46, 0xfc, 0xeb, 0x23, 0x7e,
-	0x26, 0xc0, 0x37, 0x4f, 0xfc, 0xec, 0x69, 0xbc, 0x6e, 0xc4, 0xcf, 0xb6, 0x62, 0x46, 0xfc, 0x29,
-	0x89, 0xff, 0xdf, 0x2a, 0xac, 0xee, 0x9a, 0xee, 0x8c, 0xf9, 0xd3, 0x31, 0xff, 0x17, 0xc5, 0x98,
-	0xff, 0xfd, 0xe8, 0x2b, 0x95, 0x89, 0xf0, 0xa5, 0xa9, 0xff, 0xeb, 0xa2, 0xd4, 0xdf, 0x16, 0xcf,
-	0xe3, 0x76, 0x72, 0xff, 0x20, 0xc5, 0xfd, 0x0f, 0xc4, 0x66, 0xcc, 0xc8, 0x3f, 0x25, 0xf9, 0xff,
-	0x56, 0x87, 0xb7, 0xf6, 0x35, 0xd3, 0x22, 0x23, 0xec, 0xcc, 0xd8, 0x5f, 0x9c, 0xfd, 0xbf, 0x2c,
-	0xc6, 0xfe, 0xe8, 0x83, 0x9b, 0x03, 0xf1, 0xa5, 0xe9, 0xff, 0xac, 0x28, 0xfd, 0x77, 0x26, 0x4c,
-	0xe4, 0x76, 0xf2, 0xff, 0xeb, 0xb0, 0xa4, 0x59, 0x16, 0x79, 0x12, 0x9c, 0x8a, 0xe2, 0xf0, 0x96,
-	0x37, 0x3c, 0xbe, 0xc8, 0xea, 0x42, 0x5b, 0x80, 0xe2, 0x59, 0xee, 0x68, 0xfa, 0x19, 0xb6, 0x8d,
-	0x9e, 0x11, 0xd6, 0x43, 0x64, 0xf4, 0xa0, 0x43, 0x26, 0xc3, 0x04, 0x47, 0x15, 0x1f, 0x4e, 0x40,
-	0xaa, 0x50, 0x8a, 0x59, 0x12, 0xa4, 0x98, 0xe5, 0x37, 0x2b, 0xc5, 0xb4, 0x5d, 0x68, 0x8e, 0xb1,
-	0xfc, 0xc9, 0x39, 0x76, 0x73, 0xfd, 0x2a, 0x4d, 0xeb, 0x57, 0x39, 0xcf, 0xaf, 0x9d, 0xe7, 0x72,
-	0x74, 0x0c, 0x1b, 0x28, 0x38, 0x70, 0xc8, 0xf9, 0xb0, 0x70, 0x56, 0xe3, 0x23, 0x56, 0x49, 0x45,
-	0xec, 0xe4, 0x4b, 0xf6, 0xac, 0xec, 0x54, 0xce, 0xc9, 0x4e, 0xeb, 0x00, 0x9a, 0x11, 0x1a, 0xea,
-	0xd2, 0x9b, 0x98, 0x7a, 0x9f, 0x91, 0x04, 0x05, 0x45, 0x03, 0x32, 0xc2, 0xd1, 0x90, 0x2a, 0x1d,
-	0xc2, 0x0b, 0x73, 0xb3, 0x58, 0xee, 0x4d, 0x7a, 0xe7, 0x2f, 0x12, 0xac, 0x3c, 0x18, 0x1a, 0x05,
-	0x30, 0xe2, 0xf1, 0x90, 0x53, 0x78, 0xf0, 0x16, 0x28, 0x93, 0x2d, 0x28, 0x89, 0x2d, 0x28, 0xe7,
-	0x59, 0xc0, 0x5f, 0x66, 0x74, 0x2e, 0xa2, 0xb3, 0xaa, 0x49, 0x06, 0x8c, 0x55, 0xcb, 0x9c, 0xea,
-	0x49, 0x8e, 0x66, 0x5e, 0x5d, 0xe2, 0x5f, 0xfd, 0x3f, 0x09, 0x5a, 0x41, 0x8c, 0x32, 0xd5, 0x39,
-	0xef, 0xc1, 0x82, 0xc6, 0x5f, 0xae, 0x04, 0x53, 0x48, 0x48, 0xfd, 0x71, 0x3a, 0xb1, 0x6d, 0xac,
-	0x53, 0x62, 0xfa, 0xb9, 0x27, 0x98, 0x56, 0x42, 0xca, 0x55, 0xbd, 0x28, 0x5c, 0xd5, 0x4b, 0xf2,
-	0xd5, 0xb9, 0x69, 0x29, 0xd7, 0x82, 0xcb, 0xad, 0x1b, 0x7c, 0xf3, 0x77, 0xf1, 0x2b, 0x33, 0x3f,
-	0xf9, 0xea, 0x9b, 0x36, 0xff, 0xaf, 0x0a, 0x2c, 0x05, 0xe9, 0x65, 0xdf, 0xb4, 0xf0, 0xc9, 0xa9,
-	0xe6, 0x5c, 0x77, 0x79, 0xd6, 0xab, 0x5d, 0xee, 0xec, 0xa6, 0xca, 0xaf, 0x36, 0xb9, 0xfb, 0x01,
-	0x0e, 0x85, 0xd7, 0xa7, 0x02, 0xeb, 0x4f, 0x32, 0x2c, 0x05, 0x09, 0x44, 0xec, 0xc6, 0x97, 0x2b,
-	0x4a, 0xdc, 0x4d, 0xdd, 0xf9, 0x6e, 0x72, 0x47, 0x96, 0x2f, 0x03, 0xda, 0x2d, 0xa8, 0x4b, 0xfc,
-	0xb7, 0x04, 0xcd, 0x03, 0x6c, 0x63, 0xc7, 0xd4, 0xfb, 0xd8, 0x1d, 0x12, 0xdb, 0xc5, 0xe8, 0x13,
-	0xa8, 0x38, 0xd8, 0x3d, 0xb7, 0x3c, 0xaa, 0xa2, 0xd1, 0x7d, 0x3b, 0x34, 0x34, 0x31, 0x6e, 0xab,
-	0x4f, 0x07, 0x1d, 0xde, 0xe9, 0x87, 0xc3, 0xd1, 0x37, 0xa1, 0x8c, 0x1d, 0x87, 0x38, 0xf4, 0x35,
-	0x8d, 0xee, 0x5a, 0xce, 0x73, 0x7b, 0xfe, 0x98, 0xc3, 0x3b, 0xfd, 0x60, 0x70, 0xbb, 0x03, 0x95,
-	0x40, 0x93, 0x6f, 0xe3, 0x00, 0xbb, 0xae, 0xf6, 0x63, 0x1c, 0x4e, 0x3e, 0x6a, 0xb6, 0x3f, 0x85,
-	0x32, 0x7d, 0xca, 0xe7, 0x9b, 0x4e, 0x8c, 0xa8, 0x9f, 0xfe, 0x4f, 0xf2, 0x4d, 0x4e, 0xf1, 0x6d,
-	0xa7, 0x0a, 0x65, 0x07, 0x0f, 0xad, 0x8b, 0xce, 0x0b, 0x09, 0x16, 0x0e, 0xb0, 0x77, 0x84, 0x3d,
-	0xc7, 0xd4, 0x5d, 0x1a, 0x1e, 0xeb, 0x00, 0xa6, 0xed, 0x7a, 0x9a, 0xad, 0xfb, 0xf1, 0x10, 0xe8,
-	0x65, 0x24, 0x7e, 0xff, 0x80, 0x0e, 0x67, 0x3f, 0x97, 0x63, 0x89, 0x1f, 0x4e, 0xae, 0xa7, 0x39,
-	0xde, 0x7d, 0x33, 0xfe, 0xe8, 0x8c, 0x05, 0xbe, 0x49, 0xd8, 0x36, 0x68, 0x5f, 0x98, 0xb2, 0xc2,
-	0x66, 0x7e, 0xbd, 0x57, 0xf7, 0xef, 0x75, 0x80, 0xbb, 0xc4, 0xf6, 0x1c, 0x62, 0x59, 0xd8, 0x41,
-	0xdb, 0x30, 0xc7, 0x2e, 0x7e, 0xd0, 0x5b, 0x39, 0xd5, 0xcd, 0xed, 0xd5, 0x6c, 0xbc, 0x3b, 0x77,
-	0x7c, 0x15, 0xec, 0xa7, 0x35, 0x56, 0x91, 0xac, 0xa7, 0x15, 0xab, 0x60, 0x8b, 0x33, 0x63, 0x15,
-	0xc9, 0x8a, 0x4d, 0x81, 0x8a, 0x2f, 0x60, 0x39, 0xab, 0xb4, 0x10, 0x7d, 0x79, 0x42, 0xdd, 0xa1,
-	0x58, 0x65, 0x56, 0x21, 0x5e, 0xac, 0x32, 0xaf, 0x4a, 0x4f, 0xa0, 0xf2, 0x01, 0xac, 0x66, 0xd7,
-	0x90, 0xa1, 0x77, 0x26, 0x96, 0x98, 0x89, 0xd5, 0x66, 0x97, 0x4d, 0xc5, 0x6a, 0xf3, 0xab, 0xaa,
-	0x04, 0x6a, 0x3f, 0x83, 0xc5, 0xd4, 0xa5, 0x2e, 0x5a, 0x13, 0x5d, 0xf7, 0x8a, 0x95, 0xa5, 0xee,
-	0x5f, 0x62, 0x65, 0x99, 0x37, 0x33, 0x62, 0x65, 0xa9, 0x33, 0xdd, 0x58, 0x59, 0xe6, 0x69, 0xaf,
-	0x40, 0xd9, 0x11, 0xa0, 0xf4, 0x21, 0x11, 0x7a, 0x5b, 0x78, 0x7e, 0x24, 0x50, 0x77, 0x0f, 0x96,
-	0x32, 0x76, 0x84, 0x68, 0x5d, 0xbc, 0x5b, 0x2c, 0xe2, 0x06, 0x66, 0xed, 0x9a, 0x70, 0x43, 0x62,
-	0x55, 0x2b, 0x56, 0x96, 0x5a, 0xc9, 0xc7, 0xca, 0x32, 0xd7, 0xf8, 0x45, 0x7c, 0x9a, 0xa5, 0x2c,
-	0x73, 0xbd, 0x2d, 0x50, 0xf6, 0x29, 0xc0, 0x38, 0x7b, 0xa2, 0x95, 0x78, 0x1c, 0x9b, 0x50, 0xf3,
-	0x1f, 0xef, 0xfe, 0xb6, 0x0e, 0xf3, 0xc7, 0x0e, 0x19, 0x99, 0xae, 0xbf, 0x28, 0x24, 0xfa, 0xd9,
-	0x2c, 0xb7, 0xcd, 0x72, 0xdb, 0x2c, 0xb7, 0xcd, 0x72, 0xdb, 0x2d, 0xc8, 0x6d, 0xdd, 0xdf, 0x4b,
-	0xb0, 0x14, 0x2f, 0xe2, 0x99, 0xe5, 0xd7, 0x01, 0x34, 0x13, 0xdb, 0x22, 0xd4, 0xce, 0xdf, 0x2e,
-	0x09, 0x66, 0x7b, 0x00, 0xcd, 0xc4, 0x56, 0x21, 0x56, 0x94, 0xb1, 0x85, 0x10, 0xcc, 0xf4, 0xb9,
-	0x04, 0xf3, 0xf1, 0x58, 0x9a, 0x46, 0x6f, 0xdf, 0x1c, 0x7f, 0x23, 0x01, 0x04, 0x44, 0x8f, 0xf2,
-	0x3c, 0x7b, 0xc8, 0x11, 0x67, 0xd8, 0xe4, 0xc9, 0xc7, 0xa4, 0x3c, 0x9f, 0xa1, 0x22, 0x79, 0x7a,
-	0x90, 0xaf, 0xe2, 0x51, 0x85, 0x76, 0x7c, 0xe3, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0xfe, 0x5f,
-	0xb6, 0x6b, 0xdd, 0x39, 0x00, 0x00,
+func (c *driverPluginClient) ExtendVolume(ctx context.Context, in *ExtendVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.DriverPlugin/ExtendVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (c *driverPluginClient) MigrateVolume(ctx context.Context, in *MigrateVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.DriverPlugin/MigrateVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (c *driverPluginClient) RevertVolumeToSnapshot(ctx context.Context, in *RevertVolumeToSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.DriverPlugin/RevertVolumeToSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-// ControllerClient is the client API for Controller service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ControllerClient interface {
-	// Create a volume
-	CreateVolume(ctx context.Context, in *CreateVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error)
-	// Delete a volume
-	DeleteVolume(ctx context.Context, in *DeleteVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error)
-	// Extend a volume
-	ExtendVolume(ctx context.Context, in *ExtendVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error)
-	// Create a volume snapshot
-	CreateVolumeSnapshot(ctx context.Context, in *CreateVolumeSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error)
-	// Delete a volume snapshot
-	DeleteVolumeSnapshot(ctx context.Context, in *DeleteVolumeSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error)
-	// Create a volume attachment
-	CreateVolumeAttachment(ctx context.Context, in *CreateVolumeAttachmentOpts, opts ...grpc.CallOption) (*GenericResponse, error)
-	// Delete a volume attachment
-	DeleteVolumeAttachment(ctx context.Context, in *DeleteVolumeAttachmentOpts, opts ...grpc.CallOption) (*GenericResponse, error)
-	// Create a replication
-	CreateReplication(ctx context.Context, in *CreateReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error)
-	// Delete a replication
-	DeleteReplication(ctx context.Context, in *DeleteReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error)
-	// Enable a replication
-	EnableReplication(ctx context.Context, in *EnableReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error)
-	// Disable a replication
-	DisableReplication(ctx context.Context, in *DisableReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error)
-	// Failover a replication
-	FailoverReplication(ctx context.Context, in *FailoverReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error)
-	// Create a volume group
-	CreateVolumeGroup(ctx context.Context, in *CreateVolumeGroupOpts, opts ...grpc.CallOption) (*GenericResponse, error)
-	// Update volume group
-	UpdateVolumeGroup(ctx context.Context, in *UpdateVolumeGroupOpts, opts ...grpc.CallOption) (*GenericResponse, error)
-	// Delete volume group
-	DeleteVolumeGroup(ctx context.Context, in *DeleteVolumeGroupOpts, opts ...grpc.CallOption) (*GenericResponse, error)
-	GetMetrics(ctx context.Context, in *GetMetricsOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+func (c *driverPluginClient) ManageVolume(ctx context.Context, in *ManageVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.DriverPlugin/ManageVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type controllerClient struct {
-	cc *grpc.ClientConn
+func (c *driverPluginClient) UnmanageVolume(ctx context.Context, in *UnmanageVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.DriverPlugin/UnmanageVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func NewControllerClient(cc *grpc.ClientConn) ControllerClient {
-	return &controllerClient{cc}
+func (c *driverPluginClient) InitializeConnection(ctx context.Context, in *CreateVolumeAttachmentOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.DriverPlugin/InitializeConnection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (c *controllerClient) CreateVolume(ctx context.Context, in *CreateVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+func (c *driverPluginClient) TerminateConnection(ctx context.Context, in *DeleteVolumeAttachmentOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
 	out := new(GenericResponse)
-	err := c.cc.Invoke(ctx, "/proto.Controller/CreateVolume", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/proto.DriverPlugin/TerminateConnection", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *controllerClient) DeleteVolume(ctx context.Context, in *DeleteVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+func (c *driverPluginClient) CreateSnapshot(ctx context.Context, in *CreateVolumeSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
 	out := new(GenericResponse)
-	err := c.cc.Invoke(ctx, "/proto.Controller/DeleteVolume", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/proto.DriverPlugin/CreateSnapshot", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *controllerClient) ExtendVolume(ctx context.Context, in *ExtendVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+func (c *driverPluginClient) PullSnapshot(ctx context.Context, in *PullVolumeSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
 	out := new(GenericResponse)
-	err := c.cc.Invoke(ctx, "/proto.Controller/ExtendVolume", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/proto.DriverPlugin/PullSnapshot", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *controllerClient) CreateVolumeSnapshot(ctx context.Context, in *CreateVolumeSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+func (c *driverPluginClient) ManageSnapshot(ctx context.Context, in *ManageVolumeSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
 	out := new(GenericResponse)
-	err := c.cc.Invoke(ctx, "/proto.Controller/CreateVolumeSnapshot", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/proto.DriverPlugin/ManageSnapshot", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *controllerClient) DeleteVolumeSnapshot(ctx context.Context, in *DeleteVolumeSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+func (c *driverPluginClient) UnmanageSnapshot(ctx context.Context, in *UnmanageVolumeSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
 	out := new(GenericResponse)
-	err := c.cc.Invoke(ctx, "/proto.Controller/DeleteVolumeSnapshot", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/proto.DriverPlugin/UnmanageSnapshot", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *controllerClient) CreateVolumeAttachment(ctx context.Context, in *CreateVolumeAttachmentOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+func (c *driverPluginClient) DeleteSnapshot(ctx context.Context, in *DeleteVolumeSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
 	out := new(GenericResponse)
-	err := c.cc.Invoke(ctx, "/proto.Controller/CreateVolumeAttachment", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/proto.DriverPlugin/DeleteSnapshot", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *controllerClient) DeleteVolumeAttachment(ctx context.Context, in *DeleteVolumeAttachmentOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+func (c *driverPluginClient) InitializeSnapshotConnection(ctx context.Context, in *CreateSnapshotAttachmentOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
 	out := new(GenericResponse)
-	err := c.cc.Invoke(ctx, "/proto.Controller/DeleteVolumeAttachment", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/proto.DriverPlugin/InitializeSnapshotConnection", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *controllerClient) CreateReplication(ctx context.Context, in *CreateReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+func (c *driverPluginClient) TerminateSnapshotConnection(ctx context.Context, in *DeleteSnapshotAttachmentOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
 	out := new(GenericResponse)
-	err := c.cc.Invoke(ctx, "/proto.Controller/CreateReplication", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/proto.DriverPlugin/TerminateSnapshotConnection", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *controllerClient) DeleteReplication(ctx context.Context, in *DeleteReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+func (c *driverPluginClient) CreateVolumeGroup(ctx context.Context, in *CreateVolumeGroupOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
 	out := new(GenericResponse)
-	err := c.cc.Invoke(ctx, "/proto.Controller/DeleteReplication", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/proto.DriverPlugin/CreateVolumeGroup", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *controllerClient) EnableReplication(ctx context.Context, in *EnableReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+func (c *driverPluginClient) UpdateVolumeGroup(ctx context.Context, in *UpdateVolumeGroupOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
 	out := new(GenericResponse)
-	err := c.cc.Invoke(ctx, "/proto.Controller/EnableReplication", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/proto.DriverPlugin/UpdateVolumeGroup", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *controllerClient) DisableReplication(ctx context.Context, in *DisableReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+func (c *driverPluginClient) DeleteVolumeGroup(ctx context.Context, in *DeleteVolumeGroupOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
 	out := new(GenericResponse)
-	err := c.cc.Invoke(ctx, "/proto.Controller/DisableReplication", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/proto.DriverPlugin/DeleteVolumeGroup", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *controllerClient) FailoverReplication(ctx context.Context, in *FailoverReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+func (c *driverPluginClient) CreateGroupSnapshot(ctx context.Context, in *CreateGroupSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
 	out := new(GenericResponse)
-	err := c.cc.Invoke(ctx, "/proto.Controller/FailoverReplication", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/proto.DriverPlugin/CreateGroupSnapshot", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *controllerClient) CreateVolumeGroup(ctx context.Context, in *CreateVolumeGroupOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+func (c *driverPluginClient) DeleteGroupSnapshot(ctx context.Context, in *DeleteGroupSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
 	out := new(GenericResponse)
-	err := c.cc.Invoke(ctx, "/proto.Controller/CreateVolumeGroup", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/proto.DriverPlugin/DeleteGroupSnapshot", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *controllerClient) UpdateVolumeGroup(ctx context.Context, in *UpdateVolumeGroupOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+func (c *driverPluginClient) ListPools(ctx context.Context, in *PluginOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
 	out := new(GenericResponse)
-	err := c.cc.Invoke(ctx, "/proto.Controller/UpdateVolumeGroup", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/proto.DriverPlugin/ListPools", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *controllerClient) DeleteVolumeGroup(ctx context.Context, in *DeleteVolumeGroupOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+func (c *driverPluginClient) ListVolumes(ctx context.Context, in *ListVolumesOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
 	out := new(GenericResponse)
-	err := c.cc.Invoke(ctx, "/proto.Controller/DeleteVolumeGroup", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/proto.DriverPlugin/ListVolumes", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *controllerClient) GetMetrics(ctx context.Context, in *GetMetricsOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+func (c *driverPluginClient) ListSnapshots(ctx context.Context, in *ListVolumesOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
 	out := new(GenericResponse)
-	err := c.cc.Invoke(ctx, "/proto.Controller/GetMetrics", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/proto.DriverPlugin/ListSnapshots", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// ControllerServer is the server API for Controller service.
-type ControllerServer interface {
-	// Create a volume
+// DriverPluginServer is the server API for DriverPlugin service.
+type DriverPluginServer interface {
+	// Check the protocol version and negotiate the capability of the plugin
+	Handshake(context.Context, *HandshakeOpts) (*HandshakeReply, error)
+	Setup(context.Context, *PluginOpts) (*GenericResponse, error)
+	Unset(context.Context, *PluginOpts) (*GenericResponse, error)
 	CreateVolume(context.Context, *CreateVolumeOpts) (*GenericResponse, error)
-	// Delete a volume
+	CloneVolume(context.Context, *CreateVolumeOpts) (*GenericResponse, error)
+	PullVolume(context.Context, *PullVolumeOpts) (*GenericResponse, error)
 	DeleteVolume(context.Context, *DeleteVolumeOpts) (*GenericResponse, error)
-	// Extend a volume
 	ExtendVolume(context.Context, *ExtendVolumeOpts) (*GenericResponse, error)
-	// Create a volume snapshot
-	CreateVolumeSnapshot(context.Context, *CreateVolumeSnapshotOpts) (*GenericResponse, error)
-	// Delete a volume snapshot
-	DeleteVolumeSnapshot(context.Context, *DeleteVolumeSnapshotOpts) (*GenericResponse, error)
-	// Create a volume attachment
-	CreateVolumeAttachment(context.Context, *CreateVolumeAttachmentOpts) (*GenericResponse, error)
-	// Delete a volume attachment
-	DeleteVolumeAttachment(context.Context, *DeleteVolumeAttachmentOpts) (*GenericResponse, error)
-	// Create a replication
-	CreateReplication(context.Context, *CreateReplicationOpts) (*GenericResponse, error)
-	// Delete a replication
-	DeleteReplication(context.Context, *DeleteReplicationOpts) (*GenericResponse, error)
-	// Enable a replication
-	EnableReplication(context.Context, *EnableReplicationOpts) (*GenericResponse, error)
-	// Disable a replication
-	DisableReplication(context.Context, *DisableReplicationOpts) (*GenericResponse, error)
-	// Failover a replication
-	FailoverReplication(context.Context, *FailoverReplicationOpts) (*GenericResponse, error)
-	// Create a volume group
+	MigrateVolume(context.Context, *MigrateVolumeOpts) (*GenericResponse, error)
+	RevertVolumeToSnapshot(context.Context, *RevertVolumeToSnapshotOpts) (*GenericResponse, error)
+	ManageVolume(context.Context, *ManageVolumeOpts) (*GenericResponse, error)
+	UnmanageVolume(context.Context, *UnmanageVolumeOpts) (*GenericResponse, error)
+	InitializeConnection(context.Context, *CreateVolumeAttachmentOpts) (*GenericResponse, error)
+	TerminateConnection(context.Context, *DeleteVolumeAttachmentOpts) (*GenericResponse, error)
+	CreateSnapshot(context.Context, *CreateVolumeSnapshotOpts) (*GenericResponse, error)
+	PullSnapshot(context.Context, *PullVolumeSnapshotOpts) (*GenericResponse, error)
+	ManageSnapshot(context.Context, *ManageVolumeSnapshotOpts) (*GenericResponse, error)
+	UnmanageSnapshot(context.Context, *UnmanageVolumeSnapshotOpts) (*GenericResponse, error)
+	DeleteSnapshot(context.Context, *DeleteVolumeSnapshotOpts) (*GenericResponse, error)
+	InitializeSnapshotConnection(context.Context, *CreateSnapshotAttachmentOpts) (*GenericResponse, error)
+	TerminateSnapshotConnection(context.Context, *DeleteSnapshotAttachmentOpts) (*GenericResponse, error)
 	CreateVolumeGroup(context.Context, *CreateVolumeGroupOpts) (*GenericResponse, error)
-	// Update volume group
 	UpdateVolumeGroup(context.Context, *UpdateVolumeGroupOpts) (*GenericResponse, error)
-	// Delete volume group
 	DeleteVolumeGroup(context.Context, *DeleteVolumeGroupOpts) (*GenericResponse, error)
-	GetMetrics(context.Context, *GetMetricsOpts) (*GenericResponse, error)
+	CreateGroupSnapshot(context.Context, *CreateGroupSnapshotOpts) (*GenericResponse, error)
+	DeleteGroupSnapshot(context.Context, *DeleteGroupSnapshotOpts) (*GenericResponse, error)
+	ListPools(context.Context, *PluginOpts) (*GenericResponse, error)
+	ListVolumes(context.Context, *ListVolumesOpts) (*GenericResponse, error)
+	ListSnapshots(context.Context, *ListVolumesOpts) (*GenericResponse, error)
 }
 
-// UnimplementedControllerServer can be embedded to have forward compatible implementations.
-type UnimplementedControllerServer struct {
+// UnimplementedDriverPluginServer can be embedded to have forward compatible implementations.
+type UnimplementedDriverPluginServer struct {
 }
 
-func (*UnimplementedControllerServer) CreateVolume(ctx context.Context, req *CreateVolumeOpts) (*GenericResponse, error) {
+func (*UnimplementedDriverPluginServer) Handshake(ctx context.Context, req *HandshakeOpts) (*HandshakeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Handshake not implemented")
+}
+func (*UnimplementedDriverPluginServer) Setup(ctx context.Context, req *PluginOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Setup not implemented")
+}
+func (*UnimplementedDriverPluginServer) Unset(ctx context.Context, req *PluginOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unset not implemented")
+}
+func (*UnimplementedDriverPluginServer) CreateVolume(ctx context.Context, req *CreateVolumeOpts) (*GenericResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateVolume not implemented")
 }
-func (*UnimplementedControllerServer) DeleteVolume(ctx context.Context, req *DeleteVolumeOpts) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteVolume not implemented")
+func (*UnimplementedDriverPluginServer) CloneVolume(ctx context.Context, req *CreateVolumeOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneVolume not implemented")
 }
-func (*UnimplementedControllerServer) ExtendVolume(ctx context.Context, req *ExtendVolumeOpts) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExtendVolume not implemented")
+func (*UnimplementedDriverPluginServer) PullVolume(ctx context.Context, req *PullVolumeOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PullVolume not implemented")
 }
-func (*UnimplementedControllerServer) CreateVolumeSnapshot(ctx context.Context, req *CreateVolumeSnapshotOpts) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateVolumeSnapshot not implemented")
+func (*UnimplementedDriverPluginServer) DeleteVolume(ctx context.Context, req *DeleteVolumeOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVolume not implemented")
 }
-func (*UnimplementedControllerServer) DeleteVolumeSnapshot(ctx context.Context, req *DeleteVolumeSnapshotOpts) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteVolumeSnapshot not implemented")
+func (*UnimplementedDriverPluginServer) ExtendVolume(ctx context.Context, req *ExtendVolumeOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendVolume not implemented")
 }
-func (*UnimplementedControllerServer) CreateVolumeAttachment(ctx context.Context, req *CreateVolumeAttachmentOpts) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateVolumeAttachment not implemented")
+func (*UnimplementedDriverPluginServer) MigrateVolume(ctx context.Context, req *MigrateVolumeOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateVolume not implemented")
 }
-func (*UnimplementedControllerServer) DeleteVolumeAttachment(ctx context.Context, req *DeleteVolumeAttachmentOpts) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteVolumeAttachment not implemented")
+func (*UnimplementedDriverPluginServer) RevertVolumeToSnapshot(ctx context.Context, req *RevertVolumeToSnapshotOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertVolumeToSnapshot not implemented")
 }
-func (*UnimplementedControllerServer) CreateReplication(ctx context.Context, req *CreateReplicationOpts) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateReplication not implemented")
+func (*UnimplementedDriverPluginServer) ManageVolume(ctx context.Context, req *ManageVolumeOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ManageVolume not implemented")
 }
-func (*UnimplementedControllerServer) DeleteReplication(ctx context.Context, req *DeleteReplicationOpts) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteReplication not implemented")
+func (*UnimplementedDriverPluginServer) UnmanageVolume(ctx context.Context, req *UnmanageVolumeOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnmanageVolume not implemented")
 }
-func (*UnimplementedControllerServer) EnableReplication(ctx context.Context, req *EnableReplicationOpts) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EnableReplication not implemented")
+func (*UnimplementedDriverPluginServer) InitializeConnection(ctx context.Context, req *CreateVolumeAttachmentOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitializeConnection not implemented")
 }
-func (*UnimplementedControllerServer) DisableReplication(ctx context.Context, req *DisableReplicationOpts) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DisableReplication not implemented")
+func (*UnimplementedDriverPluginServer) TerminateConnection(ctx context.Context, req *DeleteVolumeAttachmentOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TerminateConnection not implemented")
 }
-func (*UnimplementedControllerServer) FailoverReplication(ctx context.Context, req *FailoverReplicationOpts) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FailoverReplication not implemented")
+func (*UnimplementedDriverPluginServer) CreateSnapshot(ctx context.Context, req *CreateVolumeSnapshotOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSnapshot not implemented")
 }
-func (*UnimplementedControllerServer) CreateVolumeGroup(ctx context.Context, req *CreateVolumeGroupOpts) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateVolumeGroup not implemented")
+func (*UnimplementedDriverPluginServer) PullSnapshot(ctx context.Context, req *PullVolumeSnapshotOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PullSnapshot not implemented")
 }
-func (*UnimplementedControllerServer) UpdateVolumeGroup(ctx context.Context, req *UpdateVolumeGroupOpts) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateVolumeGroup not implemented")
+func (*UnimplementedDriverPluginServer) ManageSnapshot(ctx context.Context, req *ManageVolumeSnapshotOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ManageSnapshot not implemented")
 }
-func (*UnimplementedControllerServer) DeleteVolumeGroup(ctx context.Context, req *DeleteVolumeGroupOpts) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteVolumeGroup not implemented")
+func (*UnimplementedDriverPluginServer) UnmanageSnapshot(ctx context.Context, req *UnmanageVolumeSnapshotOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnmanageSnapshot not implemented")
 }
-func (*UnimplementedControllerServer) GetMetrics(ctx context.Context, req *GetMetricsOpts) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMetrics not implemented")
+func (*UnimplementedDriverPluginServer) DeleteSnapshot(ctx context.Context, req *DeleteVolumeSnapshotOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSnapshot not implemented")
 }
-
-func RegisterControllerServer(s *grpc.Server, srv ControllerServer) {
-	s.RegisterService(&_Controller_serviceDesc, srv)
+func (*UnimplementedDriverPluginServer) InitializeSnapshotConnection(ctx context.Context, req *CreateSnapshotAttachmentOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitializeSnapshotConnection not implemented")
 }
-
-func _Controller_CreateVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateVolumeOpts)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ControllerServer).CreateVolume(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.Controller/CreateVolume",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControllerServer).CreateVolume(ctx, req.(*CreateVolumeOpts))
-	}
-	return interceptor(ctx, in, info, handler)
+func (*UnimplementedDriverPluginServer) TerminateSnapshotConnection(ctx context.Context, req *DeleteSnapshotAttachmentOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TerminateSnapshotConnection not implemented")
 }
-
-func _Controller_DeleteVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteVolumeOpts)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ControllerServer).DeleteVolume(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.Controller/DeleteVolume",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControllerServer).DeleteVolume(ctx, req.(*DeleteVolumeOpts))
-	}
-	return interceptor(ctx, in, info, handler)
+func (*UnimplementedDriverPluginServer) CreateVolumeGroup(ctx context.Context, req *CreateVolumeGroupOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVolumeGroup not implemented")
 }
-
-func _Controller_ExtendVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExtendVolumeOpts)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ControllerServer).ExtendVolume(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.Controller/ExtendVolume",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControllerServer).ExtendVolume(ctx, req.(*ExtendVolumeOpts))
-	}
-	return interceptor(ctx, in, info, handler)
+func (*UnimplementedDriverPluginServer) UpdateVolumeGroup(ctx context.Context, req *UpdateVolumeGroupOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVolumeGroup not implemented")
 }
-
-func _Controller_CreateVolumeSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateVolumeSnapshotOpts)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ControllerServer).CreateVolumeSnapshot(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.Controller/CreateVolumeSnapshot",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControllerServer).CreateVolumeSnapshot(ctx, req.(*CreateVolumeSnapshotOpts))
-	}
-	return interceptor(ctx, in, info, handler)
+func (*UnimplementedDriverPluginServer) DeleteVolumeGroup(ctx context.Context, req *DeleteVolumeGroupOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVolumeGroup not implemented")
 }
-
-func _Controller_DeleteVolumeSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteVolumeSnapshotOpts)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ControllerServer).DeleteVolumeSnapshot(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.Controller/DeleteVolumeSnapshot",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControllerServer).DeleteVolumeSnapshot(ctx, req.(*DeleteVolumeSnapshotOpts))
-	}
-	return interceptor(ctx, in, info, handler)
+func (*UnimplementedDriverPluginServer) CreateGroupSnapshot(ctx context.Context, req *CreateGroupSnapshotOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroupSnapshot not implemented")
 }
-
-func _Controller_CreateVolumeAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateVolumeAttachmentOpts)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ControllerServer).CreateVolumeAttachment(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.Controller/CreateVolumeAttachment",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControllerServer).CreateVolumeAttachment(ctx, req.(*CreateVolumeAttachmentOpts))
-	}
-	return interceptor(ctx, in, info, handler)
+func (*UnimplementedDriverPluginServer) DeleteGroupSnapshot(ctx context.Context, req *DeleteGroupSnapshotOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGroupSnapshot not implemented")
+}
+func (*UnimplementedDriverPluginServer) ListPools(ctx context.Context, req *PluginOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPools not implemented")
+}
+func (*UnimplementedDriverPluginServer) ListVolumes(ctx context.Context, req *ListVolumesOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVolumes not implemented")
+}
+func (*UnimplementedDriverPluginServer) ListSnapshots(ctx context.Context, req *ListVolumesOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSnapshots not implemented")
 }
 
-func _Controller_DeleteVolumeAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteVolumeAttachmentOpts)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ControllerServer).DeleteVolumeAttachment(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.Controller/DeleteVolumeAttachment",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControllerServer).DeleteVolumeAttachment(ctx, req.(*DeleteVolumeAttachmentOpts))
-	}
-	return interceptor(ctx, in, info, handler)
+func RegisterDriverPluginServer(s *grpc.Server, srv DriverPluginServer) {
+	s.RegisterService(&_DriverPlugin_serviceDesc, srv)
 }
 
-func _Controller_CreateReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateReplicationOpts)
+func _DriverPlugin_Handshake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandshakeOpts)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ControllerServer).CreateReplication(ctx, in)
+		return srv.(DriverPluginServer).Handshake(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.Controller/CreateReplication",
+		FullMethod: "/proto.DriverPlugin/Handshake",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControllerServer).CreateReplication(ctx, req.(*CreateReplicationOpts))
+		return srv.(DriverPluginServer).Handshake(ctx, req.(*HandshakeOpts))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Controller_DeleteReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteReplicationOpts)
+func _DriverPlugin_Setup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PluginOpts)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ControllerServer).DeleteReplication(ctx, in)
+		return srv.(DriverPluginServer).Setup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.Controller/DeleteReplication",
+		FullMethod: "/proto.DriverPlugin/Setup",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControllerServer).DeleteReplication(ctx, req.(*DeleteReplicationOpts))
+		return srv.(DriverPluginServer).Setup(ctx, req.(*PluginOpts))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Controller_EnableReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EnableReplicationOpts)
+func _DriverPlugin_Unset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PluginOpts)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ControllerServer).EnableReplication(ctx, in)
+		return srv.(DriverPluginServer).Unset(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.Controller/EnableReplication",
+		FullMethod: "/proto.DriverPlugin/Unset",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControllerServer).EnableReplication(ctx, req.(*EnableReplicationOpts))
+		return srv.(DriverPluginServer).Unset(ctx, req.(*PluginOpts))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Controller_DisableReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DisableReplicationOpts)
+func _DriverPlugin_CreateVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVolumeOpts)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ControllerServer).DisableReplication(ctx, in)
+		return srv.(DriverPluginServer).CreateVolume(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.Controller/DisableReplication",
+		FullMethod: "/proto.DriverPlugin/CreateVolume",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControllerServer).DisableReplication(ctx, req.(*DisableReplicationOpts))
+		return srv.(DriverPluginServer).CreateVolume(ctx, req.(*CreateVolumeOpts))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Controller_FailoverReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FailoverReplicationOpts)
+func _DriverPlugin_CloneVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVolumeOpts)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ControllerServer).FailoverReplication(ctx, in)
+		return srv.(DriverPluginServer).CloneVolume(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.Controller/FailoverReplication",
+		FullMethod: "/proto.DriverPlugin/CloneVolume",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControllerServer).FailoverReplication(ctx, req.(*FailoverReplicationOpts))
+		return srv.(DriverPluginServer).CloneVolume(ctx, req.(*CreateVolumeOpts))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Controller_CreateVolumeGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateVolumeGroupOpts)
+func _DriverPlugin_PullVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PullVolumeOpts)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ControllerServer).CreateVolumeGroup(ctx, in)
+		return srv.(DriverPluginServer).PullVolume(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.Controller/CreateVolumeGroup",
+		FullMethod: "/proto.DriverPlugin/PullVolume",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControllerServer).CreateVolumeGroup(ctx, req.(*CreateVolumeGroupOpts))
+		return srv.(DriverPluginServer).PullVolume(ctx, req.(*PullVolumeOpts))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Controller_UpdateVolumeGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateVolumeGroupOpts)
+func _DriverPlugin_DeleteVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVolumeOpts)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ControllerServer).UpdateVolumeGroup(ctx, in)
+		return srv.(DriverPluginServer).DeleteVolume(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.Controller/UpdateVolumeGroup",
+		FullMethod: "/proto.DriverPlugin/DeleteVolume",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControllerServer).UpdateVolumeGroup(ctx, req.(*UpdateVolumeGroupOpts))
+		return srv.(DriverPluginServer).DeleteVolume(ctx, req.(*DeleteVolumeOpts))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Controller_DeleteVolumeGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteVolumeGroupOpts)
+func _DriverPlugin_ExtendVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtendVolumeOpts)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ControllerServer).DeleteVolumeGroup(ctx, in)
+		return srv.(DriverPluginServer).ExtendVolume(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.Controller/DeleteVolumeGroup",
+		FullMethod: "/proto.DriverPlugin/ExtendVolume",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControllerServer).DeleteVolumeGroup(ctx, req.(*DeleteVolumeGroupOpts))
+		return srv.(DriverPluginServer).ExtendVolume(ctx, req.(*ExtendVolumeOpts))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Controller_GetMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMetricsOpts)
+func _DriverPlugin_MigrateVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MigrateVolumeOpts)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ControllerServer).GetMetrics(ctx, in)
+		return srv.(DriverPluginServer).MigrateVolume(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.Controller/GetMetrics",
+		FullMethod: "/proto.DriverPlugin/MigrateVolume",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControllerServer).GetMetrics(ctx, req.(*GetMetricsOpts))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-var _Controller_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "proto.Controller",
-	HandlerType: (*ControllerServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateVolume",
-			Handler:    _Controller_CreateVolume_Handler,
-		},
-		{
-			MethodName: "DeleteVolume",
-			Handler:    _Controller_DeleteVolume_Handler,
-		},
-		{
-			MethodName: "ExtendVolume",
-			Handler:    _Controller_ExtendVolume_Handler,
-		},
-		{
-			MethodName: "CreateVolumeSnapshot",
-			Handler:    _Controller_CreateVolumeSnapshot_Handler,
-		},
-		{
-			MethodName: "DeleteVolumeSnapshot",
-			Handler:    _Controller_DeleteVolumeSnapshot_Handler,
-		},
-		{
-			MethodName: "CreateVolumeAttachment",
-			Handler:    _Controller_CreateVolumeAttachment_Handler,
-		},
-		{
-			MethodName: "DeleteVolumeAttachment",
-			Handler:    _Controller_DeleteVolumeAttachment_Handler,
-		},
-		{
-			MethodName: "CreateReplication",
-			Handler:    _Controller_CreateReplication_Handler,
-		},
-		{
-			MethodName: "DeleteReplication",
-			Handler:    _Controller_DeleteReplication_Handler,
-		},
-		{
-			MethodName: "EnableReplication",
-			Handler:    _Controller_EnableReplication_Handler,
-		},
-		{
-			MethodName: "DisableReplication",
-			Handler:    _Controller_DisableReplication_Handler,
-		},
-		{
-			MethodName: "FailoverReplication",
-			Handler:    _Controller_FailoverReplication_Handler,
-		},
-		{
-			MethodName: "CreateVolumeGroup",
-			Handler:    _Controller_CreateVolumeGroup_Handler,
-		},
-		{
-			MethodName: "UpdateVolumeGroup",
-			Handler:    _Controller_UpdateVolumeGroup_Handler,
-		},
-		{
-			MethodName: "DeleteVolumeGroup",
-			Handler:    _Controller_DeleteVolumeGroup_Handler,
-		},
-		{
-			MethodName: "GetMetrics",
-			Handler:    _Controller_GetMetrics_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "model.proto",
-}
-
-// ProvisionDockClient is the client API for ProvisionDock service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ProvisionDockClient interface {
-	// Create a volume
-	CreateVolume(ctx context.Context, in *CreateVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error)
-	// Delete a volume
-	DeleteVolume(ctx context.Context, in *DeleteVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error)
-	// Extend a volume
-	ExtendVolume(ctx context.Context, in *ExtendVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error)
-	// Create a volume snapshot
-	CreateVolumeSnapshot(ctx context.Context, in *CreateVolumeSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error)
-	// Delete a volume snapshot
-	DeleteVolumeSnapshot(ctx context.Context, in *DeleteVolumeSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error)
-	// Create a volume attachment
-	CreateVolumeAttachment(ctx context.Context, in *CreateVolumeAttachmentOpts, opts ...grpc.CallOption) (*GenericResponse, error)
-	// Delete a volume attachment
-	DeleteVolumeAttachment(ctx context.Context, in *DeleteVolumeAttachmentOpts, opts ...grpc.CallOption) (*GenericResponse, error)
-	// Create a replication
-	CreateReplication(ctx context.Context, in *CreateReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error)
-	// Delete a replication
-	DeleteReplication(ctx context.Context, in *DeleteReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error)
-	// Enable a replication
-	EnableReplication(ctx context.Context, in *EnableReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error)
-	// Disable a replication
-	DisableReplication(ctx context.Context, in *DisableReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error)
-	// Failover a replication
-	FailoverReplication(ctx context.Context, in *FailoverReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error)
-	// Create a volume group
-	CreateVolumeGroup(ctx context.Context, in *CreateVolumeGroupOpts, opts ...grpc.CallOption) (*GenericResponse, error)
-	// Update volume group
-	UpdateVolumeGroup(ctx context.Context, in *UpdateVolumeGroupOpts, opts ...grpc.CallOption) (*GenericResponse, error)
-	// Delete volume group
-	DeleteVolumeGroup(ctx context.Context, in *DeleteVolumeGroupOpts, opts ...grpc.CallOption) (*GenericResponse, error)
-}
-
-type provisionDockClient struct {
-	cc *grpc.ClientConn
-}
-
-func NewProvisionDockClient(cc *grpc.ClientConn) ProvisionDockClient {
-	return &provisionDockClient{cc}
-}
-
-func (c *provisionDockClient) CreateVolume(ctx context.Context, in *CreateVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
-	out := new(GenericResponse)
-	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/CreateVolume", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *provisionDockClient) DeleteVolume(ctx context.Context, in *DeleteVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
-	out := new(GenericResponse)
-	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/DeleteVolume", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *provisionDockClient) ExtendVolume(ctx context.Context, in *ExtendVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
-	out := new(GenericResponse)
-	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/ExtendVolume", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *provisionDockClient) CreateVolumeSnapshot(ctx context.Context, in *CreateVolumeSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
-	out := new(GenericResponse)
-	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/CreateVolumeSnapshot", in, out, opts...)
-	if err != nil {
-		return nil, err
+		return srv.(DriverPluginServer).MigrateVolume(ctx, req.(*MigrateVolumeOpts))
 	}
-	return out, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *provisionDockClient) DeleteVolumeSnapshot(ctx context.Context, in *DeleteVolumeSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
-	out := new(GenericResponse)
-	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/DeleteVolumeSnapshot", in, out, opts...)
-	if err != nil {
+func _DriverPlugin_RevertVolumeToSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertVolumeToSnapshotOpts)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(DriverPluginServer).RevertVolumeToSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DriverPlugin/RevertVolumeToSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverPluginServer).RevertVolumeToSnapshot(ctx, req.(*RevertVolumeToSnapshotOpts))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *provisionDockClient) CreateVolumeAttachment(ctx context.Context, in *CreateVolumeAttachmentOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
-	out := new(GenericResponse)
-	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/CreateVolumeAttachment", in, out, opts...)
-	if err != nil {
+func _DriverPlugin_ManageVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ManageVolumeOpts)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(DriverPluginServer).ManageVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DriverPlugin/ManageVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverPluginServer).ManageVolume(ctx, req.(*ManageVolumeOpts))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *provisionDockClient) DeleteVolumeAttachment(ctx context.Context, in *DeleteVolumeAttachmentOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
-	out := new(GenericResponse)
-	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/DeleteVolumeAttachment", in, out, opts...)
-	if err != nil {
+func _DriverPlugin_UnmanageVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnmanageVolumeOpts)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(DriverPluginServer).UnmanageVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DriverPlugin/UnmanageVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverPluginServer).UnmanageVolume(ctx, req.(*UnmanageVolumeOpts))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *provisionDockClient) CreateReplication(ctx context.Context, in *CreateReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
-	out := new(GenericResponse)
-	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/CreateReplication", in, out, opts...)
-	if err != nil {
+func _DriverPlugin_InitializeConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVolumeAttachmentOpts)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(DriverPluginServer).InitializeConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DriverPlugin/InitializeConnection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverPluginServer).InitializeConnection(ctx, req.(*CreateVolumeAttachmentOpts))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *provisionDockClient) DeleteReplication(ctx context.Context, in *DeleteReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
-	out := new(GenericResponse)
-	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/DeleteReplication", in, out, opts...)
-	if err != nil {
+func _DriverPlugin_TerminateConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVolumeAttachmentOpts)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(DriverPluginServer).TerminateConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DriverPlugin/TerminateConnection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverPluginServer).TerminateConnection(ctx, req.(*DeleteVolumeAttachmentOpts))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *provisionDockClient) EnableReplication(ctx context.Context, in *EnableReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
-	out := new(GenericResponse)
-	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/EnableReplication", in, out, opts...)
-	if err != nil {
+func _DriverPlugin_CreateSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVolumeSnapshotOpts)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(DriverPluginServer).CreateSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DriverPlugin/CreateSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverPluginServer).CreateSnapshot(ctx, req.(*CreateVolumeSnapshotOpts))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *provisionDockClient) DisableReplication(ctx context.Context, in *DisableReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
-	out := new(GenericResponse)
-	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/DisableReplication", in, out, opts...)
-	if err != nil {
+func _DriverPlugin_PullSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PullVolumeSnapshotOpts)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(DriverPluginServer).PullSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DriverPlugin/PullSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverPluginServer).PullSnapshot(ctx, req.(*PullVolumeSnapshotOpts))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *provisionDockClient) FailoverReplication(ctx context.Context, in *FailoverReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
-	out := new(GenericResponse)
-	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/FailoverReplication", in, out, opts...)
-	if err != nil {
+func _DriverPlugin_ManageSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ManageVolumeSnapshotOpts)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(DriverPluginServer).ManageSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DriverPlugin/ManageSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverPluginServer).ManageSnapshot(ctx, req.(*ManageVolumeSnapshotOpts))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *provisionDockClient) CreateVolumeGroup(ctx context.Context, in *CreateVolumeGroupOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
-	out := new(GenericResponse)
-	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/CreateVolumeGroup", in, out, opts...)
-	if err != nil {
+func _DriverPlugin_UnmanageSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnmanageVolumeSnapshotOpts)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(DriverPluginServer).UnmanageSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DriverPlugin/UnmanageSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverPluginServer).UnmanageSnapshot(ctx, req.(*UnmanageVolumeSnapshotOpts))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *provisionDockClient) UpdateVolumeGroup(ctx context.Context, in *UpdateVolumeGroupOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
-	out := new(GenericResponse)
-	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/UpdateVolumeGroup", in, out, opts...)
-	if err != nil {
+func _DriverPlugin_DeleteSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVolumeSnapshotOpts)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(DriverPluginServer).DeleteSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DriverPlugin/DeleteSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverPluginServer).DeleteSnapshot(ctx, req.(*DeleteVolumeSnapshotOpts))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *provisionDockClient) DeleteVolumeGroup(ctx context.Context, in *DeleteVolumeGroupOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
-	out := new(GenericResponse)
-	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/DeleteVolumeGroup", in, out, opts...)
-	if err != nil {
+func _DriverPlugin_InitializeSnapshotConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSnapshotAttachmentOpts)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// ProvisionDockServer is the server API for ProvisionDock service.
-type ProvisionDockServer interface {
-	// Create a volume
-	CreateVolume(context.Context, *CreateVolumeOpts) (*GenericResponse, error)
-	// Delete a volume
-	DeleteVolume(context.Context, *DeleteVolumeOpts) (*GenericResponse, error)
-	// Extend a volume
-	ExtendVolume(context.Context, *ExtendVolumeOpts) (*GenericResponse, error)
-	// Create a volume snapshot
-	CreateVolumeSnapshot(context.Context, *CreateVolumeSnapshotOpts) (*GenericResponse, error)
-	// Delete a volume snapshot
-	DeleteVolumeSnapshot(context.Context, *DeleteVolumeSnapshotOpts) (*GenericResponse, error)
-	// Create a volume attachment
-	CreateVolumeAttachment(context.Context, *CreateVolumeAttachmentOpts) (*GenericResponse, error)
-	// Delete a volume attachment
-	DeleteVolumeAttachment(context.Context, *DeleteVolumeAttachmentOpts) (*GenericResponse, error)
-	// Create a replication
-	CreateReplication(context.Context, *CreateReplicationOpts) (*GenericResponse, error)
-	// Delete a replication
-	DeleteReplication(context.Context, *DeleteReplicationOpts) (*GenericResponse, error)
-	// Enable a replication
-	EnableReplication(context.Context, *EnableReplicationOpts) (*GenericResponse, error)
-	// Disable a replication
-	DisableReplication(context.Context, *DisableReplicationOpts) (*GenericResponse, error)
-	// Failover a replication
-	FailoverReplication(context.Context, *FailoverReplicationOpts) (*GenericResponse, error)
-	// Create a volume group
-	CreateVolumeGroup(context.Context, *CreateVolumeGroupOpts) (*GenericResponse, error)
-	// Update volume group
-	UpdateVolumeGroup(context.Context, *UpdateVolumeGroupOpts) (*GenericResponse, error)
-	// Delete volume group
-	DeleteVolumeGroup(context.Context, *DeleteVolumeGroupOpts) (*GenericResponse, error)
-}
-
-// UnimplementedProvisionDockServer can be embedded to have forward compatible implementations.
-type UnimplementedProvisionDockServer struct {
-}
-
-func (*UnimplementedProvisionDockServer) CreateVolume(ctx context.Context, req *CreateVolumeOpts) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateVolume not implemented")
-}
-func (*UnimplementedProvisionDockServer) DeleteVolume(ctx context.Context, req *DeleteVolumeOpts) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteVolume not implemented")
-}
-func (*UnimplementedProvisionDockServer) ExtendVolume(ctx context.Context, req *ExtendVolumeOpts) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExtendVolume not implemented")
-}
-func (*UnimplementedProvisionDockServer) CreateVolumeSnapshot(ctx context.Context, req *CreateVolumeSnapshotOpts) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateVolumeSnapshot not implemented")
-}
-func (*UnimplementedProvisionDockServer) DeleteVolumeSnapshot(ctx context.Context, req *DeleteVolumeSnapshotOpts) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteVolumeSnapshot not implemented")
-}
-func (*UnimplementedProvisionDockServer) CreateVolumeAttachment(ctx context.Context, req *CreateVolumeAttachmentOpts) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateVolumeAttachment not implemented")
-}
-func (*UnimplementedProvisionDockServer) DeleteVolumeAttachment(ctx context.Context, req *DeleteVolumeAttachmentOpts) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteVolumeAttachment not implemented")
-}
-func (*UnimplementedProvisionDockServer) CreateReplication(ctx context.Context, req *CreateReplicationOpts) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateReplication not implemented")
-}
-func (*UnimplementedProvisionDockServer) DeleteReplication(ctx context.Context, req *DeleteReplicationOpts) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteReplication not implemented")
-}
-func (*UnimplementedProvisionDockServer) EnableReplication(ctx context.Context, req *EnableReplicationOpts) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EnableReplication not implemented")
-}
-func (*UnimplementedProvisionDockServer) DisableReplication(ctx context.Context, req *DisableReplicationOpts) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DisableReplication not implemented")
-}
-func (*UnimplementedProvisionDockServer) FailoverReplication(ctx context.Context, req *FailoverReplicationOpts) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FailoverReplication not implemented")
-}
-func (*UnimplementedProvisionDockServer) CreateVolumeGroup(ctx context.Context, req *CreateVolumeGroupOpts) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateVolumeGroup not implemented")
-}
-func (*UnimplementedProvisionDockServer) UpdateVolumeGroup(ctx context.Context, req *UpdateVolumeGroupOpts) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateVolumeGroup not implemented")
-}
-func (*UnimplementedProvisionDockServer) DeleteVolumeGroup(ctx context.Context, req *DeleteVolumeGroupOpts) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteVolumeGroup not implemented")
-}
-
-func RegisterProvisionDockServer(s *grpc.Server, srv ProvisionDockServer) {
-	s.RegisterService(&_ProvisionDock_serviceDesc, srv)
+	if interceptor == nil {
+		return srv.(DriverPluginServer).InitializeSnapshotConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DriverPlugin/InitializeSnapshotConnection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverPluginServer).InitializeSnapshotConnection(ctx, req.(*CreateSnapshotAttachmentOpts))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _ProvisionDock_CreateVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateVolumeOpts)
+func _DriverPlugin_TerminateSnapshotConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSnapshotAttachmentOpts)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProvisionDockServer).CreateVolume(ctx, in)
+		return srv.(DriverPluginServer).TerminateSnapshotConnection(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.ProvisionDock/CreateVolume",
+		FullMethod: "/proto.DriverPlugin/TerminateSnapshotConnection",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProvisionDockServer).CreateVolume(ctx, req.(*CreateVolumeOpts))
+		return srv.(DriverPluginServer).TerminateSnapshotConnection(ctx, req.(*DeleteSnapshotAttachmentOpts))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProvisionDock_DeleteVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteVolumeOpts)
+func _DriverPlugin_CreateVolumeGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVolumeGroupOpts)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProvisionDockServer).DeleteVolume(ctx, in)
+		return srv.(DriverPluginServer).CreateVolumeGroup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.ProvisionDock/DeleteVolume",
+		FullMethod: "/proto.DriverPlugin/CreateVolumeGroup",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProvisionDockServer).DeleteVolume(ctx, req.(*DeleteVolumeOpts))
+		return srv.(DriverPluginServer).CreateVolumeGroup(ctx, req.(*CreateVolumeGroupOpts))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProvisionDock_ExtendVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExtendVolumeOpts)
+func _DriverPlugin_UpdateVolumeGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVolumeGroupOpts)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProvisionDockServer).ExtendVolume(ctx, in)
+		return srv.(DriverPluginServer).UpdateVolumeGroup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.ProvisionDock/ExtendVolume",
+		FullMethod: "/proto.DriverPlugin/UpdateVolumeGroup",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProvisionDockServer).ExtendVolume(ctx, req.(*ExtendVolumeOpts))
+		return srv.(DriverPluginServer).UpdateVolumeGroup(ctx, req.(*UpdateVolumeGroupOpts))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProvisionDock_CreateVolumeSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateVolumeSnapshotOpts)
+func _DriverPlugin_DeleteVolumeGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVolumeGroupOpts)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProvisionDockServer).CreateVolumeSnapshot(ctx, in)
+		return srv.(DriverPluginServer).DeleteVolumeGroup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.ProvisionDock/CreateVolumeSnapshot",
+		FullMethod: "/proto.DriverPlugin/DeleteVolumeGroup",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProvisionDockServer).CreateVolumeSnapshot(ctx, req.(*CreateVolumeSnapshotOpts))
+		return srv.(DriverPluginServer).DeleteVolumeGroup(ctx, req.(*DeleteVolumeGroupOpts))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProvisionDock_DeleteVolumeSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteVolumeSnapshotOpts)
+func _DriverPlugin_CreateGroupSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupSnapshotOpts)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProvisionDockServer).DeleteVolumeSnapshot(ctx, in)
+		return srv.(DriverPluginServer).CreateGroupSnapshot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.ProvisionDock/DeleteVolumeSnapshot",
+		FullMethod: "/proto.DriverPlugin/CreateGroupSnapshot",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProvisionDockServer).DeleteVolumeSnapshot(ctx, req.(*DeleteVolumeSnapshotOpts))
+		return srv.(DriverPluginServer).CreateGroupSnapshot(ctx, req.(*CreateGroupSnapshotOpts))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProvisionDock_CreateVolumeAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateVolumeAttachmentOpts)
+func _DriverPlugin_DeleteGroupSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGroupSnapshotOpts)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProvisionDockServer).CreateVolumeAttachment(ctx, in)
+		return srv.(DriverPluginServer).DeleteGroupSnapshot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.ProvisionDock/CreateVolumeAttachment",
+		FullMethod: "/proto.DriverPlugin/DeleteGroupSnapshot",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProvisionDockServer).CreateVolumeAttachment(ctx, req.(*CreateVolumeAttachmentOpts))
+		return srv.(DriverPluginServer).DeleteGroupSnapshot(ctx, req.(*DeleteGroupSnapshotOpts))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProvisionDock_DeleteVolumeAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteVolumeAttachmentOpts)
+func _DriverPlugin_ListPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PluginOpts)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProvisionDockServer).DeleteVolumeAttachment(ctx, in)
+		return srv.(DriverPluginServer).ListPools(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.ProvisionDock/DeleteVolumeAttachment",
+		FullMethod: "/proto.DriverPlugin/ListPools",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProvisionDockServer).DeleteVolumeAttachment(ctx, req.(*DeleteVolumeAttachmentOpts))
+		return srv.(DriverPluginServer).ListPools(ctx, req.(*PluginOpts))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProvisionDock_CreateReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateReplicationOpts)
+func _DriverPlugin_ListVolumes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVolumesOpts)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProvisionDockServer).CreateReplication(ctx, in)
+		return srv.(DriverPluginServer).ListVolumes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.ProvisionDock/CreateReplication",
+		FullMethod: "/proto.DriverPlugin/ListVolumes",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProvisionDockServer).CreateReplication(ctx, req.(*CreateReplicationOpts))
+		return srv.(DriverPluginServer).ListVolumes(ctx, req.(*ListVolumesOpts))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProvisionDock_DeleteReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteReplicationOpts)
+func _DriverPlugin_ListSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVolumesOpts)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProvisionDockServer).DeleteReplication(ctx, in)
+		return srv.(DriverPluginServer).ListSnapshots(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.ProvisionDock/DeleteReplication",
+		FullMethod: "/proto.DriverPlugin/ListSnapshots",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProvisionDockServer).DeleteReplication(ctx, req.(*DeleteReplicationOpts))
+		return srv.(DriverPluginServer).ListSnapshots(ctx, req.(*ListVolumesOpts))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProvisionDock_EnableReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EnableReplicationOpts)
+var _DriverPlugin_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.DriverPlugin",
+	HandlerType: (*DriverPluginServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Handshake",
+			Handler:    _DriverPlugin_Handshake_Handler,
+		},
+		{
+			MethodName: "Setup",
+			Handler:    _DriverPlugin_Setup_Handler,
+		},
+		{
+			MethodName: "Unset",
+			Handler:    _DriverPlugin_Unset_Handler,
+		},
+		{
+			MethodName: "CreateVolume",
+			Handler:    _DriverPlugin_CreateVolume_Handler,
+		},
+		{
+			MethodName: "CloneVolume",
+			Handler:    _DriverPlugin_CloneVolume_Handler,
+		},
+		{
+			MethodName: "PullVolume",
+			Handler:    _DriverPlugin_PullVolume_Handler,
+		},
+		{
+			MethodName: "DeleteVolume",
+			Handler:    _DriverPlugin_DeleteVolume_Handler,
+		},
+		{
+			MethodName: "ExtendVolume",
+			Handler:    _DriverPlugin_ExtendVolume_Handler,
+		},
+		{
+			MethodName: "MigrateVolume",
+			Handler:    _DriverPlugin_MigrateVolume_Handler,
+		},
+		{
+			MethodName: "RevertVolumeToSnapshot",
+			Handler:    _DriverPlugin_RevertVolumeToSnapshot_Handler,
+		},
+		{
+			MethodName: "ManageVolume",
+			Handler:    _DriverPlugin_ManageVolume_Handler,
+		},
+		{
+			MethodName: "UnmanageVolume",
+			Handler:    _DriverPlugin_UnmanageVolume_Handler,
+		},
+		{
+			MethodName: "InitializeConnection",
+			Handler:    _DriverPlugin_InitializeConnection_Handler,
+		},
+		{
+			MethodName: "TerminateConnection",
+			Handler:    _DriverPlugin_TerminateConnection_Handler,
+		},
+		{
+			MethodName: "CreateSnapshot",
+			Handler:    _DriverPlugin_CreateSnapshot_Handler,
+		},
+		{
+			MethodName: "PullSnapshot",
+			Handler:    _DriverPlugin_PullSnapshot_Handler,
+		},
+		{
+			MethodName: "ManageSnapshot",
+			Handler:    _DriverPlugin_ManageSnapshot_Handler,
+		},
+		{
+			MethodName: "UnmanageSnapshot",
+			Handler:    _DriverPlugin_UnmanageSnapshot_Handler,
+		},
+		{
+			MethodName: "DeleteSnapshot",
+			Handler:    _DriverPlugin_DeleteSnapshot_Handler,
+		},
+		{
+			MethodName: "InitializeSnapshotConnection",
+			Handler:    _DriverPlugin_InitializeSnapshotConnection_Handler,
+		},
+		{
+			MethodName: "TerminateSnapshotConnection",
+			Handler:    _DriverPlugin_TerminateSnapshotConnection_Handler,
+		},
+		{
+			MethodName: "CreateVolumeGroup",
+			Handler:    _DriverPlugin_CreateVolumeGroup_Handler,
+		},
+		{
+			MethodName: "UpdateVolumeGroup",
+			Handler:    _DriverPlugin_UpdateVolumeGroup_Handler,
+		},
+		{
+			MethodName: "DeleteVolumeGroup",
+			Handler:    _DriverPlugin_DeleteVolumeGroup_Handler,
+		},
+		{
+			MethodName: "CreateGroupSnapshot",
+			Handler:    _DriverPlugin_CreateGroupSnapshot_Handler,
+		},
+		{
+			MethodName: "DeleteGroupSnapshot",
+			Handler:    _DriverPlugin_DeleteGroupSnapshot_Handler,
+		},
+		{
+			MethodName: "ListPools",
+			Handler:    _DriverPlugin_ListPools_Handler,
+		},
+		{
+			MethodName: "ListVolumes",
+			Handler:    _DriverPlugin_ListVolumes_Handler,
+		},
+		{
+			MethodName: "ListSnapshots",
+			Handler:    _DriverPlugin_ListSnapshots_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "model.proto",
+}
+
+// ReplicationDriverPluginClient is the client API for ReplicationDriverPlugin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ReplicationDriverPluginClient interface {
+	Setup(ctx context.Context, in *PluginOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	Unset(ctx context.Context, in *PluginOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	CreateReplication(ctx context.Context, in *CreateReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	DeleteReplication(ctx context.Context, in *DeleteReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	EnableReplication(ctx context.Context, in *EnableReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	DisableReplication(ctx context.Context, in *DisableReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	FailoverReplication(ctx context.Context, in *FailoverReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+}
+
+type replicationDriverPluginClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewReplicationDriverPluginClient(cc *grpc.ClientConn) ReplicationDriverPluginClient {
+	return &replicationDriverPluginClient{cc}
+}
+
+func (c *replicationDriverPluginClient) Setup(ctx context.Context, in *PluginOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.ReplicationDriverPlugin/Setup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *replicationDriverPluginClient) Unset(ctx context.Context, in *PluginOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.ReplicationDriverPlugin/Unset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *replicationDriverPluginClient) CreateReplication(ctx context.Context, in *CreateReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.ReplicationDriverPlugin/CreateReplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *replicationDriverPluginClient) DeleteReplication(ctx context.Context, in *DeleteReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.ReplicationDriverPlugin/DeleteReplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *replicationDriverPluginClient) EnableReplication(ctx context.Context, in *EnableReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.ReplicationDriverPlugin/EnableReplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *replicationDriverPluginClient) DisableReplication(ctx context.Context, in *DisableReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.ReplicationDriverPlugin/DisableReplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *replicationDriverPluginClient) FailoverReplication(ctx context.Context, in *FailoverReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.ReplicationDriverPlugin/FailoverReplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReplicationDriverPluginServer is the server API for ReplicationDriverPlugin service.
+type ReplicationDriverPluginServer interface {
+	Setup(context.Context, *PluginOpts) (*GenericResponse, error)
+	Unset(context.Context, *PluginOpts) (*GenericResponse, error)
+	CreateReplication(context.Context, *CreateReplicationOpts) (*GenericResponse, error)
+	DeleteReplication(context.Context, *DeleteReplicationOpts) (*GenericResponse, error)
+	EnableReplication(context.Context, *EnableReplicationOpts) (*GenericResponse, error)
+	DisableReplication(context.Context, *DisableReplicationOpts) (*GenericResponse, error)
+	FailoverReplication(context.Context, *FailoverReplicationOpts) (*GenericResponse, error)
+}
+
+// UnimplementedReplicationDriverPluginServer can be embedded to have forward compatible implementations.
+type UnimplementedReplicationDriverPluginServer struct {
+}
+
+func (*UnimplementedReplicationDriverPluginServer) Setup(ctx context.Context, req *PluginOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Setup not implemented")
+}
+func (*UnimplementedReplicationDriverPluginServer) Unset(ctx context.Context, req *PluginOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unset not implemented")
+}
+func (*UnimplementedReplicationDriverPluginServer) CreateReplication(ctx context.Context, req *CreateReplicationOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReplication not implemented")
+}
+func (*UnimplementedReplicationDriverPluginServer) DeleteReplication(ctx context.Context, req *DeleteReplicationOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReplication not implemented")
+}
+func (*UnimplementedReplicationDriverPluginServer) EnableReplication(ctx context.Context, req *EnableReplicationOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableReplication not implemented")
+}
+func (*UnimplementedReplicationDriverPluginServer) DisableReplication(ctx context.Context, req *DisableReplicationOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableReplication not implemented")
+}
+func (*UnimplementedReplicationDriverPluginServer) FailoverReplication(ctx context.Context, req *FailoverReplicationOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FailoverReplication not implemented")
+}
+
+func RegisterReplicationDriverPluginServer(s *grpc.Server, srv ReplicationDriverPluginServer) {
+	s.RegisterService(&_ReplicationDriverPlugin_serviceDesc, srv)
+}
+
+func _ReplicationDriverPlugin_Setup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PluginOpts)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProvisionDockServer).EnableReplication(ctx, in)
+		return srv.(ReplicationDriverPluginServer).Setup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.ProvisionDock/EnableReplication",
+		FullMethod: "/proto.ReplicationDriverPlugin/Setup",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProvisionDockServer).EnableReplication(ctx, req.(*EnableReplicationOpts))
+		return srv.(ReplicationDriverPluginServer).Setup(ctx, req.(*PluginOpts))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProvisionDock_DisableReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DisableReplicationOpts)
+func _ReplicationDriverPlugin_Unset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PluginOpts)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProvisionDockServer).DisableReplication(ctx, in)
+		return srv.(ReplicationDriverPluginServer).Unset(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.ProvisionDock/DisableReplication",
+		FullMethod: "/proto.ReplicationDriverPlugin/Unset",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProvisionDockServer).DisableReplication(ctx, req.(*DisableReplicationOpts))
+		return srv.(ReplicationDriverPluginServer).Unset(ctx, req.(*PluginOpts))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProvisionDock_FailoverReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FailoverReplicationOpts)
+func _ReplicationDriverPlugin_CreateReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReplicationOpts)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProvisionDockServer).FailoverReplication(ctx, in)
+		return srv.(ReplicationDriverPluginServer).CreateReplication(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.ProvisionDock/FailoverReplication",
+		FullMethod: "/proto.ReplicationDriverPlugin/CreateReplication",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProvisionDockServer).FailoverReplication(ctx, req.(*FailoverReplicationOpts))
+		return srv.(ReplicationDriverPluginServer).CreateReplication(ctx, req.(*CreateReplicationOpts))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProvisionDock_CreateVolumeGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateVolumeGroupOpts)
+func _ReplicationDriverPlugin_DeleteReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReplicationOpts)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProvisionDockServer).CreateVolumeGroup(ctx, in)
+		return srv.(ReplicationDriverPluginServer).DeleteReplication(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.ProvisionDock/CreateVolumeGroup",
+		FullMethod: "/proto.ReplicationDriverPlugin/DeleteReplication",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProvisionDockServer).CreateVolumeGroup(ctx, req.(*CreateVolumeGroupOpts))
+		return srv.(ReplicationDriverPluginServer).DeleteReplication(ctx, req.(*DeleteReplicationOpts))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProvisionDock_UpdateVolumeGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateVolumeGroupOpts)
+func _ReplicationDriverPlugin_EnableReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableReplicationOpts)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProvisionDockServer).UpdateVolumeGroup(ctx, in)
+		return srv.(ReplicationDriverPluginServer).EnableReplication(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.ProvisionDock/UpdateVolumeGroup",
+		FullMethod: "/proto.ReplicationDriverPlugin/EnableReplication",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProvisionDockServer).UpdateVolumeGroup(ctx, req.(*UpdateVolumeGroupOpts))
+		return srv.(ReplicationDriverPluginServer).EnableReplication(ctx, req.(*EnableReplicationOpts))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProvisionDock_DeleteVolumeGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteVolumeGroupOpts)
+func _ReplicationDriverPlugin_DisableReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableReplicationOpts)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProvisionDockServer).DeleteVolumeGroup(ctx, in)
+		return srv.(ReplicationDriverPluginServer).DisableReplication(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.ProvisionDock/DeleteVolumeGroup",
+		FullMethod: "/proto.ReplicationDriverPlugin/DisableReplication",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProvisionDockServer).DeleteVolumeGroup(ctx, req.(*DeleteVolumeGroupOpts))
+		return srv.(ReplicationDriverPluginServer).DisableReplication(ctx, req.(*DisableReplicationOpts))
 	}
 	return interceptor(ctx, in, info, handler)
 }
-
-var _ProvisionDock_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "proto.ProvisionDock",
-	HandlerType: (*ProvisionDockServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateVolume",
-			Handler:    _ProvisionDock_CreateVolume_Handler,
-		},
-		{
-			MethodName: "DeleteVolume",
-			Handler:    _ProvisionDock_DeleteVolume_Handler,
-		},
-		{
-			MethodName: "ExtendVolume",
-			Handler:    _ProvisionDock_ExtendVolume_Handler,
-		},
-		{
-			MethodName: "CreateVolumeSnapshot",
-			Handler:    _ProvisionDock_CreateVolumeSnapshot_Handler,
-		},
-		{
-			MethodName: "DeleteVolumeSnapshot",
-			Handler:    _ProvisionDock_DeleteVolumeSnapshot_Handler,
-		},
+
+func _ReplicationDriverPlugin_FailoverReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FailoverReplicationOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReplicationDriverPluginServer).FailoverReplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ReplicationDriverPlugin/FailoverReplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReplicationDriverPluginServer).FailoverReplication(ctx, req.(*FailoverReplicationOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ReplicationDriverPlugin_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.ReplicationDriverPlugin",
+	HandlerType: (*ReplicationDriverPluginServer)(nil),
+	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "CreateVolumeAttachment",
-			Handler:    _ProvisionDock_CreateVolumeAttachment_Handler,
+			MethodName: "Setup",
+			Handler:    _ReplicationDriverPlugin_Setup_Handler,
 		},
 		{
-			MethodName: "DeleteVolumeAttachment",
-			Handler:    _ProvisionDock_DeleteVolumeAttachment_Handler,
+			MethodName: "Unset",
+			Handler:    _ReplicationDriverPlugin_Unset_Handler,
 		},
 		{
 			MethodName: "CreateReplication",
-			Handler:    _ProvisionDock_CreateReplication_Handler,
+			Handler:    _ReplicationDriverPlugin_CreateReplication_Handler,
 		},
 		{
 			MethodName: "DeleteReplication",
-			Handler:    _ProvisionDock_DeleteReplication_Handler,
+			Handler:    _ReplicationDriverPlugin_DeleteReplication_Handler,
 		},
 		{
 			MethodName: "EnableReplication",
-			Handler:    _ProvisionDock_EnableReplication_Handler,
+			Handler:    _ReplicationDriverPlugin_EnableReplication_Handler,
 		},
 		{
 			MethodName: "DisableReplication",
-			Handler:    _ProvisionDock_DisableReplication_Handler,
+			Handler:    _ReplicationDriverPlugin_DisableReplication_Handler,
 		},
 		{
 			MethodName: "FailoverReplication",
-			Handler:    _ProvisionDock_FailoverReplication_Handler,
-		},
-		{
-			MethodName: "CreateVolumeGroup",
-			Handler:    _ProvisionDock_CreateVolumeGroup_Handler,
-		},
-		{
-			MethodName: "UpdateVolumeGroup",
-			Handler:    _ProvisionDock_UpdateVolumeGroup_Handler,
-		},
-		{
-			MethodName: "DeleteVolumeGroup",
-			Handler:    _ProvisionDock_DeleteVolumeGroup_Handler,
+			Handler:    _ReplicationDriverPlugin_FailoverReplication_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "model.proto",
 }
 
-// FileShareControllerClient is the client API for FileShareController service.
+// MetricDriverPluginClient is the client API for MetricDriverPlugin service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type FileShareControllerClient interface {
-	// Create a file share
-	CreateFileShare(ctx context.Context, in *CreateFileShareOpts, opts ...grpc.CallOption) (*GenericResponse, error)
-	// Delete a file share
-	DeleteFileShare(ctx context.Context, in *DeleteFileShareOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+type MetricDriverPluginClient interface {
+	Setup(ctx context.Context, in *PluginOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	Teardown(ctx context.Context, in *PluginOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	CollectMetrics(ctx context.Context, in *CollectMetricsOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	ValidateMetricsSupportList(ctx context.Context, in *ValidateMetricsOpts, opts ...grpc.CallOption) (*GenericResponse, error)
 }
 
-type fileShareControllerClient struct {
+type metricDriverPluginClient struct {
 	cc *grpc.ClientConn
 }
 
-func NewFileShareControllerClient(cc *grpc.ClientConn) FileShareControllerClient {
-	return &fileShareControllerClient{cc}
+func NewMetricDriverPluginClient(cc *grpc.ClientConn) MetricDriverPluginClient {
+	return &metricDriverPluginClient{cc}
 }
 
-func (c *fileShareControllerClient) CreateFileShare(ctx context.Context, in *CreateFileShareOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+func (c *metricDriverPluginClient) Setup(ctx context.Context, in *PluginOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
 	out := new(GenericResponse)
-	err := c.cc.Invoke(ctx, "/proto.FileShareController/CreateFileShare", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/proto.MetricDriverPlugin/Setup", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *fileShareControllerClient) DeleteFileShare(ctx context.Context, in *DeleteFileShareOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+func (c *metricDriverPluginClient) Teardown(ctx context.Context, in *PluginOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
 	out := new(GenericResponse)
-	err := c.cc.Invoke(ctx, "/proto.FileShareController/DeleteFileShare", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/proto.MetricDriverPlugin/Teardown", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// FileShareControllerServer is the server API for FileShareController service.
-type FileShareControllerServer interface {
-	// Create a file share
-	CreateFileShare(context.Context, *CreateFileShareOpts) (*GenericResponse, error)
-	// Delete a file share
-	DeleteFileShare(context.Context, *DeleteFileShareOpts) (*GenericResponse, error)
+func (c *metricDriverPluginClient) CollectMetrics(ctx context.Context, in *CollectMetricsOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.MetricDriverPlugin/CollectMetrics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedFileShareControllerServer can be embedded to have forward compatible implementations.
-type UnimplementedFileShareControllerServer struct {
+func (c *metricDriverPluginClient) ValidateMetricsSupportList(ctx context.Context, in *ValidateMetricsOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.MetricDriverPlugin/ValidateMetricsSupportList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (*UnimplementedFileShareControllerServer) CreateFileShare(ctx context.Context, req *CreateFileShareOpts) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateFileShare not implemented")
+// MetricDriverPluginServer is the server API for MetricDriverPlugin service.
+type MetricDriverPluginServer interface {
+	Setup(context.Context, *PluginOpts) (*GenericResponse, error)
+	Teardown(context.Context, *PluginOpts) (*GenericResponse, error)
+	CollectMetrics(context.Context, *CollectMetricsOpts) (*GenericResponse, error)
+	ValidateMetricsSupportList(context.Context, *ValidateMetricsOpts) (*GenericResponse, error)
 }
-func (*UnimplementedFileShareControllerServer) DeleteFileShare(ctx context.Context, req *DeleteFileShareOpts) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteFileShare not implemented")
+
+// UnimplementedMetricDriverPluginServer can be embedded to have forward compatible implementations.
+type UnimplementedMetricDriverPluginServer struct {
 }
 
-func RegisterFileShareControllerServer(s *grpc.Server, srv FileShareControllerServer) {
-	s.RegisterService(&_FileShareController_serviceDesc, srv)
+func (*UnimplementedMetricDriverPluginServer) Setup(ctx context.Context, req *PluginOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Setup not implemented")
+}
+func (*UnimplementedMetricDriverPluginServer) Teardown(ctx context.Context, req *PluginOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Teardown not implemented")
+}
+func (*UnimplementedMetricDriverPluginServer) CollectMetrics(ctx context.Context, req *CollectMetricsOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectMetrics not implemented")
+}
+func (*UnimplementedMetricDriverPluginServer) ValidateMetricsSupportList(ctx context.Context, req *ValidateMetricsOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateMetricsSupportList not implemented")
 }
 
-func _FileShareController_CreateFileShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateFileShareOpts)
+func RegisterMetricDriverPluginServer(s *grpc.Server, srv MetricDriverPluginServer) {
+	s.RegisterService(&_MetricDriverPlugin_serviceDesc, srv)
+}
+
+func _MetricDriverPlugin_Setup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PluginOpts)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FileShareControllerServer).CreateFileShare(ctx, in)
+		return srv.(MetricDriverPluginServer).Setup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.FileShareController/CreateFileShare",
+		FullMethod: "/proto.MetricDriverPlugin/Setup",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FileShareControllerServer).CreateFileShare(ctx, req.(*CreateFileShareOpts))
+		return srv.(MetricDriverPluginServer).Setup(ctx, req.(*PluginOpts))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FileShareController_DeleteFileShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteFileShareOpts)
+func _MetricDriverPlugin_Teardown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PluginOpts)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FileShareControllerServer).DeleteFileShare(ctx, in)
+		return srv.(MetricDriverPluginServer).Teardown(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.FileShareController/DeleteFileShare",
+		FullMethod: "/proto.MetricDriverPlugin/Teardown",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FileShareControllerServer).DeleteFileShare(ctx, req.(*DeleteFileShareOpts))
+		return srv.(MetricDriverPluginServer).Teardown(ctx, req.(*PluginOpts))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _FileShareController_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "proto.FileShareController",
-	HandlerType: (*FileShareControllerServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateFileShare",
-			Handler:    _FileShareController_CreateFileShare_Handler,
-		},
-		{
-			MethodName: "DeleteFileShare",
-			Handler:    _FileShareController_DeleteFileShare_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "model.proto",
-}
-
-// FileShareDockClient is the client API for FileShareDock service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type FileShareDockClient interface {
-	// Create a file share
-	CreateFileShare(ctx context.Context, in *CreateFileShareOpts, opts ...grpc.CallOption) (*GenericResponse, error)
-	// Delete a file share
-	DeleteFileShare(ctx context.Context, in *DeleteFileShareOpts, opts ...grpc.CallOption) (*GenericResponse, error)
-}
-
-type fileShareDockClient struct {
-	cc *grpc.ClientConn
-}
-
-func NewFileShareDockClient(cc *grpc.ClientConn) FileShareDockClient {
-	return &fileShareDockClient{cc}
-}
-
-func (c *fileShareDockClient) CreateFileShare(ctx context.Context, in *CreateFileShareOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
-	out := new(GenericResponse)
-	err := c.cc.Invoke(ctx, "/proto.FileShareDock/CreateFileShare", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *fileShareDockClient) DeleteFileShare(ctx context.Context, in *DeleteFileShareOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
-	out := new(GenericResponse)
-	err := c.cc.Invoke(ctx, "/proto.FileShareDock/DeleteFileShare", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-// FileShareDockServer is the server API for FileShareDock service.
-type FileShareDockServer interface {
-	// Create a file share
-	CreateFileShare(context.Context, *CreateFileShareOpts) (*GenericResponse, error)
-	// Delete a file share
-	DeleteFileShare(context.Context, *DeleteFileShareOpts) (*GenericResponse, error)
-}
-
-// UnimplementedFileShareDockServer can be embedded to have forward compatible implementations.
-type UnimplementedFileShareDockServer struct {
-}
-
-func (*UnimplementedFileShareDockServer) CreateFileShare(ctx context.Context, req *CreateFileShareOpts) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateFileShare not implemented")
-}
-func (*UnimplementedFileShareDockServer) DeleteFileShare(ctx context.Context, req *DeleteFileShareOpts) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteFileShare not implemented")
-}
-
-func RegisterFileShareDockServer(s *grpc.Server, srv FileShareDockServer) {
-	s.RegisterService(&_FileShareDock_serviceDesc, srv)
-}
-
-func _FileShareDock_CreateFileShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateFileShareOpts)
+func _MetricDriverPlugin_CollectMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectMetricsOpts)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FileShareDockServer).CreateFileShare(ctx, in)
+		return srv.(MetricDriverPluginServer).CollectMetrics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.FileShareDock/CreateFileShare",
+		FullMethod: "/proto.MetricDriverPlugin/CollectMetrics",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FileShareDockServer).CreateFileShare(ctx, req.(*CreateFileShareOpts))
+		return srv.(MetricDriverPluginServer).CollectMetrics(ctx, req.(*CollectMetricsOpts))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FileShareDock_DeleteFileShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteFileShareOpts)
+func _MetricDriverPlugin_ValidateMetricsSupportList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateMetricsOpts)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FileShareDockServer).DeleteFileShare(ctx, in)
+		return srv.(MetricDriverPluginServer).ValidateMetricsSupportList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.FileShareDock/DeleteFileShare",
+		FullMethod: "/proto.MetricDriverPlugin/ValidateMetricsSupportList",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FileShareDockServer).DeleteFileShare(ctx, req.(*DeleteFileShareOpts))
+		return srv.(MetricDriverPluginServer).ValidateMetricsSupportList(ctx, req.(*ValidateMetricsOpts))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _FileShareDock_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "proto.FileShareDock",
-	HandlerType: (*FileShareDockServer)(nil),
+var _MetricDriverPlugin_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.MetricDriverPlugin",
+	HandlerType: (*MetricDriverPluginServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "CreateFileShare",
-			Handler:    _FileShareDock_CreateFileShare_Handler,
+			MethodName: "Setup",
+			Handler:    _MetricDriverPlugin_Setup_Handler,
 		},
 		{
-			MethodName: "DeleteFileShare",
-			Handler:    _FileShareDock_DeleteFileShare_Handler,
+			MethodName: "Teardown",
+			Handler:    _MetricDriverPlugin_Teardown_Handler,
+		},
+		{
+			MethodName: "CollectMetrics",
+			Handler:    _MetricDriverPlugin_CollectMetrics_Handler,
+		},
+		{
+			MethodName: "ValidateMetricsSupportList",
+			Handler:    _MetricDriverPlugin_ValidateMetricsSupportList_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -4808,6 +10864,12 @@ type AttachDockClient interface {
 	AttachVolume(ctx context.Context, in *AttachVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error)
 	// Detach a volume
 	DetachVolume(ctx context.Context, in *DetachVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Copy data between two attached volumes
+	CopyVolume(ctx context.Context, in *CopyVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Suspend the writes to the file system mounted on a volume
+	FreezeVolume(ctx context.Context, in *FreezeVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Resume the writes to the file system mounted on a volume
+	ThawVolume(ctx context.Context, in *FreezeVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error)
 }
 
 type attachDockClient struct {
@@ -4836,12 +10898,45 @@ func (c *attachDockClient) DetachVolume(ctx context.Context, in *DetachVolumeOpt
 	return out, nil
 }
 
+func (c *attachDockClient) CopyVolume(ctx context.Context, in *CopyVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.AttachDock/CopyVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attachDockClient) FreezeVolume(ctx context.Context, in *FreezeVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.AttachDock/FreezeVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attachDockClient) ThawVolume(ctx context.Context, in *FreezeVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.AttachDock/ThawVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AttachDockServer is the server API for AttachDock service.
 type AttachDockServer interface {
 	// Attach a volume
 	AttachVolume(context.Context, *AttachVolumeOpts) (*GenericResponse, error)
 	// Detach a volume
 	DetachVolume(context.Context, *DetachVolumeOpts) (*GenericResponse, error)
+	// Copy data between two attached volumes
+	CopyVolume(context.Context, *CopyVolumeOpts) (*GenericResponse, error)
+	// Suspend the writes to the file system mounted on a volume
+	FreezeVolume(context.Context, *FreezeVolumeOpts) (*GenericResponse, error)
+	// Resume the writes to the file system mounted on a volume
+	ThawVolume(context.Context, *FreezeVolumeOpts) (*GenericResponse, error)
 }
 
 // UnimplementedAttachDockServer can be embedded to have forward compatible implementations.
@@ -4854,6 +10949,15 @@ func (*UnimplementedAttachDockServer) AttachVolume(ctx context.Context, req *Att
 func (*UnimplementedAttachDockServer) DetachVolume(ctx context.Context, req *DetachVolumeOpts) (*GenericResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DetachVolume not implemented")
 }
+func (*UnimplementedAttachDockServer) CopyVolume(ctx context.Context, req *CopyVolumeOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CopyVolume not implemented")
+}
+func (*UnimplementedAttachDockServer) FreezeVolume(ctx context.Context, req *FreezeVolumeOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeVolume not implemented")
+}
+func (*UnimplementedAttachDockServer) ThawVolume(ctx context.Context, req *FreezeVolumeOpts) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ThawVolume not implemented")
+}
 
 func RegisterAttachDockServer(s *grpc.Server, srv AttachDockServer) {
 	s.RegisterService(&_AttachDock_serviceDesc, srv)
@@ -4895,6 +10999,60 @@ func _AttachDock_DetachVolume_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AttachDock_CopyVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopyVolumeOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachDockServer).CopyVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AttachDock/CopyVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachDockServer).CopyVolume(ctx, req.(*CopyVolumeOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttachDock_FreezeVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FreezeVolumeOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachDockServer).FreezeVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AttachDock/FreezeVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachDockServer).FreezeVolume(ctx, req.(*FreezeVolumeOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttachDock_ThawVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FreezeVolumeOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachDockServer).ThawVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AttachDock/ThawVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachDockServer).ThawVolume(ctx, req.(*FreezeVolumeOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AttachDock_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "proto.AttachDock",
 	HandlerType: (*AttachDockServer)(nil),
@@ -4907,6 +11065,18 @@ var _AttachDock_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DetachVolume",
 			Handler:    _AttachDock_DetachVolume_Handler,
 		},
+		{
+			MethodName: "CopyVolume",
+			Handler:    _AttachDock_CopyVolume_Handler,
+		},
+		{
+			MethodName: "FreezeVolume",
+			Handler:    _AttachDock_FreezeVolume_Handler,
+		},
+		{
+			MethodName: "ThawVolume",
+			Handler:    _AttachDock_ThawVolume_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "model.proto",
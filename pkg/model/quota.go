@@ -0,0 +1,128 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+This module implements the quota data structure which limits the resources
+that a tenant can consume.
+
+*/
+
+package model
+
+// QuotaSet is a set of the resources which are limited by quota. As a limit
+// a negative value means unlimited.
+type QuotaSet struct {
+	// The number of volumes.
+	Volumes int64 `json:"volumes"`
+
+	// The number of volume snapshots.
+	Snapshots int64 `json:"snapshots"`
+
+	// The total size of the volumes, volume snapshots and fileshares.
+	// +units:GB
+	Capacity int64 `json:"capacity"`
+
+	// The number of fileshares.
+	FileShares int64 `json:"fileShares"`
+}
+
+// Add returns the sum of the two sets.
+func (s QuotaSet) Add(o QuotaSet) QuotaSet {
+	return QuotaSet{
+		Volumes:    s.Volumes + o.Volumes,
+		Snapshots:  s.Snapshots + o.Snapshots,
+		Capacity:   s.Capacity + o.Capacity,
+		FileShares: s.FileShares + o.FileShares,
+	}
+}
+
+// IsZero returns true if the set holds no resource at all.
+func (s QuotaSet) IsZero() bool {
+	return s == QuotaSet{}
+}
+
+// Exceeded regards s as the limits and returns the names of the resources
+// which would go beyond them if delta were added to usage. Only the resources
+// increased by delta are checked, so a tenant which is already over a lowered
+// limit can still release its resources.
+func (s QuotaSet) Exceeded(usage, delta QuotaSet) []string {
+	var over []string
+	check := func(name string, limit, used, inc int64) {
+		if limit >= 0 && inc > 0 && used+inc > limit {
+			over = append(over, name)
+		}
+	}
+	check("volumes", s.Volumes, usage.Volumes, delta.Volumes)
+	check("snapshots", s.Snapshots, usage.Snapshots, delta.Snapshots)
+	check("capacity", s.Capacity, usage.Capacity, delta.Capacity)
+	check("fileShares", s.FileShares, usage.FileShares, delta.FileShares)
+	return over
+}
+
+// QuotaSpec describes the limits of the resources which a tenant can consume.
+// The tenants which have no quota set explicitly are limited by the default
+// quota in the configuration.
+type QuotaSpec struct {
+	*BaseModel
+
+	// The uuid of the tenant that the quota is applied to, it's also the id
+	// of the quota.
+	TenantId string `json:"tenantId"`
+
+	// The limits of the resources.
+	QuotaSet
+
+	// The resources which have been consumed by the tenant.
+	// +readOnly
+	InUse *QuotaSet `json:"inUse,omitempty"`
+
+	// The resources which have been reserved by the requests in progress.
+	// +readOnly
+	Reserved *QuotaSet `json:"reserved,omitempty"`
+}
+
+// QuotaCharge is what a resource costs against the quota of its tenant. The
+// reserved part is held by the request in progress, it becomes in use if the
+// request succeeds or is given back if the request fails.
+type QuotaCharge struct {
+	InUse QuotaSet `json:"inUse"`
+
+	Reserved QuotaSet `json:"reserved"`
+
+	// ReservedAt representing the server time when the resources were
+	// reserved, the reservation is regarded as abandoned if it's not
+	// committed or rolled back in time.
+	ReservedAt string `json:"reservedAt,omitempty"`
+}
+
+// QuotaUsageSpec records the resources charged to a tenant, which is also
+// the id of the usage.
+type QuotaUsageSpec struct {
+	*BaseModel
+
+	// The uuid of the tenant that the usage belongs to.
+	TenantId string `json:"tenantId"`
+
+	// The charges of the resources indexed by their uuids.
+	Charges map[string]*QuotaCharge `json:"charges,omitempty"`
+}
+
+// Total returns the sum of the resources in use and reserved.
+func (u *QuotaUsageSpec) Total() (inUse, reserved QuotaSet) {
+	for _, ch := range u.Charges {
+		inUse = inUse.Add(ch.InUse)
+		reserved = reserved.Add(ch.Reserved)
+	}
+	return inUse, reserved
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+This module implements the report of the reconciliation between the database
+and the backends, which shows the records and the backend objects drifting
+apart.
+
+*/
+
+package model
+
+// reconcile report status
+const (
+	ReconcileRunning  = "running"
+	ReconcileFinished = "finished"
+)
+
+// drift type
+const (
+	// The record exists in the database, but the object can't be found in
+	// the backend.
+	DriftMissing = "missing"
+	// The object named by opensds exists in the backend, but no record of it
+	// can be found in the database.
+	DriftOrphaned = "orphaned"
+	// The size of the object in the backend differs from the record.
+	DriftSizeMismatch = "sizeMismatch"
+	// The status of the object in the backend differs from the record.
+	DriftStatusMismatch = "statusMismatch"
+)
+
+// ReconcileReportSpec is the result of the last reconciliation between the
+// database and the backends.
+type ReconcileReportSpec struct {
+	// The status of the reconciliation, one of running and finished.
+	Status string `json:"status"`
+
+	// Whether the drifts which are safe to be fixed are fixed.
+	AutoFix bool `json:"autoFix"`
+
+	// The time when the reconciliation started.
+	StartedAt string `json:"startedAt,omitempty"`
+
+	// The time when the reconciliation finished.
+	FinishedAt string `json:"finishedAt,omitempty"`
+
+	// The drifts found between the database and the backends.
+	Drifts []*DriftSpec `json:"drifts"`
+
+	// Why some of the pools or resources couldn't be checked.
+	// +optional
+	Errors []string `json:"errors,omitempty"`
+}
+
+// DriftSpec describes a record which doesn't match the object in the backend.
+type DriftSpec struct {
+	// The type of the drift, one of missing, orphaned, sizeMismatch and
+	// statusMismatch.
+	Type string `json:"type"`
+
+	// The type of the resource, one of volume and snapshot.
+	ResourceType string `json:"resourceType"`
+
+	// The uuid of the resource.
+	ResourceId string `json:"resourceId"`
+
+	// The uuid of the pool which the object is placed in.
+	PoolId string `json:"poolId,omitempty"`
+
+	// The value recorded in the database.
+	// +optional
+	Expected string `json:"expected,omitempty"`
+
+	// The value found in the backend, it's the name of the object in the
+	// backend if the object is orphaned.
+	// +optional
+	Actual string `json:"actual,omitempty"`
+
+	// Whether the record has been fixed.
+	Fixed bool `json:"fixed"`
+}
+
+// ReconcileSpec is the request body of starting a reconciliation.
+type ReconcileSpec struct {
+	// Whether the drifts which are safe to be fixed are fixed, the records
+	// missing in the backends or reported as error by them are marked error.
+	AutoFix bool `json:"autoFix"`
+}
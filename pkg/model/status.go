@@ -33,6 +33,15 @@ const (
 	FileShareSnapErrorDeleting = "errorDeleting"
 )
 
+// fileshare acl status
+const (
+	FileShareAclCreating      = "creating"
+	FileShareAclAvailable     = "available"
+	FileShareAclDeleting      = "deleting"
+	FileShareAclError         = "error"
+	FileShareAclErrorDeleting = "errorDeleting"
+)
+
 // volume status
 const (
 	VolumeCreating       = "creating"
@@ -43,6 +52,13 @@ const (
 	VolumeErrorDeleting  = "errorDeleting"
 	VolumeErrorExtending = "errorExtending"
 	VolumeExtending      = "extending"
+	VolumeRestoring      = "restoring"
+	VolumeErrorRestoring = "errorRestoring"
+	VolumeRetyping       = "retyping"
+	VolumeMigrating      = "migrating"
+	VolumeReverting      = "reverting"
+	VolumeManaging       = "managing"
+	VolumeUnmanaging     = "unmanaging"
 )
 
 // volume attach status
@@ -62,6 +78,18 @@ const (
 	VolumeSnapDeleting      = "deleting"
 	VolumeSnapError         = "error"
 	VolumeSnapErrorDeleting = "errorDeleting"
+	VolumeSnapManaging      = "managing"
+	VolumeSnapUnmanaging    = "unmanaging"
+)
+
+// volume backup status
+const (
+	BackupCreating      = "creating"
+	BackupAvailable     = "available"
+	BackupDeleting      = "deleting"
+	BackupRestoring     = "restoring"
+	BackupError         = "error"
+	BackupErrorDeleting = "errorDeleting"
 )
 
 // volume attachment status
@@ -103,3 +131,46 @@ const (
 	VolumeGroupUpdating      = "updating"
 	VolumeGroupInUse         = "inUse"
 )
+
+// volume group snapshot status
+const (
+	VolumeGroupSnapCreating      = "creating"
+	VolumeGroupSnapAvailable     = "available"
+	VolumeGroupSnapDeleting      = "deleting"
+	VolumeGroupSnapError         = "error"
+	VolumeGroupSnapErrorDeleting = "errorDeleting"
+)
+
+// operation status
+const (
+	OperationAccepted  = "accepted"
+	OperationRunning   = "running"
+	OperationSucceeded = "succeeded"
+	OperationFailed    = "failed"
+)
+
+// webhook delivery status
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliveryDelivered = "delivered"
+	WebhookDeliveryFailed    = "failed"
+)
+
+// audit outcome
+const (
+	AuditOutcomeSuccess = "success"
+	AuditOutcomeFailure = "failure"
+	AuditOutcomeDenied  = "denied"
+)
+
+// dock status
+const (
+	DockAvailable   = "available"
+	DockUnavailable = "unavailable"
+)
+
+// pool status
+const (
+	PoolAvailable   = "available"
+	PoolUnavailable = "unavailable"
+)
@@ -71,6 +71,9 @@ type VolumeSpec struct {
 	// The uuid of the snapshot which the volume is created
 	SnapshotId string `json:"snapshotId,omitempty"`
 
+	// The uuid of the source volume which the volume is cloned from.
+	SourceVolumeId string `json:"sourceVolumeId,omitempty"`
+
 	// Download Snapshot From Cloud
 	SnapshotFromCloud bool `json:"snapshotFromCloud,omitempty"`
 
@@ -84,6 +87,26 @@ type VolumeSpec struct {
 
 	// Whether the volume can be attached more than once, default value is false.
 	MultiAttach bool `json:"multiAttach,omitempty"`
+
+	// The state of the scheduled snapshots configured by the profile of the
+	// volume, it's maintained by osdslet.
+	// +readOnly
+	SnapshotSchedule *SnapshotScheduleSpec `json:"snapshotSchedule,omitempty"`
+}
+
+// SnapshotScheduleSpec records when the scheduled snapshots of a volume are
+// taken, it's persisted so that the schedule survives osdslet restarts.
+type SnapshotScheduleSpec struct {
+	// The schedule which the state belongs to, the state is reset when the
+	// schedule in the profile of the volume changes.
+	Policy string `json:"policy,omitempty"`
+
+	// The time when the last scheduled snapshot was taken.
+	LastRunAt string `json:"lastRunAt,omitempty"`
+
+	// The time when the next scheduled snapshot is due, empty means that no
+	// more snapshot will be taken.
+	NextRunAt string `json:"nextRunAt,omitempty"`
 }
 
 // VolumeAttachmentSpec is a description of volume attached resource.
@@ -180,17 +203,161 @@ type VolumeSnapshotSpec struct {
 	// The uuid of the volume which the snapshot belongs to.
 	VolumeId string `json:"volumeId,omitempty"`
 
+	// The uuid of the group snapshot which the snapshot was taken with.
+	// +readOnly
+	GroupSnapshotId string `json:"groupSnapshotId,omitempty"`
+
 	// Metadata should be kept until the scemantics between opensds volume
 	// snapshot and backend storage resouce snapshot description are clear.
 	// +optional
 	Metadata map[string]string `json:"metadata,omitempty"`
 }
 
+// BackupSpec is a description of volume backup resource.
+type BackupSpec struct {
+	*BaseModel
+
+	// The uuid of the project that the volume backup belongs to.
+	TenantId string `json:"tenantId,omitempty"`
+
+	// The uuid of the user that the volume backup belongs to.
+	// +optional
+	UserId string `json:"userId,omitempty"`
+
+	// The name of the volume backup.
+	Name string `json:"name,omitempty"`
+
+	// The description of the volume backup.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// The uuid of the volume which the backup belongs to.
+	VolumeId string `json:"volumeId,omitempty"`
+
+	// The uuid of the snapshot which the backup is taken from. If it is not
+	// specified, the backup will be taken from the volume directly.
+	// +optional
+	SnapshotId string `json:"snapshotId,omitempty"`
+
+	// The size of the volume which the backup belongs to.
+	// Default unit of backup Size is GB.
+	Size int64 `json:"size,omitempty"`
+
+	// The uuid of the pool which the backed up volume belongs to.
+	// +readOnly
+	PoolId string `json:"poolId,omitempty"`
+
+	// The name of the backup driver which stores the backup data.
+	// +readOnly
+	BackupDriver string `json:"backupDriver,omitempty"`
+
+	// The status of the volume backup.
+	// One of: "creating", "available", "restoring", "error", etc.
+	Status string `json:"status,omitempty"`
+
+	// Metadata is passed to the backup driver, for example the "bucket"
+	// which the backup data will be uploaded to.
+	// +optional
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// RestoreBackupSpec describes the volume which a backup will be restored
+// into. If VolumeId is not specified, a new volume will be created.
+type RestoreBackupSpec struct {
+	// The uuid of the existing volume which the backup will be restored into.
+	// +optional
+	VolumeId string `json:"volumeId,omitempty"`
+
+	// The name of the new volume.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// The uuid of the profile which the new volume belongs to.
+	// +optional
+	ProfileId string `json:"profileId,omitempty"`
+
+	// The locality that the new volume belongs to.
+	// +optional
+	AvailabilityZone string `json:"availabilityZone,omitempty"`
+}
+
 // ExtendVolumeSpec ...
 type ExtendVolumeSpec struct {
 	NewSize int64 `json:"newSize,omitempty"`
 }
 
+// The migration policies of retyping a volume.
+const (
+	// The volume is retyped only if the pool it's placed in satisfies the
+	// new profile.
+	MigrationPolicyNever = "never"
+	// The volume is migrated to another pool if the current one doesn't
+	// satisfy the new profile, it's the default policy.
+	MigrationPolicyOnDemand = "onDemand"
+)
+
+// RetypeVolumeSpec is the request body of changing the profile of a volume.
+type RetypeVolumeSpec struct {
+	// The uuid of the new profile.
+	ProfileId string `json:"profileId,omitempty"`
+	// The uuid of the pool which the volume should be migrated to if it has
+	// to be migrated, the selector will choose one if it's empty.
+	PoolId string `json:"poolId,omitempty"`
+	// Whether the volume can be migrated to satisfy the new profile.
+	MigrationPolicy string `json:"migrationPolicy,omitempty"`
+}
+
+// MigrateVolumeSpec is the request body of migrating a volume to another pool.
+type MigrateVolumeSpec struct {
+	// The uuid of the target pool, the selector will choose one satisfying
+	// the profile of the volume if it's empty.
+	PoolId string `json:"poolId,omitempty"`
+}
+
+// RevertVolumeSpec is the request body of reverting a volume to one of its
+// snapshots.
+type RevertVolumeSpec struct {
+	// The uuid of the snapshot which the volume is reverted to.
+	SnapshotId string `json:"snapshotId,omitempty"`
+	// Whether to revert the volume even if it's attached or the snapshot
+	// isn't the latest one of the volume.
+	Force bool `json:"force,omitempty"`
+}
+
+// ManageVolumeSpec is the request body of taking over a volume existing in
+// the backend, the data of the volume is left untouched.
+type ManageVolumeSpec struct {
+	// The name of the volume, the identifier is used if it's empty.
+	Name string `json:"name,omitempty"`
+	// The description of the volume.
+	Description string `json:"description,omitempty"`
+	// The name or id of the volume in the backend, which is interpreted by
+	// the driver of the pool.
+	Identifier string `json:"identifier,omitempty"`
+	// The uuid of the pool which the volume is placed in.
+	PoolId string `json:"poolId,omitempty"`
+	// The uuid of the profile, the default profile is used if it's empty.
+	ProfileId string `json:"profileId,omitempty"`
+	// The metadata of the volume.
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// ManageVolumeSnapshotSpec is the request body of taking over a volume
+// snapshot existing in the backend.
+type ManageVolumeSnapshotSpec struct {
+	// The name of the volume snapshot, the identifier is used if it's empty.
+	Name string `json:"name,omitempty"`
+	// The description of the volume snapshot.
+	Description string `json:"description,omitempty"`
+	// The name or id of the volume snapshot in the backend, which is
+	// interpreted by the driver of the pool.
+	Identifier string `json:"identifier,omitempty"`
+	// The uuid of the volume which the snapshot belongs to.
+	VolumeId string `json:"volumeId,omitempty"`
+	// The metadata of the volume snapshot.
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
 type VolumeGroupSpec struct {
 	*BaseModel
 	// The name of the volume group.
@@ -226,5 +393,59 @@ type VolumeGroupSpec struct {
 	// +readOnly
 	PoolId string `json:"poolId,omitempty"`
 
+	// The uuids of the group snapshots taken of the volume group.
+	// +readOnly
 	GroupSnapshots []string `json:"groupSnapshots,omitempty"`
+
+	// The uuid of the group snapshot which the volume group is created
+	// from, the volumes of the group are created from its member snapshots.
+	// +optional
+	GroupSnapshotId string `json:"groupSnapshotId,omitempty"`
+
+	// Metadata should be kept until the semantics between opensds volume
+	// group and backend storage resouce description are clear.
+	// +optional
+	Metadata map[string]string `json:"metadata,omitempty"`
+
+	// The members which the driver reports when the volumes are added to or
+	// removed from the group, they are written back to the volumes and not
+	// kept with the group.
+	// +readOnly
+	Volumes []*VolumeSpec `json:"volumes,omitempty"`
+}
+
+// VolumeGroupSnapshotSpec is a description of volume group snapshot
+// resource, which holds the snapshots of all the volumes in the group taken
+// at the same point in time.
+type VolumeGroupSnapshotSpec struct {
+	*BaseModel
+
+	// The uuid of the project that the group snapshot belongs to.
+	TenantId string `json:"tenantId,omitempty"`
+
+	// The uuid of the user that the group snapshot belongs to.
+	// +optional
+	UserId string `json:"userId,omitempty"`
+
+	// The name of the group snapshot.
+	Name string `json:"name,omitempty"`
+
+	// The description of the group snapshot.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// The status of the group snapshot.
+	// One of: "available", "error", etc.
+	Status string `json:"status,omitempty"`
+
+	// The uuid of the volume group which the group snapshot belongs to.
+	GroupId string `json:"groupId,omitempty"`
+
+	// The uuids of the member snapshots, one for each volume of the group.
+	// +readOnly
+	Snapshots []string `json:"snapshots,omitempty"`
+
+	// The metadata of the group snapshot.
+	// +optional
+	Metadata map[string]string `json:"metadata,omitempty"`
 }
@@ -0,0 +1,60 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+This module implements the watch event data structure, which streams the
+changes of the resources to the clients.
+
+*/
+
+package model
+
+import "encoding/json"
+
+// watch event type
+const (
+	WatchAdded    = "ADDED"
+	WatchModified = "MODIFIED"
+	WatchDeleted  = "DELETED"
+	// WatchError means the watch can't go on, such as the resource version
+	// requested has been compacted. The client should list the resources
+	// again and watch from the latest version.
+	WatchError = "ERROR"
+)
+
+// WatchResourcePool is the resource type of the pools which can be watched,
+// the other resources watched share the resource types of operations.
+const WatchResourcePool = "pool"
+
+// WatchEvent is a change of a resource.
+type WatchEvent struct {
+	// The type of the event, one of ADDED, MODIFIED, DELETED and ERROR.
+	Type string `json:"type"`
+
+	// The type of the resource, such as "volume".
+	ResourceType string `json:"resourceType,omitempty"`
+
+	// The version of the resource after the change, a watch started from
+	// it gets the changes after this one.
+	ResourceVersion int64 `json:"resourceVersion,omitempty"`
+
+	// The resource after the change, or the last state of the resource if
+	// it has been deleted.
+	// +optional
+	Object json.RawMessage `json:"object,omitempty"`
+
+	// The reason why the watch stopped if it's an ERROR event.
+	// +optional
+	ErrorMessage string `json:"errorMessage,omitempty"`
+}
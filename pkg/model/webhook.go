@@ -0,0 +1,129 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+This module implements the webhook data structures which notify the tenants
+of the status transitions of their resources.
+*/
+
+package model
+
+import "strings"
+
+// WebhookSpec is a subscription of a tenant to the events of its resources,
+// the events are posted to the url of the webhook.
+type WebhookSpec struct {
+	*BaseModel
+
+	// The uuid of the tenant that the webhook belongs to.
+	TenantId string `json:"tenantId,omitempty"`
+
+	// The uuid of the user that the webhook belongs to.
+	// +optional
+	UserId string `json:"userId,omitempty"`
+
+	// The name of the webhook.
+	Name string `json:"name,omitempty"`
+
+	// The description of the webhook.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// The http or https url which the events are posted to.
+	Url string `json:"url"`
+
+	// The types of the events subscribed, such as "volume.available", or
+	// "volume.*" for all the events of volumes. All the events are subscribed
+	// if it's empty.
+	// +optional
+	Events []string `json:"events,omitempty"`
+
+	// The secret which the events are signed with, the HMAC-SHA256 signature
+	// is sent in the X-OpenSDS-Signature header. It's never returned.
+	// +optional
+	Secret string `json:"secret,omitempty"`
+}
+
+// Subscribes returns true if the webhook subscribes the type of event.
+func (w *WebhookSpec) Subscribes(eventType string) bool {
+	if len(w.Events) == 0 {
+		return true
+	}
+	for _, pattern := range w.Events {
+		if pattern == "*" || pattern == eventType {
+			return true
+		}
+		if strings.HasSuffix(pattern, ".*") && strings.HasPrefix(eventType, strings.TrimSuffix(pattern, "*")) {
+			return true
+		}
+	}
+	return false
+}
+
+// EventSpec describes a status transition of a resource.
+type EventSpec struct {
+	// The uuid of the event, which is the same in all the deliveries.
+	Id string `json:"id"`
+
+	// The type of the event made up of the resource type and the new status,
+	// such as "volume.available".
+	Type string `json:"type"`
+
+	// The type of the resource, the same as the resource type of operations.
+	ResourceType string `json:"resourceType"`
+
+	// The uuid of the resource.
+	ResourceId string `json:"resourceId"`
+
+	// The uuid of the tenant that the resource belongs to.
+	TenantId string `json:"tenantId,omitempty"`
+
+	// The status of the resource before the transition.
+	// +optional
+	PreviousStatus string `json:"previousStatus,omitempty"`
+
+	// The status of the resource after the transition.
+	Status string `json:"status"`
+
+	// The time when the transition happened.
+	Time string `json:"time"`
+}
+
+// WebhookDeliverySpec records the delivery of an event to a webhook.
+type WebhookDeliverySpec struct {
+	*BaseModel
+
+	// The uuid of the tenant that the webhook belongs to.
+	TenantId string `json:"tenantId,omitempty"`
+
+	// The uuid of the webhook which the event is delivered to.
+	WebhookId string `json:"webhookId"`
+
+	// The event delivered.
+	Event EventSpec `json:"event"`
+
+	// The status of the delivery, one of "pending", "delivered" and "failed".
+	Status string `json:"status"`
+
+	// How many times the event has been posted.
+	Attempts int `json:"attempts"`
+
+	// The http status code responded to the last attempt.
+	// +optional
+	ResponseCode int `json:"responseCode,omitempty"`
+
+	// The error of the last attempt if it failed.
+	// +optional
+	ErrorMessage string `json:"errorMessage,omitempty"`
+}
@@ -0,0 +1,210 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+This module implements the quota management of the tenants. The resources
+requested are reserved by the api server before the request is accepted,
+then the controller commits the reservation if the request succeeds or rolls
+it back if the request fails. The resources are released when they're
+deleted.
+
+*/
+
+package quota
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	log "github.com/golang/glog"
+	c "github.com/opensds/opensds/pkg/context"
+	"github.com/opensds/opensds/pkg/db"
+	"github.com/opensds/opensds/pkg/model"
+	"github.com/opensds/opensds/pkg/utils/config"
+	"github.com/opensds/opensds/pkg/utils/constants"
+)
+
+const retryNum = 3
+
+// errUnchanged tells update that the usage needn't be written back.
+var errUnchanged = errors.New("quota usage unchanged")
+
+// Get returns the quota of the tenant along with its usage, the default
+// quota is returned if the tenant has no quota set explicitly.
+func Get(ctx *c.Context, tenantId string) (*model.QuotaSpec, error) {
+	quota, err := getLimits(ctx, tenantId)
+	if err != nil {
+		return nil, err
+	}
+	usage, err := getUsage(ctx, tenantId)
+	if err != nil {
+		return nil, err
+	}
+	inUse, reserved := usage.Total()
+	// The quota read from db may be shared, so the usage is filled in a copy.
+	q := *quota
+	q.InUse, q.Reserved = &inUse, &reserved
+	return &q, nil
+}
+
+// Default returns the default quota of the tenant in the configuration.
+func Default(tenantId string) *model.QuotaSpec {
+	return &model.QuotaSpec{
+		BaseModel: &model.BaseModel{Id: tenantId},
+		TenantId:  tenantId,
+		QuotaSet: model.QuotaSet{
+			Volumes:    config.CONF.Quota.Volumes,
+			Snapshots:  config.CONF.Quota.Snapshots,
+			Capacity:   config.CONF.Quota.Capacity,
+			FileShares: config.CONF.Quota.FileShares,
+		},
+	}
+}
+
+// Reserve charges the resources requested for the resource specified by
+// resourceId to the tenant, an ErrOverQuota is returned if any limit of the
+// tenant would be exceeded.
+func Reserve(ctx *c.Context, tenantId, resourceId string, delta model.QuotaSet) error {
+	quota, err := getLimits(ctx, tenantId)
+	if err != nil {
+		return err
+	}
+	return update(ctx, tenantId, func(usage *model.QuotaUsageSpec) error {
+		inUse, reserved := usage.Total()
+		if over := quota.Exceeded(inUse.Add(reserved), delta); len(over) > 0 {
+			return model.NewOverQuotaError(fmt.Sprintf("quota exceeded for %s of tenant %s, limit: %+v, in use: %+v, reserved: %+v, requested: %+v",
+				strings.Join(over, ", "), tenantId, quota.QuotaSet, inUse, reserved, delta))
+		}
+		ch, ok := usage.Charges[resourceId]
+		if !ok {
+			ch = &model.QuotaCharge{}
+			usage.Charges[resourceId] = ch
+		}
+		ch.Reserved = ch.Reserved.Add(delta)
+		ch.ReservedAt = time.Now().Format(constants.TimeFormat)
+		return nil
+	})
+}
+
+// Commit turns the resources reserved for the resource into use.
+func Commit(ctx *c.Context, tenantId, resourceId string) error {
+	return update(ctx, tenantId, func(usage *model.QuotaUsageSpec) error {
+		ch, ok := usage.Charges[resourceId]
+		if !ok || ch.Reserved.IsZero() {
+			return errUnchanged
+		}
+		ch.InUse = ch.InUse.Add(ch.Reserved)
+		ch.Reserved, ch.ReservedAt = model.QuotaSet{}, ""
+		return nil
+	})
+}
+
+// Rollback gives the resources reserved for the resource back to the tenant,
+// the resources already in use are kept.
+func Rollback(ctx *c.Context, tenantId, resourceId string) error {
+	return update(ctx, tenantId, func(usage *model.QuotaUsageSpec) error {
+		ch, ok := usage.Charges[resourceId]
+		if !ok || ch.Reserved.IsZero() {
+			return errUnchanged
+		}
+		ch.Reserved, ch.ReservedAt = model.QuotaSet{}, ""
+		if ch.InUse.IsZero() {
+			delete(usage.Charges, resourceId)
+		}
+		return nil
+	})
+}
+
+// Release gives all the resources charged for the resource back to the
+// tenant, it should be called once the resource is deleted.
+func Release(ctx *c.Context, tenantId, resourceId string) error {
+	return update(ctx, tenantId, func(usage *model.QuotaUsageSpec) error {
+		if _, ok := usage.Charges[resourceId]; !ok {
+			return errUnchanged
+		}
+		delete(usage.Charges, resourceId)
+		return nil
+	})
+}
+
+func getLimits(ctx *c.Context, tenantId string) (*model.QuotaSpec, error) {
+	quota, err := db.C.GetQuota(ctx, tenantId)
+	if _, ok := err.(*model.NotFoundError); ok {
+		return Default(tenantId), nil
+	}
+	return quota, err
+}
+
+func getUsage(ctx *c.Context, tenantId string) (*model.QuotaUsageSpec, error) {
+	usage, err := db.C.GetQuotaUsage(ctx, tenantId)
+	if _, ok := err.(*model.NotFoundError); ok {
+		usage = &model.QuotaUsageSpec{
+			BaseModel: &model.BaseModel{},
+			TenantId:  tenantId,
+		}
+	} else if err != nil {
+		return nil, err
+	}
+	if usage.Charges == nil {
+		usage.Charges = make(map[string]*model.QuotaCharge)
+	}
+	expire(usage)
+	return usage, nil
+}
+
+// expire drops the reservations which are neither committed nor rolled back
+// in time, the request holding them is regarded as lost.
+func expire(usage *model.QuotaUsageSpec) {
+	if config.CONF.Quota.ReservationExpire <= 0 {
+		return
+	}
+	for id, ch := range usage.Charges {
+		if ch.Reserved.IsZero() {
+			continue
+		}
+		reservedAt, err := time.ParseInLocation(constants.TimeFormat, ch.ReservedAt, time.Local)
+		if err != nil || time.Since(reservedAt) < config.CONF.Quota.ReservationExpire {
+			continue
+		}
+		log.Warningf("Reservation of %s in tenant %s has expired", id, usage.TenantId)
+		ch.Reserved, ch.ReservedAt = model.QuotaSet{}, ""
+		if ch.InUse.IsZero() {
+			delete(usage.Charges, id)
+		}
+	}
+}
+
+// update applies fn to the latest usage of the tenant and writes it back,
+// fn is applied again if the usage is modified by others in the meantime.
+func update(ctx *c.Context, tenantId string, fn func(*model.QuotaUsageSpec) error) error {
+	var err error
+	for i := 0; i < retryNum; i++ {
+		var usage *model.QuotaUsageSpec
+		if usage, err = getUsage(ctx, tenantId); err != nil {
+			return err
+		}
+		if err = fn(usage); err == errUnchanged {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if _, err = db.C.UpdateQuotaUsage(ctx, usage); !model.IsConflictError(err) {
+			return err
+		}
+		log.Warningf("Quota usage of tenant %s has been modified, retry %d", tenantId, i+1)
+	}
+	return err
+}
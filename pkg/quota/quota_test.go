@@ -0,0 +1,226 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quota
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	c "github.com/opensds/opensds/pkg/context"
+	"github.com/opensds/opensds/pkg/db"
+	"github.com/opensds/opensds/pkg/model"
+	"github.com/opensds/opensds/pkg/utils/constants"
+	dbtest "github.com/opensds/opensds/testutils/db/testing"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	tenantId = "e93b4c0934da416eb9c8d120c5d04d96"
+	volumeId = "bd5b12a8-a101-11e7-941e-d77981b584d8"
+)
+
+var assertTestResult = func(t *testing.T, got, expected interface{}) {
+	t.Helper()
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v\n", expected, got)
+	}
+}
+
+// newMockClient stores the limits and the usage of the tenant, the usage
+// written back is recorded in the returned pointer.
+func newMockClient(limits model.QuotaSet, usage *model.QuotaUsageSpec) (*dbtest.Client, **model.QuotaUsageSpec) {
+	var written *model.QuotaUsageSpec
+	mockClient := new(dbtest.Client)
+	mockClient.On("GetQuota", mock.Anything, tenantId).Return(&model.QuotaSpec{
+		BaseModel: &model.BaseModel{Id: tenantId},
+		TenantId:  tenantId,
+		QuotaSet:  limits,
+	}, nil)
+	if usage == nil {
+		mockClient.On("GetQuotaUsage", mock.Anything, tenantId).Return(nil, model.NewNotFoundError("quota usage can't find"))
+	} else {
+		mockClient.On("GetQuotaUsage", mock.Anything, tenantId).Return(usage, nil)
+	}
+	mockClient.On("UpdateQuotaUsage", mock.Anything, mock.Anything).Return(nil, nil).Run(func(args mock.Arguments) {
+		written = args.Get(1).(*model.QuotaUsageSpec)
+	})
+	return mockClient, &written
+}
+
+func TestReserve(t *testing.T) {
+	var limits = model.QuotaSet{Volumes: 2, Snapshots: -1, Capacity: 10, FileShares: -1}
+
+	t.Run("Resources within the limits should be reserved", func(t *testing.T) {
+		mockClient, written := newMockClient(limits, nil)
+		db.C = mockClient
+
+		if err := Reserve(c.NewAdminContext(), tenantId, volumeId, model.QuotaSet{Volumes: 1, Capacity: 10}); err != nil {
+			t.Fatalf("reserve quota failed: %v", err)
+		}
+		ch := (*written).Charges[volumeId]
+		assertTestResult(t, ch.Reserved, model.QuotaSet{Volumes: 1, Capacity: 10})
+		assertTestResult(t, ch.InUse, model.QuotaSet{})
+	})
+
+	t.Run("Reserved resources should count against the limits", func(t *testing.T) {
+		mockClient, written := newMockClient(limits, &model.QuotaUsageSpec{
+			BaseModel: &model.BaseModel{Revision: 2},
+			TenantId:  tenantId,
+			Charges: map[string]*model.QuotaCharge{
+				"other": {
+					Reserved:   model.QuotaSet{Volumes: 1, Capacity: 8},
+					ReservedAt: time.Now().Format(constants.TimeFormat),
+				},
+			},
+		})
+		db.C = mockClient
+
+		err := Reserve(c.NewAdminContext(), tenantId, volumeId, model.QuotaSet{Volumes: 1, Capacity: 5})
+		if !model.IsOverQuotaError(err) {
+			t.Errorf("expected an over quota error, got %v", err)
+		}
+		if *written != nil {
+			t.Error("usage should not be written if the quota is exceeded")
+		}
+	})
+
+	t.Run("Expired reservations should be given back", func(t *testing.T) {
+		mockClient, written := newMockClient(limits, &model.QuotaUsageSpec{
+			BaseModel: &model.BaseModel{Revision: 2},
+			TenantId:  tenantId,
+			Charges: map[string]*model.QuotaCharge{
+				"lost": {
+					Reserved:   model.QuotaSet{Volumes: 1, Capacity: 8},
+					ReservedAt: time.Now().Add(-48 * time.Hour).Format(constants.TimeFormat),
+				},
+			},
+		})
+		db.C = mockClient
+
+		if err := Reserve(c.NewAdminContext(), tenantId, volumeId, model.QuotaSet{Volumes: 1, Capacity: 5}); err != nil {
+			t.Fatalf("reserve quota failed: %v", err)
+		}
+		if _, ok := (*written).Charges["lost"]; ok {
+			t.Error("expired reservation should be dropped")
+		}
+		assertTestResult(t, (*written).Revision, int64(2))
+	})
+
+	t.Run("Reserve should be retried when the usage is modified", func(t *testing.T) {
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetQuota", mock.Anything, tenantId).Return(nil, model.NewNotFoundError("quota is not set"))
+		mockClient.On("GetQuotaUsage", mock.Anything, tenantId).Return(nil, model.NewNotFoundError("quota usage can't find"))
+		mockClient.On("UpdateQuotaUsage", mock.Anything, mock.Anything).Return(nil, model.NewConflictError("conflict")).Once()
+		mockClient.On("UpdateQuotaUsage", mock.Anything, mock.Anything).Return(nil, nil).Once()
+		db.C = mockClient
+
+		if err := Reserve(c.NewAdminContext(), tenantId, volumeId, model.QuotaSet{Volumes: 1}); err != nil {
+			t.Errorf("reserve quota failed: %v", err)
+		}
+		mockClient.AssertNumberOfCalls(t, "UpdateQuotaUsage", 2)
+	})
+}
+
+func TestCommitAndRollback(t *testing.T) {
+	var newUsage = func() *model.QuotaUsageSpec {
+		return &model.QuotaUsageSpec{
+			BaseModel: &model.BaseModel{Revision: 5},
+			TenantId:  tenantId,
+			Charges: map[string]*model.QuotaCharge{
+				volumeId: {
+					InUse:      model.QuotaSet{Volumes: 1, Capacity: 1},
+					Reserved:   model.QuotaSet{Capacity: 4},
+					ReservedAt: time.Now().Format(constants.TimeFormat),
+				},
+			},
+		}
+	}
+
+	t.Run("Commit should turn the reservation into use", func(t *testing.T) {
+		mockClient, written := newMockClient(model.QuotaSet{}, newUsage())
+		db.C = mockClient
+
+		if err := Commit(c.NewAdminContext(), tenantId, volumeId); err != nil {
+			t.Fatalf("commit quota failed: %v", err)
+		}
+		assertTestResult(t, *(*written).Charges[volumeId], model.QuotaCharge{
+			InUse: model.QuotaSet{Volumes: 1, Capacity: 5},
+		})
+	})
+
+	t.Run("Rollback should keep the resources in use", func(t *testing.T) {
+		mockClient, written := newMockClient(model.QuotaSet{}, newUsage())
+		db.C = mockClient
+
+		if err := Rollback(c.NewAdminContext(), tenantId, volumeId); err != nil {
+			t.Fatalf("rollback quota failed: %v", err)
+		}
+		assertTestResult(t, *(*written).Charges[volumeId], model.QuotaCharge{
+			InUse: model.QuotaSet{Volumes: 1, Capacity: 1},
+		})
+	})
+
+	t.Run("Release should drop the whole charge", func(t *testing.T) {
+		mockClient, written := newMockClient(model.QuotaSet{}, newUsage())
+		db.C = mockClient
+
+		if err := Release(c.NewAdminContext(), tenantId, volumeId); err != nil {
+			t.Fatalf("release quota failed: %v", err)
+		}
+		assertTestResult(t, len((*written).Charges), 0)
+	})
+
+	t.Run("Nothing should be written for an unknown resource", func(t *testing.T) {
+		mockClient, written := newMockClient(model.QuotaSet{}, newUsage())
+		db.C = mockClient
+
+		if err := Commit(c.NewAdminContext(), tenantId, "unknown"); err != nil {
+			t.Fatalf("commit quota failed: %v", err)
+		}
+		if *written != nil {
+			t.Error("usage should not be written")
+		}
+	})
+}
+
+func TestGet(t *testing.T) {
+	mockClient, _ := newMockClient(model.QuotaSet{Volumes: 10}, &model.QuotaUsageSpec{
+		BaseModel: &model.BaseModel{},
+		TenantId:  tenantId,
+		Charges: map[string]*model.QuotaCharge{
+			volumeId: {InUse: model.QuotaSet{Volumes: 1, Capacity: 1}},
+			"creating": {
+				Reserved:   model.QuotaSet{Volumes: 1, Capacity: 2},
+				ReservedAt: time.Now().Format(constants.TimeFormat),
+			},
+		},
+	})
+	db.C = mockClient
+
+	quota, err := Get(c.NewAdminContext(), tenantId)
+	if err != nil {
+		t.Fatalf("get quota failed: %v", err)
+	}
+	assertTestResult(t, quota.Volumes, int64(10))
+	assertTestResult(t, *quota.InUse, model.QuotaSet{Volumes: 1, Capacity: 1})
+	assertTestResult(t, *quota.Reserved, model.QuotaSet{Volumes: 1, Capacity: 2})
+
+	// The usage is filled in a copy of the quota read from db.
+	stored, _ := mockClient.GetQuota(c.NewAdminContext(), tenantId)
+	if stored.InUse != nil || stored.Reserved != nil {
+		t.Errorf("Expected the stored quota unchanged, got %+v", stored)
+	}
+}
@@ -33,6 +33,36 @@ type OsdsLet struct {
 	ApiEndpoint       string        `conf:"api_endpoint,localhost:50049"`
 	Daemon            bool          `conf:"daemon,false"`
 	LogFlushFrequency time.Duration `conf:"log_flush_frequency,5s"` // Default value is 5s
+
+	// The weighers used to rank the candidate pools, supports free_capacity,
+	// free_capacity_ratio, volume_count and affinity.
+	SchedulerWeighers                 []string `conf:"scheduler_weighers,free_capacity"`
+	FreeCapacityWeightMultiplier      float64  `conf:"free_capacity_weight_multiplier,1.0"`
+	FreeCapacityRatioWeightMultiplier float64  `conf:"free_capacity_ratio_weight_multiplier,1.0"`
+	VolumeCountWeightMultiplier       float64  `conf:"volume_count_weight_multiplier,-1.0"`
+	AffinityWeightMultiplier          float64  `conf:"affinity_weight_multiplier,1.0"`
+
+	// The docks which haven't sent heartbeat for this long are marked
+	// unavailable together with their pools, zero disables the check.
+	DockHeartbeatTimeout time.Duration `conf:"dock_heartbeat_timeout,90s"`
+
+	// How often the snapshot schedules of the volumes are checked, zero
+	// disables the scheduled snapshots.
+	SnapshotScheduleInterval time.Duration `conf:"snapshot_schedule_interval,60s"`
+
+	// How often the volumes and snapshots are reconciled with the backends,
+	// zero disables the periodic reconciliation. The records missing in the
+	// backends or reported as error by them are marked error if auto fix is
+	// enabled.
+	ReconcileInterval time.Duration `conf:"reconcile_interval,30m"`
+	ReconcileAutoFix  bool          `conf:"reconcile_auto_fix,false"`
+
+	// The events failed to be posted to the webhooks are retried until
+	// they're posted this many times, the interval before the first retry
+	// is doubled after every failed attempt.
+	WebhookMaxAttempts   int           `conf:"webhook_max_attempts,5"`
+	WebhookRetryInterval time.Duration `conf:"webhook_retry_interval,5s"`
+	WebhookTimeout       time.Duration `conf:"webhook_timeout,10s"`
 }
 
 type OsdsDock struct {
@@ -42,7 +72,9 @@ type OsdsDock struct {
 	Daemon                     bool          `conf:"daemon,false"`
 	BindIp                     string        `conf:"bind_ip"` // Just used for attacher dock
 	HostBasedReplicationDriver string        `conf:"host_based_replication_driver,drbd"`
+	BackupDriver               string        `conf:"backup_driver,multi-cloud"`
 	LogFlushFrequency          time.Duration `conf:"log_flush_frequency,5s"` // Default value is 5s
+	HeartbeatInterval          time.Duration `conf:"heartbeat_interval,30s"`
 	Backends
 }
 
@@ -52,6 +84,19 @@ type Database struct {
 	Endpoint   string `conf:"endpoint,localhost:2379,localhost:2380"`
 }
 
+// Quota holds the default quota of the tenants which have no quota set
+// explicitly, a negative limit means unlimited.
+type Quota struct {
+	Volumes    int64 `conf:"volumes,-1"`
+	Snapshots  int64 `conf:"snapshots,-1"`
+	Capacity   int64 `conf:"capacity,-1"` // In GB
+	FileShares int64 `conf:"fileshares,-1"`
+
+	// The resources reserved by a request are given back if the request
+	// neither succeeds nor fails in time, so that they won't be leaked.
+	ReservationExpire time.Duration `conf:"reservation_expire,24h"`
+}
+
 type BackendProperties struct {
 	Name               string `conf:"name"`
 	Description        string `conf:"description"`
@@ -68,6 +113,8 @@ type Backends struct {
 	HuaweiDorado        BackendProperties `conf:"huawei_dorado"`
 	HuaweiFusionStorage BackendProperties `conf:"huawei_fusionstorage"`
 	NFS           BackendProperties `conf:"nfs"`
+	// Plugin is the backend served by an out-of-process driver plugin.
+	Plugin BackendProperties `conf:"plugin"`
 }
 
 type KeystoneAuthToken struct {
@@ -94,5 +141,6 @@ type Config struct {
 	OsdsLet           `conf:"osdslet"`
 	OsdsDock          `conf:"osdsdock"`
 	Database          `conf:"database"`
+	Quota             `conf:"quota"`
 	KeystoneAuthToken `conf:"keystone_authtoken"`
 }
@@ -275,6 +275,15 @@ func TestOpensdsConfig(t *testing.T) {
 	if CONF.OsdsLet.LogFlushFrequency != 3*time.Second {
 		t.Error("Test OsdsLet.LogFlushFrequency error")
 	}
+	if !reflect.DeepEqual(CONF.OsdsLet.SchedulerWeighers, []string{"free_capacity", "volume_count"}) {
+		t.Error("Test OsdsLet.SchedulerWeighers error")
+	}
+	if CONF.OsdsLet.FreeCapacityWeightMultiplier != 1.0 {
+		t.Error("Test OsdsLet.FreeCapacityWeightMultiplier error")
+	}
+	if CONF.OsdsLet.VolumeCountWeightMultiplier != -2.0 {
+		t.Error("Test OsdsLet.VolumeCountWeightMultiplier error")
+	}
 	if CONF.OsdsDock.ApiEndpoint != "localhost:50050" {
 		t.Error("Test OsdsDock.ApiEndpoint error")
 	}
@@ -293,6 +302,9 @@ func TestOpensdsConfig(t *testing.T) {
 	if CONF.OsdsDock.EnabledBackends[2] != "sample" {
 		t.Error("Test OsdsDock.EnabledBackends[2] error")
 	}
+	if CONF.OsdsDock.BackupDriver != "multi-cloud" {
+		t.Error("Test OsdsDock.BackupDriver error")
+	}
 	if CONF.Database.Credential != "opensds:password@127.0.0.1:3306/dbname" {
 		t.Error("Test Database.Credential error")
 	}
@@ -302,6 +314,18 @@ func TestOpensdsConfig(t *testing.T) {
 	if CONF.Database.Driver != "etcd" {
 		t.Error("Test Database.Driver error")
 	}
+	if CONF.Quota.Volumes != 10 {
+		t.Error("Test Quota.Volumes error")
+	}
+	if CONF.Quota.Snapshots != -1 {
+		t.Error("Test Quota.Snapshots error")
+	}
+	if CONF.Quota.Capacity != 1000 {
+		t.Error("Test Quota.Capacity error")
+	}
+	if CONF.Quota.ReservationExpire != time.Hour {
+		t.Error("Test Quota.ReservationExpire error")
+	}
 	if CONF.Backends.Ceph.Name != "ceph" {
 		t.Error("Test Ceph.Backends.Name error")
 	}
@@ -48,6 +48,10 @@ const (
 	// dock grpc server would listen to.
 	OpensdsDockBindEndpoint = "0.0.0.0:50050"
 
+	// AlertmanagerEndpoint indicates the api which the alerts are posted to,
+	// the alert manager is co-located on the server.
+	AlertmanagerEndpoint = "http://localhost:9093/api/v1/alerts"
+
 	//Storage type for profile
 	Block = "block"
 	File  = "file"
@@ -56,4 +60,7 @@ const (
 	Read    = "Read"
 	Write   = "Write"
 	Execute = "Execute"
+
+	//Access type enum constants for fileshare acl
+	IpAccess = "ip"
 )
@@ -66,6 +66,10 @@ func GenerateSnapshotURL(urlType int, tenantId string, in ...string) string {
 	return generateURL("block/snapshots", urlType, tenantId, in...)
 }
 
+func GenerateBackupURL(urlType int, tenantId string, in ...string) string {
+	return generateURL("block/backups", urlType, tenantId, in...)
+}
+
 func GenerateReplicationURL(urlType int, tenantId string, in ...string) string {
 	return generateURL("block/replications", urlType, tenantId, in...)
 }
@@ -74,6 +78,38 @@ func GenerateVolumeGroupURL(urlType int, tenantId string, in ...string) string {
 	return generateURL("block/volumeGroups", urlType, tenantId, in...)
 }
 
+func GenerateVolumeGroupSnapshotURL(urlType int, tenantId string, in ...string) string {
+	return generateURL("block/groupSnapshots", urlType, tenantId, in...)
+}
+
+func GenerateOperationURL(urlType int, tenantId string, in ...string) string {
+	return generateURL("operations", urlType, tenantId, in...)
+}
+
+func GenerateQuotaURL(urlType int, tenantId string, in ...string) string {
+	return generateURL("quotas", urlType, tenantId, in...)
+}
+
+func GenerateQuotaUsageURL(urlType int, tenantId string, in ...string) string {
+	return generateURL("quotaUsages", urlType, tenantId, in...)
+}
+
+func GenerateWebhookURL(urlType int, tenantId string, in ...string) string {
+	return generateURL("webhooks", urlType, tenantId, in...)
+}
+
+func GenerateWebhookDeliveryURL(urlType int, tenantId string, in ...string) string {
+	return generateURL("webhookDeliveries", urlType, tenantId, in...)
+}
+
+func GenerateAuditURL(urlType int, tenantId string, in ...string) string {
+	return generateURL("audit", urlType, tenantId, in...)
+}
+
+func GenerateEventURL(urlType int, tenantId string, in ...string) string {
+	return generateURL("events", urlType, tenantId, in...)
+}
+
 func generateURL(resource string, urlType int, tenantId string, in ...string) string {
 	// If project id is not specified, ignore it.
 	if tenantId == "" {
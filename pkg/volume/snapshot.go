@@ -0,0 +1,106 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+This module implements the database operations of volume snapshots which are
+shared by the API server and the snapshot scheduler of the controller.
+*/
+
+package volume
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	log "github.com/golang/glog"
+	c "github.com/opensds/opensds/pkg/context"
+	"github.com/opensds/opensds/pkg/db"
+	"github.com/opensds/opensds/pkg/model"
+	"github.com/opensds/opensds/pkg/quota"
+	"github.com/opensds/opensds/pkg/utils"
+	"github.com/opensds/opensds/pkg/utils/constants"
+	uuid "github.com/satori/go.uuid"
+)
+
+// CreateSnapshotDBEntry reserves the quota of the volume snapshot and stores
+// it into database with the status "creating".
+func CreateSnapshotDBEntry(ctx *c.Context, in *model.VolumeSnapshotSpec) (*model.VolumeSnapshotSpec, error) {
+	vol, err := db.C.GetVolume(ctx, in.VolumeId)
+	if err != nil {
+		log.Error("get volume failed in create volume snapshot method: ", err)
+		return nil, err
+	}
+	if vol.Status != model.VolumeAvailable && vol.Status != model.VolumeInUse {
+		var errMsg = "only the status of volume is available or in-use, the snapshot can be created"
+		log.Error(errMsg)
+		return nil, errors.New(errMsg)
+	}
+
+	if in.Id == "" {
+		in.Id = uuid.NewV4().String()
+	}
+	if in.CreatedAt == "" {
+		in.CreatedAt = time.Now().Format(constants.TimeFormat)
+	}
+
+	in.Status = model.VolumeSnapCreating
+	if err = quota.Reserve(ctx, ctx.TenantId, in.Id, model.QuotaSet{Snapshots: 1, Capacity: vol.Size}); err != nil {
+		log.Error("reserve quota failed in create volume snapshot method: ", err)
+		return nil, err
+	}
+	result, err := db.C.CreateVolumeSnapshot(ctx, in)
+	if err != nil {
+		quota.Rollback(ctx, ctx.TenantId, in.Id)
+		return nil, err
+	}
+	return result, nil
+}
+
+// DeleteSnapshotDBEntry just modifies the state of the volume snapshot to
+// be deleting in the DB, the real deletion operation would be executed in
+// another new thread.
+func DeleteSnapshotDBEntry(ctx *c.Context, in *model.VolumeSnapshotSpec) error {
+	validStatus := []string{model.VolumeSnapAvailable, model.VolumeSnapError,
+		model.VolumeSnapErrorDeleting}
+	if !utils.Contained(in.Status, validStatus) {
+		errMsg := fmt.Sprintf("only the volume snapshot with the status available, error, error_deleting can be deleted, the volume status is %s", in.Status)
+		log.Error(errMsg)
+		return errors.New(errMsg)
+	}
+	if in.GroupSnapshotId != "" {
+		errMsg := fmt.Sprintf("the volume snapshot belongs to group snapshot %s, it can only be deleted along with the group snapshot", in.GroupSnapshotId)
+		log.Error(errMsg)
+		return errors.New(errMsg)
+	}
+
+	// If volume id is invalid, it would mean that volume snapshot creation failed before the create method
+	// in storage driver was called, and delete its db entry directly.
+	_, err := db.C.GetVolume(ctx, in.VolumeId)
+	if err != nil {
+		if err := db.C.DeleteVolumeSnapshot(ctx, in.Id); err != nil {
+			log.Error("when delete volume snapshot in db:", err)
+			return err
+		}
+		quota.Release(ctx, in.TenantId, in.Id)
+		return nil
+	}
+
+	in.Status = model.VolumeSnapDeleting
+	_, err = db.C.UpdateVolumeSnapshot(ctx, in.Id, in)
+	if err != nil {
+		return err
+	}
+	return nil
+}
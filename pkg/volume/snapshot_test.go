@@ -0,0 +1,91 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package volume
+
+import (
+	"testing"
+
+	"github.com/opensds/opensds/pkg/context"
+	"github.com/opensds/opensds/pkg/db"
+	"github.com/opensds/opensds/pkg/model"
+	. "github.com/opensds/opensds/testutils/collection"
+	dbtest "github.com/opensds/opensds/testutils/db/testing"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateSnapshotDBEntry(t *testing.T) {
+	var newReq = func() *model.VolumeSnapshotSpec {
+		return &model.VolumeSnapshotSpec{
+			BaseModel: &model.BaseModel{},
+			VolumeId:  "bd5b12a8-a101-11e7-941e-d77981b584d8",
+			Name:      "sample-snapshot-01",
+			Size:      int64(1),
+		}
+	}
+
+	t.Run("Everything should work well", func(t *testing.T) {
+		req := newReq()
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetVolume", context.NewAdminContext(), req.VolumeId).Return(&model.VolumeSpec{
+			BaseModel: &model.BaseModel{Id: req.VolumeId}, Size: 1, Status: model.VolumeAvailable,
+		}, nil)
+		mockClient.On("GetQuota", mock.Anything, mock.Anything).Return(nil, model.NewNotFoundError("quota is not set"))
+		mockClient.On("GetQuotaUsage", mock.Anything, mock.Anything).Return(nil, model.NewNotFoundError("quota usage can't find"))
+		mockClient.On("UpdateQuotaUsage", mock.Anything, mock.Anything).Return(&SampleQuotaUsages[0], nil)
+		mockClient.On("CreateVolumeSnapshot", context.NewAdminContext(), req).Return(req, nil)
+		db.C = mockClient
+
+		result, err := CreateSnapshotDBEntry(context.NewAdminContext(), req)
+		if err != nil {
+			t.Fatalf("failed to create volume snapshot, err is %v", err)
+		}
+		if result.Id == "" || result.Status != model.VolumeSnapCreating {
+			t.Errorf("expected a creating snapshot with an id, got %+v", result)
+		}
+	})
+
+	t.Run("Volume must be available or in-use", func(t *testing.T) {
+		req := newReq()
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetVolume", context.NewAdminContext(), req.VolumeId).Return(&model.VolumeSpec{
+			BaseModel: &model.BaseModel{Id: req.VolumeId}, Size: 1, Status: model.VolumeCreating,
+		}, nil)
+		db.C = mockClient
+
+		if _, err := CreateSnapshotDBEntry(context.NewAdminContext(), req); err == nil {
+			t.Error("expected an error when the volume is creating")
+		}
+		mockClient.AssertNotCalled(t, "CreateVolumeSnapshot", mock.Anything, mock.Anything)
+	})
+}
+
+func TestDeleteSnapshotDBEntry(t *testing.T) {
+	var req = &model.VolumeSnapshotSpec{
+		BaseModel: &model.BaseModel{Id: "3769855c-a102-11e7-b772-17b880d2f537"},
+		VolumeId:  "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		Status:    model.VolumeSnapAvailable,
+	}
+	mockClient := new(dbtest.Client)
+	mockClient.On("GetVolume", context.NewAdminContext(), req.VolumeId).Return(&SampleVolumes[0], nil)
+	mockClient.On("UpdateVolumeSnapshot", context.NewAdminContext(), req.Id, req).Return(req, nil)
+	db.C = mockClient
+
+	if err := DeleteSnapshotDBEntry(context.NewAdminContext(), req); err != nil {
+		t.Fatalf("failed to delete volume snapshot, err is %v", err)
+	}
+	if req.Status != model.VolumeSnapDeleting {
+		t.Errorf("expected status %s, got %s", model.VolumeSnapDeleting, req.Status)
+	}
+}
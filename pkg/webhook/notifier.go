@@ -0,0 +1,235 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+This module implements the webhook notifications of the resource lifecycle
+events. Every status transition written by the controller is turned into an
+event, which is posted to the webhooks subscribing it and recorded in the
+delivery log of the webhooks.
+
+*/
+
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	log "github.com/golang/glog"
+	c "github.com/opensds/opensds/pkg/context"
+	"github.com/opensds/opensds/pkg/db"
+	"github.com/opensds/opensds/pkg/model"
+	"github.com/opensds/opensds/pkg/utils/constants"
+	uuid "github.com/satori/go.uuid"
+)
+
+const (
+	EventHeader     = "X-OpenSDS-Event"
+	DeliveryHeader  = "X-OpenSDS-Delivery"
+	SignatureHeader = "X-OpenSDS-Signature"
+)
+
+// Notifier delivers the events to the webhooks subscribing them.
+type Notifier struct {
+	c      db.Client
+	client *http.Client
+
+	maxAttempts int
+	// backoff is the interval before the first retry, it's doubled after
+	// every failed attempt.
+	backoff time.Duration
+	sleep   func(time.Duration)
+
+	wg sync.WaitGroup
+}
+
+// NewNotifier returns a notifier which posts every event at most maxAttempts
+// times, waiting backoff before the first retry and twice as long before
+// each of the next ones.
+func NewNotifier(client db.Client, maxAttempts int, backoff, timeout time.Duration) *Notifier {
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+	return &Notifier{
+		c:           client,
+		client:      &http.Client{Timeout: timeout},
+		maxAttempts: maxAttempts,
+		backoff:     backoff,
+		sleep:       time.Sleep,
+	}
+}
+
+// Notify is a db.StatusHook which sends the status transition of the object
+// to the webhooks of its tenant. The events are delivered in background, so
+// the caller is never blocked by the receivers.
+func (n *Notifier) Notify(ctx *c.Context, in interface{}, previous, status string) {
+	event := NewEvent(in, previous, status)
+	if event == nil {
+		return
+	}
+
+	hooks, err := n.c.ListWebhooks(c.NewAdminContext())
+	if err != nil {
+		log.Errorf("list webhooks for event %s of %s failed: %v", event.Type, event.ResourceId, err)
+		return
+	}
+	for _, hook := range hooks {
+		if hook.TenantId != event.TenantId || !hook.Subscribes(event.Type) {
+			continue
+		}
+		dlv, err := n.c.CreateWebhookDelivery(c.NewAdminContext(), &model.WebhookDeliverySpec{
+			BaseModel: &model.BaseModel{},
+			TenantId:  hook.TenantId,
+			WebhookId: hook.Id,
+			Event:     *event,
+			Status:    model.WebhookDeliveryPending,
+		})
+		if err != nil {
+			log.Errorf("create delivery of event %s to webhook %s failed: %v", event.Type, hook.Id, err)
+			continue
+		}
+		n.wg.Add(1)
+		go func(hook *model.WebhookSpec, dlv *model.WebhookDeliverySpec) {
+			defer n.wg.Done()
+			n.deliver(hook, dlv)
+		}(hook, dlv)
+	}
+}
+
+// Wait blocks until all the events notified have been delivered or given up.
+func (n *Notifier) Wait() {
+	n.wg.Wait()
+}
+
+// deliver posts the event of the delivery to the webhook until it succeeds
+// or the attempts are used up, the delivery is updated after every attempt.
+func (n *Notifier) deliver(hook *model.WebhookSpec, dlv *model.WebhookDeliverySpec) {
+	body, err := json.Marshal(dlv.Event)
+	if err != nil {
+		log.Error("marshal event failed: ", err)
+		return
+	}
+
+	backoff := n.backoff
+	for dlv.Attempts < n.maxAttempts {
+		if dlv.Attempts > 0 {
+			n.sleep(backoff)
+			backoff *= 2
+		}
+		dlv.Attempts++
+		dlv.ResponseCode, err = n.post(hook, dlv, body)
+		switch {
+		case err == nil:
+			dlv.Status, dlv.ErrorMessage = model.WebhookDeliveryDelivered, ""
+		case dlv.Attempts < n.maxAttempts:
+			dlv.ErrorMessage = err.Error()
+		default:
+			dlv.Status, dlv.ErrorMessage = model.WebhookDeliveryFailed, err.Error()
+			log.Errorf("deliver event %s to webhook %s failed after %d attempts: %v",
+				dlv.Event.Type, hook.Id, dlv.Attempts, err)
+		}
+		n.update(dlv)
+		if err == nil {
+			return
+		}
+	}
+}
+
+func (n *Notifier) update(dlv *model.WebhookDeliverySpec) {
+	result, err := n.c.UpdateWebhookDelivery(c.NewAdminContext(), dlv.Id, dlv)
+	if err != nil {
+		log.Errorf("update webhook delivery %s failed: %v", dlv.Id, err)
+		return
+	}
+	dlv.Revision = result.Revision
+}
+
+// post sends the event to the webhook once, any 2xx response is regarded
+// as success.
+func (n *Notifier) post(hook *model.WebhookSpec, dlv *model.WebhookDeliverySpec, body []byte) (int, error) {
+	req, err := http.NewRequest(http.MethodPost, hook.Url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, dlv.Event.Type)
+	req.Header.Set(DeliveryHeader, dlv.Id)
+	if hook.Secret != "" {
+		req.Header.Set(SignatureHeader, Sign(hook.Secret, body))
+	}
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("webhook responded %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
+
+// Sign returns the signature of the body sent in the X-OpenSDS-Signature
+// header, which is the hex encoded HMAC-SHA256 of the body keyed by secret.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// NewEvent returns the event of the status transition of the object, nil is
+// returned if the object isn't owned by any tenant.
+func NewEvent(in interface{}, previous, status string) *model.EventSpec {
+	var resourceType, resourceId, tenantId string
+	switch in := in.(type) {
+	case *model.VolumeSpec:
+		resourceType, resourceId, tenantId = model.OperationResourceVolume, in.Id, in.TenantId
+	case *model.VolumeSnapshotSpec:
+		resourceType, resourceId, tenantId = model.OperationResourceSnapshot, in.Id, in.TenantId
+	case *model.VolumeAttachmentSpec:
+		resourceType, resourceId, tenantId = model.OperationResourceAttachment, in.Id, in.TenantId
+	case *model.BackupSpec:
+		resourceType, resourceId, tenantId = model.OperationResourceBackup, in.Id, in.TenantId
+	case *model.ReplicationSpec:
+		resourceType, resourceId, tenantId = model.OperationResourceReplication, in.Id, in.TenantId
+	case *model.VolumeGroupSpec:
+		resourceType, resourceId, tenantId = model.OperationResourceVolumeGroup, in.Id, in.TenantId
+	case *model.FileShareSpec:
+		resourceType, resourceId, tenantId = model.OperationResourceFileShare, in.Id, in.TenantId
+	case *model.FileShareSnapshotSpec:
+		resourceType, resourceId, tenantId = model.OperationResourceFileShareSnapshot, in.Id, in.TenantId
+	case *model.FileShareAclSpec:
+		resourceType, resourceId, tenantId = model.OperationResourceFileShareAcl, in.Id, in.TenantId
+	default:
+		// Docks and pools belong to the administrators only.
+		return nil
+	}
+	return &model.EventSpec{
+		Id:             uuid.NewV4().String(),
+		Type:           resourceType + "." + status,
+		ResourceType:   resourceType,
+		ResourceId:     resourceId,
+		TenantId:       tenantId,
+		PreviousStatus: previous,
+		Status:         status,
+		Time:           time.Now().Format(constants.TimeFormat),
+	}
+}
@@ -0,0 +1,240 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	c "github.com/opensds/opensds/pkg/context"
+	"github.com/opensds/opensds/pkg/model"
+	dbtest "github.com/opensds/opensds/testutils/db/testing"
+	"github.com/stretchr/testify/mock"
+)
+
+// receiver is a webhook endpoint which responds the codes in turn, the last
+// code is repeated once the others are used up.
+type receiver struct {
+	sync.Mutex
+	codes    []int
+	requests []*http.Request
+	bodies   [][]byte
+}
+
+func (r *receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.Lock()
+	defer r.Unlock()
+	body, _ := ioutil.ReadAll(req.Body)
+	r.requests = append(r.requests, req)
+	r.bodies = append(r.bodies, body)
+	code := r.codes[0]
+	if len(r.codes) > 1 {
+		r.codes = r.codes[1:]
+	}
+	w.WriteHeader(code)
+}
+
+// deliveryLog records the deliveries written by the notifier.
+type deliveryLog struct {
+	sync.Mutex
+	updates []model.WebhookDeliverySpec
+}
+
+func newMockClient(hooks []*model.WebhookSpec, dlvs *deliveryLog) *dbtest.Client {
+	m := new(dbtest.Client)
+	m.On("ListWebhooks", c.NewAdminContext()).Return(hooks, nil)
+	m.On("CreateWebhookDelivery", c.NewAdminContext(), mock.Anything).Return(
+		func(ctx *c.Context, dlv *model.WebhookDeliverySpec) *model.WebhookDeliverySpec {
+			dlv.Id = "delivery-" + dlv.WebhookId
+			return dlv
+		}, nil)
+	m.On("UpdateWebhookDelivery", c.NewAdminContext(), mock.Anything, mock.Anything).Return(
+		func(ctx *c.Context, id string, dlv *model.WebhookDeliverySpec) *model.WebhookDeliverySpec {
+			dlvs.Lock()
+			defer dlvs.Unlock()
+			dlvs.updates = append(dlvs.updates, *dlv)
+			return dlv
+		}, nil)
+	return m
+}
+
+func newTestNotifier(m *dbtest.Client, maxAttempts int, slept *[]time.Duration) *Notifier {
+	n := NewNotifier(m, maxAttempts, time.Second, 5*time.Second)
+	n.sleep = func(d time.Duration) { *slept = append(*slept, d) }
+	return n
+}
+
+var sampleVolume = &model.VolumeSpec{
+	BaseModel: &model.BaseModel{Id: "bd5b12a8-a101-11e7-941e-d77981b584d8"},
+	TenantId:  "tenant",
+	Status:    model.VolumeAvailable,
+}
+
+func TestNotifySignsEvent(t *testing.T) {
+	r := &receiver{codes: []int{http.StatusNoContent}}
+	srv := httptest.NewServer(r)
+	defer srv.Close()
+
+	var dlvs deliveryLog
+	var slept []time.Duration
+	hook := &model.WebhookSpec{
+		BaseModel: &model.BaseModel{Id: "hook"}, TenantId: "tenant", Url: srv.URL, Secret: "secret",
+	}
+	n := newTestNotifier(newMockClient([]*model.WebhookSpec{hook}, &dlvs), 3, &slept)
+	n.Notify(c.NewAdminContext(), sampleVolume, model.VolumeCreating, model.VolumeAvailable)
+	n.Wait()
+
+	if len(r.requests) != 1 {
+		t.Fatalf("expected 1 request, got %d", len(r.requests))
+	}
+	req, body := r.requests[0], r.bodies[0]
+	if got := req.Header.Get(SignatureHeader); got != Sign("secret", body) {
+		t.Errorf("expected signature %s, got %s", Sign("secret", body), got)
+	}
+	if got := req.Header.Get(EventHeader); got != "volume.available" {
+		t.Errorf("expected event volume.available, got %s", got)
+	}
+	if got := req.Header.Get(DeliveryHeader); got != "delivery-hook" {
+		t.Errorf("expected delivery delivery-hook, got %s", got)
+	}
+	var event model.EventSpec
+	if err := json.Unmarshal(body, &event); err != nil {
+		t.Fatal(err)
+	}
+	if event.ResourceId != sampleVolume.Id || event.PreviousStatus != model.VolumeCreating ||
+		event.Status != model.VolumeAvailable || event.TenantId != "tenant" {
+		t.Errorf("unexpected event %+v", event)
+	}
+
+	if len(dlvs.updates) != 1 {
+		t.Fatalf("expected 1 delivery update, got %d", len(dlvs.updates))
+	}
+	if dlv := dlvs.updates[0]; dlv.Status != model.WebhookDeliveryDelivered || dlv.Attempts != 1 ||
+		dlv.ResponseCode != http.StatusNoContent {
+		t.Errorf("unexpected delivery %+v", dlv)
+	}
+	if len(slept) != 0 {
+		t.Errorf("expected no retry, slept %v", slept)
+	}
+}
+
+func TestNotifyRetriesWithBackoff(t *testing.T) {
+	r := &receiver{codes: []int{http.StatusInternalServerError, http.StatusBadGateway, http.StatusOK}}
+	srv := httptest.NewServer(r)
+	defer srv.Close()
+
+	var dlvs deliveryLog
+	var slept []time.Duration
+	hook := &model.WebhookSpec{BaseModel: &model.BaseModel{Id: "hook"}, TenantId: "tenant", Url: srv.URL}
+	n := newTestNotifier(newMockClient([]*model.WebhookSpec{hook}, &dlvs), 5, &slept)
+	n.Notify(c.NewAdminContext(), sampleVolume, model.VolumeCreating, model.VolumeAvailable)
+	n.Wait()
+
+	if len(r.requests) != 3 {
+		t.Fatalf("expected 3 requests, got %d", len(r.requests))
+	}
+	if r.requests[0].Header.Get(SignatureHeader) != "" {
+		t.Error("expected no signature without secret")
+	}
+	if expected := []time.Duration{time.Second, 2 * time.Second}; !reflect.DeepEqual(slept, expected) {
+		t.Errorf("expected backoff %v, got %v", expected, slept)
+	}
+	var statuses []string
+	for _, dlv := range dlvs.updates {
+		statuses = append(statuses, dlv.Status)
+	}
+	expected := []string{model.WebhookDeliveryPending, model.WebhookDeliveryPending, model.WebhookDeliveryDelivered}
+	if !reflect.DeepEqual(statuses, expected) {
+		t.Errorf("expected delivery statuses %v, got %v", expected, statuses)
+	}
+	if dlv := dlvs.updates[1]; dlv.ResponseCode != http.StatusBadGateway || dlv.ErrorMessage == "" {
+		t.Errorf("expected the failed attempt to be recorded, got %+v", dlv)
+	}
+	if dlv := dlvs.updates[2]; dlv.Attempts != 3 || dlv.ErrorMessage != "" {
+		t.Errorf("unexpected delivery %+v", dlv)
+	}
+}
+
+func TestNotifyGivesUp(t *testing.T) {
+	r := &receiver{codes: []int{http.StatusServiceUnavailable}}
+	srv := httptest.NewServer(r)
+	defer srv.Close()
+
+	var dlvs deliveryLog
+	var slept []time.Duration
+	hook := &model.WebhookSpec{BaseModel: &model.BaseModel{Id: "hook"}, TenantId: "tenant", Url: srv.URL}
+	n := newTestNotifier(newMockClient([]*model.WebhookSpec{hook}, &dlvs), 3, &slept)
+	n.Notify(c.NewAdminContext(), sampleVolume, model.VolumeCreating, model.VolumeError)
+	n.Wait()
+
+	if len(r.requests) != 3 {
+		t.Fatalf("expected 3 requests, got %d", len(r.requests))
+	}
+	last := dlvs.updates[len(dlvs.updates)-1]
+	if last.Status != model.WebhookDeliveryFailed || last.Attempts != 3 ||
+		last.ResponseCode != http.StatusServiceUnavailable {
+		t.Errorf("unexpected delivery %+v", last)
+	}
+}
+
+func TestNotifyFiltersWebhooks(t *testing.T) {
+	r := &receiver{codes: []int{http.StatusOK}}
+	srv := httptest.NewServer(r)
+	defer srv.Close()
+
+	hooks := []*model.WebhookSpec{
+		{BaseModel: &model.BaseModel{Id: "all"}, TenantId: "tenant", Url: srv.URL},
+		{BaseModel: &model.BaseModel{Id: "volumes"}, TenantId: "tenant", Url: srv.URL, Events: []string{"volume.*"}},
+		{BaseModel: &model.BaseModel{Id: "errors"}, TenantId: "tenant", Url: srv.URL, Events: []string{"volume.error"}},
+		{BaseModel: &model.BaseModel{Id: "snapshots"}, TenantId: "tenant", Url: srv.URL, Events: []string{"snapshot.*"}},
+		{BaseModel: &model.BaseModel{Id: "other"}, TenantId: "other", Url: srv.URL},
+	}
+	var dlvs deliveryLog
+	var slept []time.Duration
+	m := newMockClient(hooks, &dlvs)
+	n := newTestNotifier(m, 1, &slept)
+	n.Notify(c.NewAdminContext(), sampleVolume, model.VolumeCreating, model.VolumeAvailable)
+	n.Wait()
+
+	var delivered []string
+	for _, call := range m.Calls {
+		if call.Method == "CreateWebhookDelivery" {
+			delivered = append(delivered, call.Arguments[1].(*model.WebhookDeliverySpec).WebhookId)
+		}
+	}
+	if expected := []string{"all", "volumes"}; !reflect.DeepEqual(delivered, expected) {
+		t.Errorf("expected deliveries to %v, got %v", expected, delivered)
+	}
+	if len(r.requests) != 2 {
+		t.Errorf("expected 2 requests, got %d", len(r.requests))
+	}
+}
+
+func TestNewEvent(t *testing.T) {
+	replica := &model.ReplicationSpec{BaseModel: &model.BaseModel{Id: "replica"}, TenantId: "tenant"}
+	event := NewEvent(replica, model.ReplicationCreating, model.ReplicationAvailable)
+	if event == nil || event.Type != "replication.available" || event.ResourceId != "replica" {
+		t.Errorf("unexpected event %+v", event)
+	}
+	if event := NewEvent(&model.DockSpec{BaseModel: &model.BaseModel{}}, "", "available"); event != nil {
+		t.Errorf("expected no event of dock, got %+v", event)
+	}
+}
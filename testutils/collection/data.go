@@ -171,7 +171,12 @@ var (
 			BaseModel: &model.BaseModel{
 				Id: "d2975ebe-d82c-430f-b28e-f373746a71ca",
 			},
-			Description: "This is a sample Acl for testing",
+			FileShareId:      "d2975ebe-d82c-430f-b28e-f373746a71ca",
+			Type:             "ip",
+			AccessTo:         []string{"10.0.0.0/24"},
+			AccessCapability: []string{"Read", "Write"},
+			Description:      "This is a sample Acl for testing",
+			Status:           "available",
 		},
 		{
 			BaseModel: &model.BaseModel{
@@ -284,6 +289,40 @@ var (
 		},
 	}
 
+	SampleBackups = []model.BackupSpec{
+		{
+			BaseModel: &model.BaseModel{
+				Id: "5d8c2a5e-5e0f-11e9-8cf1-6f3c2b7e2c11",
+			},
+			Name:         "sample-backup-01",
+			Description:  "This is the first sample backup for testing",
+			VolumeId:     "bd5b12a8-a101-11e7-941e-d77981b584d8",
+			Size:         int64(1),
+			PoolId:       "084bf71e-a102-11e7-88a8-e31fe6d52248",
+			BackupDriver: "multi-cloud",
+			Status:       "available",
+			Metadata: map[string]string{
+				"bucket": "sample-bucket",
+			},
+		},
+		{
+			BaseModel: &model.BaseModel{
+				Id: "6a1f7e8c-5e0f-11e9-9b8e-2b4f1d0c9a77",
+			},
+			Name:         "sample-backup-02",
+			Description:  "This is the second sample backup for testing",
+			VolumeId:     "bd5b12a8-a101-11e7-941e-d77981b584d8",
+			SnapshotId:   "3769855c-a102-11e7-b772-17b880d2f537",
+			Size:         int64(1),
+			PoolId:       "084bf71e-a102-11e7-88a8-e31fe6d52248",
+			BackupDriver: "multi-cloud",
+			Status:       "available",
+			Metadata: map[string]string{
+				"bucket": "sample-bucket",
+			},
+		},
+	}
+
 	SampleReplications = []model.ReplicationSpec{
 		{
 			BaseModel: &model.BaseModel{
@@ -320,6 +359,113 @@ var (
 			PoolId:      "084bf71e-a102-11e7-88a8-e31fe6d52248",
 		},
 	}
+
+	SampleGroupSnapshots = []model.VolumeGroupSnapshotSpec{
+		{
+			BaseModel: &model.BaseModel{
+				Id: "9c8a3d2e-7f41-11e9-a6b2-3f5e8c1d4b90",
+			},
+			Name:        "sample-group-snapshot-01",
+			Description: "This is the first sample group snapshot for testing",
+			Status:      "available",
+			GroupId:     "3769855c-a102-11e7-b772-17b880d2f555",
+			Snapshots:   []string{"3769855c-a102-11e7-b772-17b880d2f537"},
+		},
+	}
+
+	SampleOperations = []model.OperationSpec{
+		{
+			BaseModel: &model.BaseModel{
+				Id: "8b3ac17a-4c4f-11e9-9d5b-7b2ad6b2b1ea",
+			},
+			Action:       "CreateVolume",
+			ResourceType: "volume",
+			ResourceId:   "bd5b12a8-a101-11e7-941e-d77981b584d8",
+			DockId:       "b7602e18-771e-11e7-8f38-dbd6d291f4e0",
+			Status:       "succeeded",
+		},
+		{
+			BaseModel: &model.BaseModel{
+				Id: "b1c5bd6a-4c4f-11e9-a2d4-8f54d5a1f0c3",
+			},
+			Action:       "DeleteVolume",
+			ResourceType: "volume",
+			ResourceId:   "bd5b12a8-a101-11e7-941e-d77981b584d8",
+			DockId:       "b7602e18-771e-11e7-8f38-dbd6d291f4e0",
+			Status:       "failed",
+			ErrorMessage: "volume is in use",
+		},
+	}
+
+	SampleQuotas = []model.QuotaSpec{
+		{
+			BaseModel: &model.BaseModel{
+				Id: "e93b4c0934da416eb9c8d120c5d04d96",
+			},
+			TenantId: "e93b4c0934da416eb9c8d120c5d04d96",
+			QuotaSet: model.QuotaSet{
+				Volumes:    10,
+				Snapshots:  20,
+				Capacity:   1000,
+				FileShares: -1,
+			},
+		},
+	}
+
+	SampleQuotaUsages = []model.QuotaUsageSpec{
+		{
+			BaseModel: &model.BaseModel{
+				Id: "e93b4c0934da416eb9c8d120c5d04d96",
+			},
+			TenantId: "e93b4c0934da416eb9c8d120c5d04d96",
+			Charges: map[string]*model.QuotaCharge{
+				"bd5b12a8-a101-11e7-941e-d77981b584d8": {
+					InUse: model.QuotaSet{Volumes: 1, Capacity: 1},
+				},
+				"3769855c-a102-11e7-b772-17b880d2f537": {
+					InUse: model.QuotaSet{Snapshots: 1, Capacity: 1},
+				},
+			},
+		},
+	}
+
+	SampleWebhooks = []model.WebhookSpec{
+		{
+			BaseModel: &model.BaseModel{
+				Id:        "4a3f2d3c-6b1e-11e9-9a4a-6f7c1f4f2b1a",
+				CreatedAt: "2019-05-01T08:00:00",
+			},
+			TenantId: "ef305038-cd12-4f3b-90bd-0612f83e14ee",
+			Name:     "volume-watcher",
+			Url:      "https://hooks.example.com/opensds",
+			Events:   []string{"volume.*"},
+			Secret:   "s3cr3t",
+		},
+	}
+
+	SampleWebhookDeliveries = []model.WebhookDeliverySpec{
+		{
+			BaseModel: &model.BaseModel{
+				Id:        "8d7e6c5b-6b1e-11e9-9a4a-6f7c1f4f2b1a",
+				CreatedAt: "2019-05-01T08:05:00",
+			},
+			TenantId:  "ef305038-cd12-4f3b-90bd-0612f83e14ee",
+			WebhookId: "4a3f2d3c-6b1e-11e9-9a4a-6f7c1f4f2b1a",
+			Event: model.EventSpec{
+				Id:             "9e8f7a6b-6b1e-11e9-9a4a-6f7c1f4f2b1a",
+				Type:           "volume.available",
+				ResourceType:   "volume",
+				ResourceId:     "bd5b12a8-a101-11e7-941e-d77981b584d8",
+				TenantId:       "ef305038-cd12-4f3b-90bd-0612f83e14ee",
+				PreviousStatus: "creating",
+				Status:         "available",
+				Time:           "2019-05-01T08:05:00",
+			},
+			Status:       "delivered",
+			Attempts:     1,
+			ResponseCode: 200,
+		},
+	}
 )
 
 // The Byte*** variable here is designed for unit test in client package.
@@ -546,6 +692,26 @@ var (
 		}
 	]`
 
+	ByteGroupSnapshot = `{
+		"id": "9c8a3d2e-7f41-11e9-a6b2-3f5e8c1d4b90",
+		"name": "sample-group-snapshot-01",
+		"description": "This is the first sample group snapshot for testing",
+		"status": "available",
+		"groupId": "3769855c-a102-11e7-b772-17b880d2f555",
+		"snapshots": ["3769855c-a102-11e7-b772-17b880d2f537"]
+	}`
+
+	ByteGroupSnapshots = `[
+		{
+			"id": "9c8a3d2e-7f41-11e9-a6b2-3f5e8c1d4b90",
+			"name": "sample-group-snapshot-01",
+			"description": "This is the first sample group snapshot for testing",
+			"status": "available",
+			"groupId": "3769855c-a102-11e7-b772-17b880d2f555",
+			"snapshots": ["3769855c-a102-11e7-b772-17b880d2f537"]
+		}
+	]`
+
 	ByteSnapshots = `[
 		{
 			"id": "3769855c-a102-11e7-b772-17b880d2f537",
@@ -565,6 +731,50 @@ var (
 		}
 	]`
 
+	ByteBackup = `{
+		"id": "5d8c2a5e-5e0f-11e9-8cf1-6f3c2b7e2c11",
+		"name": "sample-backup-01",
+		"description": "This is the first sample backup for testing",
+		"volumeId": "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		"size": 1,
+		"poolId": "084bf71e-a102-11e7-88a8-e31fe6d52248",
+		"backupDriver": "multi-cloud",
+		"status": "available",
+		"metadata": {
+			"bucket": "sample-bucket"
+		}
+	}`
+
+	ByteBackups = `[
+		{
+			"id": "5d8c2a5e-5e0f-11e9-8cf1-6f3c2b7e2c11",
+			"name": "sample-backup-01",
+			"description": "This is the first sample backup for testing",
+			"volumeId": "bd5b12a8-a101-11e7-941e-d77981b584d8",
+			"size": 1,
+			"poolId": "084bf71e-a102-11e7-88a8-e31fe6d52248",
+			"backupDriver": "multi-cloud",
+			"status": "available",
+			"metadata": {
+				"bucket": "sample-bucket"
+			}
+		},
+		{
+			"id": "6a1f7e8c-5e0f-11e9-9b8e-2b4f1d0c9a77",
+			"name": "sample-backup-02",
+			"description": "This is the second sample backup for testing",
+			"volumeId": "bd5b12a8-a101-11e7-941e-d77981b584d8",
+			"snapshotId": "3769855c-a102-11e7-b772-17b880d2f537",
+			"size": 1,
+			"poolId": "084bf71e-a102-11e7-88a8-e31fe6d52248",
+			"backupDriver": "multi-cloud",
+			"status": "available",
+			"metadata": {
+				"bucket": "sample-bucket"
+			}
+		}
+	]`
+
 	ByteReplication = `{
 			"id": "c299a978-4f3e-11e8-8a5c-977218a83359",
 			"primaryVolumeId": "bd5b12a8-a101-11e7-941e-d77981b584d8",
@@ -596,12 +806,180 @@ var (
 		}
 	]`
 
+	ByteOperation = `{
+		"id": "8b3ac17a-4c4f-11e9-9d5b-7b2ad6b2b1ea",
+		"action": "CreateVolume",
+		"resourceType": "volume",
+		"resourceId": "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		"dockId": "b7602e18-771e-11e7-8f38-dbd6d291f4e0",
+		"status": "succeeded"
+	}`
+
+	ByteOperations = `[
+		{
+			"id": "8b3ac17a-4c4f-11e9-9d5b-7b2ad6b2b1ea",
+			"action": "CreateVolume",
+			"resourceType": "volume",
+			"resourceId": "bd5b12a8-a101-11e7-941e-d77981b584d8",
+			"dockId": "b7602e18-771e-11e7-8f38-dbd6d291f4e0",
+			"status": "succeeded"
+		},
+		{
+			"id": "b1c5bd6a-4c4f-11e9-a2d4-8f54d5a1f0c3",
+			"action": "DeleteVolume",
+			"resourceType": "volume",
+			"resourceId": "bd5b12a8-a101-11e7-941e-d77981b584d8",
+			"dockId": "b7602e18-771e-11e7-8f38-dbd6d291f4e0",
+			"status": "failed",
+			"errorMessage": "volume is in use"
+		}
+	]`
+
 	ByteVersion = `{
 		"name": "v1beta",
 		"status": "SUPPORTED",
 		"updatedAt": "2017-04-10T14:36:58.014Z"
 	}`
 
+	ByteQuota = `{
+		"id": "e93b4c0934da416eb9c8d120c5d04d96",
+		"tenantId": "e93b4c0934da416eb9c8d120c5d04d96",
+		"volumes": 10,
+		"snapshots": 20,
+		"capacity": 1000,
+		"fileShares": -1,
+		"inUse": {
+			"volumes": 1,
+			"snapshots": 1,
+			"capacity": 2,
+			"fileShares": 0
+		},
+		"reserved": {
+			"volumes": 0,
+			"snapshots": 0,
+			"capacity": 0,
+			"fileShares": 0
+		}
+	}`
+
+	ByteWebhook = `{
+		"id": "4a3f2d3c-6b1e-11e9-9a4a-6f7c1f4f2b1a",
+		"createdAt": "2019-05-01T08:00:00",
+		"tenantId": "ef305038-cd12-4f3b-90bd-0612f83e14ee",
+		"name": "volume-watcher",
+		"url": "https://hooks.example.com/opensds",
+		"events": ["volume.*"]
+	}`
+
+	ByteWebhooks = `[
+		{
+			"id": "4a3f2d3c-6b1e-11e9-9a4a-6f7c1f4f2b1a",
+			"createdAt": "2019-05-01T08:00:00",
+			"tenantId": "ef305038-cd12-4f3b-90bd-0612f83e14ee",
+			"name": "volume-watcher",
+			"url": "https://hooks.example.com/opensds",
+			"events": ["volume.*"]
+		}
+	]`
+
+	ByteWebhookDeliveries = `[
+		{
+			"id": "8d7e6c5b-6b1e-11e9-9a4a-6f7c1f4f2b1a",
+			"createdAt": "2019-05-01T08:05:00",
+			"tenantId": "ef305038-cd12-4f3b-90bd-0612f83e14ee",
+			"webhookId": "4a3f2d3c-6b1e-11e9-9a4a-6f7c1f4f2b1a",
+			"event": {
+				"id": "9e8f7a6b-6b1e-11e9-9a4a-6f7c1f4f2b1a",
+				"type": "volume.available",
+				"resourceType": "volume",
+				"resourceId": "bd5b12a8-a101-11e7-941e-d77981b584d8",
+				"tenantId": "ef305038-cd12-4f3b-90bd-0612f83e14ee",
+				"previousStatus": "creating",
+				"status": "available",
+				"time": "2019-05-01T08:05:00"
+			},
+			"status": "delivered",
+			"attempts": 1,
+			"responseCode": 200
+		}
+	]`
+
+	ByteWatchEvents = ": keepalive\n\n" +
+		"id: 8\nevent: ADDED\n" +
+		`data: {"type":"ADDED","resourceType":"volume","resourceVersion":8,` +
+		`"object":{"id":"bd5b12a8-a101-11e7-941e-d77981b584d8","name":"sample-volume","status":"creating","revision":8}}` + "\n\n" +
+		"id: 9\nevent: DELETED\n" +
+		`data: {"type":"DELETED","resourceType":"volume","resourceVersion":9,` +
+		`"object":{"id":"bd5b12a8-a101-11e7-941e-d77981b584d8","name":"sample-volume","status":"deleting","revision":9}}` + "\n\n" +
+		"event: ERROR\n" +
+		`data: {"type":"ERROR","errorMessage":"mvcc: required revision has been compacted"}` + "\n\n"
+
+	ByteFileShare = `{
+		"id": "d2975ebe-d82c-430f-b28e-f373746a71ca",
+		"name": "sample-fileshare",
+		"description": "This is a sample fileshare for testing",
+		"size": 1,
+		"status": "available",
+		"poolId": "a5965ebe-dg2c-434t-b28e-f373746a71ca",
+		"profileId": "b3585ebe-c42c-120g-b28e-f373746a71ca",
+		"exportLocations": ["0.0.0.0"]
+	}`
+
+	ByteFileShares = `[
+		{
+			"id": "d2975ebe-d82c-430f-b28e-f373746a71ca",
+			"name": "sample-fileshare",
+			"description": "This is a sample fileshare for testing",
+			"size": 1,
+			"status": "available",
+			"poolId": "a5965ebe-dg2c-434t-b28e-f373746a71ca",
+			"profileId": "b3585ebe-c42c-120g-b28e-f373746a71ca",
+			"exportLocations": ["0.0.0.0"]
+		}
+	]`
+
+	ByteFileShareSnapshot = `{
+		"id": "3769855c-a102-11e7-b772-17b880d2f537",
+		"fileshareId": "d2975ebe-d82c-430f-b28e-f373746a71ca",
+		"name": "sample-snapshot-01",
+		"description": "This is the first sample snapshot for testing",
+		"snapshotSize": 1,
+		"status": "available"
+	}`
+
+	ByteFileShareSnapshots = `[
+		{
+			"id": "3769855c-a102-11e7-b772-17b880d2f537",
+			"fileshareId": "d2975ebe-d82c-430f-b28e-f373746a71ca",
+			"name": "sample-snapshot-01",
+			"description": "This is the first sample snapshot for testing",
+			"snapshotSize": 1,
+			"status": "available"
+		}
+	]`
+
+	ByteFileShareAcl = `{
+		"id": "d2975ebe-d82c-430f-b28e-f373746a71ca",
+		"fileshareId": "d2975ebe-d82c-430f-b28e-f373746a71ca",
+		"type": "ip",
+		"accessTo": ["10.0.0.0/24"],
+		"accessCapability": ["Read", "Write"],
+		"description": "This is a sample Acl for testing",
+		"status": "available"
+	}`
+
+	ByteFileShareAcls = `[
+		{
+			"id": "d2975ebe-d82c-430f-b28e-f373746a71ca",
+			"fileshareId": "d2975ebe-d82c-430f-b28e-f373746a71ca",
+			"type": "ip",
+			"accessTo": ["10.0.0.0/24"],
+			"accessCapability": ["Read", "Write"],
+			"description": "This is a sample Acl for testing",
+			"status": "available"
+		}
+	]`
+
 	ByteVersions = `[
 		{
 			"name": "v1beta",
@@ -732,6 +1110,47 @@ var (
 		}`,
 	}
 
+	StringSliceBackups = []string{
+		`{
+			"id":           "5d8c2a5e-5e0f-11e9-8cf1-6f3c2b7e2c11",
+			"name":         "sample-backup-01",
+			"description":  "This is the first sample backup for testing",
+			"volumeId":     "bd5b12a8-a101-11e7-941e-d77981b584d8",
+			"size":         1,
+			"poolId":       "084bf71e-a102-11e7-88a8-e31fe6d52248",
+			"backupDriver": "multi-cloud",
+			"status":       "available",
+			"metadata": {
+				"bucket": "sample-bucket"
+			}
+		}`,
+		`{
+			"id":           "6a1f7e8c-5e0f-11e9-9b8e-2b4f1d0c9a77",
+			"name":         "sample-backup-02",
+			"description":  "This is the second sample backup for testing",
+			"volumeId":     "bd5b12a8-a101-11e7-941e-d77981b584d8",
+			"snapshotId":   "3769855c-a102-11e7-b772-17b880d2f537",
+			"size":         1,
+			"poolId":       "084bf71e-a102-11e7-88a8-e31fe6d52248",
+			"backupDriver": "multi-cloud",
+			"status":       "available",
+			"metadata": {
+				"bucket": "sample-bucket"
+			}
+		}`,
+	}
+
+	StringSliceGroupSnapshots = []string{
+		`{
+			"id":          "9c8a3d2e-7f41-11e9-a6b2-3f5e8c1d4b90",
+			"name":        "sample-group-snapshot-01",
+			"description": "This is the first sample group snapshot for testing",
+			"status":      "available",
+			"groupId":     "3769855c-a102-11e7-b772-17b880d2f555",
+			"snapshots":   ["3769855c-a102-11e7-b772-17b880d2f537"]
+		}`,
+	}
+
 	StringSliceReplications = []string{
 		`{
 			"id":                "c299a978-4f3e-11e8-8a5c-977218a83359",
@@ -752,4 +1171,35 @@ var (
 			"profileId":         "1106b972-66ef-11e7-b172-db03f3689c9c"
 		}`,
 	}
+
+	StringSliceOperations = []string{
+		`{
+			"id":           "8b3ac17a-4c4f-11e9-9d5b-7b2ad6b2b1ea",
+			"action":       "CreateVolume",
+			"resourceType": "volume",
+			"resourceId":   "bd5b12a8-a101-11e7-941e-d77981b584d8",
+			"dockId":       "b7602e18-771e-11e7-8f38-dbd6d291f4e0",
+			"status":       "succeeded"
+		}`,
+		`{
+			"id":           "b1c5bd6a-4c4f-11e9-a2d4-8f54d5a1f0c3",
+			"action":       "DeleteVolume",
+			"resourceType": "volume",
+			"resourceId":   "bd5b12a8-a101-11e7-941e-d77981b584d8",
+			"dockId":       "b7602e18-771e-11e7-8f38-dbd6d291f4e0",
+			"status":       "failed",
+			"errorMessage": "volume is in use"
+		}`,
+	}
+
+	StringSliceQuotas = []string{
+		`{
+			"id":         "e93b4c0934da416eb9c8d120c5d04d96",
+			"tenantId":   "e93b4c0934da416eb9c8d120c5d04d96",
+			"volumes":    10,
+			"snapshots":  20,
+			"capacity":   1000,
+			"fileShares": -1
+		}`,
+	}
 )
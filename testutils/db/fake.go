@@ -95,6 +95,11 @@ func (fc *FakeDbClient) UpdateFileShare(ctx *c.Context, fshare *model.FileShareS
 	return &SampleFileShares[0], nil
 }
 
+// UpdateFileShareAcl
+func (fc *FakeDbClient) UpdateFileShareAcl(ctx *c.Context, acl *model.FileShareAclSpec) (*model.FileShareAclSpec, error) {
+	return &SampleFileSharesAcl[0], nil
+}
+
 // DeleteFileShare
 func (fc *FakeDbClient) DeleteFileShare(ctx *c.Context, fshareID string) error {
 	return nil
@@ -535,6 +540,157 @@ func (fc *FakeDbClient) ListVolumeGroups(ctx *c.Context) ([]*model.VolumeGroupSp
 	return vgs, nil
 }
 
+func (fc *FakeDbClient) CreateVolumeGroupSnapshot(ctx *c.Context, gs *model.VolumeGroupSnapshotSpec) (*model.VolumeGroupSnapshotSpec, error) {
+	return &SampleGroupSnapshots[0], nil
+}
+
+func (fc *FakeDbClient) GetVolumeGroupSnapshot(ctx *c.Context, gsId string) (*model.VolumeGroupSnapshotSpec, error) {
+	return &SampleGroupSnapshots[0], nil
+}
+
+func (fc *FakeDbClient) ListVolumeGroupSnapshots(ctx *c.Context) ([]*model.VolumeGroupSnapshotSpec, error) {
+	var gss = []*model.VolumeGroupSnapshotSpec{
+		&SampleGroupSnapshots[0],
+	}
+	return gss, nil
+}
+
+func (fc *FakeDbClient) ListGroupSnapshotsByGroupId(ctx *c.Context, vgId string) ([]*model.VolumeGroupSnapshotSpec, error) {
+	return fc.ListVolumeGroupSnapshots(ctx)
+}
+
+func (fc *FakeDbClient) UpdateVolumeGroupSnapshot(ctx *c.Context, gsId string, gs *model.VolumeGroupSnapshotSpec) (*model.VolumeGroupSnapshotSpec, error) {
+	return &SampleGroupSnapshots[0], nil
+}
+
+func (fc *FakeDbClient) DeleteVolumeGroupSnapshot(ctx *c.Context, gsId string) error {
+	return nil
+}
+
 func (fc *FakeDbClient) VolumesToUpdate(ctx *c.Context, volumeList []*model.VolumeSpec) ([]*model.VolumeSpec, error) {
 	return nil, nil
 }
+
+func (fc *FakeDbClient) CreateOperation(ctx *c.Context, op *model.OperationSpec) (*model.OperationSpec, error) {
+	return &SampleOperations[0], nil
+}
+
+func (fc *FakeDbClient) GetOperation(ctx *c.Context, opId string) (*model.OperationSpec, error) {
+	return &SampleOperations[0], nil
+}
+
+func (fc *FakeDbClient) ListOperations(ctx *c.Context) ([]*model.OperationSpec, error) {
+	var ops = []*model.OperationSpec{
+		&SampleOperations[0], &SampleOperations[1],
+	}
+	return ops, nil
+}
+
+func (fc *FakeDbClient) ListOperationsWithFilter(ctx *c.Context, m map[string][]string) ([]*model.OperationSpec, error) {
+	var ops = []*model.OperationSpec{
+		&SampleOperations[0], &SampleOperations[1],
+	}
+	return ops, nil
+}
+
+func (fc *FakeDbClient) UpdateOperation(ctx *c.Context, opId string, op *model.OperationSpec) (*model.OperationSpec, error) {
+	return &SampleOperations[0], nil
+}
+
+func (fc *FakeDbClient) DeleteOperation(ctx *c.Context, opId string) error {
+	return nil
+}
+
+func (fc *FakeDbClient) CreateBackup(ctx *c.Context, backup *model.BackupSpec) (*model.BackupSpec, error) {
+	return &SampleBackups[0], nil
+}
+
+func (fc *FakeDbClient) GetBackup(ctx *c.Context, backupId string) (*model.BackupSpec, error) {
+	return &SampleBackups[0], nil
+}
+
+func (fc *FakeDbClient) ListBackups(ctx *c.Context) ([]*model.BackupSpec, error) {
+	var backups = []*model.BackupSpec{
+		&SampleBackups[0], &SampleBackups[1],
+	}
+	return backups, nil
+}
+
+func (fc *FakeDbClient) ListBackupsWithFilter(ctx *c.Context, m map[string][]string) ([]*model.BackupSpec, error) {
+	var backups = []*model.BackupSpec{
+		&SampleBackups[0], &SampleBackups[1],
+	}
+	return backups, nil
+}
+
+func (fc *FakeDbClient) UpdateBackup(ctx *c.Context, backupId string, backup *model.BackupSpec) (*model.BackupSpec, error) {
+	return &SampleBackups[0], nil
+}
+
+func (fc *FakeDbClient) DeleteBackup(ctx *c.Context, backupId string) error {
+	return nil
+}
+
+func (fc *FakeDbClient) GetQuota(ctx *c.Context, tenantId string) (*model.QuotaSpec, error) {
+	return &SampleQuotas[0], nil
+}
+
+func (fc *FakeDbClient) UpdateQuota(ctx *c.Context, quota *model.QuotaSpec) (*model.QuotaSpec, error) {
+	return &SampleQuotas[0], nil
+}
+
+func (fc *FakeDbClient) GetQuotaUsage(ctx *c.Context, tenantId string) (*model.QuotaUsageSpec, error) {
+	return &SampleQuotaUsages[0], nil
+}
+
+func (fc *FakeDbClient) UpdateQuotaUsage(ctx *c.Context, usage *model.QuotaUsageSpec) (*model.QuotaUsageSpec, error) {
+	return &SampleQuotaUsages[0], nil
+}
+
+func (fc *FakeDbClient) CreateWebhook(ctx *c.Context, hook *model.WebhookSpec) (*model.WebhookSpec, error) {
+	return &SampleWebhooks[0], nil
+}
+
+func (fc *FakeDbClient) GetWebhook(ctx *c.Context, hookId string) (*model.WebhookSpec, error) {
+	return &SampleWebhooks[0], nil
+}
+
+func (fc *FakeDbClient) ListWebhooks(ctx *c.Context) ([]*model.WebhookSpec, error) {
+	var hooks []*model.WebhookSpec
+	for i := range SampleWebhooks {
+		hooks = append(hooks, &SampleWebhooks[i])
+	}
+	return hooks, nil
+}
+
+func (fc *FakeDbClient) DeleteWebhook(ctx *c.Context, hookId string) error {
+	return nil
+}
+
+func (fc *FakeDbClient) CreateWebhookDelivery(ctx *c.Context, dlv *model.WebhookDeliverySpec) (*model.WebhookDeliverySpec, error) {
+	return &SampleWebhookDeliveries[0], nil
+}
+
+func (fc *FakeDbClient) UpdateWebhookDelivery(ctx *c.Context, dlvId string, dlv *model.WebhookDeliverySpec) (*model.WebhookDeliverySpec, error) {
+	return &SampleWebhookDeliveries[0], nil
+}
+
+func (fc *FakeDbClient) ListWebhookDeliveries(ctx *c.Context, hookId string) ([]*model.WebhookDeliverySpec, error) {
+	var dlvs []*model.WebhookDeliverySpec
+	for i := range SampleWebhookDeliveries {
+		dlvs = append(dlvs, &SampleWebhookDeliveries[i])
+	}
+	return dlvs, nil
+}
+
+func (fc *FakeDbClient) CreateAuditRecord(ctx *c.Context, rec *model.AuditRecordSpec) (*model.AuditRecordSpec, error) {
+	return rec, nil
+}
+
+func (fc *FakeDbClient) ListAuditRecords(ctx *c.Context, m map[string][]string) ([]*model.AuditRecordSpec, error) {
+	return nil, nil
+}
+
+func (fc *FakeDbClient) Watch(ctx *c.Context, resourceType string, revision int64, stop <-chan struct{}) (<-chan *model.WatchEvent, error) {
+	return nil, nil
+}
@@ -35,6 +35,52 @@ func (_m *Client) AddCustomProperty(ctx *context.Context, prfID string, custom m
 	return r0, r1
 }
 
+// CreateAuditRecord provides a mock function with given fields: ctx, rec
+func (_m *Client) CreateAuditRecord(ctx *context.Context, rec *model.AuditRecordSpec) (*model.AuditRecordSpec, error) {
+	ret := _m.Called(ctx, rec)
+
+	var r0 *model.AuditRecordSpec
+	if rf, ok := ret.Get(0).(func(*context.Context, *model.AuditRecordSpec) *model.AuditRecordSpec); ok {
+		r0 = rf(ctx, rec)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.AuditRecordSpec)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*context.Context, *model.AuditRecordSpec) error); ok {
+		r1 = rf(ctx, rec)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateBackup provides a mock function with given fields: ctx, backup
+func (_m *Client) CreateBackup(ctx *context.Context, backup *model.BackupSpec) (*model.BackupSpec, error) {
+	ret := _m.Called(ctx, backup)
+
+	var r0 *model.BackupSpec
+	if rf, ok := ret.Get(0).(func(*context.Context, *model.BackupSpec) *model.BackupSpec); ok {
+		r0 = rf(ctx, backup)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.BackupSpec)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*context.Context, *model.BackupSpec) error); ok {
+		r1 = rf(ctx, backup)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateDock provides a mock function with given fields: ctx, dck
 func (_m *Client) CreateDock(ctx *context.Context, dck *model.DockSpec) (*model.DockSpec, error) {
 	ret := _m.Called(ctx, dck)
@@ -127,6 +173,29 @@ func (_m *Client) CreateFileShareSnapshot(ctx *context.Context, vs *model.FileSh
 	return r0, r1
 }
 
+// CreateOperation provides a mock function with given fields: ctx, op
+func (_m *Client) CreateOperation(ctx *context.Context, op *model.OperationSpec) (*model.OperationSpec, error) {
+	ret := _m.Called(ctx, op)
+
+	var r0 *model.OperationSpec
+	if rf, ok := ret.Get(0).(func(*context.Context, *model.OperationSpec) *model.OperationSpec); ok {
+		r0 = rf(ctx, op)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.OperationSpec)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*context.Context, *model.OperationSpec) error); ok {
+		r1 = rf(ctx, op)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreatePool provides a mock function with given fields: ctx, pol
 func (_m *Client) CreatePool(ctx *context.Context, pol *model.StoragePoolSpec) (*model.StoragePoolSpec, error) {
 	ret := _m.Called(ctx, pol)
@@ -265,6 +334,29 @@ func (_m *Client) CreateVolumeGroup(ctx *context.Context, vg *model.VolumeGroupS
 	return r0, r1
 }
 
+// CreateVolumeGroupSnapshot provides a mock function with given fields: ctx, gs
+func (_m *Client) CreateVolumeGroupSnapshot(ctx *context.Context, gs *model.VolumeGroupSnapshotSpec) (*model.VolumeGroupSnapshotSpec, error) {
+	ret := _m.Called(ctx, gs)
+
+	var r0 *model.VolumeGroupSnapshotSpec
+	if rf, ok := ret.Get(0).(func(*context.Context, *model.VolumeGroupSnapshotSpec) *model.VolumeGroupSnapshotSpec); ok {
+		r0 = rf(ctx, gs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.VolumeGroupSnapshotSpec)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*context.Context, *model.VolumeGroupSnapshotSpec) error); ok {
+		r1 = rf(ctx, gs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateVolumeSnapshot provides a mock function with given fields: ctx, vs
 func (_m *Client) CreateVolumeSnapshot(ctx *context.Context, vs *model.VolumeSnapshotSpec) (*model.VolumeSnapshotSpec, error) {
 	ret := _m.Called(ctx, vs)
@@ -288,6 +380,66 @@ func (_m *Client) CreateVolumeSnapshot(ctx *context.Context, vs *model.VolumeSna
 	return r0, r1
 }
 
+// CreateWebhook provides a mock function with given fields: ctx, hook
+func (_m *Client) CreateWebhook(ctx *context.Context, hook *model.WebhookSpec) (*model.WebhookSpec, error) {
+	ret := _m.Called(ctx, hook)
+
+	var r0 *model.WebhookSpec
+	if rf, ok := ret.Get(0).(func(*context.Context, *model.WebhookSpec) *model.WebhookSpec); ok {
+		r0 = rf(ctx, hook)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.WebhookSpec)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*context.Context, *model.WebhookSpec) error); ok {
+		r1 = rf(ctx, hook)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateWebhookDelivery provides a mock function with given fields: ctx, dlv
+func (_m *Client) CreateWebhookDelivery(ctx *context.Context, dlv *model.WebhookDeliverySpec) (*model.WebhookDeliverySpec, error) {
+	ret := _m.Called(ctx, dlv)
+
+	var r0 *model.WebhookDeliverySpec
+	if rf, ok := ret.Get(0).(func(*context.Context, *model.WebhookDeliverySpec) *model.WebhookDeliverySpec); ok {
+		r0 = rf(ctx, dlv)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.WebhookDeliverySpec)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*context.Context, *model.WebhookDeliverySpec) error); ok {
+		r1 = rf(ctx, dlv)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteBackup provides a mock function with given fields: ctx, backupId
+func (_m *Client) DeleteBackup(ctx *context.Context, backupId string) error {
+	ret := _m.Called(ctx, backupId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*context.Context, string) error); ok {
+		r0 = rf(ctx, backupId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteDock provides a mock function with given fields: ctx, dckID
 func (_m *Client) DeleteDock(ctx *context.Context, dckID string) error {
 	ret := _m.Called(ctx, dckID)
@@ -344,6 +496,20 @@ func (_m *Client) DeleteFileShareSnapshot(ctx *context.Context, snapshotID strin
 	return r0
 }
 
+// DeleteOperation provides a mock function with given fields: ctx, opId
+func (_m *Client) DeleteOperation(ctx *context.Context, opId string) error {
+	ret := _m.Called(ctx, opId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*context.Context, string) error); ok {
+		r0 = rf(ctx, opId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeletePool provides a mock function with given fields: ctx, polID
 func (_m *Client) DeletePool(ctx *context.Context, polID string) error {
 	ret := _m.Called(ctx, polID)
@@ -428,6 +594,20 @@ func (_m *Client) DeleteVolumeGroup(ctx *context.Context, vgId string) error {
 	return r0
 }
 
+// DeleteVolumeGroupSnapshot provides a mock function with given fields: ctx, gsId
+func (_m *Client) DeleteVolumeGroupSnapshot(ctx *context.Context, gsId string) error {
+	ret := _m.Called(ctx, gsId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*context.Context, string) error); ok {
+		r0 = rf(ctx, gsId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteVolumeSnapshot provides a mock function with given fields: ctx, snapshotID
 func (_m *Client) DeleteVolumeSnapshot(ctx *context.Context, snapshotID string) error {
 	ret := _m.Called(ctx, snapshotID)
@@ -442,6 +622,20 @@ func (_m *Client) DeleteVolumeSnapshot(ctx *context.Context, snapshotID string)
 	return r0
 }
 
+// DeleteWebhook provides a mock function with given fields: ctx, hookId
+func (_m *Client) DeleteWebhook(ctx *context.Context, hookId string) error {
+	ret := _m.Called(ctx, hookId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*context.Context, string) error); ok {
+		r0 = rf(ctx, hookId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ExtendVolume provides a mock function with given fields: ctx, vol
 func (_m *Client) ExtendVolume(ctx *context.Context, vol *model.VolumeSpec) (*model.VolumeSpec, error) {
 	ret := _m.Called(ctx, vol)
@@ -465,6 +659,29 @@ func (_m *Client) ExtendVolume(ctx *context.Context, vol *model.VolumeSpec) (*mo
 	return r0, r1
 }
 
+// GetBackup provides a mock function with given fields: ctx, backupId
+func (_m *Client) GetBackup(ctx *context.Context, backupId string) (*model.BackupSpec, error) {
+	ret := _m.Called(ctx, backupId)
+
+	var r0 *model.BackupSpec
+	if rf, ok := ret.Get(0).(func(*context.Context, string) *model.BackupSpec); ok {
+		r0 = rf(ctx, backupId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.BackupSpec)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*context.Context, string) error); ok {
+		r1 = rf(ctx, backupId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetDefaultProfile provides a mock function with given fields: ctx
 func (_m *Client) GetDefaultProfile(ctx *context.Context) (*model.ProfileSpec, error) {
 	ret := _m.Called(ctx)
@@ -603,6 +820,29 @@ func (_m *Client) GetFileShareSnapshot(ctx *context.Context, snapshotID string)
 	return r0, r1
 }
 
+// GetOperation provides a mock function with given fields: ctx, opId
+func (_m *Client) GetOperation(ctx *context.Context, opId string) (*model.OperationSpec, error) {
+	ret := _m.Called(ctx, opId)
+
+	var r0 *model.OperationSpec
+	if rf, ok := ret.Get(0).(func(*context.Context, string) *model.OperationSpec); ok {
+		r0 = rf(ctx, opId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.OperationSpec)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*context.Context, string) error); ok {
+		r1 = rf(ctx, opId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetPool provides a mock function with given fields: ctx, polID
 func (_m *Client) GetPool(ctx *context.Context, polID string) (*model.StoragePoolSpec, error) {
 	ret := _m.Called(ctx, polID)
@@ -649,6 +889,52 @@ func (_m *Client) GetProfile(ctx *context.Context, prfID string) (*model.Profile
 	return r0, r1
 }
 
+// GetQuota provides a mock function with given fields: ctx, tenantId
+func (_m *Client) GetQuota(ctx *context.Context, tenantId string) (*model.QuotaSpec, error) {
+	ret := _m.Called(ctx, tenantId)
+
+	var r0 *model.QuotaSpec
+	if rf, ok := ret.Get(0).(func(*context.Context, string) *model.QuotaSpec); ok {
+		r0 = rf(ctx, tenantId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.QuotaSpec)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*context.Context, string) error); ok {
+		r1 = rf(ctx, tenantId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetQuotaUsage provides a mock function with given fields: ctx, tenantId
+func (_m *Client) GetQuotaUsage(ctx *context.Context, tenantId string) (*model.QuotaUsageSpec, error) {
+	ret := _m.Called(ctx, tenantId)
+
+	var r0 *model.QuotaUsageSpec
+	if rf, ok := ret.Get(0).(func(*context.Context, string) *model.QuotaUsageSpec); ok {
+		r0 = rf(ctx, tenantId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.QuotaUsageSpec)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*context.Context, string) error); ok {
+		r1 = rf(ctx, tenantId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetReplication provides a mock function with given fields: ctx, replicationId
 func (_m *Client) GetReplication(ctx *context.Context, replicationId string) (*model.ReplicationSpec, error) {
 	ret := _m.Called(ctx, replicationId)
@@ -764,6 +1050,29 @@ func (_m *Client) GetVolumeGroup(ctx *context.Context, vgId string) (*model.Volu
 	return r0, r1
 }
 
+// GetVolumeGroupSnapshot provides a mock function with given fields: ctx, gsId
+func (_m *Client) GetVolumeGroupSnapshot(ctx *context.Context, gsId string) (*model.VolumeGroupSnapshotSpec, error) {
+	ret := _m.Called(ctx, gsId)
+
+	var r0 *model.VolumeGroupSnapshotSpec
+	if rf, ok := ret.Get(0).(func(*context.Context, string) *model.VolumeGroupSnapshotSpec); ok {
+		r0 = rf(ctx, gsId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.VolumeGroupSnapshotSpec)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*context.Context, string) error); ok {
+		r1 = rf(ctx, gsId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetVolumeSnapshot provides a mock function with given fields: ctx, snapshotID
 func (_m *Client) GetVolumeSnapshot(ctx *context.Context, snapshotID string) (*model.VolumeSnapshotSpec, error) {
 	ret := _m.Called(ctx, snapshotID)
@@ -787,6 +1096,29 @@ func (_m *Client) GetVolumeSnapshot(ctx *context.Context, snapshotID string) (*m
 	return r0, r1
 }
 
+// GetWebhook provides a mock function with given fields: ctx, hookId
+func (_m *Client) GetWebhook(ctx *context.Context, hookId string) (*model.WebhookSpec, error) {
+	ret := _m.Called(ctx, hookId)
+
+	var r0 *model.WebhookSpec
+	if rf, ok := ret.Get(0).(func(*context.Context, string) *model.WebhookSpec); ok {
+		r0 = rf(ctx, hookId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.WebhookSpec)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*context.Context, string) error); ok {
+		r1 = rf(ctx, hookId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListAttachmentsByVolumeId provides a mock function with given fields: ctx, volId
 func (_m *Client) ListAttachmentsByVolumeId(ctx *context.Context, volId string) ([]*model.VolumeAttachmentSpec, error) {
 	ret := _m.Called(ctx, volId)
@@ -810,6 +1142,29 @@ func (_m *Client) ListAttachmentsByVolumeId(ctx *context.Context, volId string)
 	return r0, r1
 }
 
+// ListAuditRecords provides a mock function with given fields: ctx, m
+func (_m *Client) ListAuditRecords(ctx *context.Context, m map[string][]string) ([]*model.AuditRecordSpec, error) {
+	ret := _m.Called(ctx, m)
+
+	var r0 []*model.AuditRecordSpec
+	if rf, ok := ret.Get(0).(func(*context.Context, map[string][]string) []*model.AuditRecordSpec); ok {
+		r0 = rf(ctx, m)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.AuditRecordSpec)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*context.Context, map[string][]string) error); ok {
+		r1 = rf(ctx, m)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListAvailabilityZones provides a mock function with given fields: ctx
 func (_m *Client) ListAvailabilityZones(ctx *context.Context) ([]string, error) {
 	ret := _m.Called(ctx)
@@ -833,6 +1188,52 @@ func (_m *Client) ListAvailabilityZones(ctx *context.Context) ([]string, error)
 	return r0, r1
 }
 
+// ListBackups provides a mock function with given fields: ctx
+func (_m *Client) ListBackups(ctx *context.Context) ([]*model.BackupSpec, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*model.BackupSpec
+	if rf, ok := ret.Get(0).(func(*context.Context) []*model.BackupSpec); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.BackupSpec)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListBackupsWithFilter provides a mock function with given fields: ctx, m
+func (_m *Client) ListBackupsWithFilter(ctx *context.Context, m map[string][]string) ([]*model.BackupSpec, error) {
+	ret := _m.Called(ctx, m)
+
+	var r0 []*model.BackupSpec
+	if rf, ok := ret.Get(0).(func(*context.Context, map[string][]string) []*model.BackupSpec); ok {
+		r0 = rf(ctx, m)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.BackupSpec)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*context.Context, map[string][]string) error); ok {
+		r1 = rf(ctx, m)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListCustomProperties provides a mock function with given fields: ctx, prfID
 func (_m *Client) ListCustomProperties(ctx *context.Context, prfID string) (*model.CustomPropertiesSpec, error) {
 	ret := _m.Called(ctx, prfID)
@@ -1040,6 +1441,75 @@ func (_m *Client) ListFileSharesWithFilter(ctx *context.Context, m map[string][]
 	return r0, r1
 }
 
+// ListGroupSnapshotsByGroupId provides a mock function with given fields: ctx, vgId
+func (_m *Client) ListGroupSnapshotsByGroupId(ctx *context.Context, vgId string) ([]*model.VolumeGroupSnapshotSpec, error) {
+	ret := _m.Called(ctx, vgId)
+
+	var r0 []*model.VolumeGroupSnapshotSpec
+	if rf, ok := ret.Get(0).(func(*context.Context, string) []*model.VolumeGroupSnapshotSpec); ok {
+		r0 = rf(ctx, vgId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.VolumeGroupSnapshotSpec)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*context.Context, string) error); ok {
+		r1 = rf(ctx, vgId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListOperations provides a mock function with given fields: ctx
+func (_m *Client) ListOperations(ctx *context.Context) ([]*model.OperationSpec, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*model.OperationSpec
+	if rf, ok := ret.Get(0).(func(*context.Context) []*model.OperationSpec); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.OperationSpec)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListOperationsWithFilter provides a mock function with given fields: ctx, m
+func (_m *Client) ListOperationsWithFilter(ctx *context.Context, m map[string][]string) ([]*model.OperationSpec, error) {
+	ret := _m.Called(ctx, m)
+
+	var r0 []*model.OperationSpec
+	if rf, ok := ret.Get(0).(func(*context.Context, map[string][]string) []*model.OperationSpec); ok {
+		r0 = rf(ctx, m)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.OperationSpec)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*context.Context, map[string][]string) error); ok {
+		r1 = rf(ctx, m)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListPools provides a mock function with given fields: ctx
 func (_m *Client) ListPools(ctx *context.Context) ([]*model.StoragePoolSpec, error) {
 	ret := _m.Called(ctx)
@@ -1247,6 +1717,29 @@ func (_m *Client) ListVolumeAttachmentsWithFilter(ctx *context.Context, m map[st
 	return r0, r1
 }
 
+// ListVolumeGroupSnapshots provides a mock function with given fields: ctx
+func (_m *Client) ListVolumeGroupSnapshots(ctx *context.Context) ([]*model.VolumeGroupSnapshotSpec, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*model.VolumeGroupSnapshotSpec
+	if rf, ok := ret.Get(0).(func(*context.Context) []*model.VolumeGroupSnapshotSpec); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.VolumeGroupSnapshotSpec)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListVolumeGroups provides a mock function with given fields: ctx
 func (_m *Client) ListVolumeGroups(ctx *context.Context) ([]*model.VolumeGroupSpec, error) {
 	ret := _m.Called(ctx)
@@ -1408,6 +1901,52 @@ func (_m *Client) ListVolumesWithFilter(ctx *context.Context, m map[string][]str
 	return r0, r1
 }
 
+// ListWebhookDeliveries provides a mock function with given fields: ctx, hookId
+func (_m *Client) ListWebhookDeliveries(ctx *context.Context, hookId string) ([]*model.WebhookDeliverySpec, error) {
+	ret := _m.Called(ctx, hookId)
+
+	var r0 []*model.WebhookDeliverySpec
+	if rf, ok := ret.Get(0).(func(*context.Context, string) []*model.WebhookDeliverySpec); ok {
+		r0 = rf(ctx, hookId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.WebhookDeliverySpec)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*context.Context, string) error); ok {
+		r1 = rf(ctx, hookId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListWebhooks provides a mock function with given fields: ctx
+func (_m *Client) ListWebhooks(ctx *context.Context) ([]*model.WebhookSpec, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*model.WebhookSpec
+	if rf, ok := ret.Get(0).(func(*context.Context) []*model.WebhookSpec); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.WebhookSpec)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RemoveCustomProperty provides a mock function with given fields: ctx, prfID, customKey
 func (_m *Client) RemoveCustomProperty(ctx *context.Context, prfID string, customKey string) error {
 	ret := _m.Called(ctx, prfID, customKey)
@@ -1422,6 +1961,29 @@ func (_m *Client) RemoveCustomProperty(ctx *context.Context, prfID string, custo
 	return r0
 }
 
+// UpdateBackup provides a mock function with given fields: ctx, backupId, backup
+func (_m *Client) UpdateBackup(ctx *context.Context, backupId string, backup *model.BackupSpec) (*model.BackupSpec, error) {
+	ret := _m.Called(ctx, backupId, backup)
+
+	var r0 *model.BackupSpec
+	if rf, ok := ret.Get(0).(func(*context.Context, string, *model.BackupSpec) *model.BackupSpec); ok {
+		r0 = rf(ctx, backupId, backup)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.BackupSpec)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*context.Context, string, *model.BackupSpec) error); ok {
+		r1 = rf(ctx, backupId, backup)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateDock provides a mock function with given fields: ctx, dckID, name, desp
 func (_m *Client) UpdateDock(ctx *context.Context, dckID string, name string, desp string) (*model.DockSpec, error) {
 	ret := _m.Called(ctx, dckID, name, desp)
@@ -1468,6 +2030,29 @@ func (_m *Client) UpdateFileShare(ctx *context.Context, fshare *model.FileShareS
 	return r0, r1
 }
 
+// UpdateFileShareAcl provides a mock function with given fields: ctx, acl
+func (_m *Client) UpdateFileShareAcl(ctx *context.Context, acl *model.FileShareAclSpec) (*model.FileShareAclSpec, error) {
+	ret := _m.Called(ctx, acl)
+
+	var r0 *model.FileShareAclSpec
+	if rf, ok := ret.Get(0).(func(*context.Context, *model.FileShareAclSpec) *model.FileShareAclSpec); ok {
+		r0 = rf(ctx, acl)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.FileShareAclSpec)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*context.Context, *model.FileShareAclSpec) error); ok {
+		r1 = rf(ctx, acl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateFileShareSnapshot provides a mock function with given fields: ctx, snapshotID, vs
 func (_m *Client) UpdateFileShareSnapshot(ctx *context.Context, snapshotID string, vs *model.FileShareSnapshotSpec) (*model.FileShareSnapshotSpec, error) {
 	ret := _m.Called(ctx, snapshotID, vs)
@@ -1491,6 +2076,29 @@ func (_m *Client) UpdateFileShareSnapshot(ctx *context.Context, snapshotID strin
 	return r0, r1
 }
 
+// UpdateOperation provides a mock function with given fields: ctx, opId, op
+func (_m *Client) UpdateOperation(ctx *context.Context, opId string, op *model.OperationSpec) (*model.OperationSpec, error) {
+	ret := _m.Called(ctx, opId, op)
+
+	var r0 *model.OperationSpec
+	if rf, ok := ret.Get(0).(func(*context.Context, string, *model.OperationSpec) *model.OperationSpec); ok {
+		r0 = rf(ctx, opId, op)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.OperationSpec)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*context.Context, string, *model.OperationSpec) error); ok {
+		r1 = rf(ctx, opId, op)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdatePool provides a mock function with given fields: ctx, polID, name, desp, usedCapacity, used
 func (_m *Client) UpdatePool(ctx *context.Context, polID string, name string, desp string, usedCapacity int64, used bool) (*model.StoragePoolSpec, error) {
 	ret := _m.Called(ctx, polID, name, desp, usedCapacity, used)
@@ -1537,6 +2145,52 @@ func (_m *Client) UpdateProfile(ctx *context.Context, prfID string, input *model
 	return r0, r1
 }
 
+// UpdateQuota provides a mock function with given fields: ctx, quota
+func (_m *Client) UpdateQuota(ctx *context.Context, quota *model.QuotaSpec) (*model.QuotaSpec, error) {
+	ret := _m.Called(ctx, quota)
+
+	var r0 *model.QuotaSpec
+	if rf, ok := ret.Get(0).(func(*context.Context, *model.QuotaSpec) *model.QuotaSpec); ok {
+		r0 = rf(ctx, quota)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.QuotaSpec)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*context.Context, *model.QuotaSpec) error); ok {
+		r1 = rf(ctx, quota)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateQuotaUsage provides a mock function with given fields: ctx, usage
+func (_m *Client) UpdateQuotaUsage(ctx *context.Context, usage *model.QuotaUsageSpec) (*model.QuotaUsageSpec, error) {
+	ret := _m.Called(ctx, usage)
+
+	var r0 *model.QuotaUsageSpec
+	if rf, ok := ret.Get(0).(func(*context.Context, *model.QuotaUsageSpec) *model.QuotaUsageSpec); ok {
+		r0 = rf(ctx, usage)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.QuotaUsageSpec)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*context.Context, *model.QuotaUsageSpec) error); ok {
+		r1 = rf(ctx, usage)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateReplication provides a mock function with given fields: ctx, replicationId, input
 func (_m *Client) UpdateReplication(ctx *context.Context, replicationId string, input *model.ReplicationSpec) (*model.ReplicationSpec, error) {
 	ret := _m.Called(ctx, replicationId, input)
@@ -1643,6 +2297,29 @@ func (_m *Client) UpdateVolumeGroup(ctx *context.Context, vg *model.VolumeGroupS
 	return r0, r1
 }
 
+// UpdateVolumeGroupSnapshot provides a mock function with given fields: ctx, gsId, gs
+func (_m *Client) UpdateVolumeGroupSnapshot(ctx *context.Context, gsId string, gs *model.VolumeGroupSnapshotSpec) (*model.VolumeGroupSnapshotSpec, error) {
+	ret := _m.Called(ctx, gsId, gs)
+
+	var r0 *model.VolumeGroupSnapshotSpec
+	if rf, ok := ret.Get(0).(func(*context.Context, string, *model.VolumeGroupSnapshotSpec) *model.VolumeGroupSnapshotSpec); ok {
+		r0 = rf(ctx, gsId, gs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.VolumeGroupSnapshotSpec)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*context.Context, string, *model.VolumeGroupSnapshotSpec) error); ok {
+		r1 = rf(ctx, gsId, gs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateVolumeSnapshot provides a mock function with given fields: ctx, snapshotID, vs
 func (_m *Client) UpdateVolumeSnapshot(ctx *context.Context, snapshotID string, vs *model.VolumeSnapshotSpec) (*model.VolumeSnapshotSpec, error) {
 	ret := _m.Called(ctx, snapshotID, vs)
@@ -1666,6 +2343,29 @@ func (_m *Client) UpdateVolumeSnapshot(ctx *context.Context, snapshotID string,
 	return r0, r1
 }
 
+// UpdateWebhookDelivery provides a mock function with given fields: ctx, dlvId, dlv
+func (_m *Client) UpdateWebhookDelivery(ctx *context.Context, dlvId string, dlv *model.WebhookDeliverySpec) (*model.WebhookDeliverySpec, error) {
+	ret := _m.Called(ctx, dlvId, dlv)
+
+	var r0 *model.WebhookDeliverySpec
+	if rf, ok := ret.Get(0).(func(*context.Context, string, *model.WebhookDeliverySpec) *model.WebhookDeliverySpec); ok {
+		r0 = rf(ctx, dlvId, dlv)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.WebhookDeliverySpec)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*context.Context, string, *model.WebhookDeliverySpec) error); ok {
+		r1 = rf(ctx, dlvId, dlv)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // VolumesToUpdate provides a mock function with given fields: ctx, volumeList
 func (_m *Client) VolumesToUpdate(ctx *context.Context, volumeList []*model.VolumeSpec) ([]*model.VolumeSpec, error) {
 	ret := _m.Called(ctx, volumeList)
@@ -1688,3 +2388,26 @@ func (_m *Client) VolumesToUpdate(ctx *context.Context, volumeList []*model.Volu
 
 	return r0, r1
 }
+
+// Watch provides a mock function with given fields: ctx, resourceType, revision, stop
+func (_m *Client) Watch(ctx *context.Context, resourceType string, revision int64, stop <-chan struct{}) (<-chan *model.WatchEvent, error) {
+	ret := _m.Called(ctx, resourceType, revision, stop)
+
+	var r0 <-chan *model.WatchEvent
+	if rf, ok := ret.Get(0).(func(*context.Context, string, int64, <-chan struct{}) <-chan *model.WatchEvent); ok {
+		r0 = rf(ctx, resourceType, revision, stop)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan *model.WatchEvent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*context.Context, string, int64, <-chan struct{}) error); ok {
+		r1 = rf(ctx, resourceType, revision, stop)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
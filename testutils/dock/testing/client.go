@@ -61,6 +61,156 @@ func (_m *Client) Connect(edp string) error {
 	return r0
 }
 
+// CopyVolume provides a mock function with given fields: ctx, in, opts
+func (_m *Client) CopyVolume(ctx context.Context, in *proto.CopyVolumeOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *proto.GenericResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.CopyVolumeOpts, ...grpc.CallOption) *proto.GenericResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.GenericResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.CopyVolumeOpts, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateFileShare provides a mock function with given fields: ctx, in, opts
+func (_m *Client) CreateFileShare(ctx context.Context, in *proto.CreateFileShareOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *proto.GenericResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.CreateFileShareOpts, ...grpc.CallOption) *proto.GenericResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.GenericResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.CreateFileShareOpts, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateFileShareAcl provides a mock function with given fields: ctx, in, opts
+func (_m *Client) CreateFileShareAcl(ctx context.Context, in *proto.CreateFileShareAclOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *proto.GenericResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.CreateFileShareAclOpts, ...grpc.CallOption) *proto.GenericResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.GenericResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.CreateFileShareAclOpts, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateFileShareSnapshot provides a mock function with given fields: ctx, in, opts
+func (_m *Client) CreateFileShareSnapshot(ctx context.Context, in *proto.CreateFileShareSnapshotOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *proto.GenericResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.CreateFileShareSnapshotOpts, ...grpc.CallOption) *proto.GenericResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.GenericResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.CreateFileShareSnapshotOpts, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateGroupSnapshot provides a mock function with given fields: ctx, in, opts
+func (_m *Client) CreateGroupSnapshot(ctx context.Context, in *proto.CreateGroupSnapshotOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *proto.GenericResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.CreateGroupSnapshotOpts, ...grpc.CallOption) *proto.GenericResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.GenericResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.CreateGroupSnapshotOpts, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateReplication provides a mock function with given fields: ctx, in, opts
 func (_m *Client) CreateReplication(ctx context.Context, in *proto.CreateReplicationOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -151,6 +301,36 @@ func (_m *Client) CreateVolumeAttachment(ctx context.Context, in *proto.CreateVo
 	return r0, r1
 }
 
+// CreateVolumeBackup provides a mock function with given fields: ctx, in, opts
+func (_m *Client) CreateVolumeBackup(ctx context.Context, in *proto.CreateVolumeBackupOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *proto.GenericResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.CreateVolumeBackupOpts, ...grpc.CallOption) *proto.GenericResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.GenericResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.CreateVolumeBackupOpts, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateVolumeGroup provides a mock function with given fields: ctx, in, opts
 func (_m *Client) CreateVolumeGroup(ctx context.Context, in *proto.CreateVolumeGroupOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -211,8 +391,8 @@ func (_m *Client) CreateVolumeSnapshot(ctx context.Context, in *proto.CreateVolu
 	return r0, r1
 }
 
-// DeleteReplication provides a mock function with given fields: ctx, in, opts
-func (_m *Client) DeleteReplication(ctx context.Context, in *proto.DeleteReplicationOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
+// DeleteFileShare provides a mock function with given fields: ctx, in, opts
+func (_m *Client) DeleteFileShare(ctx context.Context, in *proto.DeleteFileShareOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -223,7 +403,7 @@ func (_m *Client) DeleteReplication(ctx context.Context, in *proto.DeleteReplica
 	ret := _m.Called(_ca...)
 
 	var r0 *proto.GenericResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *proto.DeleteReplicationOpts, ...grpc.CallOption) *proto.GenericResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.DeleteFileShareOpts, ...grpc.CallOption) *proto.GenericResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -232,7 +412,7 @@ func (_m *Client) DeleteReplication(ctx context.Context, in *proto.DeleteReplica
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *proto.DeleteReplicationOpts, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.DeleteFileShareOpts, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -241,8 +421,8 @@ func (_m *Client) DeleteReplication(ctx context.Context, in *proto.DeleteReplica
 	return r0, r1
 }
 
-// DeleteVolume provides a mock function with given fields: ctx, in, opts
-func (_m *Client) DeleteVolume(ctx context.Context, in *proto.DeleteVolumeOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
+// DeleteFileShareAcl provides a mock function with given fields: ctx, in, opts
+func (_m *Client) DeleteFileShareAcl(ctx context.Context, in *proto.DeleteFileShareAclOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -253,7 +433,7 @@ func (_m *Client) DeleteVolume(ctx context.Context, in *proto.DeleteVolumeOpts,
 	ret := _m.Called(_ca...)
 
 	var r0 *proto.GenericResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *proto.DeleteVolumeOpts, ...grpc.CallOption) *proto.GenericResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.DeleteFileShareAclOpts, ...grpc.CallOption) *proto.GenericResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -262,7 +442,7 @@ func (_m *Client) DeleteVolume(ctx context.Context, in *proto.DeleteVolumeOpts,
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *proto.DeleteVolumeOpts, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.DeleteFileShareAclOpts, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -271,8 +451,8 @@ func (_m *Client) DeleteVolume(ctx context.Context, in *proto.DeleteVolumeOpts,
 	return r0, r1
 }
 
-// DeleteVolumeAttachment provides a mock function with given fields: ctx, in, opts
-func (_m *Client) DeleteVolumeAttachment(ctx context.Context, in *proto.DeleteVolumeAttachmentOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
+// DeleteFileShareSnapshot provides a mock function with given fields: ctx, in, opts
+func (_m *Client) DeleteFileShareSnapshot(ctx context.Context, in *proto.DeleteFileShareSnapshotOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -283,7 +463,7 @@ func (_m *Client) DeleteVolumeAttachment(ctx context.Context, in *proto.DeleteVo
 	ret := _m.Called(_ca...)
 
 	var r0 *proto.GenericResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *proto.DeleteVolumeAttachmentOpts, ...grpc.CallOption) *proto.GenericResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.DeleteFileShareSnapshotOpts, ...grpc.CallOption) *proto.GenericResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -292,7 +472,7 @@ func (_m *Client) DeleteVolumeAttachment(ctx context.Context, in *proto.DeleteVo
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *proto.DeleteVolumeAttachmentOpts, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.DeleteFileShareSnapshotOpts, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -301,8 +481,8 @@ func (_m *Client) DeleteVolumeAttachment(ctx context.Context, in *proto.DeleteVo
 	return r0, r1
 }
 
-// DeleteVolumeGroup provides a mock function with given fields: ctx, in, opts
-func (_m *Client) DeleteVolumeGroup(ctx context.Context, in *proto.DeleteVolumeGroupOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
+// DeleteGroupSnapshot provides a mock function with given fields: ctx, in, opts
+func (_m *Client) DeleteGroupSnapshot(ctx context.Context, in *proto.DeleteGroupSnapshotOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -313,7 +493,7 @@ func (_m *Client) DeleteVolumeGroup(ctx context.Context, in *proto.DeleteVolumeG
 	ret := _m.Called(_ca...)
 
 	var r0 *proto.GenericResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *proto.DeleteVolumeGroupOpts, ...grpc.CallOption) *proto.GenericResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.DeleteGroupSnapshotOpts, ...grpc.CallOption) *proto.GenericResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -322,7 +502,7 @@ func (_m *Client) DeleteVolumeGroup(ctx context.Context, in *proto.DeleteVolumeG
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *proto.DeleteVolumeGroupOpts, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.DeleteGroupSnapshotOpts, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -331,8 +511,8 @@ func (_m *Client) DeleteVolumeGroup(ctx context.Context, in *proto.DeleteVolumeG
 	return r0, r1
 }
 
-// DeleteVolumeSnapshot provides a mock function with given fields: ctx, in, opts
-func (_m *Client) DeleteVolumeSnapshot(ctx context.Context, in *proto.DeleteVolumeSnapshotOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
+// DeleteReplication provides a mock function with given fields: ctx, in, opts
+func (_m *Client) DeleteReplication(ctx context.Context, in *proto.DeleteReplicationOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -343,7 +523,7 @@ func (_m *Client) DeleteVolumeSnapshot(ctx context.Context, in *proto.DeleteVolu
 	ret := _m.Called(_ca...)
 
 	var r0 *proto.GenericResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *proto.DeleteVolumeSnapshotOpts, ...grpc.CallOption) *proto.GenericResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.DeleteReplicationOpts, ...grpc.CallOption) *proto.GenericResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -352,7 +532,7 @@ func (_m *Client) DeleteVolumeSnapshot(ctx context.Context, in *proto.DeleteVolu
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *proto.DeleteVolumeSnapshotOpts, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.DeleteReplicationOpts, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -361,8 +541,8 @@ func (_m *Client) DeleteVolumeSnapshot(ctx context.Context, in *proto.DeleteVolu
 	return r0, r1
 }
 
-// DetachVolume provides a mock function with given fields: ctx, in, opts
-func (_m *Client) DetachVolume(ctx context.Context, in *proto.DetachVolumeOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
+// DeleteVolume provides a mock function with given fields: ctx, in, opts
+func (_m *Client) DeleteVolume(ctx context.Context, in *proto.DeleteVolumeOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -373,7 +553,7 @@ func (_m *Client) DetachVolume(ctx context.Context, in *proto.DetachVolumeOpts,
 	ret := _m.Called(_ca...)
 
 	var r0 *proto.GenericResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *proto.DetachVolumeOpts, ...grpc.CallOption) *proto.GenericResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.DeleteVolumeOpts, ...grpc.CallOption) *proto.GenericResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -382,7 +562,7 @@ func (_m *Client) DetachVolume(ctx context.Context, in *proto.DetachVolumeOpts,
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *proto.DetachVolumeOpts, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.DeleteVolumeOpts, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -391,8 +571,8 @@ func (_m *Client) DetachVolume(ctx context.Context, in *proto.DetachVolumeOpts,
 	return r0, r1
 }
 
-// DisableReplication provides a mock function with given fields: ctx, in, opts
-func (_m *Client) DisableReplication(ctx context.Context, in *proto.DisableReplicationOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
+// DeleteVolumeAttachment provides a mock function with given fields: ctx, in, opts
+func (_m *Client) DeleteVolumeAttachment(ctx context.Context, in *proto.DeleteVolumeAttachmentOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -403,7 +583,7 @@ func (_m *Client) DisableReplication(ctx context.Context, in *proto.DisableRepli
 	ret := _m.Called(_ca...)
 
 	var r0 *proto.GenericResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *proto.DisableReplicationOpts, ...grpc.CallOption) *proto.GenericResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.DeleteVolumeAttachmentOpts, ...grpc.CallOption) *proto.GenericResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -412,7 +592,7 @@ func (_m *Client) DisableReplication(ctx context.Context, in *proto.DisableRepli
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *proto.DisableReplicationOpts, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.DeleteVolumeAttachmentOpts, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -421,8 +601,8 @@ func (_m *Client) DisableReplication(ctx context.Context, in *proto.DisableRepli
 	return r0, r1
 }
 
-// EnableReplication provides a mock function with given fields: ctx, in, opts
-func (_m *Client) EnableReplication(ctx context.Context, in *proto.EnableReplicationOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
+// DeleteVolumeBackup provides a mock function with given fields: ctx, in, opts
+func (_m *Client) DeleteVolumeBackup(ctx context.Context, in *proto.DeleteVolumeBackupOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -433,7 +613,7 @@ func (_m *Client) EnableReplication(ctx context.Context, in *proto.EnableReplica
 	ret := _m.Called(_ca...)
 
 	var r0 *proto.GenericResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *proto.EnableReplicationOpts, ...grpc.CallOption) *proto.GenericResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.DeleteVolumeBackupOpts, ...grpc.CallOption) *proto.GenericResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -442,7 +622,7 @@ func (_m *Client) EnableReplication(ctx context.Context, in *proto.EnableReplica
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *proto.EnableReplicationOpts, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.DeleteVolumeBackupOpts, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -451,8 +631,8 @@ func (_m *Client) EnableReplication(ctx context.Context, in *proto.EnableReplica
 	return r0, r1
 }
 
-// ExtendVolume provides a mock function with given fields: ctx, in, opts
-func (_m *Client) ExtendVolume(ctx context.Context, in *proto.ExtendVolumeOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
+// DeleteVolumeGroup provides a mock function with given fields: ctx, in, opts
+func (_m *Client) DeleteVolumeGroup(ctx context.Context, in *proto.DeleteVolumeGroupOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -463,7 +643,7 @@ func (_m *Client) ExtendVolume(ctx context.Context, in *proto.ExtendVolumeOpts,
 	ret := _m.Called(_ca...)
 
 	var r0 *proto.GenericResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *proto.ExtendVolumeOpts, ...grpc.CallOption) *proto.GenericResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.DeleteVolumeGroupOpts, ...grpc.CallOption) *proto.GenericResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -472,7 +652,7 @@ func (_m *Client) ExtendVolume(ctx context.Context, in *proto.ExtendVolumeOpts,
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *proto.ExtendVolumeOpts, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.DeleteVolumeGroupOpts, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -481,8 +661,8 @@ func (_m *Client) ExtendVolume(ctx context.Context, in *proto.ExtendVolumeOpts,
 	return r0, r1
 }
 
-// FailoverReplication provides a mock function with given fields: ctx, in, opts
-func (_m *Client) FailoverReplication(ctx context.Context, in *proto.FailoverReplicationOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
+// DeleteVolumeSnapshot provides a mock function with given fields: ctx, in, opts
+func (_m *Client) DeleteVolumeSnapshot(ctx context.Context, in *proto.DeleteVolumeSnapshotOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -493,7 +673,7 @@ func (_m *Client) FailoverReplication(ctx context.Context, in *proto.FailoverRep
 	ret := _m.Called(_ca...)
 
 	var r0 *proto.GenericResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *proto.FailoverReplicationOpts, ...grpc.CallOption) *proto.GenericResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.DeleteVolumeSnapshotOpts, ...grpc.CallOption) *proto.GenericResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -502,7 +682,547 @@ func (_m *Client) FailoverReplication(ctx context.Context, in *proto.FailoverRep
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *proto.FailoverReplicationOpts, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.DeleteVolumeSnapshotOpts, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DetachVolume provides a mock function with given fields: ctx, in, opts
+func (_m *Client) DetachVolume(ctx context.Context, in *proto.DetachVolumeOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *proto.GenericResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.DetachVolumeOpts, ...grpc.CallOption) *proto.GenericResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.GenericResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.DetachVolumeOpts, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DisableReplication provides a mock function with given fields: ctx, in, opts
+func (_m *Client) DisableReplication(ctx context.Context, in *proto.DisableReplicationOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *proto.GenericResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.DisableReplicationOpts, ...grpc.CallOption) *proto.GenericResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.GenericResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.DisableReplicationOpts, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EnableReplication provides a mock function with given fields: ctx, in, opts
+func (_m *Client) EnableReplication(ctx context.Context, in *proto.EnableReplicationOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *proto.GenericResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.EnableReplicationOpts, ...grpc.CallOption) *proto.GenericResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.GenericResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.EnableReplicationOpts, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExtendVolume provides a mock function with given fields: ctx, in, opts
+func (_m *Client) ExtendVolume(ctx context.Context, in *proto.ExtendVolumeOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *proto.GenericResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.ExtendVolumeOpts, ...grpc.CallOption) *proto.GenericResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.GenericResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.ExtendVolumeOpts, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FailoverReplication provides a mock function with given fields: ctx, in, opts
+func (_m *Client) FailoverReplication(ctx context.Context, in *proto.FailoverReplicationOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *proto.GenericResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.FailoverReplicationOpts, ...grpc.CallOption) *proto.GenericResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.GenericResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.FailoverReplicationOpts, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FreezeVolume provides a mock function with given fields: ctx, in, opts
+func (_m *Client) FreezeVolume(ctx context.Context, in *proto.FreezeVolumeOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *proto.GenericResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.FreezeVolumeOpts, ...grpc.CallOption) *proto.GenericResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.GenericResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.FreezeVolumeOpts, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListVolumeSnapshots provides a mock function with given fields: ctx, in, opts
+func (_m *Client) ListVolumeSnapshots(ctx context.Context, in *proto.ListVolumesOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *proto.GenericResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.ListVolumesOpts, ...grpc.CallOption) *proto.GenericResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.GenericResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.ListVolumesOpts, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListVolumes provides a mock function with given fields: ctx, in, opts
+func (_m *Client) ListVolumes(ctx context.Context, in *proto.ListVolumesOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *proto.GenericResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.ListVolumesOpts, ...grpc.CallOption) *proto.GenericResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.GenericResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.ListVolumesOpts, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ManageVolume provides a mock function with given fields: ctx, in, opts
+func (_m *Client) ManageVolume(ctx context.Context, in *proto.ManageVolumeOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *proto.GenericResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.ManageVolumeOpts, ...grpc.CallOption) *proto.GenericResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.GenericResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.ManageVolumeOpts, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ManageVolumeSnapshot provides a mock function with given fields: ctx, in, opts
+func (_m *Client) ManageVolumeSnapshot(ctx context.Context, in *proto.ManageVolumeSnapshotOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *proto.GenericResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.ManageVolumeSnapshotOpts, ...grpc.CallOption) *proto.GenericResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.GenericResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.ManageVolumeSnapshotOpts, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MigrateVolume provides a mock function with given fields: ctx, in, opts
+func (_m *Client) MigrateVolume(ctx context.Context, in *proto.MigrateVolumeOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *proto.GenericResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.MigrateVolumeOpts, ...grpc.CallOption) *proto.GenericResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.GenericResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.MigrateVolumeOpts, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PullVolume provides a mock function with given fields: ctx, in, opts
+func (_m *Client) PullVolume(ctx context.Context, in *proto.PullVolumeOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *proto.GenericResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.PullVolumeOpts, ...grpc.CallOption) *proto.GenericResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.GenericResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.PullVolumeOpts, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PullVolumeSnapshot provides a mock function with given fields: ctx, in, opts
+func (_m *Client) PullVolumeSnapshot(ctx context.Context, in *proto.PullVolumeSnapshotOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *proto.GenericResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.PullVolumeSnapshotOpts, ...grpc.CallOption) *proto.GenericResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.GenericResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.PullVolumeSnapshotOpts, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestoreVolumeBackup provides a mock function with given fields: ctx, in, opts
+func (_m *Client) RestoreVolumeBackup(ctx context.Context, in *proto.RestoreVolumeBackupOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *proto.GenericResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.RestoreVolumeBackupOpts, ...grpc.CallOption) *proto.GenericResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.GenericResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.RestoreVolumeBackupOpts, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RevertVolumeToSnapshot provides a mock function with given fields: ctx, in, opts
+func (_m *Client) RevertVolumeToSnapshot(ctx context.Context, in *proto.RevertVolumeToSnapshotOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *proto.GenericResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.RevertVolumeToSnapshotOpts, ...grpc.CallOption) *proto.GenericResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.GenericResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.RevertVolumeToSnapshotOpts, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ThawVolume provides a mock function with given fields: ctx, in, opts
+func (_m *Client) ThawVolume(ctx context.Context, in *proto.FreezeVolumeOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *proto.GenericResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.FreezeVolumeOpts, ...grpc.CallOption) *proto.GenericResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.GenericResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.FreezeVolumeOpts, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UnmanageVolume provides a mock function with given fields: ctx, in, opts
+func (_m *Client) UnmanageVolume(ctx context.Context, in *proto.UnmanageVolumeOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *proto.GenericResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.UnmanageVolumeOpts, ...grpc.CallOption) *proto.GenericResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.GenericResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.UnmanageVolumeOpts, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UnmanageVolumeSnapshot provides a mock function with given fields: ctx, in, opts
+func (_m *Client) UnmanageVolumeSnapshot(ctx context.Context, in *proto.UnmanageVolumeSnapshotOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *proto.GenericResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.UnmanageVolumeSnapshotOpts, ...grpc.CallOption) *proto.GenericResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.GenericResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.UnmanageVolumeSnapshotOpts, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -21,8 +21,6 @@ operations of volume and return a fake value.
 package sample
 
 import (
-	"errors"
-
 	"github.com/opensds/opensds/pkg/model"
 	pb "github.com/opensds/opensds/pkg/model/proto"
 	. "github.com/opensds/opensds/testutils/collection"
@@ -42,15 +40,24 @@ func (*Driver) CreateVolume(opt *pb.CreateVolumeOpts) (*model.VolumeSpec, error)
 	return &SampleVolumes[0], nil
 }
 
+// CloneVolume
+func (*Driver) CloneVolume(opt *pb.CreateVolumeOpts) (*model.VolumeSpec, error) {
+	return &SampleVolumes[0], nil
+}
+
 // PullVolume
-func (*Driver) PullVolume(volIdentifier string) (*model.VolumeSpec, error) {
+func (d *Driver) PullVolume(opt *pb.PullVolumeOpts) (*model.VolumeSpec, error) {
+	return d.getVolume(opt.GetId())
+}
+
+func (*Driver) getVolume(volIdentifier string) (*model.VolumeSpec, error) {
 	for _, volume := range SampleVolumes {
 		if volIdentifier == volume.Id {
 			return &volume, nil
 		}
 	}
 
-	return nil, errors.New("Can't find volume " + volIdentifier)
+	return nil, model.NewNotFoundError("Can't find volume " + volIdentifier)
 }
 
 // DeleteVolume
@@ -63,6 +70,22 @@ func (*Driver) ExtendVolume(opt *pb.ExtendVolumeOpts) (*model.VolumeSpec, error)
 	return &SampleVolumes[0], nil
 }
 
+// MigrateVolume ...
+func (*Driver) MigrateVolume(opt *pb.MigrateVolumeOpts) (*model.VolumeSpec, error) {
+	return &SampleVolumes[0], nil
+}
+
+// RevertVolumeToSnapshot
+func (*Driver) RevertVolumeToSnapshot(opt *pb.RevertVolumeToSnapshotOpts) error { return nil }
+
+// ManageVolume
+func (d *Driver) ManageVolume(opt *pb.ManageVolumeOpts) (*model.VolumeSpec, error) {
+	return d.getVolume(opt.GetIdentifier())
+}
+
+// UnmanageVolume
+func (*Driver) UnmanageVolume(opt *pb.UnmanageVolumeOpts) error { return nil }
+
 // InitializeConnection
 func (*Driver) InitializeConnection(opt *pb.CreateVolumeAttachmentOpts) (*model.ConnectionInfo, error) {
 	return &SampleConnection, nil
@@ -77,16 +100,28 @@ func (*Driver) CreateSnapshot(opt *pb.CreateVolumeSnapshotOpts) (*model.VolumeSn
 }
 
 // PullSnapshot
-func (*Driver) PullSnapshot(snapIdentifier string) (*model.VolumeSnapshotSpec, error) {
+func (d *Driver) PullSnapshot(opt *pb.PullVolumeSnapshotOpts) (*model.VolumeSnapshotSpec, error) {
+	return d.getSnapshot(opt.GetId())
+}
+
+func (*Driver) getSnapshot(snapIdentifier string) (*model.VolumeSnapshotSpec, error) {
 	for _, snapshot := range SampleSnapshots {
 		if snapIdentifier == snapshot.Id {
 			return &snapshot, nil
 		}
 	}
 
-	return nil, errors.New("Can't find snapshot " + snapIdentifier)
+	return nil, model.NewNotFoundError("Can't find snapshot " + snapIdentifier)
+}
+
+// ManageSnapshot
+func (d *Driver) ManageSnapshot(opt *pb.ManageVolumeSnapshotOpts) (*model.VolumeSnapshotSpec, error) {
+	return d.getSnapshot(opt.GetIdentifier())
 }
 
+// UnmanageSnapshot
+func (*Driver) UnmanageSnapshot(opt *pb.UnmanageVolumeSnapshotOpts) error { return nil }
+
 // DeleteSnapshot
 func (*Driver) DeleteSnapshot(opt *pb.DeleteVolumeSnapshotOpts) error {
 	return nil
@@ -102,6 +137,28 @@ func (*Driver) ListPools() ([]*model.StoragePoolSpec, error) {
 	return pols, nil
 }
 
+// ListVolumes
+func (*Driver) ListVolumes(opt *pb.ListVolumesOpts) ([]*model.VolumeSpec, error) {
+	var vols []*model.VolumeSpec
+
+	for i := range SampleVolumes {
+		if SampleVolumes[i].PoolId == opt.GetPoolId() {
+			vols = append(vols, &SampleVolumes[i])
+		}
+	}
+	return vols, nil
+}
+
+// ListSnapshots
+func (*Driver) ListSnapshots(opt *pb.ListVolumesOpts) ([]*model.VolumeSnapshotSpec, error) {
+	var snps []*model.VolumeSnapshotSpec
+
+	for i := range SampleSnapshots {
+		snps = append(snps, &SampleSnapshots[i])
+	}
+	return snps, nil
+}
+
 func (d *Driver) InitializeSnapshotConnection(opt *pb.CreateSnapshotAttachmentOpts) (*model.ConnectionInfo, error) {
 	return nil, &model.NotImplementError{S: "method InitializeSnapshotConnection has not been implemented yet"}
 }
@@ -122,6 +179,14 @@ func (d *Driver) DeleteVolumeGroup(opt *pb.DeleteVolumeGroupOpts) error {
 	return &model.NotImplementError{"method DeleteVolumeGroup has not been implemented yet"}
 }
 
+func (d *Driver) CreateGroupSnapshot(opt *pb.CreateGroupSnapshotOpts) ([]*model.VolumeSnapshotSpec, error) {
+	return nil, &model.NotImplementError{S: "method CreateGroupSnapshot has not been implemented yet"}
+}
+
+func (d *Driver) DeleteGroupSnapshot(opt *pb.DeleteGroupSnapshotOpts) error {
+	return &model.NotImplementError{S: "method DeleteGroupSnapshot has not been implemented yet"}
+}
+
 func (d *Driver) DeleteFileShare(opt *pb.DeleteFileShareOpts) (*model.FileShareSpec, error) {
 	return nil, nil
 }
@@ -129,3 +194,19 @@ func (d *Driver) DeleteFileShare(opt *pb.DeleteFileShareOpts) (*model.FileShareS
 func (d *Driver) CreateFileShare(opt *pb.CreateFileShareOpts) (*model.FileShareSpec, error) {
 	return &SampleFileShares[0], nil
 }
+
+func (d *Driver) CreateFileShareSnapshot(opt *pb.CreateFileShareSnapshotOpts) (*model.FileShareSnapshotSpec, error) {
+	return &SampleFileShareSnapshots[0], nil
+}
+
+func (d *Driver) DeleteFileShareSnapshot(opt *pb.DeleteFileShareSnapshotOpts) error {
+	return nil
+}
+
+func (d *Driver) CreateFileShareAcl(opt *pb.CreateFileShareAclOpts) (*model.FileShareAclSpec, error) {
+	return &SampleFileSharesAcl[0], nil
+}
+
+func (d *Driver) DeleteFileShareAcl(opt *pb.DeleteFileShareAclOpts) error {
+	return nil
+}
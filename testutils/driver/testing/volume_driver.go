@@ -11,6 +11,52 @@ type VolumeDriver struct {
 	mock.Mock
 }
 
+// CloneVolume provides a mock function with given fields: opt
+func (_m *VolumeDriver) CloneVolume(opt *proto.CreateVolumeOpts) (*model.VolumeSpec, error) {
+	ret := _m.Called(opt)
+
+	var r0 *model.VolumeSpec
+	if rf, ok := ret.Get(0).(func(*proto.CreateVolumeOpts) *model.VolumeSpec); ok {
+		r0 = rf(opt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.VolumeSpec)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*proto.CreateVolumeOpts) error); ok {
+		r1 = rf(opt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateGroupSnapshot provides a mock function with given fields: opt
+func (_m *VolumeDriver) CreateGroupSnapshot(opt *proto.CreateGroupSnapshotOpts) ([]*model.VolumeSnapshotSpec, error) {
+	ret := _m.Called(opt)
+
+	var r0 []*model.VolumeSnapshotSpec
+	if rf, ok := ret.Get(0).(func(*proto.CreateGroupSnapshotOpts) []*model.VolumeSnapshotSpec); ok {
+		r0 = rf(opt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.VolumeSnapshotSpec)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*proto.CreateGroupSnapshotOpts) error); ok {
+		r1 = rf(opt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateSnapshot provides a mock function with given fields: opt
 func (_m *VolumeDriver) CreateSnapshot(opt *proto.CreateVolumeSnapshotOpts) (*model.VolumeSnapshotSpec, error) {
 	ret := _m.Called(opt)
@@ -80,6 +126,20 @@ func (_m *VolumeDriver) CreateVolumeGroup(opt *proto.CreateVolumeGroupOpts) (*mo
 	return r0, r1
 }
 
+// DeleteGroupSnapshot provides a mock function with given fields: opt
+func (_m *VolumeDriver) DeleteGroupSnapshot(opt *proto.DeleteGroupSnapshotOpts) error {
+	ret := _m.Called(opt)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*proto.DeleteGroupSnapshotOpts) error); ok {
+		r0 = rf(opt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteSnapshot provides a mock function with given fields: opt
 func (_m *VolumeDriver) DeleteSnapshot(opt *proto.DeleteVolumeSnapshotOpts) error {
 	ret := _m.Called(opt)
@@ -214,13 +274,59 @@ func (_m *VolumeDriver) ListPools() ([]*model.StoragePoolSpec, error) {
 	return r0, r1
 }
 
-// PullSnapshot provides a mock function with given fields: snapIdentifier
-func (_m *VolumeDriver) PullSnapshot(snapIdentifier string) (*model.VolumeSnapshotSpec, error) {
-	ret := _m.Called(snapIdentifier)
+// ListSnapshots provides a mock function with given fields: opt
+func (_m *VolumeDriver) ListSnapshots(opt *proto.ListVolumesOpts) ([]*model.VolumeSnapshotSpec, error) {
+	ret := _m.Called(opt)
+
+	var r0 []*model.VolumeSnapshotSpec
+	if rf, ok := ret.Get(0).(func(*proto.ListVolumesOpts) []*model.VolumeSnapshotSpec); ok {
+		r0 = rf(opt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.VolumeSnapshotSpec)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*proto.ListVolumesOpts) error); ok {
+		r1 = rf(opt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListVolumes provides a mock function with given fields: opt
+func (_m *VolumeDriver) ListVolumes(opt *proto.ListVolumesOpts) ([]*model.VolumeSpec, error) {
+	ret := _m.Called(opt)
+
+	var r0 []*model.VolumeSpec
+	if rf, ok := ret.Get(0).(func(*proto.ListVolumesOpts) []*model.VolumeSpec); ok {
+		r0 = rf(opt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.VolumeSpec)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*proto.ListVolumesOpts) error); ok {
+		r1 = rf(opt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ManageSnapshot provides a mock function with given fields: opt
+func (_m *VolumeDriver) ManageSnapshot(opt *proto.ManageVolumeSnapshotOpts) (*model.VolumeSnapshotSpec, error) {
+	ret := _m.Called(opt)
 
 	var r0 *model.VolumeSnapshotSpec
-	if rf, ok := ret.Get(0).(func(string) *model.VolumeSnapshotSpec); ok {
-		r0 = rf(snapIdentifier)
+	if rf, ok := ret.Get(0).(func(*proto.ManageVolumeSnapshotOpts) *model.VolumeSnapshotSpec); ok {
+		r0 = rf(opt)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.VolumeSnapshotSpec)
@@ -228,8 +334,8 @@ func (_m *VolumeDriver) PullSnapshot(snapIdentifier string) (*model.VolumeSnapsh
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(snapIdentifier)
+	if rf, ok := ret.Get(1).(func(*proto.ManageVolumeSnapshotOpts) error); ok {
+		r1 = rf(opt)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -237,13 +343,13 @@ func (_m *VolumeDriver) PullSnapshot(snapIdentifier string) (*model.VolumeSnapsh
 	return r0, r1
 }
 
-// PullVolume provides a mock function with given fields: volIdentifier
-func (_m *VolumeDriver) PullVolume(volIdentifier string) (*model.VolumeSpec, error) {
-	ret := _m.Called(volIdentifier)
+// ManageVolume provides a mock function with given fields: opt
+func (_m *VolumeDriver) ManageVolume(opt *proto.ManageVolumeOpts) (*model.VolumeSpec, error) {
+	ret := _m.Called(opt)
 
 	var r0 *model.VolumeSpec
-	if rf, ok := ret.Get(0).(func(string) *model.VolumeSpec); ok {
-		r0 = rf(volIdentifier)
+	if rf, ok := ret.Get(0).(func(*proto.ManageVolumeOpts) *model.VolumeSpec); ok {
+		r0 = rf(opt)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.VolumeSpec)
@@ -251,8 +357,8 @@ func (_m *VolumeDriver) PullVolume(volIdentifier string) (*model.VolumeSpec, err
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(volIdentifier)
+	if rf, ok := ret.Get(1).(func(*proto.ManageVolumeOpts) error); ok {
+		r1 = rf(opt)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -260,6 +366,89 @@ func (_m *VolumeDriver) PullVolume(volIdentifier string) (*model.VolumeSpec, err
 	return r0, r1
 }
 
+// MigrateVolume provides a mock function with given fields: opt
+func (_m *VolumeDriver) MigrateVolume(opt *proto.MigrateVolumeOpts) (*model.VolumeSpec, error) {
+	ret := _m.Called(opt)
+
+	var r0 *model.VolumeSpec
+	if rf, ok := ret.Get(0).(func(*proto.MigrateVolumeOpts) *model.VolumeSpec); ok {
+		r0 = rf(opt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.VolumeSpec)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*proto.MigrateVolumeOpts) error); ok {
+		r1 = rf(opt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PullSnapshot provides a mock function with given fields: opt
+func (_m *VolumeDriver) PullSnapshot(opt *proto.PullVolumeSnapshotOpts) (*model.VolumeSnapshotSpec, error) {
+	ret := _m.Called(opt)
+
+	var r0 *model.VolumeSnapshotSpec
+	if rf, ok := ret.Get(0).(func(*proto.PullVolumeSnapshotOpts) *model.VolumeSnapshotSpec); ok {
+		r0 = rf(opt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.VolumeSnapshotSpec)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*proto.PullVolumeSnapshotOpts) error); ok {
+		r1 = rf(opt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PullVolume provides a mock function with given fields: opt
+func (_m *VolumeDriver) PullVolume(opt *proto.PullVolumeOpts) (*model.VolumeSpec, error) {
+	ret := _m.Called(opt)
+
+	var r0 *model.VolumeSpec
+	if rf, ok := ret.Get(0).(func(*proto.PullVolumeOpts) *model.VolumeSpec); ok {
+		r0 = rf(opt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.VolumeSpec)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*proto.PullVolumeOpts) error); ok {
+		r1 = rf(opt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RevertVolumeToSnapshot provides a mock function with given fields: opt
+func (_m *VolumeDriver) RevertVolumeToSnapshot(opt *proto.RevertVolumeToSnapshotOpts) error {
+	ret := _m.Called(opt)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*proto.RevertVolumeToSnapshotOpts) error); ok {
+		r0 = rf(opt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Setup provides a mock function with given fields:
 func (_m *VolumeDriver) Setup() error {
 	ret := _m.Called()
@@ -302,6 +491,34 @@ func (_m *VolumeDriver) TerminateSnapshotConnection(opt *proto.DeleteSnapshotAtt
 	return r0
 }
 
+// UnmanageSnapshot provides a mock function with given fields: opt
+func (_m *VolumeDriver) UnmanageSnapshot(opt *proto.UnmanageVolumeSnapshotOpts) error {
+	ret := _m.Called(opt)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*proto.UnmanageVolumeSnapshotOpts) error); ok {
+		r0 = rf(opt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UnmanageVolume provides a mock function with given fields: opt
+func (_m *VolumeDriver) UnmanageVolume(opt *proto.UnmanageVolumeOpts) error {
+	ret := _m.Called(opt)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*proto.UnmanageVolumeOpts) error); ok {
+		r0 = rf(opt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Unset provides a mock function with given fields:
 func (_m *VolumeDriver) Unset() error {
 	ret := _m.Called()